@@ -8,6 +8,8 @@ LICENSE file in the root directory of this source tree.
 
 package cwf
 
+import "time"
+
 const (
 	ModuleName = "cwf"
 
@@ -16,3 +18,8 @@ const (
 	CwfGatewayType             = "carrier_wifi_gateway"
 	CwfSubscriberDirectoryType = "cwf_subscriber_directory_record"
 )
+
+// CwfSubscriberDirectoryTTL is how long a CWF subscriber directory record
+// stays valid after both it was last reported and its gateway last checked
+// in.
+const CwfSubscriberDirectoryTTL = 24 * time.Hour
@@ -96,6 +96,8 @@ func buildFromConfigs(nwConfig *models.NetworkCarrierWifiConfigs, gwConfig *mode
 	if aaa != nil {
 		mc := &fegmconfig.AAAConfig{LogLevel: protos.LogLevel_INFO}
 		protos.FillIn(aaa, mc)
+		mc.SessionStore = getAAASessionStore(aaa.SessionStore)
+		mc.SessionStorePath = aaa.SessionStorePath
 		ret["aaa_server"] = mc
 	}
 	ret["pipelined"] = &ltemconfig.PipelineD{
@@ -145,3 +147,9 @@ func getPipelineDServicesConfig(networkServices []string) ([]ltemconfig.Pipeline
 	}
 	return apps, nil
 }
+
+// getAAASessionStore returns the mconfig session store type of the
+// session_store of an aaa_server config. Unset stores default to MEMORY.
+func getAAASessionStore(sessionStore string) fegmconfig.AAAConfig_SessionStoreType {
+	return fegmconfig.AAAConfig_SessionStoreType(fegmconfig.AAAConfig_SessionStoreType_value[sessionStore])
+}
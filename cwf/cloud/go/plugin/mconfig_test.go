@@ -78,6 +78,8 @@ func TestBuilder_Build(t *testing.T) {
 			IdleSessionTimeoutMs: 21600000,
 			AccountingEnabled:    false,
 			CreateSessionOnAuth:  false,
+			SessionStore:         fegmconfig.AAAConfig_REDIS,
+			SessionStorePath:     "/var/opt/magma/aaa_sessions",
 		},
 		"pipelined": &ltemconfig.PipelineD{
 			LogLevel:      protos.LogLevel_INFO,
@@ -123,6 +125,8 @@ var defaultnwConfig = &models.NetworkCarrierWifiConfigs{
 		IDLESessionTimeoutMs: 21600000,
 		AccountingEnabled:    false,
 		CreateSessionOnAuth:  false,
+		SessionStore:         "REDIS",
+		SessionStorePath:     "/var/opt/magma/aaa_sessions",
 	},
 	NetworkServices: []string{"policy_enforcement"},
 	DefaultRuleID:   swag.String(""),
@@ -43,7 +43,8 @@ func (*CwfOrchestratorPlugin) GetSerdes() []serde.Serde {
 	return []serde.Serde{
 		configurator.NewNetworkConfigSerde(cwf.CwfNetworkType, &models.NetworkCarrierWifiConfigs{}),
 		configurator.NewNetworkEntityConfigSerde(cwf.CwfGatewayType, &models.GatewayCwfConfigs{}),
-		state.NewStateSerde(cwf.CwfSubscriberDirectoryType, &models.CwfSubscriberDirectoryRecord{}),
+		state.NewGatewayBoundStateSerde(
+			cwf.CwfSubscriberDirectoryType, &models.CwfSubscriberDirectoryRecord{}, cwf.CwfSubscriberDirectoryTTL),
 	}
 }
 
@@ -25,6 +25,7 @@ const (
 	defaultRadiusSecret      = "123456"
 	defaultCwagTestBr        = "cwag_test_br0"
 	defaultBrMac             = "76-02-5B-80-EC-44"
+	defaultAkaPrimeNetwork   = "WLAN"
 )
 
 var (
@@ -57,8 +58,16 @@ func getUESimConfig() (*UESimConfig, error) {
 	brMac := getBridgeMac(brName)
 	amfBytes := getHexParam(uecfg, "amf", defaultAmf)
 	opBytes := getHexParam(uecfg, "op", defaultOp)
-	glog.Infof("UE SIM Config - OP: %x, AMF: %x, RADIUS Endpoint: %s, RADIUS Secret: %s",
-		opBytes, amfBytes, authAddr, secret)
+	akaPrime, err := uecfg.GetBoolParam("eap_aka_prime")
+	if err != nil {
+		akaPrime = false
+	}
+	akaPrimeNetwork, err := uecfg.GetStringParam("aka_prime_network_name")
+	if err != nil {
+		akaPrimeNetwork = defaultAkaPrimeNetwork
+	}
+	glog.Infof("UE SIM Config - OP: %x, AMF: %x, RADIUS Endpoint: %s, RADIUS Secret: %s, EAP-AKA': %t",
+		opBytes, amfBytes, authAddr, secret, akaPrime)
 	return &UESimConfig{
 		op:                opBytes,
 		amf:               amfBytes,
@@ -66,6 +75,9 @@ func getUESimConfig() (*UESimConfig, error) {
 		radiusAcctAddress: acctAddr,
 		radiusSecret:      secret,
 		brMac:             brMac,
+
+		akaPrime:            akaPrime,
+		akaPrimeNetworkName: akaPrimeNetwork,
 	}, nil
 }
 
@@ -77,6 +89,8 @@ func getDefaultUESimConfig() *UESimConfig {
 		radiusAcctAddress: defaultRadiusAcctAddress,
 		radiusSecret:      defaultRadiusSecret,
 		brMac:             defaultBrMac,
+
+		akaPrimeNetworkName: defaultAkaPrimeNetwork,
 	}
 }
 
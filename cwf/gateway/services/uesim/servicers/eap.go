@@ -33,6 +33,8 @@ func (srv *UESimServer) HandleEap(ue *cwfprotos.UEConfig, req eap.Packet) (eap.P
 		return srv.eapIdentityRequest(ue, req)
 	case fegprotos.EapType_AKA:
 		return srv.handleEapAka(ue, req)
+	case fegprotos.EapType_AKAPrime:
+		return srv.handleEapAkaPrime(ue, req)
 	}
 	return nil, errors.Errorf("Unsupported Eap Type: %d", req[eap.EapMsgMethodType])
 }
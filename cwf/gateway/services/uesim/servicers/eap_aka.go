@@ -16,8 +16,10 @@ import (
 	"reflect"
 
 	"magma/cwf/cloud/go/protos"
+	fegprotos "magma/feg/gateway/services/aaa/protos"
 	"magma/feg/gateway/services/eap"
 	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 	"magma/lte/cloud/go/crypto"
 	"magma/lte/cloud/go/services/eps_authentication/servicers"
 
@@ -43,6 +45,11 @@ const (
 
 // handleEapAka routes the EAP-AKA request to the UE with the specified imsi.
 func (srv *UESimServer) handleEapAka(ue *protos.UEConfig, req eap.Packet) (eap.Packet, error) {
+	if srv.cfg.akaPrime {
+		// UE prefers EAP-AKA', ask the server to use it instead (RFC 5448, section 3)
+		return eap.NewPacket(
+			eap.ResponseCode, req.Identifier(), []byte{uint8(fegprotos.EapType_Legacy_Nak), aka_prime.TYPE}), nil
+	}
 	switch aka.Subtype(req[eap.EapSubtype]) {
 	case aka.SubtypeIdentity:
 		return srv.eapAkaIdentityRequest(ue, req)
@@ -55,6 +62,11 @@ func (srv *UESimServer) handleEapAka(ue *protos.UEConfig, req eap.Packet) (eap.P
 
 // Given a UE and the EAP-AKA identity request, generates the EAP response.
 func (srv *UESimServer) eapAkaIdentityRequest(ue *protos.UEConfig, req eap.Packet) (eap.Packet, error) {
+	return identityResponse(req, "\x30"+ue.GetImsi()+IdentityPostfix)
+}
+
+// identityResponse creates EAP-AKA or AKA' identity response (of the request's type) with the given identity
+func identityResponse(req eap.Packet, identity string) (eap.Packet, error) {
 	scanner, err := eap.NewAttributeScanner(req)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error creating new attribute scanner")
@@ -70,11 +82,11 @@ func (srv *UESimServer) eapAkaIdentityRequest(ue *protos.UEConfig, req eap.Packe
 			p := eap.NewPacket(
 				eap.ResponseCode,
 				req.Identifier(),
-				[]byte{aka.TYPE, byte(aka.SubtypeIdentity), 0, 0},
+				[]byte{req.Type(), byte(aka.SubtypeIdentity), 0, 0},
 			)
 
 			// Append Identity Attribute data to packet.
-			id := []byte("\x30" + ue.GetImsi() + IdentityPostfix)
+			id := []byte(identity)
 			p, err = p.Append(
 				eap.NewAttribute(
 					aka.AT_IDENTITY,
@@ -117,6 +129,39 @@ func (srv *UESimServer) eapAkaChallengeRequest(ue *protos.UEConfig, req eap.Pack
 	expectedMac := attrs.mac.Marshaled()[aka.ATT_HDR_LEN:]
 
 	id := []byte("\x30" + ue.GetImsi() + IdentityPostfix)
+	auth, err := srv.newUEAuthenticator(ue, srv.cfg.amf, rand)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate and verify MAC.
+	_, kAut, _, _ := aka.MakeAKAKeys(id, auth.vector.IntegrityKey[:], auth.vector.ConfidentialityKey[:])
+	err = verifyChallengeMac(req, expectedMac, aka.GenMac, kAut)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify AUTN & update UE SEQ
+	res, err := srv.verifyAutn(ue, auth, rand, expectedAutn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the response EAP packet with RES & CHECKCODE attributes
+	return newChallengeResponse(
+		req, res, []eap.Attribute{eap.NewAttribute(aka.AT_CHECKCODE, []byte(CheckcodeValue))}, aka.GenMac, kAut)
+}
+
+// ueAuthenticator holds the UE's milenage state of an AKA or AKA' challenge
+type ueAuthenticator struct {
+	milenage *crypto.MilenageCipher
+	key, opc []byte
+	// vector of the challenge's RAND & the UE's SEQ, its keys don't depend on SQN
+	vector *crypto.SIPAuthVector
+}
+
+// newUEAuthenticator verifies the UE's Opc & calculates the vector of the challenge's RAND with the given AMF
+func (srv *UESimServer) newUEAuthenticator(ue *protos.UEConfig, amf, rand []byte) (*ueAuthenticator, error) {
 	key := []byte(ue.AuthKey)
 
 	// Calculate SQN using SEQ and arbitrary IND
@@ -132,7 +177,7 @@ func (srv *UESimServer) eapAkaChallengeRequest(ue *protos.UEConfig, req eap.Pack
 	}
 
 	// Calculate RES and other keys.
-	milenage, err := crypto.NewMilenageCipher(srv.cfg.amf)
+	milenage, err := crypto.NewMilenageCipher(amf)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error creating milenage cipher")
 	}
@@ -140,34 +185,20 @@ func (srv *UESimServer) eapAkaChallengeRequest(ue *protos.UEConfig, req eap.Pack
 	if err != nil {
 		return nil, errors.Wrap(err, "Error calculating authentication vector")
 	}
-	// Make copy of packet and zero out MAC value.
-	copyReq := make([]byte, len(req))
-	copy(copyReq, req)
-	copyAttrs, err := parseChallengeAttributes(eap.Packet(copyReq))
-	if err != io.EOF {
-		return nil, errors.Wrap(err, "Error while parsing attributes of copied request packet")
-	}
-	copyMacBytes := copyAttrs.mac.Marshaled()
-	for i := aka.ATT_HDR_LEN; i < len(copyMacBytes); i++ {
-		copyMacBytes[i] = 0
-	}
-
-	// Calculate and verify MAC.
-	_, kAut, _, _ := aka.MakeAKAKeys(id, intermediateVec.IntegrityKey[:], intermediateVec.ConfidentialityKey[:])
-	mac := aka.GenMac(copyReq, kAut)
-	if !reflect.DeepEqual(expectedMac, mac) {
-		return nil, fmt.Errorf("Invalid MAC: Expected MAC: %x; Actual MAC: %x", expectedMac, mac)
-	}
+	return &ueAuthenticator{milenage: milenage, key: key, opc: opc[:], vector: intermediateVec}, nil
+}
 
-	// Verify AUTN (MacA must be equal and SEQ in correct range)
-	receivedSqn := extractSqnFromAutn(expectedAutn, intermediateVec.AnonymityKey[:])
-	resultVec, err := milenage.GenerateSIPAuthVectorWithRand(rand, key, opc[:], receivedSqn)
+// verifyAutn verifies AUTN of the challenge (MacA must be equal and SEQ in correct range), updates the UE's SEQ &
+// returns RES
+func (srv *UESimServer) verifyAutn(ue *protos.UEConfig, auth *ueAuthenticator, rand, autn []byte) ([]byte, error) {
+	receivedSqn := extractSqnFromAutn(autn, auth.vector.AnonymityKey[:])
+	resultVec, err := auth.milenage.GenerateSIPAuthVectorWithRand(rand, auth.key, auth.opc, receivedSqn)
 	if err != nil {
 		return nil, errors.Wrap(err, "Error calculating authentication vector")
 	}
-	if !reflect.DeepEqual(expectedAutn[MacAStart:], resultVec.Autn[MacAStart:]) {
+	if !reflect.DeepEqual(autn[MacAStart:], resultVec.Autn[MacAStart:]) {
 		return nil, fmt.Errorf("Invalid MacA in AUTN: Received MacA %x; Calculated MacA: %x",
-			expectedAutn[MacAStart:],
+			autn[MacAStart:],
 			resultVec.Autn[MacAStart:],
 		)
 	}
@@ -185,17 +216,47 @@ func (srv *UESimServer) eapAkaChallengeRequest(ue *protos.UEConfig, req eap.Pack
 	if err != nil {
 		return nil, fmt.Errorf("An unexpected error occurred while updating SEQ: %s", err)
 	}
+	return resultVec.Xres[:], nil
+}
 
-	// Create the response EAP packet.
-	p := eap.NewPacket(eap.ResponseCode, req.Identifier(), []byte{aka.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+// verifyChallengeMac verifies AT_MAC of the challenge using the given MAC function & K_aut
+func verifyChallengeMac(req eap.Packet, expectedMac []byte, genMac func(data, kAut []byte) []byte, kAut []byte) error {
+	// Make copy of packet and zero out MAC value.
+	copyReq := make([]byte, len(req))
+	copy(copyReq, req)
+	copyAttrs, err := parseChallengeAttributes(eap.Packet(copyReq))
+	if err != io.EOF {
+		return errors.Wrap(err, "Error while parsing attributes of copied request packet")
+	}
+	copyMacBytes := copyAttrs.mac.Marshaled()
+	for i := aka.ATT_HDR_LEN; i < len(copyMacBytes); i++ {
+		copyMacBytes[i] = 0
+	}
+	mac := genMac(copyReq, kAut)
+	if !reflect.DeepEqual(expectedMac, mac) {
+		return fmt.Errorf("Invalid MAC: Expected MAC: %x; Actual MAC: %x", expectedMac, mac)
+	}
+	return nil
+}
+
+// newChallengeResponse creates the challenge response EAP packet with AT_RES, the given attributes & AT_MAC
+// signed with the given MAC function & K_aut
+func newChallengeResponse(
+	req eap.Packet,
+	res []byte,
+	attrs []eap.Attribute,
+	genMac func(data, kAut []byte) []byte,
+	kAut []byte,
+) (eap.Packet, error) {
+	p := eap.NewPacket(eap.ResponseCode, req.Identifier(), []byte{req.Type(), byte(aka.SubtypeChallenge), 0, 0})
 
 	// Add the RES attribute.
-	p, err = p.Append(
+	p, err := p.Append(
 		eap.NewAttribute(
 			aka.AT_RES,
 			append(
-				[]byte{uint8(len(resultVec.Xres[:]) * 8 >> 8), uint8(len(resultVec.Xres[:]) * 8)},
-				[]byte(resultVec.Xres[:])...,
+				[]byte{uint8(len(res) * 8 >> 8), uint8(len(res) * 8)},
+				res...,
 			),
 		),
 	)
@@ -203,13 +264,12 @@ func (srv *UESimServer) eapAkaChallengeRequest(ue *protos.UEConfig, req eap.Pack
 		return nil, errors.Wrap(err, "Error appending attribute to packet")
 	}
 
-	// Add the CHECKCODE attribute.
-	p, err = p.Append(
-		eap.NewAttribute(
-			aka.AT_CHECKCODE,
-			[]byte(CheckcodeValue),
-		),
-	)
+	for _, a := range attrs {
+		p, err = p.Append(a)
+		if err != nil {
+			return nil, errors.Wrap(err, "Error appending attribute to packet")
+		}
+	}
 
 	atMacOffset := len(p) + aka.ATT_HDR_LEN
 
@@ -217,7 +277,7 @@ func (srv *UESimServer) eapAkaChallengeRequest(ue *protos.UEConfig, req eap.Pack
 	p, err = p.Append(
 		eap.NewAttribute(
 			aka.AT_MAC,
-			append(make([]byte, 2+16)),
+			make([]byte, 2+16),
 		),
 	)
 	if err != nil {
@@ -225,7 +285,7 @@ func (srv *UESimServer) eapAkaChallengeRequest(ue *protos.UEConfig, req eap.Pack
 	}
 
 	// Calculate and Copy MAC into packet.
-	mac = aka.GenMac(p, kAut)
+	mac := genMac(p, kAut)
 	copy(p[atMacOffset:], mac)
 
 	return p, nil
@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package servicers
+
+import (
+	"io"
+
+	"magma/cwf/cloud/go/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+// handleEapAkaPrime routes the EAP-AKA' request to the UE with the specified imsi.
+func (srv *UESimServer) handleEapAkaPrime(ue *protos.UEConfig, req eap.Packet) (eap.Packet, error) {
+	switch aka.Subtype(req[eap.EapSubtype]) {
+	case aka.SubtypeIdentity:
+		return identityResponse(req, string(aka_prime.PermanentIdPrefix)+ue.GetImsi()+IdentityPostfix)
+	case aka.SubtypeChallenge:
+		return srv.eapAkaPrimeChallengeRequest(ue, req)
+	default:
+		return nil, errors.Errorf("Unsupported Subtype: %d", req[eap.EapSubtype])
+	}
+}
+
+// Given a UE, the Op, the Amf, and the EAP-AKA' challenge, generates the EAP response.
+func (srv *UESimServer) eapAkaPrimeChallengeRequest(ue *protos.UEConfig, req eap.Packet) (eap.Packet, error) {
+	attrs, err := parseChallengeAttributes(req)
+	if err != io.EOF {
+		return nil, errors.Wrap(err, "Error while parsing attributes of request packet")
+	}
+	if attrs.rand == nil || attrs.autn == nil || attrs.mac == nil {
+		return nil, errors.Errorf("Missing one or more expected attributes\nRAND: %s\nAUTN: %s\nMAC: %s\n", attrs.rand, attrs.autn, attrs.mac)
+	}
+	primeAttrs, err := aka_prime.ParseChallengeAttributes(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error while parsing AKA' attributes of request packet")
+	}
+
+	// Negotiate KDF, see RFC 5448, section 3.2
+	if len(primeAttrs.KDFs) == 0 || primeAttrs.KDFs[0] != aka_prime.KDF_AKA_PRIME {
+		for _, kdf := range primeAttrs.KDFs {
+			if kdf == aka_prime.KDF_AKA_PRIME {
+				// Ask the server to use the supported KDF
+				p := eap.NewPacket(
+					eap.ResponseCode, req.Identifier(), []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+				return p.Append(aka_prime.NewKDFAttribute(aka_prime.KDF_AKA_PRIME))
+			}
+		}
+		return eap.NewPacket(
+			eap.ResponseCode,
+			req.Identifier(),
+			[]byte{aka_prime.TYPE, byte(aka.SubtypeAuthenticationReject), 0, 0}), nil
+	}
+	if primeAttrs.NetworkName != srv.cfg.akaPrimeNetworkName {
+		return nil, errors.Errorf(
+			"Invalid AT_KDF_INPUT: Expected Network Name: %s; Actual Network Name: %s",
+			srv.cfg.akaPrimeNetworkName, primeAttrs.NetworkName)
+	}
+
+	// Parse out RAND, expected AUTN, and expected MAC values.
+	rand := attrs.rand.Marshaled()[aka.ATT_HDR_LEN:]
+	expectedAutn := attrs.autn.Marshaled()[aka.ATT_HDR_LEN:]
+	expectedMac := attrs.mac.Marshaled()[aka.ATT_HDR_LEN:]
+
+	// AKA' vectors must have the AMF separation bit set, see 3GPP TS 33.402, 6.2
+	if !aka_prime.HasAMFSeparationBit(expectedAutn) {
+		glog.Errorf("Missing AMF separation bit in AKA' Challenge AUTN: %x", expectedAutn)
+		return eap.NewPacket(
+			eap.ResponseCode,
+			req.Identifier(),
+			[]byte{aka_prime.TYPE, byte(aka.SubtypeAuthenticationReject), 0, 0}), nil
+	}
+
+	auth, err := srv.newUEAuthenticator(ue, aka_prime.WithAMFSeparationBit(srv.cfg.amf), rand)
+	if err != nil {
+		return nil, err
+	}
+
+	// Derive CK' & IK' and calculate and verify MAC.
+	ckPrime, ikPrime := aka_prime.MakeCKIKPrime(
+		auth.vector.ConfidentialityKey[:],
+		auth.vector.IntegrityKey[:],
+		primeAttrs.NetworkName,
+		expectedAutn[:aka_prime.SQN_XOR_AK_LEN])
+	id := []byte(string(aka_prime.PermanentIdPrefix) + ue.GetImsi() + IdentityPostfix)
+	_, kAut, _, _, _ := aka_prime.MakeAKAPrimeKeys(id, ikPrime, ckPrime)
+	err = verifyChallengeMac(req, expectedMac, aka_prime.GenMac, kAut)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify AUTN & update UE SEQ
+	res, err := srv.verifyAutn(ue, auth, rand, expectedAutn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the response EAP packet with RES attribute
+	return newChallengeResponse(req, res, nil, aka_prime.GenMac, kAut)
+}
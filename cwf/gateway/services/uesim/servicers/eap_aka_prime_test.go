@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package servicers_test
+
+import (
+	"testing"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
+	"magma/lte/cloud/go/crypto"
+	"magma/lte/cloud/go/services/eps_authentication/servicers"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	EapAkaPrimeIdentityRequestPacket = "\x01\xe8\x00\x0c\x32\x05\x00\x00\x0a\x01\x00\x00"
+	AkaPrimeIdentity                 = "6" + Imsi + "@wlan.mnc001.mcc001.3gppnetwork.org"
+	AkaPrimeRand                     = "\xee\xb3\x53\x6c\x2f\xc3\x68\xfe\x3a\xfb\xd5\x5c\xfe\xf9\x6b\x29"
+	// AkaPrimeAmf - the UE's AMF with the separation bit set
+	AkaPrimeAmf = "\xe7\x41"
+)
+
+func TestEapAkaPrimeIdentityRequest(t *testing.T) {
+	server, ue, err := setupTest()
+	assert.NoError(t, err)
+
+	res, err := server.HandleEap(ue, eap.Packet(EapAkaPrimeIdentityRequestPacket))
+	assert.NoError(t, err)
+	assert.Equal(t, aka_prime.TYPE, res.Type())
+	assert.Equal(t, uint8(aka.SubtypeIdentity), res[eap.EapSubtype])
+
+	scanner, err := eap.NewAttributeScanner(res)
+	assert.NoError(t, err)
+	a, err := scanner.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, aka.AT_IDENTITY, a.Type())
+	assert.Equal(t, AkaPrimeIdentity, string(a.Value()[2:2+len(AkaPrimeIdentity)]))
+}
+
+func TestEapAkaPrimeChallengeRequest(t *testing.T) {
+	server, ue, err := setupTest()
+	assert.NoError(t, err)
+
+	req, res, kAut := newAkaPrimeChallenge(t, Seq+1, AkaPrimeAmf, aka_prime.KDF_AKA_PRIME)
+	resp, err := server.HandleEap(ue, req)
+	assert.NoError(t, err)
+	assert.Equal(t, uint8(eap.ResponseCode), resp.Code())
+	assert.Equal(t, req.Identifier(), resp.Identifier())
+	assert.Equal(t, aka_prime.TYPE, resp.Type())
+	assert.Equal(t, uint8(aka.SubtypeChallenge), resp[eap.EapSubtype])
+
+	var resAttr, macAttr eap.Attribute
+	scanner, err := eap.NewAttributeScanner(resp)
+	assert.NoError(t, err)
+	for a, err := scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case aka.AT_RES:
+			resAttr = a
+		case aka.AT_MAC:
+			macAttr = a
+		default:
+			t.Errorf("Unexpected AKA' Challenge Response Attribute type %d", a.Type())
+		}
+	}
+	if assert.NotNil(t, resAttr) && assert.NotNil(t, macAttr) {
+		assert.Equal(t, res, resAttr.Value()[2:])
+
+		// Verify response MAC
+		mac := append([]byte{}, macAttr.Value()[2:]...)
+		copy(macAttr.Value()[2:], make([]byte, len(mac)))
+		assert.Equal(t, aka_prime.GenMac(resp, kAut), mac)
+	}
+	assert.Equal(t, uint64(Seq+1), ue.GetSeq())
+
+	// Replayed challenge must be rejected
+	_, err = server.HandleEap(ue, req)
+	assert.Error(t, err)
+}
+
+func TestEapAkaPrimeKDFNegotiation(t *testing.T) {
+	server, ue, err := setupTest()
+	assert.NoError(t, err)
+
+	// Supported KDF is not the first one in the list, UE must request it
+	req, _, _ := newAkaPrimeChallenge(t, Seq+1, AkaPrimeAmf, 2, aka_prime.KDF_AKA_PRIME)
+	resp, err := server.HandleEap(ue, req)
+	assert.NoError(t, err)
+	expected := eap.NewPacket(eap.ResponseCode, req.Identifier(), []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	expected, err = expected.Append(aka_prime.NewKDFAttribute(aka_prime.KDF_AKA_PRIME))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp)
+	assert.Equal(t, uint64(Seq), ue.GetSeq())
+
+	// No supported KDFs, UE must reject authentication
+	req, _, _ = newAkaPrimeChallenge(t, Seq+1, AkaPrimeAmf, 2)
+	resp, err = server.HandleEap(ue, req)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		eap.NewPacket(eap.ResponseCode, req.Identifier(), []byte{aka_prime.TYPE, byte(aka.SubtypeAuthenticationReject), 0, 0}),
+		resp)
+}
+
+func TestEapAkaPrimeAMFSeparationBit(t *testing.T) {
+	server, ue, err := setupTest()
+	assert.NoError(t, err)
+
+	// AUTN without AMF separation bit, UE must reject authentication
+	req, _, _ := newAkaPrimeChallenge(t, Seq+1, "\x67\x41", aka_prime.KDF_AKA_PRIME)
+	resp, err := server.HandleEap(ue, req)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		eap.NewPacket(eap.ResponseCode, req.Identifier(), []byte{aka_prime.TYPE, byte(aka.SubtypeAuthenticationReject), 0, 0}),
+		resp)
+	assert.Equal(t, uint64(Seq), ue.GetSeq())
+}
+
+// newAkaPrimeChallenge creates AKA' challenge for the test UE with the given SEQ, AMF & KDFs, it returns the challenge,
+// expected RES & K_aut
+func newAkaPrimeChallenge(t *testing.T, seq uint64, amf string, kdfs ...uint16) (eap.Packet, []byte, []byte) {
+	milenage, err := crypto.NewMilenageCipher([]byte(amf))
+	assert.NoError(t, err)
+	vector, err := milenage.GenerateSIPAuthVectorWithRand(
+		[]byte(AkaPrimeRand), []byte(Key), []byte(Opc), servicers.SeqToSqn(seq, 0))
+	assert.NoError(t, err)
+
+	ckPrime, ikPrime := aka_prime.MakeCKIKPrime(
+		vector.ConfidentialityKey[:],
+		vector.IntegrityKey[:],
+		aka_prime.DefaultNetworkName,
+		vector.Autn[:aka_prime.SQN_XOR_AK_LEN])
+	_, kAut, _, _, _ := aka_prime.MakeAKAPrimeKeys([]byte(AkaPrimeIdentity), ikPrime, ckPrime)
+
+	p := eap.NewPacket(eap.RequestCode, 0xea, []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	p, err = p.Append(eap.NewAttribute(aka.AT_RAND, append([]byte{0, 0}, vector.Rand[:]...)))
+	assert.NoError(t, err)
+	p, err = p.Append(eap.NewAttribute(aka.AT_AUTN, append([]byte{0, 0}, vector.Autn[:]...)))
+	assert.NoError(t, err)
+	p, err = p.Append(aka_prime.NewKDFInputAttribute(aka_prime.DefaultNetworkName))
+	assert.NoError(t, err)
+	for _, kdf := range kdfs {
+		p, err = p.Append(aka_prime.NewKDFAttribute(kdf))
+		assert.NoError(t, err)
+	}
+	p, err = aka_prime.AppendMac(p, kAut)
+	assert.NoError(t, err)
+	return p, vector.Xres[:], kAut
+}
@@ -45,6 +45,10 @@ type UESimConfig struct {
 	radiusAcctAddress string
 	radiusSecret      string
 	brMac             string
+	// akaPrime - use EAP-AKA' (RFC 5448) instead of EAP-AKA
+	akaPrime bool
+	// akaPrimeNetworkName - expected AKA' Access Network Identity (AT_KDF_INPUT)
+	akaPrimeNetworkName string
 }
 
 // NewUESimServer initializes a UESimServer with an empty store map.
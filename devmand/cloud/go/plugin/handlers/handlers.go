@@ -17,6 +17,7 @@ import (
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/models"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/pluginimpl/handlers"
 	orc8rmodels "magma/orc8r/cloud/go/pluginimpl/models"
@@ -147,7 +148,7 @@ func createNetwork(c echo.Context) error {
 	if err := payload.Validate(strfmt.Default); err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
-	err := configurator.CreateNetwork(payload.ToConfiguratorNetwork())
+	err := configurator.CreateNetworkAs(access.GetVerifiedOperator(c), payload.ToConfiguratorNetwork())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -202,7 +203,7 @@ func updateNetwork(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a Symphony network", nid))
 	}
 
-	err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{payload.ToUpdateCriteria()})
+	err = configurator.UpdateNetworksAs(access.GetVerifiedOperator(c), []configurator.NetworkUpdateCriteria{payload.ToUpdateCriteria()})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -227,7 +228,7 @@ func deleteNetwork(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a Symphony network", nid))
 	}
 
-	err = configurator.DeleteNetwork(nid)
+	err = configurator.DeleteNetworkAs(access.GetVerifiedOperator(c), nid)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -264,7 +265,7 @@ func getAgent(c echo.Context) error {
 		return nerr
 	}
 
-	magmadGWModel, nerr := handlers.LoadMagmadGatewayModel(nid, aid)
+	magmadGWModel, nerr := handlers.LoadMagmadGatewayModel(access.GetVerifiedOperator(c), nid, aid)
 	if nerr != nil {
 		return nerr
 	}
@@ -320,7 +321,7 @@ func deleteAgent(c echo.Context) error {
 			Type: orc8r.MagmadGatewayType, Key: aid, DeleteEntity: true,
 		},
 	}
-	err := configurator.WriteEntities(nid, updates...)
+	err := configurator.WriteEntitiesAs(access.GetVerifiedOperator(c), nid, updates...)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -372,7 +373,7 @@ func createDevice(c echo.Context) error {
 	for _, update := range symphonymodels.GetAgentUpdates(string(payload.ID), "", string(payload.ManagingAgent)) {
 		writes = append(writes, update)
 	}
-	err := configurator.WriteEntities(nid, writes...)
+	err := configurator.WriteEntitiesAs(access.GetVerifiedOperator(c), nid, writes...)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -419,7 +420,7 @@ func updateDevice(c echo.Context) error {
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
-	_, err = configurator.UpdateEntities(nid, deviceUpdates)
+	_, err = configurator.UpdateEntitiesAs(access.GetVerifiedOperator(c), nid, deviceUpdates)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -440,7 +441,7 @@ func deleteDevice(c echo.Context) error {
 		return echo.ErrNotFound
 	}
 
-	err = configurator.DeleteEntity(nid, devmand.SymphonyDeviceType, did)
+	err = configurator.DeleteEntityAs(access.GetVerifiedOperator(c), nid, devmand.SymphonyDeviceType, did)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
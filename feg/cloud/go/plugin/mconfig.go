@@ -109,6 +109,8 @@ func (*Builder) Build(
 	if aaa != nil {
 		mc := &mconfig.AAAConfig{LogLevel: protos.LogLevel_INFO}
 		protos.FillIn(aaa, mc)
+		mc.SessionStore = getAAASessionStore(aaa.SessionStore)
+		mc.SessionStorePath = aaa.SessionStorePath
 		mconfigOut["aaa_server"] = mc
 	}
 
@@ -149,6 +151,12 @@ func getHealthConfig(network configurator.Network) (*models.Health, error) {
 	return nil, fmt.Errorf("network health config is nil")
 }
 
+// getAAASessionStore returns the mconfig session store type of the
+// session_store of an aaa_server config. Unset stores default to MEMORY.
+func getAAASessionStore(sessionStore string) mconfig.AAAConfig_SessionStoreType {
+	return mconfig.AAAConfig_SessionStoreType(mconfig.AAAConfig_SessionStoreType_value[sessionStore])
+}
+
 func getGyInitMethod(initMethod *uint32) mconfig.GyInitMethod {
 	if initMethod == nil {
 		return mconfig.GyInitMethod_RESERVED
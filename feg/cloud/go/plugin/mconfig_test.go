@@ -145,6 +145,8 @@ func TestBuilder_Build(t *testing.T) {
 			IdleSessionTimeoutMs: 21600000,
 			AccountingEnabled:    false,
 			CreateSessionOnAuth:  false,
+			SessionStore:         mconfig.AAAConfig_REDIS,
+			SessionStorePath:     "/var/opt/magma/aaa_sessions",
 		},
 		"health": &mconfig.GatewayHealthConfig{
 			RequiredServices:          []string{"SWX_PROXY", "SESSION_PROXY"},
@@ -170,6 +172,65 @@ func TestBuilder_Build(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestBuilder_Build_DiameterPeers(t *testing.T) {
+	builder := &plugin.Builder{}
+
+	gxServer := *defaultConfig.Gx.Server
+	gxServer.Address = "pcrf1.magma.com:3868"
+	gxServer.LoadBalancing = models.DiameterClientConfigsLoadBalancingSessionSticky
+	gxServer.Weight = 2
+	gxServer.Peers = []*models.DiameterPeerConfigs{
+		{Protocol: "tcp", Address: "pcrf2.magma.com:3868", DestHost: "pcrf2.magma.com", Weight: 1},
+		{Protocol: "sctp", Address: "pcrf3.magma.com:3868", DisableDestHost: true},
+	}
+	config := *defaultConfig
+	config.Gx = &models.Gx{Server: &gxServer}
+	nw := configurator.Network{ID: "n1", Configs: map[string]interface{}{feg.FegNetworkType: &config}}
+	gw := configurator.NetworkEntity{
+		Type:         orc8r.MagmadGatewayType,
+		Key:          "gw1",
+		Associations: []storage.TypeAndKey{{Type: feg.FegGatewayType, Key: "gw1"}},
+	}
+	fegw := configurator.NetworkEntity{
+		Type:               feg.FegGatewayType,
+		Key:                "gw1",
+		ParentAssociations: []storage.TypeAndKey{{Type: orc8r.MagmadGatewayType, Key: "gw1"}},
+	}
+	graph := configurator.EntityGraph{
+		Entities: []configurator.NetworkEntity{gw, fegw},
+		Edges: []configurator.GraphEdge{
+			{From: storage.TypeAndKey{Type: orc8r.MagmadGatewayType, Key: "gw1"}, To: storage.TypeAndKey{Type: feg.FegGatewayType, Key: "gw1"}},
+		},
+	}
+
+	actual := map[string]proto.Message{}
+	err := builder.Build("n1", "gw1", graph, nw, actual)
+	assert.NoError(t, err)
+	expected := &mconfig.DiamClientConfig{
+		Protocol:         "tcp",
+		Address:          "pcrf1.magma.com:3868",
+		Retransmits:      0x3,
+		WatchdogInterval: 0x1,
+		RetryCount:       0x5,
+		ProductName:      "magma",
+		Realm:            "magma.com",
+		Host:             "magma-fedgw.magma.com",
+		Peers: []*mconfig.DiamPeerConfig{
+			{Protocol: "tcp", Address: "pcrf2.magma.com:3868", DestHost: "pcrf2.magma.com", Weight: 1},
+			{Protocol: "sctp", Address: "pcrf3.magma.com:3868", DisableDestHost: true},
+		},
+		LoadBalancing: mconfig.DiamLoadBalancing_SESSION_STICKY,
+		Weight:        2,
+	}
+	assert.Equal(t, expected, actual["session_proxy"].(*mconfig.SessionProxyConfig).Gx.Server)
+	// Clients without peers are unchanged
+	assert.Empty(t, actual["session_proxy"].(*mconfig.SessionProxyConfig).Gy.Server.Peers)
+
+	// Peers must have distinct addresses
+	gxServer.Peers[1].Address = gxServer.Address
+	assert.EqualError(t, config.ValidateModel(), "Duplicate diameter peer address: pcrf1.magma.com:3868")
+}
+
 var defaultConfig = &models.NetworkFederationConfigs{
 	S6a: &models.S6a{
 		Server: &models.DiameterClientConfigs{
@@ -246,6 +307,8 @@ var defaultConfig = &models.NetworkFederationConfigs{
 		IDLESessionTimeoutMs: 21600000,
 		AccountingEnabled:    false,
 		CreateSessionOnAuth:  false,
+		SessionStore:         "REDIS",
+		SessionStorePath:     "/var/opt/magma/aaa_sessions",
 	},
 	ServedNetworkIds: []string{},
 	Health: &models.Health{
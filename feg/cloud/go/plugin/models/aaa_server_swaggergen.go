@@ -6,9 +6,13 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // AaaServer aaa server configuration
@@ -23,10 +27,72 @@ type AaaServer struct {
 
 	// idle session timeout ms
 	IDLESessionTimeoutMs uint32 `json:"idle_session_timeout_ms,omitempty" magma_alt_name:"IdleSessionTimeoutMs"`
+
+	// Storage of the authenticated sessions. MEMORY sessions are lost on restart, REDIS sessions are shared by all aaa_server instances of the gateway and LOCAL sessions are kept in files under session_store_path.
+	// Enum: [MEMORY REDIS LOCAL]
+	SessionStore string `json:"session_store,omitempty"`
+
+	// Directory of the LOCAL session store
+	SessionStorePath string `json:"session_store_path,omitempty" magma_alt_name:"SessionStorePath"`
 }
 
 // Validate validates this aaa server
 func (m *AaaServer) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSessionStore(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var aaaServerTypeSessionStorePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["MEMORY","REDIS","LOCAL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		aaaServerTypeSessionStorePropEnum = append(aaaServerTypeSessionStorePropEnum, v)
+	}
+}
+
+const (
+
+	// AaaServerSessionStoreMEMORY captures enum value "MEMORY"
+	AaaServerSessionStoreMEMORY string = "MEMORY"
+
+	// AaaServerSessionStoreREDIS captures enum value "REDIS"
+	AaaServerSessionStoreREDIS string = "REDIS"
+
+	// AaaServerSessionStoreLOCAL captures enum value "LOCAL"
+	AaaServerSessionStoreLOCAL string = "LOCAL"
+)
+
+// prop value enum
+func (m *AaaServer) validateSessionStoreEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, aaaServerTypeSessionStorePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AaaServer) validateSessionStore(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SessionStore) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateSessionStoreEnum("session_store", "body", m.SessionStore); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -260,6 +260,21 @@ func (m *GatewayFederationConfigs) ToUpdateCriteria(networkID string, gatewayID
 func (m *DiameterClientConfigs) ToMconfig() *mconfig.DiamClientConfig {
 	res := &mconfig.DiamClientConfig{}
 	protos.FillIn(m, res)
+	if m == nil {
+		return res
+	}
+	if m.LoadBalancing == DiameterClientConfigsLoadBalancingSessionSticky {
+		res.LoadBalancing = mconfig.DiamLoadBalancing_SESSION_STICKY
+	}
+	for _, peer := range m.Peers {
+		res.Peers = append(res.Peers, peer.ToMconfig())
+	}
+	return res
+}
+
+func (m *DiameterPeerConfigs) ToMconfig() *mconfig.DiamPeerConfig {
+	res := &mconfig.DiamPeerConfig{}
+	protos.FillIn(m, res)
 	return res
 }
 
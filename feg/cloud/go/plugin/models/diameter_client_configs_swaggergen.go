@@ -7,6 +7,7 @@ package models
 
 import (
 	"encoding/json"
+	"strconv"
 
 	strfmt "github.com/go-openapi/strfmt"
 
@@ -36,10 +37,17 @@ type DiameterClientConfigs struct {
 	// Min Length: 1
 	Host string `json:"host,omitempty"`
 
+	// How requests are spread across the server and its peers. With failover, all requests go to the first healthy one of the server and its peers in order. With session_sticky, sessions are spread across the healthy ones by weight and all requests of a session go to the same one.
+	// Enum: [failover session_sticky]
+	LoadBalancing string `json:"load_balancing,omitempty"`
+
 	// local address
 	// Pattern: [0-9a-f\:\.]*(:[0-9]{1,5})?
 	LocalAddress string `json:"local_address,omitempty"`
 
+	// Additional servers to fail over to or to load balance across
+	Peers []*DiameterPeerConfigs `json:"peers"`
+
 	// product name
 	// Min Length: 1
 	ProductName string `json:"product_name,omitempty"`
@@ -60,6 +68,9 @@ type DiameterClientConfigs struct {
 
 	// watchdog interval
 	WatchdogInterval uint32 `json:"watchdog_interval,omitempty"`
+
+	// Weight of the server when load balancing sessions across it and its peers, 0 is treated as 1
+	Weight uint32 `json:"weight,omitempty"`
 }
 
 // Validate validates this diameter client configs
@@ -74,10 +85,18 @@ func (m *DiameterClientConfigs) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLoadBalancing(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateLocalAddress(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validatePeers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProductName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -122,6 +141,49 @@ func (m *DiameterClientConfigs) validateHost(formats strfmt.Registry) error {
 	return nil
 }
 
+var diameterClientConfigsTypeLoadBalancingPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["failover","session_sticky"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		diameterClientConfigsTypeLoadBalancingPropEnum = append(diameterClientConfigsTypeLoadBalancingPropEnum, v)
+	}
+}
+
+const (
+
+	// DiameterClientConfigsLoadBalancingFailover captures enum value "failover"
+	DiameterClientConfigsLoadBalancingFailover string = "failover"
+
+	// DiameterClientConfigsLoadBalancingSessionSticky captures enum value "session_sticky"
+	DiameterClientConfigsLoadBalancingSessionSticky string = "session_sticky"
+)
+
+// prop value enum
+func (m *DiameterClientConfigs) validateLoadBalancingEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, diameterClientConfigsTypeLoadBalancingPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *DiameterClientConfigs) validateLoadBalancing(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LoadBalancing) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateLoadBalancingEnum("load_balancing", "body", m.LoadBalancing); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *DiameterClientConfigs) validateLocalAddress(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.LocalAddress) { // not required
@@ -135,6 +197,31 @@ func (m *DiameterClientConfigs) validateLocalAddress(formats strfmt.Registry) er
 	return nil
 }
 
+func (m *DiameterClientConfigs) validatePeers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Peers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Peers); i++ {
+		if swag.IsZero(m.Peers[i]) { // not required
+			continue
+		}
+
+		if m.Peers[i] != nil {
+			if err := m.Peers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("peers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *DiameterClientConfigs) validateProductName(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ProductName) { // not required
@@ -55,6 +55,16 @@ func (m *DiameterClientConfigs) ValidateModel() error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
 	}
+	addresses := map[string]bool{m.Address: true}
+	for _, peer := range m.Peers {
+		if peer == nil {
+			return errors.New("peers must not be null")
+		}
+		if addresses[peer.Address] {
+			return errors.New(fmt.Sprintf("Duplicate diameter peer address: %s", peer.Address))
+		}
+		addresses[peer.Address] = true
+	}
 	return nil
 }
 
@@ -76,14 +86,14 @@ func (m *GatewayFederationConfigs) ValidateModel() error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
 	}
-	return nil
+	return validateDiameterClients(m.S6a, m.Gx, m.Gy, m.Swx)
 }
 
 func (m *NetworkFederationConfigs) ValidateModel() error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
 	}
-	return nil
+	return validateDiameterClients(m.S6a, m.Gx, m.Gy, m.Swx)
 }
 
 func (m *SubscriptionProfile) ValidateModel() error {
@@ -92,3 +102,30 @@ func (m *SubscriptionProfile) ValidateModel() error {
 	}
 	return nil
 }
+
+// validateDiameterClients runs the model validation of the diameter clients
+// of a federation config, which the generated validation doesn't
+func validateDiameterClients(s6a *S6a, gx *Gx, gy *Gy, swx *Swx) error {
+	var servers []*DiameterClientConfigs
+	if s6a != nil {
+		servers = append(servers, s6a.Server)
+	}
+	if gx != nil {
+		servers = append(servers, gx.Server)
+	}
+	if gy != nil {
+		servers = append(servers, gy.Server)
+	}
+	if swx != nil {
+		servers = append(servers, swx.Server)
+	}
+	for _, server := range servers {
+		if server == nil {
+			continue
+		}
+		if err := server.ValidateModel(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
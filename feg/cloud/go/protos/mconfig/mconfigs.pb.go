@@ -49,25 +49,89 @@ func (GyInitMethod) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_ac1e34e12c6f455d, []int{0}
 }
 
-//------------------------------------------------------------------------------
+// Load balancing mode of a diameter client with peers
+type DiamLoadBalancing int32
+
+const (
+	// Send requests to the server and fail over to the peers in order
+	DiamLoadBalancing_FAILOVER DiamLoadBalancing = 0
+	// Spread sessions across the server and its peers by weight, keeping all
+	// requests of a session on one peer
+	DiamLoadBalancing_SESSION_STICKY DiamLoadBalancing = 1
+)
+
+var DiamLoadBalancing_name = map[int32]string{
+	0: "FAILOVER",
+	1: "SESSION_STICKY",
+}
+
+var DiamLoadBalancing_value = map[string]int32{
+	"FAILOVER":       0,
+	"SESSION_STICKY": 1,
+}
+
+func (x DiamLoadBalancing) String() string {
+	return proto.EnumName(DiamLoadBalancing_name, int32(x))
+}
+
+func (DiamLoadBalancing) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ac1e34e12c6f455d, []int{1}
+}
+
+type AAAConfig_SessionStoreType int32
+
+const (
+	// sessions are lost on restart
+	AAAConfig_MEMORY AAAConfig_SessionStoreType = 0
+	// sessions are kept in the gateway redis & shared by all aaa_server instances
+	AAAConfig_REDIS AAAConfig_SessionStoreType = 1
+	// sessions are kept in files under SessionStorePath
+	AAAConfig_LOCAL AAAConfig_SessionStoreType = 2
+)
+
+var AAAConfig_SessionStoreType_name = map[int32]string{
+	0: "MEMORY",
+	1: "REDIS",
+	2: "LOCAL",
+}
+
+var AAAConfig_SessionStoreType_value = map[string]int32{
+	"MEMORY": 0,
+	"REDIS":  1,
+	"LOCAL":  2,
+}
+
+func (x AAAConfig_SessionStoreType) String() string {
+	return proto.EnumName(AAAConfig_SessionStoreType_name, int32(x))
+}
+
+func (AAAConfig_SessionStoreType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ac1e34e12c6f455d, []int{9, 0}
+}
+
+// ------------------------------------------------------------------------------
 // FeG configs
-//------------------------------------------------------------------------------
+// ------------------------------------------------------------------------------
 type DiamClientConfig struct {
-	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
-	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Retransmits          uint32   `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
-	WatchdogInterval     uint32   `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
-	RetryCount           uint32   `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
-	LocalAddress         string   `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
-	ProductName          string   `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Realm                string   `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`
-	Host                 string   `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
-	DestRealm            string   `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
-	DestHost             string   `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
-	DisableDestHost      bool     `protobuf:"varint,12,opt,name=disable_dest_host,json=disableDestHost,proto3" json:"disable_dest_host,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Protocol         string `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address          string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Retransmits      uint32 `protobuf:"varint,3,opt,name=retransmits,proto3" json:"retransmits,omitempty"`
+	WatchdogInterval uint32 `protobuf:"varint,4,opt,name=watchdog_interval,json=watchdogInterval,proto3" json:"watchdog_interval,omitempty"`
+	RetryCount       uint32 `protobuf:"varint,5,opt,name=retry_count,json=retryCount,proto3" json:"retry_count,omitempty"`
+	LocalAddress     string `protobuf:"bytes,6,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	ProductName      string `protobuf:"bytes,7,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Realm            string `protobuf:"bytes,8,opt,name=realm,proto3" json:"realm,omitempty"`
+	Host             string `protobuf:"bytes,9,opt,name=host,proto3" json:"host,omitempty"`
+	DestRealm        string `protobuf:"bytes,10,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	DestHost         string `protobuf:"bytes,11,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	DisableDestHost  bool   `protobuf:"varint,12,opt,name=disable_dest_host,json=disableDestHost,proto3" json:"disable_dest_host,omitempty"`
+	// Additional servers to fail over to or to load balance across
+	Peers                []*DiamPeerConfig `protobuf:"bytes,13,rep,name=peers,proto3" json:"peers,omitempty"`
+	LoadBalancing        DiamLoadBalancing `protobuf:"varint,14,opt,name=load_balancing,json=loadBalancing,proto3,enum=magma.mconfig.DiamLoadBalancing" json:"load_balancing,omitempty"`
+	Weight               uint32            `protobuf:"varint,15,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *DiamClientConfig) Reset()         { *m = DiamClientConfig{} }
@@ -179,6 +243,114 @@ func (m *DiamClientConfig) GetDisableDestHost() bool {
 	return false
 }
 
+func (m *DiamClientConfig) GetPeers() []*DiamPeerConfig {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *DiamClientConfig) GetLoadBalancing() DiamLoadBalancing {
+	if m != nil {
+		return m.LoadBalancing
+	}
+	return DiamLoadBalancing_FAILOVER
+}
+
+func (m *DiamClientConfig) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+type DiamPeerConfig struct {
+	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	LocalAddress         string   `protobuf:"bytes,3,opt,name=local_address,json=localAddress,proto3" json:"local_address,omitempty"`
+	DestRealm            string   `protobuf:"bytes,4,opt,name=dest_realm,json=destRealm,proto3" json:"dest_realm,omitempty"`
+	DestHost             string   `protobuf:"bytes,5,opt,name=dest_host,json=destHost,proto3" json:"dest_host,omitempty"`
+	DisableDestHost      bool     `protobuf:"varint,6,opt,name=disable_dest_host,json=disableDestHost,proto3" json:"disable_dest_host,omitempty"`
+	Weight               uint32   `protobuf:"varint,7,opt,name=weight,proto3" json:"weight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiamPeerConfig) Reset()         { *m = DiamPeerConfig{} }
+func (m *DiamPeerConfig) String() string { return proto.CompactTextString(m) }
+func (*DiamPeerConfig) ProtoMessage()    {}
+func (*DiamPeerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ac1e34e12c6f455d, []int{1}
+}
+
+func (m *DiamPeerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiamPeerConfig.Unmarshal(m, b)
+}
+func (m *DiamPeerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiamPeerConfig.Marshal(b, m, deterministic)
+}
+func (m *DiamPeerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiamPeerConfig.Merge(m, src)
+}
+func (m *DiamPeerConfig) XXX_Size() int {
+	return xxx_messageInfo_DiamPeerConfig.Size(m)
+}
+func (m *DiamPeerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiamPeerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiamPeerConfig proto.InternalMessageInfo
+
+func (m *DiamPeerConfig) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetLocalAddress() string {
+	if m != nil {
+		return m.LocalAddress
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetDestRealm() string {
+	if m != nil {
+		return m.DestRealm
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetDestHost() string {
+	if m != nil {
+		return m.DestHost
+	}
+	return ""
+}
+
+func (m *DiamPeerConfig) GetDisableDestHost() bool {
+	if m != nil {
+		return m.DisableDestHost
+	}
+	return false
+}
+
+func (m *DiamPeerConfig) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
 type DiamServerConfig struct {
 	Protocol             string   `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -194,7 +366,7 @@ func (m *DiamServerConfig) Reset()         { *m = DiamServerConfig{} }
 func (m *DiamServerConfig) String() string { return proto.CompactTextString(m) }
 func (*DiamServerConfig) ProtoMessage()    {}
 func (*DiamServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{1}
+	return fileDescriptor_ac1e34e12c6f455d, []int{2}
 }
 
 func (m *DiamServerConfig) XXX_Unmarshal(b []byte) error {
@@ -266,7 +438,7 @@ func (m *S6AConfig) Reset()         { *m = S6AConfig{} }
 func (m *S6AConfig) String() string { return proto.CompactTextString(m) }
 func (*S6AConfig) ProtoMessage()    {}
 func (*S6AConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{2}
+	return fileDescriptor_ac1e34e12c6f455d, []int{3}
 }
 
 func (m *S6AConfig) XXX_Unmarshal(b []byte) error {
@@ -326,7 +498,7 @@ func (m *GxConfig) Reset()         { *m = GxConfig{} }
 func (m *GxConfig) String() string { return proto.CompactTextString(m) }
 func (*GxConfig) ProtoMessage()    {}
 func (*GxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{3}
+	return fileDescriptor_ac1e34e12c6f455d, []int{4}
 }
 
 func (m *GxConfig) XXX_Unmarshal(b []byte) error {
@@ -366,7 +538,7 @@ func (m *GyConfig) Reset()         { *m = GyConfig{} }
 func (m *GyConfig) String() string { return proto.CompactTextString(m) }
 func (*GyConfig) ProtoMessage()    {}
 func (*GyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{4}
+	return fileDescriptor_ac1e34e12c6f455d, []int{5}
 }
 
 func (m *GyConfig) XXX_Unmarshal(b []byte) error {
@@ -418,7 +590,7 @@ func (m *SessionProxyConfig) Reset()         { *m = SessionProxyConfig{} }
 func (m *SessionProxyConfig) String() string { return proto.CompactTextString(m) }
 func (*SessionProxyConfig) ProtoMessage()    {}
 func (*SessionProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{5}
+	return fileDescriptor_ac1e34e12c6f455d, []int{6}
 }
 
 func (m *SessionProxyConfig) XXX_Unmarshal(b []byte) error {
@@ -499,7 +671,7 @@ func (m *SwxConfig) Reset()         { *m = SwxConfig{} }
 func (m *SwxConfig) String() string { return proto.CompactTextString(m) }
 func (*SwxConfig) ProtoMessage()    {}
 func (*SwxConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{6}
+	return fileDescriptor_ac1e34e12c6f455d, []int{7}
 }
 
 func (m *SwxConfig) XXX_Unmarshal(b []byte) error {
@@ -596,7 +768,7 @@ func (m *EapAkaConfig) Reset()         { *m = EapAkaConfig{} }
 func (m *EapAkaConfig) String() string { return proto.CompactTextString(m) }
 func (*EapAkaConfig) ProtoMessage()    {}
 func (*EapAkaConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{7}
+	return fileDescriptor_ac1e34e12c6f455d, []int{8}
 }
 
 func (m *EapAkaConfig) XXX_Unmarshal(b []byte) error {
@@ -652,7 +824,7 @@ func (m *EapAkaConfig_Timeouts) Reset()         { *m = EapAkaConfig_Timeouts{} }
 func (m *EapAkaConfig_Timeouts) String() string { return proto.CompactTextString(m) }
 func (*EapAkaConfig_Timeouts) ProtoMessage()    {}
 func (*EapAkaConfig_Timeouts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{7, 0}
+	return fileDescriptor_ac1e34e12c6f455d, []int{8, 0}
 }
 
 func (m *EapAkaConfig_Timeouts) XXX_Unmarshal(b []byte) error {
@@ -708,7 +880,11 @@ type AAAConfig struct {
 	// enable accounting & maintain long term user sessions
 	AccountingEnabled bool `protobuf:"varint,3,opt,name=AccountingEnabled,proto3" json:"AccountingEnabled,omitempty"`
 	// Postpone Auth success until successful accounting CreateSession completion
-	CreateSessionOnAuth  bool     `protobuf:"varint,4,opt,name=CreateSessionOnAuth,proto3" json:"CreateSessionOnAuth,omitempty"`
+	CreateSessionOnAuth bool `protobuf:"varint,4,opt,name=CreateSessionOnAuth,proto3" json:"CreateSessionOnAuth,omitempty"`
+	// Storage of the authenticated sessions
+	SessionStore AAAConfig_SessionStoreType `protobuf:"varint,5,opt,name=SessionStore,proto3,enum=magma.mconfig.AAAConfig_SessionStoreType" json:"SessionStore,omitempty"`
+	// Directory of the LOCAL session store
+	SessionStorePath     string   `protobuf:"bytes,6,opt,name=SessionStorePath,proto3" json:"SessionStorePath,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -718,7 +894,7 @@ func (m *AAAConfig) Reset()         { *m = AAAConfig{} }
 func (m *AAAConfig) String() string { return proto.CompactTextString(m) }
 func (*AAAConfig) ProtoMessage()    {}
 func (*AAAConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{8}
+	return fileDescriptor_ac1e34e12c6f455d, []int{9}
 }
 
 func (m *AAAConfig) XXX_Unmarshal(b []byte) error {
@@ -767,6 +943,20 @@ func (m *AAAConfig) GetCreateSessionOnAuth() bool {
 	return false
 }
 
+func (m *AAAConfig) GetSessionStore() AAAConfig_SessionStoreType {
+	if m != nil {
+		return m.SessionStore
+	}
+	return AAAConfig_MEMORY
+}
+
+func (m *AAAConfig) GetSessionStorePath() string {
+	if m != nil {
+		return m.SessionStorePath
+	}
+	return ""
+}
+
 type GatewayHealthConfig struct {
 	RequiredServices          []string `protobuf:"bytes,1,rep,name=required_services,json=requiredServices,proto3" json:"required_services,omitempty"`
 	UpdateIntervalSecs        uint32   `protobuf:"varint,2,opt,name=update_interval_secs,json=updateIntervalSecs,proto3" json:"update_interval_secs,omitempty"`
@@ -782,7 +972,7 @@ func (m *GatewayHealthConfig) Reset()         { *m = GatewayHealthConfig{} }
 func (m *GatewayHealthConfig) String() string { return proto.CompactTextString(m) }
 func (*GatewayHealthConfig) ProtoMessage()    {}
 func (*GatewayHealthConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{9}
+	return fileDescriptor_ac1e34e12c6f455d, []int{10}
 }
 
 func (m *GatewayHealthConfig) XXX_Unmarshal(b []byte) error {
@@ -859,7 +1049,7 @@ func (m *HSSConfig) Reset()         { *m = HSSConfig{} }
 func (m *HSSConfig) String() string { return proto.CompactTextString(m) }
 func (*HSSConfig) ProtoMessage()    {}
 func (*HSSConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{10}
+	return fileDescriptor_ac1e34e12c6f455d, []int{11}
 }
 
 func (m *HSSConfig) XXX_Unmarshal(b []byte) error {
@@ -936,7 +1126,7 @@ func (m *HSSConfig_SubscriptionProfile) Reset()         { *m = HSSConfig_Subscri
 func (m *HSSConfig_SubscriptionProfile) String() string { return proto.CompactTextString(m) }
 func (*HSSConfig_SubscriptionProfile) ProtoMessage()    {}
 func (*HSSConfig_SubscriptionProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{10, 0}
+	return fileDescriptor_ac1e34e12c6f455d, []int{11, 0}
 }
 
 func (m *HSSConfig_SubscriptionProfile) XXX_Unmarshal(b []byte) error {
@@ -989,7 +1179,7 @@ func (m *RadiusdConfig) Reset()         { *m = RadiusdConfig{} }
 func (m *RadiusdConfig) String() string { return proto.CompactTextString(m) }
 func (*RadiusdConfig) ProtoMessage()    {}
 func (*RadiusdConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ac1e34e12c6f455d, []int{11}
+	return fileDescriptor_ac1e34e12c6f455d, []int{12}
 }
 
 func (m *RadiusdConfig) XXX_Unmarshal(b []byte) error {
@@ -1040,7 +1230,10 @@ func (m *RadiusdConfig) GetRadiusMetricsHost() string {
 
 func init() {
 	proto.RegisterEnum("magma.mconfig.GyInitMethod", GyInitMethod_name, GyInitMethod_value)
+	proto.RegisterEnum("magma.mconfig.DiamLoadBalancing", DiamLoadBalancing_name, DiamLoadBalancing_value)
+	proto.RegisterEnum("magma.mconfig.AAAConfig_SessionStoreType", AAAConfig_SessionStoreType_name, AAAConfig_SessionStoreType_value)
 	proto.RegisterType((*DiamClientConfig)(nil), "magma.mconfig.DiamClientConfig")
+	proto.RegisterType((*DiamPeerConfig)(nil), "magma.mconfig.DiamPeerConfig")
 	proto.RegisterType((*DiamServerConfig)(nil), "magma.mconfig.DiamServerConfig")
 	proto.RegisterType((*S6AConfig)(nil), "magma.mconfig.S6aConfig")
 	proto.RegisterType((*GxConfig)(nil), "magma.mconfig.GxConfig")
@@ -1060,91 +1253,104 @@ func init() {
 func init() { proto.RegisterFile("feg/protos/mconfig/mconfigs.proto", fileDescriptor_ac1e34e12c6f455d) }
 
 var fileDescriptor_ac1e34e12c6f455d = []byte{
-	// 1365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x4e, 0x1b, 0xc7,
-	0x17, 0xff, 0xdb, 0xe6, 0xc3, 0x3e, 0x36, 0xc1, 0x0c, 0x24, 0x18, 0x92, 0x7f, 0x43, 0x9c, 0x56,
-	0xa5, 0x49, 0x6a, 0x52, 0x2a, 0xa5, 0x51, 0x54, 0x35, 0x72, 0xc0, 0x25, 0xa8, 0x90, 0xa0, 0x59,
-	0x52, 0xa9, 0x55, 0xa5, 0xd5, 0xb0, 0x3b, 0xb6, 0x47, 0x99, 0xdd, 0x71, 0x67, 0x67, 0x09, 0xee,
-	0x5d, 0x5f, 0x21, 0x6f, 0xd1, 0xbb, 0x5e, 0xe4, 0x45, 0xa2, 0xbc, 0x48, 0x1f, 0xa1, 0x9a, 0x8f,
-	0xb5, 0x8d, 0x31, 0x48, 0xc8, 0xbd, 0xf2, 0xce, 0xf9, 0xfd, 0xce, 0x99, 0xf3, 0x35, 0x33, 0xc7,
-	0x70, 0xaf, 0x4d, 0x3b, 0x5b, 0x3d, 0x29, 0x94, 0x48, 0xb6, 0xa2, 0x40, 0xc4, 0x6d, 0xd6, 0xc9,
-	0x7e, 0x93, 0x86, 0x91, 0xa3, 0x85, 0x88, 0x74, 0x22, 0xd2, 0x70, 0xd2, 0xf5, 0x35, 0x21, 0x83,
-	0xa7, 0x32, 0xd3, 0x09, 0x44, 0x14, 0x89, 0xd8, 0x32, 0xeb, 0xef, 0x0b, 0x50, 0xdd, 0x65, 0x24,
-	0xda, 0xe1, 0x8c, 0xc6, 0x6a, 0xc7, 0xf0, 0xd1, 0x3a, 0x14, 0x0d, 0x1a, 0x08, 0x5e, 0xcb, 0x6d,
-	0xe4, 0x36, 0x4b, 0x78, 0xb0, 0x46, 0x35, 0x98, 0x27, 0x61, 0x28, 0x69, 0x92, 0xd4, 0xf2, 0x06,
-	0xca, 0x96, 0x68, 0x03, 0xca, 0x92, 0x2a, 0x49, 0xe2, 0x24, 0x62, 0x2a, 0xa9, 0x15, 0x36, 0x72,
-	0x9b, 0x0b, 0x78, 0x54, 0x84, 0x1e, 0xc2, 0xd2, 0x3b, 0xa2, 0x82, 0x6e, 0x28, 0x3a, 0x3e, 0x8b,
-	0x15, 0x95, 0xa7, 0x84, 0xd7, 0x66, 0x0c, 0xaf, 0x9a, 0x01, 0xfb, 0x4e, 0x8e, 0xee, 0x5a, 0x73,
-	0x7d, 0x3f, 0x10, 0x69, 0xac, 0x6a, 0xb3, 0x86, 0x06, 0x46, 0xb4, 0xa3, 0x25, 0xe8, 0x3e, 0x2c,
-	0x70, 0x11, 0x10, 0xee, 0x67, 0xfe, 0xcc, 0x19, 0x7f, 0x2a, 0x46, 0xd8, 0x74, 0x4e, 0xdd, 0x83,
-	0x4a, 0x4f, 0x8a, 0x30, 0x0d, 0x94, 0x1f, 0x93, 0x88, 0xd6, 0xe6, 0x0d, 0xa7, 0xec, 0x64, 0xaf,
-	0x48, 0x44, 0xd1, 0x0a, 0xcc, 0x4a, 0x4a, 0x78, 0x54, 0x2b, 0x1a, 0xcc, 0x2e, 0x10, 0x82, 0x99,
-	0xae, 0x48, 0x54, 0xad, 0x64, 0x84, 0xe6, 0x1b, 0xfd, 0x1f, 0x20, 0xa4, 0x89, 0xf2, 0x2d, 0x1d,
-	0x0c, 0x52, 0xd2, 0x12, 0x6c, 0x54, 0x6e, 0x83, 0x59, 0xf8, 0x46, 0xaf, 0x6c, 0xf3, 0xa6, 0x05,
-	0x2f, 0xb5, 0xee, 0x03, 0x58, 0x0a, 0x59, 0x42, 0x4e, 0x38, 0xf5, 0x87, 0xa4, 0xca, 0x46, 0x6e,
-	0xb3, 0x88, 0x17, 0x1d, 0xb0, 0xeb, 0xb8, 0xf5, 0xbf, 0x72, 0xb6, 0x28, 0x1e, 0x95, 0xa7, 0x54,
-	0x4e, 0x55, 0x94, 0x0b, 0x49, 0x2a, 0x4c, 0x48, 0xd2, 0x39, 0xc7, 0x67, 0xc6, 0x1c, 0x3f, 0x1f,
-	0xf4, 0xec, 0x58, 0xd0, 0xf5, 0x7f, 0x72, 0x50, 0xf2, 0x9e, 0x10, 0xe7, 0xe4, 0x36, 0x94, 0xb8,
-	0xe8, 0xf8, 0x9c, 0x9e, 0x52, 0xeb, 0xe5, 0x8d, 0xed, 0x9b, 0x0d, 0xdb, 0x8c, 0xa6, 0x07, 0x1b,
-	0x07, 0xa2, 0x73, 0xa0, 0x41, 0x5c, 0xe4, 0xee, 0x0b, 0x7d, 0x07, 0x73, 0x89, 0x09, 0xd4, 0x18,
-	0x2f, 0x6f, 0xdf, 0x6d, 0x9c, 0xeb, 0xde, 0xc6, 0x78, 0x7b, 0x62, 0x47, 0x47, 0xcf, 0x60, 0x4d,
-	0xd2, 0xdf, 0x53, 0xed, 0x5c, 0x9b, 0x30, 0x9e, 0x4a, 0xea, 0xab, 0xae, 0xa4, 0x49, 0x57, 0xf0,
-	0xd0, 0x34, 0x43, 0x1e, 0xaf, 0x3a, 0xc2, 0x8f, 0x16, 0x3f, 0xce, 0x60, 0xad, 0x1b, 0xb1, 0x98,
-	0x45, 0x69, 0xe4, 0x67, 0x36, 0x86, 0xba, 0xf3, 0xa6, 0xd7, 0x56, 0x1d, 0x01, 0x5b, 0x7c, 0xa0,
-	0x5b, 0xdf, 0x81, 0xe2, 0xde, 0x99, 0x0b, 0x78, 0xe8, 0x7c, 0xee, 0x5a, 0xce, 0xd7, 0xff, 0xcc,
-	0x41, 0x71, 0xaf, 0x3f, 0xa5, 0x15, 0xf4, 0x3d, 0x94, 0x59, 0xcc, 0x94, 0x1f, 0x51, 0xd5, 0x15,
-	0xa1, 0x29, 0xfe, 0x8d, 0xed, 0xdb, 0x63, 0xda, 0x7b, 0xfd, 0xfd, 0x98, 0xa9, 0x43, 0x43, 0xc1,
-	0xc0, 0x06, 0xdf, 0xf5, 0xf7, 0x79, 0x40, 0x1e, 0x4d, 0x12, 0x26, 0xe2, 0x23, 0x29, 0xce, 0xfa,
-	0x53, 0x14, 0xf1, 0x4b, 0xc8, 0x77, 0xce, 0x5c, 0x01, 0x57, 0xc7, 0xf7, 0x77, 0xc9, 0xc2, 0xf9,
-	0xce, 0x99, 0x21, 0xf6, 0x4d, 0x75, 0x26, 0x10, 0xfb, 0x03, 0x62, 0xff, 0xea, 0xea, 0xce, 0x4f,
-	0x51, 0xdd, 0xe2, 0xd5, 0xd5, 0xfd, 0x58, 0x80, 0x92, 0xf7, 0xee, 0xec, 0x3f, 0x69, 0xe8, 0xfc,
-	0xf5, 0xaa, 0xf9, 0x0d, 0xac, 0x9c, 0x52, 0xc9, 0xda, 0x7d, 0x9f, 0xa4, 0xaa, 0x2b, 0x24, 0xfb,
-	0x83, 0x28, 0x26, 0x62, 0x73, 0x66, 0x8b, 0x78, 0xd9, 0x62, 0xcd, 0x51, 0x08, 0x6d, 0xc2, 0xe2,
-	0x0e, 0x09, 0xba, 0xf4, 0xf8, 0xf8, 0xc0, 0xa3, 0x81, 0x88, 0xc3, 0xc4, 0x5d, 0xa8, 0xe3, 0xe2,
-	0xab, 0xf3, 0x39, 0x3b, 0x45, 0x3e, 0xe7, 0xae, 0xcc, 0x27, 0xda, 0x84, 0xaa, 0xa4, 0x1d, 0x96,
-	0x28, 0x2a, 0x7d, 0x11, 0x9b, 0xc8, 0x4c, 0xf9, 0x8a, 0xf8, 0x46, 0x26, 0x7f, 0x1d, 0xeb, 0xa0,
-	0xd0, 0x13, 0x58, 0x0d, 0xa9, 0x64, 0xa7, 0xd4, 0x4f, 0xe3, 0x81, 0xca, 0xf0, 0x6a, 0x2e, 0xe2,
-	0x9b, 0x16, 0x7e, 0x33, 0x40, 0xed, 0xbd, 0xbb, 0x01, 0x95, 0x2e, 0x97, 0x7e, 0x8f, 0x47, 0xb1,
-	0xcf, 0xc2, 0xa4, 0x56, 0xda, 0x28, 0x6c, 0x96, 0x30, 0x74, 0xb9, 0x3c, 0xe2, 0x51, 0xbc, 0x1f,
-	0x26, 0xf5, 0x4f, 0x79, 0xa8, 0xb4, 0x48, 0xaf, 0xf9, 0x76, 0x9a, 0x7b, 0xea, 0x07, 0x98, 0x57,
-	0x2c, 0xa2, 0x22, 0x55, 0xae, 0xae, 0x9f, 0x8f, 0xd5, 0x75, 0x74, 0x87, 0xc6, 0xb1, 0xa5, 0x26,
-	0x38, 0x53, 0xd2, 0x97, 0xb4, 0xf3, 0xa7, 0x56, 0x30, 0x1e, 0x66, 0xcb, 0xf5, 0x0f, 0x39, 0x28,
-	0x66, 0x7c, 0xfd, 0x8c, 0xee, 0x74, 0x09, 0xe7, 0x34, 0xee, 0xd0, 0xc3, 0xc4, 0x38, 0xb7, 0x80,
-	0x47, 0x45, 0xe8, 0x31, 0x2c, 0xb7, 0xa4, 0x14, 0xf2, 0x95, 0x50, 0xac, 0xcd, 0x02, 0xd3, 0x08,
-	0x87, 0xf6, 0xe6, 0x5f, 0xc0, 0x93, 0x20, 0x74, 0x07, 0x4a, 0xee, 0x9c, 0x1f, 0x66, 0x0f, 0xf3,
-	0x50, 0x80, 0x9e, 0xc0, 0x2d, 0xb7, 0xd0, 0x65, 0xa0, 0xb1, 0xd2, 0x8a, 0x34, 0x3c, 0xcc, 0x5a,
-	0xe9, 0x12, 0xb4, 0xfe, 0x29, 0x07, 0xa5, 0x66, 0xb3, 0x39, 0x45, 0x4a, 0xb7, 0x61, 0x65, 0x3f,
-	0xe4, 0xd4, 0xd9, 0x77, 0x29, 0x18, 0x84, 0x32, 0x11, 0x43, 0x8f, 0x60, 0xa9, 0x19, 0x98, 0x99,
-	0x80, 0xc5, 0x9d, 0x56, 0xac, 0x1f, 0xce, 0xd0, 0x9d, 0x90, 0x8b, 0x80, 0xce, 0xd5, 0x8e, 0xa4,
-	0x44, 0x65, 0x76, 0x6c, 0xab, 0x99, 0xc0, 0x8a, 0x78, 0x12, 0x54, 0xff, 0x3b, 0x0f, 0xcb, 0x7b,
-	0x44, 0xd1, 0x77, 0xa4, 0xff, 0x92, 0x12, 0xae, 0xba, 0x2e, 0xbe, 0x87, 0xb0, 0xa4, 0x7b, 0x9f,
-	0x49, 0x1a, 0xfa, 0xfa, 0xbc, 0xb2, 0x80, 0xea, 0xea, 0xe8, 0x42, 0x56, 0x33, 0xc0, 0x73, 0x72,
-	0xf4, 0x18, 0x56, 0xd2, 0x5e, 0x48, 0x14, 0x1d, 0xcc, 0x39, 0x7e, 0x42, 0x83, 0x2c, 0x30, 0x64,
-	0xb1, 0x6c, 0xd4, 0xf1, 0x68, 0x90, 0xa0, 0xa7, 0x50, 0x73, 0x1a, 0x17, 0x4f, 0xa7, 0xad, 0xd8,
-	0x2d, 0x8b, 0x5f, 0x38, 0x9c, 0xcf, 0xe1, 0x4e, 0xc0, 0x45, 0x1a, 0xfa, 0x21, 0x4b, 0x02, 0x11,
-	0xc7, 0x34, 0x50, 0x7e, 0x8f, 0x4a, 0x26, 0x42, 0xbb, 0xa7, 0x2d, 0xe2, 0x9a, 0xe1, 0xec, 0x0e,
-	0x28, 0x47, 0x86, 0x61, 0xb6, 0x7e, 0x0e, 0x77, 0xec, 0x8c, 0x70, 0x89, 0x01, 0x3b, 0x7a, 0xad,
-	0x19, 0xce, 0x24, 0x03, 0xf5, 0x0f, 0x33, 0x50, 0x7a, 0xe9, 0x79, 0xd7, 0x78, 0xcc, 0x46, 0x27,
-	0x9b, 0xc1, 0xf5, 0xf7, 0x19, 0x94, 0xb9, 0xa2, 0xe6, 0x86, 0xf0, 0x45, 0xcf, 0xe4, 0xaa, 0x82,
-	0x4b, 0x5c, 0x51, 0x5d, 0x97, 0xd7, 0x3d, 0x7d, 0xce, 0x07, 0x38, 0x89, 0xda, 0x26, 0x2d, 0x15,
-	0x0c, 0x8e, 0xd0, 0x8c, 0xda, 0xe8, 0x00, 0x2a, 0x49, 0x7a, 0xe2, 0xf7, 0xa4, 0x68, 0x33, 0x4e,
-	0x75, 0xe8, 0x85, 0xcd, 0xf2, 0xf6, 0x57, 0x63, 0x0e, 0x0c, 0x5c, 0x6d, 0x78, 0xe9, 0xc9, 0x91,
-	0xe3, 0xb6, 0x62, 0x25, 0xfb, 0xb8, 0x9c, 0x0c, 0x25, 0xe8, 0x37, 0x58, 0x0e, 0x69, 0x9b, 0xa4,
-	0x5c, 0xf9, 0x23, 0x56, 0xdd, 0x23, 0xf7, 0xe8, 0x2a, 0xa3, 0x49, 0x20, 0x59, 0x4f, 0xd9, 0x67,
-	0x55, 0xeb, 0xe0, 0x25, 0x67, 0x68, 0xb8, 0x21, 0xfa, 0x1a, 0x50, 0xa2, 0x24, 0x25, 0x91, 0x36,
-	0xae, 0x15, 0x4e, 0xa8, 0xb4, 0x33, 0x6c, 0x11, 0x2f, 0x59, 0xc4, 0x1b, 0x02, 0xeb, 0x01, 0x2c,
-	0x4f, 0x30, 0x8c, 0xbe, 0x80, 0xc5, 0x88, 0x9c, 0xf9, 0x29, 0xf7, 0x4f, 0x98, 0xf2, 0x25, 0x51,
-	0xd4, 0x64, 0x7d, 0x06, 0x57, 0x22, 0x72, 0xf6, 0x86, 0xbf, 0x60, 0x0a, 0x13, 0x35, 0xa0, 0x85,
-	0x23, 0xb4, 0xfc, 0x80, 0xb6, 0x9b, 0xd1, 0xd6, 0x39, 0x54, 0xc7, 0x53, 0x82, 0xaa, 0x50, 0x78,
-	0x4b, 0xfb, 0x6e, 0xe4, 0xd4, 0x9f, 0xe8, 0x05, 0xcc, 0x9e, 0x12, 0x9e, 0x52, 0x77, 0x0d, 0x5e,
-	0x2f, 0x13, 0x56, 0xf5, 0x59, 0xfe, 0x69, 0xae, 0xfe, 0x31, 0x07, 0x0b, 0x98, 0x84, 0x2c, 0x4d,
-	0x42, 0xd7, 0x3a, 0x0d, 0x58, 0x96, 0x46, 0xa0, 0x07, 0x1a, 0xc9, 0x82, 0xc4, 0xef, 0x09, 0xa9,
-	0xdc, 0x1d, 0xb8, 0x64, 0xa1, 0x43, 0x8b, 0x1c, 0x09, 0xa9, 0x26, 0xf1, 0x89, 0xea, 0xba, 0x19,
-	0x78, 0x8c, 0x4f, 0x54, 0xf7, 0xd2, 0x63, 0x59, 0xb8, 0xf4, 0x58, 0x5e, 0xdc, 0x61, 0x64, 0x48,
-	0x3e, 0xbf, 0x83, 0x9e, 0x96, 0x1f, 0x3c, 0x83, 0xca, 0xe8, 0xb8, 0x85, 0x2a, 0x50, 0xc4, 0x2d,
-	0xaf, 0x85, 0x7f, 0x6e, 0xed, 0x56, 0xff, 0x87, 0x16, 0xa1, 0x7c, 0xd4, 0xc2, 0xbe, 0xd7, 0xf2,
-	0xbc, 0xfd, 0xd7, 0xaf, 0xaa, 0x39, 0x54, 0x86, 0x79, 0x2d, 0xf8, 0xa9, 0xf5, 0x4b, 0x35, 0xff,
-	0xe2, 0xfe, 0xaf, 0xf7, 0x4c, 0x26, 0xb7, 0xf4, 0x1f, 0x3c, 0x73, 0x5c, 0xb7, 0x3a, 0x62, 0xec,
-	0x9f, 0xde, 0xc9, 0x9c, 0x59, 0x7f, 0xfb, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x91, 0x5d, 0xfa,
-	0x52, 0x06, 0x0e, 0x00, 0x00,
+	// 1571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x6e, 0x1b, 0xc7,
+	0x15, 0x36, 0x49, 0xfd, 0x90, 0x87, 0x14, 0x45, 0x8d, 0x1c, 0x7b, 0xad, 0x38, 0x0d, 0xcd, 0xb4,
+	0xa8, 0xea, 0xa4, 0x74, 0xaa, 0xb4, 0xae, 0x61, 0x14, 0x0d, 0x68, 0x8a, 0x91, 0x85, 0x88, 0x96,
+	0x30, 0xab, 0x04, 0x70, 0x51, 0x60, 0x31, 0xda, 0x1d, 0x92, 0x83, 0xcc, 0xee, 0xb0, 0xb3, 0xb3,
+	0xb2, 0xd8, 0xbb, 0x02, 0x7d, 0x82, 0x3e, 0x45, 0x7b, 0xd7, 0x8b, 0xbc, 0x48, 0xd0, 0xc7, 0xe8,
+	0x4d, 0x1f, 0xa1, 0x98, 0x9f, 0xe5, 0x9f, 0x68, 0xa1, 0xb6, 0x8a, 0x5c, 0x69, 0xe7, 0x7c, 0xdf,
+	0x39, 0x33, 0xe7, 0x67, 0xce, 0x1c, 0x11, 0x1e, 0x0d, 0xe8, 0xf0, 0xc9, 0x58, 0x0a, 0x25, 0xd2,
+	0x27, 0x71, 0x28, 0x92, 0x01, 0x1b, 0xe6, 0x7f, 0xd3, 0xb6, 0x91, 0xa3, 0xad, 0x98, 0x0c, 0x63,
+	0xd2, 0x76, 0xd2, 0xbd, 0x07, 0x42, 0x86, 0xcf, 0x64, 0xae, 0x13, 0x8a, 0x38, 0x16, 0x89, 0x65,
+	0xb6, 0xfe, 0xbe, 0x06, 0x8d, 0x43, 0x46, 0xe2, 0x2e, 0x67, 0x34, 0x51, 0x5d, 0xc3, 0x47, 0x7b,
+	0x50, 0x36, 0x68, 0x28, 0xb8, 0x57, 0x68, 0x16, 0xf6, 0x2b, 0x78, 0xba, 0x46, 0x1e, 0x6c, 0x92,
+	0x28, 0x92, 0x34, 0x4d, 0xbd, 0xa2, 0x81, 0xf2, 0x25, 0x6a, 0x42, 0x55, 0x52, 0x25, 0x49, 0x92,
+	0xc6, 0x4c, 0xa5, 0x5e, 0xa9, 0x59, 0xd8, 0xdf, 0xc2, 0xf3, 0x22, 0xf4, 0x29, 0xec, 0xbc, 0x21,
+	0x2a, 0x1c, 0x45, 0x62, 0x18, 0xb0, 0x44, 0x51, 0x79, 0x49, 0xb8, 0xb7, 0x66, 0x78, 0x8d, 0x1c,
+	0x38, 0x76, 0x72, 0xf4, 0xb1, 0x35, 0x37, 0x09, 0x42, 0x91, 0x25, 0xca, 0x5b, 0x37, 0x34, 0x30,
+	0xa2, 0xae, 0x96, 0xa0, 0x4f, 0x60, 0x8b, 0x8b, 0x90, 0xf0, 0x20, 0x3f, 0xcf, 0x86, 0x39, 0x4f,
+	0xcd, 0x08, 0x3b, 0xee, 0x50, 0x8f, 0xa0, 0x36, 0x96, 0x22, 0xca, 0x42, 0x15, 0x24, 0x24, 0xa6,
+	0xde, 0xa6, 0xe1, 0x54, 0x9d, 0xec, 0x15, 0x89, 0x29, 0xba, 0x0b, 0xeb, 0x92, 0x12, 0x1e, 0x7b,
+	0x65, 0x83, 0xd9, 0x05, 0x42, 0xb0, 0x36, 0x12, 0xa9, 0xf2, 0x2a, 0x46, 0x68, 0xbe, 0xd1, 0x47,
+	0x00, 0x11, 0x4d, 0x55, 0x60, 0xe9, 0x60, 0x90, 0x8a, 0x96, 0x60, 0xa3, 0xf2, 0x21, 0x98, 0x45,
+	0x60, 0xf4, 0xaa, 0x36, 0x6e, 0x5a, 0xf0, 0x52, 0xeb, 0x3e, 0x86, 0x9d, 0x88, 0xa5, 0xe4, 0x82,
+	0xd3, 0x60, 0x46, 0xaa, 0x35, 0x0b, 0xfb, 0x65, 0xbc, 0xed, 0x80, 0xc3, 0x9c, 0xfb, 0x05, 0xac,
+	0x8f, 0x29, 0x95, 0xa9, 0xb7, 0xd5, 0x2c, 0xed, 0x57, 0x0f, 0x3e, 0x6a, 0x2f, 0xa4, 0xb3, 0xad,
+	0xf3, 0x75, 0x46, 0xa9, 0xb4, 0xd9, 0xc2, 0x96, 0x8b, 0x8e, 0xa0, 0xce, 0x05, 0x89, 0x82, 0x0b,
+	0xc2, 0x49, 0x12, 0xb2, 0x64, 0xe8, 0xd5, 0x9b, 0x85, 0xfd, 0xfa, 0x41, 0x73, 0x85, 0xf6, 0x89,
+	0x20, 0xd1, 0x8b, 0x9c, 0x87, 0xb7, 0xf8, 0xfc, 0x12, 0xdd, 0x83, 0x8d, 0x37, 0x94, 0x0d, 0x47,
+	0xca, 0xdb, 0x36, 0x31, 0x77, 0xab, 0xd6, 0xbf, 0x0b, 0x50, 0x5f, 0xdc, 0xfa, 0x3d, 0x0b, 0xe5,
+	0x5a, 0xe2, 0x4a, 0x2b, 0x12, 0xb7, 0x18, 0xeb, 0xb5, 0x1b, 0x63, 0xbd, 0xfe, 0xbf, 0xc4, 0x7a,
+	0x63, 0x75, 0xac, 0x67, 0xde, 0x6e, 0x2e, 0x78, 0xfb, 0x8f, 0x82, 0xbd, 0x18, 0x3e, 0x95, 0x97,
+	0x3f, 0x86, 0xbf, 0x0b, 0x0e, 0xad, 0x2d, 0x39, 0xb4, 0x18, 0x8c, 0xf5, 0xa5, 0x60, 0xb4, 0xfe,
+	0x53, 0x80, 0x8a, 0xff, 0x94, 0xb8, 0x43, 0x1e, 0x40, 0x85, 0x8b, 0x61, 0xc0, 0xe9, 0x25, 0xb5,
+	0xa7, 0xac, 0x1f, 0x7c, 0xe0, 0x6a, 0xc0, 0xf4, 0x81, 0xf6, 0x89, 0x18, 0x9e, 0x68, 0x10, 0x97,
+	0xb9, 0xfb, 0x42, 0xbf, 0x85, 0x8d, 0xd4, 0x38, 0x6a, 0x8c, 0x57, 0x0f, 0x3e, 0x5e, 0x51, 0x34,
+	0xf3, 0x2d, 0x02, 0x3b, 0x3a, 0x7a, 0x0e, 0x0f, 0x24, 0xfd, 0x53, 0xa6, 0x0f, 0x37, 0x20, 0x8c,
+	0x67, 0x92, 0x06, 0x6a, 0x24, 0x69, 0x3a, 0x12, 0x3c, 0x32, 0x21, 0x2f, 0xe2, 0xfb, 0x8e, 0xf0,
+	0x95, 0xc5, 0xcf, 0x73, 0x58, 0xeb, 0xc6, 0x2c, 0x61, 0x71, 0x16, 0x07, 0xb9, 0x8d, 0x99, 0xae,
+	0xcd, 0xc6, 0x7d, 0x47, 0xc0, 0x16, 0x9f, 0xea, 0xb6, 0xba, 0x50, 0x3e, 0xba, 0x72, 0x0e, 0xcf,
+	0x0e, 0x5f, 0x78, 0xa7, 0xc3, 0xb7, 0xfe, 0x52, 0x80, 0xf2, 0xd1, 0xe4, 0x96, 0x56, 0xd0, 0xef,
+	0xa0, 0xca, 0x12, 0xa6, 0x82, 0x98, 0xaa, 0x91, 0x88, 0x4c, 0xf2, 0xeb, 0x07, 0x1f, 0x2e, 0x69,
+	0x1f, 0x4d, 0x8e, 0x13, 0xa6, 0xfa, 0x86, 0x82, 0x81, 0x4d, 0xbf, 0x5b, 0x7f, 0x2b, 0x02, 0xf2,
+	0x69, 0x9a, 0x32, 0x91, 0x9c, 0x49, 0x71, 0x35, 0xb9, 0x45, 0x12, 0x7f, 0x0e, 0xc5, 0xe1, 0x95,
+	0x4b, 0xe0, 0xfd, 0xe5, 0xfd, 0x5d, 0xb0, 0x70, 0x71, 0x78, 0x65, 0x88, 0x13, 0x6f, 0x63, 0x35,
+	0x71, 0x32, 0x25, 0x4e, 0x6e, 0xce, 0xee, 0xe6, 0x2d, 0xb2, 0x5b, 0xbe, 0x39, 0xbb, 0x3f, 0x94,
+	0xa0, 0xe2, 0xbf, 0xb9, 0xfa, 0xbf, 0x14, 0x74, 0xf1, 0xdd, 0xb2, 0xf9, 0x2b, 0xb8, 0x7b, 0x49,
+	0x25, 0x1b, 0x4c, 0x02, 0x92, 0xa9, 0x91, 0x90, 0xec, 0xcf, 0x44, 0x31, 0x91, 0x98, 0x3b, 0x5b,
+	0xc6, 0xbb, 0x16, 0xeb, 0xcc, 0x43, 0x68, 0x1f, 0xb6, 0xbb, 0x24, 0x1c, 0xd1, 0xf3, 0xf3, 0x13,
+	0x9f, 0x86, 0x22, 0x89, 0x52, 0xf7, 0xa8, 0x2d, 0x8b, 0x6f, 0x8e, 0xe7, 0xfa, 0x2d, 0xe2, 0xb9,
+	0x71, 0x63, 0x3c, 0xd1, 0x3e, 0x34, 0x24, 0x1d, 0xb2, 0x54, 0x51, 0x19, 0x88, 0xc4, 0x78, 0x66,
+	0xd2, 0x57, 0xc6, 0xf5, 0x5c, 0x7e, 0x9a, 0x68, 0xa7, 0xd0, 0x53, 0xb8, 0x1f, 0x51, 0xc9, 0x2e,
+	0x69, 0x90, 0x25, 0x53, 0x95, 0xd9, 0xf3, 0x58, 0xc6, 0x1f, 0x58, 0xf8, 0x9b, 0x29, 0x6a, 0xfb,
+	0x71, 0x13, 0x6a, 0x23, 0x2e, 0x83, 0x31, 0x8f, 0x93, 0x80, 0x45, 0xa9, 0x57, 0x69, 0x96, 0xf6,
+	0x2b, 0x18, 0x46, 0x5c, 0x9e, 0xf1, 0x38, 0x39, 0x8e, 0xd2, 0xd6, 0xbf, 0x8a, 0x50, 0xeb, 0x91,
+	0x71, 0xe7, 0xbb, 0xdb, 0xf4, 0xa9, 0xdf, 0xc3, 0xa6, 0x62, 0x31, 0x15, 0x99, 0x72, 0x79, 0xfd,
+	0xe9, 0x52, 0x5e, 0xe7, 0x77, 0x68, 0x9f, 0x5b, 0x6a, 0x8a, 0x73, 0x25, 0xdd, 0xa4, 0xdd, 0x79,
+	0xbc, 0x92, 0x39, 0x61, 0xbe, 0xdc, 0xfb, 0xbe, 0x00, 0xe5, 0x9c, 0xaf, 0x47, 0x99, 0xee, 0x88,
+	0x70, 0x4e, 0x93, 0x21, 0xed, 0xa7, 0xe6, 0x70, 0x5b, 0x78, 0x5e, 0x84, 0x3e, 0x87, 0xdd, 0x9e,
+	0x94, 0x42, 0xbe, 0x12, 0x8a, 0x0d, 0x58, 0x68, 0x0a, 0xa1, 0x6f, 0x3b, 0xff, 0x16, 0x5e, 0x05,
+	0xa1, 0x87, 0x50, 0x71, 0xf7, 0xbc, 0x9f, 0x0f, 0x47, 0x33, 0x01, 0x7a, 0x0a, 0xf7, 0xdc, 0x42,
+	0xa7, 0x81, 0x26, 0x4a, 0x2b, 0xd2, 0xa8, 0x9f, 0x97, 0xd2, 0x5b, 0xd0, 0xd6, 0x5f, 0x4b, 0x50,
+	0xe9, 0x74, 0x3a, 0xb7, 0x08, 0xe9, 0x01, 0xdc, 0x3d, 0x8e, 0x38, 0x75, 0xf6, 0x5d, 0x08, 0xa6,
+	0xae, 0xac, 0xc4, 0xd0, 0x67, 0xb0, 0xd3, 0x09, 0xcd, 0x5c, 0xc6, 0x92, 0x61, 0x2f, 0xd1, 0x0f,
+	0x6a, 0xe4, 0x6e, 0xc8, 0x75, 0x40, 0xc7, 0xaa, 0x2b, 0x29, 0x51, 0xb9, 0x1d, 0x5b, 0x6a, 0xc6,
+	0xb1, 0x32, 0x5e, 0x05, 0xa1, 0x3e, 0xd4, 0x9c, 0xc0, 0x57, 0x42, 0x52, 0x73, 0x35, 0xea, 0x07,
+	0xbf, 0x58, 0xca, 0xf5, 0xd4, 0xef, 0xf6, 0x3c, 0xf9, 0x7c, 0x32, 0xa6, 0x78, 0x41, 0x1d, 0x3d,
+	0x86, 0xc6, 0xfc, 0xfa, 0x8c, 0xa8, 0x91, 0x1b, 0x16, 0xaf, 0xc9, 0x5b, 0xbf, 0x86, 0xc6, 0xb2,
+	0x35, 0x04, 0xb0, 0xd1, 0xef, 0xf5, 0x4f, 0xf1, 0xeb, 0xc6, 0x1d, 0x54, 0x81, 0x75, 0xdc, 0x3b,
+	0x3c, 0xf6, 0x1b, 0x05, 0xfd, 0x79, 0x72, 0xda, 0xed, 0x9c, 0x34, 0x8a, 0xad, 0x7f, 0x16, 0x61,
+	0xf7, 0x88, 0x28, 0xfa, 0x86, 0x4c, 0x5e, 0x52, 0xc2, 0xd5, 0xc8, 0x25, 0xe4, 0x53, 0xd8, 0xd1,
+	0x97, 0x95, 0x49, 0x1a, 0x05, 0xba, 0xc1, 0xb0, 0x90, 0xea, 0x72, 0xd2, 0x95, 0xd7, 0xc8, 0x01,
+	0xdf, 0xc9, 0xd1, 0xe7, 0x70, 0x37, 0x1b, 0x47, 0x44, 0xd1, 0xe9, 0x70, 0x1c, 0xa4, 0x34, 0xcc,
+	0x33, 0x81, 0x2c, 0x96, 0xcf, 0xc7, 0x3e, 0x0d, 0x53, 0xf4, 0x0c, 0x3c, 0xa7, 0x71, 0xbd, 0x9d,
+	0xd8, 0x12, 0xbb, 0x67, 0xf1, 0x6b, 0xdd, 0xe4, 0x4b, 0x78, 0x18, 0x72, 0x91, 0x45, 0x41, 0xc4,
+	0xd2, 0x50, 0x24, 0x09, 0x0d, 0x55, 0x30, 0xa6, 0x92, 0x89, 0xc8, 0xee, 0x69, 0xab, 0xee, 0x81,
+	0xe1, 0x1c, 0x4e, 0x29, 0x67, 0x86, 0x61, 0xb6, 0xfe, 0x12, 0x1e, 0xda, 0xa1, 0xe6, 0x2d, 0x06,
+	0xec, 0xbc, 0xfe, 0xc0, 0x70, 0x56, 0x19, 0x68, 0x7d, 0xbf, 0x06, 0x95, 0x97, 0xbe, 0xff, 0x0e,
+	0xaf, 0xef, 0xfc, 0x28, 0x36, 0xed, 0xd7, 0x3f, 0x81, 0x2a, 0x57, 0xd4, 0xb4, 0xb4, 0x40, 0x8c,
+	0x4d, 0xac, 0x6a, 0xb8, 0xc2, 0x15, 0xd5, 0x85, 0x74, 0x3a, 0xd6, 0x8d, 0x69, 0x8a, 0x93, 0x78,
+	0x60, 0xc2, 0x52, 0xc3, 0xe0, 0x08, 0x9d, 0x78, 0x80, 0x4e, 0xa0, 0x96, 0x66, 0x17, 0xc1, 0x58,
+	0x8a, 0x01, 0xe3, 0x54, 0xbb, 0xae, 0x87, 0xee, 0xe5, 0x62, 0x9b, 0x1e, 0xb5, 0xed, 0x67, 0x17,
+	0x67, 0x8e, 0xdb, 0x4b, 0x94, 0x9c, 0xe0, 0x6a, 0x3a, 0x93, 0xa0, 0x3f, 0xc2, 0x6e, 0x44, 0x07,
+	0x24, 0xe3, 0x2a, 0x98, 0xb3, 0xea, 0x5e, 0xe5, 0xcf, 0x6e, 0x32, 0x9a, 0x86, 0x92, 0x8d, 0x95,
+	0x9d, 0x03, 0xb4, 0x0e, 0xde, 0x71, 0x86, 0x66, 0x1b, 0xa2, 0x5f, 0x02, 0x4a, 0x95, 0xa4, 0x24,
+	0x0e, 0x52, 0xab, 0x70, 0xa1, 0xff, 0x4d, 0xb0, 0xa3, 0xed, 0x8e, 0x45, 0xfc, 0x19, 0xb0, 0x17,
+	0xc2, 0xee, 0x0a, 0xc3, 0xe8, 0x67, 0xb0, 0x1d, 0x93, 0xab, 0x20, 0xe3, 0xc1, 0x05, 0x53, 0x81,
+	0x24, 0x8a, 0x9a, 0xa8, 0xaf, 0xe1, 0x5a, 0x4c, 0xae, 0xbe, 0xe1, 0x2f, 0x98, 0xc2, 0x44, 0x4d,
+	0x69, 0xd1, 0x1c, 0xad, 0x38, 0xa5, 0x1d, 0xe6, 0xb4, 0x3d, 0x0e, 0x8d, 0xe5, 0x90, 0xa0, 0x06,
+	0x94, 0xbe, 0xa3, 0x13, 0x37, 0x23, 0xeb, 0x4f, 0xf4, 0x02, 0xd6, 0x2f, 0x09, 0xcf, 0xa8, 0x57,
+	0x7c, 0x8f, 0x48, 0x58, 0xd5, 0xe7, 0xc5, 0x67, 0x85, 0xd6, 0x0f, 0x05, 0xd8, 0xc2, 0x24, 0x62,
+	0x59, 0x1a, 0xb9, 0xd2, 0x69, 0xc3, 0xae, 0x34, 0x02, 0x3d, 0x81, 0x49, 0x16, 0xa6, 0xc1, 0x58,
+	0x48, 0xe5, 0x9a, 0xf6, 0x8e, 0x85, 0xfa, 0x16, 0x39, 0x13, 0x52, 0xad, 0xe2, 0xeb, 0x86, 0x60,
+	0x87, 0xf6, 0x25, 0x3e, 0x51, 0xa3, 0xb7, 0x5e, 0xcb, 0xd2, 0x5b, 0xaf, 0xe5, 0xf5, 0x1d, 0xe6,
+	0xa6, 0xfa, 0xc5, 0x1d, 0xf4, 0x78, 0xff, 0xf8, 0x39, 0xd4, 0xe6, 0xe7, 0x43, 0x54, 0x83, 0x32,
+	0xee, 0xf9, 0x3d, 0xfc, 0x6d, 0xef, 0xb0, 0x71, 0x07, 0x6d, 0x43, 0xf5, 0xac, 0x87, 0x03, 0xbf,
+	0xe7, 0xfb, 0xc7, 0xa7, 0xaf, 0x1a, 0x05, 0x54, 0x85, 0x4d, 0x2d, 0xf8, 0xba, 0xf7, 0xba, 0x51,
+	0x7c, 0xfc, 0x1b, 0xd8, 0xb9, 0xf6, 0x1f, 0x9d, 0x36, 0xf0, 0x55, 0xe7, 0xf8, 0xe4, 0xf4, 0xdb,
+	0x1e, 0x6e, 0xdc, 0x41, 0x08, 0xea, 0x4e, 0x39, 0xf0, 0xcf, 0x8f, 0xbb, 0x5f, 0xbf, 0x6e, 0x14,
+	0x5e, 0x7c, 0xf2, 0x87, 0x47, 0x26, 0x01, 0x4f, 0xf4, 0x8f, 0x09, 0xe6, 0x96, 0x3f, 0x19, 0x8a,
+	0xa5, 0x5f, 0x15, 0x2e, 0x36, 0xcc, 0xfa, 0x8b, 0xff, 0x0e, 0x00, 0x8c, 0x50, 0x09, 0x23, 0x72,
+	0x10, 0x00, 0x00,
 }
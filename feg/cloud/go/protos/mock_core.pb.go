@@ -1308,6 +1308,555 @@ func (m *UsageMonitorCredit) GetVolume() uint64 {
 	return 0
 }
 
+type EventTriggerInfo struct {
+	Imsi          string   `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	EventTriggers []uint32 `protobuf:"varint,2,rep,packed,name=event_triggers,json=eventTriggers,proto3" json:"event_triggers,omitempty"`
+	// seconds since epoch, 0 for none
+	RevalidationTime     uint64   `protobuf:"varint,3,opt,name=revalidation_time,json=revalidationTime,proto3" json:"revalidation_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventTriggerInfo) Reset()         { *m = EventTriggerInfo{} }
+func (m *EventTriggerInfo) String() string { return proto.CompactTextString(m) }
+func (*EventTriggerInfo) ProtoMessage()    {}
+func (*EventTriggerInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{12}
+}
+
+func (m *EventTriggerInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventTriggerInfo.Unmarshal(m, b)
+}
+func (m *EventTriggerInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventTriggerInfo.Marshal(b, m, deterministic)
+}
+func (m *EventTriggerInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTriggerInfo.Merge(m, src)
+}
+func (m *EventTriggerInfo) XXX_Size() int {
+	return xxx_messageInfo_EventTriggerInfo.Size(m)
+}
+func (m *EventTriggerInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTriggerInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTriggerInfo proto.InternalMessageInfo
+
+func (m *EventTriggerInfo) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *EventTriggerInfo) GetEventTriggers() []uint32 {
+	if m != nil {
+		return m.EventTriggers
+	}
+	return nil
+}
+
+func (m *EventTriggerInfo) GetRevalidationTime() uint64 {
+	if m != nil {
+		return m.RevalidationTime
+	}
+	return 0
+}
+
+type AnswerOverride struct {
+	Imsi string `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	// CC-Request-Type of the CCRs to override, 0 for all of them
+	RequestType uint32 `protobuf:"varint,2,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	ResultCode  uint32 `protobuf:"varint,3,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	// send result_code as Experimental-Result-Code
+	Experimental bool `protobuf:"varint,4,opt,name=experimental,proto3" json:"experimental,omitempty"`
+	// don't answer the CCRs at all
+	Drop                 bool     `protobuf:"varint,5,opt,name=drop,proto3" json:"drop,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnswerOverride) Reset()         { *m = AnswerOverride{} }
+func (m *AnswerOverride) String() string { return proto.CompactTextString(m) }
+func (*AnswerOverride) ProtoMessage()    {}
+func (*AnswerOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{13}
+}
+
+func (m *AnswerOverride) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnswerOverride.Unmarshal(m, b)
+}
+func (m *AnswerOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnswerOverride.Marshal(b, m, deterministic)
+}
+func (m *AnswerOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnswerOverride.Merge(m, src)
+}
+func (m *AnswerOverride) XXX_Size() int {
+	return xxx_messageInfo_AnswerOverride.Size(m)
+}
+func (m *AnswerOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnswerOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnswerOverride proto.InternalMessageInfo
+
+func (m *AnswerOverride) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *AnswerOverride) GetRequestType() uint32 {
+	if m != nil {
+		return m.RequestType
+	}
+	return 0
+}
+
+func (m *AnswerOverride) GetResultCode() uint32 {
+	if m != nil {
+		return m.ResultCode
+	}
+	return 0
+}
+
+func (m *AnswerOverride) GetExperimental() bool {
+	if m != nil {
+		return m.Experimental
+	}
+	return false
+}
+
+func (m *AnswerOverride) GetDrop() bool {
+	if m != nil {
+		return m.Drop
+	}
+	return false
+}
+
+type ReceivedCCR struct {
+	SessionId            string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	RequestType          uint32                `protobuf:"varint,2,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	RequestNumber        uint32                `protobuf:"varint,3,opt,name=request_number,json=requestNumber,proto3" json:"request_number,omitempty"`
+	EventTriggers        []uint32              `protobuf:"varint,4,rep,packed,name=event_triggers,json=eventTriggers,proto3" json:"event_triggers,omitempty"`
+	UsageMonitors        []*UsageMonitorReport `protobuf:"bytes,5,rep,name=usage_monitors,json=usageMonitors,proto3" json:"usage_monitors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ReceivedCCR) Reset()         { *m = ReceivedCCR{} }
+func (m *ReceivedCCR) String() string { return proto.CompactTextString(m) }
+func (*ReceivedCCR) ProtoMessage()    {}
+func (*ReceivedCCR) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{14}
+}
+
+func (m *ReceivedCCR) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceivedCCR.Unmarshal(m, b)
+}
+func (m *ReceivedCCR) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceivedCCR.Marshal(b, m, deterministic)
+}
+func (m *ReceivedCCR) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceivedCCR.Merge(m, src)
+}
+func (m *ReceivedCCR) XXX_Size() int {
+	return xxx_messageInfo_ReceivedCCR.Size(m)
+}
+func (m *ReceivedCCR) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceivedCCR.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceivedCCR proto.InternalMessageInfo
+
+func (m *ReceivedCCR) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *ReceivedCCR) GetRequestType() uint32 {
+	if m != nil {
+		return m.RequestType
+	}
+	return 0
+}
+
+func (m *ReceivedCCR) GetRequestNumber() uint32 {
+	if m != nil {
+		return m.RequestNumber
+	}
+	return 0
+}
+
+func (m *ReceivedCCR) GetEventTriggers() []uint32 {
+	if m != nil {
+		return m.EventTriggers
+	}
+	return nil
+}
+
+func (m *ReceivedCCR) GetUsageMonitors() []*UsageMonitorReport {
+	if m != nil {
+		return m.UsageMonitors
+	}
+	return nil
+}
+
+type UsageMonitorReport struct {
+	MonitoringKey        string   `protobuf:"bytes,1,opt,name=monitoring_key,json=monitoringKey,proto3" json:"monitoring_key,omitempty"`
+	InputOctets          uint64   `protobuf:"varint,2,opt,name=input_octets,json=inputOctets,proto3" json:"input_octets,omitempty"`
+	OutputOctets         uint64   `protobuf:"varint,3,opt,name=output_octets,json=outputOctets,proto3" json:"output_octets,omitempty"`
+	TotalOctets          uint64   `protobuf:"varint,4,opt,name=total_octets,json=totalOctets,proto3" json:"total_octets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UsageMonitorReport) Reset()         { *m = UsageMonitorReport{} }
+func (m *UsageMonitorReport) String() string { return proto.CompactTextString(m) }
+func (*UsageMonitorReport) ProtoMessage()    {}
+func (*UsageMonitorReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{15}
+}
+
+func (m *UsageMonitorReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsageMonitorReport.Unmarshal(m, b)
+}
+func (m *UsageMonitorReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsageMonitorReport.Marshal(b, m, deterministic)
+}
+func (m *UsageMonitorReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageMonitorReport.Merge(m, src)
+}
+func (m *UsageMonitorReport) XXX_Size() int {
+	return xxx_messageInfo_UsageMonitorReport.Size(m)
+}
+func (m *UsageMonitorReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageMonitorReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageMonitorReport proto.InternalMessageInfo
+
+func (m *UsageMonitorReport) GetMonitoringKey() string {
+	if m != nil {
+		return m.MonitoringKey
+	}
+	return ""
+}
+
+func (m *UsageMonitorReport) GetInputOctets() uint64 {
+	if m != nil {
+		return m.InputOctets
+	}
+	return 0
+}
+
+func (m *UsageMonitorReport) GetOutputOctets() uint64 {
+	if m != nil {
+		return m.OutputOctets
+	}
+	return 0
+}
+
+func (m *UsageMonitorReport) GetTotalOctets() uint64 {
+	if m != nil {
+		return m.TotalOctets
+	}
+	return 0
+}
+
+type ReceivedCCRs struct {
+	Ccrs                 []*ReceivedCCR `protobuf:"bytes,1,rep,name=ccrs,proto3" json:"ccrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ReceivedCCRs) Reset()         { *m = ReceivedCCRs{} }
+func (m *ReceivedCCRs) String() string { return proto.CompactTextString(m) }
+func (*ReceivedCCRs) ProtoMessage()    {}
+func (*ReceivedCCRs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{16}
+}
+
+func (m *ReceivedCCRs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReceivedCCRs.Unmarshal(m, b)
+}
+func (m *ReceivedCCRs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReceivedCCRs.Marshal(b, m, deterministic)
+}
+func (m *ReceivedCCRs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceivedCCRs.Merge(m, src)
+}
+func (m *ReceivedCCRs) XXX_Size() int {
+	return xxx_messageInfo_ReceivedCCRs.Size(m)
+}
+func (m *ReceivedCCRs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceivedCCRs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceivedCCRs proto.InternalMessageInfo
+
+func (m *ReceivedCCRs) GetCcrs() []*ReceivedCCR {
+	if m != nil {
+		return m.Ccrs
+	}
+	return nil
+}
+
+type PolicyReAuthTarget struct {
+	Imsi                     string                `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	RulesToRemove            []string              `protobuf:"bytes,2,rep,name=rules_to_remove,json=rulesToRemove,proto3" json:"rules_to_remove,omitempty"`
+	RuleBaseNamesToRemove    []string              `protobuf:"bytes,3,rep,name=rule_base_names_to_remove,json=ruleBaseNamesToRemove,proto3" json:"rule_base_names_to_remove,omitempty"`
+	RulesToInstall           []string              `protobuf:"bytes,4,rep,name=rules_to_install,json=rulesToInstall,proto3" json:"rules_to_install,omitempty"`
+	RuleBaseNamesToInstall   []string              `protobuf:"bytes,5,rep,name=rule_base_names_to_install,json=ruleBaseNamesToInstall,proto3" json:"rule_base_names_to_install,omitempty"`
+	RuleDefinitionsToInstall []*RuleDefinition     `protobuf:"bytes,6,rep,name=rule_definitions_to_install,json=ruleDefinitionsToInstall,proto3" json:"rule_definitions_to_install,omitempty"`
+	UsageMonitors            []*UsageMonitorCredit `protobuf:"bytes,7,rep,name=usage_monitors,json=usageMonitors,proto3" json:"usage_monitors,omitempty"`
+	EventTriggers            []uint32              `protobuf:"varint,8,rep,packed,name=event_triggers,json=eventTriggers,proto3" json:"event_triggers,omitempty"`
+	// seconds since epoch, 0 for none
+	RevalidationTime     uint64   `protobuf:"varint,9,opt,name=revalidation_time,json=revalidationTime,proto3" json:"revalidation_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyReAuthTarget) Reset()         { *m = PolicyReAuthTarget{} }
+func (m *PolicyReAuthTarget) String() string { return proto.CompactTextString(m) }
+func (*PolicyReAuthTarget) ProtoMessage()    {}
+func (*PolicyReAuthTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{17}
+}
+
+func (m *PolicyReAuthTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyReAuthTarget.Unmarshal(m, b)
+}
+func (m *PolicyReAuthTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyReAuthTarget.Marshal(b, m, deterministic)
+}
+func (m *PolicyReAuthTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyReAuthTarget.Merge(m, src)
+}
+func (m *PolicyReAuthTarget) XXX_Size() int {
+	return xxx_messageInfo_PolicyReAuthTarget.Size(m)
+}
+func (m *PolicyReAuthTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyReAuthTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyReAuthTarget proto.InternalMessageInfo
+
+func (m *PolicyReAuthTarget) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+func (m *PolicyReAuthTarget) GetRulesToRemove() []string {
+	if m != nil {
+		return m.RulesToRemove
+	}
+	return nil
+}
+
+func (m *PolicyReAuthTarget) GetRuleBaseNamesToRemove() []string {
+	if m != nil {
+		return m.RuleBaseNamesToRemove
+	}
+	return nil
+}
+
+func (m *PolicyReAuthTarget) GetRulesToInstall() []string {
+	if m != nil {
+		return m.RulesToInstall
+	}
+	return nil
+}
+
+func (m *PolicyReAuthTarget) GetRuleBaseNamesToInstall() []string {
+	if m != nil {
+		return m.RuleBaseNamesToInstall
+	}
+	return nil
+}
+
+func (m *PolicyReAuthTarget) GetRuleDefinitionsToInstall() []*RuleDefinition {
+	if m != nil {
+		return m.RuleDefinitionsToInstall
+	}
+	return nil
+}
+
+func (m *PolicyReAuthTarget) GetUsageMonitors() []*UsageMonitorCredit {
+	if m != nil {
+		return m.UsageMonitors
+	}
+	return nil
+}
+
+func (m *PolicyReAuthTarget) GetEventTriggers() []uint32 {
+	if m != nil {
+		return m.EventTriggers
+	}
+	return nil
+}
+
+func (m *PolicyReAuthTarget) GetRevalidationTime() uint64 {
+	if m != nil {
+		return m.RevalidationTime
+	}
+	return 0
+}
+
+type PolicyReAuthAnswer struct {
+	SessionId  string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ResultCode uint32 `protobuf:"varint,2,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	// rules reported as failed in the RAA
+	FailedRules          []string `protobuf:"bytes,3,rep,name=failed_rules,json=failedRules,proto3" json:"failed_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PolicyReAuthAnswer) Reset()         { *m = PolicyReAuthAnswer{} }
+func (m *PolicyReAuthAnswer) String() string { return proto.CompactTextString(m) }
+func (*PolicyReAuthAnswer) ProtoMessage()    {}
+func (*PolicyReAuthAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{18}
+}
+
+func (m *PolicyReAuthAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyReAuthAnswer.Unmarshal(m, b)
+}
+func (m *PolicyReAuthAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyReAuthAnswer.Marshal(b, m, deterministic)
+}
+func (m *PolicyReAuthAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyReAuthAnswer.Merge(m, src)
+}
+func (m *PolicyReAuthAnswer) XXX_Size() int {
+	return xxx_messageInfo_PolicyReAuthAnswer.Size(m)
+}
+func (m *PolicyReAuthAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyReAuthAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyReAuthAnswer proto.InternalMessageInfo
+
+func (m *PolicyReAuthAnswer) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *PolicyReAuthAnswer) GetResultCode() uint32 {
+	if m != nil {
+		return m.ResultCode
+	}
+	return 0
+}
+
+func (m *PolicyReAuthAnswer) GetFailedRules() []string {
+	if m != nil {
+		return m.FailedRules
+	}
+	return nil
+}
+
+type AbortSessionTarget struct {
+	Imsi                 string   `protobuf:"bytes,1,opt,name=imsi,proto3" json:"imsi,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbortSessionTarget) Reset()         { *m = AbortSessionTarget{} }
+func (m *AbortSessionTarget) String() string { return proto.CompactTextString(m) }
+func (*AbortSessionTarget) ProtoMessage()    {}
+func (*AbortSessionTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{19}
+}
+
+func (m *AbortSessionTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortSessionTarget.Unmarshal(m, b)
+}
+func (m *AbortSessionTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortSessionTarget.Marshal(b, m, deterministic)
+}
+func (m *AbortSessionTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortSessionTarget.Merge(m, src)
+}
+func (m *AbortSessionTarget) XXX_Size() int {
+	return xxx_messageInfo_AbortSessionTarget.Size(m)
+}
+func (m *AbortSessionTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortSessionTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortSessionTarget proto.InternalMessageInfo
+
+func (m *AbortSessionTarget) GetImsi() string {
+	if m != nil {
+		return m.Imsi
+	}
+	return ""
+}
+
+type AbortSessionAnswer struct {
+	SessionId            string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	ResultCode           uint32   `protobuf:"varint,2,opt,name=result_code,json=resultCode,proto3" json:"result_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AbortSessionAnswer) Reset()         { *m = AbortSessionAnswer{} }
+func (m *AbortSessionAnswer) String() string { return proto.CompactTextString(m) }
+func (*AbortSessionAnswer) ProtoMessage()    {}
+func (*AbortSessionAnswer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ef3afe2df05d1dc6, []int{20}
+}
+
+func (m *AbortSessionAnswer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbortSessionAnswer.Unmarshal(m, b)
+}
+func (m *AbortSessionAnswer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbortSessionAnswer.Marshal(b, m, deterministic)
+}
+func (m *AbortSessionAnswer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbortSessionAnswer.Merge(m, src)
+}
+func (m *AbortSessionAnswer) XXX_Size() int {
+	return xxx_messageInfo_AbortSessionAnswer.Size(m)
+}
+func (m *AbortSessionAnswer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbortSessionAnswer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AbortSessionAnswer proto.InternalMessageInfo
+
+func (m *AbortSessionAnswer) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *AbortSessionAnswer) GetResultCode() uint32 {
+	if m != nil {
+		return m.ResultCode
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("magma.feg.Reply_ServerBehavior", Reply_ServerBehavior_name, Reply_ServerBehavior_value)
 	proto.RegisterEnum("magma.feg.CreditInfo_UnitType", CreditInfo_UnitType_name, CreditInfo_UnitType_value)
@@ -1324,131 +1873,169 @@ func init() {
 	proto.RegisterType((*RuleDefinition)(nil), "magma.feg.RuleDefinition")
 	proto.RegisterType((*UsageMonitorInfo)(nil), "magma.feg.UsageMonitorInfo")
 	proto.RegisterType((*UsageMonitorCredit)(nil), "magma.feg.UsageMonitorCredit")
+	proto.RegisterType((*EventTriggerInfo)(nil), "magma.feg.EventTriggerInfo")
+	proto.RegisterType((*AnswerOverride)(nil), "magma.feg.AnswerOverride")
+	proto.RegisterType((*ReceivedCCR)(nil), "magma.feg.ReceivedCCR")
+	proto.RegisterType((*UsageMonitorReport)(nil), "magma.feg.UsageMonitorReport")
+	proto.RegisterType((*ReceivedCCRs)(nil), "magma.feg.ReceivedCCRs")
+	proto.RegisterType((*PolicyReAuthTarget)(nil), "magma.feg.PolicyReAuthTarget")
+	proto.RegisterType((*PolicyReAuthAnswer)(nil), "magma.feg.PolicyReAuthAnswer")
+	proto.RegisterType((*AbortSessionTarget)(nil), "magma.feg.AbortSessionTarget")
+	proto.RegisterType((*AbortSessionAnswer)(nil), "magma.feg.AbortSessionAnswer")
 }
 
 func init() { proto.RegisterFile("feg/protos/mock_core.proto", fileDescriptor_ef3afe2df05d1dc6) }
 
 var fileDescriptor_ef3afe2df05d1dc6 = []byte{
-	// 1889 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x5b, 0x73, 0xdc, 0x48,
-	0x15, 0x9e, 0xf1, 0x2d, 0x9e, 0xe3, 0xb9, 0x28, 0x6d, 0x3b, 0x99, 0x38, 0x95, 0xc4, 0x4c, 0x60,
-	0x2b, 0x55, 0x0b, 0x76, 0x95, 0xa1, 0x8a, 0x2d, 0xb2, 0x40, 0x8d, 0xc7, 0xde, 0xb5, 0xc1, 0x76,
-	0x12, 0x8d, 0x4d, 0xd8, 0xbc, 0x08, 0x59, 0x3a, 0x1e, 0x0b, 0xeb, 0x96, 0xee, 0x96, 0x93, 0x79,
-	0xe1, 0x09, 0xde, 0x78, 0xe2, 0x89, 0x7f, 0xc1, 0x23, 0xf0, 0xef, 0xa8, 0xbe, 0x68, 0xd4, 0x1a,
-	0xc9, 0x9b, 0x2d, 0xf2, 0x34, 0xd3, 0xdf, 0xf9, 0xfa, 0xeb, 0xcb, 0x39, 0xfd, 0xb5, 0x24, 0xd8,
-	0xba, 0xc2, 0xc9, 0x6e, 0x4a, 0x13, 0x9e, 0xb0, 0xdd, 0x28, 0xf1, 0x6e, 0x1c, 0x2f, 0xa1, 0xb8,
-	0x23, 0x01, 0xd2, 0x8a, 0xdc, 0x49, 0xe4, 0xee, 0x5c, 0xe1, 0x64, 0xeb, 0x51, 0x42, 0xbd, 0xaf,
-	0x68, 0x4e, 0xf4, 0x92, 0x28, 0x4a, 0x62, 0xc5, 0xda, 0xda, 0x34, 0x14, 0x3c, 0x76, 0x75, 0xa9,
-	0xe1, 0x47, 0x21, 0xc7, 0x1c, 0x4e, 0x93, 0x30, 0xf0, 0xa6, 0x7e, 0x1e, 0xda, 0x36, 0x42, 0x0c,
-	0x19, 0x0b, 0x92, 0xd8, 0x89, 0xdc, 0xd8, 0x9d, 0x20, 0xd5, 0x8c, 0x27, 0x26, 0x23, 0xbb, 0x64,
-	0x1e, 0x0d, 0x2e, 0x91, 0xe6, 0x02, 0x83, 0x7f, 0xae, 0xc1, 0xb2, 0x8d, 0x69, 0x38, 0x25, 0x47,
-	0xd0, 0x63, 0x48, 0x6f, 0x91, 0x3a, 0x97, 0x78, 0xed, 0xde, 0x06, 0x09, 0xed, 0x37, 0xb7, 0x9b,
-	0x2f, 0xba, 0x7b, 0xcf, 0x76, 0x66, 0x93, 0xdf, 0x91, 0xd4, 0x9d, 0xb1, 0xe4, 0xed, 0x6b, 0x9a,
-	0xdd, 0x65, 0xa5, 0x36, 0x79, 0x06, 0x6b, 0x54, 0xf0, 0x1c, 0x1f, 0x43, 0x77, 0xda, 0x5f, 0xd8,
-	0x6e, 0xbe, 0x58, 0xb6, 0x41, 0x42, 0x07, 0x02, 0x21, 0xbf, 0x81, 0x8e, 0x1b, 0x22, 0xe5, 0x0e,
-	0xc5, 0xf7, 0x19, 0x32, 0xde, 0x5f, 0xdc, 0x6e, 0xbe, 0x58, 0xdb, 0x7b, 0x68, 0x0c, 0x34, 0x14,
-	0x71, 0x5b, 0x85, 0x8f, 0x1a, 0x76, 0xdb, 0x35, 0xda, 0xe4, 0x77, 0x70, 0xdf, 0x4f, 0x3e, 0xc4,
-	0x61, 0x10, 0xdf, 0x38, 0x59, 0x1c, 0x70, 0xdf, 0xe5, 0x6e, 0x7f, 0x49, 0x6a, 0x3c, 0x36, 0x34,
-	0x0e, 0x34, 0xe7, 0x42, 0x53, 0x8e, 0x1a, 0xb6, 0xe5, 0xcf, 0x61, 0xe4, 0xb7, 0xd0, 0xc5, 0x94,
-	0x39, 0x3e, 0x72, 0xd7, 0xbb, 0x76, 0x5c, 0xef, 0xa6, 0xbf, 0x5c, 0x99, 0xcc, 0xe1, 0xeb, 0xf1,
-	0x81, 0x8c, 0x0f, 0xbd, 0x1b, 0x31, 0x19, 0x4c, 0xd9, 0xac, 0x4d, 0xf6, 0xa1, 0x17, 0x44, 0x2c,
-	0x30, 0x15, 0x56, 0xa4, 0x42, 0xdf, 0x50, 0x38, 0x3e, 0x1d, 0x1f, 0x9b, 0x12, 0x1d, 0xd1, 0xa5,
-	0xd0, 0x78, 0x0b, 0x0f, 0xc2, 0xc4, 0x73, 0xb9, 0x48, 0x5f, 0x96, 0xfa, 0x2e, 0x47, 0xc7, 0xf5,
-	0x3c, 0x4c, 0x79, 0xff, 0x9e, 0x94, 0x32, 0x53, 0x70, 0xa2, 0x89, 0x17, 0x92, 0x37, 0x94, 0xb4,
-	0xa3, 0x86, 0xbd, 0x11, 0xd6, 0xe0, 0x75, 0xc2, 0x14, 0xff, 0x8c, 0x1e, 0xef, 0xaf, 0x7e, 0x42,
-	0xd8, 0x96, 0xb4, 0xaa, 0xb0, 0xc2, 0x85, 0x70, 0x14, 0x39, 0x41, 0x7c, 0x95, 0xd0, 0x48, 0xc9,
-	0xe7, 0xb9, 0x6c, 0x55, 0x84, 0x4f, 0x4f, 0x8f, 0x0b, 0x5e, 0x91, 0xd3, 0x8d, 0x28, 0xaa, 0xe2,
-	0x64, 0x08, 0xdd, 0xd4, 0x9d, 0x04, 0xf1, 0x64, 0x26, 0x08, 0x95, 0xdd, 0x7c, 0x2d, 0x09, 0x85,
-	0x52, 0x27, 0x35, 0x01, 0x72, 0x00, 0x3d, 0x8a, 0x21, 0xba, 0x0c, 0x67, 0x1a, 0x6b, 0x52, 0xe3,
-	0x51, 0xa9, 0x92, 0x25, 0xa3, 0x10, 0xe9, 0xd2, 0x12, 0x42, 0xce, 0x61, 0x53, 0xd4, 0x75, 0xe0,
-	0xa1, 0xe3, 0x5e, 0x26, 0x46, 0xb1, 0xb6, 0xa5, 0xd6, 0x53, 0x43, 0x6b, 0xac, 0x78, 0x43, 0x41,
-	0x2b, 0x04, 0xd7, 0x59, 0x15, 0x26, 0x7b, 0xd0, 0xa2, 0xc8, 0x90, 0xcb, 0x3a, 0xe9, 0x48, 0xa5,
-	0xf5, 0xd2, 0xac, 0x18, 0x72, 0x55, 0x22, 0xab, 0x54, 0xff, 0x27, 0xdf, 0x82, 0xa5, 0xfa, 0x04,
-	0xb1, 0x1f, 0xa8, 0x5c, 0xf4, 0xbb, 0xb2, 0xeb, 0xd6, 0x7c, 0xd7, 0xe3, 0x19, 0xe3, 0xa8, 0x61,
-	0xf7, 0x68, 0x19, 0x22, 0x5f, 0xc2, 0x0a, 0xe3, 0x2e, 0xcf, 0x58, 0xbf, 0x27, 0xbb, 0xdf, 0x37,
-	0xd7, 0x20, 0x03, 0x47, 0x0d, 0x5b, 0x53, 0xc8, 0x3b, 0x78, 0xe8, 0x51, 0x14, 0x15, 0x93, 0x1b,
-	0x0b, 0x45, 0x96, 0x26, 0x31, 0xc3, 0xbe, 0x25, 0x7b, 0x6f, 0xeb, 0xde, 0x21, 0xc7, 0x9d, 0x91,
-	0x64, 0x8e, 0x15, 0xd1, 0xd6, 0xbc, 0xa3, 0xa6, 0xbd, 0xe9, 0xd5, 0x05, 0x84, 0xb6, 0xae, 0xc6,
-	0x8a, 0xf6, 0xfd, 0x8a, 0xb6, 0xaa, 0xbb, 0x1a, 0xed, 0xac, 0x2e, 0x40, 0x3c, 0xd8, 0xca, 0x45,
-	0x39, 0xd2, 0x28, 0x88, 0x55, 0xd1, 0x6b, 0x79, 0x22, 0xe5, 0x9f, 0x1b, 0xf2, 0xba, 0xff, 0x79,
-	0xce, 0x35, 0x46, 0xe8, 0xb3, 0x3b, 0x62, 0x83, 0x11, 0x74, 0xcb, 0x26, 0x48, 0xd6, 0xa1, 0x67,
-	0x1f, 0xbe, 0x3e, 0xf9, 0xce, 0x39, 0x3e, 0x1b, 0x9f, 0x0f, 0xcf, 0xce, 0x4f, 0xbe, 0xb3, 0x1a,
-	0xa4, 0x0b, 0xa0, 0xc0, 0x93, 0xe1, 0xf9, 0xa1, 0xd5, 0x24, 0x6d, 0x58, 0x3d, 0x7b, 0xe5, 0x48,
-	0xc8, 0x5a, 0xd8, 0xef, 0xc0, 0x1a, 0x9b, 0x30, 0x27, 0x42, 0xc6, 0xdc, 0x09, 0xee, 0x77, 0xa1,
-	0x3d, 0xf9, 0x38, 0x99, 0xe6, 0xed, 0xc1, 0xbf, 0x01, 0x7a, 0x87, 0x1f, 0x53, 0xf4, 0x38, 0xfa,
-	0x46, 0xf9, 0x28, 0xe7, 0x14, 0xe5, 0xd3, 0xac, 0x94, 0x8f, 0x74, 0x4d, 0x5d, 0x3e, 0xae, 0xfe,
-	0x4f, 0x5e, 0x42, 0x3b, 0x77, 0x5b, 0x79, 0xf2, 0x17, 0x64, 0xb7, 0x07, 0x55, 0xb3, 0xd5, 0x07,
-	0x7e, 0xcd, 0x2d, 0x9a, 0xe2, 0x14, 0x18, 0xf6, 0x68, 0x14, 0xe0, 0x62, 0xe5, 0x14, 0xcc, 0x5c,
-	0xb2, 0x54, 0x84, 0xeb, 0x33, 0xb3, 0x34, 0x0a, 0xf1, 0x2d, 0x3c, 0x30, 0x3d, 0xd3, 0x90, 0x5d,
-	0xaa, 0xb8, 0x47, 0x61, 0x9d, 0x25, 0xdd, 0x8d, 0xc2, 0x41, 0x0d, 0xe1, 0x77, 0xf0, 0xb0, 0xea,
-	0x77, 0xea, 0xd8, 0x2e, 0x97, 0x0a, 0xab, 0xce, 0xf0, 0xf2, 0x83, 0xbb, 0x19, 0xd6, 0x05, 0xc4,
-	0xad, 0x35, 0x73, 0x26, 0xb9, 0x91, 0x2b, 0x95, 0x8b, 0x22, 0x37, 0x26, 0xbd, 0x93, 0xed, 0xd4,
-	0x68, 0x0b, 0x5b, 0xca, 0x0d, 0x25, 0x9f, 0xd3, 0xbd, 0x8a, 0x2d, 0x69, 0x2b, 0x31, 0x6c, 0x89,
-	0x95, 0x10, 0x51, 0xde, 0x5c, 0x6c, 0x1d, 0x45, 0x37, 0x9c, 0x2d, 0xd5, 0x4b, 0xa2, 0x34, 0x44,
-	0x8e, 0xda, 0xd5, 0x9f, 0x1b, 0x82, 0xe7, 0xa7, 0xe3, 0x63, 0xdb, 0xe0, 0x8e, 0x34, 0xf5, 0xa8,
-	0x61, 0xf7, 0x85, 0x50, 0x5d, 0x4c, 0xe4, 0x27, 0x13, 0x57, 0x10, 0x0f, 0x6e, 0x03, 0x3e, 0x35,
-	0xf3, 0x53, 0x75, 0xf7, 0x8b, 0xc3, 0xa1, 0xe6, 0x95, 0xf3, 0x93, 0x61, 0x15, 0x17, 0xee, 0x9e,
-	0xa1, 0x93, 0xc5, 0x14, 0x5d, 0xef, 0xda, 0xbd, 0x0c, 0xb1, 0xc6, 0xdd, 0x2f, 0x0e, 0x2f, 0x8a,
-	0xb8, 0x70, 0xf7, 0x0c, 0x0d, 0x40, 0x6c, 0x63, 0x96, 0x96, 0xaf, 0xfe, 0xaa, 0xbb, 0x5f, 0xa4,
-	0x73, 0x17, 0x7f, 0x37, 0x2b, 0x21, 0x65, 0x1f, 0x6e, 0xff, 0xff, 0x3e, 0xdc, 0xf9, 0x3c, 0x1f,
-	0xee, 0x7e, 0xda, 0x87, 0xdf, 0xc2, 0x83, 0x8a, 0x0f, 0xab, 0xea, 0xe9, 0x95, 0x72, 0x51, 0x63,
-	0xc3, 0xaa, 0x86, 0x9a, 0xf6, 0x86, 0x57, 0x83, 0xcb, 0x24, 0xcf, 0x9b, 0xb0, 0x12, 0xb6, 0x2a,
-	0xc2, 0x73, 0x1e, 0x3c, 0x13, 0xce, 0x6a, 0x70, 0xf2, 0x27, 0x78, 0x54, 0xe7, 0xc0, 0x4a, 0x5b,
-	0xf9, 0xfb, 0xe0, 0x7b, 0x0d, 0x38, 0x97, 0x7f, 0xc8, 0xea, 0x43, 0x9f, 0x72, 0xce, 0x10, 0xda,
-	0x9a, 0xa9, 0x1e, 0x6d, 0x7f, 0x01, 0xf7, 0xf2, 0xe1, 0x9b, 0x95, 0x7c, 0xcd, 0x59, 0xac, 0x9d,
-	0x53, 0xc9, 0x17, 0xb0, 0x2c, 0x9f, 0x59, 0xb5, 0x61, 0x5a, 0xf3, 0x8f, 0xc1, 0xb6, 0x0a, 0x0f,
-	0xc6, 0xb0, 0xae, 0xee, 0x82, 0x51, 0x12, 0x5f, 0x05, 0x93, 0x8c, 0xaa, 0x24, 0x7f, 0x0d, 0x1d,
-	0xad, 0xe4, 0x28, 0x99, 0xe6, 0xf6, 0xe2, 0x9c, 0x5d, 0x98, 0x93, 0xb4, 0xdb, 0xd4, 0x68, 0x0d,
-	0xfe, 0x02, 0xad, 0x57, 0xa3, 0xb1, 0x52, 0x24, 0x5f, 0x40, 0x2f, 0x72, 0x3f, 0x3a, 0x99, 0x58,
-	0x9c, 0x73, 0x39, 0xe5, 0xc8, 0xe4, 0x3a, 0x3a, 0x76, 0x27, 0x72, 0x3f, 0x5e, 0xc8, 0x2d, 0x10,
-	0x20, 0xf9, 0x31, 0x74, 0x0b, 0x1e, 0x0f, 0x22, 0x94, 0x53, 0xef, 0xd8, 0xed, 0x9c, 0x76, 0x1e,
-	0x44, 0x48, 0x9e, 0x43, 0xe7, 0xd6, 0x0d, 0x03, 0x5f, 0x9c, 0x6c, 0x49, 0x5a, 0x54, 0xa4, 0x1c,
-	0x14, 0xa4, 0xc1, 0x7f, 0x9b, 0x00, 0x23, 0x8a, 0x7e, 0xc0, 0xc5, 0x33, 0x1a, 0x21, 0xb0, 0x24,
-	0xfc, 0x56, 0x0e, 0xdb, 0xb2, 0xe5, 0x7f, 0xf2, 0x23, 0x68, 0x7b, 0xd7, 0x2e, 0x95, 0x8e, 0x78,
-	0x83, 0x53, 0x3d, 0xd6, 0x5a, 0x8e, 0xfd, 0x1e, 0xa7, 0xe4, 0x01, 0xac, 0xdc, 0x26, 0x61, 0xa6,
-	0xc7, 0x58, 0xb2, 0x75, 0x8b, 0xbc, 0x84, 0x96, 0x38, 0xbc, 0x0e, 0x9f, 0xa6, 0x28, 0x2d, 0xbf,
-	0x5b, 0xba, 0x49, 0x8a, 0x81, 0x77, 0xc4, 0x81, 0x3d, 0x9f, 0xa6, 0x68, 0xaf, 0x66, 0xfa, 0xdf,
-	0xe0, 0x19, 0xac, 0xe6, 0x28, 0x69, 0xc1, 0xb2, 0x5c, 0xba, 0xd5, 0x20, 0xab, 0xb0, 0x24, 0x66,
-	0x6e, 0x35, 0x07, 0x87, 0x22, 0xfd, 0xc3, 0x8c, 0x5f, 0x9f, 0xbb, 0x74, 0x82, 0xfc, 0xae, 0xc9,
-	0x8b, 0x3c, 0xc5, 0x13, 0x67, 0x42, 0x93, 0x2c, 0xcd, 0x27, 0xaf, 0xb0, 0x6f, 0x05, 0x34, 0x38,
-	0xcb, 0x65, 0x86, 0x31, 0xfb, 0x80, 0x94, 0x3c, 0x01, 0xc8, 0xcb, 0x3a, 0xf0, 0xb5, 0x58, 0x4b,
-	0x23, 0xc7, 0xbe, 0x7a, 0xeb, 0x61, 0x59, 0xc8, 0x1d, 0x2f, 0xf1, 0xf3, 0x9d, 0x07, 0x05, 0x8d,
-	0x12, 0x1f, 0x07, 0xff, 0x6a, 0x42, 0x7b, 0xe8, 0x79, 0x49, 0x16, 0x73, 0x3b, 0x0b, 0x91, 0xd5,
-	0xce, 0xeb, 0x09, 0x00, 0xcd, 0x42, 0x74, 0x62, 0x37, 0x42, 0xd6, 0x5f, 0xd8, 0x5e, 0x14, 0x83,
-	0x08, 0xe4, 0x4c, 0x00, 0xa2, 0x12, 0x64, 0xf8, 0x52, 0x3c, 0xdc, 0x2a, 0xce, 0xa2, 0xe4, 0x74,
-	0x04, 0xbc, 0xef, 0x32, 0xcd, 0x3b, 0x00, 0x4b, 0xf2, 0x7c, 0xbc, 0x0a, 0xe2, 0x40, 0xd4, 0x23,
-	0xeb, 0x2f, 0xc9, 0xfa, 0x2b, 0x3d, 0x03, 0x67, 0x21, 0x1e, 0xcc, 0x18, 0xb6, 0x94, 0x2e, 0xda,
-	0x6c, 0xf0, 0x8f, 0x45, 0xe8, 0x96, 0x39, 0xe4, 0xa7, 0x40, 0x74, 0x82, 0xd1, 0x99, 0x4d, 0x54,
-	0xaf, 0xc0, 0xca, 0x23, 0xb6, 0x9e, 0xef, 0x0f, 0xd8, 0x65, 0xf2, 0x14, 0x20, 0xa5, 0xe8, 0xa1,
-	0x8f, 0xb1, 0x97, 0x97, 0xa2, 0x81, 0x90, 0x9f, 0x40, 0x37, 0x4a, 0xe2, 0x80, 0x27, 0x34, 0xaf,
-	0xb3, 0x25, 0x39, 0x58, 0xa7, 0x40, 0x45, 0xa5, 0x7d, 0x09, 0xf7, 0xaf, 0xc2, 0xe4, 0x83, 0xe3,
-	0xa3, 0x78, 0xc3, 0x4d, 0xd5, 0x8a, 0x97, 0xe5, 0xd6, 0x58, 0x22, 0x70, 0x60, 0xe0, 0x33, 0xb2,
-	0xf1, 0xfa, 0xc2, 0xfa, 0x2b, 0x05, 0xd9, 0x78, 0x2d, 0x61, 0xe4, 0x0d, 0x6c, 0x88, 0x72, 0xa4,
-	0xe8, 0x71, 0xb3, 0x83, 0xbe, 0xbb, 0x9f, 0x1a, 0x46, 0x66, 0x6b, 0x9a, 0xf9, 0x56, 0xb3, 0x4e,
-	0xab, 0x20, 0x79, 0x09, 0xbd, 0xf7, 0x09, 0x2b, 0xa9, 0xa9, 0x8b, 0x9b, 0x18, 0x6a, 0xdf, 0x84,
-	0xc9, 0x87, 0x37, 0x09, 0xb3, 0xbb, 0xef, 0x13, 0x66, 0x74, 0x1e, 0x4c, 0xc1, 0x92, 0x67, 0xf9,
-	0x54, 0xad, 0xff, 0xce, 0xe3, 0xf9, 0x06, 0x36, 0x95, 0x11, 0xe8, 0x8d, 0x72, 0x3c, 0x79, 0xaa,
-	0x54, 0x51, 0xad, 0xed, 0x3d, 0x31, 0x6f, 0x4b, 0x43, 0x4f, 0x9d, 0x3d, 0x7b, 0x3d, 0xab, 0x60,
-	0x6c, 0xf0, 0xd7, 0x05, 0x20, 0x55, 0x6e, 0x4d, 0x8a, 0x9a, 0x75, 0x29, 0xfa, 0x23, 0x58, 0x06,
-	0x2d, 0xc4, 0x5b, 0x0c, 0x65, 0x41, 0x74, 0xf7, 0x7e, 0xf6, 0xbd, 0x73, 0xd9, 0x39, 0x9d, 0xf5,
-	0x3a, 0x11, 0x9d, 0xec, 0x5e, 0x54, 0x06, 0x64, 0x99, 0x21, 0xcf, 0x68, 0xac, 0xcd, 0x51, 0x99,
-	0xcd, 0x9a, 0xc2, 0x94, 0x35, 0x16, 0x4e, 0xb4, 0x64, 0x3a, 0xd1, 0x60, 0x0f, 0x7a, 0x73, 0xf2,
-	0xc4, 0x82, 0xb6, 0xbe, 0x92, 0x64, 0xdb, 0x6a, 0x90, 0x0e, 0xb4, 0x44, 0x49, 0xab, 0x66, 0x73,
-	0xef, 0xef, 0x4d, 0xd8, 0x38, 0x4d, 0xbc, 0x9b, 0x51, 0x42, 0xb1, 0xf0, 0xfc, 0x84, 0x92, 0x11,
-	0xb4, 0x55, 0x5b, 0xdd, 0x07, 0x64, 0xfe, 0x1d, 0x71, 0xee, 0x8a, 0xd8, 0xca, 0xef, 0x7d, 0xf9,
-	0x45, 0x68, 0xe7, 0x0f, 0x49, 0xe0, 0x0f, 0x1a, 0x64, 0x17, 0x96, 0xe5, 0x23, 0x04, 0xa9, 0x46,
-	0x6b, 0x3b, 0xec, 0xfd, 0x67, 0x01, 0xee, 0x89, 0xe9, 0xbc, 0x1a, 0x8d, 0xc9, 0x4b, 0xf1, 0x5e,
-	0xc2, 0x5f, 0x8d, 0xc6, 0x63, 0xe4, 0xe2, 0x8c, 0x31, 0xb2, 0x61, 0xcc, 0x61, 0x76, 0xa3, 0xd4,
-	0x8f, 0xfc, 0x4b, 0x68, 0x8d, 0x91, 0xeb, 0xa4, 0x6e, 0xd6, 0xfa, 0x71, 0x7d, 0xc7, 0x5f, 0x43,
-	0x47, 0x3d, 0x79, 0x68, 0x7b, 0x23, 0x0f, 0xcd, 0xeb, 0x7d, 0xf6, 0xd1, 0xe9, 0xf8, 0xa0, 0xbe,
-	0xfb, 0xaf, 0xc0, 0x1a, 0x85, 0xe8, 0xd2, 0x82, 0xc9, 0x7e, 0xe8, 0xe2, 0xc9, 0xd7, 0xb0, 0xa2,
-	0x4c, 0x9a, 0x94, 0x2f, 0xd6, 0xc2, 0xfe, 0xb7, 0xaa, 0x01, 0x65, 0xe8, 0x83, 0xc6, 0xde, 0xdf,
-	0x16, 0x60, 0x55, 0x6c, 0xdd, 0xeb, 0x91, 0xfd, 0xcd, 0xe7, 0xae, 0xe2, 0x2b, 0x58, 0x1d, 0xa3,
-	0x76, 0xf6, 0xd2, 0x97, 0x2c, 0xc3, 0xf2, 0xeb, 0x7b, 0x1e, 0x80, 0x35, 0x46, 0x6e, 0x16, 0x3e,
-	0x23, 0x8f, 0xef, 0x38, 0x12, 0x77, 0x27, 0xe1, 0x33, 0x76, 0x71, 0xff, 0xf1, 0xbb, 0x47, 0x12,
-	0xdd, 0xbd, 0xc2, 0xc9, 0xae, 0x17, 0x26, 0x99, 0xbf, 0x3b, 0x49, 0xf4, 0x57, 0xc3, 0xcb, 0x15,
-	0xf9, 0xfb, 0xf3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x41, 0x6b, 0x31, 0xda, 0xe0, 0x14, 0x00,
-	0x00,
+	// 2363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4d, 0x73, 0x1c, 0x47,
+	0x19, 0xde, 0x95, 0x56, 0xf2, 0xee, 0xbb, 0x9f, 0x6e, 0x49, 0xf6, 0x5a, 0x2e, 0x27, 0xca, 0x84,
+	0xa4, 0x5c, 0x04, 0xe4, 0x2a, 0x41, 0x15, 0xa9, 0x38, 0x40, 0xc9, 0x2b, 0x25, 0x12, 0x58, 0xb2,
+	0x33, 0xbb, 0x22, 0x1f, 0x97, 0x61, 0x34, 0xf3, 0x6a, 0x3d, 0x68, 0xbe, 0xd2, 0xdd, 0x23, 0x5b,
+	0x1c, 0x38, 0x71, 0xe4, 0xc4, 0x89, 0x23, 0x27, 0xae, 0x1c, 0x81, 0xdf, 0x03, 0x7f, 0x80, 0x7f,
+	0x40, 0xf5, 0xc7, 0xec, 0xf6, 0xec, 0xcc, 0x46, 0x2a, 0x9c, 0x93, 0xb6, 0x9f, 0x7e, 0xde, 0xa7,
+	0x7b, 0xba, 0xdf, 0x7e, 0xde, 0x9e, 0x11, 0x6c, 0x5f, 0xe0, 0xf4, 0x49, 0x4a, 0x13, 0x9e, 0xb0,
+	0x27, 0x51, 0xe2, 0x5d, 0x3a, 0x5e, 0x42, 0x71, 0x57, 0x02, 0xa4, 0x15, 0xb9, 0xd3, 0xc8, 0xdd,
+	0xbd, 0xc0, 0xe9, 0xf6, 0x83, 0x84, 0x7a, 0x1f, 0xd3, 0x9c, 0xe8, 0x25, 0x51, 0x94, 0xc4, 0x8a,
+	0xb5, 0xbd, 0x65, 0x28, 0x78, 0xec, 0xe2, 0x5c, 0xc3, 0x0f, 0x42, 0x8e, 0x39, 0x9c, 0x26, 0x61,
+	0xe0, 0x5d, 0xfb, 0x79, 0xd7, 0x8e, 0xd1, 0xc5, 0x90, 0xb1, 0x20, 0x89, 0x9d, 0xc8, 0x8d, 0xdd,
+	0x29, 0x52, 0xcd, 0x78, 0x64, 0x32, 0xb2, 0x73, 0xe6, 0xd1, 0xe0, 0x1c, 0x69, 0x2e, 0x60, 0xfd,
+	0xa5, 0x0d, 0x6b, 0x36, 0xa6, 0xe1, 0x35, 0x39, 0x82, 0x3e, 0x43, 0x7a, 0x85, 0xd4, 0x39, 0xc7,
+	0x57, 0xee, 0x55, 0x90, 0xd0, 0x61, 0x7d, 0xa7, 0xfe, 0xb8, 0xb7, 0xf7, 0xee, 0xee, 0x6c, 0xf2,
+	0xbb, 0x92, 0xba, 0x3b, 0x96, 0xbc, 0x67, 0x9a, 0x66, 0xf7, 0x58, 0xa1, 0x4d, 0xde, 0x85, 0x36,
+	0x15, 0x3c, 0xc7, 0xc7, 0xd0, 0xbd, 0x1e, 0xae, 0xec, 0xd4, 0x1f, 0xaf, 0xd9, 0x20, 0xa1, 0x03,
+	0x81, 0x90, 0x5f, 0x40, 0xd7, 0x0d, 0x91, 0x72, 0x87, 0xe2, 0xb7, 0x19, 0x32, 0x3e, 0x5c, 0xdd,
+	0xa9, 0x3f, 0x6e, 0xef, 0xdd, 0x37, 0x06, 0xda, 0x17, 0xfd, 0xb6, 0xea, 0x3e, 0xaa, 0xd9, 0x1d,
+	0xd7, 0x68, 0x93, 0x5f, 0xc1, 0x5d, 0x3f, 0x79, 0x1d, 0x87, 0x41, 0x7c, 0xe9, 0x64, 0x71, 0xc0,
+	0x7d, 0x97, 0xbb, 0xc3, 0x86, 0xd4, 0x78, 0x68, 0x68, 0x1c, 0x68, 0xce, 0x99, 0xa6, 0x1c, 0xd5,
+	0xec, 0x81, 0xbf, 0x80, 0x91, 0x5f, 0x42, 0x0f, 0x53, 0xe6, 0xf8, 0xc8, 0x5d, 0xef, 0x95, 0xe3,
+	0x7a, 0x97, 0xc3, 0xb5, 0xd2, 0x64, 0x0e, 0x5f, 0x8e, 0x0f, 0x64, 0xff, 0xbe, 0x77, 0x29, 0x26,
+	0x83, 0x29, 0x9b, 0xb5, 0xc9, 0x33, 0xe8, 0x07, 0x11, 0x0b, 0x4c, 0x85, 0x75, 0xa9, 0x30, 0x34,
+	0x14, 0x8e, 0x4f, 0xc6, 0xc7, 0xa6, 0x44, 0x57, 0x84, 0xcc, 0x35, 0xbe, 0x84, 0x7b, 0x61, 0xe2,
+	0xb9, 0x5c, 0x6c, 0x5f, 0x96, 0xfa, 0x2e, 0x47, 0xc7, 0xf5, 0x3c, 0x4c, 0xf9, 0xf0, 0x8e, 0x94,
+	0x32, 0xb7, 0xe0, 0xb9, 0x26, 0x9e, 0x49, 0xde, 0xbe, 0xa4, 0x1d, 0xd5, 0xec, 0xcd, 0xb0, 0x02,
+	0xaf, 0x12, 0xa6, 0xf8, 0x3b, 0xf4, 0xf8, 0xb0, 0x79, 0x83, 0xb0, 0x2d, 0x69, 0x65, 0x61, 0x85,
+	0x0b, 0xe1, 0x28, 0x72, 0x82, 0xf8, 0x22, 0xa1, 0x91, 0x92, 0xcf, 0xf7, 0xb2, 0x55, 0x12, 0x3e,
+	0x39, 0x39, 0x9e, 0xf3, 0xe6, 0x7b, 0xba, 0x19, 0x45, 0x65, 0x9c, 0xec, 0x43, 0x2f, 0x75, 0xa7,
+	0x41, 0x3c, 0x9d, 0x09, 0x42, 0x69, 0x35, 0x5f, 0x4a, 0xc2, 0x5c, 0xa9, 0x9b, 0x9a, 0x00, 0x39,
+	0x80, 0x3e, 0xc5, 0x10, 0x5d, 0x86, 0x33, 0x8d, 0xb6, 0xd4, 0x78, 0x50, 0xc8, 0x64, 0xc9, 0x98,
+	0x8b, 0xf4, 0x68, 0x01, 0x21, 0x13, 0xd8, 0x12, 0x79, 0x1d, 0x78, 0xe8, 0xb8, 0xe7, 0x89, 0x91,
+	0xac, 0x1d, 0xa9, 0xf5, 0x8e, 0xa1, 0x35, 0x56, 0xbc, 0x7d, 0x41, 0x9b, 0x0b, 0x6e, 0xb0, 0x32,
+	0x4c, 0xf6, 0xa0, 0x45, 0x91, 0x21, 0x97, 0x79, 0xd2, 0x95, 0x4a, 0x1b, 0x85, 0x59, 0x31, 0xe4,
+	0x2a, 0x45, 0x9a, 0x54, 0xff, 0x26, 0x9f, 0xc3, 0x40, 0xc5, 0x04, 0xb1, 0x1f, 0xa8, 0xbd, 0x18,
+	0xf6, 0x64, 0xe8, 0xf6, 0x62, 0xe8, 0xf1, 0x8c, 0x71, 0x54, 0xb3, 0xfb, 0xb4, 0x08, 0x91, 0x8f,
+	0x60, 0x9d, 0x71, 0x97, 0x67, 0x6c, 0xd8, 0x97, 0xe1, 0x77, 0xcd, 0x67, 0x90, 0x1d, 0x47, 0x35,
+	0x5b, 0x53, 0xc8, 0x37, 0x70, 0xdf, 0xa3, 0x28, 0x32, 0x26, 0x37, 0x16, 0x8a, 0x2c, 0x4d, 0x62,
+	0x86, 0xc3, 0x81, 0x8c, 0xde, 0xd1, 0xd1, 0x21, 0xc7, 0xdd, 0x91, 0x64, 0x8e, 0x15, 0xd1, 0xd6,
+	0xbc, 0xa3, 0xba, 0xbd, 0xe5, 0x55, 0x75, 0x08, 0x6d, 0x9d, 0x8d, 0x25, 0xed, 0xbb, 0x25, 0x6d,
+	0x95, 0x77, 0x15, 0xda, 0x59, 0x55, 0x07, 0xf1, 0x60, 0x3b, 0x17, 0xe5, 0x48, 0xa3, 0x20, 0x56,
+	0x49, 0xaf, 0xe5, 0x89, 0x94, 0x7f, 0xdf, 0x90, 0xd7, 0xf1, 0x93, 0x9c, 0x6b, 0x8c, 0x30, 0x64,
+	0x4b, 0xfa, 0xac, 0x11, 0xf4, 0x8a, 0x26, 0x48, 0x36, 0xa0, 0x6f, 0x1f, 0xbe, 0x7c, 0xfe, 0xb5,
+	0x73, 0x7c, 0x3a, 0x9e, 0xec, 0x9f, 0x4e, 0x9e, 0x7f, 0x3d, 0xa8, 0x91, 0x1e, 0x80, 0x02, 0x9f,
+	0xef, 0x4f, 0x0e, 0x07, 0x75, 0xd2, 0x81, 0xe6, 0xe9, 0x0b, 0x47, 0x42, 0x83, 0x95, 0x67, 0x5d,
+	0x68, 0xb3, 0x29, 0x73, 0x22, 0x64, 0xcc, 0x9d, 0xe2, 0xb3, 0x1e, 0x74, 0xa6, 0x6f, 0xa6, 0xd7,
+	0x79, 0xdb, 0xfa, 0x07, 0x40, 0xff, 0xf0, 0x4d, 0x8a, 0x1e, 0x47, 0xdf, 0x48, 0x1f, 0xe5, 0x9c,
+	0x22, 0x7d, 0xea, 0xa5, 0xf4, 0x91, 0xae, 0xa9, 0xd3, 0xc7, 0xd5, 0xbf, 0xc9, 0x53, 0xe8, 0xe4,
+	0x6e, 0x2b, 0x4f, 0xfe, 0x8a, 0x0c, 0xbb, 0x57, 0x36, 0x5b, 0x7d, 0xe0, 0xdb, 0xee, 0xbc, 0x29,
+	0x4e, 0x81, 0x61, 0x8f, 0x46, 0x02, 0xae, 0x96, 0x4e, 0xc1, 0xcc, 0x25, 0x0b, 0x49, 0xb8, 0x31,
+	0x33, 0xcb, 0x39, 0x2c, 0xdc, 0xc3, 0xf4, 0x4c, 0x43, 0xb6, 0x51, 0x72, 0x8f, 0xb9, 0x75, 0x16,
+	0x74, 0x37, 0xe7, 0x0e, 0x6a, 0x08, 0x7f, 0x03, 0xf7, 0xcb, 0x7e, 0xa7, 0x8e, 0xed, 0x5a, 0x21,
+	0xb1, 0xaa, 0x0c, 0x2f, 0x3f, 0xb8, 0x5b, 0x61, 0x55, 0x87, 0xa8, 0x5a, 0x33, 0x67, 0x92, 0x0b,
+	0xb9, 0x5e, 0x2a, 0x14, 0xb9, 0x31, 0xe9, 0x95, 0xec, 0xa4, 0x46, 0x5b, 0xd8, 0x52, 0x6e, 0x28,
+	0xf9, 0x9c, 0xee, 0x94, 0x6c, 0x49, 0x5b, 0x89, 0x61, 0x4b, 0xac, 0x80, 0x88, 0xf4, 0xe6, 0x62,
+	0xe9, 0x28, 0xba, 0xe1, 0xec, 0x51, 0xbd, 0x24, 0x4a, 0x43, 0xe4, 0x38, 0x6c, 0x16, 0xd2, 0x5b,
+	0x08, 0x4e, 0x4e, 0xc6, 0xc7, 0xb6, 0xc1, 0x1d, 0x69, 0xea, 0x51, 0xcd, 0x1e, 0x0a, 0xa1, 0xaa,
+	0x3e, 0xb1, 0x3f, 0x99, 0x28, 0x41, 0x3c, 0xb8, 0x0a, 0xf8, 0xb5, 0xb9, 0x3f, 0x65, 0x77, 0x3f,
+	0x3b, 0xdc, 0xd7, 0xbc, 0xe2, 0xfe, 0x64, 0x58, 0xc6, 0x85, 0xbb, 0x67, 0xe8, 0x64, 0x31, 0x45,
+	0xd7, 0x7b, 0xe5, 0x9e, 0x87, 0x58, 0xe1, 0xee, 0x67, 0x87, 0x67, 0xf3, 0x7e, 0xe1, 0xee, 0x19,
+	0x1a, 0x80, 0x58, 0xc6, 0x2c, 0x2d, 0x96, 0xfe, 0xb2, 0xbb, 0x9f, 0xa5, 0x0b, 0x85, 0xbf, 0x97,
+	0x15, 0x90, 0xa2, 0x0f, 0x77, 0xfe, 0x7f, 0x1f, 0xee, 0xbe, 0x9d, 0x0f, 0xf7, 0x6e, 0xf6, 0xe1,
+	0x2f, 0xe1, 0x5e, 0xc9, 0x87, 0x55, 0xf6, 0xf4, 0x0b, 0x7b, 0x51, 0x61, 0xc3, 0x2a, 0x87, 0xea,
+	0xf6, 0xa6, 0x57, 0x81, 0xcb, 0x4d, 0x5e, 0x34, 0x61, 0x25, 0x3c, 0x28, 0x09, 0x2f, 0x78, 0xf0,
+	0x4c, 0x38, 0xab, 0xc0, 0xc9, 0x6f, 0xe1, 0x41, 0x95, 0x03, 0x2b, 0x6d, 0xe5, 0xef, 0xd6, 0x77,
+	0x1a, 0x70, 0x2e, 0x7f, 0x9f, 0x55, 0x77, 0xdd, 0xe4, 0x9c, 0x21, 0x74, 0x34, 0x53, 0x5d, 0x6d,
+	0x7f, 0x0a, 0x77, 0xf2, 0xe1, 0xeb, 0xa5, 0xfd, 0x5a, 0xb0, 0x58, 0x3b, 0xa7, 0x92, 0x0f, 0x61,
+	0x4d, 0xde, 0x59, 0xb5, 0x61, 0x0e, 0x16, 0xaf, 0xc1, 0xb6, 0xea, 0xb6, 0xc6, 0xb0, 0xa1, 0x6a,
+	0xc1, 0x28, 0x89, 0x2f, 0x82, 0x69, 0x46, 0xd5, 0x26, 0x7f, 0x0a, 0x5d, 0xad, 0xe4, 0x28, 0x99,
+	0xfa, 0xce, 0xea, 0x82, 0x5d, 0x98, 0x93, 0xb4, 0x3b, 0xd4, 0x68, 0x59, 0x7f, 0x80, 0xd6, 0x8b,
+	0xd1, 0x58, 0x29, 0x92, 0x0f, 0xa1, 0x1f, 0xb9, 0x6f, 0x9c, 0x4c, 0x3c, 0x9c, 0x73, 0x7e, 0xcd,
+	0x91, 0xc9, 0xe7, 0xe8, 0xda, 0xdd, 0xc8, 0x7d, 0x73, 0x26, 0x97, 0x40, 0x80, 0xe4, 0x07, 0xd0,
+	0x9b, 0xf3, 0x78, 0x10, 0xa1, 0x9c, 0x7a, 0xd7, 0xee, 0xe4, 0xb4, 0x49, 0x10, 0x21, 0x79, 0x1f,
+	0xba, 0x57, 0x6e, 0x18, 0xf8, 0xe2, 0x64, 0x4b, 0xd2, 0xaa, 0x22, 0xe5, 0xa0, 0x20, 0x59, 0xff,
+	0xaa, 0x03, 0x8c, 0x28, 0xfa, 0x01, 0x17, 0x77, 0x34, 0x42, 0xa0, 0x21, 0xfc, 0x56, 0x0e, 0xdb,
+	0xb2, 0xe5, 0x6f, 0xf2, 0x1e, 0x74, 0xbc, 0x57, 0x2e, 0x95, 0x8e, 0x78, 0x89, 0xd7, 0x7a, 0xac,
+	0x76, 0x8e, 0xfd, 0x1a, 0xaf, 0xc9, 0x3d, 0x58, 0xbf, 0x4a, 0xc2, 0x4c, 0x8f, 0xd1, 0xb0, 0x75,
+	0x8b, 0x3c, 0x85, 0x96, 0x38, 0xbc, 0x0e, 0xbf, 0x4e, 0x51, 0x5a, 0x7e, 0xaf, 0x50, 0x49, 0xe6,
+	0x03, 0xef, 0x8a, 0x03, 0x3b, 0xb9, 0x4e, 0xd1, 0x6e, 0x66, 0xfa, 0x97, 0xf5, 0x2e, 0x34, 0x73,
+	0x94, 0xb4, 0x60, 0x4d, 0x3e, 0xfa, 0xa0, 0x46, 0x9a, 0xd0, 0x10, 0x33, 0x1f, 0xd4, 0xad, 0x43,
+	0xb1, 0xfd, 0xfb, 0x19, 0x7f, 0x35, 0x71, 0xe9, 0x14, 0xf9, 0xb2, 0xc9, 0x8b, 0x7d, 0x8a, 0xa7,
+	0xce, 0x94, 0x26, 0x59, 0x9a, 0x4f, 0x5e, 0x61, 0x9f, 0x0b, 0xc8, 0x3a, 0xcd, 0x65, 0xf6, 0x63,
+	0xf6, 0x1a, 0x29, 0x79, 0x04, 0x90, 0xa7, 0x75, 0xe0, 0x6b, 0xb1, 0x96, 0x46, 0x8e, 0x7d, 0xf5,
+	0xd6, 0xc3, 0xb2, 0x90, 0x3b, 0x5e, 0xe2, 0xe7, 0x2b, 0x0f, 0x0a, 0x1a, 0x25, 0x3e, 0x5a, 0x7f,
+	0xaf, 0x43, 0x67, 0xdf, 0xf3, 0x92, 0x2c, 0xe6, 0x76, 0x16, 0x22, 0xab, 0x9c, 0xd7, 0x23, 0x00,
+	0x9a, 0x85, 0xe8, 0xc4, 0x6e, 0x84, 0x6c, 0xb8, 0xb2, 0xb3, 0x2a, 0x06, 0x11, 0xc8, 0xa9, 0x00,
+	0x44, 0x26, 0xc8, 0xee, 0x73, 0x97, 0xe5, 0x9c, 0x55, 0xc9, 0xe9, 0x0a, 0xf8, 0x99, 0xcb, 0x34,
+	0xef, 0x00, 0x06, 0x92, 0xe7, 0xe3, 0x45, 0x10, 0x07, 0x22, 0x1f, 0xd9, 0xb0, 0xb1, 0xb3, 0xba,
+	0xe0, 0x92, 0x62, 0x1a, 0x07, 0x33, 0x86, 0xdd, 0xa7, 0x85, 0x36, 0xb3, 0xfe, 0xbc, 0x0a, 0xbd,
+	0x22, 0x87, 0xfc, 0x08, 0x88, 0xde, 0x60, 0x74, 0x66, 0x13, 0xd5, 0x4f, 0x30, 0xc8, 0x7b, 0x6c,
+	0x3d, 0xdf, 0x5b, 0xac, 0x32, 0x79, 0x07, 0x20, 0xa5, 0xe8, 0xa1, 0x8f, 0xb1, 0x97, 0xa7, 0xa2,
+	0x81, 0x90, 0x0f, 0xa0, 0x17, 0x25, 0x71, 0xc0, 0x13, 0x9a, 0xe7, 0x59, 0x43, 0x0e, 0xd6, 0x9d,
+	0xa3, 0x22, 0xd3, 0x3e, 0x82, 0xbb, 0x17, 0x61, 0xf2, 0xda, 0xf1, 0x51, 0xbc, 0xe1, 0xa6, 0xea,
+	0x89, 0xd7, 0xe4, 0xd2, 0x0c, 0x44, 0xc7, 0x81, 0x81, 0xcf, 0xc8, 0xc6, 0xeb, 0x0b, 0x1b, 0xae,
+	0xcf, 0xc9, 0xc6, 0x6b, 0x09, 0x23, 0x5f, 0xc0, 0xa6, 0x48, 0x47, 0x8a, 0x1e, 0x37, 0x03, 0x74,
+	0xed, 0x7e, 0xc7, 0x30, 0x32, 0x5b, 0xd3, 0x8c, 0x70, 0x7b, 0x83, 0x96, 0x41, 0xf2, 0x14, 0xfa,
+	0xdf, 0x26, 0xac, 0xa0, 0xa6, 0x0a, 0x37, 0x31, 0xd4, 0x3e, 0x0b, 0x93, 0xd7, 0x5f, 0x24, 0xcc,
+	0xee, 0x7d, 0x9b, 0x30, 0x23, 0xd8, 0xba, 0x86, 0x81, 0x3c, 0xcb, 0x27, 0xea, 0xf9, 0x97, 0x1e,
+	0xcf, 0x2f, 0x60, 0x4b, 0x19, 0x81, 0x5e, 0x28, 0xc7, 0x93, 0xa7, 0x4a, 0x25, 0x55, 0x7b, 0xef,
+	0x91, 0x59, 0x2d, 0x0d, 0x3d, 0x75, 0xf6, 0xec, 0x8d, 0xac, 0x84, 0x31, 0xeb, 0x8f, 0x2b, 0x40,
+	0xca, 0xdc, 0x8a, 0x2d, 0xaa, 0x57, 0x6d, 0xd1, 0x57, 0x30, 0x30, 0x68, 0x21, 0x5e, 0x61, 0x28,
+	0x13, 0xa2, 0xb7, 0xf7, 0xe3, 0xef, 0x9c, 0xcb, 0xee, 0xc9, 0x2c, 0xea, 0xb9, 0x08, 0xb2, 0xfb,
+	0x51, 0x11, 0x90, 0x69, 0x86, 0x3c, 0xa3, 0xb1, 0x36, 0x47, 0x65, 0x36, 0x6d, 0x85, 0x29, 0x6b,
+	0x9c, 0x3b, 0x51, 0xc3, 0x74, 0x22, 0x6b, 0x0f, 0xfa, 0x0b, 0xf2, 0x64, 0x00, 0x1d, 0x5d, 0x92,
+	0x64, 0x7b, 0x50, 0x23, 0x5d, 0x68, 0x89, 0x94, 0x56, 0xcd, 0xba, 0xf5, 0x7b, 0x18, 0x1c, 0x5e,
+	0x61, 0xcc, 0x27, 0x34, 0x98, 0x4e, 0x71, 0xf9, 0x0e, 0x7c, 0x00, 0x3d, 0x14, 0x3c, 0x87, 0x2b,
+	0xa2, 0x5a, 0xfa, 0xae, 0xdd, 0x45, 0x23, 0x5a, 0x66, 0x23, 0x45, 0x69, 0xbe, 0xea, 0x32, 0x37,
+	0xf3, 0xe4, 0x86, 0x3d, 0x30, 0x3b, 0xa4, 0x2f, 0xff, 0xb5, 0x0e, 0x3d, 0xe5, 0x47, 0x2f, 0xae,
+	0x90, 0xd2, 0xc0, 0xc7, 0xa5, 0xf6, 0xa6, 0x8b, 0x8f, 0xf4, 0xd8, 0xfc, 0xe0, 0x29, 0x4c, 0x5a,
+	0xe7, 0x82, 0x5f, 0xad, 0x2e, 0xfa, 0x15, 0xb1, 0xa0, 0x83, 0x6f, 0x52, 0xa4, 0x41, 0x84, 0x31,
+	0x77, 0x43, 0xb9, 0x70, 0x4d, 0xbb, 0x80, 0x89, 0xb1, 0x7d, 0x9a, 0xa4, 0xf2, 0x72, 0xdd, 0xb4,
+	0xe5, 0x6f, 0xeb, 0xdf, 0x75, 0x68, 0xdb, 0xe8, 0x61, 0x70, 0x85, 0xfe, 0x68, 0x64, 0xdf, 0xe4,
+	0x9b, 0xb7, 0x98, 0xea, 0x07, 0xd0, 0xcb, 0x29, 0x71, 0x16, 0x9d, 0x23, 0xd5, 0xb3, 0xcd, 0x0b,
+	0xec, 0xa9, 0x04, 0x2b, 0xd6, 0xbb, 0x51, 0xb5, 0xde, 0x07, 0xd0, 0x2b, 0x1c, 0x0c, 0xe5, 0x13,
+	0xcb, 0x4f, 0x84, 0x8d, 0xa9, 0x78, 0x81, 0xef, 0x9a, 0x27, 0x82, 0x59, 0x7f, 0xab, 0x03, 0x29,
+	0xb3, 0x6e, 0x7b, 0x16, 0xde, 0x83, 0x4e, 0x10, 0xa7, 0x19, 0x77, 0x12, 0x8f, 0xa3, 0x3c, 0x93,
+	0x32, 0x63, 0x25, 0xf6, 0x42, 0x42, 0xa2, 0x4c, 0x27, 0x19, 0x37, 0x38, 0x2a, 0x25, 0x3a, 0x0a,
+	0xd4, 0xa4, 0xf7, 0xa0, 0xc3, 0x13, 0xee, 0x86, 0x39, 0x47, 0x25, 0x77, 0x5b, 0x62, 0x8a, 0x62,
+	0x7d, 0x02, 0x1d, 0x63, 0x37, 0x18, 0xf9, 0x21, 0x34, 0x3c, 0x8f, 0x32, 0x7d, 0x1d, 0xb9, 0x57,
+	0xb8, 0x8e, 0xcc, 0x68, 0xb6, 0xe4, 0x58, 0xff, 0x59, 0x05, 0xf2, 0x52, 0x7e, 0x71, 0xbc, 0xb1,
+	0xa0, 0xea, 0xca, 0xc4, 0x1c, 0x9e, 0x38, 0x14, 0xa3, 0xe4, 0x0a, 0x87, 0x2b, 0xf3, 0xca, 0xc4,
+	0x26, 0x89, 0x2d, 0x41, 0xf2, 0x31, 0x3c, 0x58, 0xa8, 0x60, 0x46, 0x84, 0xaa, 0x65, 0x5b, 0x85,
+	0x5a, 0x36, 0x8b, 0x7c, 0xac, 0x6a, 0x9a, 0xe4, 0x07, 0x31, 0xe3, 0x6e, 0x18, 0xca, 0x0d, 0x6e,
+	0xd9, 0x3d, 0x3d, 0xc4, 0xb1, 0x42, 0xc9, 0x27, 0xb0, 0x5d, 0x31, 0x46, 0x1e, 0xa3, 0xaa, 0xc2,
+	0xbd, 0x85, 0x41, 0xf2, 0xd8, 0xaf, 0xe0, 0xe1, 0x62, 0xe5, 0x34, 0x83, 0xd7, 0x6f, 0x2a, 0xa2,
+	0xc3, 0x85, 0x22, 0x3a, 0x57, 0x2e, 0xe7, 0xdd, 0x9d, 0xdb, 0x38, 0x71, 0x31, 0xef, 0x2a, 0x92,
+	0xbc, 0x79, 0x6b, 0x53, 0x69, 0x2d, 0x31, 0x95, 0xd7, 0xc5, 0x5d, 0xfe, 0x7e, 0xee, 0x3b, 0x22,
+	0x37, 0x2f, 0xdc, 0x20, 0x44, 0x5f, 0x5e, 0x14, 0xf2, 0x8b, 0x4a, 0x5b, 0x61, 0xf2, 0x06, 0x64,
+	0x3d, 0x06, 0x22, 0xbf, 0x8e, 0xe5, 0xaf, 0x00, 0x4b, 0xd3, 0xcb, 0x9a, 0x14, 0x99, 0xdf, 0xcf,
+	0x14, 0xf7, 0xfe, 0x54, 0x87, 0xcd, 0x93, 0xc4, 0xbb, 0x1c, 0x25, 0x14, 0xe7, 0xb7, 0xf7, 0x84,
+	0x92, 0x11, 0x74, 0x54, 0x5b, 0xdd, 0xec, 0xc9, 0xe2, 0xd7, 0xbe, 0x85, 0xcb, 0xfe, 0x76, 0xfe,
+	0x06, 0x27, 0xbf, 0xed, 0xef, 0xfe, 0x26, 0x09, 0x7c, 0xab, 0x46, 0x9e, 0x88, 0x4f, 0xeb, 0x0c,
+	0x39, 0x29, 0xf7, 0x56, 0x06, 0xec, 0xfd, 0x73, 0x05, 0xee, 0x88, 0xe9, 0xbc, 0x18, 0x8d, 0xc9,
+	0x53, 0xf1, 0x85, 0x89, 0xbf, 0x18, 0x8d, 0xc7, 0xc8, 0xc5, 0x6d, 0x89, 0x91, 0x4d, 0x63, 0x0e,
+	0xb3, 0x77, 0x83, 0xea, 0x91, 0x7f, 0x06, 0xad, 0x31, 0x72, 0x5d, 0x9e, 0xb7, 0x2a, 0x6f, 0xd6,
+	0xd5, 0x81, 0x3f, 0x87, 0xae, 0x7a, 0x87, 0xd4, 0x17, 0x55, 0x72, 0xdf, 0x7c, 0x51, 0x9b, 0xfd,
+	0xfb, 0xe0, 0xf8, 0xa0, 0x3a, 0xfc, 0x13, 0x18, 0x8c, 0x42, 0x74, 0xe9, 0x9c, 0xc9, 0x6e, 0xfb,
+	0xf0, 0xe4, 0x53, 0x58, 0x57, 0xe9, 0x47, 0x8a, 0xaf, 0x48, 0x73, 0xdf, 0xd9, 0x2e, 0x77, 0xa8,
+	0x3c, 0xb0, 0x6a, 0x7b, 0xff, 0x6d, 0x40, 0x53, 0x2c, 0xdd, 0xcb, 0x91, 0xfd, 0xd9, 0xdb, 0x3e,
+	0xc5, 0xc7, 0xd0, 0x1c, 0xa3, 0xbe, 0xa3, 0x17, 0xfe, 0x27, 0x61, 0x5c, 0xde, 0xab, 0x23, 0x0f,
+	0x60, 0x30, 0x46, 0x7e, 0x56, 0x38, 0xb0, 0x0f, 0x97, 0x1c, 0xef, 0xe5, 0x9b, 0xf0, 0x36, 0xab,
+	0xa8, 0x66, 0x70, 0x58, 0xf0, 0x02, 0x73, 0x06, 0x8b, 0x17, 0x97, 0x6a, 0x95, 0x11, 0xdc, 0x1d,
+	0x23, 0x5f, 0xb8, 0x67, 0x98, 0xa6, 0x57, 0xec, 0xaa, 0x16, 0x39, 0x84, 0xfe, 0xe7, 0xc8, 0x0b,
+	0xb5, 0x67, 0xe9, 0x3e, 0xdc, 0xaf, 0x2e, 0x43, 0xcc, 0xaa, 0x91, 0xa3, 0x59, 0x5e, 0x98, 0x46,
+	0x59, 0xae, 0x4a, 0xdb, 0xcb, 0xba, 0xf3, 0x1c, 0x21, 0xa7, 0xd0, 0x31, 0x3d, 0xa4, 0xa0, 0x57,
+	0xb6, 0xa1, 0xed, 0x65, 0xdd, 0xb9, 0xde, 0xb3, 0x87, 0xdf, 0x3c, 0x90, 0x8c, 0x27, 0xe2, 0xdf,
+	0x76, 0x5e, 0x98, 0x64, 0xfe, 0x93, 0x69, 0xa2, 0xff, 0xd7, 0x76, 0xbe, 0x2e, 0xff, 0xfe, 0xe4,
+	0x7f, 0x03, 0x00, 0x78, 0x6b, 0x88, 0x6a, 0x16, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1575,6 +2162,7 @@ type MockOCSClient interface {
 	SetCredit(ctx context.Context, in *CreditInfo, opts ...grpc.CallOption) (*protos1.Void, error)
 	CreateAccount(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*protos1.Void, error)
 	ClearSubscribers(ctx context.Context, in *protos1.Void, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Sends a RAR to the last session of the subscriber and returns the RAA
 	ReAuth(ctx context.Context, in *ReAuthTarget, opts ...grpc.CallOption) (*ReAuthAnswer, error)
 }
 
@@ -1637,6 +2225,7 @@ type MockOCSServer interface {
 	SetCredit(context.Context, *CreditInfo) (*protos1.Void, error)
 	CreateAccount(context.Context, *protos.SubscriberID) (*protos1.Void, error)
 	ClearSubscribers(context.Context, *protos1.Void) (*protos1.Void, error)
+	// Sends a RAR to the last session of the subscriber and returns the RAA
 	ReAuth(context.Context, *ReAuthTarget) (*ReAuthAnswer, error)
 }
 
@@ -1791,6 +2380,16 @@ type MockPCRFClient interface {
 	SetRules(ctx context.Context, in *AccountRules, opts ...grpc.CallOption) (*protos1.Void, error)
 	SetUsageMonitors(ctx context.Context, in *UsageMonitorInfo, opts ...grpc.CallOption) (*protos1.Void, error)
 	ClearSubscribers(ctx context.Context, in *protos1.Void, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Sets the event triggers & revalidation time sent in the CCA-I of the subscriber
+	SetEventTriggers(ctx context.Context, in *EventTriggerInfo, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Overrides the answers to the CCRs of the subscriber
+	SetAnswerOverride(ctx context.Context, in *AnswerOverride, opts ...grpc.CallOption) (*protos1.Void, error)
+	// Returns the CCRs received for the subscriber, oldest first
+	GetReceivedCCRs(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*ReceivedCCRs, error)
+	// Sends a RAR to the last session of the subscriber and returns the RAA
+	ReAuth(ctx context.Context, in *PolicyReAuthTarget, opts ...grpc.CallOption) (*PolicyReAuthAnswer, error)
+	// Sends an ASR to the last session of the subscriber and returns the ASA
+	AbortSession(ctx context.Context, in *AbortSessionTarget, opts ...grpc.CallOption) (*AbortSessionAnswer, error)
 }
 
 type mockPCRFClient struct {
@@ -1837,12 +2436,67 @@ func (c *mockPCRFClient) ClearSubscribers(ctx context.Context, in *protos1.Void,
 	return out, nil
 }
 
+func (c *mockPCRFClient) SetEventTriggers(ctx context.Context, in *EventTriggerInfo, opts ...grpc.CallOption) (*protos1.Void, error) {
+	out := new(protos1.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.MockPCRF/SetEventTriggers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockPCRFClient) SetAnswerOverride(ctx context.Context, in *AnswerOverride, opts ...grpc.CallOption) (*protos1.Void, error) {
+	out := new(protos1.Void)
+	err := c.cc.Invoke(ctx, "/magma.feg.MockPCRF/SetAnswerOverride", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockPCRFClient) GetReceivedCCRs(ctx context.Context, in *protos.SubscriberID, opts ...grpc.CallOption) (*ReceivedCCRs, error) {
+	out := new(ReceivedCCRs)
+	err := c.cc.Invoke(ctx, "/magma.feg.MockPCRF/GetReceivedCCRs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockPCRFClient) ReAuth(ctx context.Context, in *PolicyReAuthTarget, opts ...grpc.CallOption) (*PolicyReAuthAnswer, error) {
+	out := new(PolicyReAuthAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.MockPCRF/ReAuth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mockPCRFClient) AbortSession(ctx context.Context, in *AbortSessionTarget, opts ...grpc.CallOption) (*AbortSessionAnswer, error) {
+	out := new(AbortSessionAnswer)
+	err := c.cc.Invoke(ctx, "/magma.feg.MockPCRF/AbortSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MockPCRFServer is the server API for MockPCRF service.
 type MockPCRFServer interface {
 	CreateAccount(context.Context, *protos.SubscriberID) (*protos1.Void, error)
 	SetRules(context.Context, *AccountRules) (*protos1.Void, error)
 	SetUsageMonitors(context.Context, *UsageMonitorInfo) (*protos1.Void, error)
 	ClearSubscribers(context.Context, *protos1.Void) (*protos1.Void, error)
+	// Sets the event triggers & revalidation time sent in the CCA-I of the subscriber
+	SetEventTriggers(context.Context, *EventTriggerInfo) (*protos1.Void, error)
+	// Overrides the answers to the CCRs of the subscriber
+	SetAnswerOverride(context.Context, *AnswerOverride) (*protos1.Void, error)
+	// Returns the CCRs received for the subscriber, oldest first
+	GetReceivedCCRs(context.Context, *protos.SubscriberID) (*ReceivedCCRs, error)
+	// Sends a RAR to the last session of the subscriber and returns the RAA
+	ReAuth(context.Context, *PolicyReAuthTarget) (*PolicyReAuthAnswer, error)
+	// Sends an ASR to the last session of the subscriber and returns the ASA
+	AbortSession(context.Context, *AbortSessionTarget) (*AbortSessionAnswer, error)
 }
 
 // UnimplementedMockPCRFServer can be embedded to have forward compatible implementations.
@@ -1861,6 +2515,21 @@ func (*UnimplementedMockPCRFServer) SetUsageMonitors(ctx context.Context, req *U
 func (*UnimplementedMockPCRFServer) ClearSubscribers(ctx context.Context, req *protos1.Void) (*protos1.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearSubscribers not implemented")
 }
+func (*UnimplementedMockPCRFServer) SetEventTriggers(ctx context.Context, req *EventTriggerInfo) (*protos1.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEventTriggers not implemented")
+}
+func (*UnimplementedMockPCRFServer) SetAnswerOverride(ctx context.Context, req *AnswerOverride) (*protos1.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAnswerOverride not implemented")
+}
+func (*UnimplementedMockPCRFServer) GetReceivedCCRs(ctx context.Context, req *protos.SubscriberID) (*ReceivedCCRs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceivedCCRs not implemented")
+}
+func (*UnimplementedMockPCRFServer) ReAuth(ctx context.Context, req *PolicyReAuthTarget) (*PolicyReAuthAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReAuth not implemented")
+}
+func (*UnimplementedMockPCRFServer) AbortSession(ctx context.Context, req *AbortSessionTarget) (*AbortSessionAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortSession not implemented")
+}
 
 func RegisterMockPCRFServer(s *grpc.Server, srv MockPCRFServer) {
 	s.RegisterService(&_MockPCRF_serviceDesc, srv)
@@ -1938,6 +2607,96 @@ func _MockPCRF_ClearSubscribers_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MockPCRF_SetEventTriggers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventTriggerInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockPCRFServer).SetEventTriggers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.MockPCRF/SetEventTriggers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockPCRFServer).SetEventTriggers(ctx, req.(*EventTriggerInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockPCRF_SetAnswerOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockPCRFServer).SetAnswerOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.MockPCRF/SetAnswerOverride",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockPCRFServer).SetAnswerOverride(ctx, req.(*AnswerOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockPCRF_GetReceivedCCRs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.SubscriberID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockPCRFServer).GetReceivedCCRs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.MockPCRF/GetReceivedCCRs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockPCRFServer).GetReceivedCCRs(ctx, req.(*protos.SubscriberID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockPCRF_ReAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyReAuthTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockPCRFServer).ReAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.MockPCRF/ReAuth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockPCRFServer).ReAuth(ctx, req.(*PolicyReAuthTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MockPCRF_AbortSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortSessionTarget)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MockPCRFServer).AbortSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.feg.MockPCRF/AbortSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MockPCRFServer).AbortSession(ctx, req.(*AbortSessionTarget))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MockPCRF_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.feg.MockPCRF",
 	HandlerType: (*MockPCRFServer)(nil),
@@ -1958,6 +2717,26 @@ var _MockPCRF_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClearSubscribers",
 			Handler:    _MockPCRF_ClearSubscribers_Handler,
 		},
+		{
+			MethodName: "SetEventTriggers",
+			Handler:    _MockPCRF_SetEventTriggers_Handler,
+		},
+		{
+			MethodName: "SetAnswerOverride",
+			Handler:    _MockPCRF_SetAnswerOverride_Handler,
+		},
+		{
+			MethodName: "GetReceivedCCRs",
+			Handler:    _MockPCRF_GetReceivedCCRs_Handler,
+		},
+		{
+			MethodName: "ReAuth",
+			Handler:    _MockPCRF_ReAuth_Handler,
+		},
+		{
+			MethodName: "AbortSession",
+			Handler:    _MockPCRF_AbortSession_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feg/protos/mock_core.proto",
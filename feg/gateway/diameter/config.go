@@ -76,6 +76,9 @@ type DiameterClientConfig struct {
 	RetryCount         uint // number of times to reconnect after connection lost
 	SupportedVendorIDs string
 	ServiceContextId   string
+	// PeerGroup holds the servers to fail over to or load balance across
+	// along with the server the client sends its requests to, nil if none
+	PeerGroup *PeerGroupConfig
 }
 
 func (cfg *DiameterServerConfig) Validate() error {
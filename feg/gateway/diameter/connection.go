@@ -38,6 +38,9 @@ type Connection struct {
 	server   *DiameterServerConfig
 	client   *sm.Client
 	mutex    sync.Mutex
+	// onStateChange is called when the connection is established, fails to
+	// be established or is closed by the peer or the watchdog
+	onStateChange func(connected bool)
 }
 
 func newConnection(client *sm.Client, server *DiameterServerConfig) *Connection {
@@ -142,17 +145,56 @@ func (c *Connection) getDiamConnection() (diam.Conn, *smpeer.Metadata, error) {
 	}
 	conn, err := c.client.DialExt(c.server.Protocol, c.server.Addr, 0, localAddr)
 	if err != nil {
+		c.notifyStateChange(false)
 		return nil, nil, err
 	}
 	metadata, ok := smpeer.FromContext(conn.Context())
 	if !ok {
 		conn.Close()
+		c.notifyStateChange(false)
 		return nil, nil, errors.New("Could not obtain metadata from connection")
 	}
 	c.conn, c.metadata = conn, metadata
+	c.notifyStateChange(true)
+	go c.monitorConnection(conn)
 	return conn, metadata, nil
 }
 
+// monitorConnection waits for the connection to be closed by the peer or by
+// the watchdog after the peer stopped answering DWRs. The connection is then
+// dropped, so the next request dials a new one.
+func (c *Connection) monitorConnection(conn diam.Conn) {
+	closeNotifier, ok := conn.(diam.CloseNotifier)
+	if !ok {
+		return
+	}
+	<-closeNotifier.CloseNotify()
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	// the connection was already replaced or cleaned up
+	if conn != c.conn {
+		return
+	}
+	glog.Warningf("%s diameter connection from '%s' to '%s' was closed", c.server.Protocol, c.server.LocalAddr, c.server.Addr)
+	c.conn = nil
+	c.metadata = nil
+	c.notifyStateChange(false)
+}
+
+// setStateHandler sets the function to call on connection state changes
+func (c *Connection) setStateHandler(handler func(connected bool)) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.onStateChange = handler
+}
+
+// notifyStateChange must be called with the mutex held
+func (c *Connection) notifyStateChange(connected bool) {
+	if c.onStateChange != nil {
+		c.onStateChange(connected)
+	}
+}
+
 // destroyConnection closes a bad connection. If the connection
 // passed is the same as the one stored in the locked connection, it is nullified.
 // If the passed diam connection is not the same, this probably means another go routine
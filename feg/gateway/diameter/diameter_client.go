@@ -14,6 +14,7 @@ import (
 	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/fiorix/go-diameter/v4/diam"
@@ -46,6 +47,8 @@ type Client struct {
 	requestTracker *RequestTracker
 	cfg            *DiameterClientConfig
 	originStateID  uint32
+	peerGroups     map[DiameterServerConnConfig]*PeerGroup
+	peerGroupsLock sync.Mutex
 }
 
 // OriginRealm returns client's config Realm
@@ -136,6 +139,7 @@ func NewClient(clientCfg *DiameterClientConfig) *Client {
 		requestTracker: NewRequestTracker(),
 		cfg:            clientCfg,
 		originStateID:  originStateID,
+		peerGroups:     map[DiameterServerConnConfig]*PeerGroup{},
 	}
 }
 
@@ -153,13 +157,34 @@ func (client *Client) BeginConnection(server *DiameterServerConfig) error {
 		glog.Error(err)
 		return err
 	}
-	_, err := client.connMan.GetConnection(client.smClient, server)
+	var err error
+	if group := client.getPeerGroup(server); group != nil {
+		err = group.BeginConnections(client.connMan, client.smClient)
+	} else {
+		_, err = client.connMan.GetConnection(client.smClient, server)
+	}
 	if err != nil {
 		glog.Error(err)
 	}
 	return err
 }
 
+// getPeerGroup returns the peer group of the server if the client is
+// configured with peers, nil otherwise
+func (client *Client) getPeerGroup(server *DiameterServerConfig) *PeerGroup {
+	if client.cfg == nil || client.cfg.PeerGroup == nil {
+		return nil
+	}
+	client.peerGroupsLock.Lock()
+	defer client.peerGroupsLock.Unlock()
+	group, ok := client.peerGroups[server.DiameterServerConnConfig]
+	if !ok {
+		group = NewPeerGroup(server, client.cfg.PeerGroup)
+		client.peerGroups[server.DiameterServerConnConfig] = group
+	}
+	return group
+}
+
 func (client *Client) Retries() uint {
 	if client != nil && client.cfg != nil {
 		return client.cfg.RetryCount
@@ -189,7 +214,9 @@ func (client *Client) DisableConnectionCreation(period time.Duration) {
 // SendRequest sends a diameter request message to the given server and sends
 // back the answer on the given channel. A key is required to identify the
 // corresponding answer. Additionally, SendRequest will add the OriginHost/Realm
-// AVPs to the message because they are mandatory for all requests
+// AVPs to the message because they are mandatory for all requests.
+// If the client is configured with peers, the request is sent to the peer
+// group of the server instead (see PeerGroup.SendTrackedRequest)
 // Input: server - cfg containing info on what server to send to
 // 				done - channel to send the answer to when received
 //				message - request to send
@@ -202,6 +229,15 @@ func (client *Client) SendRequest(
 	key interface{},
 ) error {
 	client.requestTracker.RegisterRequest(key, done)
+	if group := client.getPeerGroup(server); group != nil {
+		m := client.AddOriginAVPsToMessage(message)
+		err := group.SendTrackedRequest(
+			client.connMan, client.smClient, m, client.cfg.RetryCount, client.requestTracker, key)
+		if err != nil {
+			client.requestTracker.DeregisterRequest(key)
+		}
+		return err
+	}
 	conn, err := client.connMan.GetConnection(client.smClient, server)
 	if err == nil {
 		m := client.AddOriginAVPsToMessage(message)
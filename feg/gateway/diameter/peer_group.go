@@ -0,0 +1,441 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/golang/glog"
+
+	"magma/feg/cloud/go/protos/mconfig"
+)
+
+// LoadBalancingMode defines how a PeerGroup picks the peer to send a request to
+type LoadBalancingMode uint8
+
+// LoadBalancingMode enum values
+const (
+	// FailoverLoadBalancing sends all requests to the first healthy peer, the
+	// following peers are only used once the ones before them fail
+	FailoverLoadBalancing LoadBalancingMode = iota
+	// SessionLoadBalancing spreads sessions across the healthy peers in
+	// proportion to their weights. All requests with the same Session-Id are
+	// sent to the same peer while it stays healthy
+	SessionLoadBalancing
+)
+
+// DefaultPeerRecoveryInterval is how long a failed peer is skipped before
+// requests are sent to it again
+const DefaultPeerRecoveryInterval = 30 * time.Second
+
+// DefaultPeerAnswerTimeout is how long a peer has to answer a request sent
+// with SendRequestAndWait or SendTrackedRequest before the request is sent to
+// the next peer
+const DefaultPeerAnswerTimeout = time.Second
+
+// DiameterPeerConfig is a server of a peer group
+type DiameterPeerConfig struct {
+	DiameterServerConfig
+	Weight uint32 // load balancing weight, 0 is treated as 1
+}
+
+// PeerGroupConfig holds the additional servers a client sends its requests to
+// along with its primary server
+type PeerGroupConfig struct {
+	Weight           uint32 // load balancing weight of the primary server, 0 is treated as 1
+	Peers            []*DiameterPeerConfig
+	LoadBalancing    LoadBalancingMode
+	RecoveryInterval time.Duration // DefaultPeerRecoveryInterval if 0
+	AnswerTimeout    time.Duration // DefaultPeerAnswerTimeout if 0
+}
+
+// GetPeerGroupConfig returns the peer group configured in the managed configs
+// of a diameter client or nil if the client has no peers
+func GetPeerGroupConfig(cfg *mconfig.DiamClientConfig) *PeerGroupConfig {
+	if len(cfg.GetPeers()) == 0 {
+		return nil
+	}
+	res := &PeerGroupConfig{Weight: cfg.GetWeight()}
+	if cfg.GetLoadBalancing() == mconfig.DiamLoadBalancing_SESSION_STICKY {
+		res.LoadBalancing = SessionLoadBalancing
+	}
+	for _, peer := range cfg.GetPeers() {
+		protocol := peer.GetProtocol()
+		if len(protocol) == 0 {
+			protocol = cfg.GetProtocol()
+		}
+		res.Peers = append(res.Peers, &DiameterPeerConfig{
+			DiameterServerConfig: DiameterServerConfig{
+				DiameterServerConnConfig: DiameterServerConnConfig{
+					Addr:      peer.GetAddress(),
+					Protocol:  protocol,
+					LocalAddr: peer.GetLocalAddress()},
+				DestHost:        peer.GetDestHost(),
+				DestRealm:       peer.GetDestRealm(),
+				DisableDestHost: peer.GetDisableDestHost(),
+			},
+			Weight: peer.GetWeight(),
+		})
+	}
+	return res
+}
+
+// PeerGroup is a set of diameter servers which serve the same application.
+// Requests sent to a group go to one of its healthy peers and fail over to
+// the next one when sending fails or the answer times out.
+//
+// A peer becomes unhealthy when sending a request to it fails, when it doesn't
+// answer a request in time or when its connection is closed, which is also
+// how the watchdog reports a peer that stopped answering DWRs. Unhealthy peers are only used when all others are
+// unhealthy too, until the recovery interval passes or a new connection to
+// them is established.
+type PeerGroup struct {
+	peers            []*peer
+	loadBalancing    LoadBalancingMode
+	recoveryInterval time.Duration
+	answerTimeout    time.Duration
+	mutex            sync.RWMutex
+}
+
+type peer struct {
+	server    *DiameterServerConfig
+	weight    uint32
+	healthy   bool
+	downSince time.Time
+}
+
+// NewPeerGroup creates a group of the server followed by the peers of the
+// config. A nil config makes a group of the server alone.
+func NewPeerGroup(server *DiameterServerConfig, cfg *PeerGroupConfig) *PeerGroup {
+	if cfg == nil {
+		cfg = &PeerGroupConfig{}
+	}
+	group := &PeerGroup{
+		peers:            []*peer{newPeer(server, cfg.Weight)},
+		loadBalancing:    cfg.LoadBalancing,
+		recoveryInterval: cfg.RecoveryInterval,
+		answerTimeout:    cfg.AnswerTimeout,
+	}
+	if group.recoveryInterval <= 0 {
+		group.recoveryInterval = DefaultPeerRecoveryInterval
+	}
+	if group.answerTimeout <= 0 {
+		group.answerTimeout = DefaultPeerAnswerTimeout
+	}
+	for _, peerCfg := range cfg.Peers {
+		if peerCfg != nil {
+			group.peers = append(group.peers, newPeer(&peerCfg.DiameterServerConfig, peerCfg.Weight))
+		}
+	}
+	return group
+}
+
+func newPeer(server *DiameterServerConfig, weight uint32) *peer {
+	if weight == 0 {
+		weight = 1
+	}
+	return &peer{server: server, weight: weight, healthy: true}
+}
+
+// Servers returns the configs of all peers of the group, starting with the
+// primary server
+func (g *PeerGroup) Servers() []*DiameterServerConfig {
+	res := make([]*DiameterServerConfig, 0, len(g.peers))
+	for _, p := range g.peers {
+		res = append(res, p.server)
+	}
+	return res
+}
+
+// IsHealthy returns whether the peer of the group with the given server config
+// is healthy
+func (g *PeerGroup) IsHealthy(server *DiameterServerConfig) bool {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	for _, p := range g.peers {
+		if p.server.DiameterServerConnConfig == server.DiameterServerConnConfig {
+			return p.healthy
+		}
+	}
+	return false
+}
+
+// BeginConnections attempts to begin connections with all peers of the group
+func (g *PeerGroup) BeginConnections(connMan *ConnectionManager, client *sm.Client) error {
+	var err error
+	for _, p := range g.peers {
+		conn, connErr := connMan.GetConnection(client, p.server)
+		if connErr != nil {
+			err = connErr
+			continue
+		}
+		conn.setStateHandler(g.stateHandler(p))
+	}
+	return err
+}
+
+// ErrAnswerTimeout is returned by SendRequestAndWait when no peer of the
+// group answered a request in time
+var ErrAnswerTimeout = errors.New("PeerGroup: timed out waiting for answer")
+
+// SendRequest sends the request to a peer of the group, picked according to
+// the load balancing mode and the Session-Id AVP of the message. When sending
+// fails, the peer is marked unhealthy and the request is retried on the next
+// peer. The request is sent at most retryCount+1 times, but at least once to
+// every peer of the group.
+func (g *PeerGroup) SendRequest(
+	connMan *ConnectionManager, client *sm.Client, message *diam.Message, retryCount uint) error {
+
+	_, err := g.send(connMan, client, message, retryCount, g.candidates(getSessionID(message)))
+	return err
+}
+
+// SendRequestAndWait sends the request like SendRequest and waits for its
+// answer on answers. When a peer doesn't answer within the answer timeout of
+// the group, it is marked unhealthy and the request is sent again, flagged as
+// potentially retransmitted, to the next peer which hasn't timed out on it
+// yet. The last peer is waited on until ctx is done, as there is no peer left
+// to fail over to. It returns the answer and whether answers was still open,
+// or ErrAnswerTimeout once every peer of the group timed out or ctx is done,
+// so callers never wait past the deadline of ctx.
+func (g *PeerGroup) SendRequestAndWait(
+	ctx context.Context,
+	connMan *ConnectionManager,
+	client *sm.Client,
+	message *diam.Message,
+	retryCount uint,
+	answers <-chan interface{},
+) (interface{}, bool, error) {
+
+	peers := g.candidates(getSessionID(message))
+	for len(peers) > 0 {
+		p, err := g.send(connMan, client, message, retryCount, peers)
+		if err != nil {
+			return nil, false, err
+		}
+		sentAt := time.Now()
+		timer := time.NewTimer(g.answerTimeout)
+		timeout := timer.C
+		if _, hasDeadline := ctx.Deadline(); len(peers) == 1 && hasDeadline {
+			timeout = nil
+		}
+		select {
+		case answer, open := <-answers:
+			timer.Stop()
+			return answer, open, nil
+		case <-ctx.Done():
+			timer.Stop()
+			glog.Errorf("Deadline exceeded waiting for diameter answer from peer %s", p.server.Addr)
+			// a peer cut short by the deadline may still be healthy
+			if time.Since(sentAt) >= g.answerTimeout {
+				g.setHealthy(p, false)
+			}
+			return nil, false, ErrAnswerTimeout
+		case <-timeout:
+		}
+		glog.Errorf("Timed out waiting for diameter answer from peer %s", p.server.Addr)
+		g.setHealthy(p, false)
+		peers = removePeer(peers, p)
+		message.Header.CommandFlags |= diam.RetransmittedFlag
+	}
+	return nil, false, ErrAnswerTimeout
+}
+
+// SendTrackedRequest sends the request like SendRequest, for callers which
+// wait for the answer themselves. As long as the request stays tracked under
+// key, i.e. it is neither answered nor ignored, it is sent again to the next
+// peer, flagged as potentially retransmitted, each time a peer doesn't answer
+// within the answer timeout of the group.
+func (g *PeerGroup) SendTrackedRequest(
+	connMan *ConnectionManager,
+	client *sm.Client,
+	message *diam.Message,
+	retryCount uint,
+	tracker *RequestTracker,
+	key interface{},
+) error {
+
+	peers := g.candidates(getSessionID(message))
+	p, err := g.send(connMan, client, message, retryCount, peers)
+	if err != nil {
+		return err
+	}
+	go g.resendUnanswered(connMan, client, message, retryCount, tracker, key, peers, p)
+	return nil
+}
+
+// resendUnanswered sends the request to the next of the peers each time the
+// peer it was last sent to doesn't answer in time
+func (g *PeerGroup) resendUnanswered(
+	connMan *ConnectionManager,
+	client *sm.Client,
+	message *diam.Message,
+	retryCount uint,
+	tracker *RequestTracker,
+	key interface{},
+	peers []*peer,
+	p *peer,
+) {
+	for {
+		time.Sleep(g.answerTimeout)
+		if !tracker.IsTracked(key) {
+			return
+		}
+		glog.Errorf("Timed out waiting for diameter answer from peer %s", p.server.Addr)
+		g.setHealthy(p, false)
+		peers = removePeer(peers, p)
+		if len(peers) == 0 {
+			return
+		}
+		message.Header.CommandFlags |= diam.RetransmittedFlag
+		var err error
+		p, err = g.send(connMan, client, message, retryCount, peers)
+		if err != nil {
+			glog.Errorf("Failed to resend unanswered diameter request: %v", err)
+			return
+		}
+	}
+}
+
+// send tries to send the request to the peers in order, and returns the peer
+// the request was sent to
+func (g *PeerGroup) send(
+	connMan *ConnectionManager, client *sm.Client, message *diam.Message, retryCount uint, peers []*peer) (*peer, error) {
+
+	attempts := int(retryCount) + 1
+	if attempts < len(peers) {
+		attempts = len(peers)
+	}
+	err := errors.New("PeerGroup: no peers to send request to")
+	if len(peers) == 0 {
+		return nil, err
+	}
+	for i := 0; i < attempts; i++ {
+		p := peers[i%len(peers)]
+		var conn *Connection
+		conn, err = connMan.GetConnection(client, p.server)
+		if err != nil {
+			// connection creation is disabled, other peers won't do better
+			return nil, err
+		}
+		conn.setStateHandler(g.stateHandler(p))
+		err = conn.SendRequestToServer(message, 0, p.server)
+		if err == nil {
+			g.setHealthy(p, true)
+			return p, nil
+		}
+		glog.Errorf("Failed to send diameter request to peer %s: %v", p.server.Addr, err)
+		g.setHealthy(p, false)
+	}
+	return nil, err
+}
+
+func removePeer(peers []*peer, removed *peer) []*peer {
+	res := make([]*peer, 0, len(peers))
+	for _, p := range peers {
+		if p != removed {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// candidates returns the peers in the order they should be tried for a
+// request of the given session: the available peers first, then the ones
+// waiting to recover, each in load balancing order
+func (g *PeerGroup) candidates(sessionID string) []*peer {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+	ordered := make([]*peer, len(g.peers))
+	copy(ordered, g.peers)
+	if g.loadBalancing == SessionLoadBalancing {
+		scores := make(map[*peer]float64, len(ordered))
+		for _, p := range ordered {
+			scores[p] = rendezvousScore(sessionID, p)
+		}
+		sort.SliceStable(ordered, func(i, j int) bool { return scores[ordered[i]] > scores[ordered[j]] })
+	}
+	now := time.Now()
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return g.isAvailable(ordered[i], now) && !g.isAvailable(ordered[j], now)
+	})
+	return ordered
+}
+
+// isAvailable returns whether the peer is healthy or has been unhealthy for
+// long enough to be tried again. Must be called with the mutex held.
+func (g *PeerGroup) isAvailable(p *peer, now time.Time) bool {
+	return p.healthy || now.Sub(p.downSince) >= g.recoveryInterval
+}
+
+func (g *PeerGroup) setHealthy(p *peer, healthy bool) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+	if p.healthy == healthy {
+		return
+	}
+	p.healthy = healthy
+	if healthy {
+		glog.Infof("Diameter peer %s is healthy", p.server.Addr)
+	} else {
+		p.downSince = time.Now()
+		glog.Warningf("Diameter peer %s is unhealthy", p.server.Addr)
+	}
+}
+
+func (g *PeerGroup) stateHandler(p *peer) func(connected bool) {
+	return func(connected bool) { g.setHealthy(p, connected) }
+}
+
+// rendezvousScore is the weighted rendezvous hash of the session and peer.
+// The peer with the highest score serves the session, so when a peer becomes
+// unavailable only its own sessions move to other peers.
+func rendezvousScore(sessionID string, p *peer) float64 {
+	h := fnv.New64a()
+	h.Write([]byte(sessionID))
+	h.Write([]byte{0})
+	h.Write([]byte(p.server.Addr))
+	// map the hash into (0, 1)
+	u := (float64(mix(h.Sum64())>>11) + 0.5) / (1 << 53)
+	return -float64(p.weight) / math.Log(u)
+}
+
+// mix is the splitmix64 finalizer, FNV alone doesn't spread similar inputs
+// evenly enough
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// getSessionID returns the value of the Session-Id AVP of the message or an
+// empty string if it has none
+func getSessionID(message *diam.Message) string {
+	sidAVP, err := message.FindAVP(avp.SessionID, 0)
+	if err != nil || sidAVP == nil {
+		return ""
+	}
+	sid, ok := sidAVP.Data.(datatype.UTF8String)
+	if !ok {
+		return ""
+	}
+	return string(sid)
+}
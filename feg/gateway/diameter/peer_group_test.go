@@ -0,0 +1,349 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package diameter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/cloud/go/protos/mconfig"
+)
+
+func TestGetPeerGroupConfig(t *testing.T) {
+	assert.Nil(t, GetPeerGroupConfig(nil))
+	assert.Nil(t, GetPeerGroupConfig(&mconfig.DiamClientConfig{Address: "pcrf1:3868"}))
+
+	cfg := GetPeerGroupConfig(&mconfig.DiamClientConfig{
+		Protocol:      "sctp",
+		Address:       "pcrf1:3868",
+		Weight:        3,
+		LoadBalancing: mconfig.DiamLoadBalancing_SESSION_STICKY,
+		Peers: []*mconfig.DiamPeerConfig{
+			{Address: "pcrf2:3868", DestHost: "pcrf2.magma.com", DestRealm: "magma.com", Weight: 2},
+			{Protocol: "tcp", Address: "pcrf3:3868", LocalAddress: ":56789", DisableDestHost: true},
+		},
+	})
+	assert.Equal(t, &PeerGroupConfig{
+		Weight:        3,
+		LoadBalancing: SessionLoadBalancing,
+		Peers: []*DiameterPeerConfig{
+			{
+				DiameterServerConfig: DiameterServerConfig{
+					DiameterServerConnConfig: DiameterServerConnConfig{Addr: "pcrf2:3868", Protocol: "sctp"},
+					DestHost:                 "pcrf2.magma.com",
+					DestRealm:                "magma.com",
+				},
+				Weight: 2,
+			},
+			{
+				DiameterServerConfig: DiameterServerConfig{
+					DiameterServerConnConfig: DiameterServerConnConfig{Addr: "pcrf3:3868", Protocol: "tcp", LocalAddr: ":56789"},
+					DisableDestHost:          true,
+				},
+			},
+		},
+	}, cfg)
+}
+
+func TestPeerGroup_Failover(t *testing.T) {
+	group := NewPeerGroup(newTestPeerServer("pcrf1:3868"), &PeerGroupConfig{
+		Peers:            []*DiameterPeerConfig{newTestPeer("pcrf2:3868", 0), newTestPeer("pcrf3:3868", 0)},
+		RecoveryInterval: time.Hour,
+	})
+	assert.Equal(t, []string{"pcrf1:3868", "pcrf2:3868", "pcrf3:3868"}, getAddrs(group.candidates("s1")))
+
+	// unhealthy peers are tried last
+	group.setHealthy(group.peers[0], false)
+	assert.False(t, group.IsHealthy(group.peers[0].server))
+	assert.Equal(t, []string{"pcrf2:3868", "pcrf3:3868", "pcrf1:3868"}, getAddrs(group.candidates("s1")))
+	group.setHealthy(group.peers[1], false)
+	assert.Equal(t, []string{"pcrf3:3868", "pcrf1:3868", "pcrf2:3868"}, getAddrs(group.candidates("s1")))
+
+	// until they recover
+	group.peers[0].downSince = time.Now().Add(-time.Hour)
+	assert.Equal(t, []string{"pcrf1:3868", "pcrf3:3868", "pcrf2:3868"}, getAddrs(group.candidates("s1")))
+	group.setHealthy(group.peers[1], true)
+	assert.Equal(t, []string{"pcrf1:3868", "pcrf2:3868", "pcrf3:3868"}, getAddrs(group.candidates("s1")))
+}
+
+func TestPeerGroup_SessionLoadBalancing(t *testing.T) {
+	group := NewPeerGroup(newTestPeerServer("pcrf1:3868"), &PeerGroupConfig{
+		Weight:        2,
+		Peers:         []*DiameterPeerConfig{newTestPeer("pcrf2:3868", 1), newTestPeer("pcrf3:3868", 1)},
+		LoadBalancing: SessionLoadBalancing,
+	})
+	sessions := map[string]string{}
+	counts := map[string]int{}
+	for i := 0; i < 4000; i++ {
+		sid := fmt.Sprintf("magma;%d;IMSI00101%010d", i, i)
+		peer := group.candidates(sid)[0].server.Addr
+		sessions[sid] = peer
+		counts[peer]++
+		// sticky on Session-Id
+		assert.Equal(t, peer, group.candidates(sid)[0].server.Addr)
+	}
+	// sessions are spread by weight
+	assert.InDelta(t, 2000, counts["pcrf1:3868"], 200)
+	assert.InDelta(t, 1000, counts["pcrf2:3868"], 200)
+	assert.InDelta(t, 1000, counts["pcrf3:3868"], 200)
+
+	// only the sessions of an unhealthy peer move
+	group.setHealthy(group.peers[1], false)
+	for sid, peer := range sessions {
+		actual := group.candidates(sid)[0].server.Addr
+		if peer == "pcrf2:3868" {
+			assert.NotEqual(t, peer, actual)
+		} else {
+			assert.Equal(t, peer, actual)
+		}
+	}
+}
+
+func TestPeerGroup_SendRequest(t *testing.T) {
+	const clientHost = datatype.DiameterIdentity("test.magma.com")
+	var (
+		mux = sm.New(&sm.Settings{
+			OriginHost:  clientHost,
+			OriginRealm: "magma.com",
+			VendorID:    datatype.Unsigned32(Vendor3GPP),
+			ProductName: "peer group",
+		})
+		cli = &sm.Client{
+			Dict:               dict.Default,
+			Handler:            mux,
+			RetransmitInterval: time.Second,
+			EnableWatchdog:     true,
+			WatchdogInterval:   50 * time.Millisecond,
+			AuthApplicationID: []*diam.AVP{
+				diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.CHARGING_CONTROL_APP_ID)),
+			},
+		}
+		requests = make(chan *diam.Message, 10)
+	)
+	liveServer := newTestPeerServer("127.0.0.1:0")
+	liveServer.DestHost = "pcrf2.magma.com"
+	liveServer.DestRealm = "magma.com"
+	serverConns := startTestPeerServer(t, liveServer, requests)
+
+	// nothing listens on the primary server
+	group := NewPeerGroup(newTestPeerServer("127.0.0.1:1"), &PeerGroupConfig{
+		Peers:            []*DiameterPeerConfig{{DiameterServerConfig: *liveServer}},
+		RecoveryInterval: time.Hour,
+	})
+	connMan := NewConnectionManager()
+	newMessage := func(sid string) *diam.Message {
+		m := diam.NewRequest(diam.CreditControl, diam.CHARGING_CONTROL_APP_ID, nil)
+		m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
+		m.NewAVP(avp.OriginHost, avp.Mbit, 0, clientHost)
+		m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("magma.com"))
+		return m
+	}
+
+	// a request without retries still fails over to the next peer
+	err := group.SendRequest(connMan, cli, newMessage("s1"), 0)
+	assert.NoError(t, err)
+	received := waitForTestRequest(t, requests)
+	assert.Equal(t, "s1", getSessionID(received))
+	host, err := received.FindAVP(avp.DestinationHost, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, datatype.DiameterIdentity("pcrf2.magma.com"), host.Data)
+	assert.False(t, group.IsHealthy(group.peers[0].server))
+	assert.True(t, group.IsHealthy(group.peers[1].server))
+
+	// the failed peer isn't tried again until it recovers
+	assert.Equal(t, []string{liveServer.Addr, "127.0.0.1:1"}, getAddrs(group.candidates("s2")))
+	assert.NoError(t, group.SendRequest(connMan, cli, newMessage("s2"), 0))
+	waitForTestRequest(t, requests)
+
+	// a connection closed by the peer makes it unhealthy, go-diameter only
+	// notices the close once a DWA was received on the connection
+	time.Sleep(4 * cli.WatchdogInterval)
+	(<-serverConns).Close()
+	assert.Eventually(t, func() bool { return !group.IsHealthy(liveServer) }, time.Second, 10*time.Millisecond)
+
+	// and it is healthy again once a request is sent to it
+	group.peers[1].downSince = time.Now().Add(-time.Hour)
+	assert.NoError(t, group.SendRequest(connMan, cli, newMessage("s3"), 0))
+	waitForTestRequest(t, requests)
+	assert.True(t, group.IsHealthy(liveServer))
+
+	// all peers failing fails the request
+	connMan.DisableFor(time.Hour)
+	assert.Error(t, group.SendRequest(connMan, cli, newMessage("s4"), 1))
+}
+
+func TestPeerGroup_AnswerTimeout(t *testing.T) {
+	const clientHost = datatype.DiameterIdentity("test.magma.com")
+	var (
+		mux = sm.New(&sm.Settings{
+			OriginHost:  clientHost,
+			OriginRealm: "magma.com",
+			VendorID:    datatype.Unsigned32(Vendor3GPP),
+			ProductName: "peer group",
+		})
+		cli = &sm.Client{
+			Dict:               dict.Default,
+			Handler:            mux,
+			RetransmitInterval: time.Second,
+			AuthApplicationID: []*diam.AVP{
+				diam.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.CHARGING_CONTROL_APP_ID)),
+			},
+		}
+		requests1 = make(chan *diam.Message, 10)
+		requests2 = make(chan *diam.Message, 10)
+	)
+	// neither server answers
+	server1 := newTestPeerServer("127.0.0.1:0")
+	startTestPeerServer(t, server1, requests1)
+	server2 := newTestPeerServer("127.0.0.1:0")
+	startTestPeerServer(t, server2, requests2)
+
+	const answerTimeout = 100 * time.Millisecond
+	group := NewPeerGroup(server1, &PeerGroupConfig{
+		Peers:            []*DiameterPeerConfig{{DiameterServerConfig: *server2}},
+		RecoveryInterval: time.Hour,
+		AnswerTimeout:    answerTimeout,
+	})
+	connMan := NewConnectionManager()
+	newMessage := func(sid string) *diam.Message {
+		m := diam.NewRequest(diam.CreditControl, diam.CHARGING_CONTROL_APP_ID, nil)
+		m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
+		m.NewAVP(avp.OriginHost, avp.Mbit, 0, clientHost)
+		m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity("magma.com"))
+		return m
+	}
+	resetHealth := func() {
+		group.setHealthy(group.peers[0], true)
+		group.setHealthy(group.peers[1], true)
+	}
+
+	// an unanswered request is sent again to the next peer, flagged as
+	// potentially retransmitted, and the last peer is waited on until the
+	// deadline
+	answers := make(chan interface{}, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 4*answerTimeout)
+	start := time.Now()
+	_, _, err := group.SendRequestAndWait(ctx, connMan, cli, newMessage("s1"), 0, answers)
+	cancel()
+	assert.Equal(t, ErrAnswerTimeout, err)
+	assert.True(t, time.Since(start) >= 4*answerTimeout)
+	assert.Zero(t, waitForTestRequest(t, requests1).Header.CommandFlags&diam.RetransmittedFlag)
+	assert.NotZero(t, waitForTestRequest(t, requests2).Header.CommandFlags&diam.RetransmittedFlag)
+	assert.False(t, group.IsHealthy(server1))
+	assert.False(t, group.IsHealthy(server2))
+
+	// the deadline cuts the wait short, without failing over to the next peer
+	resetHealth()
+	ctx, cancel = context.WithTimeout(context.Background(), answerTimeout/2)
+	start = time.Now()
+	_, _, err = group.SendRequestAndWait(ctx, connMan, cli, newMessage("s1"), 0, answers)
+	cancel()
+	assert.Equal(t, ErrAnswerTimeout, err)
+	assert.True(t, time.Since(start) < answerTimeout)
+	waitForTestRequest(t, requests1)
+	assert.True(t, group.IsHealthy(server1))
+	assert.Empty(t, requests2)
+
+	// an answered request isn't
+	resetHealth()
+	go func() {
+		waitForTestRequest(t, requests1)
+		answers <- "answer"
+	}()
+	answer, open, err := group.SendRequestAndWait(context.Background(), connMan, cli, newMessage("s2"), 0, answers)
+	assert.NoError(t, err)
+	assert.True(t, open)
+	assert.Equal(t, "answer", answer)
+	assert.True(t, group.IsHealthy(server1))
+	assert.Empty(t, requests2)
+
+	// tracked requests are sent again while they are tracked
+	tracker := NewRequestTracker()
+	tracker.RegisterRequest("s3", make(chan interface{}))
+	assert.NoError(t, group.SendTrackedRequest(connMan, cli, newMessage("s3"), 0, tracker, "s3"))
+	waitForTestRequest(t, requests1)
+	assert.NotZero(t, waitForTestRequest(t, requests2).Header.CommandFlags&diam.RetransmittedFlag)
+	assert.False(t, group.IsHealthy(server1))
+	tracker.DeregisterRequest("s3")
+
+	resetHealth()
+	tracker.RegisterRequest("s4", make(chan interface{}))
+	assert.NoError(t, group.SendTrackedRequest(connMan, cli, newMessage("s4"), 0, tracker, "s4"))
+	waitForTestRequest(t, requests1)
+	tracker.DeregisterRequest("s4")
+	time.Sleep(3 * answerTimeout)
+	assert.Empty(t, requests2)
+	assert.True(t, group.IsHealthy(server1))
+}
+
+func newTestPeerServer(addr string) *DiameterServerConfig {
+	return &DiameterServerConfig{
+		DiameterServerConnConfig: DiameterServerConnConfig{Addr: addr, Protocol: "tcp"},
+		DestHost:                 "pcrf.magma.com",
+		DestRealm:                "magma.com",
+	}
+}
+
+func newTestPeer(addr string, weight uint32) *DiameterPeerConfig {
+	return &DiameterPeerConfig{DiameterServerConfig: *newTestPeerServer(addr), Weight: weight}
+}
+
+func getAddrs(peers []*peer) []string {
+	var res []string
+	for _, p := range peers {
+		res = append(res, p.server.Addr)
+	}
+	return res
+}
+
+// startTestPeerServer starts a diameter server which forwards the received
+// CCRs to requests and the connections they were received on to the
+// returned channel. The address of the server is updated to the listening one.
+func startTestPeerServer(t *testing.T, server *DiameterServerConfig, requests chan *diam.Message) chan diam.Conn {
+	serverMux := sm.New(&sm.Settings{
+		OriginHost:  "pcrf2.magma.com",
+		OriginRealm: "magma.com",
+		VendorID:    datatype.Unsigned32(Vendor3GPP),
+		ProductName: "test pcrf",
+	})
+	conns := make(chan diam.Conn, 10)
+	serverMux.HandleIdx(
+		diam.CommandIndex{AppID: diam.CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: true},
+		diam.HandlerFunc(func(c diam.Conn, m *diam.Message) {
+			requests <- m
+			conns <- c
+		}))
+	listener, err := diam.Listen(server.Protocol, server.Addr)
+	if err != nil {
+		t.Fatalf("Could not create server socket on: %s, %v", server.Addr, err)
+	}
+	server.Addr = listener.Addr().String()
+	srv := &diam.Server{Network: server.Protocol, Addr: server.Addr, Handler: serverMux}
+	go srv.Serve(listener)
+	return conns
+}
+
+func waitForTestRequest(t *testing.T, requests chan *diam.Message) *diam.Message {
+	select {
+	case m := <-requests:
+		return m
+	case <-time.After(time.Second):
+		t.Fatal("Timed out waiting for request")
+	}
+	return nil
+}
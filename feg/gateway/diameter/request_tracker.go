@@ -45,3 +45,11 @@ func (rt *RequestTracker) DeregisterRequest(key interface{}) chan interface{} {
 	delete(rt.requestMap, key)
 	return channel
 }
+
+// IsTracked returns whether a request is tracked under the key
+func (rt *RequestTracker) IsTracked(key interface{}) bool {
+	rt.mapMutex.Lock()
+	defer rt.mapMutex.Unlock()
+	_, ok := rt.requestMap[key]
+	return ok
+}
@@ -0,0 +1,122 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package object_store
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/glog"
+)
+
+// tmpFilePrefix prefixes files being written, they are ignored by GetAll
+const tmpFilePrefix = "."
+
+// FileMap is an ObjectMap that stores each object in a file of a local
+// directory. Files are replaced atomically, so the map survives crashes.
+type FileMap struct {
+	dir          string
+	serializer   Serializer
+	deserializer Deserializer
+}
+
+// NewFileMap creates a new file map in the given directory, creating the
+// directory if needed
+func NewFileMap(
+	dir string,
+	serializer Serializer,
+	deserializer Deserializer,
+) (*FileMap, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileMap{
+		dir:          dir,
+		serializer:   serializer,
+		deserializer: deserializer,
+	}, nil
+}
+
+// Set sets an object in the map
+func (fm *FileMap) Set(key string, object interface{}) error {
+	str, err := fm.serializer(object)
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(fm.dir, tmpFilePrefix)
+	if err != nil {
+		return err
+	}
+	_, err = tmp.WriteString(str)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), fm.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+	return err
+}
+
+// Get retrieves an object from the map
+func (fm *FileMap) Get(key string) (interface{}, error) {
+	val, err := ioutil.ReadFile(fm.path(key))
+	if err != nil {
+		return nil, err
+	}
+	return fm.deserializer(string(val))
+}
+
+// Delete removes an object from the map, deleting a missing object is not an error
+func (fm *FileMap) Delete(key string) error {
+	err := os.Remove(fm.path(key))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// GetAll returns all objects in the map
+func (fm *FileMap) GetAll() (map[string]interface{}, error) {
+	files, err := ioutil.ReadDir(fm.dir)
+	if err != nil {
+		return nil, err
+	}
+	returnVals := make(map[string]interface{})
+	for _, file := range files {
+		if file.IsDir() || strings.HasPrefix(file.Name(), tmpFilePrefix) {
+			continue
+		}
+		key, err := hex.DecodeString(file.Name())
+		if err != nil {
+			glog.Errorf("Unexpected file %s in %s", file.Name(), fm.dir)
+			continue
+		}
+		obj, err := fm.Get(string(key))
+		if err != nil {
+			glog.Errorf("Unable to parse key %s because: %s", key, err.Error())
+		} else {
+			returnVals[string(key)] = obj
+		}
+	}
+	return returnVals, nil
+}
+
+// path returns the file of the key, keys are hex encoded to be valid file names
+func (fm *FileMap) path(key string) string {
+	return filepath.Join(fm.dir, hex.EncodeToString([]byte(key)))
+}
@@ -10,6 +10,8 @@ package object_store_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"testing"
 
 	"magma/feg/gateway/object_store"
@@ -98,3 +100,40 @@ func TestRedisMap(t *testing.T) {
 		assert.True(t, ok)
 	}
 }
+
+func TestFileMap(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file_map_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	fileMap, err := object_store.NewFileMap(dir, getSerializer(), getDeserializer())
+	assert.NoError(t, err)
+
+	err = fileMap.Set("1", &testObject{foo: "first"})
+	assert.NoError(t, err)
+	err = fileMap.Set("key/with;separators", &testObject{foo: "second"})
+	assert.NoError(t, err)
+	err = fileMap.Set("1", &testObject{foo: "replaced"})
+	assert.NoError(t, err)
+
+	objRaw, err := fileMap.Get("1")
+	assert.NoError(t, err)
+	obj, ok := objRaw.(*testObject)
+	assert.True(t, ok)
+	assert.Equal(t, "replaced", obj.foo)
+
+	// a new map on the same directory sees the same objects
+	fileMap, err = object_store.NewFileMap(dir, getSerializer(), getDeserializer())
+	assert.NoError(t, err)
+	allVals, err := fileMap.GetAll()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(allVals))
+	assert.Equal(t, "second", allVals["key/with;separators"].(*testObject).foo)
+
+	assert.NoError(t, fileMap.Delete("1"))
+	assert.NoError(t, fileMap.Delete("1"))
+	_, err = fileMap.Get("1")
+	assert.Error(t, err)
+	allVals, err = fileMap.GetAll()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(allVals))
+}
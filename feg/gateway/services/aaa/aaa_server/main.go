@@ -11,12 +11,15 @@ package main
 
 import (
 	"log"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 
 	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/cloud/go/protos/mconfig"
+	"magma/feg/gateway/object_store"
 	"magma/feg/gateway/registry"
+	"magma/feg/gateway/services/aaa"
 	"magma/feg/gateway/services/aaa/protos"
 	"magma/feg/gateway/services/aaa/servicers"
 	"magma/feg/gateway/services/aaa/store"
@@ -28,12 +31,12 @@ import (
 const (
 	AAAServiceName = "aaa_server"
 	Version        = "0.1"
+
+	redisConnectAttempts      = 10
+	redisConnectRetryInterval = time.Second * 3
 )
 
 func main() {
-	// Create a shared Session Table
-	sessions := store.NewMemorySessionTable()
-
 	// Create the EAP AKA Provider service
 	srv, err := service.NewServiceWithOptions(registry.ModuleName, registry.AAA_SERVER)
 	if err != nil {
@@ -45,7 +48,13 @@ func main() {
 		log.Printf("Error getting AAA Server service configs: %s", err)
 		aaaConfigs = nil
 	}
-	acct, _ := servicers.NewAccountingService(sessions, proto.Clone(aaaConfigs).(*mconfig.AAAConfig))
+	// Create a shared Session Table
+	sessions := newSessionTable(aaaConfigs)
+
+	acct, err := servicers.NewAccountingService(sessions, proto.Clone(aaaConfigs).(*mconfig.AAAConfig))
+	if err != nil {
+		log.Printf("Error restoring AAA sessions: %s", err)
+	}
 	protos.RegisterAccountingServer(srv.GrpcServer, acct)
 	lteprotos.RegisterAbortSessionResponderServer(srv.GrpcServer, acct)
 	fegprotos.RegisterSwxGatewayServiceServer(srv.GrpcServer, acct)
@@ -59,3 +68,49 @@ func main() {
 		log.Fatalf("Error running AAA service: %s", err)
 	}
 }
+
+// newSessionTable returns the session table of the configured store. Since sessions of a persistent store would be
+// lost on restart if they were kept in memory instead, it retries connecting to redis & fails if the configured store
+// remains unavailable.
+func newSessionTable(cfg *mconfig.AAAConfig) aaa.SessionTable {
+	switch cfg.GetSessionStore() {
+	case mconfig.AAAConfig_REDIS:
+		client, err := newRedisClient()
+		if err != nil {
+			log.Fatalf("Error connecting to redis AAA session store: %s", err)
+		}
+		log.Print("Using redis AAA session store")
+		return store.NewRedisSessionTable(client)
+	case mconfig.AAAConfig_LOCAL:
+		sessions, err := store.NewLocalSessionTable(cfg.GetSessionStorePath())
+		if err != nil {
+			log.Fatalf("Error creating local AAA session store: %s", err)
+		}
+		log.Print("Using local AAA session store")
+		return sessions
+	}
+	return store.NewMemorySessionTable()
+}
+
+// newRedisClient returns a client of the gateway redis once redis is reachable, retrying up to
+// redisConnectAttempts times
+func newRedisClient() (object_store.RedisClient, error) {
+	var err error
+	for attempt := 1; attempt <= redisConnectAttempts; attempt++ {
+		var client object_store.RedisClient
+		client, err = object_store.NewRedisClient()
+		if err == nil {
+			if impl, ok := client.(*object_store.RedisClientImpl); ok {
+				err = impl.RawClient.Ping().Err()
+			}
+		}
+		if err == nil {
+			return client, nil
+		}
+		log.Printf("Redis AAA session store is unavailable (attempt %d of %d): %s", attempt, redisConnectAttempts, err)
+		if attempt < redisConnectAttempts {
+			time.Sleep(redisConnectRetryInterval)
+		}
+	}
+	return nil, err
+}
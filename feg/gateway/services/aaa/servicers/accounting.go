@@ -43,11 +43,19 @@ const (
 
 // NewEapAuthenticator returns a new instance of EAP Auth service
 func NewAccountingService(sessions aaa.SessionTable, cfg *mconfig.AAAConfig) (*accountingService, error) {
-	return &accountingService{
+	srv := &accountingService{
 		sessions:    sessions,
 		config:      cfg,
 		sessionTout: GetIdleSessionTimeout(cfg),
-	}, nil
+	}
+	if persistent, ok := sessions.(aaa.PersistentSessionTable); ok {
+		restored, err := persistent.Restore(srv.timeoutSessionNotifier)
+		if err != nil {
+			return srv, err
+		}
+		log.Printf("Restored %d AAA sessions", restored)
+	}
+	return srv, nil
 }
 
 // Start implements Radius Acct-Status-Type: Start endpoint
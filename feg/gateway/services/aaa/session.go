@@ -64,3 +64,11 @@ type SessionTable interface {
 	// SetTimeout - [Re]sets the session's cleanup timeout to fire after tout duration
 	SetTimeout(sid string, tout time.Duration, callback TimeoutNotifier) bool
 }
+
+// PersistentSessionTable - SessionTable keeping its sessions across restarts & shared by all its instances
+type PersistentSessionTable interface {
+	SessionTable
+	// Restore loads the stored sessions & [re]arms their timeouts, returns the number of restored sessions.
+	// The notifier is called on timeout of restored sessions & of sessions added by other instances.
+	Restore(notifier TimeoutNotifier) (int, error)
+}
@@ -25,6 +25,7 @@ import (
 
 // Session - struct to save an authenticated session state
 type memSession struct {
+	deadline int64 // UnixNano time of the session's timeout, accessed atomically
 	*protos.Context
 	imsi            string
 	cleanupTimerCtx unsafe.Pointer // *cleanupTimerCtx
@@ -216,6 +217,7 @@ func setTimeoutUnsafe(st *memSessionTable, sid string, tout time.Duration, s *me
 	var ctx = &cleanupTimerCtx{owner: st, sidKey: sid, s: s, notifyRoutine: notifier}
 	newTimer := time.AfterFunc(tout, func() { cleanupTimer(ctx) })
 	atomic.StorePointer(&ctx.sessionTimerPtr, unsafe.Pointer(newTimer))
+	atomic.StoreInt64(&s.deadline, time.Now().Add(tout).UnixNano())
 	atomic.StorePointer(&s.cleanupTimerCtx, unsafe.Pointer(ctx))
 }
 
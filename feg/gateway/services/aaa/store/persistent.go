@@ -0,0 +1,368 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"magma/feg/gateway/object_store"
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/protos"
+)
+
+const (
+	// DefaultLocalStorePath is the default directory of the local session store
+	DefaultLocalStorePath = "/var/opt/magma/aaa"
+
+	// sharedTimeoutGrace delays the timeout of sessions whose timeout was set by another instance, so the instance
+	// which set it times the session out
+	sharedTimeoutGrace = time.Second
+
+	redisSessionsHash = "aaa_sessions"
+	redisSidsHash     = "aaa_session_ids"
+)
+
+// storedSession - persisted state of a session
+type storedSession struct {
+	Ctx      []byte `json:"ctx"`      // serialized protos.Context
+	Deadline int64  `json:"deadline"` // UnixNano time of the session's timeout
+}
+
+// persistentSession - memSession which is persisted on every Unlock
+type persistentSession struct {
+	*memSession
+	table *persistentSessionTable
+}
+
+// Unlock - persists the Session's context & unlocks the Session's mutex
+func (s *persistentSession) Unlock() {
+	if s != nil && s.memSession != nil {
+		s.table.persist(s.memSession)
+		s.memSession.Unlock()
+	}
+}
+
+// persistentSessionTable - session table keeping its sessions in memory & writing them through to an object store.
+// Sessions added by other instances sharing the store are loaded on demand, a session's timeout only fires once its
+// stored timeout expired, so instances refreshing the session's timeout keep it alive on all the instances.
+// Sessions removed by another instance are dropped from memory on their timeout.
+type persistentSessionTable struct {
+	mem      *memSessionTable
+	sessions object_store.ObjectMap // stored sessions by SID
+	sids     object_store.ObjectMap // SIDs by IMSI
+	notifier aaa.TimeoutNotifier    // notifier of restored & loaded sessions
+	rwl      sync.RWMutex           // R/W lock synchronizing notifier access
+}
+
+// NewPersistentSessionTable - returns a new session table storing its sessions & their SIDs by IMSI in the given maps
+func NewPersistentSessionTable(sessions, sids object_store.ObjectMap) aaa.PersistentSessionTable {
+	return &persistentSessionTable{
+		mem:      NewMemorySessionTable().(*memSessionTable),
+		sessions: sessions,
+		sids:     sids,
+	}
+}
+
+// NewRedisSessionTable - returns a new session table stored in redis, which can be shared by multiple AAA servers
+func NewRedisSessionTable(client object_store.RedisClient) aaa.PersistentSessionTable {
+	return NewPersistentSessionTable(
+		object_store.NewRedisMap(client, redisSessionsHash, serializeSession, deserializeSession),
+		object_store.NewRedisMap(client, redisSidsHash, serializeSid, deserializeSid))
+}
+
+// NewLocalSessionTable - returns a new session table stored in files under the given directory
+func NewLocalSessionTable(dir string) (aaa.PersistentSessionTable, error) {
+	if len(dir) == 0 {
+		dir = DefaultLocalStorePath
+	}
+	sessions, err := object_store.NewFileMap(filepath.Join(dir, "sessions"), serializeSession, deserializeSession)
+	if err != nil {
+		return nil, err
+	}
+	sids, err := object_store.NewFileMap(filepath.Join(dir, "sids"), serializeSid, deserializeSid)
+	if err != nil {
+		return nil, err
+	}
+	return NewPersistentSessionTable(sessions, sids), nil
+}
+
+// AddSession - adds a new session to the table & returns the newly created session pointer.
+// If a session with the same ID already is in the table - returns "Session with SID: XYZ already exist" as well as the
+// existing session.
+func (st *persistentSessionTable) AddSession(
+	pc *protos.Context, tout time.Duration, notifier aaa.TimeoutNotifier, overwrite ...bool) (aaa.Session, error) {
+
+	if st == nil {
+		return nil, fmt.Errorf("Nil SessionTable")
+	}
+	if pc != nil && (len(overwrite) == 0 || !overwrite[0]) && st.mem.get(pc.GetSessionId()) == nil {
+		st.load(pc.GetSessionId()) // the session may have been added by another instance
+	}
+	s, err := st.mem.AddSession(pc, tout, st.timeoutNotifier(notifier), overwrite...)
+	ms, _ := s.(*memSession)
+	if err != nil {
+		return st.wrap(ms), err
+	}
+	ps := st.wrap(ms)
+	ps.Lock()
+	ps.Unlock() // persists the new session
+	return ps, nil
+}
+
+// GetSession returns session corresponding to the given sid or nil if not found
+func (st *persistentSessionTable) GetSession(sid string) aaa.Session {
+	if st == nil {
+		return nil
+	}
+	ms := st.mem.get(sid)
+	if ms == nil {
+		ms = st.load(sid)
+	}
+	return st.wrap(ms)
+}
+
+// FindSession returns session ID corresponding to the given IMSI (empty string if not found)
+func (st *persistentSessionTable) FindSession(imsi string) string {
+	if st == nil {
+		return ""
+	}
+	if sid := st.mem.FindSession(imsi); len(sid) > 0 {
+		return sid
+	}
+	obj, err := st.sids.Get(imsi)
+	if err != nil {
+		return ""
+	}
+	sid, _ := obj.(string)
+	ms := st.mem.get(sid)
+	if ms == nil {
+		ms = st.load(sid)
+	}
+	if ms == nil || ms.imsi != imsi {
+		return ""
+	}
+	return sid
+}
+
+// RemoveSession - removes the session with the given SID and returns it, returns nil if not found
+func (st *persistentSessionTable) RemoveSession(sid string) aaa.Session {
+	if st == nil {
+		return nil
+	}
+	ms, _ := st.mem.RemoveSession(sid).(*memSession)
+	if ms == nil {
+		// the session may have been added by another instance
+		obj, err := st.sessions.Get(sid)
+		if err != nil {
+			return nil
+		}
+		pc, err := unmarshalContext(obj)
+		if err != nil {
+			st.remove(sid, "")
+			return nil
+		}
+		ms = &memSession{Context: pc, imsi: pc.GetImsi()}
+	}
+	// wait for a concurrent Unlock to complete its write, so the session cannot be stored again
+	ms.Lock()
+	st.remove(sid, ms.imsi)
+	ms.Unlock()
+	return ms
+}
+
+// SetTimeout - [Re]sets the session's cleanup timeout to fire after tout duration
+func (st *persistentSessionTable) SetTimeout(sid string, tout time.Duration, notifier aaa.TimeoutNotifier) bool {
+	if st == nil || (st.mem.get(sid) == nil && st.load(sid) == nil) {
+		return false
+	}
+	if !st.mem.SetTimeout(sid, tout, st.timeoutNotifier(notifier)) {
+		return false
+	}
+	if ms := st.mem.get(sid); ms != nil {
+		ms.Lock()
+		st.persist(ms)
+		ms.Unlock()
+	}
+	return true
+}
+
+// Restore loads the stored sessions & [re]arms their timeouts, returns the number of restored sessions.
+// The notifier is called on timeout of restored sessions & of sessions added by other instances.
+func (st *persistentSessionTable) Restore(notifier aaa.TimeoutNotifier) (int, error) {
+	if st == nil {
+		return 0, fmt.Errorf("Nil SessionTable")
+	}
+	st.rwl.Lock()
+	st.notifier = notifier
+	st.rwl.Unlock()
+
+	stored, err := st.sessions.GetAll()
+	if err != nil {
+		return 0, err
+	}
+	var restored int
+	for sid, obj := range stored {
+		if st.restore(sid, obj, notifier, 0) != nil {
+			restored++
+		}
+	}
+	return restored, nil
+}
+
+func (st *persistentSessionTable) wrap(ms *memSession) aaa.Session {
+	if ms == nil {
+		return nil
+	}
+	return &persistentSession{memSession: ms, table: st}
+}
+
+// persist writes the session through to the store, must be called on a Locked session
+func (st *persistentSessionTable) persist(ms *memSession) {
+	pc := ms.GetCtx()
+	sid := pc.GetSessionId()
+	if st.mem.get(sid) != ms {
+		return // removed or timed out
+	}
+	serialized, err := proto.Marshal(pc)
+	if err != nil {
+		log.Printf("Error serializing session %s: %v", sid, err)
+		return
+	}
+	err = st.sessions.Set(sid, &storedSession{Ctx: serialized, Deadline: atomic.LoadInt64(&ms.deadline)})
+	if err != nil {
+		log.Printf("Error storing session %s: %v", sid, err)
+		return
+	}
+	if err = st.sids.Set(ms.imsi, sid); err != nil {
+		log.Printf("Error storing session ID %s of IMSI %s: %v", sid, ms.imsi, err)
+	}
+}
+
+// remove deletes the session from the store
+func (st *persistentSessionTable) remove(sid, imsi string) {
+	if err := st.sessions.Delete(sid); err != nil {
+		log.Printf("Error deleting stored session %s: %v", sid, err)
+	}
+	if obj, err := st.sids.Get(imsi); err == nil && obj == sid {
+		if err = st.sids.Delete(imsi); err != nil {
+			log.Printf("Error deleting stored session ID of IMSI %s: %v", imsi, err)
+		}
+	}
+}
+
+// load adds the stored session with the given SID to the memory table & returns it, returns nil if not found
+func (st *persistentSessionTable) load(sid string) *memSession {
+	obj, err := st.sessions.Get(sid)
+	if err != nil {
+		return nil
+	}
+	st.rwl.RLock()
+	notifier := st.notifier
+	st.rwl.RUnlock()
+	return st.restore(sid, obj, notifier, sharedTimeoutGrace)
+}
+
+// restore adds the stored session to the memory table with the remainder of its timeout plus grace & returns it,
+// returns the session already in the table if any
+func (st *persistentSessionTable) restore(
+	sid string, obj interface{}, notifier aaa.TimeoutNotifier, grace time.Duration) *memSession {
+
+	pc, err := unmarshalContext(obj)
+	if err != nil {
+		log.Printf("Error restoring session %s: %v", sid, err)
+		return nil
+	}
+	deadline := obj.(*storedSession).Deadline
+	s, err := st.mem.AddSession(pc, time.Until(time.Unix(0, deadline))+grace, st.timeoutNotifier(notifier))
+	ms, _ := s.(*memSession)
+	if err == nil && ms != nil {
+		atomic.StoreInt64(&ms.deadline, deadline)
+	}
+	return ms
+}
+
+// timeoutNotifier wraps the session's timeout notifier, the session only times out if its stored timeout expired
+// as well & it is removed from the store before the notification. Sessions without a notifier use the restore one.
+func (st *persistentSessionTable) timeoutNotifier(notifier aaa.TimeoutNotifier) aaa.TimeoutNotifier {
+	return func(s aaa.Session) error {
+		ms, ok := s.(*memSession)
+		if !ok || ms == nil {
+			return nil
+		}
+		sid := ms.GetSessionId()
+		obj, err := st.sessions.Get(sid)
+		if err != nil {
+			return nil // removed or timed out by another instance
+		}
+		if stored, ok := obj.(*storedSession); ok && stored.Deadline > atomic.LoadInt64(&ms.deadline) {
+			st.restore(sid, obj, notifier, sharedTimeoutGrace) // the timeout was reset by another instance
+			return nil
+		}
+		st.remove(sid, ms.imsi)
+		if notifier == nil {
+			st.rwl.RLock()
+			notifier = st.notifier
+			st.rwl.RUnlock()
+		}
+		if notifier != nil {
+			return notifier(s)
+		}
+		return nil
+	}
+}
+
+// get returns the session with the given SID or nil if not found
+func (st *memSessionTable) get(sid string) *memSession {
+	st.rwl.RLock()
+	defer st.rwl.RUnlock()
+	return st.sm[sid]
+}
+
+func unmarshalContext(obj interface{}) (*protos.Context, error) {
+	stored, ok := obj.(*storedSession)
+	if !ok {
+		return nil, fmt.Errorf("Invalid stored session type: %T", obj)
+	}
+	pc := &protos.Context{}
+	return pc, proto.Unmarshal(stored.Ctx, pc)
+}
+
+func serializeSession(object interface{}) (string, error) {
+	stored, ok := object.(*storedSession)
+	if !ok {
+		return "", fmt.Errorf("Invalid session type: %T", object)
+	}
+	serialized, err := json.Marshal(stored)
+	return string(serialized), err
+}
+
+func deserializeSession(serialized string) (interface{}, error) {
+	stored := &storedSession{}
+	return stored, json.Unmarshal([]byte(serialized), stored)
+}
+
+func serializeSid(object interface{}) (string, error) {
+	sid, ok := object.(string)
+	if !ok {
+		return "", fmt.Errorf("Invalid session ID type: %T", object)
+	}
+	return sid, nil
+}
+
+func deserializeSid(serialized string) (interface{}, error) {
+	return serialized, nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package store_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"magma/feg/gateway/services/aaa"
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/aaa/store"
+)
+
+type mockRedisClient struct {
+	sync.Mutex
+	hashes map[string]map[string]string
+}
+
+func (client *mockRedisClient) HSet(hash string, field string, value string) error {
+	client.Lock()
+	defer client.Unlock()
+	if _, ok := client.hashes[hash]; !ok {
+		client.hashes[hash] = map[string]string{}
+	}
+	client.hashes[hash][field] = value
+	return nil
+}
+
+func (client *mockRedisClient) HGet(hash string, field string) (string, error) {
+	client.Lock()
+	defer client.Unlock()
+	value, ok := client.hashes[hash][field]
+	if !ok {
+		return "", fmt.Errorf("Not found: %s", field)
+	}
+	return value, nil
+}
+
+func (client *mockRedisClient) HGetAll(hash string) (map[string]string, error) {
+	client.Lock()
+	defer client.Unlock()
+	res := map[string]string{}
+	for field, value := range client.hashes[hash] {
+		res[field] = value
+	}
+	return res, nil
+}
+
+func (client *mockRedisClient) HDel(hash string, field string) error {
+	client.Lock()
+	defer client.Unlock()
+	delete(client.hashes[hash], field)
+	return nil
+}
+
+// testStore creates session tables, which share the same store, i.e. of restarted or replicated AAA servers
+type testStore func(t *testing.T) aaa.PersistentSessionTable
+
+func TestPersistentSessionTable(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aaa_session_test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	local := func(t *testing.T) aaa.PersistentSessionTable {
+		st, err := store.NewLocalSessionTable(dir)
+		assert.NoError(t, err)
+		return st
+	}
+	redisClient := &mockRedisClient{hashes: map[string]map[string]string{}}
+	redis := func(t *testing.T) aaa.PersistentSessionTable {
+		return store.NewRedisSessionTable(redisClient)
+	}
+	for name, newTable := range map[string]testStore{"local": local, "redis": redis} {
+		t.Run(name+"/restart", func(t *testing.T) { testRestart(t, newTable) })
+		t.Run(name+"/replicas", func(t *testing.T) { testReplicas(t, newTable) })
+	}
+}
+
+func testRestart(t *testing.T, newTable testStore) {
+	st := newTable(t)
+	_, err := st.Restore(nil)
+	assert.NoError(t, err)
+
+	sid, imsi := aaa.CreateSessionId(), "001010000000001"
+	s, err := st.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Minute, nil)
+	assert.NoError(t, err)
+	s.Lock()
+	s.GetCtx().AcctSessionId = "acct-1"
+	s.Unlock()
+	timedOutSid := aaa.CreateSessionId()
+	s, err = st.AddSession(&protos.Context{SessionId: timedOutSid, Imsi: "001010000000002"}, time.Minute, nil)
+	assert.NoError(t, err)
+	assert.True(t, st.SetTimeout(timedOutSid, time.Millisecond*100, nil))
+	s.StopTimeout() // the server goes away before the timeout fires
+	removedSid := aaa.CreateSessionId()
+	_, err = st.AddSession(&protos.Context{SessionId: removedSid, Imsi: "001010000000003"}, time.Minute, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, st.RemoveSession(removedSid))
+
+	// restart
+	var timedOut int32
+	st = newTable(t)
+	restored, err := st.Restore(func(s aaa.Session) error {
+		if s.GetCtx().GetSessionId() == timedOutSid {
+			atomic.AddInt32(&timedOut, 1)
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, restored)
+	assert.Equal(t, sid, st.FindSession(imsi))
+	s = st.GetSession(sid)
+	if assert.NotNil(t, s) {
+		s.Lock()
+		assert.Equal(t, "acct-1", s.GetCtx().GetAcctSessionId())
+		s.Unlock()
+	}
+	assert.Nil(t, st.GetSession(removedSid))
+
+	time.Sleep(time.Millisecond * 300)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&timedOut))
+	assert.Nil(t, st.GetSession(timedOutSid))
+	assert.Equal(t, "", st.FindSession("001010000000002"))
+
+	assert.NotNil(t, st.RemoveSession(sid))
+	restored, err = newTable(t).Restore(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, restored)
+}
+
+func testReplicas(t *testing.T, newTable testStore) {
+	var timedOut1, timedOut2 int32
+	st1, st2 := newTable(t), newTable(t)
+	_, err := st1.Restore(func(aaa.Session) error { atomic.AddInt32(&timedOut1, 1); return nil })
+	assert.NoError(t, err)
+	_, err = st2.Restore(func(aaa.Session) error { atomic.AddInt32(&timedOut2, 1); return nil })
+	assert.NoError(t, err)
+
+	// sessions authenticated by one replica are visible to the other one
+	sid, imsi := aaa.CreateSessionId(), "001010000000004"
+	_, err = st1.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Millisecond*200, nil)
+	assert.NoError(t, err)
+	_, err = st2.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Minute, nil)
+	assert.Error(t, err)
+	assert.Equal(t, sid, st2.FindSession(imsi))
+	s := st2.GetSession(sid)
+	if assert.NotNil(t, s) {
+		s.Lock()
+		s.GetCtx().Apn = "wifi"
+		s.Unlock()
+	}
+
+	// the timeout reset by one replica keeps the session alive on the other one
+	assert.True(t, st2.SetTimeout(sid, time.Millisecond*600, nil))
+	time.Sleep(time.Millisecond * 400)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&timedOut1))
+	s = st1.GetSession(sid)
+	if assert.NotNil(t, s) {
+		s.Lock()
+		assert.Equal(t, "wifi", s.GetCtx().GetApn())
+		s.Unlock()
+	}
+	// the replica which reset the timeout times the session out, the other one drops it
+	time.Sleep(time.Millisecond * 500)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&timedOut2))
+	assert.Nil(t, st2.GetSession(sid))
+	time.Sleep(time.Millisecond * 1200)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&timedOut1))
+	assert.Nil(t, st1.GetSession(sid))
+
+	// sessions removed by one replica are gone on the other one
+	_, err = st1.AddSession(&protos.Context{SessionId: sid, Imsi: imsi}, time.Minute, nil)
+	assert.NoError(t, err)
+	assert.NotNil(t, st2.GetSession(sid))
+	removed := st2.RemoveSession(sid)
+	if assert.NotNil(t, removed) {
+		assert.Equal(t, imsi, removed.GetCtx().GetImsi())
+	}
+	assert.Nil(t, st2.GetSession(sid))
+	assert.Equal(t, "", st2.FindSession(imsi))
+}
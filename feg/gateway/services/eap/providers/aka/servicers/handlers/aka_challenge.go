@@ -22,14 +22,15 @@ import (
 	"magma/feg/gateway/services/eap/providers/aka"
 	"magma/feg/gateway/services/eap/providers/aka/metrics"
 	"magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 )
 
 func init() {
 	servicers.AddHandler(aka.SubtypeChallenge, challengeResponse)
 }
 
-// challengeResponse implements handler for AKA Challenge Response,
-// see https://tools.ietf.org/html/rfc4187#page-49 for details
+// challengeResponse implements handler for AKA & AKA' Challenge Response,
+// see https://tools.ietf.org/html/rfc4187#page-49 & https://tools.ietf.org/html/rfc5448#section-3 for details
 func challengeResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packet) (eap.Packet, error) {
 	var (
 		success    bool
@@ -72,6 +73,13 @@ func challengeResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Pack
 			state, imsi, ctx.SessionId)
 	}
 
+	if req.Type() != uc.Method {
+		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.InvalidArgument,
+			"Unexpected EAP Method %d of Challenge Response for Session ID: %s, expected: %d",
+			req.Type(), ctx.SessionId, uc.Method)
+	}
+
 	p := make([]byte, len(req))
 	copy(p, req)
 	scanner, err := eap.NewAttributeScanner(p)
@@ -96,6 +104,15 @@ attrLoop:
 				break attrLoop
 			}
 		case aka.AT_CHECKCODE: // Ignore CHECKCODE for now
+		case aka_prime.AT_KDF:
+			if uc.Method == aka_prime.TYPE {
+				// The peer asks for another KDF, the only KDF defined & offered is AKA' KDF, see
+				// https://tools.ietf.org/html/rfc5448#section-3.2
+				s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+				return aka.EapErrorResPacket(identifier, aka.NOTIFICATION_FAILURE, codes.Unimplemented,
+					"Unsupported AT_KDF %x requested for Session ID: %s; IMSI: %s", a.Value(), ctx.SessionId, imsi)
+			}
+			log.Printf("INFO: Unexpected EAP-AKA Challenge Response Attribute type %d", a.Type())
 		default:
 			log.Printf("INFO: Unexpected EAP-AKA Challenge Response Attribute type %d", a.Type())
 		}
@@ -124,7 +141,12 @@ attrLoop:
 	for i := aka.ATT_HDR_LEN; i < len(macBytes); i++ {
 		macBytes[i] = 0
 	}
-	mac := aka.GenMac(p, uc.K_aut)
+	var mac []byte
+	if uc.Method == aka_prime.TYPE {
+		mac = aka_prime.GenMac(p, uc.K_aut)
+	} else {
+		mac = aka.GenMac(p, uc.K_aut)
+	}
 	if !reflect.DeepEqual(ueMac, mac) {
 		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
 		log.Printf(
@@ -141,6 +163,11 @@ attrLoop:
 		log.Printf("Invalid AT_RES for Session ID: %s; IMSI: %s\n\t%.3v !=\n\t%.3v",
 			sessionId, imsi, ueRes, uc.Xres)
 		s.UpdateSessionUnlockCtx(uc, s.NotificationTimeout())
+		if uc.Method == aka_prime.TYPE {
+			return aka_prime.EapErrorResPacketWithMac(
+				identifier, aka.NOTIFICATION_FAILURE_AUTH, uc.K_aut, codes.Unauthenticated,
+				"Invalid AT_RES for Session ID: %s; IMSI: %s", ctx.SessionId, imsi)
+		}
 		return aka.EapErrorResPacketWithMac(
 			identifier, aka.NOTIFICATION_FAILURE_AUTH, uc.K_aut, codes.Unauthenticated,
 			"Invalid AT_RES for Session ID: %s; IMSI: %s", ctx.SessionId, imsi)
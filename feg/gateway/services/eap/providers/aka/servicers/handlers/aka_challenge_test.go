@@ -15,7 +15,9 @@ import (
 	"magma/feg/gateway/registry"
 	"magma/feg/gateway/services/aaa/protos"
 	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
 	"magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 	"magma/orc8r/cloud/go/test_utils"
 )
 
@@ -60,3 +62,81 @@ func TestAkaChallengeResp(t *testing.T) {
 	}
 
 }
+
+func TestAkaPrimeChallengeResp(t *testing.T) {
+	srv, lis := test_utils.NewTestService(t, registry.ModuleName, registry.SWX_PROXY)
+	service := testSwxProxy{authSchemes: make(chan cp.AuthenticationScheme, 10)}
+	cp.RegisterSwxProxyServer(srv.GrpcServer, service)
+	go srv.RunTest(lis)
+
+	akaSrv, _ := servicers.NewEapAkaService(nil)
+	eapCtx := &protos.Context{}
+	// AKA' Identity Response with '6' prefixed permanent identity
+	identityResp := []byte(testEapIdentityResp)
+	identityResp[eap.EapMsgMethodType], identityResp[12] = aka_prime.TYPE, aka_prime.PermanentIdPrefix
+	p, err := identityResponse(akaSrv, eapCtx, eap.Packet(identityResp))
+	if err != nil {
+		t.Fatalf("Unexpected identityResponse error: %v", err)
+	}
+	if eapCtx.Imsi != "001010000000055" {
+		t.Fatalf("Unexpected IMSI: %s", eapCtx.Imsi)
+	}
+	if p.Type() != aka_prime.TYPE || aka.Subtype(p[eap.EapSubtype]) != aka.SubtypeChallenge {
+		t.Fatalf("Unexpected identityResponse EAP: %v", p)
+	}
+	attrs, err := aka_prime.ParseChallengeAttributes(p)
+	if err != nil {
+		t.Fatalf("Unexpected AKA' Challenge attributes error: %v", err)
+	}
+	if attrs.NetworkName != aka_prime.DefaultNetworkName ||
+		!reflect.DeepEqual(attrs.KDFs, []uint16{aka_prime.KDF_AKA_PRIME}) {
+		t.Fatalf("Unexpected AKA' Challenge attributes: %+v", attrs)
+	}
+
+	// AKA' vectors are requested from the HSS
+	if scheme := <-service.authSchemes; scheme != cp.AuthenticationScheme_EAP_AKA_PRIME {
+		t.Fatalf("Unexpected SWx authentication scheme: %v", scheme)
+	}
+
+	// Peer side keys derivation from the USIM's CK & IK
+	av, _ := service.Authenticate(nil, &cp.AuthenticationRequest{})
+	vector := av.SipAuthVectors[0]
+	autn := vector.RandAutn[aka.RAND_LEN:]
+	CKPrime, IKPrime := aka_prime.MakeCKIKPrime(
+		vector.ConfidentialityKey, vector.IntegrityKey, attrs.NetworkName, autn[:aka_prime.SQN_XOR_AK_LEN])
+	_, K_aut, _, MSK, _ := aka_prime.MakeAKAPrimeKeys(identityResp[12:63], IKPrime, CKPrime)
+
+	serverMac := make([]byte, aka.MAC_LEN)
+	copy(serverMac, p[len(p)-aka.MAC_LEN:])
+	copy(p[len(p)-aka.MAC_LEN:], make([]byte, aka.MAC_LEN))
+	if mac := aka_prime.GenMac(p, K_aut); !reflect.DeepEqual(mac, serverMac) {
+		t.Fatalf("Invalid AKA' Challenge MAC\n\tReceived: %v\n\tExpected: %v", serverMac, mac)
+	}
+
+	// A peer asking for an unsupported KDF fails
+	kdfResp := eap.NewPacket(eap.ResponseCode, p.Identifier(), []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	kdfResp, _ = kdfResp.Append(aka_prime.NewKDFAttribute(2))
+	rp, err := challengeResponse(akaSrv, eapCtx, kdfResp)
+	if err != nil || aka.Subtype(rp[eap.EapSubtype]) != aka.SubtypeNotification {
+		t.Fatalf("Unexpected AT_KDF challengeResponse EAP: %v, error: %v", rp, err)
+	}
+	_, err = identityResponse(akaSrv, eapCtx, eap.Packet(identityResp))
+	if err != nil {
+		t.Fatalf("Unexpected identityResponse error: %v", err)
+	}
+
+	challengeResp := eap.NewPacket(
+		eap.ResponseCode, p.Identifier(), []byte{aka_prime.TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	challengeResp, _ = challengeResp.Append(eap.NewAttribute(aka.AT_RES, append([]byte{0, 64}, vector.Xres...)))
+	challengeResp, _ = aka_prime.AppendMac(challengeResp, K_aut)
+	rp, err = challengeResponse(akaSrv, eapCtx, challengeResp)
+	if err != nil {
+		t.Fatalf("Unexpected challengeResponse error: %v", err)
+	}
+	if !reflect.DeepEqual([]byte(rp), successEAP) {
+		t.Fatalf("Unexpected challengeResponse EAP\n\tReceived: %v\n\tExpected: %v", rp, successEAP)
+	}
+	if !reflect.DeepEqual(eapCtx.Msk, MSK) {
+		t.Fatalf("Unexpected MSK\n\tReceived: %v\n\tExpected: %v", eapCtx.Msk, MSK)
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"magma/feg/gateway/services/eap/providers/aka"
 	"magma/feg/gateway/services/eap/providers/aka/metrics"
 	"magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 )
 
 func init() {
@@ -52,12 +53,16 @@ func identityResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packe
 	}
 	var a eap.Attribute
 
+	method, permanentIdPrefix := req.Type(), byte('0')
+	if method == aka_prime.TYPE {
+		permanentIdPrefix = aka_prime.PermanentIdPrefix
+	}
 	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
 		// Find first valid AT_IDENTITY attribute to get UE IMSI
 		if a.Type() == aka.AT_IDENTITY {
 			identity, imsi, err := getIMSIIdentity(a)
 			if err == nil {
-				if imsi[0] != '0' {
+				if imsi[0] != permanentIdPrefix {
 					log.Printf("AKA AT_IDENTITY '%s' (IMSI: %s) is non-permanent type", identity, imsi)
 				} else {
 					imsi = imsi[1:]
@@ -79,6 +84,7 @@ func identityResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packe
 						state, t, imsi, uc.Identity)
 				}
 				uc.Identity = identity
+				uc.Method = method
 				uc.SetState(aka.StateIdentity)
 				p, err := createChallengeRequest(s, uc, identifier, nil)
 				if success = err == nil; success {
@@ -100,7 +106,7 @@ func identityResponse(s *servicers.EapAkaSrv, ctx *protos.Context, req eap.Packe
 		identifier, aka.NOTIFICATION_FAILURE, codes.FailedPrecondition, "Missing AT_IDENTITY Attribute")
 }
 
-// see https://tools.ietf.org/html/rfc4187#section-4.1.1.4
+// see https://tools.ietf.org/html/rfc4187#section-4.1.1.4 & https://tools.ietf.org/html/rfc5448#section-3
 func getIMSIIdentity(a eap.Attribute) (string, aka.IMSI, error) {
 	if a.Type() != aka.AT_IDENTITY {
 		return "", "", fmt.Errorf("Unexpected Attr Type: %d, AT_IDENTITY expected", a.Type())
@@ -121,5 +127,8 @@ func getIMSIIdentity(a eap.Attribute) (string, aka.IMSI, error) {
 	} else {
 		imsi = aka.IMSI(fullIdentity)
 	}
+	if len(imsi) == aka.MaxImsiLen && imsi[0] == aka_prime.PermanentIdPrefix {
+		return fullIdentity, imsi, imsi[1:].Validate() // AKA' permanent identity
+	}
 	return fullIdentity, imsi, imsi.Validate()
 }
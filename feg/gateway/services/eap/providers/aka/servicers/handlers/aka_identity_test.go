@@ -13,6 +13,7 @@ import (
 
 	"magma/feg/gateway/services/eap"
 	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 
 	"golang.org/x/net/context"
 
@@ -23,7 +24,10 @@ import (
 	"magma/orc8r/cloud/go/test_utils"
 )
 
-type testSwxProxy struct{}
+type testSwxProxy struct {
+	// authSchemes receives the authentication scheme of every Authenticate request, if set
+	authSchemes chan cp.AuthenticationScheme
+}
 
 // Test SwxProxyServer implementation
 //
@@ -33,19 +37,28 @@ func (s testSwxProxy) Authenticate(
 	ctx context.Context,
 	req *cp.AuthenticationRequest,
 ) (*cp.AuthenticationAnswer, error) {
+	if s.authSchemes != nil {
+		s.authSchemes <- req.AuthenticationScheme
+	}
+	vector := &cp.AuthenticationAnswer_SIPAuthVector{
+		AuthenticationScheme: req.AuthenticationScheme,
+		RandAutn: []byte(
+			"\x01\x23\x45\x67\x89\xab\xcd\xef\x01\x23\x45\x67\x89\xab\xcd\xef" +
+				"\x54\xab\x64\x4a\x90\x51\xb9\xb9\x5e\x85\xc1\x22\x3e\x0e\xf1\x4c"),
+		Xres:               []byte("\x29\x5c\x00\xea\xe3\x88\x93\x0d"),
+		ConfidentialityKey: []byte("\xa8\x35\xcf\x22\xb0\xf4\x3e\x15\x19\xd6\xfd\x23\x4c\x00\xd7\x93"),
+		IntegrityKey:       []byte("\xd5\x37\x0f\x13\x79\x6f\x2f\x61\x5c\xbe\x15\xef\x9f\x42\x0a\x98"),
+	}
+	if req.AuthenticationScheme == cp.AuthenticationScheme_EAP_AKA_PRIME {
+		// Like the HSS, set the AMF separation bit & return CK' & IK'
+		autn := vector.RandAutn[aka.RAND_LEN:]
+		autn[aka_prime.SQN_XOR_AK_LEN] |= aka_prime.AMF_SEPARATION_BIT
+		vector.ConfidentialityKey, vector.IntegrityKey = aka_prime.MakeCKIKPrime(
+			vector.ConfidentialityKey, vector.IntegrityKey, aka_prime.DefaultNetworkName, autn[:aka_prime.SQN_XOR_AK_LEN])
+	}
 	return &cp.AuthenticationAnswer{
-		UserName: req.GetUserName(),
-		SipAuthVectors: []*cp.AuthenticationAnswer_SIPAuthVector{
-			&cp.AuthenticationAnswer_SIPAuthVector{
-				AuthenticationScheme: req.AuthenticationScheme,
-				RandAutn: []byte(
-					"\x01\x23\x45\x67\x89\xab\xcd\xef\x01\x23\x45\x67\x89\xab\xcd\xef" +
-						"\x54\xab\x64\x4a\x90\x51\xb9\xb9\x5e\x85\xc1\x22\x3e\x0e\xf1\x4c"),
-				Xres:               []byte("\x29\x5c\x00\xea\xe3\x88\x93\x0d"),
-				ConfidentialityKey: []byte("\xa8\x35\xcf\x22\xb0\xf4\x3e\x15\x19\xd6\xfd\x23\x4c\x00\xd7\x93"),
-				IntegrityKey:       []byte("\xd5\x37\x0f\x13\x79\x6f\x2f\x61\x5c\xbe\x15\xef\x9f\x42\x0a\x98"),
-			},
-		},
+		UserName:       req.GetUserName(),
+		SipAuthVectors: []*cp.AuthenticationAnswer_SIPAuthVector{vector},
 	}, nil
 }
 
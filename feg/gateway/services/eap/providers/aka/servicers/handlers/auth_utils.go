@@ -19,6 +19,7 @@ import (
 	"magma/feg/gateway/services/eap/providers/aka"
 	"magma/feg/gateway/services/eap/providers/aka/metrics"
 	"magma/feg/gateway/services/eap/providers/aka/servicers"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 	"magma/feg/gateway/services/swx_proxy"
 )
 
@@ -71,11 +72,15 @@ func createChallengeRequest(
 	metrics.SwxRequests.Inc()
 	swxStartTime := time.Now()
 
+	authScheme := swx_protos.AuthenticationScheme_EAP_AKA
+	if lockedCtx.Method == aka_prime.TYPE {
+		authScheme = swx_protos.AuthenticationScheme_EAP_AKA_PRIME
+	}
 	ans, err := swx_proxy.Authenticate(
 		&swx_protos.AuthenticationRequest{
 			UserName:             string(lockedCtx.Imsi),
 			SipNumAuthVectors:    1,
-			AuthenticationScheme: swx_protos.AuthenticationScheme_EAP_AKA,
+			AuthenticationScheme: authScheme,
 			ResyncInfo:           resyncInfo,
 			RetrieveUserProfile:  true,
 		})
@@ -118,6 +123,13 @@ func createChallengeRequest(
 	lockedCtx.Profile = ans.GetUserProfile()
 	lockedCtx.AuthSessionId = ans.GetSessionId()
 
+	// For EAP-AKA' the HSS returns CK' & IK' instead of CK & IK (3GPP TS 33.402, 6.2)
+	IK := av.GetIntegrityKey()
+	CK := av.GetConfidentialityKey()
+	if lockedCtx.Method == aka_prime.TYPE {
+		return createAkaPrimeChallengeRequest(lockedCtx, identifier, autn, IK, CK)
+	}
+
 	// Clone EAP Challenge packet
 	p := eap.Packet(make([]byte, challengeReqTemplateLen))
 	copy(p, challengeReqTemplate)
@@ -132,11 +144,23 @@ func createChallengeRequest(
 	copy(p[atAutnOffset:], autn)
 
 	// Calculate AT_MAC
-	IK := av.GetIntegrityKey()
-	CK := av.GetConfidentialityKey()
 	_, lockedCtx.K_aut, lockedCtx.MSK, _ = aka.MakeAKAKeys([]byte(lockedCtx.Identity), IK, CK)
 	mac := aka.GenMac(p, lockedCtx.K_aut)
 	// Set AT_MAC
 	copy(p[atMacOffset:], mac)
 	return p, nil
 }
+
+// createAkaPrimeChallengeRequest returns AKA'-Challenge for the vector's CK' & IK', offering the AKA' KDF with the
+// network name the HSS derived them with, see https://tools.ietf.org/html/rfc5448#section-3
+func createAkaPrimeChallengeRequest(
+	lockedCtx *servicers.UserCtx, identifier uint8, autn, IKPrime, CKPrime []byte) (eap.Packet, error) {
+
+	_, lockedCtx.K_aut, _, lockedCtx.MSK, _ = aka_prime.MakeAKAPrimeKeys([]byte(lockedCtx.Identity), IKPrime, CKPrime)
+	p, err := aka_prime.NewChallengeReq(identifier, lockedCtx.Rand, autn, aka_prime.DefaultNetworkName, lockedCtx.K_aut)
+	if err != nil {
+		return aka.EapErrorResPacket(
+			identifier, aka.NOTIFICATION_FAILURE, codes.Internal, "Error creating AKA' Challenge: %v", err)
+	}
+	return p, nil
+}
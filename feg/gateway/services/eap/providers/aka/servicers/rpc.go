@@ -20,9 +20,10 @@ import (
 	"magma/feg/gateway/services/eap/client"
 	"magma/feg/gateway/services/eap/providers/aka"
 	"magma/feg/gateway/services/eap/providers/aka/metrics"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 )
 
-// Handle implements AKA handler RPC
+// Handle implements AKA & AKA' handler RPC
 func (s *EapAkaSrv) Handle(ctx context.Context, req *protos.Eap) (*protos.Eap, error) {
 	failure := true
 	metrics.Requests.Inc()
@@ -53,7 +54,7 @@ func (s *EapAkaSrv) Handle(ctx context.Context, req *protos.Eap) (*protos.Eap, e
 	if method == client.EapMethodIdentity {
 		return &protos.Eap{Payload: aka.NewIdentityReq(identifier+1, aka.AT_PERMANENT_ID_REQ), Ctx: eapCtx}, nil
 	}
-	if method != aka.TYPE {
+	if method != aka.TYPE && method != aka_prime.TYPE {
 		return aka.EapErrorRes(
 			identifier, aka.NOTIFICATION_FAILURE, codes.Unimplemented, eapCtx, "Wrong EAP Method: %d", method)
 	}
@@ -70,5 +71,9 @@ func (s *EapAkaSrv) Handle(ctx context.Context, req *protos.Eap) (*protos.Eap, e
 	}
 	rp, err := h(s, eapCtx, p)
 	failure = err != nil
+	if method == aka_prime.TYPE && rp.Code() == eap.RequestCode && rp.Type() == aka.TYPE {
+		// AKA' shares subtypes & attributes with AKA, unsigned AKA requests (notifications) are sent as AKA'
+		rp[eap.EapMsgMethodType] = aka_prime.TYPE
+	}
 	return &protos.Eap{Payload: rp, Ctx: eapCtx}, err
 }
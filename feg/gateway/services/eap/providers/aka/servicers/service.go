@@ -31,6 +31,7 @@ type UserCtx struct {
 	Imsi       aka.IMSI
 	Profile    *protos.AuthenticationAnswer_UserProfile
 	Identifier uint8
+	Method     uint8 // EAP Method of the session: AKA or AKA'
 	Rand,
 	K_aut,
 	MSK,
@@ -0,0 +1,93 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package aka_prime
+
+import (
+	"fmt"
+	"io"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// ChallengeAttributes - AKA' specific attributes of EAP-Request/AKA'-Challenge
+type ChallengeAttributes struct {
+	NetworkName string   // AT_KDF_INPUT
+	KDFs        []uint16 // AT_KDF values in the server's order of preference
+}
+
+// NewChallengeReq returns a new EAP-Request/AKA'-Challenge with AT_RAND, AT_AUTN, AT_KDF_INPUT, AT_KDF & AT_MAC
+// signed with K_aut, see https://tools.ietf.org/html/rfc5448#section-3
+func NewChallengeReq(identifier uint8, rand, autn []byte, networkName string, K_aut []byte) (eap.Packet, error) {
+	p := eap.NewPacket(eap.RequestCode, identifier, []byte{TYPE, byte(aka.SubtypeChallenge), 0, 0})
+	p, err := p.Append(eap.NewAttribute(aka.AT_RAND, append([]byte{0, 0}, rand[:aka.RAND_LEN]...)))
+	if err != nil {
+		return p, err
+	}
+	p, err = p.Append(eap.NewAttribute(aka.AT_AUTN, append([]byte{0, 0}, autn[:aka.AUTN_LEN]...)))
+	if err != nil {
+		return p, err
+	}
+	p, err = p.Append(NewKDFInputAttribute(networkName))
+	if err != nil {
+		return p, err
+	}
+	p, err = p.Append(NewKDFAttribute(KDF_AKA_PRIME))
+	if err != nil {
+		return p, err
+	}
+	return AppendMac(p, K_aut)
+}
+
+// NewKDFInputAttribute returns AT_KDF_INPUT attribute carrying the given network name
+func NewKDFInputAttribute(networkName string) eap.Attribute {
+	return eap.NewAttribute(
+		AT_KDF_INPUT,
+		append([]byte{byte(len(networkName) >> 8), byte(len(networkName))}, networkName...))
+}
+
+// NewKDFAttribute returns AT_KDF attribute with the given key derivation function
+func NewKDFAttribute(kdf uint16) eap.Attribute {
+	return eap.NewAttribute(AT_KDF, []byte{byte(kdf >> 8), byte(kdf)})
+}
+
+// ParseChallengeAttributes returns AT_KDF_INPUT & AT_KDF values of the given AKA'-Challenge packet
+func ParseChallengeAttributes(p eap.Packet) (ChallengeAttributes, error) {
+	var attrs ChallengeAttributes
+	scanner, err := eap.NewAttributeScanner(p)
+	if err != nil {
+		return attrs, err
+	}
+	var a eap.Attribute
+	for a, err = scanner.Next(); err == nil; a, err = scanner.Next() {
+		switch a.Type() {
+		case AT_KDF_INPUT:
+			val := a.Value()
+			if len(val) < 2 {
+				return attrs, fmt.Errorf("AT_KDF_INPUT is too short: %d", len(val))
+			}
+			nameLen := int(val[0])<<8 + int(val[1]) + 2
+			if nameLen > len(val) {
+				return attrs, fmt.Errorf(
+					"Corrupt AT_KDF_INPUT Attribute: actual len %d > data len %d", nameLen-2, len(val)-2)
+			}
+			attrs.NetworkName = string(val[2:nameLen])
+		case AT_KDF:
+			val := a.Value()
+			if len(val) < 2 {
+				return attrs, fmt.Errorf("AT_KDF is too short: %d", len(val))
+			}
+			attrs.KDFs = append(attrs.KDFs, uint16(val[0])<<8+uint16(val[1]))
+		}
+	}
+	if err != io.EOF {
+		return attrs, err
+	}
+	return attrs, nil
+}
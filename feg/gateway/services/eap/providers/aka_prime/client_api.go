@@ -0,0 +1,52 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package aka_prime implements EAP-AKA' provider
+package aka_prime
+
+import (
+	"errors"
+
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// AKA' Provider Implementation, AKA' is served by the EAP-AKA service
+type providerImpl struct {
+	aka providers.Method
+}
+
+func New() providers.Method {
+	return providerImpl{aka: aka.New()}
+}
+
+// String returns EAP AKA' Provider name/info
+func (providerImpl) String() string {
+	return "<Magma EAP-AKA' Method Provider>"
+}
+
+// EAPType returns EAP AKA' Type - 50
+func (providerImpl) EAPType() uint8 {
+	return TYPE
+}
+
+// Handle handles passed EAP-AKA' payload & returns corresponding result
+// EAP Identity responses don't carry the method, so AKA' Identity request is created here & all other
+// AKA' messages are passed to the EAP-AKA service
+func (p providerImpl) Handle(msg *protos.Eap) (*protos.Eap, error) {
+	if msg == nil {
+		return nil, errors.New("Invalid EAP AKA' Message")
+	}
+	req := eap.Packet(msg.GetPayload())
+	if req.Validate() == nil && req.Type() == uint8(protos.EapType_Identity) {
+		return &protos.Eap{Payload: NewIdentityReq(req.Identifier()+1, aka.AT_PERMANENT_ID_REQ), Ctx: msg.Ctx}, nil
+	}
+	return p.aka.Handle(msg)
+}
@@ -0,0 +1,43 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// package aka_prime implements EAP-AKA' provider
+package aka_prime
+
+import (
+	"magma/feg/gateway/services/aaa/protos"
+	"magma/feg/gateway/services/eap"
+)
+
+const (
+	TYPE = uint8(protos.EapType_AKAPrime)
+)
+
+const (
+	// AKA' Attributes, all other attributes & subtypes are shared with EAP-AKA (RFC 5448, section 3)
+	AT_KDF_INPUT eap.AttrType = 23
+	AT_KDF       eap.AttrType = 24
+)
+
+const (
+	// KDF_AKA_PRIME - the HMAC-SHA-256 based AKA' Key Derivation Function, the only KDF defined by RFC 5448
+	KDF_AKA_PRIME uint16 = 1
+
+	// DefaultNetworkName - Access Network Identity of WLAN access used in AT_KDF_INPUT (3GPP TS 24.302, 8.1.1)
+	DefaultNetworkName = "WLAN"
+
+	// PermanentIdPrefix - leading byte of AKA' permanent (IMSI based) identities (3GPP TS 23.003, 19.3.2)
+	PermanentIdPrefix = '6'
+
+	// AMF_SEPARATION_BIT - bit 0 of AMF, set in the AUTN of AKA' vectors (3GPP TS 33.402, 6.2)
+	AMF_SEPARATION_BIT = 0x80
+
+	SQN_XOR_AK_LEN = 6
+	K_AUT_LEN      = 32
+	KEYS_LEN       = 208 // K_encr (16) | K_aut (32) | K_re (32) | MSK (64) | EMSK (64)
+)
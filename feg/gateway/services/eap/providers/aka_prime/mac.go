@@ -0,0 +1,104 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package aka_prime
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// ckIkPrimeFC - KDF function code of CK' & IK' derivation (3GPP TS 33.402, Annex A.2)
+const ckIkPrimeFC = 0x20
+
+// GenMac calculates AKA' MAC given data & K_aut (HMAC-SHA-256-128, see: https://tools.ietf.org/html/rfc5448#section-3.4)
+func GenMac(data, K_aut []byte) []byte {
+	return HmacSha256(data, K_aut)[:aka.MAC_LEN]
+}
+
+// HmacSha256 - SHA-256 based HMAC
+func HmacSha256(data, key []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+// MakeCKIKPrime derives CK' & IK' from the AKA vector's CK & IK, the access network name & SQN xor AK (the first
+// 6 bytes of AUTN) as defined in 3GPP TS 33.402, Annex A.2:
+// CK' | IK' = HMAC-SHA-256(CK | IK, FC | network name | len(network name) | SQN xor AK | len(SQN xor AK))
+func MakeCKIKPrime(CK, IK []byte, networkName string, sqnXorAk []byte) (CKPrime, IKPrime []byte) {
+	s := make([]byte, 0, 1+len(networkName)+2+SQN_XOR_AK_LEN+2)
+	s = append(s, ckIkPrimeFC)
+	s = append(s, networkName...)
+	s = append(s, byte(len(networkName)>>8), byte(len(networkName)))
+	s = append(s, sqnXorAk[:SQN_XOR_AK_LEN]...)
+	s = append(s, 0, SQN_XOR_AK_LEN)
+
+	key := make([]byte, 0, len(CK)+len(IK))
+	key = append(append(key, CK...), IK...)
+	k := HmacSha256(s, key)
+	return k[:16], k[16:32]
+}
+
+// WithAMFSeparationBit returns a copy of AMF with the separation bit set, as used for AKA' vectors
+func WithAMFSeparationBit(amf []byte) []byte {
+	res := append([]byte{}, amf...)
+	if len(res) > 0 {
+		res[0] |= AMF_SEPARATION_BIT
+	}
+	return res
+}
+
+// HasAMFSeparationBit returns true if the AMF of AUTN has the separation bit set
+func HasAMFSeparationBit(autn []byte) bool {
+	return len(autn) > SQN_XOR_AK_LEN && autn[SQN_XOR_AK_LEN]&AMF_SEPARATION_BIT != 0
+}
+
+// MakeAKAPrimeKeys returns generated K_encr, K_aut, K_re, MSK, EMSK keys for AKA' Authentication
+// (RFC 5448, section 3.3): MK = PRF'(IK'|CK', "EAP-AKA'"|Identity)
+func MakeAKAPrimeKeys(identity, IKPrime, CKPrime []byte) (K_encr, K_aut, K_re, MSK, EMSK []byte) {
+	key := make([]byte, 0, len(IKPrime)+len(CKPrime))
+	key = append(append(key, IKPrime...), CKPrime...)
+	s := append([]byte("EAP-AKA'"), identity...)
+	mk := PRFPrime(key, s, KEYS_LEN)
+	return mk[:16], mk[16:48], mk[48:80], mk[80:144], mk[144:208]
+}
+
+// PRFPrime - AKA' pseudo random function, returns first n bytes of T1 | T2 | T3 | T4 | ... where
+// T1 = HMAC-SHA-256 (K, S | 0x01), Tn = HMAC-SHA-256 (K, T(n-1) | S | n) (RFC 5448, section 3.4)
+func PRFPrime(key, s []byte, n int) []byte {
+	res := make([]byte, 0, n+sha256.Size)
+	var t []byte
+	for i := 1; len(res) < n; i++ {
+		h := hmac.New(sha256.New, key)
+		h.Write(t)
+		h.Write(s)
+		h.Write([]byte{byte(i)})
+		t = h.Sum(nil)
+		res = append(res, t...)
+	}
+	return res[:n]
+}
+
+// AppendMac appends AT_MAC attribute to eap packet, signs the packet & returns the new, signed packet
+// returns error if provided EAP Packet was malformed
+func AppendMac(p eap.Packet, K_aut []byte) (eap.Packet, error) {
+	p = p.Truncate()
+	atMacOffset := len(p) + aka.ATT_HDR_LEN
+	p, err := p.Append(eap.NewAttribute(aka.AT_MAC, append([]byte{0, 0}, make([]byte, aka.MAC_LEN)...)))
+	if err != nil {
+		return p, err
+	}
+	mac := GenMac(p, K_aut)
+	// Set AT_MAC
+	copy(p[atMacOffset:], mac)
+	return p, nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+package aka_prime
+
+import (
+	"encoding/hex"
+	"reflect"
+	"testing"
+)
+
+// RFC 5448, Appendix C, Test Case 1
+const (
+	testIdentity    = "0555444333222111"
+	testNetworkName = "WLAN"
+	testAutn        = "bb52e91c747ac3ab2a5c23d15ee351d5"
+	testIK          = "9744871ad32bf9bbd1dd5ce54e3e2e5a"
+	testCK          = "5349fbe098649f948f5d2e973a81c00f"
+
+	expectedCKPrime = "0093962d0dd84aa5684b045c9edffa04"
+	expectedIKPrime = "ccfc230ca74fcc96c0a5d61164f5a76c"
+	expectedK_encr  = "766fa0a6c317174b812d52fbcd11a179"
+	expectedK_aut   = "0842ea722ff6835bfa2032499fc3ec23c2f0e388b4f07543ffc677f1696d71ea"
+	expectedK_re    = "cf83aa8bc7e0aced892acc98e76a9b2095b558c7795c7094715cb3393aa7d17a"
+	expectedMSK     = "67c42d9aa56c1b79e295e3459fc3d187d42be0bf818d3070e362c5e967a4d544" +
+		"e8ecfe19358ab3039aff03b7c930588c055babee58a02650b067ec4e9347c75a"
+)
+
+func TestAKAPrimeKeys(t *testing.T) {
+	autn, _ := hex.DecodeString(testAutn)
+	IK, _ := hex.DecodeString(testIK)
+	CK, _ := hex.DecodeString(testCK)
+
+	CKPrime, IKPrime := MakeCKIKPrime(CK, IK, testNetworkName, autn[:SQN_XOR_AK_LEN])
+	if hex.EncodeToString(CKPrime) != expectedCKPrime {
+		t.Fatalf("Unexpected CK'\n\tReceived: %x\n\tExpected: %s", CKPrime, expectedCKPrime)
+	}
+	if hex.EncodeToString(IKPrime) != expectedIKPrime {
+		t.Fatalf("Unexpected IK'\n\tReceived: %x\n\tExpected: %s", IKPrime, expectedIKPrime)
+	}
+	K_encr, K_aut, K_re, MSK, EMSK := MakeAKAPrimeKeys([]byte(testIdentity), IKPrime, CKPrime)
+	for name, key := range map[string][]string{
+		"K_encr": {hex.EncodeToString(K_encr), expectedK_encr},
+		"K_aut":  {hex.EncodeToString(K_aut), expectedK_aut},
+		"K_re":   {hex.EncodeToString(K_re), expectedK_re},
+		"MSK":    {hex.EncodeToString(MSK), expectedMSK},
+	} {
+		if key[0] != key[1] {
+			t.Fatalf("Unexpected %s\n\tReceived: %s\n\tExpected: %s", name, key[0], key[1])
+		}
+	}
+	if len(EMSK) != 64 {
+		t.Fatalf("Unexpected EMSK length: %d", len(EMSK))
+	}
+}
+
+func TestAKAPrimeMac(t *testing.T) {
+	K_aut, _ := hex.DecodeString(expectedK_aut)
+	p, err := NewChallengeReq(2, make([]byte, 16), make([]byte, 16), DefaultNetworkName, K_aut)
+	if err != nil {
+		t.Fatalf("Unexpected NewChallengeReq error: %v", err)
+	}
+	if p.Type() != TYPE {
+		t.Fatalf("Unexpected EAP Type: %d", p.Type())
+	}
+	ueMac := make([]byte, 16)
+	copy(ueMac, p[len(p)-16:])
+	copy(p[len(p)-16:], make([]byte, 16))
+	if mac := GenMac(p, K_aut); !reflect.DeepEqual(mac, ueMac) {
+		t.Fatalf("Unexpected AT_MAC\n\tReceived: %x\n\tExpected: %x", ueMac, mac)
+	}
+	attrs, err := ParseChallengeAttributes(p)
+	if err != nil {
+		t.Fatalf("Unexpected ParseChallengeAttributes error: %v", err)
+	}
+	if attrs.NetworkName != DefaultNetworkName {
+		t.Fatalf("Unexpected AT_KDF_INPUT: %s", attrs.NetworkName)
+	}
+	if !reflect.DeepEqual(attrs.KDFs, []uint16{KDF_AKA_PRIME}) {
+		t.Fatalf("Unexpected AT_KDF list: %v", attrs.KDFs)
+	}
+}
+
+func TestAMFSeparationBit(t *testing.T) {
+	autn, _ := hex.DecodeString(testAutn)
+	if !HasAMFSeparationBit(autn) {
+		t.Fatalf("Missing AMF separation bit in AUTN: %x", autn)
+	}
+	autn[SQN_XOR_AK_LEN] &^= AMF_SEPARATION_BIT
+	if HasAMFSeparationBit(autn) {
+		t.Fatalf("Unexpected AMF separation bit in AUTN: %x", autn)
+	}
+	amf := []byte{0x67, 0x41}
+	if res := WithAMFSeparationBit(amf); !reflect.DeepEqual(res, []byte{0xe7, 0x41}) {
+		t.Fatalf("Unexpected AMF: %x", res)
+	}
+	if !reflect.DeepEqual(amf, []byte{0x67, 0x41}) {
+		t.Fatalf("AMF modified: %x", amf)
+	}
+}
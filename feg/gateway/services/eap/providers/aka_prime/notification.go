@@ -0,0 +1,34 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package aka_prime
+
+import (
+	"google.golang.org/grpc/codes"
+
+	"magma/feg/gateway/services/eap"
+	"magma/feg/gateway/services/eap/providers/aka"
+)
+
+// NewNotificationReq returns EAP-Request/AKA'-Notification with the given notification code
+func NewNotificationReq(identifier uint8, code uint16) eap.Packet {
+	p := aka.NewAKANotificationReq(identifier, code)
+	p[eap.EapMsgMethodType] = TYPE
+	return p
+}
+
+func EapErrorResPacketWithMac(
+	id uint8, code uint16, K_aut []byte, rpcCode codes.Code, f string, a ...interface{}) (eap.Packet, error) {
+
+	p, err := AppendMac(NewNotificationReq(id, code), K_aut)
+	if err != nil {
+		panic(err) // should never happen
+	}
+	aka.Errorf(rpcCode, f, a...) // log only
+	return p, nil
+}
@@ -11,8 +11,10 @@ package registry
 
 import (
 	"magma/feg/gateway/services/eap/providers/aka"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 )
 
 func init() {
 	Register(aka.New())
+	Register(aka_prime.New())
 }
@@ -23,15 +23,12 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam/avp"
 	"github.com/fiorix/go-diameter/v4/diam/datatype"
 	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 )
 
 // sendAIR - sends AIR with given Session ID (sid)
-func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
-	if err != nil {
-		return err
-	}
+func (s *s6aProxy) sendAIR(ctx context.Context, sid string, req *protos.AuthenticationInformationRequest, retryCount uint, ch <-chan interface{}) (interface{}, bool, error) {
 	var irp uint32
 	if req.ImmediateResponsePreferred {
 		irp = 1
@@ -61,11 +58,13 @@ func (s *s6aProxy) sendAIR(sid string, req *protos.AuthenticationInformationRequ
 	}
 	m.NewAVP(avp.RequestedEUTRANAuthenticationInfo, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, authInfo)
 
-	err = c.SendRequest(m, retryCount)
-	if err != nil {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*TIMEOUT_SECONDS)
+	defer cancel()
+	resp, open, err := s.peerGroup.SendRequestAndWait(ctx, s.connMan, s.smClient, m, retryCount, ch)
+	if err != nil && err != diameter.ErrAnswerTimeout {
 		err = Error(codes.DataLoss, err)
 	}
-	return err
+	return resp, open, err
 }
 
 // S6a AIA
@@ -89,7 +88,7 @@ func handleAIA(s *s6aProxy) diam.HandlerFunc {
 // AuthenticationInformationImpl sends AIR over diameter connection,
 // waits (blocks) for AIA & returns its RPC representation
 func (s *s6aProxy) AuthenticationInformationImpl(
-	req *protos.AuthenticationInformationRequest) (*protos.AuthenticationInformationAnswer, error) {
+	ctx context.Context, req *protos.AuthenticationInformationRequest) (*protos.AuthenticationInformationAnswer, error) {
 
 	res := &protos.AuthenticationInformationAnswer{}
 	if req == nil {
@@ -102,50 +101,45 @@ func (s *s6aProxy) AuthenticationInformationImpl(
 	// if request hasn't been removed by end of transaction, remove it
 	defer s.requestTracker.DeregisterRequest(sid)
 
-	var (
-		err     error
-		retries uint = MAX_DIAM_RETRIES
-	)
+	var retries uint = MAX_DIAM_RETRIES
 
-	err = s.sendAIR(sid, req, retries)
+	resp, open, err := s.sendAIR(ctx, sid, req, retries, ch)
 
-	if err != nil {
+	if err != nil && err != diameter.ErrAnswerTimeout {
 		metrics.AIRSendFailures.Inc()
 		log.Printf("Error sending AIR with SID %s: %v", sid, err)
 	}
 
-	if err == nil {
+	if err == diameter.ErrAnswerTimeout {
+		metrics.AIRRequests.Inc()
+		err = Errorf(codes.DeadlineExceeded, "AIR Timed Out for Session ID: %s", sid)
+		metrics.S6aTimeouts.Inc()
+	} else if err == nil {
 		metrics.AIRRequests.Inc()
-		select {
-		case resp, open := <-ch:
-			if open {
-				aia, ok := resp.(*AIA)
-				if ok {
-					metrics.S6aResultCodes.WithLabelValues(strconv.FormatUint(uint64(aia.ResultCode), 10)).Inc()
-					err = diameter.TranslateDiamResultCode(aia.ResultCode)
-					res.ErrorCode = protos.ErrorCode(aia.ExperimentalResult.ExperimentalResultCode)
-					for _, ai := range aia.AIs {
-						for _, ev := range ai.EUtranVectors {
-							res.EutranVectors = append(
-								res.EutranVectors,
-								&protos.AuthenticationInformationAnswer_EUTRANVector{
-									Rand:  ev.RAND.Serialize(),
-									Xres:  ev.XRES.Serialize(),
-									Autn:  ev.AUTN.Serialize(),
-									Kasme: ev.KASME.Serialize()})
-						}
+		if open {
+			aia, ok := resp.(*AIA)
+			if ok {
+				metrics.S6aResultCodes.WithLabelValues(strconv.FormatUint(uint64(aia.ResultCode), 10)).Inc()
+				err = diameter.TranslateDiamResultCode(aia.ResultCode)
+				res.ErrorCode = protos.ErrorCode(aia.ExperimentalResult.ExperimentalResultCode)
+				for _, ai := range aia.AIs {
+					for _, ev := range ai.EUtranVectors {
+						res.EutranVectors = append(
+							res.EutranVectors,
+							&protos.AuthenticationInformationAnswer_EUTRANVector{
+								Rand:  ev.RAND.Serialize(),
+								Xres:  ev.XRES.Serialize(),
+								Autn:  ev.AUTN.Serialize(),
+								Kasme: ev.KASME.Serialize()})
 					}
-					return res, err // the only successful "exit" is here
-				} else {
-					err = Errorf(codes.Internal, "Invalid Response Type: %T, AIA expected.", resp)
-					metrics.S6aUnparseableMsg.Inc()
 				}
+				return res, err // the only successful "exit" is here
 			} else {
-				err = Errorf(codes.Aborted, "AIR for Session ID: %s is canceled", sid)
+				err = Errorf(codes.Internal, "Invalid Response Type: %T, AIA expected.", resp)
+				metrics.S6aUnparseableMsg.Inc()
 			}
-		case <-time.After(time.Second * TIMEOUT_SECONDS):
-			err = Errorf(codes.DeadlineExceeded, "AIR Timed Out for Session ID: %s", sid)
-			metrics.S6aTimeouts.Inc()
+		} else {
+			err = Errorf(codes.Aborted, "AIR for Session ID: %s is canceled", sid)
 		}
 	}
 
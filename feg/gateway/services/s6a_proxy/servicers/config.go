@@ -74,6 +74,7 @@ func GetS6aProxyConfigs() (*diameter.DiameterClientConfig, *diameter.DiameterSer
 			Retransmits:      uint(configsPtr.Server.Retransmits),
 			WatchdogInterval: uint(configsPtr.Server.WatchdogInterval),
 			RetryCount:       uint(configsPtr.Server.RetryCount),
+			PeerGroup:        diameter.GetPeerGroupConfig(configsPtr.Server),
 		},
 		&diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
 			Addr:      diameter.GetValueOrEnv(diameter.AddrFlag, HSSAddrEnv, configsPtr.Server.Address),
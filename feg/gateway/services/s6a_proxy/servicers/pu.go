@@ -18,6 +18,7 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam/avp"
 	"github.com/fiorix/go-diameter/v4/diam/datatype"
 	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 
 	"magma/feg/cloud/go/protos"
@@ -25,22 +26,20 @@ import (
 )
 
 // sendPUR - sends PUR with given Session ID (sid)
-func (s *s6aProxy) sendPUR(sid string, req *protos.PurgeUERequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
-	if err != nil {
-		return err
-	}
+func (s *s6aProxy) sendPUR(ctx context.Context, sid string, req *protos.PurgeUERequest, retryCount uint, ch <-chan interface{}) (interface{}, bool, error) {
 	m := diameter.NewProxiableRequest(diam.PurgeUE, diam.TGPP_S6A_APP_ID, dict.Default)
 	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
 	m.NewAVP(avp.AuthSessionState, avp.Mbit, 0, datatype.Enumerated(1))
 	s.addDiamOriginAVPs(m)
 	m.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(req.UserName))
 
-	err = c.SendRequest(m, retryCount)
-	if err != nil {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*TIMEOUT_SECONDS)
+	defer cancel()
+	resp, open, err := s.peerGroup.SendRequestAndWait(ctx, s.connMan, s.smClient, m, retryCount, ch)
+	if err != nil && err != diameter.ErrAnswerTimeout {
 		err = Error(codes.DataLoss, err)
 	}
-	return err
+	return resp, open, err
 }
 
 // S6a PUA
@@ -63,7 +62,7 @@ func handlePUA(s *s6aProxy) diam.HandlerFunc {
 
 // PurgeUEImpl sends PUR over diameter connection,
 // waits (blocks) for PUA & returns its RPC representation
-func (s *s6aProxy) PurgeUEImpl(req *protos.PurgeUERequest) (*protos.PurgeUEAnswer, error) {
+func (s *s6aProxy) PurgeUEImpl(ctx context.Context, req *protos.PurgeUERequest) (*protos.PurgeUEAnswer, error) {
 	res := &protos.PurgeUEAnswer{}
 	if req == nil {
 		return res, Errorf(codes.InvalidArgument, "Nil PU Request")
@@ -75,32 +74,26 @@ func (s *s6aProxy) PurgeUEImpl(req *protos.PurgeUERequest) (*protos.PurgeUEAnswe
 	// if request hasn't been removed by end of transaction, remove it
 	defer s.requestTracker.DeregisterRequest(sid)
 
-	var (
-		err     error
-		retries uint = MAX_DIAM_RETRIES
-	)
+	var retries uint = MAX_DIAM_RETRIES
 
-	err = s.sendPUR(sid, req, retries)
+	resp, open, err := s.sendPUR(ctx, sid, req, retries, ch)
 
-	if err != nil {
+	if err != nil && err != diameter.ErrAnswerTimeout {
 		log.Printf("Error sending PUR with SID %s: %v", sid, err)
 	}
-	if err == nil {
-		select {
-		case resp, open := <-ch:
-			if open {
-				pua, ok := resp.(*PUA)
-				if ok {
-					err = diameter.TranslateDiamResultCode(pua.ResultCode)
-					res.ErrorCode = protos.ErrorCode(pua.ResultCode)
-					return res, err // the only successful "exit" is here
-				}
-				err = Errorf(codes.Internal, "Invalid Response Type: %T, PUA expected.", resp)
-			} else {
-				err = Errorf(codes.Aborted, "PUR for Session ID: %s is canceled", sid)
+	if err == diameter.ErrAnswerTimeout {
+		err = Errorf(codes.DeadlineExceeded, "PUR Timed Out for Session ID: %s", sid)
+	} else if err == nil {
+		if open {
+			pua, ok := resp.(*PUA)
+			if ok {
+				err = diameter.TranslateDiamResultCode(pua.ResultCode)
+				res.ErrorCode = protos.ErrorCode(pua.ResultCode)
+				return res, err // the only successful "exit" is here
 			}
-		case <-time.After(time.Second * TIMEOUT_SECONDS):
-			err = Errorf(codes.DeadlineExceeded, "PUR Timed Out for Session ID: %s", sid)
+			err = Errorf(codes.Internal, "Invalid Response Type: %T, PUA expected.", resp)
+		} else {
+			err = Errorf(codes.Aborted, "PUR for Session ID: %s is canceled", sid)
 		}
 	}
 	return res, err
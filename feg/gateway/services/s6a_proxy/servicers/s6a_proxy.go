@@ -39,6 +39,7 @@ type s6aProxy struct {
 	serverCfg      *diameter.DiameterServerConfig
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
+	peerGroup      *diameter.PeerGroup
 	requestTracker *diameter.RequestTracker
 	healthTracker  *metrics.S6aHealthTracker
 	originStateID  uint32
@@ -96,14 +97,16 @@ func NewS6aProxy(
 	}
 
 	connMan := diameter.NewConnectionManager()
-	// create connection in connection map
-	connMan.GetConnection(smClient, serverCfg)
+	peerGroup := diameter.NewPeerGroup(serverCfg, clientCfg.PeerGroup)
+	// create connections in connection map
+	peerGroup.BeginConnections(connMan, smClient)
 
 	proxy := &s6aProxy{
 		clientCfg:      clientCfg,
 		serverCfg:      serverCfg,
 		smClient:       smClient,
 		connMan:        connMan,
+		peerGroup:      peerGroup,
 		requestTracker: diameter.NewRequestTracker(),
 		healthTracker:  metrics.NewS6aHealthTracker(),
 		originStateID:  originStateID,
@@ -138,7 +141,7 @@ func (s *s6aProxy) AuthenticationInformation(
 	ctx context.Context, req *protos.AuthenticationInformationRequest) (*protos.AuthenticationInformationAnswer, error,
 ) {
 	airStartTime := time.Now()
-	res, err := s.AuthenticationInformationImpl(req)
+	res, err := s.AuthenticationInformationImpl(ctx, req)
 	if err == nil {
 		metrics.AIRLatency.Observe(float64(time.Since(airStartTime)) / float64(time.Millisecond))
 	}
@@ -152,7 +155,7 @@ func (s *s6aProxy) UpdateLocation(
 	ctx context.Context, req *protos.UpdateLocationRequest) (*protos.UpdateLocationAnswer, error,
 ) {
 	ulrStartTime := time.Now()
-	res, err := s.UpdateLocationImpl(req)
+	res, err := s.UpdateLocationImpl(ctx, req)
 	if err == nil {
 		metrics.ULRLatency.Observe(float64(time.Since(ulrStartTime)) / float64(time.Millisecond))
 	}
@@ -163,7 +166,7 @@ func (s *s6aProxy) UpdateLocation(
 // PurgeUE sends PUR (Code 321) over diameter connection,
 // waits (blocks) for PUA & returns its RPC representation
 func (s *s6aProxy) PurgeUE(ctx context.Context, req *protos.PurgeUERequest) (*protos.PurgeUEAnswer, error) {
-	res, err := s.PurgeUEImpl(req)
+	res, err := s.PurgeUEImpl(ctx, req)
 	metrics.UpdateS6aRecentRequestMetrics(err)
 	return res, err
 }
@@ -183,7 +186,7 @@ func (s *s6aProxy) Disable(ctx context.Context, req *protos.DisableMessage) (*or
 // exists, Enable has no effect
 func (s *s6aProxy) Enable(ctx context.Context, req *orcprotos.Void) (*orcprotos.Void, error) {
 	s.connMan.Enable()
-	_, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
+	err := s.peerGroup.BeginConnections(s.connMan, s.smClient)
 	return &orcprotos.Void{}, err
 }
 
@@ -23,15 +23,12 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam/avp"
 	"github.com/fiorix/go-diameter/v4/diam/datatype"
 	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 )
 
 // sendULR - sends ULR with given Session ID (sid)
-func (s *s6aProxy) sendULR(sid string, req *protos.UpdateLocationRequest, retryCount uint) error {
-	c, err := s.connMan.GetConnection(s.smClient, s.serverCfg)
-	if err != nil {
-		return err
-	}
+func (s *s6aProxy) sendULR(ctx context.Context, sid string, req *protos.UpdateLocationRequest, retryCount uint, ch <-chan interface{}) (interface{}, bool, error) {
 	m := diameter.NewProxiableRequest(diam.UpdateLocation, diam.TGPP_S6A_APP_ID, dict.Default)
 	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sid))
 	s.addDiamOriginAVPs(m)
@@ -41,11 +38,13 @@ func (s *s6aProxy) sendULR(sid string, req *protos.UpdateLocationRequest, retryC
 	m.NewAVP(avp.ULRFlags, avp.Vbit|avp.Mbit, uint32(diameter.Vendor3GPP), datatype.Unsigned32(ULR_FLAGS))
 	m.NewAVP(avp.VisitedPLMNID, avp.Vbit|avp.Mbit, diameter.Vendor3GPP, datatype.OctetString(req.VisitedPlmn))
 
-	err = c.SendRequest(m, retryCount)
-	if err != nil {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*TIMEOUT_SECONDS)
+	defer cancel()
+	resp, open, err := s.peerGroup.SendRequestAndWait(ctx, s.connMan, s.smClient, m, retryCount, ch)
+	if err != nil && err != diameter.ErrAnswerTimeout {
 		err = Error(codes.DataLoss, err)
 	}
-	return err
+	return resp, open, err
 }
 
 // S6a ULA
@@ -68,7 +67,7 @@ func handleULA(s *s6aProxy) diam.HandlerFunc {
 
 // UpdateLocationImpl sends ULR (Code 316) over diameter connection,
 // waits (blocks) for ULA & returns its RPC representation
-func (s *s6aProxy) UpdateLocationImpl(req *protos.UpdateLocationRequest) (*protos.UpdateLocationAnswer, error,
+func (s *s6aProxy) UpdateLocationImpl(ctx context.Context, req *protos.UpdateLocationRequest) (*protos.UpdateLocationAnswer, error,
 ) {
 	res := &protos.UpdateLocationAnswer{}
 	if req == nil {
@@ -79,68 +78,63 @@ func (s *s6aProxy) UpdateLocationImpl(req *protos.UpdateLocationRequest) (*proto
 	s.requestTracker.RegisterRequest(sid, ch)
 	defer s.requestTracker.DeregisterRequest(sid)
 
-	var (
-		err     error
-		retries uint = MAX_DIAM_RETRIES
-	)
+	var retries uint = MAX_DIAM_RETRIES
 
-	err = s.sendULR(sid, req, retries)
+	resp, open, err := s.sendULR(ctx, sid, req, retries, ch)
 
-	if err != nil {
+	if err != nil && err != diameter.ErrAnswerTimeout {
 		metrics.ULRSendFailures.Inc()
 		log.Printf("Error sending ULR with SID %s: %v", sid, err)
 	}
 
-	if err == nil {
+	if err == diameter.ErrAnswerTimeout {
+		metrics.ULRRequests.Inc()
+		err = Errorf(codes.DeadlineExceeded, "ULR Timed Out for Session ID: %s", sid)
+		metrics.S6aTimeouts.Inc()
+	} else if err == nil {
 		metrics.ULRRequests.Inc()
-		select {
-		case resp, open := <-ch:
-			if open {
-				ula, ok := resp.(*ULA)
-				if ok {
-					metrics.S6aResultCodes.WithLabelValues(strconv.FormatUint(uint64(ula.ResultCode), 10)).Inc()
-					err = diameter.TranslateDiamResultCode(ula.ResultCode)
-					res.ErrorCode = protos.ErrorCode(ula.ExperimentalResult.ExperimentalResultCode)
-					res.Msisdn = ula.SubscriptionData.MSISDN.Serialize()
-					res.DefaultContextId = ula.SubscriptionData.APNConfigurationProfile.ContextIdentifier
-					res.TotalAmbr = &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
-						MaxBandwidthUl: ula.SubscriptionData.AMBR.MaxRequestedBandwidthUL,
-						MaxBandwidthDl: ula.SubscriptionData.AMBR.MaxRequestedBandwidthDL,
-					}
-					res.AllApnsIncluded =
-						ula.SubscriptionData.APNConfigurationProfile.AllAPNConfigurationsIncludedIndicator == 0
-					res.NetworkAccessMode = protos.UpdateLocationAnswer_NetworkAccessMode(ula.SubscriptionData.NetworkAccessMode)
+		if open {
+			ula, ok := resp.(*ULA)
+			if ok {
+				metrics.S6aResultCodes.WithLabelValues(strconv.FormatUint(uint64(ula.ResultCode), 10)).Inc()
+				err = diameter.TranslateDiamResultCode(ula.ResultCode)
+				res.ErrorCode = protos.ErrorCode(ula.ExperimentalResult.ExperimentalResultCode)
+				res.Msisdn = ula.SubscriptionData.MSISDN.Serialize()
+				res.DefaultContextId = ula.SubscriptionData.APNConfigurationProfile.ContextIdentifier
+				res.TotalAmbr = &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
+					MaxBandwidthUl: ula.SubscriptionData.AMBR.MaxRequestedBandwidthUL,
+					MaxBandwidthDl: ula.SubscriptionData.AMBR.MaxRequestedBandwidthDL,
+				}
+				res.AllApnsIncluded =
+					ula.SubscriptionData.APNConfigurationProfile.AllAPNConfigurationsIncludedIndicator == 0
+				res.NetworkAccessMode = protos.UpdateLocationAnswer_NetworkAccessMode(ula.SubscriptionData.NetworkAccessMode)
 
-					for _, apnCfg := range ula.SubscriptionData.APNConfigurationProfile.APNConfigs {
-						res.Apn = append(
-							res.Apn,
-							&protos.UpdateLocationAnswer_APNConfiguration{
-								ContextId:        apnCfg.ContextIdentifier,
-								Pdn:              protos.UpdateLocationAnswer_APNConfiguration_PDNType(apnCfg.PDNType),
-								ServiceSelection: apnCfg.ServiceSelection,
-								QosProfile: &protos.UpdateLocationAnswer_APNConfiguration_QoSProfile{
-									ClassId:                 apnCfg.EPSSubscribedQoSProfile.QoSClassIdentifier,
-									PriorityLevel:           apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PriorityLevel,
-									PreemptionCapability:    apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionCapability == 0,
-									PreemptionVulnerability: apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionVulnerability == 0,
-								},
-								Ambr: &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
-									MaxBandwidthUl: apnCfg.AMBR.MaxRequestedBandwidthUL,
-									MaxBandwidthDl: apnCfg.AMBR.MaxRequestedBandwidthDL,
-								},
-							})
-					}
-					return res, err
-				} else {
-					err = Errorf(codes.Internal, "Invalid Response Type: %T, ULA expected.", resp)
-					metrics.S6aUnparseableMsg.Inc()
+				for _, apnCfg := range ula.SubscriptionData.APNConfigurationProfile.APNConfigs {
+					res.Apn = append(
+						res.Apn,
+						&protos.UpdateLocationAnswer_APNConfiguration{
+							ContextId:        apnCfg.ContextIdentifier,
+							Pdn:              protos.UpdateLocationAnswer_APNConfiguration_PDNType(apnCfg.PDNType),
+							ServiceSelection: apnCfg.ServiceSelection,
+							QosProfile: &protos.UpdateLocationAnswer_APNConfiguration_QoSProfile{
+								ClassId:                 apnCfg.EPSSubscribedQoSProfile.QoSClassIdentifier,
+								PriorityLevel:           apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PriorityLevel,
+								PreemptionCapability:    apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionCapability == 0,
+								PreemptionVulnerability: apnCfg.EPSSubscribedQoSProfile.AllocationRetentionPriority.PreemptionVulnerability == 0,
+							},
+							Ambr: &protos.UpdateLocationAnswer_AggregatedMaximumBitrate{
+								MaxBandwidthUl: apnCfg.AMBR.MaxRequestedBandwidthUL,
+								MaxBandwidthDl: apnCfg.AMBR.MaxRequestedBandwidthDL,
+							},
+						})
 				}
+				return res, err
 			} else {
-				err = Errorf(codes.Aborted, "ULR for Session ID: %s is canceled", sid)
+				err = Errorf(codes.Internal, "Invalid Response Type: %T, ULA expected.", resp)
+				metrics.S6aUnparseableMsg.Inc()
 			}
-		case <-time.After(time.Second * TIMEOUT_SECONDS):
-			err = Errorf(codes.DeadlineExceeded, "ULR Timed Out for Session ID: %s", sid)
-			metrics.S6aTimeouts.Inc()
+		} else {
+			err = Errorf(codes.Aborted, "ULR for Session ID: %s is canceled", sid)
 		}
 	}
 	return res, err
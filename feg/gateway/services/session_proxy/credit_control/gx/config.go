@@ -108,6 +108,7 @@ func GetGxClientConfiguration() *diameter.DiameterClientConfig {
 		WatchdogInterval:   diameter.DefaultWatchdogIntervalSeconds,
 		RetryCount:         uint(retries),
 		SupportedVendorIDs: diameter.GetValueOrEnv("", GxSupportedVendorIDsEnv, ""),
+		PeerGroup:          diameter.GetPeerGroupConfig(gxCfg),
 	}
 }
 
@@ -259,6 +259,211 @@ func TestGxClientUsageMonitoring(t *testing.T) {
 	}
 }
 
+// TestGxClientPCRFInitiated tests RARs and ASRs sent by the fake PCRF as well
+// as its event triggers, answer overrides and CCR recording
+func TestGxClientPCRFInitiated(t *testing.T) {
+	serverConfig := &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+		Addr:     "127.0.0.1:3900",
+		Protocol: "tcp"},
+	}
+	clientConfig := getClientConfig()
+	pcrf := startServer(clientConfig, serverConfig)
+	ctx := context.Background()
+
+	reAuthRequests := make(chan *gx.ReAuthRequest, 10)
+	abortRequests := make(chan *diam.Message, 10)
+	diamClient := diameter.NewClient(clientConfig)
+	diamClient.BeginConnection(serverConfig)
+	diamClient.RegisterHandler(diam.AbortSession, diam.GX_CHARGING_CONTROL_APP_ID, true,
+		diam.HandlerFunc(func(conn diam.Conn, m *diam.Message) {
+			abortRequests <- m
+			ans := diamClient.AddOriginAVPsToMessage(m.Answer(diam.Success))
+			ans.InsertAVP(m.AVP[0])
+			ans.WriteTo(conn)
+		}))
+	gxClient := gx.NewConnectedGxClient(
+		diamClient,
+		serverConfig,
+		func(request *gx.ReAuthRequest) *gx.ReAuthAnswer {
+			reAuthRequests <- request
+			return &gx.ReAuthAnswer{SessionID: request.SessionID, ResultCode: diam.Success}
+		},
+		nil,
+	)
+	done := make(chan interface{}, 1000)
+
+	// event triggers & revalidation time are sent in the CCA-I
+	revalidationTime := time.Unix(time.Now().Unix()+3600, 0)
+	_, err := pcrf.SetEventTriggers(ctx, &fegprotos.EventTriggerInfo{
+		Imsi:             testIMSI2,
+		EventTriggers:    []uint32{uint32(gx.RevalidationTimeout)},
+		RevalidationTime: uint64(revalidationTime.Unix()),
+	})
+	assert.NoError(t, err)
+	ccrInit := &gx.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTInit,
+		IMSI:          testIMSI2,
+		RequestNumber: 0,
+		IPAddr:        "192.168.1.1",
+		SpgwIPV4:      "10.10.10.10",
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, ccrInit))
+	answer := gx.GetAnswer(done)
+	assert.Equal(t, uint32(diam.Success), answer.ResultCode)
+	assert.Equal(t, []gx.EventTrigger{gx.RevalidationTimeout}, answer.EventTriggers)
+	if assert.NotNil(t, answer.RevalidationTime) {
+		assert.True(t, revalidationTime.Equal(*answer.RevalidationTime))
+	}
+
+	// RAR with rule changes & usage monitors
+	raa, err := pcrf.ReAuth(ctx, &fegprotos.PolicyReAuthTarget{
+		Imsi:           testIMSI2,
+		RulesToRemove:  []string{"rule1"},
+		RulesToInstall: []string{"rule4"},
+		UsageMonitors: []*fegprotos.UsageMonitorCredit{
+			{MonitoringKey: "mkey", ReturnBytes: 512, MonitoringLevel: fegprotos.UsageMonitorCredit_SessionLevel},
+		},
+		EventTriggers: []uint32{uint32(gx.UsageReportTrigger)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", raa.SessionId)
+	assert.Equal(t, uint32(diam.Success), raa.ResultCode)
+	rar := <-reAuthRequests
+	assert.Equal(t, "1", rar.SessionID)
+	assert.Len(t, rar.RulesToRemove, 1)
+	assert.Equal(t, []string{"rule1"}, rar.RulesToRemove[0].RuleNames)
+	assert.Len(t, rar.RulesToInstall, 1)
+	assert.Equal(t, []string{"rule4"}, rar.RulesToInstall[0].RuleNames)
+	assert.Len(t, rar.UsageMonitors, 1)
+	assert.Equal(t, "mkey", string(rar.UsageMonitors[0].MonitoringKey))
+	assert.Equal(t, uint64(512), *rar.UsageMonitors[0].GrantedServiceUnit.TotalOctets)
+	assert.Equal(t, []gx.EventTrigger{gx.UsageReportTrigger}, rar.EventTriggers)
+
+	// concurrent RARs get the RAA of their own session
+	ccrInit3 := &gx.CreditControlRequest{
+		SessionID:     "2",
+		Type:          credit_control.CRTInit,
+		IMSI:          testIMSI3,
+		RequestNumber: 0,
+		IPAddr:        "192.168.1.2",
+		SpgwIPV4:      "10.10.10.10",
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, ccrInit3))
+	assert.Equal(t, uint32(diam.Success), gx.GetAnswer(done).ResultCode)
+	raaSessions := make(chan string, 2)
+	for _, imsi := range []string{testIMSI2, testIMSI3} {
+		go func(imsi string) {
+			raa, err := pcrf.ReAuth(ctx, &fegprotos.PolicyReAuthTarget{Imsi: imsi})
+			assert.NoError(t, err)
+			raaSessions <- imsi + ":" + raa.GetSessionId()
+		}(imsi)
+	}
+	assert.ElementsMatch(t, []string{testIMSI2 + ":1", testIMSI3 + ":2"}, []string{<-raaSessions, <-raaSessions})
+	<-reAuthRequests
+	<-reAuthRequests
+
+	// result code overrides
+	_, err = pcrf.SetAnswerOverride(ctx, &fegprotos.AnswerOverride{
+		Imsi:        testIMSI2,
+		RequestType: uint32(credit_control.CRTUpdate),
+		ResultCode:  diam.UnableToComply,
+	})
+	assert.NoError(t, err)
+	ccrUpdate := &gx.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTUpdate,
+		IMSI:          testIMSI2,
+		RequestNumber: 1,
+		IPAddr:        "192.168.1.1",
+		UsageReports: []*gx.UsageReport{
+			{MonitoringKey: []byte("mkey"), Level: gx.SessionLevel, InputOctets: 10, OutputOctets: 20, TotalOctets: 30},
+		},
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, ccrUpdate))
+	answer = gx.GetAnswer(done)
+	assert.Equal(t, uint32(diam.UnableToComply), answer.ResultCode)
+
+	_, err = pcrf.SetAnswerOverride(ctx, &fegprotos.AnswerOverride{
+		Imsi:         testIMSI2,
+		RequestType:  uint32(credit_control.CRTUpdate),
+		ResultCode:   5030, // DIAMETER_USER_UNKNOWN
+		Experimental: true,
+	})
+	assert.NoError(t, err)
+	ccrUpdate.RequestNumber = 2
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, ccrUpdate))
+	answer = gx.GetAnswer(done)
+	assert.Equal(t, uint32(5030), answer.ExperimentalResultCode)
+
+	_, err = pcrf.SetAnswerOverride(ctx, &fegprotos.AnswerOverride{
+		Imsi:        testIMSI2,
+		RequestType: uint32(credit_control.CRTUpdate),
+		Drop:        true,
+	})
+	assert.NoError(t, err)
+	ccrUpdate.RequestNumber = 3
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, ccrUpdate))
+	select {
+	case <-done:
+		assert.Fail(t, "Dropped CCR was answered")
+	case <-time.After(200 * time.Millisecond):
+		gxClient.IgnoreAnswer(ccrUpdate)
+	}
+
+	// removing the override restores regular answers
+	_, err = pcrf.SetAnswerOverride(ctx, &fegprotos.AnswerOverride{
+		Imsi:        testIMSI2,
+		RequestType: uint32(credit_control.CRTUpdate),
+	})
+	assert.NoError(t, err)
+	ccrUpdate.RequestNumber = 4
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, ccrUpdate))
+	answer = gx.GetAnswer(done)
+	assert.Equal(t, uint32(diam.Success), answer.ResultCode)
+
+	// all CCRs are recorded
+	ccrs, err := pcrf.GetReceivedCCRs(ctx, &protos.SubscriberID{Id: testIMSI2})
+	assert.NoError(t, err)
+	assert.Len(t, ccrs.Ccrs, 5)
+	assert.Equal(t, uint32(credit_control.CRTInit), ccrs.Ccrs[0].RequestType)
+	for i, ccr := range ccrs.Ccrs[1:] {
+		assert.Equal(t, "1", ccr.SessionId)
+		assert.Equal(t, uint32(credit_control.CRTUpdate), ccr.RequestType)
+		assert.Equal(t, uint32(i+1), ccr.RequestNumber)
+		assert.Len(t, ccr.UsageMonitors, 1)
+		assert.Equal(t, "mkey", ccr.UsageMonitors[0].MonitoringKey)
+		assert.Equal(t, uint64(30), ccr.UsageMonitors[0].TotalOctets)
+	}
+
+	// ASR
+	asa, err := pcrf.AbortSession(ctx, &fegprotos.AbortSessionTarget{Imsi: testIMSI2})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", asa.SessionId)
+	assert.Equal(t, uint32(diam.Success), asa.ResultCode)
+	asr := <-abortRequests
+	var asrSession struct {
+		SessionID string `avp:"Session-Id"`
+	}
+	assert.NoError(t, asr.Unmarshal(&asrSession))
+	assert.Equal(t, "1", asrSession.SessionID)
+
+	// the session location is forgotten on termination
+	ccrTerminate := &gx.CreditControlRequest{
+		SessionID:     "1",
+		Type:          credit_control.CRTTerminate,
+		IMSI:          testIMSI2,
+		RequestNumber: 5,
+		IPAddr:        "192.168.1.1",
+	}
+	assert.NoError(t, gxClient.SendCreditControlRequest(serverConfig, done, ccrTerminate))
+	gx.GetAnswer(done)
+	_, err = pcrf.ReAuth(ctx, &fegprotos.PolicyReAuthTarget{Imsi: testIMSI2})
+	assert.Error(t, err)
+	_, err = pcrf.AbortSession(ctx, &fegprotos.AbortSessionTarget{Imsi: testIMSI2})
+	assert.Error(t, err)
+}
+
 func getClientConfig() *diameter.DiameterClientConfig {
 	return &diameter.DiameterClientConfig{
 		Host:        "test.test.com",
@@ -271,14 +476,14 @@ func getClientConfig() *diameter.DiameterClientConfig {
 func startServer(
 	client *diameter.DiameterClientConfig,
 	server *diameter.DiameterServerConfig,
-) {
+) *mock_pcrf.PCRFDiamServer {
 	serverStarted := make(chan struct{})
+	pcrf := mock_pcrf.NewPCRFDiamServer(
+		client,
+		&mock_pcrf.PCRFConfig{ServerConfig: server},
+	)
 	go func() {
 		log.Printf("Starting server")
-		pcrf := mock_pcrf.NewPCRFDiamServer(
-			client,
-			&mock_pcrf.PCRFConfig{ServerConfig: server},
-		)
 		ctx := context.Background()
 		pcrf.CreateAccount(ctx, &protos.SubscriberID{Id: testIMSI1})
 		pcrf.CreateAccount(ctx, &protos.SubscriberID{Id: testIMSI2})
@@ -389,12 +594,12 @@ func startServer(
 				},
 			},
 		)
-		serverStarted <- struct{}{}
 		lis, err := pcrf.StartListener()
 		if err != nil {
 			log.Fatalf("Could not start listener for PCRF, %s", err.Error())
 		}
 		server.Addr = lis.Addr().String()
+		serverStarted <- struct{}{}
 		err = pcrf.Start(lis)
 		if err != nil {
 			log.Fatalf("Could not start test PCRF server, %s", err.Error())
@@ -403,6 +608,7 @@ func startServer(
 	}()
 	<-serverStarted
 	time.Sleep(time.Millisecond)
+	return pcrf
 }
 
 func getMockReAuthHandler() gx.ReAuthHandler {
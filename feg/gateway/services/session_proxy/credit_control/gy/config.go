@@ -144,6 +144,7 @@ func GetGyClientConfiguration() *diameter.DiameterClientConfig {
 		RetryCount:         uint(retries),
 		SupportedVendorIDs: diameter.GetValueOrEnv("", GySupportedVendorIDsEnv, ""),
 		ServiceContextId:   diameter.GetValueOrEnv("", GyServiceContextIdEnv, ""),
+		PeerGroup:          diameter.GetPeerGroupConfig(gyCfg),
 	}
 }
 
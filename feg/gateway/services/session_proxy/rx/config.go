@@ -0,0 +1,41 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package rx
+
+import (
+	"magma/feg/gateway/diameter"
+)
+
+// Rx Environment Variables
+const (
+	RxAddrEnv        = "RX_ADDR"
+	RxNetworkEnv     = "RX_NETWORK"
+	RxDiamHostEnv    = "RX_DIAM_HOST"
+	RxDiamRealmEnv   = "RX_DIAM_REALM"
+	RxDiamProductEnv = "RX_DIAM_PRODUCT"
+)
+
+// GetRxServerConfiguration returns the address the Rx server listens on for
+// AF connections. The Rx server is disabled if the address is empty
+func GetRxServerConfiguration() *diameter.DiameterServerConfig {
+	return &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+		Addr:     diameter.GetValueOrEnv("", RxAddrEnv, ""),
+		Protocol: diameter.GetValueOrEnv("", RxNetworkEnv, "tcp"),
+	}}
+}
+
+// GetRxDiameterSettings returns the diameter identity of the Rx server
+func GetRxDiameterSettings() *diameter.DiameterClientConfig {
+	return &diameter.DiameterClientConfig{
+		Host:        diameter.GetValueOrEnv("", RxDiamHostEnv, diameter.DiamHost),
+		Realm:       diameter.GetValueOrEnv("", RxDiamRealmEnv, diameter.DiamRealm),
+		ProductName: diameter.GetValueOrEnv("", RxDiamProductEnv, diameter.DiamProductName),
+		AppID:       RxAppID,
+	}
+}
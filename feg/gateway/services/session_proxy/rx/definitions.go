@@ -0,0 +1,167 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package rx
+
+import (
+	"magma/feg/gateway/services/session_proxy/credit_control"
+)
+
+// RxAppID is the Diameter Application ID of Rx, 3GPP TS 29.214 Section 5.2
+const RxAppID = 16777236
+
+// Rx AVP codes not known to go-diameter, 3GPP TS 29.214 Section 5.3
+const (
+	AbortCauseAVP                = 500
+	AFApplicationIdentifierAVP   = 504
+	FlowNumberAVP                = 509
+	FlowStatusAVP                = 511
+	FlowUsageAVP                 = 512
+	SpecificActionAVP            = 513
+	MediaComponentDescriptionAVP = 517
+	MediaComponentNumberAVP      = 518
+	MediaSubComponentAVP         = 519
+	MediaTypeAVP                 = 520
+	RxRequestTypeAVP             = 533
+)
+
+// Experimental-Result-Code values of Rx, 3GPP TS 29.214 Section 5.5
+const (
+	InvalidServiceInformation       = 5061
+	FilterRestrictions              = 5062
+	RequestedServiceNotAuthorized   = 5063
+	DuplicatedAFSession             = 5064
+	IPCANSessionNotAvailable        = 5065
+	UnauthorizedNonEmergencySession = 5066
+)
+
+type MediaType uint32
+
+const (
+	MediaTypeAudio       MediaType = 0
+	MediaTypeVideo       MediaType = 1
+	MediaTypeData        MediaType = 2
+	MediaTypeApplication MediaType = 3
+	MediaTypeControl     MediaType = 4
+	MediaTypeText        MediaType = 5
+	MediaTypeMessage     MediaType = 6
+	MediaTypeOther       MediaType = 0xFFFFFFFF
+)
+
+type FlowStatus uint32
+
+const (
+	FlowStatusEnabledUplink   FlowStatus = 0
+	FlowStatusEnabledDownlink FlowStatus = 1
+	FlowStatusEnabled         FlowStatus = 2
+	FlowStatusDisabled        FlowStatus = 3
+	FlowStatusRemoved         FlowStatus = 4
+)
+
+type FlowUsage uint32
+
+const (
+	FlowUsageNoInformation FlowUsage = 0
+	FlowUsageRTCP          FlowUsage = 1
+	FlowUsageAFSignalling  FlowUsage = 2
+)
+
+// AbortCause is the reason an AF session is aborted, 3GPP TS 29.214 Section 5.3.1
+type AbortCause uint32
+
+const (
+	AbortCauseBearerReleased                      AbortCause = 0
+	AbortCauseInsufficientServerResources         AbortCause = 1
+	AbortCauseInsufficientBearerResources         AbortCause = 2
+	AbortCausePSToCSHandover                      AbortCause = 3
+	AbortCauseSponsoredDataConnectivityDisallowed AbortCause = 4
+)
+
+type RequestType uint32
+
+const (
+	InitialRequest RequestType = 0
+	UpdateRequest  RequestType = 1
+)
+
+// AARequest is an Rx AA-Request, 3GPP TS 29.214 Section 5.6.1
+type AARequest struct {
+	SessionID       string                       `avp:"Session-Id"`
+	OriginHost      string                       `avp:"Origin-Host"`
+	OriginRealm     string                       `avp:"Origin-Realm"`
+	AFApplicationID string                       `avp:"AF-Application-Identifier"`
+	MediaComponents []*MediaComponentDescription `avp:"Media-Component-Description"`
+	SpecificActions []uint32                     `avp:"Specific-Action"`
+	SubscriptionIDs []*SubscriptionID            `avp:"Subscription-Id"`
+	FramedIPAddress []byte                       `avp:"Framed-IP-Address"`
+	CalledStationID string                       `avp:"Called-Station-Id"`
+	RequestType     RequestType                  `avp:"Rx-Request-Type"`
+}
+
+type SubscriptionID struct {
+	IDType credit_control.SubscriptionIDType `avp:"Subscription-Id-Type"`
+	IDData string                            `avp:"Subscription-Id-Data"`
+}
+
+// MediaComponentDescription describes a media stream of an AF session,
+// 3GPP TS 29.214 Section 5.3.7
+type MediaComponentDescription struct {
+	Number                  uint32               `avp:"Media-Component-Number"`
+	SubComponents           []*MediaSubComponent `avp:"Media-Sub-Component"`
+	MediaType               *MediaType           `avp:"Media-Type"`
+	MaxRequestedBandwidthUL *uint32              `avp:"Max-Requested-Bandwidth-UL"`
+	MaxRequestedBandwidthDL *uint32              `avp:"Max-Requested-Bandwidth-DL"`
+	FlowStatus              *FlowStatus          `avp:"Flow-Status"`
+}
+
+// MediaSubComponent describes a single IP flow of a media component,
+// 3GPP TS 29.214 Section 5.3.8
+type MediaSubComponent struct {
+	FlowNumber              uint32      `avp:"Flow-Number"`
+	FlowDescriptions        []string    `avp:"Flow-Description"`
+	FlowStatus              *FlowStatus `avp:"Flow-Status"`
+	FlowUsage               *FlowUsage  `avp:"Flow-Usage"`
+	MaxRequestedBandwidthUL *uint32     `avp:"Max-Requested-Bandwidth-UL"`
+	MaxRequestedBandwidthDL *uint32     `avp:"Max-Requested-Bandwidth-DL"`
+}
+
+// AAAnswer is an Rx AA-Answer, 3GPP TS 29.214 Section 5.6.2
+type AAAnswer struct {
+	SessionID          string `avp:"Session-Id"`
+	ResultCode         uint32 `avp:"Result-Code"`
+	ExperimentalResult struct {
+		VendorId               uint32 `avp:"Vendor-Id"`
+		ExperimentalResultCode uint32 `avp:"Experimental-Result-Code"`
+	} `avp:"Experimental-Result"`
+}
+
+// STRequest is an Rx Session-Termination-Request, 3GPP TS 29.214 Section 5.6.4
+type STRequest struct {
+	SessionID        string `avp:"Session-Id"`
+	OriginHost       string `avp:"Origin-Host"`
+	TerminationCause uint32 `avp:"Termination-Cause"`
+}
+
+// STAnswer is an Rx Session-Termination-Answer, 3GPP TS 29.214 Section 5.6.5
+type STAnswer struct {
+	SessionID  string `avp:"Session-Id"`
+	ResultCode uint32 `avp:"Result-Code"`
+}
+
+// ASRequest is an Rx Abort-Session-Request, 3GPP TS 29.214 Section 5.6.7
+type ASRequest struct {
+	SessionID  string     `avp:"Session-Id"`
+	OriginHost string     `avp:"Origin-Host"`
+	AbortCause AbortCause `avp:"Abort-Cause"`
+}
+
+// ASAnswer is an Rx Abort-Session-Answer, 3GPP TS 29.214 Section 5.6.8
+type ASAnswer struct {
+	SessionID  string `avp:"Session-Id"`
+	ResultCode uint32 `avp:"Result-Code"`
+}
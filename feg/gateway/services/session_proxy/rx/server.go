@@ -0,0 +1,463 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package rx
+
+import (
+	"fmt"
+	"hash/fnv"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control"
+	"magma/feg/gateway/services/session_proxy/credit_control/gx"
+)
+
+const (
+	// media rules take precedence over the static & base rules of the subscriber
+	mediaRulePrecedence = 1
+
+	// QCIs of the media flows, 3GPP TS 29.213 Table 6.3
+	qciConversationalVoice = 1
+	qciConversationalVideo = 2
+	qciIMSSignalling       = 5
+	qciDefault             = 9
+)
+
+// GxSessionResolver finds the Gx session of the subscriber an AF session
+// belongs to
+type GxSessionResolver interface {
+	// FindGxSession returns the ID of the active Gx session of the subscriber
+	// with the given IMSI and/or UE IPv4 address
+	FindGxSession(imsi, ueIPv4 string) (string, bool)
+}
+
+// afSession is an AF session bound to a Gx session
+type afSession struct {
+	gxSessionID string
+	// media component number -> names of the rules installed for its flows
+	components map[uint32][]string
+	// the connection & identity of the AF, to abort the session
+	conn    diam.Conn
+	afHost  string
+	afRealm string
+}
+
+// Server is the Rx interface of session_proxy. It accepts the sessions of
+// application functions (i.e. a P-CSCF) and installs the media components of
+// each session as dynamic rules of the subscriber's Gx session, the dedicated
+// bearers are then set up by sessiond for the QoS of the rules.
+type Server struct {
+	diamSettings  *diameter.DiameterClientConfig
+	serverConfig  *diameter.DiameterServerConfig
+	resolver      GxSessionResolver
+	reAuthHandler gx.ReAuthHandler
+	sessions      map[string]*afSession      // Rx Session-Id -> AF session
+	byGxSession   map[string]map[string]bool // Gx Session-Id -> Rx Session-Ids
+	mutex         sync.Mutex
+}
+
+// NewServer creates an Rx server which binds AF sessions to the Gx sessions
+// found by resolver and installs their rules through reAuthHandler
+func NewServer(
+	diamSettings *diameter.DiameterClientConfig,
+	serverConfig *diameter.DiameterServerConfig,
+	resolver GxSessionResolver,
+	reAuthHandler gx.ReAuthHandler,
+) *Server {
+	return &Server{
+		diamSettings:  diamSettings,
+		serverConfig:  serverConfig,
+		resolver:      resolver,
+		reAuthHandler: reAuthHandler,
+		sessions:      map[string]*afSession{},
+		byGxSession:   map[string]map[string]bool{},
+	}
+}
+
+// StartListener starts a listener on the configured Rx address
+func (srv *Server) StartListener() (net.Listener, error) {
+	network := srv.serverConfig.Protocol
+	if len(network) == 0 {
+		network = "tcp"
+	}
+	return diam.Listen(network, srv.serverConfig.Addr)
+}
+
+// Start serves AF connections accepted by the listener, it blocks until the
+// listener is closed
+func (srv *Server) Start(lis net.Listener) error {
+	mux := sm.New(&sm.Settings{
+		OriginHost:       datatype.DiameterIdentity(srv.diamSettings.Host),
+		OriginRealm:      datatype.DiameterIdentity(srv.diamSettings.Realm),
+		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
+		ProductName:      datatype.UTF8String(srv.diamSettings.ProductName),
+		OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
+		FirmwareRevision: 1,
+	})
+	mux.HandleIdx(diam.CommandIndex{AppID: RxAppID, Code: diam.AA, Request: true}, diam.HandlerFunc(srv.handleAAR))
+	mux.HandleIdx(
+		diam.CommandIndex{AppID: RxAppID, Code: diam.SessionTermination, Request: true}, diam.HandlerFunc(srv.handleSTR))
+	mux.HandleIdx(diam.CommandIndex{AppID: RxAppID, Code: diam.AbortSession}, diam.HandlerFunc(handleASA))
+	go logErrors(mux.ErrorReports())
+	server := &diam.Server{
+		Network: srv.serverConfig.Protocol,
+		Addr:    srv.serverConfig.Addr,
+		Handler: mux,
+	}
+	return server.Serve(lis)
+}
+
+func (srv *Server) handleAAR(c diam.Conn, m *diam.Message) {
+	// installing the rules goes through the gateway, don't block the connection
+	go func() {
+		var aar AARequest
+		if err := m.Unmarshal(&aar); err != nil {
+			glog.Errorf("Received unparseable AAR over Rx: %s", err)
+			srv.sendAnswer(c, m, aar.SessionID, diam.InvalidAVPValue)
+			return
+		}
+		glog.V(2).Infof("Received Rx AAR for session %s", aar.SessionID)
+		srv.sendAnswer(c, m, aar.SessionID, srv.processAAR(c, &aar))
+	}()
+}
+
+func (srv *Server) handleSTR(c diam.Conn, m *diam.Message) {
+	go func() {
+		var str STRequest
+		if err := m.Unmarshal(&str); err != nil {
+			glog.Errorf("Received unparseable STR over Rx: %s", err)
+			srv.sendAnswer(c, m, str.SessionID, diam.InvalidAVPValue)
+			return
+		}
+		glog.V(2).Infof("Received Rx STR for session %s", str.SessionID)
+		srv.sendAnswer(c, m, str.SessionID, srv.processSTR(&str))
+	}()
+}
+
+// processAAR binds a new AF session to the Gx session of its subscriber or
+// updates an existing one, and installs the rules of the media components
+// in the request. Returns the result code of the AAA.
+func (srv *Server) processAAR(c diam.Conn, aar *AARequest) uint32 {
+	srv.mutex.Lock()
+	session, found := srv.sessions[aar.SessionID]
+	srv.mutex.Unlock()
+	if !found {
+		if aar.RequestType == UpdateRequest {
+			glog.Errorf("Received Rx AAR update for unknown session %s", aar.SessionID)
+			return diam.UnknownSessionID
+		}
+		imsi, ueIPv4 := getSubscriber(aar)
+		gxSessionID, ok := srv.resolver.FindGxSession(imsi, ueIPv4)
+		if !ok {
+			glog.Errorf(
+				"No Gx session found for Rx session %s; IMSI: '%s', UE IP: '%s'", aar.SessionID, imsi, ueIPv4)
+			return IPCANSessionNotAvailable
+		}
+		session = &afSession{gxSessionID: gxSessionID, components: map[uint32][]string{}}
+	}
+
+	components := make(map[uint32][]string, len(session.components))
+	for number, ruleNames := range session.components {
+		components[number] = ruleNames
+	}
+	var (
+		rulesToInstall []*gx.RuleDefinition
+		rulesToRemove  []string
+	)
+	for _, component := range aar.MediaComponents {
+		rules := getMediaRules(aar.SessionID, component)
+		ruleNames := make([]string, 0, len(rules))
+		for _, rule := range rules {
+			ruleNames = append(ruleNames, rule.RuleName)
+		}
+		rulesToRemove = append(rulesToRemove, difference(components[component.Number], ruleNames)...)
+		rulesToInstall = append(rulesToInstall, rules...)
+		if len(ruleNames) > 0 {
+			components[component.Number] = ruleNames
+		} else {
+			delete(components, component.Number)
+		}
+	}
+
+	rar := &gx.ReAuthRequest{SessionID: session.gxSessionID}
+	if len(rulesToInstall) > 0 {
+		rar.RulesToInstall = []*gx.RuleInstallAVP{{RuleDefinitions: rulesToInstall}}
+	}
+	if len(rulesToRemove) > 0 {
+		rar.RulesToRemove = []*gx.RuleRemoveAVP{{RuleNames: rulesToRemove}}
+	}
+	if len(rar.RulesToInstall) > 0 || len(rar.RulesToRemove) > 0 {
+		raa := srv.reAuthHandler(rar)
+		switch {
+		case raa == nil:
+			return diam.UnableToComply
+		case raa.ResultCode == diam.UnknownSessionID:
+			glog.Errorf("Gx session %s of Rx session %s is gone", session.gxSessionID, aar.SessionID)
+			return IPCANSessionNotAvailable
+		case raa.ResultCode != diam.Success:
+			glog.Errorf(
+				"Failed to update rules of Gx session %s for Rx session %s; result code: %d",
+				session.gxSessionID, aar.SessionID, raa.ResultCode)
+			return diam.UnableToComply
+		}
+		for _, report := range raa.RuleReports {
+			glog.Warningf(
+				"Rules %v of Rx session %s failed to install; failure code: %d",
+				report.RuleNames, aar.SessionID, report.FailureCode)
+		}
+	}
+
+	srv.mutex.Lock()
+	session.components = components
+	session.conn, session.afHost, session.afRealm = c, aar.OriginHost, aar.OriginRealm
+	srv.sessions[aar.SessionID] = session
+	if srv.byGxSession[session.gxSessionID] == nil {
+		srv.byGxSession[session.gxSessionID] = map[string]bool{}
+	}
+	srv.byGxSession[session.gxSessionID][aar.SessionID] = true
+	srv.mutex.Unlock()
+	return diam.Success
+}
+
+// processSTR ends an AF session and removes all of its rules. Returns the
+// result code of the STA.
+func (srv *Server) processSTR(str *STRequest) uint32 {
+	srv.mutex.Lock()
+	session, found := srv.sessions[str.SessionID]
+	if found {
+		srv.removeSession(str.SessionID, session)
+	}
+	srv.mutex.Unlock()
+	if !found {
+		glog.Errorf("Received Rx STR for unknown session %s", str.SessionID)
+		return diam.UnknownSessionID
+	}
+	var ruleNames []string
+	for _, names := range session.components {
+		ruleNames = append(ruleNames, names...)
+	}
+	if len(ruleNames) == 0 {
+		return diam.Success
+	}
+	raa := srv.reAuthHandler(&gx.ReAuthRequest{
+		SessionID:     session.gxSessionID,
+		RulesToRemove: []*gx.RuleRemoveAVP{{RuleNames: ruleNames}},
+	})
+	// the AF session is over regardless, a Gx session which is gone has no
+	// rules left to remove
+	if raa == nil || (raa.ResultCode != diam.Success && raa.ResultCode != diam.UnknownSessionID) {
+		glog.Errorf("Failed to remove rules %v of Rx session %s", ruleNames, str.SessionID)
+	}
+	return diam.Success
+}
+
+// AbortGxSession ends the AF sessions bound to a Gx session which has
+// terminated. Each AF is sent an ASR, as 3GPP TS 29.214 requires when the
+// IP-CAN session is gone; the rules of the AF sessions went with the Gx
+// session, so none are removed.
+func (srv *Server) AbortGxSession(gxSessionID string) {
+	srv.mutex.Lock()
+	aborted := make(map[string]*afSession, len(srv.byGxSession[gxSessionID]))
+	for sessionID := range srv.byGxSession[gxSessionID] {
+		session := srv.sessions[sessionID]
+		aborted[sessionID] = session
+		srv.removeSession(sessionID, session)
+	}
+	srv.mutex.Unlock()
+	for sessionID, session := range aborted {
+		glog.V(2).Infof("Aborting Rx session %s of terminated Gx session %s", sessionID, gxSessionID)
+		srv.sendASR(sessionID, session, AbortCauseBearerReleased)
+	}
+}
+
+// removeSession drops an AF session. Must be called with the mutex held.
+func (srv *Server) removeSession(sessionID string, session *afSession) {
+	delete(srv.sessions, sessionID)
+	delete(srv.byGxSession[session.gxSessionID], sessionID)
+	if len(srv.byGxSession[session.gxSessionID]) == 0 {
+		delete(srv.byGxSession, session.gxSessionID)
+	}
+}
+
+// sendASR asks the AF to end an AF session, over the connection of its latest
+// AAR
+func (srv *Server) sendASR(sessionID string, session *afSession, cause AbortCause) {
+	m := diam.NewRequest(diam.AbortSession, RxAppID, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(srv.diamSettings.Host))
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(srv.diamSettings.Realm))
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, datatype.DiameterIdentity(session.afRealm))
+	m.NewAVP(avp.DestinationHost, avp.Mbit, 0, datatype.DiameterIdentity(session.afHost))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(RxAppID))
+	m.NewAVP(AbortCauseAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(cause))
+	if _, err := m.WriteTo(session.conn); err != nil {
+		glog.Errorf("Failed to send Rx ASR for session %s to %s: %s", sessionID, session.conn.RemoteAddr(), err)
+	}
+}
+
+func handleASA(c diam.Conn, m *diam.Message) {
+	var asa ASAnswer
+	if err := m.Unmarshal(&asa); err != nil {
+		glog.Errorf("Received unparseable ASA over Rx: %s", err)
+		return
+	}
+	if asa.ResultCode != diam.Success {
+		glog.Errorf("AF failed to abort Rx session %s; result code: %d", asa.SessionID, asa.ResultCode)
+	}
+}
+
+// sendAnswer answers an Rx request, the result codes defined by 29.214 are
+// sent as Experimental-Result
+func (srv *Server) sendAnswer(c diam.Conn, m *diam.Message, sessionID string, resultCode uint32) {
+	var a *diam.Message
+	if resultCode >= InvalidServiceInformation && resultCode <= UnauthorizedNonEmergencySession {
+		a = diam.NewMessage(
+			m.Header.CommandCode,
+			m.Header.CommandFlags&^diam.RequestFlag,
+			m.Header.ApplicationID,
+			m.Header.HopByHopID,
+			m.Header.EndToEndID,
+			m.Dictionary())
+		a.NewAVP(avp.ExperimentalResult, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+				diam.NewAVP(avp.ExperimentalResultCode, avp.Mbit, 0, datatype.Unsigned32(resultCode)),
+			},
+		})
+	} else {
+		a = m.Answer(resultCode)
+	}
+	a.NewAVP(avp.OriginHost, avp.Mbit, 0, datatype.DiameterIdentity(srv.diamSettings.Host))
+	a.NewAVP(avp.OriginRealm, avp.Mbit, 0, datatype.DiameterIdentity(srv.diamSettings.Realm))
+	if m.Header.CommandCode == diam.AA {
+		a.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(RxAppID))
+	}
+	// SessionID must be the first AVP
+	a.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID)))
+	if _, err := a.WriteTo(c); err != nil {
+		glog.Errorf("Failed to send Rx answer to %s: %s", c.RemoteAddr(), err)
+	}
+}
+
+// getSubscriber returns the IMSI and the UE IPv4 address of an AAR, either
+// of them may be empty
+func getSubscriber(aar *AARequest) (imsi string, ueIPv4 string) {
+	for _, subscriptionID := range aar.SubscriptionIDs {
+		if subscriptionID.IDType == credit_control.EndUserIMSI {
+			imsi = subscriptionID.IDData
+			break
+		}
+	}
+	if len(aar.FramedIPAddress) == net.IPv4len {
+		ueIPv4 = net.IP(aar.FramedIPAddress).String()
+	}
+	return imsi, ueIPv4
+}
+
+// getMediaRules returns a dynamic rule for each enabled flow of a media
+// component. Gating isn't supported, so disabled flows are not installed.
+func getMediaRules(rxSessionID string, component *MediaComponentDescription) []*gx.RuleDefinition {
+	if component.FlowStatus != nil && isDisabled(*component.FlowStatus) {
+		return nil
+	}
+	rules := make([]*gx.RuleDefinition, 0, len(component.SubComponents))
+	for _, sub := range component.SubComponents {
+		if len(sub.FlowDescriptions) == 0 || (sub.FlowStatus != nil && isDisabled(*sub.FlowStatus)) {
+			continue
+		}
+		qci := getQCI(component, sub)
+		qos := &gx.QosInformation{
+			Qci:        &qci,
+			MaxReqBwUL: firstNonNil(sub.MaxRequestedBandwidthUL, component.MaxRequestedBandwidthUL),
+			MaxReqBwDL: firstNonNil(sub.MaxRequestedBandwidthDL, component.MaxRequestedBandwidthDL),
+		}
+		if qci == qciConversationalVoice || qci == qciConversationalVideo {
+			// guaranteed bit rate bearer, reserve the requested bandwidth
+			qos.GbrUL, qos.GbrDL = qos.MaxReqBwUL, qos.MaxReqBwDL
+		}
+		rules = append(rules, &gx.RuleDefinition{
+			RuleName:         getRuleName(rxSessionID, component.Number, sub.FlowNumber),
+			Precedence:       mediaRulePrecedence,
+			FlowDescriptions: sub.FlowDescriptions,
+			Qos:              qos,
+		})
+	}
+	return rules
+}
+
+func getQCI(component *MediaComponentDescription, sub *MediaSubComponent) uint32 {
+	if sub.FlowUsage != nil && *sub.FlowUsage == FlowUsageAFSignalling {
+		return qciIMSSignalling
+	}
+	if component.MediaType == nil {
+		return qciDefault
+	}
+	switch *component.MediaType {
+	case MediaTypeAudio:
+		return qciConversationalVoice
+	case MediaTypeVideo:
+		return qciConversationalVideo
+	default:
+		return qciDefault
+	}
+}
+
+// getRuleName returns a rule name unique to the flow of an AF session
+func getRuleName(rxSessionID string, componentNumber, flowNumber uint32) string {
+	h := fnv.New32a()
+	h.Write([]byte(rxSessionID))
+	return fmt.Sprintf("rx-%08x-%d-%d", h.Sum32(), componentNumber, flowNumber)
+}
+
+func isDisabled(status FlowStatus) bool {
+	return status == FlowStatusDisabled || status == FlowStatusRemoved
+}
+
+func firstNonNil(values ...*uint32) *uint32 {
+	for _, v := range values {
+		if v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// difference returns the names of old which are not in new
+func difference(old, new []string) []string {
+	var result []string
+	for _, name := range old {
+		found := false
+		for _, n := range new {
+			if n == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+// logErrors logs errors received during transmission
+func logErrors(ec <-chan *diam.ErrorReport) {
+	for err := range ec {
+		glog.Errorf("Rx transmit error: %s", err)
+	}
+}
@@ -0,0 +1,258 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package rx_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control/gx"
+	"magma/feg/gateway/services/session_proxy/rx"
+	"magma/feg/gateway/services/testcore/af/mock_af"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testIMSI       = "001010000000001"
+	testGxSession  = "IMSI001010000000001-1234"
+	testUEIP       = "192.168.128.12"
+	testIPSession  = "IMSI001010000000002-5678"
+	goneIMSI       = "001010000000003"
+	goneGxSession  = "IMSI001010000000003-9999"
+	audioBandwidth = 64000
+)
+
+// mockResolver maps IMSIs & UE IPs to Gx sessions
+type mockResolver map[string]string
+
+func (r mockResolver) FindGxSession(imsi, ueIPv4 string) (string, bool) {
+	if sid, found := r[ueIPv4]; found {
+		return sid, true
+	}
+	sid, found := r[imsi]
+	return sid, found
+}
+
+// mockPCEF records the RARs sent for Rx sessions
+type mockPCEF struct {
+	sync.Mutex
+	requests []*gx.ReAuthRequest
+}
+
+func (p *mockPCEF) reAuth(request *gx.ReAuthRequest) *gx.ReAuthAnswer {
+	p.Lock()
+	defer p.Unlock()
+	p.requests = append(p.requests, request)
+	if request.SessionID == goneGxSession {
+		return &gx.ReAuthAnswer{SessionID: request.SessionID, ResultCode: diam.UnknownSessionID}
+	}
+	return &gx.ReAuthAnswer{SessionID: request.SessionID, ResultCode: diam.Success}
+}
+
+func (p *mockPCEF) popRequests() []*gx.ReAuthRequest {
+	p.Lock()
+	defer p.Unlock()
+	requests := p.requests
+	p.requests = nil
+	return requests
+}
+
+func TestRxServer(t *testing.T) {
+	pcef := &mockPCEF{}
+	_, af := startRxServer(t, pcef)
+
+	// new AF session with a voice and a signalling component
+	sessionID, resultCode, err := af.StartSession(testIMSI, "", getTestMedia())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+
+	requests := pcef.popRequests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, testGxSession, requests[0].SessionID)
+	assert.Empty(t, requests[0].RulesToRemove)
+	assert.Len(t, requests[0].RulesToInstall, 1)
+	rules := requests[0].RulesToInstall[0].RuleDefinitions
+	assert.Len(t, rules, 3)
+	for _, rule := range rules[:2] {
+		assert.Equal(t, uint32(1), *rule.Qos.Qci)
+		assert.Equal(t, uint32(audioBandwidth), *rule.Qos.MaxReqBwDL)
+		assert.Equal(t, uint32(audioBandwidth), *rule.Qos.GbrDL)
+		assert.Equal(t, uint32(audioBandwidth), *rule.Qos.GbrUL)
+		assert.Len(t, rule.FlowDescriptions, 2)
+	}
+	assert.Equal(t, uint32(5), *rules[2].Qos.Qci)
+	assert.Nil(t, rules[2].Qos.GbrUL)
+	assert.NotEqual(t, rules[0].RuleName, rules[1].RuleName)
+
+	// the voice component is removed, its rules must be removed too
+	removed := rx.FlowStatusRemoved
+	resultCode, err = af.UpdateSession(sessionID, []*rx.MediaComponentDescription{{Number: 1, FlowStatus: &removed}})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	requests = pcef.popRequests()
+	assert.Len(t, requests, 1)
+	assert.Empty(t, requests[0].RulesToInstall)
+	assert.Len(t, requests[0].RulesToRemove, 1)
+	assert.ElementsMatch(t, []string{rules[0].RuleName, rules[1].RuleName}, requests[0].RulesToRemove[0].RuleNames)
+
+	// termination removes the remaining signalling rule
+	resultCode, err = af.TerminateSession(sessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	requests = pcef.popRequests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, []string{rules[2].RuleName}, requests[0].RulesToRemove[0].RuleNames)
+
+	resultCode, err = af.TerminateSession(sessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.UnknownSessionID), resultCode)
+	resultCode, err = af.UpdateSession(sessionID, getTestMedia())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.UnknownSessionID), resultCode)
+	assert.Empty(t, pcef.popRequests())
+}
+
+func TestRxServer_SubscriberResolution(t *testing.T) {
+	pcef := &mockPCEF{}
+	_, af := startRxServer(t, pcef)
+
+	// subscriber identified by the UE IP only
+	_, resultCode, err := af.StartSession("", testUEIP, getTestMedia())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	requests := pcef.popRequests()
+	assert.Len(t, requests, 1)
+	assert.Equal(t, testIPSession, requests[0].SessionID)
+
+	// no Gx session for the subscriber
+	_, resultCode, err = af.StartSession("001010000000999", "", getTestMedia())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(rx.IPCANSessionNotAvailable), resultCode)
+	assert.Empty(t, pcef.popRequests())
+
+	// the Gx session is unknown to the gateway
+	_, resultCode, err = af.StartSession(goneIMSI, "", getTestMedia())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(rx.IPCANSessionNotAvailable), resultCode)
+	assert.Len(t, pcef.popRequests(), 1)
+}
+
+func TestRxServer_GxSessionTermination(t *testing.T) {
+	pcef := &mockPCEF{}
+	server, af := startRxServer(t, pcef)
+
+	sessionID, resultCode, err := af.StartSession(testIMSI, "", getTestMedia())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	otherSessionID, resultCode, err := af.StartSession("", testUEIP, getTestMedia())
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	pcef.popRequests()
+
+	// the AF sessions of the terminated Gx session are aborted, their rules
+	// went with the Gx session
+	server.AbortGxSession(testGxSession)
+	select {
+	case aborted := <-af.Aborts:
+		assert.Equal(t, sessionID, aborted)
+	case <-time.After(mock_af.DefaultTimeout):
+		assert.Fail(t, "Timed out waiting for ASR")
+	}
+	resultCode, err = af.TerminateSession(sessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.UnknownSessionID), resultCode)
+	assert.Empty(t, pcef.popRequests())
+
+	// other AF sessions are unaffected
+	resultCode, err = af.TerminateSession(otherSessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(diam.Success), resultCode)
+	assert.Len(t, pcef.popRequests(), 1)
+	assert.Empty(t, af.Aborts)
+
+	// Gx sessions without AF sessions have nothing to abort
+	server.AbortGxSession(testGxSession)
+	assert.Empty(t, af.Aborts)
+}
+
+func startRxServer(t *testing.T, pcef *mockPCEF) (*rx.Server, *mock_af.MockAF) {
+	serverCfg := &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+		Addr:     "127.0.0.1:0",
+		Protocol: "tcp"},
+	}
+	resolver := mockResolver{testIMSI: testGxSession, testUEIP: testIPSession, goneIMSI: goneGxSession}
+	server := rx.NewServer(
+		&diameter.DiameterClientConfig{Host: "feg.magma.com", Realm: "magma.com", ProductName: "rx_test"},
+		serverCfg,
+		resolver,
+		pcef.reAuth)
+	lis, err := server.StartListener()
+	assert.NoError(t, err)
+	go server.Start(lis)
+	t.Cleanup(func() { lis.Close() })
+
+	afServerCfg := &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
+		Addr:     lis.Addr().String(),
+		Protocol: "tcp"},
+	}
+	af := mock_af.NewMockAF(
+		&diameter.DiameterClientConfig{Host: "pcscf.ims.com", Realm: "ims.com", ProductName: "mock_af"},
+		afServerCfg)
+	return server, af
+}
+
+// getTestMedia returns a voice component with RTP & RTCP flows and a
+// signalling component
+func getTestMedia() []*rx.MediaComponentDescription {
+	audio, control := rx.MediaTypeAudio, rx.MediaTypeControl
+	signalling := rx.FlowUsageAFSignalling
+	var bandwidth uint32 = audioBandwidth
+	return []*rx.MediaComponentDescription{
+		{
+			Number:                  1,
+			MediaType:               &audio,
+			MaxRequestedBandwidthUL: &bandwidth,
+			MaxRequestedBandwidthDL: &bandwidth,
+			SubComponents: []*rx.MediaSubComponent{
+				{
+					FlowNumber: 1,
+					FlowDescriptions: []string{
+						"permit out 17 from 10.10.10.10 49152 to 192.168.128.12 50000",
+						"permit out 17 from 192.168.128.12 50000 to 10.10.10.10 49152",
+					},
+				},
+				{
+					FlowNumber: 2,
+					FlowDescriptions: []string{
+						"permit out 17 from 10.10.10.10 49153 to 192.168.128.12 50001",
+						"permit out 17 from 192.168.128.12 50001 to 10.10.10.10 49153",
+					},
+				},
+			},
+		},
+		{
+			Number:    2,
+			MediaType: &control,
+			SubComponents: []*rx.MediaSubComponent{
+				{
+					FlowNumber: 1,
+					FlowUsage:  &signalling,
+					FlowDescriptions: []string{
+						"permit out 17 from 10.10.10.20 5060 to 192.168.128.12 5060",
+						"permit out 17 from 192.168.128.12 5060 to 10.10.10.20 5060",
+					},
+				},
+			},
+		},
+	}
+}
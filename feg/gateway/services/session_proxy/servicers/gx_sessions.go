@@ -0,0 +1,104 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"sync"
+
+	"magma/feg/gateway/services/session_proxy/credit_control"
+)
+
+type gxSession struct {
+	imsi   string
+	ueIPv4 string
+}
+
+// gxSessionTracker keeps the active Gx sessions, so the sessions of other
+// interfaces (i.e. Rx) can be bound to the Gx session of their subscriber
+type gxSessionTracker struct {
+	sync.RWMutex
+	sessions map[string]gxSession // session ID -> session
+	byIMSI   map[string]string    // IMSI -> ID of the latest session of the subscriber
+	byIP     map[string]string    // UE IPv4 -> session ID
+}
+
+func newGxSessionTracker() *gxSessionTracker {
+	return &gxSessionTracker{
+		sessions: map[string]gxSession{},
+		byIMSI:   map[string]string{},
+		byIP:     map[string]string{},
+	}
+}
+
+func (t *gxSessionTracker) add(sessionID, imsi, ueIPv4 string) {
+	t.Lock()
+	defer t.Unlock()
+	t.sessions[sessionID] = gxSession{imsi: imsi, ueIPv4: ueIPv4}
+	t.byIMSI[imsi] = sessionID
+	if len(ueIPv4) > 0 {
+		t.byIP[ueIPv4] = sessionID
+	}
+}
+
+func (t *gxSessionTracker) remove(sessionID string) {
+	t.Lock()
+	defer t.Unlock()
+	session, found := t.sessions[sessionID]
+	if !found {
+		return
+	}
+	delete(t.sessions, sessionID)
+	if t.byIP[session.ueIPv4] == sessionID {
+		delete(t.byIP, session.ueIPv4)
+	}
+	if t.byIMSI[session.imsi] != sessionID {
+		return
+	}
+	delete(t.byIMSI, session.imsi)
+	// fall back to another session of the subscriber, if any (i.e. on another APN)
+	for id, other := range t.sessions {
+		if other.imsi == session.imsi {
+			t.byIMSI[session.imsi] = id
+			break
+		}
+	}
+}
+
+// find returns the session of the UE IP if given, the latest session of the
+// IMSI otherwise. If both are given, they must belong to the same subscriber.
+func (t *gxSessionTracker) find(imsi, ueIPv4 string) (string, bool) {
+	t.RLock()
+	defer t.RUnlock()
+	if len(ueIPv4) > 0 {
+		sessionID, found := t.byIP[ueIPv4]
+		if !found || (len(imsi) > 0 && t.sessions[sessionID].imsi != imsi) {
+			return "", false
+		}
+		return sessionID, true
+	}
+	sessionID, found := t.byIMSI[imsi]
+	return sessionID, found
+}
+
+// FindGxSession returns the ID of the active Gx session of the subscriber with
+// the given IMSI and/or UE IPv4 address
+func (srv *CentralSessionController) FindGxSession(imsi, ueIPv4 string) (string, bool) {
+	if len(imsi) == 0 && len(ueIPv4) == 0 {
+		return "", false
+	}
+	return srv.gxSessions.find(credit_control.RemoveIMSIPrefix(imsi), ueIPv4)
+}
+
+// SetGxSessionTerminationHandler sets the function called with the ID of each
+// Gx session which terminates, so the sessions of other interfaces (i.e. Rx)
+// bound to it can be ended. It must be set before the controller serves
+// requests.
+func (srv *CentralSessionController) SetGxSessionTerminationHandler(handler func(gxSessionID string)) {
+	srv.gxTerminationHandler = handler
+}
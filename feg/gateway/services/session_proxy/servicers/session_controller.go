@@ -36,6 +36,9 @@ type CentralSessionController struct {
 	dbClient      policydb.PolicyDBClient
 	cfg           *SessionControllerConfig
 	healthTracker *metrics.SessionHealthTracker
+	gxSessions    *gxSessionTracker
+	// called with the ID of each Gx session which terminates
+	gxTerminationHandler func(gxSessionID string)
 }
 
 // SessionControllerConfig stores all the needed configuration for running
@@ -67,6 +70,7 @@ func NewCentralSessionController(
 		dbClient:      dbClient,
 		cfg:           cfg,
 		healthTracker: metrics.NewSessionHealthTracker(),
+		gxSessions:    newGxSessionTracker(),
 	}
 }
 
@@ -99,7 +103,11 @@ func (srv *CentralSessionController) CreateSession(
 	usageMonitors := getUsageMonitorsFromCCA_I(imsi, sessionID, gxCCAInit)
 
 	if srv.cfg.UseGyForAuthOnly {
-		return srv.handleUseGyForAuthOnly(imsi, request, staticRuleInstalls, dynamicRuleInstalls, usageMonitors)
+		res, err := srv.handleUseGyForAuthOnly(imsi, request, staticRuleInstalls, dynamicRuleInstalls, usageMonitors)
+		if err == nil {
+			srv.gxSessions.add(sessionID, imsi, request.UeIpv4)
+		}
+		return res, err
 	}
 	credits := []*protos.CreditUpdateResponse{}
 
@@ -128,6 +136,7 @@ func (srv *CentralSessionController) CreateSession(
 		metrics.OcsCcrInitRequests.Inc()
 	}
 
+	srv.gxSessions.add(sessionID, imsi, request.UeIpv4)
 	return &protos.CreateSessionResponse{
 		Credits:       credits,
 		StaticRules:   staticRuleInstalls,
@@ -249,6 +258,10 @@ func (srv *CentralSessionController) TerminateSession(
 		}
 	}()
 	wg.Wait()
+	srv.gxSessions.remove(request.SessionId)
+	if srv.gxTerminationHandler != nil {
+		srv.gxTerminationHandler(request.SessionId)
+	}
 	// in the event of any errors on Gx or Gy, the session should regardless be
 	// terminated, so there are no errors sent back
 	return &protos.SessionTerminateResponse{
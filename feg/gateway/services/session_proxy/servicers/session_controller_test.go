@@ -614,6 +614,68 @@ func TestSessionTermination(t *testing.T) {
 	assert.Equal(t, fmt.Sprintf("%s-1234", IMSI2), termResponse.SessionId)
 }
 
+func TestFindGxSession(t *testing.T) {
+	mocks := &sessionMocks{
+		gy:       &MockCreditClient{},
+		gx:       &MockPolicyClient{},
+		policydb: &MockPolicyDBClient{},
+	}
+	srv := servicers.NewCentralSessionController(
+		mocks.gy,
+		mocks.gx,
+		mocks.policydb,
+		getTestConfig(gy.PerSessionInit),
+	)
+	var terminated []string
+	srv.SetGxSessionTerminationHandler(func(gxSessionID string) { terminated = append(terminated, gxSessionID) })
+	ctx := context.Background()
+
+	mocks.gx.On("SendCreditControlRequest", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		done := args.Get(1).(chan interface{})
+		request := args.Get(2).(*gx.CreditControlRequest)
+		done <- &gx.CreditControlAnswer{
+			ResultCode:    uint32(diameter.SuccessCode),
+			SessionID:     request.SessionID,
+			RequestNumber: request.RequestNumber,
+		}
+	})
+	mocks.gy.On("SendCreditControlRequest", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(returnDefaultGyResponse)
+	mocks.policydb.On("GetChargingKeysForRules", mock.Anything, mock.Anything).Return([]policydb.ChargingKey{})
+	mocks.policydb.On("GetOmnipresentRules").Return([]string{}, []string{})
+	mocks.policydb.On("GetRuleIDsForBaseNames", mock.Anything).Return([]string{})
+
+	sessionID := fmt.Sprintf("%s-1234", IMSI1)
+	_, err := srv.CreateSession(ctx, &protos.CreateSessionRequest{
+		Subscriber: &protos.SubscriberID{Id: IMSI1},
+		SessionId:  sessionID,
+		UeIpv4:     "192.168.128.11",
+	})
+	assert.NoError(t, err)
+
+	sid, found := srv.FindGxSession(IMSI1, "")
+	assert.True(t, found)
+	assert.Equal(t, sessionID, sid)
+	sid, found = srv.FindGxSession("00101", "192.168.128.11")
+	assert.True(t, found)
+	assert.Equal(t, sessionID, sid)
+	sid, found = srv.FindGxSession("", "192.168.128.11")
+	assert.True(t, found)
+	assert.Equal(t, sessionID, sid)
+	// the IP belongs to another subscriber
+	_, found = srv.FindGxSession(IMSI2, "192.168.128.11")
+	assert.False(t, found)
+
+	assert.Empty(t, terminated)
+
+	_, err = srv.TerminateSession(ctx, &protos.SessionTerminateRequest{Sid: IMSI1, SessionId: sessionID})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{sessionID}, terminated)
+	_, found = srv.FindGxSession(IMSI1, "")
+	assert.False(t, found)
+	_, found = srv.FindGxSession("", "192.168.128.11")
+	assert.False(t, found)
+}
+
 func TestGxUsageMonitoring(t *testing.T) {
 	mocks := &sessionMocks{
 		gy:       &MockCreditClient{},
@@ -25,6 +25,7 @@ import (
 	"magma/feg/gateway/services/session_proxy/credit_control"
 	"magma/feg/gateway/services/session_proxy/credit_control/gx"
 	"magma/feg/gateway/services/session_proxy/credit_control/gy"
+	"magma/feg/gateway/services/session_proxy/rx"
 	"magma/feg/gateway/services/session_proxy/servicers"
 	lteprotos "magma/lte/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
@@ -116,6 +117,24 @@ func main() {
 	lteprotos.RegisterCentralSessionControllerServer(srv.GrpcServer, sessionManager)
 	protos.RegisterServiceHealthServer(srv.GrpcServer, sessionManager)
 
+	// Start the Rx server for AF (P-CSCF) sessions, if configured
+	rxServerCfg := rx.GetRxServerConfiguration()
+	if len(rxServerCfg.Addr) > 0 {
+		rxServer := rx.NewServer(
+			rx.GetRxDiameterSettings(),
+			rxServerCfg,
+			sessionManager,
+			gx.GetGxReAuthHandler(cloudReg, policyDBClient))
+		sessionManager.SetGxSessionTerminationHandler(rxServer.AbortGxSession)
+		lis, err := rxServer.StartListener()
+		if err != nil {
+			glog.Fatalf("Error starting Rx listener on %s: %s", rxServerCfg.Addr, err)
+		}
+		go func() {
+			glog.Errorf("Rx server stopped: %v", rxServer.Start(lis))
+		}()
+	}
+
 	// Run the service
 	err = srv.Run()
 	if err != nil {
@@ -25,6 +25,7 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam/datatype"
 	"github.com/fiorix/go-diameter/v4/diam/dict"
 	"github.com/golang/glog"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -33,7 +34,7 @@ const MinRequestedVectors uint32 = 5
 
 // AuthenticateImpl sends MAR over diameter connection,
 // waits (blocks) for MAA & returns its RPC representation
-func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.AuthenticationAnswer, error) {
+func (s *swxProxy) AuthenticateImpl(ctx context.Context, req *protos.AuthenticationRequest) (*protos.AuthenticationAnswer, error) {
 	var (
 		res = &protos.AuthenticationAnswer{}
 		err = validateAuthRequest(req)
@@ -58,7 +59,7 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 		}
 	}
 	sid := s.genSID(req.GetUserName())
-	maa, err := s.sendMAR(req, sid)
+	maa, err := s.sendMAR(ctx, req, sid)
 	if err != nil {
 		if protos.SwxErrorCode(status.Code(err)) != protos.SwxErrorCode_IDENTITY_ALREADY_REGISTERED {
 			return res, err
@@ -76,13 +77,14 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 			}
 			// deregister
 			s.sendSARExt(
+				ctx,
 				req.GetUserName(),
 				ServerAssignnmentType_USER_DEREGISTRATION,
 				aaaHost,
 				originRalm, "")
 			// repeat MAR after deregistration
 			sid = s.genSID(req.GetUserName())
-			maa, err = s.sendMAR(req, sid)
+			maa, err = s.sendMAR(ctx, req, sid)
 		}
 		if err != nil {
 			return res, err
@@ -90,7 +92,7 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 	}
 	res.SessionId = sid
 	if shouldSendSar {
-		profile, authorized, err := s.retrieveUserProfile(req.GetUserName(), sid)
+		profile, authorized, err := s.retrieveUserProfile(ctx, req.GetUserName(), sid)
 		if err != nil {
 			glog.Error(err)
 		}
@@ -100,7 +102,7 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 		}
 		res.UserProfile = profile
 	} else if s.config.RegisterOnAuth {
-		err := s.registerUser(req.GetUserName(), sid)
+		err := s.registerUser(ctx, req.GetUserName(), sid)
 		if err != nil {
 			glog.Error(err)
 		}
@@ -113,7 +115,7 @@ func (s *swxProxy) AuthenticateImpl(req *protos.AuthenticationRequest) (*protos.
 	return res, err
 }
 
-func (s *swxProxy) sendMAR(req *protos.AuthenticationRequest, sid string) (*MAA, error) {
+func (s *swxProxy) sendMAR(ctx context.Context, req *protos.AuthenticationRequest, sid string) (*MAA, error) {
 	if len(sid) == 0 {
 		sid = s.genSID(req.GetUserName())
 	}
@@ -128,7 +130,15 @@ func (s *swxProxy) sendMAR(req *protos.AuthenticationRequest, sid string) (*MAA,
 	}
 
 	marStartTime := time.Now()
-	err = s.sendDiameterMsg(marMsg, MAX_DIAM_RETRIES)
+	resp, open, err := s.sendDiameterMsg(ctx, marMsg, MAX_DIAM_RETRIES, ch)
+	if err == diameter.ErrAnswerTimeout {
+		metrics.MARRequests.Inc()
+		metrics.MARLatency.Observe(time.Since(marStartTime).Seconds())
+		metrics.SwxTimeouts.Inc()
+		err = status.Errorf(codes.DeadlineExceeded, "MAA Timed Out for Session ID: %s", sid)
+		glog.Error(err)
+		return nil, err
+	}
 	if err != nil {
 		metrics.MARSendFailures.Inc()
 		err = status.Errorf(codes.Internal, "Error while sending MAR with SID %s: %s", sid, err)
@@ -136,49 +146,39 @@ func (s *swxProxy) sendMAR(req *protos.AuthenticationRequest, sid string) (*MAA,
 		return nil, err
 	}
 	metrics.MARRequests.Inc()
-	select {
-	case resp, open := <-ch:
-		metrics.MARLatency.Observe(time.Since(marStartTime).Seconds())
-		if !open {
-			metrics.SwxInvalidSessions.Inc()
-			err = status.Errorf(codes.Aborted, "MAA for Session ID: %s is cancelled", sid)
-			glog.Error(err)
-			return nil, err
-		}
-		maa, ok := resp.(*MAA)
-		if !ok {
-			metrics.SwxUnparseableMsg.Inc()
-			err = status.Errorf(codes.Internal, "Invalid Response Type: %T, MAA expected.", resp)
-			glog.Error(err)
-			return nil, err
-		}
-		err = diameter.TranslateDiamResultCode(maa.ResultCode)
-		metrics.SwxResultCodes.WithLabelValues(strconv.FormatUint(uint64(maa.ResultCode), 10)).Inc()
-		// If there is no base diameter error, check that there is no experimental error either
-		if err == nil {
-			err = diameter.TranslateDiamResultCode(maa.ExperimentalResult.ExperimentalResultCode)
-			metrics.SwxExperimentalResultCodes.WithLabelValues(strconv.FormatUint(uint64(maa.ExperimentalResult.ExperimentalResultCode), 10)).Inc()
-		}
-		// According to spec 29.273, SIP-Auth-Data-Item(s) only present on SUCCESS
-		return maa, err
-
-	case <-time.After(time.Second * TIMEOUT_SECONDS):
-		metrics.MARLatency.Observe(time.Since(marStartTime).Seconds())
-		metrics.SwxTimeouts.Inc()
-		err = status.Errorf(codes.DeadlineExceeded, "MAA Timed Out for Session ID: %s", sid)
+	metrics.MARLatency.Observe(time.Since(marStartTime).Seconds())
+	if !open {
+		metrics.SwxInvalidSessions.Inc()
+		err = status.Errorf(codes.Aborted, "MAA for Session ID: %s is cancelled", sid)
 		glog.Error(err)
 		return nil, err
 	}
+	maa, ok := resp.(*MAA)
+	if !ok {
+		metrics.SwxUnparseableMsg.Inc()
+		err = status.Errorf(codes.Internal, "Invalid Response Type: %T, MAA expected.", resp)
+		glog.Error(err)
+		return nil, err
+	}
+	err = diameter.TranslateDiamResultCode(maa.ResultCode)
+	metrics.SwxResultCodes.WithLabelValues(strconv.FormatUint(uint64(maa.ResultCode), 10)).Inc()
+	// If there is no base diameter error, check that there is no experimental error either
+	if err == nil {
+		err = diameter.TranslateDiamResultCode(maa.ExperimentalResult.ExperimentalResultCode)
+		metrics.SwxExperimentalResultCodes.WithLabelValues(strconv.FormatUint(uint64(maa.ExperimentalResult.ExperimentalResultCode), 10)).Inc()
+	}
+	// According to spec 29.273, SIP-Auth-Data-Item(s) only present on SUCCESS
+	return maa, err
 }
 
 // retrieveUserProfile sends SARs with ServerAssignmentType AAA_USER_DATA_REQUEST or REGISTRATION, receives back SAA
 // and returns the subscribers's Non-3GPP-User-Data profile
-func (s *swxProxy) retrieveUserProfile(userName, sid string) (*protos.AuthenticationAnswer_UserProfile, bool, error) {
+func (s *swxProxy) retrieveUserProfile(ctx context.Context, userName, sid string) (*protos.AuthenticationAnswer_UserProfile, bool, error) {
 	var sat uint32 = ServerAssignmentType_AAA_USER_DATA_REQUEST
 	if s.config.RegisterOnAuth {
 		sat = ServerAssignmentType_REGISTRATION
 	}
-	saa, err := s.sendSAR(userName, sat, sid)
+	saa, err := s.sendSAR(ctx, userName, sat, sid)
 	if err != nil {
 		return nil, true, err
 	}
@@ -199,8 +199,8 @@ func (s *swxProxy) retrieveUserProfile(userName, sid string) (*protos.Authentica
 }
 
 // registerUser sends SARs with ServerAssignmentType REGISTRATION
-func (s *swxProxy) registerUser(userName, sid string) error {
-	_, err := s.sendSAR(userName, ServerAssignmentType_REGISTRATION, sid)
+func (s *swxProxy) registerUser(ctx context.Context, userName, sid string) error {
+	_, err := s.sendSAR(ctx, userName, ServerAssignmentType_REGISTRATION, sid)
 	return err
 }
 
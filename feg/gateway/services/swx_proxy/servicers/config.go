@@ -101,6 +101,7 @@ func GetSwxProxyConfig() *SwxProxyConfig {
 			Retransmits:      uint(configsPtr.GetServer().GetRetransmits()),
 			WatchdogInterval: uint(configsPtr.GetServer().GetWatchdogInterval()),
 			RetryCount:       uint(configsPtr.GetServer().GetRetryCount()),
+			PeerGroup:        diameter.GetPeerGroupConfig(configsPtr.GetServer()),
 		},
 		ServerCfg: &diameter.DiameterServerConfig{DiameterServerConnConfig: diameter.DiameterServerConnConfig{
 			Addr:      diameter.GetValueOrEnv(diameter.AddrFlag, HSSAddrEnv, configsPtr.GetServer().GetAddress()),
@@ -24,13 +24,14 @@ import (
 	"github.com/fiorix/go-diameter/v4/diam/datatype"
 	"github.com/fiorix/go-diameter/v4/diam/dict"
 	"github.com/golang/glog"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 // RegisterImpl sends SAR (code 301) over diameter
 // waits (blocks) for SAA and returns its RPC representation
-func (s *swxProxy) RegisterImpl(req *protos.RegistrationRequest, serverAssignmentType uint32) (*protos.RegistrationAnswer, error) {
+func (s *swxProxy) RegisterImpl(ctx context.Context, req *protos.RegistrationRequest, serverAssignmentType uint32) (*protos.RegistrationAnswer, error) {
 	sid := req.GetSessionId()
 	if len(sid) == 0 {
 		sid = s.genSID(req.GetUserName())
@@ -40,16 +41,16 @@ func (s *swxProxy) RegisterImpl(req *protos.RegistrationRequest, serverAssignmen
 	if err != nil {
 		return res, status.Errorf(codes.InvalidArgument, err.Error())
 	}
-	_, err = s.sendSAR(req.GetUserName(), serverAssignmentType, sid)
+	_, err = s.sendSAR(ctx, req.GetUserName(), serverAssignmentType, sid)
 	return res, err
 }
 
-func (s *swxProxy) sendSAR(userName string, serverAssignmentType uint32, sid string) (*SAA, error) {
-	return s.sendSARExt(userName, serverAssignmentType, s.config.ClientCfg.Host, s.config.ClientCfg.Realm, sid)
+func (s *swxProxy) sendSAR(ctx context.Context, userName string, serverAssignmentType uint32, sid string) (*SAA, error) {
+	return s.sendSARExt(ctx, userName, serverAssignmentType, s.config.ClientCfg.Host, s.config.ClientCfg.Realm, sid)
 }
 
 func (s *swxProxy) sendSARExt(
-	userName string, serverAssignmentType uint32, originHost, originRealm, sid string) (*SAA, error) {
+	ctx context.Context, userName string, serverAssignmentType uint32, originHost, originRealm, sid string) (*SAA, error) {
 	if len(sid) == 0 {
 		sid = s.genSID(userName)
 	}
@@ -61,45 +62,43 @@ func (s *swxProxy) sendSARExt(
 	sarMsg := s.createSAR(sid, userName, serverAssignmentType, originHost, originRealm)
 
 	sarStartTime := time.Now()
-	err := s.sendDiameterMsg(sarMsg, MAX_DIAM_RETRIES)
+	resp, open, err := s.sendDiameterMsg(ctx, sarMsg, MAX_DIAM_RETRIES, ch)
+	if err == diameter.ErrAnswerTimeout {
+		metrics.SARRequests.Inc()
+		metrics.SARLatency.Observe(time.Since(sarStartTime).Seconds())
+		metrics.SwxTimeouts.Inc()
+		err = status.Errorf(codes.DeadlineExceeded, "SAA Timed Out for Session ID: %s", sid)
+		glog.Error(err)
+		return nil, err
+	}
 	if err != nil {
 		metrics.SARSendFailures.Inc()
 		glog.Errorf("Error while sending SAR with SID %s: %s", sid, err)
 		return nil, err
 	}
 	metrics.SARRequests.Inc()
-	select {
-	case resp, open := <-ch:
-		metrics.SARLatency.Observe(time.Since(sarStartTime).Seconds())
-		if !open {
-			metrics.SwxInvalidSessions.Inc()
-			err = status.Errorf(codes.Aborted, "SAA for Session ID: %s is cancelled", sid)
-			glog.Error(err)
-			return nil, err
-		}
-		saa, ok := resp.(*SAA)
-		if !ok {
-			metrics.SwxUnparseableMsg.Inc()
-			err = status.Errorf(codes.Internal, "Invalid Response Type: %T, SAA expected.", resp)
-			glog.Error(err)
-			return nil, err
-		}
-		err = diameter.TranslateDiamResultCode(saa.ResultCode)
-		metrics.SwxResultCodes.WithLabelValues(strconv.FormatUint(uint64(saa.ResultCode), 10)).Inc()
-		// If there is no base diameter error, check that there is no experimental error either
-		if err == nil {
-			err = diameter.TranslateDiamResultCode(saa.ExperimentalResult.ExperimentalResultCode)
-			metrics.SwxExperimentalResultCodes.WithLabelValues(strconv.FormatUint(uint64(saa.ExperimentalResult.ExperimentalResultCode), 10)).Inc()
-		}
-		return saa, err
-
-	case <-time.After(time.Second * TIMEOUT_SECONDS):
-		metrics.SARLatency.Observe(time.Since(sarStartTime).Seconds())
-		metrics.SwxTimeouts.Inc()
-		err = status.Errorf(codes.DeadlineExceeded, "SAA Timed Out for Session ID: %s", sid)
+	metrics.SARLatency.Observe(time.Since(sarStartTime).Seconds())
+	if !open {
+		metrics.SwxInvalidSessions.Inc()
+		err = status.Errorf(codes.Aborted, "SAA for Session ID: %s is cancelled", sid)
 		glog.Error(err)
 		return nil, err
 	}
+	saa, ok := resp.(*SAA)
+	if !ok {
+		metrics.SwxUnparseableMsg.Inc()
+		err = status.Errorf(codes.Internal, "Invalid Response Type: %T, SAA expected.", resp)
+		glog.Error(err)
+		return nil, err
+	}
+	err = diameter.TranslateDiamResultCode(saa.ResultCode)
+	metrics.SwxResultCodes.WithLabelValues(strconv.FormatUint(uint64(saa.ResultCode), 10)).Inc()
+	// If there is no base diameter error, check that there is no experimental error either
+	if err == nil {
+		err = diameter.TranslateDiamResultCode(saa.ExperimentalResult.ExperimentalResultCode)
+		metrics.SwxExperimentalResultCodes.WithLabelValues(strconv.FormatUint(uint64(saa.ExperimentalResult.ExperimentalResultCode), 10)).Inc()
+	}
+	return saa, err
 }
 
 // createSAR creates a Server Assignment Request with provided SessionID (sid),
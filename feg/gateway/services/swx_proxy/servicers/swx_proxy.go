@@ -46,6 +46,7 @@ type swxProxy struct {
 	config         *SwxProxyConfig
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
+	peerGroup      *diameter.PeerGroup
 	requestTracker *diameter.RequestTracker
 	originStateID  uint32
 	cache          *cache.Impl
@@ -127,13 +128,15 @@ func NewSwxProxyWithCache(config *SwxProxyConfig, cache *cache.Impl) (*swxProxy,
 	}
 
 	connMan := diameter.NewConnectionManager()
-	// create connection in connection map
-	connMan.GetConnection(smClient, config.ServerCfg)
+	peerGroup := diameter.NewPeerGroup(config.ServerCfg, config.ClientCfg.PeerGroup)
+	// create connections in connection map
+	peerGroup.BeginConnections(connMan, smClient)
 
 	proxy := &swxProxy{
 		config:         config,
 		smClient:       smClient,
 		connMan:        connMan,
+		peerGroup:      peerGroup,
 		healthTracker:  metrics.NewSwxHealthTracker(),
 		requestTracker: diameter.NewRequestTracker(),
 		originStateID:  originStateID,
@@ -169,7 +172,7 @@ func (s *swxProxy) Authenticate(
 	if s.IsHlrClient(req.GetUserName()) {
 		res, err = hlr_proxy.Authenticate(ctx, req)
 	} else {
-		res, err = s.AuthenticateImpl(req)
+		res, err = s.AuthenticateImpl(ctx, req)
 	}
 	if err == nil {
 		metrics.AuthLatency.Observe(time.Since(authStartTime).Seconds())
@@ -191,7 +194,7 @@ func (s *swxProxy) Register(
 	if s.IsHlrClient(req.GetUserName()) {
 		res, err = hlr_proxy.Register(ctx, req)
 	} else {
-		res, err = s.RegisterImpl(req, ServerAssignmentType_REGISTRATION)
+		res, err = s.RegisterImpl(ctx, req, ServerAssignmentType_REGISTRATION)
 	}
 	if err == nil {
 		metrics.RegisterLatency.Observe(time.Since(registerStartTime).Seconds())
@@ -213,7 +216,7 @@ func (s *swxProxy) Deregister(
 	if s.IsHlrClient(req.GetUserName()) {
 		res, err = hlr_proxy.Register(ctx, req)
 	} else {
-		res, err = s.RegisterImpl(req, ServerAssignnmentType_USER_DEREGISTRATION)
+		res, err = s.RegisterImpl(ctx, req, ServerAssignnmentType_USER_DEREGISTRATION)
 	}
 	if err == nil {
 		metrics.DeregisterLatency.Observe(time.Since(deregisterStartTime).Seconds())
@@ -237,7 +240,7 @@ func (s *swxProxy) Disable(ctx context.Context, req *protos.DisableMessage) (*or
 // exists, Enable has no effect
 func (s *swxProxy) Enable(ctx context.Context, req *orcprotos.Void) (*orcprotos.Void, error) {
 	s.connMan.Enable()
-	_, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
+	err := s.peerGroup.BeginConnections(s.connMan, s.smClient)
 	return &orcprotos.Void{}, err
 }
 
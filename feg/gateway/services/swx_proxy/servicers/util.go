@@ -9,21 +9,29 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
+	"time"
+
+	"magma/feg/gateway/diameter"
+
 	"github.com/fiorix/go-diameter/v4/diam"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func (s *swxProxy) sendDiameterMsg(msg *diam.Message, retryCount uint) error {
-	conn, err := s.connMan.GetConnection(s.smClient, s.config.ServerCfg)
-	if err != nil {
-		return err
-	}
-	err = conn.SendRequest(msg, retryCount)
-	if err != nil {
+// sendDiameterMsg sends the request to the HSS and waits for its answer on ch.
+// When the answer times out, the request is sent again to the next peer of
+// the HSS peer group, and diameter.ErrAnswerTimeout is returned once every
+// peer timed out or TIMEOUT_SECONDS, or the deadline of ctx if it is earlier,
+// have passed.
+func (s *swxProxy) sendDiameterMsg(ctx context.Context, msg *diam.Message, retryCount uint, ch <-chan interface{}) (interface{}, bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, time.Second*TIMEOUT_SECONDS)
+	defer cancel()
+	resp, open, err := s.peerGroup.SendRequestAndWait(ctx, s.connMan, s.smClient, msg, retryCount, ch)
+	if err != nil && err != diameter.ErrAnswerTimeout {
 		err = status.Errorf(codes.DataLoss, err.Error())
 	}
-	return err
+	return resp, open, err
 }
 
 func (s *swxProxy) IsHlrClient(imsi string) bool {
@@ -0,0 +1,247 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package mock_af implements a mock application function (i.e. a P-CSCF),
+// which requests media sessions of subscribers over Rx
+package mock_af
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+	"github.com/golang/glog"
+
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control"
+	"magma/feg/gateway/services/session_proxy/rx"
+)
+
+// DefaultTimeout is how long the AF waits for an answer of the Rx server
+const DefaultTimeout = 5 * time.Second
+
+// Termination-Cause of the STRs sent by the AF
+const diameterLogout = 1
+
+// maxPendingAborts is how many aborted session IDs Aborts buffers, later
+// ones are dropped until it is read
+const maxPendingAborts = 16
+
+// MockAF is an Rx client sending AA and Session-Termination requests
+type MockAF struct {
+	diamClient *diameter.Client
+	clientCfg  *diameter.DiameterClientConfig
+	serverCfg  *diameter.DiameterServerConfig
+	Timeout    time.Duration
+	// Aborts receives the IDs of the sessions the Rx server aborts with ASRs
+	Aborts chan string
+}
+
+// requestKey identifies the answer of an Rx request
+type requestKey struct {
+	command   uint32
+	sessionID string
+}
+
+// NewMockAF creates an AF connecting to the Rx server of serverCfg
+func NewMockAF(clientCfg *diameter.DiameterClientConfig, serverCfg *diameter.DiameterServerConfig) *MockAF {
+	cfg := *clientCfg
+	cfg.AppID = rx.RxAppID
+	af := &MockAF{
+		diamClient: diameter.NewClient(&cfg),
+		clientCfg:  &cfg,
+		serverCfg:  serverCfg,
+		Timeout:    DefaultTimeout,
+		Aborts:     make(chan string, maxPendingAborts),
+	}
+	af.diamClient.RegisterAnswerHandlerForAppID(diam.AA, rx.RxAppID, aaaHandler)
+	af.diamClient.RegisterAnswerHandlerForAppID(diam.SessionTermination, rx.RxAppID, staHandler)
+	af.diamClient.RegisterRequestHandlerForAppID(diam.AbortSession, rx.RxAppID, af.handleASR)
+	return af
+}
+
+// StartSession starts an AF session for the media of the subscriber, which is
+// identified by IMSI and/or UE IPv4 address.
+// Output: the Rx session ID and the result code of the AAA
+func (af *MockAF) StartSession(
+	imsi string,
+	ueIPv4 string,
+	media []*rx.MediaComponentDescription,
+) (string, uint32, error) {
+	sessionID := af.clientCfg.GenSessionID("rx")
+	m := af.newRequest(diam.AA, sessionID)
+	m.NewAVP(rx.RxRequestTypeAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(rx.InitialRequest))
+	if len(imsi) > 0 {
+		m.NewAVP(avp.SubscriptionID, avp.Mbit, 0, &diam.GroupedAVP{
+			AVP: []*diam.AVP{
+				diam.NewAVP(avp.SubscriptionIDType, avp.Mbit, 0, datatype.Enumerated(credit_control.EndUserIMSI)),
+				diam.NewAVP(avp.SubscriptionIDData, avp.Mbit, 0, datatype.UTF8String(imsi)),
+			},
+		})
+	}
+	if len(ueIPv4) > 0 {
+		ip := net.ParseIP(ueIPv4).To4()
+		if ip == nil {
+			return "", 0, fmt.Errorf("Invalid UE IPv4 address: %s", ueIPv4)
+		}
+		m.NewAVP(avp.FramedIPAddress, avp.Mbit, 0, datatype.OctetString(ip))
+	}
+	addMediaComponents(m, media)
+	resultCode, err := af.sendRequest(m, diam.AA, sessionID)
+	return sessionID, resultCode, err
+}
+
+// UpdateSession modifies the media components of an AF session
+// Output: the result code of the AAA
+func (af *MockAF) UpdateSession(sessionID string, media []*rx.MediaComponentDescription) (uint32, error) {
+	m := af.newRequest(diam.AA, sessionID)
+	m.NewAVP(rx.RxRequestTypeAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(rx.UpdateRequest))
+	addMediaComponents(m, media)
+	return af.sendRequest(m, diam.AA, sessionID)
+}
+
+// TerminateSession ends an AF session
+// Output: the result code of the STA
+func (af *MockAF) TerminateSession(sessionID string) (uint32, error) {
+	m := af.newRequest(diam.SessionTermination, sessionID)
+	m.NewAVP(avp.TerminationCause, avp.Mbit, 0, datatype.Enumerated(diameterLogout))
+	return af.sendRequest(m, diam.SessionTermination, sessionID)
+}
+
+func (af *MockAF) newRequest(command uint32, sessionID string) *diam.Message {
+	m := diam.NewRequest(command, rx.RxAppID, dict.Default)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(sessionID))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(rx.RxAppID))
+	return m
+}
+
+// sendRequest sends the request and waits for the result code of its answer
+func (af *MockAF) sendRequest(m *diam.Message, command uint32, sessionID string) (uint32, error) {
+	key := requestKey{command: command, sessionID: sessionID}
+	done := make(chan interface{}, 1)
+	if err := af.diamClient.SendRequest(af.serverCfg, done, m, key); err != nil {
+		return 0, err
+	}
+	select {
+	case resultCode := <-done:
+		return resultCode.(uint32), nil
+	case <-time.After(af.Timeout):
+		af.diamClient.IgnoreAnswer(key)
+		return 0, fmt.Errorf("Timed out waiting for answer of Rx session %s", sessionID)
+	}
+}
+
+func addMediaComponents(m *diam.Message, media []*rx.MediaComponentDescription) {
+	for _, component := range media {
+		m.AddAVP(getMediaComponentAVP(component))
+	}
+}
+
+func getMediaComponentAVP(component *rx.MediaComponentDescription) *diam.AVP {
+	avps := []*diam.AVP{
+		diam.NewAVP(rx.MediaComponentNumberAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(component.Number)),
+	}
+	for _, sub := range component.SubComponents {
+		subAVPs := []*diam.AVP{
+			diam.NewAVP(rx.FlowNumberAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(sub.FlowNumber)),
+		}
+		for _, flow := range sub.FlowDescriptions {
+			subAVPs = append(subAVPs,
+				diam.NewAVP(avp.FlowDescription, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.IPFilterRule(flow)))
+		}
+		if sub.FlowStatus != nil {
+			subAVPs = append(subAVPs,
+				diam.NewAVP(rx.FlowStatusAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(*sub.FlowStatus)))
+		}
+		if sub.FlowUsage != nil {
+			subAVPs = append(subAVPs,
+				diam.NewAVP(rx.FlowUsageAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(*sub.FlowUsage)))
+		}
+		subAVPs = append(subAVPs, getBandwidthAVPs(sub.MaxRequestedBandwidthUL, sub.MaxRequestedBandwidthDL)...)
+		avps = append(avps, diam.NewAVP(
+			rx.MediaSubComponentAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{AVP: subAVPs}))
+	}
+	if component.MediaType != nil {
+		avps = append(avps,
+			diam.NewAVP(rx.MediaTypeAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(*component.MediaType)))
+	}
+	avps = append(avps, getBandwidthAVPs(component.MaxRequestedBandwidthUL, component.MaxRequestedBandwidthDL)...)
+	if component.FlowStatus != nil {
+		avps = append(avps,
+			diam.NewAVP(rx.FlowStatusAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(*component.FlowStatus)))
+	}
+	return diam.NewAVP(rx.MediaComponentDescriptionAVP, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{AVP: avps})
+}
+
+func getBandwidthAVPs(ul, dl *uint32) []*diam.AVP {
+	var avps []*diam.AVP
+	if ul != nil {
+		avps = append(avps,
+			diam.NewAVP(avp.MaxRequestedBandwidthUL, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(*ul)))
+	}
+	if dl != nil {
+		avps = append(avps,
+			diam.NewAVP(avp.MaxRequestedBandwidthDL, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(*dl)))
+	}
+	return avps
+}
+
+// handleASR answers an ASR of the Rx server and reports the aborted session
+// on Aborts
+func (af *MockAF) handleASR(c diam.Conn, m *diam.Message) {
+	var asr rx.ASRequest
+	if err := m.Unmarshal(&asr); err != nil {
+		glog.Errorf("Received unparseable ASR over Rx: %s", err)
+		return
+	}
+	a := m.Answer(diam.Success)
+	a.InsertAVP(diam.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(asr.SessionID)))
+	a = af.diamClient.AddOriginAVPsToMessage(a)
+	if _, err := a.WriteTo(c); err != nil {
+		glog.Errorf("Failed to send Rx ASA for session %s: %s", asr.SessionID, err)
+	}
+	select {
+	case af.Aborts <- asr.SessionID:
+	default:
+		glog.Warningf("Dropped abort of Rx session %s, Aborts is full", asr.SessionID)
+	}
+}
+
+// aaaHandler returns the result code of an AAA, which is either a base or an
+// Rx specific experimental result code
+func aaaHandler(message *diam.Message) diameter.KeyAndAnswer {
+	var aaa rx.AAAnswer
+	if err := message.Unmarshal(&aaa); err != nil {
+		glog.Errorf("Received unparseable AAA over Rx: %s", err)
+		return diameter.KeyAndAnswer{}
+	}
+	resultCode := aaa.ResultCode
+	if resultCode == 0 {
+		resultCode = aaa.ExperimentalResult.ExperimentalResultCode
+	}
+	return diameter.KeyAndAnswer{
+		Key:    requestKey{command: diam.AA, sessionID: aaa.SessionID},
+		Answer: resultCode,
+	}
+}
+
+func staHandler(message *diam.Message) diameter.KeyAndAnswer {
+	var sta rx.STAnswer
+	if err := message.Unmarshal(&sta); err != nil {
+		glog.Errorf("Received unparseable STA over Rx: %s", err)
+		return diameter.KeyAndAnswer{}
+	}
+	return diameter.KeyAndAnswer{
+		Key:    requestKey{command: diam.SessionTermination, sessionID: sta.SessionID},
+		Answer: sta.ResultCode,
+	}
+}
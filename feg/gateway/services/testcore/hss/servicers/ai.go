@@ -57,7 +57,7 @@ func NewAIA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 	plmn := air.VisitedPLMNID.Serialize()[plmnOffsetBytes:]
 
 	vectors, lteAuthNextSeq, err := servicers.GenerateLteAuthVectors(uint32(air.RequestedEUTRANAuthInfo.NumVectors),
-		srv.Milenage, subscriber, plmn, srv.Config.LteAuthOp, srv.AuthSqnInd)
+		srv.AuthCiphers, subscriber, plmn, srv.Config.LteAuthOp, srv.AuthSqnInd)
 	if err == nil {
 		err = srv.setLteAuthNextSeq(subscriber, lteAuthNextSeq)
 	}
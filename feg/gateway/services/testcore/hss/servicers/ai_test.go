@@ -61,7 +61,7 @@ func TestNewAIA_SuccessfulResponse(t *testing.T) {
 	rand := []byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\t\n\x0b\x0c\r\x0e\x0f")
 	milenage, err := crypto.NewMockMilenageCipher(amf, rand)
 	assert.NoError(t, err)
-	server.Milenage = milenage
+	server.AuthCiphers[lteprotos.LTESubscription_MILENAGE] = milenage
 
 	air := createAIR("sub1")
 	response, err := hss.NewAIA(server, air)
@@ -91,6 +91,40 @@ func TestNewAIA_SuccessfulResponse(t *testing.T) {
 	assert.Equal(t, uint64(7351), subscriber.State.LteAuthNextSeq)
 }
 
+func TestNewAIA_TuakSubscriber(t *testing.T) {
+	server := test.NewTestHomeSubscriberServer(t)
+	sub := &lteprotos.SubscriberData{
+		Sid: &lteprotos.SubscriberID{Id: "tuak_sub"},
+		Lte: &lteprotos.LTESubscription{
+			State:    lteprotos.LTESubscription_ACTIVE,
+			AuthAlgo: lteprotos.LTESubscription_TUAK,
+			AuthKey:  []byte("\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab\xab"),
+			AuthOpc:  []byte("\xbd\x04\xd9S\x0e\x87Q<]\x83z\xc2\xad\x95F#\xa8\xe23\x0c\x11S\x05\xa7>\xb4]\x1f@\xcc\xcb\xff"),
+		},
+		State: &lteprotos.SubscriberState{LteAuthNextSeq: 7350},
+	}
+	_, err := server.AddSubscriber(context.Background(), sub)
+	assert.NoError(t, err)
+
+	response, err := hss.NewAIA(server, createAIR("tuak_sub"))
+	assert.NoError(t, err)
+
+	var aia definitions.AIA
+	err = response.Unmarshal(&aia)
+	assert.NoError(t, err)
+	assert.Equal(t, diam.Success, int(aia.ResultCode))
+	assert.Equal(t, 1, len(aia.AIs))
+	assert.Equal(t, 1, len(aia.AIs[0].EUtranVectors))
+	vector := aia.AIs[0].EUtranVectors[0]
+	assert.Equal(t, crypto.XresBytes, len(vector.XRES))
+	assert.Equal(t, crypto.AutnBytes, len(vector.AUTN))
+	assert.Equal(t, crypto.KasmeBytes, len(vector.KASME))
+
+	subscriber, err := server.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "tuak_sub"})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(7351), subscriber.State.LteAuthNextSeq)
+}
+
 func TestNewAIA_MultipleVectors(t *testing.T) {
 	server := test.NewTestHomeSubscriberServer(t)
 	air := createAIRExtended("sub1", 3)
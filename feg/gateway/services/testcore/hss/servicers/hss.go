@@ -13,9 +13,10 @@ import (
 
 	"magma/feg/cloud/go/protos/mconfig"
 	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 	"magma/feg/gateway/services/testcore/hss/storage"
-	"magma/lte/cloud/go/crypto"
 	lteprotos "magma/lte/cloud/go/protos"
+	"magma/lte/cloud/go/services/eps_authentication/servicers"
 	"magma/orc8r/cloud/go/protos"
 
 	"github.com/fiorix/go-diameter/v4/diam"
@@ -36,12 +37,15 @@ const (
 type HomeSubscriberServer struct {
 	store          storage.SubscriberStore
 	Config         *mconfig.HSSConfig
-	Milenage       *crypto.MilenageCipher
+	AuthCiphers    servicers.AuthCiphers
 	smClient       *sm.Client
 	connMan        *diameter.ConnectionManager
 	requestTracker *diameter.RequestTracker
 	clientMapping  map[string]string
 
+	// AkaPrimeAuthCiphers generate the EAP-AKA' vectors, their AMF has the separation bit set.
+	AkaPrimeAuthCiphers servicers.AuthCiphers
+
 	// authSqnInd is an index used in the array scheme described by 3GPP TS 33.102 Appendix C.1.2 and C.2.2.
 	// SQN consists of two parts (SQN = SEQ||IND).
 	AuthSqnInd uint64
@@ -50,17 +54,22 @@ type HomeSubscriberServer struct {
 // NewHomeSubscriberServer initializes a HomeSubscriberServer with an empty accounts map.
 // Output: a new HomeSubscriberServer
 func NewHomeSubscriberServer(store storage.SubscriberStore, config *mconfig.HSSConfig) (*HomeSubscriberServer, error) {
-	milenage, err := crypto.NewMilenageCipher(config.LteAuthAmf)
+	ciphers, err := servicers.NewAuthCiphers(config.LteAuthAmf)
+	if err != nil {
+		return nil, err
+	}
+	akaPrimeCiphers, err := servicers.NewAuthCiphers(aka_prime.WithAMFSeparationBit(config.LteAuthAmf))
 	if err != nil {
 		return nil, err
 	}
 	return &HomeSubscriberServer{
-		store:          store,
-		Config:         config,
-		Milenage:       milenage,
-		requestTracker: diameter.NewRequestTracker(),
-		connMan:        diameter.NewConnectionManager(),
-		clientMapping:  map[string]string{},
+		store:               store,
+		Config:              config,
+		AuthCiphers:         ciphers,
+		AkaPrimeAuthCiphers: akaPrimeCiphers,
+		requestTracker:      diameter.NewRequestTracker(),
+		connMan:             diameter.NewConnectionManager(),
+		clientMapping:       map[string]string{},
 	}, nil
 }
 
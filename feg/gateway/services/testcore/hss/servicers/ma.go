@@ -14,6 +14,7 @@ import (
 
 	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 	swx "magma/feg/gateway/services/swx_proxy/servicers"
 	"magma/feg/gateway/services/testcore/hss/storage"
 	"magma/lte/cloud/go/crypto"
@@ -70,12 +71,13 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		return ConvertAuthErrorToFailureMessage(err, msg, mar.SessionID, srv.Config.Server), err
 	}
 
-	if mar.AuthData.AuthScheme != swx.SipAuthScheme_EAP_AKA {
-		err = fmt.Errorf("Unsupported SIP authentication scheme: %s", mar.AuthData.AuthScheme)
+	authScheme := mar.AuthData.AuthScheme
+	if authScheme != swx.SipAuthScheme_EAP_AKA && authScheme != swx.SipAuthScheme_EAP_AKA_PRIME {
+		err = fmt.Errorf("Unsupported SIP authentication scheme: %s", authScheme)
 		return ConstructFailureAnswer(msg, mar.SessionID, srv.Config.Server, uint32(diam.UnableToComply)), err
 	}
 
-	vectors, lteAuthNextSeq, err := srv.GenerateSIPAuthVectors(subscriber, mar.NumberAuthItems)
+	vectors, lteAuthNextSeq, err := srv.GenerateSIPAuthVectors(subscriber, mar.NumberAuthItems, authScheme)
 	if err == nil {
 		err = srv.setLteAuthNextSeq(subscriber, lteAuthNextSeq)
 	}
@@ -88,20 +90,20 @@ func NewMAA(srv *HomeSubscriberServer, msg *diam.Message) (*diam.Message, error)
 		}
 	}
 
-	return srv.NewSuccessfulMAA(msg, mar.SessionID, datatype.UTF8String(mar.UserName), vectors), nil
+	return srv.NewSuccessfulMAA(msg, mar.SessionID, datatype.UTF8String(mar.UserName), authScheme, vectors), nil
 }
 
 // NewSuccessfulMAA outputs a successful multimedia authentication answer (MAA) to reply to an
 // multimedia authentication request (MAR) message. It populates the MAA with all of the mandatory fields
-// and adds the authentication vectors. See 3GPP TS 29.273 table 8.1.2.1.1/5.
-func (srv *HomeSubscriberServer) NewSuccessfulMAA(msg *diam.Message, sessionID datatype.UTF8String, userName datatype.UTF8String, vectors []*crypto.SIPAuthVector) *diam.Message {
+// and adds the authentication vectors of the SIP auth scheme. See 3GPP TS 29.273 table 8.1.2.1.1/5.
+func (srv *HomeSubscriberServer) NewSuccessfulMAA(msg *diam.Message, sessionID datatype.UTF8String, userName datatype.UTF8String, authScheme string, vectors []*crypto.SIPAuthVector) *diam.Message {
 	maa := ConstructSuccessAnswer(msg, sessionID, srv.Config.Server, diam.TGPP_SWX_APP_ID)
 	for itemNumber, vector := range vectors {
 		authenticate := append(vector.Rand[:], vector.Autn[:]...)
 		maa.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 			AVP: []*diam.AVP{
 				diam.NewAVP(avp.SIPItemNumber, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(itemNumber)),
-				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(swx.SipAuthScheme_EAP_AKA)),
+				diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(authScheme)),
 				diam.NewAVP(avp.SIPAuthenticate, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(authenticate)),
 				diam.NewAVP(avp.SIPAuthorization, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(vector.Xres[:])),
 				diam.NewAVP(avp.ConfidentialityKey, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(vector.ConfidentialityKey[:])),
@@ -114,13 +116,13 @@ func (srv *HomeSubscriberServer) NewSuccessfulMAA(msg *diam.Message, sessionID d
 	return maa
 }
 
-// GenerateSIPAuthVectors generates `numVectors` SIP auth vectors for the subscriber.
+// GenerateSIPAuthVectors generates `numVectors` SIP auth vectors of the SIP auth scheme for the subscriber.
 // The vectors and the next value of lteAuthNextSeq are returned (or an error).
-func (srv *HomeSubscriberServer) GenerateSIPAuthVectors(subscriber *lteprotos.SubscriberData, numVectors uint32) ([]*crypto.SIPAuthVector, uint64, error) {
+func (srv *HomeSubscriberServer) GenerateSIPAuthVectors(subscriber *lteprotos.SubscriberData, numVectors uint32, authScheme string) ([]*crypto.SIPAuthVector, uint64, error) {
 	var vectors = make([]*crypto.SIPAuthVector, 0, numVectors)
 	lteAuthNextSeq := subscriber.GetState().GetLteAuthNextSeq()
 	for i := uint32(0); i < numVectors; i++ {
-		vector, nextSeq, err := srv.GenerateSIPAuthVector(subscriber)
+		vector, nextSeq, err := srv.GenerateSIPAuthVector(subscriber, authScheme)
 		lteAuthNextSeq = nextSeq
 		if err != nil {
 			return vectors, 0, err
@@ -130,8 +132,10 @@ func (srv *HomeSubscriberServer) GenerateSIPAuthVectors(subscriber *lteprotos.Su
 	return vectors, lteAuthNextSeq, nil
 }
 
-// GenerateSIPAuthVector returns the SIP auth vector and the next value of lteAuthNextSeq for the subscriber (or an error).
-func (srv *HomeSubscriberServer) GenerateSIPAuthVector(subscriber *lteprotos.SubscriberData) (*crypto.SIPAuthVector, uint64, error) {
+// GenerateSIPAuthVector returns the SIP auth vector of the SIP auth scheme and the next value of lteAuthNextSeq for
+// the subscriber (or an error). EAP-AKA' vectors have the AMF separation bit set and carry CK' & IK' instead of
+// CK & IK, see 3GPP TS 33.402 section 6.2.
+func (srv *HomeSubscriberServer) GenerateSIPAuthVector(subscriber *lteprotos.SubscriberData, authScheme string) (*crypto.SIPAuthVector, uint64, error) {
 	lte := subscriber.Lte
 	if err := servicers.ValidateLteSubscription(lte); err != nil {
 		return nil, 0, servicers.NewAuthRejectedError(err.Error())
@@ -145,11 +149,28 @@ func (srv *HomeSubscriberServer) GenerateSIPAuthVector(subscriber *lteprotos.Sub
 		return nil, 0, err
 	}
 
+	ciphers := srv.AuthCiphers
+	if authScheme == swx.SipAuthScheme_EAP_AKA_PRIME {
+		ciphers = srv.AkaPrimeAuthCiphers
+	}
+	cipher, err := ciphers.Get(lte.AuthAlgo)
+	if err != nil {
+		return nil, 0, err
+	}
 	sqn := servicers.SeqToSqn(subscriber.State.LteAuthNextSeq, srv.AuthSqnInd)
-	vector, err := srv.Milenage.GenerateSIPAuthVector(lte.AuthKey, opc, sqn)
+	vector, err := cipher.GenerateSIPAuthVector(lte.AuthKey, opc, sqn)
 	if err != nil {
 		return nil, 0, servicers.NewAuthRejectedError(err.Error())
 	}
+	if authScheme == swx.SipAuthScheme_EAP_AKA_PRIME {
+		ckPrime, ikPrime := aka_prime.MakeCKIKPrime(
+			vector.ConfidentialityKey[:],
+			vector.IntegrityKey[:],
+			aka_prime.DefaultNetworkName,
+			vector.Autn[:aka_prime.SQN_XOR_AK_LEN])
+		copy(vector.ConfidentialityKey[:], ckPrime)
+		copy(vector.IntegrityKey[:], ikPrime)
+	}
 	return vector, subscriber.State.LteAuthNextSeq + 1, err
 }
 
@@ -14,6 +14,7 @@ import (
 
 	fegprotos "magma/feg/cloud/go/protos"
 	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/eap/providers/aka_prime"
 	definitions "magma/feg/gateway/services/swx_proxy/servicers"
 	hss "magma/feg/gateway/services/testcore/hss/servicers"
 	"magma/feg/gateway/services/testcore/hss/servicers/test"
@@ -21,6 +22,7 @@ import (
 	"magma/lte/cloud/go/crypto"
 	lteprotos "magma/lte/cloud/go/protos"
 	"magma/lte/cloud/go/services/eps_authentication/servicers"
+	"magma/lte/cloud/go/services/eps_authentication/servicers/test_utils"
 
 	"github.com/fiorix/go-diameter/v4/diam"
 	"github.com/fiorix/go-diameter/v4/diam/avp"
@@ -106,6 +108,50 @@ func TestNewMAA_MultipleVectors(t *testing.T) {
 	checkSIPAuthVectors(t, maa, 3)
 }
 
+func TestNewMAA_AkaPrime(t *testing.T) {
+	// AMF without separation bit
+	config := *test.NewTestHomeSubscriberServer(t).Config
+	config.LteAuthAmf = []byte("\x67\x41")
+	server, err := hss.NewHomeSubscriberServer(storage.NewMemorySubscriberStore(), &config)
+	assert.NoError(t, err)
+	for _, sub := range test_utils.GetTestSubscribers() {
+		_, err = server.AddSubscriber(context.Background(), sub)
+		assert.NoError(t, err)
+	}
+	subscriber, err := server.GetSubscriberData(context.Background(), &lteprotos.SubscriberID{Id: "sub1"})
+	assert.NoError(t, err)
+
+	mar := createMARWithAuthScheme("sub1", 1, definitions.RadioAccessTechnologyType_WLAN, definitions.SipAuthScheme_EAP_AKA_PRIME)
+	response, err := hss.NewMAA(server, mar)
+	assert.NoError(t, err)
+	var maa definitions.MAA
+	err = response.Unmarshal(&maa)
+	assert.NoError(t, err)
+	assert.Equal(t, diam.Success, int(maa.ResultCode))
+	assert.Len(t, maa.SIPAuthDataItems, 1)
+	vector := maa.SIPAuthDataItems[0]
+	assert.Equal(t, definitions.SipAuthScheme_EAP_AKA_PRIME, vector.AuthScheme)
+
+	// AUTN has the AMF separation bit set and the keys are CK' & IK'
+	rand, autn := []byte(vector.Authenticate[:crypto.RandChallengeBytes]), []byte(vector.Authenticate[crypto.RandChallengeBytes:])
+	assert.True(t, aka_prime.HasAMFSeparationBit(autn))
+	opc, err := servicers.GetOrGenerateOpc(subscriber.Lte, config.LteAuthOp)
+	assert.NoError(t, err)
+	milenage, err := crypto.NewMilenageCipher(config.LteAuthAmf)
+	assert.NoError(t, err)
+	akaVector, err := milenage.GenerateSIPAuthVectorWithRand(rand, subscriber.Lte.AuthKey, opc, 0)
+	assert.NoError(t, err)
+	ckPrime, ikPrime := aka_prime.MakeCKIKPrime(
+		akaVector.ConfidentialityKey[:], akaVector.IntegrityKey[:], aka_prime.DefaultNetworkName, autn[:aka_prime.SQN_XOR_AK_LEN])
+	assert.Equal(t, ckPrime, []byte(vector.ConfidentialityKey))
+	assert.Equal(t, ikPrime, []byte(vector.IntegrityKey))
+
+	// Other schemes aren't supported
+	mar = createMARWithAuthScheme("sub1", 1, definitions.RadioAccessTechnologyType_WLAN, "Digest-AKAv1-MD5")
+	_, err = hss.NewMAA(server, mar)
+	assert.EqualError(t, err, "Unsupported SIP authentication scheme: Digest-AKAv1-MD5")
+}
+
 func TestNewMAA_MissingAVP(t *testing.T) {
 	mar := createBaseMAR()
 	mar.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(definitions.RadioAccessTechnologyType_WLAN))
@@ -230,13 +276,17 @@ func createMARWithSingleAuthItem(userName string) *diam.Message {
 }
 
 func createMARExtended(userName string, numberAuthItems uint32, ratType uint32) *diam.Message {
+	return createMARWithAuthScheme(userName, numberAuthItems, ratType, definitions.SipAuthScheme_EAP_AKA)
+}
+
+func createMARWithAuthScheme(userName string, numberAuthItems uint32, ratType uint32, authScheme string) *diam.Message {
 	mar := createBaseMAR()
 	mar.NewAVP(avp.UserName, avp.Mbit, 0, datatype.UTF8String(userName))
 	mar.NewAVP(avp.RATType, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(ratType))
 	mar.NewAVP(avp.SIPNumberAuthItems, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Unsigned32(numberAuthItems))
 	mar.NewAVP(avp.SIPAuthDataItem, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 		AVP: []*diam.AVP{
-			diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String("EAP-AKA")),
+			diam.NewAVP(avp.SIPAuthenticationScheme, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(authScheme)),
 		},
 	})
 	return mar
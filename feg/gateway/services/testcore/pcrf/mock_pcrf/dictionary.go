@@ -0,0 +1,64 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package mock_pcrf
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/fiorix/go-diameter/v4/diam/dict"
+)
+
+// The Gx dictionary of go-diameter lacks the AVPs a PCEF reports failed rules
+// with, they are added to the default one on init
+func init() {
+	err := dict.Default.Load(bytes.NewReader([]byte(gxRuleReportDictionary)))
+	if err != nil {
+		panic(fmt.Sprintf("Cannot load Gx rule report dictionary: %s", err))
+	}
+}
+
+// gxRuleReportDictionary holds Charging-Rule-Report, 3GPP TS 29.212 Section 5.3.18
+const gxRuleReportDictionary = `<?xml version="1.0" encoding="UTF-8"?>
+<diameter>
+    <application id="16777238" type="auth" name="Gx Charging Control">
+        <vendor id="10415" name="TGPP"/>
+        <avp name="Charging-Rule-Report" code="1018" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Grouped">
+                <rule avp="Charging-Rule-Name" required="false"/>
+                <rule avp="Charging-Rule-Base-Name" required="false"/>
+                <rule avp="Bearer-Identifier" required="false" max="1"/>
+                <rule avp="PCC-Rule-Status" required="false" max="1"/>
+                <rule avp="Rule-Failure-Code" required="false" max="1"/>
+            </data>
+        </avp>
+        <avp name="PCC-Rule-Status" code="1019" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="0" name="ACTIVE"/>
+                <item code="1" name="INACTIVE"/>
+                <item code="2" name="TEMPORARILY_INACTIVE"/>
+            </data>
+        </avp>
+        <avp name="Rule-Failure-Code" code="1031" must="M,V" may="P" must-not="-" may-encrypt="Y" vendor-id="10415">
+            <data type="Enumerated">
+                <item code="1" name="UNKNOWN_RULE_NAME"/>
+                <item code="2" name="RATING_GROUP_ERROR"/>
+                <item code="3" name="SERVICE_IDENTIFIER_ERROR"/>
+                <item code="4" name="GW/PCEF_MALFUNCTION"/>
+                <item code="5" name="RESOURCES_LIMITATION"/>
+                <item code="6" name="MAX_NR_BEARERS_REACHED"/>
+                <item code="7" name="UNKNOWN_BEARER_ID"/>
+                <item code="8" name="MISSING_BEARER_ID"/>
+                <item code="9" name="MISSING_FLOW_INFORMATION"/>
+                <item code="10" name="RESOURCE_ALLOCATION_FAILURE"/>
+                <item code="11" name="UNSUCCESSFUL_QOS_VALIDATION"/>
+            </data>
+        </avp>
+    </application>
+</diameter>`
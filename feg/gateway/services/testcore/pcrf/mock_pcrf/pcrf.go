@@ -13,6 +13,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"magma/feg/cloud/go/protos"
@@ -35,11 +36,21 @@ type PCRFConfig struct {
 	ServerConfig *diameter.DiameterServerConfig
 }
 
+type subscriberSessionState struct {
+	SessionID  string
+	Connection diam.Conn
+}
+
 type subscriberAccount struct {
-	RuleNames       []string
-	RuleBaseNames   []string
-	RuleDefinitions []*protos.RuleDefinition
-	UsageMonitors   map[string]*protos.UsageMonitorCredit
+	RuleNames        []string
+	RuleBaseNames    []string
+	RuleDefinitions  []*protos.RuleDefinition
+	UsageMonitors    map[string]*protos.UsageMonitorCredit
+	EventTriggers    []uint32
+	RevalidationTime uint64
+	AnswerOverrides  map[uint32]*protos.AnswerOverride // map of CC-Request-Type (0 for all) to override
+	ReceivedCCRs     []*protos.ReceivedCCR
+	CurrentState     *subscriberSessionState
 }
 
 // PCRFDiamServer wraps an PCRF storing subscribers and their rules
@@ -47,6 +58,14 @@ type PCRFDiamServer struct {
 	diameterSettings *diameter.DiameterClientConfig
 	pcrfConfig       *PCRFConfig
 	subscribers      map[string]*subscriberAccount // map of imsi to to rules
+	// subscribersMutex guards subscribers and their accounts, which are
+	// accessed by both the diameter and the GRPC handlers
+	subscribersMutex sync.Mutex
+	mux              *sm.StateMachine
+	// raaTracker and asaTracker route RAAs and ASAs to the ReAuth and
+	// AbortSession calls waiting for them, by Session-Id
+	raaTracker *diameter.RequestTracker
+	asaTracker *diameter.RequestTracker
 }
 
 type ccrMessage struct {
@@ -60,6 +79,7 @@ type ccrMessage struct {
 	SubscriptionIDs  []*subscriptionIDDiam     `avp:"Subscription-Id"`
 	IPAddr           datatype.OctetString      `avp:"Framed-IP-Address"`
 	UsageMonitors    []*usageMonitorRequestAVP `avp:"Usage-Monitoring-Information"`
+	EventTriggers    []uint32                  `avp:"Event-Trigger"`
 }
 
 type subscriptionIDDiam struct {
@@ -92,6 +112,8 @@ func NewPCRFDiamServer(
 		diameterSettings: diameterSettings,
 		pcrfConfig:       pcrfConfig,
 		subscribers:      map[string]*subscriberAccount{},
+		raaTracker:       diameter.NewRequestTracker(),
+		asaTracker:       diameter.NewRequestTracker(),
 	}
 }
 
@@ -116,7 +138,7 @@ func (srv *PCRFDiamServer) ConfigServer(
 // Start begins the server and blocks, listening to the network
 // Output: error if the server could not be started
 func (srv *PCRFDiamServer) Start(lis net.Listener) error {
-	mux := sm.New(&sm.Settings{
+	srv.mux = sm.New(&sm.Settings{
 		OriginHost:       datatype.DiameterIdentity(srv.diameterSettings.Host),
 		OriginRealm:      datatype.DiameterIdentity(srv.diameterSettings.Realm),
 		VendorID:         datatype.Unsigned32(diameter.Vendor3GPP),
@@ -124,15 +146,21 @@ func (srv *PCRFDiamServer) Start(lis net.Listener) error {
 		OriginStateID:    datatype.Unsigned32(time.Now().Unix()),
 		FirmwareRevision: 1,
 	})
-	mux.HandleIdx(
+	srv.mux.HandleIdx(
 		diam.CommandIndex{AppID: diam.GX_CHARGING_CONTROL_APP_ID, Code: diam.CreditControl, Request: true},
 		getCCRHandler(srv))
-	go logErrors(mux.ErrorReports())
+	srv.mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.GX_CHARGING_CONTROL_APP_ID, Code: diam.ReAuth, Request: false},
+		handleRAA(srv.raaTracker))
+	srv.mux.HandleIdx(
+		diam.CommandIndex{AppID: diam.GX_CHARGING_CONTROL_APP_ID, Code: diam.AbortSession, Request: false},
+		handleASA(srv.asaTracker))
+	go logErrors(srv.mux.ErrorReports())
 	serverConfig := srv.pcrfConfig.ServerConfig
 	server := &diam.Server{
 		Network: serverConfig.Protocol,
 		Addr:    serverConfig.Addr,
-		Handler: mux,
+		Handler: srv.mux,
 		Dict:    nil,
 	}
 	return server.Serve(lis)
@@ -171,9 +199,12 @@ func (srv *PCRFDiamServer) CreateAccount(
 	ctx context.Context,
 	subscriberID *lteprotos.SubscriberID,
 ) (*orcprotos.Void, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
 	srv.subscribers[subscriberID.Id] = &subscriberAccount{
-		RuleNames:     []string{},
-		UsageMonitors: make(map[string]*protos.UsageMonitorCredit),
+		RuleNames:       []string{},
+		UsageMonitors:   make(map[string]*protos.UsageMonitorCredit),
+		AnswerOverrides: make(map[uint32]*protos.AnswerOverride),
 	}
 	glog.V(2).Infof("New account %s added", subscriberID.Id)
 	return &orcprotos.Void{}, nil
@@ -189,6 +220,8 @@ func (srv *PCRFDiamServer) SetRules(
 	ctx context.Context,
 	accountRules *protos.AccountRules,
 ) (*orcprotos.Void, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
 	account, ok := srv.subscribers[accountRules.Imsi]
 	if !ok {
 		return nil, fmt.Errorf("Could not find imsi %s", accountRules.Imsi)
@@ -203,6 +236,8 @@ func (srv *PCRFDiamServer) SetUsageMonitors(
 	ctx context.Context,
 	usageMonitorInfo *protos.UsageMonitorInfo,
 ) (*orcprotos.Void, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
 	account, ok := srv.subscribers[usageMonitorInfo.Imsi]
 	if !ok {
 		return nil, fmt.Errorf("Could not find imsi %s", usageMonitorInfo.Imsi)
@@ -214,11 +249,74 @@ func (srv *PCRFDiamServer) SetUsageMonitors(
 	return &orcprotos.Void{}, nil
 }
 
+// SetEventTriggers sets the event triggers and the revalidation time sent in
+// the CCA-I of the subscriber
+// Input: imsi string IMSI for the subscriber
+//			  eventTriggers []uint32 containing the Event-Trigger values
+//			  revalidationTime uint64 seconds since epoch, 0 for none
+// Output: error if subscriber could not be found
+func (srv *PCRFDiamServer) SetEventTriggers(
+	ctx context.Context,
+	eventTriggerInfo *protos.EventTriggerInfo,
+) (*orcprotos.Void, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
+	account, ok := srv.subscribers[eventTriggerInfo.Imsi]
+	if !ok {
+		return nil, fmt.Errorf("Could not find imsi %s", eventTriggerInfo.Imsi)
+	}
+	account.EventTriggers = eventTriggerInfo.EventTriggers
+	account.RevalidationTime = eventTriggerInfo.RevalidationTime
+	return &orcprotos.Void{}, nil
+}
+
+// SetAnswerOverride makes the PCRF answer the CCRs of the subscriber of the
+// given request type (or all of them) with the given result code, or not
+// answer them at all. An override without result code which doesn't drop
+// CCRs removes the override.
+// Output: error if subscriber could not be found
+func (srv *PCRFDiamServer) SetAnswerOverride(
+	ctx context.Context,
+	override *protos.AnswerOverride,
+) (*orcprotos.Void, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
+	account, ok := srv.subscribers[override.Imsi]
+	if !ok {
+		return nil, fmt.Errorf("Could not find imsi %s", override.Imsi)
+	}
+	if override.ResultCode == 0 && !override.Drop {
+		delete(account.AnswerOverrides, override.RequestType)
+	} else {
+		account.AnswerOverrides[override.RequestType] = override
+	}
+	return &orcprotos.Void{}, nil
+}
+
+// GetReceivedCCRs returns the CCRs received for the subscriber, oldest first
+// Output: error if subscriber could not be found
+func (srv *PCRFDiamServer) GetReceivedCCRs(
+	ctx context.Context,
+	subscriberID *lteprotos.SubscriberID,
+) (*protos.ReceivedCCRs, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
+	account, ok := srv.subscribers[subscriberID.Id]
+	if !ok {
+		return nil, fmt.Errorf("Could not find imsi %s", subscriberID.Id)
+	}
+	ccrs := make([]*protos.ReceivedCCR, len(account.ReceivedCCRs))
+	copy(ccrs, account.ReceivedCCRs)
+	return &protos.ReceivedCCRs{Ccrs: ccrs}, nil
+}
+
 // GetRuleNames returns all the rules set for a subscriber
 // Input: string IMSI for the subscriber
 // Output: []string containing all applicable rules
 //			   error if subscriber could not be found
 func (srv *PCRFDiamServer) GetRuleNames(imsi string) ([]string, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
 	account, ok := srv.subscribers[imsi]
 	if !ok {
 		return nil, fmt.Errorf("Could not find imsi %s", imsi)
@@ -231,6 +329,8 @@ func (srv *PCRFDiamServer) GetRuleNames(imsi string) ([]string, error) {
 // Output: []string containing all applicable rule base names
 //			   error if subscriber could not be found
 func (srv *PCRFDiamServer) GetRuleBaseNames(imsi string) ([]string, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
 	account, ok := srv.subscribers[imsi]
 	if !ok {
 		return nil, fmt.Errorf("Could not find imsi %s", imsi)
@@ -245,6 +345,8 @@ func (srv *PCRFDiamServer) GetRuleBaseNames(imsi string) ([]string, error) {
 func (srv *PCRFDiamServer) GetRuleDefinitions(
 	imsi string,
 ) ([]*protos.RuleDefinition, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
 	account, ok := srv.subscribers[imsi]
 	if !ok {
 		return nil, fmt.Errorf("Could not find imsi %s", imsi)
@@ -254,6 +356,8 @@ func (srv *PCRFDiamServer) GetRuleDefinitions(
 
 // Reset eliminates all the subscribers allocated for the system.
 func (srv *PCRFDiamServer) ClearSubscribers(ctx context.Context, void *orcprotos.Void) (*orcprotos.Void, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
 	srv.subscribers = map[string]*subscriberAccount{}
 	glog.V(2).Info("All accounts deleted.")
 	return &orcprotos.Void{}, nil
@@ -274,17 +378,40 @@ func getCCRHandler(srv *PCRFDiamServer) diam.HandlerFunc {
 			sendAnswer(ccr, c, m, diam.AuthenticationRejected)
 			return
 		}
+		srv.subscribersMutex.Lock()
+		defer srv.subscribersMutex.Unlock()
 		account, found := srv.subscribers[imsi]
 		if !found {
 			glog.Error("IMSI not found in subscribers")
 			sendAnswer(ccr, c, m, diam.AuthenticationRejected)
 			return
 		}
+		account.ReceivedCCRs = append(account.ReceivedCCRs, getReceivedCCR(ccr))
+		requestType := credit_control.CreditRequestType(ccr.RequestType)
+		if requestType == credit_control.CRTTerminate {
+			account.CurrentState = nil
+		} else {
+			account.CurrentState = &subscriberSessionState{SessionID: string(ccr.SessionID), Connection: c}
+		}
+
+		if override := account.getAnswerOverride(requestType); override != nil {
+			if override.Drop {
+				glog.V(2).Infof("Dropping CCR of session %s", ccr.SessionID)
+				return
+			}
+			if override.Experimental {
+				sendExperimentalAnswer(ccr, c, m, override.ResultCode)
+			} else {
+				sendAnswer(ccr, c, m, override.ResultCode)
+			}
+			return
+		}
 
-		if credit_control.CreditRequestType(ccr.RequestType) == credit_control.CRTInit {
+		if requestType == credit_control.CRTInit {
 			ruleInstalls := getRuleInstallAVPs(account.RuleNames, account.RuleBaseNames, account.RuleDefinitions)
 			usageMonitors := getInitialUsageMonitoringAVPs(account.UsageMonitors)
 			avps := append(ruleInstalls, usageMonitors...)
+			avps = append(avps, getEventTriggerAVPs(account.EventTriggers, account.RevalidationTime)...)
 			sendAnswer(ccr, c, m, diam.Success, avps...)
 			return
 		}
@@ -298,6 +425,33 @@ func getCCRHandler(srv *PCRFDiamServer) diam.HandlerFunc {
 	}
 }
 
+// getAnswerOverride returns the override of the request type if set, the one
+// of all request types otherwise
+func (account *subscriberAccount) getAnswerOverride(requestType credit_control.CreditRequestType) *protos.AnswerOverride {
+	if override, ok := account.AnswerOverrides[uint32(requestType)]; ok {
+		return override
+	}
+	return account.AnswerOverrides[0]
+}
+
+func getReceivedCCR(ccr ccrMessage) *protos.ReceivedCCR {
+	received := &protos.ReceivedCCR{
+		SessionId:     string(ccr.SessionID),
+		RequestType:   uint32(ccr.RequestType),
+		RequestNumber: uint32(ccr.RequestNumber),
+		EventTriggers: ccr.EventTriggers,
+	}
+	for _, monitor := range ccr.UsageMonitors {
+		received.UsageMonitors = append(received.UsageMonitors, &protos.UsageMonitorReport{
+			MonitoringKey: monitor.MonitoringKey,
+			InputOctets:   monitor.UsedServiceUnit.InputOctets,
+			OutputOctets:  monitor.UsedServiceUnit.OutputOctets,
+			TotalOctets:   monitor.UsedServiceUnit.TotalOctets,
+		})
+	}
+	return received
+}
+
 func shouldReturnRules(requestType credit_control.CreditRequestType) bool {
 	return requestType == credit_control.CRTInit
 }
@@ -362,6 +516,18 @@ func getUsageMonitorUpdates(account *subscriberAccount, monitorUpdates []*usageM
 	return avps, nil
 }
 
+func getEventTriggerAVPs(eventTriggers []uint32, revalidationTime uint64) []*diam.AVP {
+	avps := make([]*diam.AVP, 0, len(eventTriggers)+1)
+	for _, trigger := range eventTriggers {
+		avps = append(avps, diam.NewAVP(avp.EventTrigger, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Enumerated(trigger)))
+	}
+	if revalidationTime != 0 {
+		avps = append(avps, diam.NewAVP(
+			avp.RevalidationTime, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.Time(time.Unix(int64(revalidationTime), 0))))
+	}
+	return avps
+}
+
 func getUsageMonitoringResponseAVP(monitoringKey string, returnBytes uint64, level protos.UsageMonitorCredit_MonitoringLevel) *diam.AVP {
 	return diam.NewAVP(avp.UsageMonitoringInformation, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{
 		AVP: []*diam.AVP{
@@ -462,7 +628,29 @@ func sendAnswer(
 	statusCode uint32,
 	additionalAVPs ...*diam.AVP,
 ) {
-	a := message.Answer(statusCode)
+	writeAnswer(ccr, conn, message.Answer(statusCode), additionalAVPs...)
+}
+
+// sendExperimentalAnswer sends a CCA with an Experimental-Result instead of
+// a Result-Code to the connection given
+func sendExperimentalAnswer(ccr ccrMessage, conn diam.Conn, message *diam.Message, resultCode uint32) {
+	a := diam.NewMessage(
+		message.Header.CommandCode,
+		message.Header.CommandFlags&^diam.RequestFlag,
+		message.Header.ApplicationID,
+		message.Header.HopByHopID,
+		message.Header.EndToEndID,
+		message.Dictionary())
+	a.NewAVP(avp.ExperimentalResult, avp.Mbit, 0, &diam.GroupedAVP{
+		AVP: []*diam.AVP{
+			diam.NewAVP(avp.VendorID, avp.Mbit, 0, datatype.Unsigned32(diameter.Vendor3GPP)),
+			diam.NewAVP(avp.ExperimentalResultCode, avp.Mbit, 0, datatype.Unsigned32(resultCode)),
+		},
+	})
+	writeAnswer(ccr, conn, a)
+}
+
+func writeAnswer(ccr ccrMessage, conn diam.Conn, a *diam.Message, additionalAVPs ...*diam.AVP) {
 	a.NewAVP(avp.OriginHost, avp.Mbit, 0, ccr.DestinationHost)
 	a.NewAVP(avp.OriginRealm, avp.Mbit, 0, ccr.DestinationRealm)
 	a.NewAVP(avp.DestinationRealm, avp.Mbit, 0, ccr.OriginRealm)
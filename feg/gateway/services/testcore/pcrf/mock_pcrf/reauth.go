@@ -0,0 +1,213 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package mock_pcrf
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"magma/feg/cloud/go/protos"
+	"magma/feg/gateway/diameter"
+	"magma/feg/gateway/services/session_proxy/credit_control/gx"
+
+	"github.com/fiorix/go-diameter/v4/diam"
+	"github.com/fiorix/go-diameter/v4/diam/avp"
+	"github.com/fiorix/go-diameter/v4/diam/datatype"
+	"github.com/fiorix/go-diameter/v4/diam/sm"
+	"github.com/fiorix/go-diameter/v4/diam/sm/smpeer"
+	"github.com/golang/glog"
+)
+
+// Re-Auth-Request-Type of the RARs sent by the PCRF, AUTHORIZE_ONLY
+const authorizeOnly = 0
+
+// asaMessage holds the parts of a Gx Abort-Session-Answer the PCRF reports
+type asaMessage struct {
+	SessionID  string `avp:"Session-Id"`
+	ResultCode uint32 `avp:"Result-Code"`
+}
+
+// ReAuth sends a Gx RAR with the given rule, usage monitor and event trigger
+// changes to the current session of the subscriber and waits for its RAA
+// Output: the RAA with the names of the rules the PCEF reported as failed
+func (srv *PCRFDiamServer) ReAuth(
+	ctx context.Context,
+	target *protos.PolicyReAuthTarget,
+) (*protos.PolicyReAuthAnswer, error) {
+	state, err := srv.getSessionState(target.Imsi, func(account *subscriberAccount) {
+		for _, monitor := range target.UsageMonitors {
+			account.UsageMonitors[monitor.MonitoringKey] = monitor
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	done := srv.raaTracker.RegisterRequest(state.SessionID, make(chan interface{}, 1))
+	defer srv.raaTracker.DeregisterRequest(state.SessionID)
+	if err := sendRAR(state, target, srv.mux.Settings()); err != nil {
+		return nil, err
+	}
+	select {
+	case ans := <-done:
+		raa := ans.(*gx.ReAuthAnswer)
+		answer := &protos.PolicyReAuthAnswer{SessionId: diameter.DecodeSessionID(raa.SessionID), ResultCode: raa.ResultCode}
+		for _, report := range raa.RuleReports {
+			answer.FailedRules = append(answer.FailedRules, report.RuleNames...)
+			answer.FailedRules = append(answer.FailedRules, report.RuleBaseNames...)
+		}
+		return answer, nil
+	case <-time.After(10 * time.Second):
+		return nil, fmt.Errorf("No RAA received")
+	}
+}
+
+// AbortSession sends a Gx ASR to the current session of the subscriber and
+// waits for its ASA
+func (srv *PCRFDiamServer) AbortSession(
+	ctx context.Context,
+	target *protos.AbortSessionTarget,
+) (*protos.AbortSessionAnswer, error) {
+	state, err := srv.getSessionState(target.Imsi, nil)
+	if err != nil {
+		return nil, err
+	}
+	done := srv.asaTracker.RegisterRequest(state.SessionID, make(chan interface{}, 1))
+	defer srv.asaTracker.DeregisterRequest(state.SessionID)
+	if err := sendASR(state, srv.mux.Settings()); err != nil {
+		return nil, err
+	}
+	select {
+	case ans := <-done:
+		asa := ans.(*asaMessage)
+		return &protos.AbortSessionAnswer{SessionId: diameter.DecodeSessionID(asa.SessionID), ResultCode: asa.ResultCode}, nil
+	case <-time.After(10 * time.Second):
+		return nil, fmt.Errorf("No ASA received")
+	}
+}
+
+// getSessionState returns the current session of the subscriber after
+// applying update, if any, to its account
+func (srv *PCRFDiamServer) getSessionState(imsi string, update func(*subscriberAccount)) (*subscriberSessionState, error) {
+	srv.subscribersMutex.Lock()
+	defer srv.subscribersMutex.Unlock()
+	account, ok := srv.subscribers[imsi]
+	if !ok {
+		return nil, fmt.Errorf("Could not find imsi %s", imsi)
+	}
+	if account.CurrentState == nil {
+		return nil, fmt.Errorf("Credit client location unknown for imsi %s", imsi)
+	}
+	if update != nil {
+		update(account)
+	}
+	return account.CurrentState, nil
+}
+
+func sendRAR(state *subscriberSessionState, target *protos.PolicyReAuthTarget, cfg *sm.Settings) error {
+	m, err := newSessionRequest(diam.ReAuth, state, cfg)
+	if err != nil {
+		return err
+	}
+	m.NewAVP(avp.ReAuthRequestType, avp.Mbit, 0, datatype.Enumerated(authorizeOnly))
+	for _, avp := range getRuleRemoveAVPs(target.RulesToRemove, target.RuleBaseNamesToRemove) {
+		m.AddAVP(avp)
+	}
+	ruleInstalls := getRuleInstallAVPs(target.RulesToInstall, target.RuleBaseNamesToInstall, target.RuleDefinitionsToInstall)
+	for _, avp := range ruleInstalls {
+		m.AddAVP(avp)
+	}
+	for _, monitor := range target.UsageMonitors {
+		m.AddAVP(getUsageMonitoringResponseAVP(monitor.MonitoringKey, monitor.ReturnBytes, monitor.MonitoringLevel))
+	}
+	for _, avp := range getEventTriggerAVPs(target.EventTriggers, target.RevalidationTime) {
+		m.AddAVP(avp)
+	}
+	glog.V(2).Infof("Sending RAR to %s\n%s", state.Connection.RemoteAddr(), m)
+	_, err = m.WriteTo(state.Connection)
+	return err
+}
+
+func sendASR(state *subscriberSessionState, cfg *sm.Settings) error {
+	m, err := newSessionRequest(diam.AbortSession, state, cfg)
+	if err != nil {
+		return err
+	}
+	glog.V(2).Infof("Sending ASR to %s\n%s", state.Connection.RemoteAddr(), m)
+	_, err = m.WriteTo(state.Connection)
+	return err
+}
+
+// newSessionRequest creates a Gx request addressed to the PCEF of the session
+func newSessionRequest(command uint32, state *subscriberSessionState, cfg *sm.Settings) (*diam.Message, error) {
+	meta, ok := smpeer.FromContext(state.Connection.Context())
+	if !ok {
+		return nil, fmt.Errorf("peer metadata unavailable")
+	}
+	m := diameter.NewProxiableRequest(command, diam.GX_CHARGING_CONTROL_APP_ID, nil)
+	m.NewAVP(avp.SessionID, avp.Mbit, 0, datatype.UTF8String(state.SessionID))
+	m.NewAVP(avp.AuthApplicationID, avp.Mbit, 0, datatype.Unsigned32(diam.GX_CHARGING_CONTROL_APP_ID))
+	m.NewAVP(avp.OriginHost, avp.Mbit, 0, cfg.OriginHost)
+	m.NewAVP(avp.OriginRealm, avp.Mbit, 0, cfg.OriginRealm)
+	m.NewAVP(avp.DestinationRealm, avp.Mbit, 0, meta.OriginRealm)
+	m.NewAVP(avp.DestinationHost, avp.Mbit, 0, meta.OriginHost)
+	return m, nil
+}
+
+func getRuleRemoveAVPs(ruleNames []string, ruleBaseNames []string) []*diam.AVP {
+	if len(ruleNames) == 0 && len(ruleBaseNames) == 0 {
+		return []*diam.AVP{}
+	}
+	avps := make([]*diam.AVP, 0, len(ruleNames)+len(ruleBaseNames))
+	for _, rule := range ruleNames {
+		avps = append(avps, diam.NewAVP(avp.ChargingRuleName, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.OctetString(rule)))
+	}
+	for _, rule := range ruleBaseNames {
+		avps = append(avps, diam.NewAVP(avp.ChargingRuleBaseName, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, datatype.UTF8String(rule)))
+	}
+	return []*diam.AVP{
+		diam.NewAVP(avp.ChargingRuleRemove, avp.Mbit|avp.Vbit, diameter.Vendor3GPP, &diam.GroupedAVP{AVP: avps}),
+	}
+}
+
+// handleRAA passes RAAs to the ReAuth call waiting for the answer of their
+// session
+func handleRAA(tracker *diameter.RequestTracker) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var raa gx.ReAuthAnswer
+		if err := m.Unmarshal(&raa); err != nil {
+			glog.Errorf("Received unparseable RAA over Gx %s\n%s", m, err)
+			return
+		}
+		done := tracker.DeregisterRequest(raa.SessionID)
+		if done == nil {
+			glog.Errorf("Received RAA for session %s without pending RAR", raa.SessionID)
+			return
+		}
+		done <- &raa
+	}
+}
+
+// handleASA passes ASAs to the AbortSession call waiting for the answer of
+// their session
+func handleASA(tracker *diameter.RequestTracker) diam.HandlerFunc {
+	return func(c diam.Conn, m *diam.Message) {
+		var asa asaMessage
+		if err := m.Unmarshal(&asa); err != nil {
+			glog.Errorf("Received unparseable ASA over Gx %s\n%s", m, err)
+			return
+		}
+		done := tracker.DeregisterRequest(asa.SessionID)
+		if done == nil {
+			glog.Errorf("Received ASA for session %s without pending ASR", asa.SessionID)
+			return
+		}
+		done <- &asa
+	}
+}
@@ -0,0 +1,33 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+// AuthCipher is an authentication algorithm set which generates the auth
+// vectors of a subscriber and re-synchronizes its sequence number.
+// opc is the operator variant algorithm configuration field of the algorithm,
+// i.e. OPc for Milenage and TOPc for TUAK.
+type AuthCipher interface {
+	// GenerateEutranVector creates an E-UTRAN key vector.
+	GenerateEutranVector(key []byte, opc []byte, sqn uint64, plmn []byte) (*EutranVector, error)
+
+	// GenerateSIPAuthVector creates a SIP auth vector.
+	GenerateSIPAuthVector(key []byte, opc []byte, sqn uint64) (*SIPAuthVector, error)
+
+	// GenerateSIPAuthVectorWithRand creates a SIP auth vector using a
+	// specific random challenge value.
+	GenerateSIPAuthVectorWithRand(rand []byte, key []byte, opc []byte, sqn uint64) (*SIPAuthVector, error)
+
+	// GenerateResync computes SQN_MS and MAC-S from AUTS for re-synchronization.
+	GenerateResync(auts, key, opc, rand []byte) (uint64, [8]byte, error)
+}
+
+var (
+	_ AuthCipher = (*MilenageCipher)(nil)
+	_ AuthCipher = (*TuakCipher)(nil)
+)
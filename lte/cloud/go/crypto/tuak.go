@@ -0,0 +1,393 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+)
+
+const (
+	// ExpectedTuakKeyBytes128 and ExpectedTuakKeyBytes256 are the allowed numbers of bytes for the TUAK subscriber key.
+	ExpectedTuakKeyBytes128 = 16
+	ExpectedTuakKeyBytes256 = 32
+
+	// ExpectedTopBytes is the number of bytes for the TUAK operator variant configuration field.
+	ExpectedTopBytes = 32
+
+	// ExpectedTopcBytes is the number of bytes for the TUAK operator variant algorithm configuration field.
+	ExpectedTopcBytes = 32
+
+	// tuakStateBytes is the size of the Keccak-f[1600] state.
+	tuakStateBytes = 200
+
+	// keccakIterations is the number of Keccak permutations per TUAK function,
+	// which is 1 unless an operator customizes it (3GPP TS 35.231 6.1).
+	keccakIterations = 1
+
+	// Byte offsets of the TUAK function inputs and outputs in the Keccak state (3GPP TS 35.231 6).
+	tuakTopOffset      = 0
+	tuakInstanceOffset = 32
+	tuakAlgonameOffset = 33
+	tuakRandOffset     = 40
+	tuakAmfOffset      = 56
+	tuakSqnOffset      = 58
+	tuakKeyOffset      = 64
+	tuakCkOffset       = 32
+	tuakIkOffset       = 64
+	tuakAkOffset       = 96
+
+	macBytes = 8
+	akBytes  = 6
+)
+
+// tuakAlgoname identifies the TUAK algorithm set version.
+var tuakAlgoname = []byte("TUAK1.0")
+
+// INSTANCE values which separate the TUAK functions (3GPP TS 35.231 6).
+// The lengths of the outputs and of the key are encoded in the lower bits.
+const (
+	tuakInstanceTopc   = 0x00
+	tuakInstanceF1     = 0x00
+	tuakInstanceF1Star = 0x80
+	tuakInstanceF2345  = 0x40
+	tuakInstanceF5Star = 0xc0
+
+	tuakInstanceCk256  = 0x04
+	tuakInstanceIk256  = 0x02
+	tuakInstanceKey256 = 0x01
+)
+
+// TuakCipher implements the TUAK algorithm set (3GPP TS 35.231, .232, .233)
+type TuakCipher struct {
+	// rng is a cryptographically secure random number generator
+	rng cryptoRNG
+
+	// amf is a 16 bit authentication management field
+	amf [ExpectedAmfBytes]byte
+}
+
+// NewTuakCipher instantiates the TUAK algo using crypto/rand for rng.
+func NewTuakCipher(amf []byte) (*TuakCipher, error) {
+	if len(amf) != ExpectedAmfBytes {
+		return nil, fmt.Errorf("incorrect amf size. Expected 2 bytes, but got %v bytes", len(amf))
+	}
+
+	tuak := &TuakCipher{rng: defaultCryptoRNG{}}
+	copy(tuak.amf[:], amf)
+	return tuak, nil
+}
+
+// GenerateEutranVector creates an E-UTRAN key vector.
+// Inputs:
+//
+//	key: 128 or 256 bit subscriber key
+//	topc: 256 bit operator variant algorithm configuration field
+//	sqn: 48 bit sequence number
+//	plmn: 24 bit network identifier
+//
+// Outputs: An EutranVector or an error. The EutranVector is not nil if and only if err == nil.
+func (tuak *TuakCipher) GenerateEutranVector(key []byte, topc []byte, sqn uint64, plmn []byte) (*EutranVector, error) {
+	if len(plmn) != ExpectedPlmnBytes {
+		return nil, fmt.Errorf("incorrect plmn size. Expected 3 bytes, but got %v bytes", len(plmn))
+	}
+	vector, err := tuak.GenerateSIPAuthVector(key, topc, sqn)
+	if err != nil {
+		return nil, err
+	}
+
+	kasme, err := generateKasme(vector.ConfidentialityKey[:], vector.IntegrityKey[:], plmn, getSqnBytes(sqn), vector.AnonymityKey[:])
+	if err != nil {
+		return nil, err
+	}
+	return newEutranVector(vector.Rand[:], vector.Xres[:], vector.Autn[:], kasme), nil
+}
+
+// GenerateSIPAuthVector creates a SIP auth vector.
+// Inputs:
+//
+//	key: 128 or 256 bit subscriber key
+//	topc: 256 bit operator variant algorithm configuration field
+//	sqn: 48 bit sequence number
+//
+// Outputs: A SIP auth vector or an error. The SIP auth vector is not nil if and only if err == nil.
+func (tuak *TuakCipher) GenerateSIPAuthVector(key []byte, topc []byte, sqn uint64) (*SIPAuthVector, error) {
+	if err := validateTuakInputs(key, topc, sqn); err != nil {
+		return nil, err
+	}
+
+	var randChallenge = make([]byte, RandChallengeBytes)
+	_, err := tuak.rng.Read(randChallenge)
+	if err != nil {
+		return nil, err
+	}
+	return tuak.GenerateSIPAuthVectorWithRand(randChallenge, key, topc, sqn)
+}
+
+// GenerateSIPAuthVectorWithRand creates a SIP auth vector using a specific random challenge value.
+// Inputs:
+//
+//	rand: 128 bit random challenge
+//	key:  128 or 256 bit subscriber key
+//	topc: 256 bit operator variant algorithm configuration field
+//	sqn:  48 bit sequence number
+//
+// Outputs: A SIP auth vector or an error. The SIP auth vector is not nil if and only if err == nil.
+func (tuak *TuakCipher) GenerateSIPAuthVectorWithRand(rand []byte, key []byte, topc []byte, sqn uint64) (*SIPAuthVector, error) {
+	if len(rand) != RandChallengeBytes {
+		return nil, fmt.Errorf("incorrect rand size. Expected %v bytes, but got %v bytes", RandChallengeBytes, len(rand))
+	}
+	if err := validateTuakInputs(key, topc, sqn); err != nil {
+		return nil, err
+	}
+	sqnBytes := getSqnBytes(sqn)
+
+	macA := tuakF1(key, topc, rand, sqnBytes, tuak.amf[:], tuakInstanceF1, macBytes)
+	xres, ck, ik, ak := tuakF2345(key, topc, rand, XresBytes, ConfidentialityKeyBytes, IntegrityKeyBytes)
+
+	autn := generateAutn(sqnBytes, ak, macA, tuak.amf[:])
+	return newSIPAuthVector(rand, xres, autn, ck, ik, ak), nil
+}
+
+// GenerateResync computes SQN_MS and MAC-S from AUTS for re-synchronization.
+//
+//	AUTS = SQN_MS ^ AK || f1*(SQN_MS || RAND || AMF*)
+//
+// Inputs:
+//
+//	auts: 112 bit authentication token from client key
+//	key: 128 or 256 bit subscriber key
+//	topc: 256 bit operator variant algorithm configuration field
+//	rand: 128 bit random challenge
+//
+// Outputs: (sqnMs, macS) or an error
+func (tuak *TuakCipher) GenerateResync(auts, key, topc, rand []byte) (uint64, [8]byte, error) {
+	var macS [8]byte
+	if len(auts) != ExpectedAutsBytes {
+		return 0, macS, fmt.Errorf("incorrect auts size. Expected %v bytes, but got %v bytes", ExpectedAutsBytes, len(auts))
+	}
+	if len(rand) != RandChallengeBytes {
+		return 0, macS, fmt.Errorf("incorrect rand size. Expected %v bytes, but got %v bytes", RandChallengeBytes, len(rand))
+	}
+	if err := validateTuakInputs(key, topc, 0); err != nil {
+		return 0, macS, err
+	}
+
+	ak := tuakF5Star(key, topc, rand)
+	sqnMs := xor(auts[:sqnMaxBytes], ak)
+	sqnMsInt := uint64(sqnMs[5]) | uint64(sqnMs[4])<<8 | uint64(sqnMs[3])<<16 | uint64(sqnMs[2])<<24 |
+		uint64(sqnMs[1])<<32 | uint64(sqnMs[0])<<40
+	copy(macS[:], tuakF1(key, topc, rand, sqnMs, tuak.amf[:], tuakInstanceF1Star, macBytes))
+	return sqnMsInt, macS, nil
+}
+
+// GenerateTopc returns the TOPc according to 3GPP 35.231 6.2
+// Inputs:
+//
+//	key: 128 or 256 bit subscriber key
+//	top: 256 bit operator variant configuration field
+func GenerateTopc(key, top []byte) ([ExpectedTopcBytes]byte, error) {
+	var topc [ExpectedTopcBytes]byte
+	if err := validateTuakKey(key); err != nil {
+		return topc, err
+	}
+	if len(top) != ExpectedTopBytes {
+		return topc, fmt.Errorf("incorrect top size. Expected %v bytes, but got %v bytes", ExpectedTopBytes, len(top))
+	}
+
+	state := tuakCore(key, top, tuakInstanceTopc, nil, nil, nil)
+	copy(topc[:], popTuakData(state, tuakTopOffset, ExpectedTopcBytes))
+	return topc, nil
+}
+
+func validateTuakInputs(key, topc []byte, sqn uint64) error {
+	if err := validateTuakKey(key); err != nil {
+		return err
+	}
+	if len(topc) != ExpectedTopcBytes {
+		return fmt.Errorf("incorrect topc size. Expected %v bytes, but got %v bytes", ExpectedTopcBytes, len(topc))
+	}
+	if sqn > maxSqn {
+		return fmt.Errorf("sequence number too large, expected a number which can fit in 48 bits. Got: %v", sqn)
+	}
+	return nil
+}
+
+func validateTuakKey(key []byte) error {
+	if len(key) != ExpectedTuakKeyBytes128 && len(key) != ExpectedTuakKeyBytes256 {
+		return fmt.Errorf("incorrect key size. Expected %v or %v bytes, but got %v bytes", ExpectedTuakKeyBytes128, ExpectedTuakKeyBytes256, len(key))
+	}
+	return nil
+}
+
+// tuakF1 implements f1 and f1*, the network authentication function and the
+// re-synchronisation message authentication function according to
+// 3GPP 35.231 6.3 and 6.5. instance selects between the two.
+// Outputs: MAC-A or MAC-S of macLen bytes (8, 16 or 32)
+func tuakF1(key, topc, rand, sqn, amf []byte, instance byte, macLen int) []byte {
+	state := tuakCore(key, topc, instance|tuakLengthInstance(macLen), rand, amf, sqn)
+	return popTuakData(state, 0, macLen)
+}
+
+// tuakF2345 implements f2, f3, f4 and f5 according to 3GPP 35.231 6.4
+// Inputs:
+//
+//	resLen: length of RES in bytes (4, 8, 16 or 32)
+//	ckLen, ikLen: lengths of CK and IK in bytes (16 or 32)
+//
+// Outputs: (response to challenge, confidentiality key, integrity key,
+//
+//	48 bit anonymity key)
+func tuakF2345(key, topc, rand []byte, resLen, ckLen, ikLen int) ([]byte, []byte, []byte, []byte) {
+	instance := byte(tuakInstanceF2345) | tuakLengthInstance(resLen)
+	if ckLen == 32 {
+		instance |= tuakInstanceCk256
+	}
+	if ikLen == 32 {
+		instance |= tuakInstanceIk256
+	}
+	state := tuakCore(key, topc, instance, rand, nil, nil)
+	return popTuakData(state, 0, resLen),
+		popTuakData(state, tuakCkOffset, ckLen),
+		popTuakData(state, tuakIkOffset, ikLen),
+		popTuakData(state, tuakAkOffset, akBytes)
+}
+
+// tuakF5Star implements f5*, the resync anonymity key according to 3GPP 35.231 6.6
+// Outputs: 48 bit anonymity key
+func tuakF5Star(key, topc, rand []byte) []byte {
+	state := tuakCore(key, topc, tuakInstanceF5Star, rand, nil, nil)
+	return popTuakData(state, tuakAkOffset, akBytes)
+}
+
+// tuakLengthInstance returns the INSTANCE bits which encode the length of
+// a MAC or RES output. The 32 bit length is only valid for RES.
+func tuakLengthInstance(outLen int) byte {
+	switch outLen {
+	case 8:
+		return 0x08
+	case 16:
+		return 0x10
+	case 32:
+		return 0x20
+	default:
+		return 0x00
+	}
+}
+
+// tuakCore lays out the inputs of a TUAK function in the Keccak state and
+// applies the Keccak permutation. Inputs which a function doesn't use are nil
+// and left zeroed.
+func tuakCore(key, top []byte, instance byte, rand, amf, sqn []byte) []byte {
+	state := make([]byte, tuakStateBytes)
+	pushTuakData(state, tuakTopOffset, top)
+	if len(key) == ExpectedTuakKeyBytes256 {
+		instance |= tuakInstanceKey256
+	}
+	state[tuakInstanceOffset] = instance
+	pushTuakData(state, tuakAlgonameOffset, tuakAlgoname)
+	pushTuakData(state, tuakRandOffset, rand)
+	pushTuakData(state, tuakAmfOffset, amf)
+	pushTuakData(state, tuakSqnOffset, sqn)
+	pushTuakData(state, tuakKeyOffset, key)
+
+	// Padding of the 1088 bit Keccak rate
+	state[96] = 0x1f
+	state[135] = 0x80
+
+	for i := 0; i < keccakIterations; i++ {
+		keccakF1600(state)
+	}
+	return state
+}
+
+// pushTuakData writes data into the state at the offset. TUAK numbers the bits
+// of its parameters from the least significant one, so parameters are written
+// in reverse byte order.
+func pushTuakData(state []byte, offset int, data []byte) {
+	n := len(data)
+	for i := 0; i < n; i++ {
+		state[offset+i] = data[n-i-1]
+	}
+}
+
+// popTuakData reads n bytes at the offset of the state, reversing
+// pushTuakData.
+func popTuakData(state []byte, offset, n int) []byte {
+	out := make([]byte, n)
+	for i := 0; i < n; i++ {
+		out[n-i-1] = state[offset+i]
+	}
+	return out
+}
+
+// keccakRoundConstants are the iota step constants of Keccak-f[1600].
+var keccakRoundConstants = [24]uint64{
+	0x0000000000000001, 0x0000000000008082, 0x800000000000808A, 0x8000000080008000,
+	0x000000000000808B, 0x0000000080000001, 0x8000000080008081, 0x8000000000008009,
+	0x000000000000008A, 0x0000000000000088, 0x0000000080008009, 0x000000008000000A,
+	0x000000008000808B, 0x800000000000008B, 0x8000000000008089, 0x8000000000008003,
+	0x8000000000008002, 0x8000000000000080, 0x000000000000800A, 0x800000008000000A,
+	0x8000000080008081, 0x8000000000008080, 0x0000000080000001, 0x8000000080008008,
+}
+
+// keccakRotations are the rho step rotation offsets of the lane at x + 5y.
+var keccakRotations = [25]int{
+	0, 1, 62, 28, 27,
+	36, 44, 6, 55, 20,
+	3, 10, 43, 25, 39,
+	41, 45, 15, 21, 8,
+	18, 2, 61, 56, 14,
+}
+
+// keccakF1600 applies the Keccak-f[1600] permutation to the 200 byte state,
+// whose lanes are little-endian 64 bit words.
+func keccakF1600(state []byte) {
+	var a [25]uint64
+	for i := range a {
+		a[i] = binary.LittleEndian.Uint64(state[8*i:])
+	}
+
+	for round := 0; round < len(keccakRoundConstants); round++ {
+		// theta
+		var c [5]uint64
+		for x := 0; x < 5; x++ {
+			c[x] = a[x] ^ a[x+5] ^ a[x+10] ^ a[x+15] ^ a[x+20]
+		}
+		for x := 0; x < 5; x++ {
+			d := c[(x+4)%5] ^ bits.RotateLeft64(c[(x+1)%5], 1)
+			for y := 0; y < 25; y += 5 {
+				a[y+x] ^= d
+			}
+		}
+
+		// rho and pi
+		var b [25]uint64
+		for x := 0; x < 5; x++ {
+			for y := 0; y < 5; y++ {
+				b[y+5*((2*x+3*y)%5)] = bits.RotateLeft64(a[x+5*y], keccakRotations[x+5*y])
+			}
+		}
+
+		// chi
+		for y := 0; y < 25; y += 5 {
+			for x := 0; x < 5; x++ {
+				a[y+x] = b[y+x] ^ (^b[y+(x+1)%5] & b[y+(x+2)%5])
+			}
+		}
+
+		// iota
+		a[0] ^= keccakRoundConstants[round]
+	}
+
+	for i := range a {
+		binary.LittleEndian.PutUint64(state[8*i:], a[i])
+	}
+}
@@ -0,0 +1,19 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+// NewMockTuakCipher instantiates the TUAK algo using MockRNG for rng.
+func NewMockTuakCipher(amf []byte, rand []byte) (*TuakCipher, error) {
+	tuak, err := NewTuakCipher(amf)
+	if err != nil {
+		return nil, err
+	}
+	tuak.rng = MockRNG{rand: rand}
+	return tuak, nil
+}
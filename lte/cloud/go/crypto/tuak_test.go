@@ -0,0 +1,161 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package crypto
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// This is test set 1 from 3GPP 35.232 6.3
+func TestTuak_Set1(t *testing.T) {
+	key, err := hex.DecodeString("abababababababababababababababab")
+	assert.NoError(t, err)
+	rand, err := hex.DecodeString("42424242424242424242424242424242")
+	assert.NoError(t, err)
+	sqn, err := hex.DecodeString("111111111111")
+	assert.NoError(t, err)
+	amf, err := hex.DecodeString("ffff")
+	assert.NoError(t, err)
+	top, err := hex.DecodeString("5555555555555555555555555555555555555555555555555555555555555555")
+	assert.NoError(t, err)
+
+	topc, err := GenerateTopc(key, top)
+	assert.NoError(t, err)
+	assert.Equal(t, "bd04d9530e87513c5d837ac2ad954623a8e2330c115305a73eb45d1f40cccbff", hex.EncodeToString(topc[:]))
+
+	macA := tuakF1(key, topc[:], rand, sqn, amf, tuakInstanceF1, 8)
+	assert.Equal(t, "f9a54e6aeaa8618d", hex.EncodeToString(macA))
+	macS := tuakF1(key, topc[:], rand, sqn, amf, tuakInstanceF1Star, 8)
+	assert.Equal(t, "e94b4dc6c7297df3", hex.EncodeToString(macS))
+
+	res, ck, ik, ak := tuakF2345(key, topc[:], rand, 4, 16, 16)
+	assert.Equal(t, "657acd64", hex.EncodeToString(res))
+	assert.Equal(t, "d71a1e5c6caffe986a26f783e5c78be1", hex.EncodeToString(ck))
+	assert.Equal(t, "be849fa2564f869aecee6f62d4337e72", hex.EncodeToString(ik))
+	assert.Equal(t, "719f1e9b9054", hex.EncodeToString(ak))
+
+	akStar := tuakF5Star(key, topc[:], rand)
+	assert.Equal(t, "e7af6b3d0e38", hex.EncodeToString(akStar))
+}
+
+func TestTuakGenerateEutranVector(t *testing.T) {
+	rand, err := hex.DecodeString("42424242424242424242424242424242")
+	assert.NoError(t, err)
+	key, err := hex.DecodeString("abababababababababababababababab")
+	assert.NoError(t, err)
+	topc, err := hex.DecodeString("bd04d9530e87513c5d837ac2ad954623a8e2330c115305a73eb45d1f40cccbff")
+	assert.NoError(t, err)
+	amf := []byte("\xff\xff")
+	plmn := []byte("\x02\xf8\x59")
+
+	tuak, err := NewMockTuakCipher(amf, rand)
+	assert.NoError(t, err)
+
+	eutran, err := tuak.GenerateEutranVector(key, topc, 0x111111111111, plmn)
+	assert.NoError(t, err)
+	assert.Equal(t, rand, eutran.Rand[:])
+	assert.Equal(t, "7abd06d3fff7f634", hex.EncodeToString(eutran.Xres[:]))
+	// The MAC-A in the AUTN is the f1 output of test set 1
+	assert.Equal(t, "bbc306548b74fffff9a54e6aeaa8618d", hex.EncodeToString(eutran.Autn[:]))
+	assert.Equal(t, "44851d201d606d08452ac6cb084eab430ca7107e2a9a08a77e6c5c497efd3da5", hex.EncodeToString(eutran.Kasme[:]))
+}
+
+func TestTuakGenerateSIPAuthVectorWithRand_256BitKey(t *testing.T) {
+	rand := []byte("\x00\x01\x02\x03\x04\x05\x06\x07\x08\t\n\x0b\x0c\r\x0e\x0f")
+	key := make([]byte, ExpectedTuakKeyBytes256)
+	for i := range key {
+		key[i] = byte(i)
+	}
+	topc, err := GenerateTopc(key, make([]byte, ExpectedTopBytes))
+	assert.NoError(t, err)
+	sqn := uint64(7351)
+	amf := []byte("\x80\x00")
+
+	tuak, err := NewTuakCipher(amf)
+	assert.NoError(t, err)
+	vector, err := tuak.GenerateSIPAuthVectorWithRand(rand, key, topc[:], sqn)
+	assert.NoError(t, err)
+
+	xres, ck, ik, ak := tuakF2345(key, topc[:], rand, XresBytes, ConfidentialityKeyBytes, IntegrityKeyBytes)
+	macA := tuakF1(key, topc[:], rand, getSqnBytes(sqn), amf, tuakInstanceF1, macBytes)
+	assert.Equal(t, rand, vector.Rand[:])
+	assert.Equal(t, xres, vector.Xres[:])
+	assert.Equal(t, generateAutn(getSqnBytes(sqn), ak, macA, amf), vector.Autn[:])
+	assert.Equal(t, ck, vector.ConfidentialityKey[:])
+	assert.Equal(t, ik, vector.IntegrityKey[:])
+	assert.Equal(t, ak, vector.AnonymityKey[:akBytes])
+
+	// The key length is part of the INSTANCE, so a 128 bit prefix of the key
+	// yields a different vector
+	shortKeyTopc, err := GenerateTopc(key[:ExpectedTuakKeyBytes128], make([]byte, ExpectedTopBytes))
+	assert.NoError(t, err)
+	shortKeyVector, err := tuak.GenerateSIPAuthVectorWithRand(rand, key[:ExpectedTuakKeyBytes128], shortKeyTopc[:], sqn)
+	assert.NoError(t, err)
+	assert.NotEqual(t, vector.Xres, shortKeyVector.Xres)
+}
+
+func TestTuakGenerateResync(t *testing.T) {
+	rand := []byte("\xcd\x14\xa7S\x97\x7f\xbcq\x8eb\xbd\xdbS]\x88\xf8")
+	key, err := hex.DecodeString("abababababababababababababababab")
+	assert.NoError(t, err)
+	topc, err := hex.DecodeString("bd04d9530e87513c5d837ac2ad954623a8e2330c115305a73eb45d1f40cccbff")
+	assert.NoError(t, err)
+	amf := []byte{0, 0}
+
+	// AUTS = SQN_MS ^ AK* || MAC-S as computed by the USIM
+	sqnMs := getSqnBytes(0x1234)
+	auts := append(xor(sqnMs, tuakF5Star(key, topc, rand)), tuakF1(key, topc, rand, sqnMs, amf, tuakInstanceF1Star, macBytes)...)
+
+	tuak, err := NewTuakCipher(amf)
+	assert.NoError(t, err)
+	sqn, macS, err := tuak.GenerateResync(auts, key, topc, rand)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0x1234), sqn)
+	assert.Equal(t, auts[sqnMaxBytes:], macS[:])
+}
+
+func TestTuak_InvalidInput(t *testing.T) {
+	rand := make([]byte, RandChallengeBytes)
+	key := make([]byte, ExpectedTuakKeyBytes128)
+	topc := make([]byte, ExpectedTopcBytes)
+	auts := make([]byte, ExpectedAutsBytes)
+	plmn := make([]byte, ExpectedPlmnBytes)
+
+	_, err := NewTuakCipher([]byte{0})
+	assert.EqualError(t, err, "incorrect amf size. Expected 2 bytes, but got 1 bytes")
+
+	tuak, err := NewTuakCipher(make([]byte, ExpectedAmfBytes))
+	assert.NoError(t, err)
+
+	_, err = tuak.GenerateEutranVector(make([]byte, 24), topc, 0, plmn)
+	assert.EqualError(t, err, "incorrect key size. Expected 16 or 32 bytes, but got 24 bytes")
+	_, err = tuak.GenerateEutranVector(key, make([]byte, ExpectedOpcBytes), 0, plmn)
+	assert.EqualError(t, err, "incorrect topc size. Expected 32 bytes, but got 16 bytes")
+	_, err = tuak.GenerateEutranVector(key, topc, maxSqn+1, plmn)
+	assert.EqualError(t, err, "sequence number too large, expected a number which can fit in 48 bits. Got: 140737488355328")
+	_, err = tuak.GenerateEutranVector(key, topc, 0, make([]byte, 2))
+	assert.EqualError(t, err, "incorrect plmn size. Expected 3 bytes, but got 2 bytes")
+	_, err = tuak.GenerateSIPAuthVectorWithRand(make([]byte, 15), key, topc, 0)
+	assert.EqualError(t, err, "incorrect rand size. Expected 16 bytes, but got 15 bytes")
+
+	_, _, err = tuak.GenerateResync(make([]byte, 13), key, topc, rand)
+	assert.EqualError(t, err, "incorrect auts size. Expected 14 bytes, but got 13 bytes")
+	_, _, err = tuak.GenerateResync(auts, key, topc, make([]byte, 17))
+	assert.EqualError(t, err, "incorrect rand size. Expected 16 bytes, but got 17 bytes")
+	_, _, err = tuak.GenerateResync(auts, key, make([]byte, 31), rand)
+	assert.EqualError(t, err, "incorrect topc size. Expected 32 bytes, but got 31 bytes")
+
+	_, err = GenerateTopc(key, make([]byte, ExpectedOpBytes))
+	assert.EqualError(t, err, "incorrect top size. Expected 32 bytes, but got 16 bytes")
+	_, err = GenerateTopc(make([]byte, 8), make([]byte, ExpectedTopBytes))
+	assert.EqualError(t, err, "incorrect key size. Expected 16 or 32 bytes, but got 8 bytes")
+}
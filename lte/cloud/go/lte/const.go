@@ -8,6 +8,8 @@ LICENSE file in the root directory of this source tree.
 
 package lte
 
+import "time"
+
 const ModuleName = "lte"
 
 const (
@@ -27,3 +29,7 @@ const (
 
 	RatingGroupEntityType = "rating_group"
 )
+
+// EnodebStateTTL is how long an enodeb state stays valid after both it was
+// last reported and its gateway last checked in.
+const EnodebStateTTL = 24 * time.Hour
@@ -16,6 +16,7 @@ import (
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/models"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/pluginimpl/handlers"
 	orc8rmodels "magma/orc8r/cloud/go/pluginimpl/models"
@@ -23,6 +24,7 @@ import (
 	"magma/orc8r/cloud/go/services/state"
 	"magma/orc8r/cloud/go/storage"
 
+	"github.com/go-openapi/swag"
 	"github.com/labstack/echo"
 	"github.com/pkg/errors"
 )
@@ -60,17 +62,21 @@ const (
 	ManageGatewayCellularNonEpsPath   = ManageGatewayCellularPath + obsidian.UrlSep + "non_eps"
 	ManageGatewayConnectedEnodebsPath = ManageGatewayPath + obsidian.UrlSep + "connected_enodeb_serials"
 
-	Enodebs            = "enodebs"
-	ListEnodebsPath    = ManageNetworkPath + obsidian.UrlSep + Enodebs
-	ManageEnodebPath   = ListEnodebsPath + obsidian.UrlSep + ":enodeb_serial"
-	GetEnodebStatePath = ManageEnodebPath + obsidian.UrlSep + "state"
+	Enodebs                = "enodebs"
+	ListEnodebsPath        = ManageNetworkPath + obsidian.UrlSep + Enodebs
+	ManageEnodebPath       = ListEnodebsPath + obsidian.UrlSep + ":enodeb_serial"
+	GetEnodebStatePath     = ManageEnodebPath + obsidian.UrlSep + "state"
+	ManageEnodebLabelsPath = ManageEnodebPath + obsidian.UrlSep + "labels"
 
 	Subscribers              = "subscribers"
 	ListSubscribersPath      = ManageNetworkPath + obsidian.UrlSep + Subscribers
+	ImportSubscribersPath    = ListSubscribersPath + obsidian.UrlSep + "import"
+	ExportSubscribersPath    = ListSubscribersPath + obsidian.UrlSep + "export"
 	ManageSubscriberPath     = ListSubscribersPath + obsidian.UrlSep + ":subscriber_id"
 	ActivateSubscriberPath   = ManageSubscriberPath + obsidian.UrlSep + "activate"
 	DeactivateSubscriberPath = ManageSubscriberPath + obsidian.UrlSep + "deactivate"
 	SubscriberProfilePath    = ManageSubscriberPath + obsidian.UrlSep + "lte" + obsidian.UrlSep + "sub_profile"
+	SubscriberLabelsPath     = ManageSubscriberPath + obsidian.UrlSep + "labels"
 
 	policiesRootPath         = handlers.ManageNetworkPath + obsidian.UrlSep + "policies"
 	policyRuleRootPath       = policiesRootPath + obsidian.UrlSep + "rules"
@@ -107,6 +113,8 @@ func GetHandlers() []obsidian.Handler {
 
 		{Path: ListSubscribersPath, Methods: obsidian.GET, HandlerFunc: listSubscribers},
 		{Path: ListSubscribersPath, Methods: obsidian.POST, HandlerFunc: createSubscriber},
+		{Path: ImportSubscribersPath, Methods: obsidian.POST, HandlerFunc: importSubscribers},
+		{Path: ExportSubscribersPath, Methods: obsidian.GET, HandlerFunc: exportSubscribers},
 		{Path: ManageSubscriberPath, Methods: obsidian.GET, HandlerFunc: getSubscriber},
 		{Path: ManageSubscriberPath, Methods: obsidian.PUT, HandlerFunc: updateSubscriber},
 		{Path: ManageSubscriberPath, Methods: obsidian.DELETE, HandlerFunc: deleteSubscriber},
@@ -157,6 +165,9 @@ func GetHandlers() []obsidian.Handler {
 	ret = append(ret, handlers.GetPartialGatewayHandlers(ManageGatewayCellularRanPath, &ltemodels.GatewayRanConfigs{})...)
 	ret = append(ret, handlers.GetPartialGatewayHandlers(ManageGatewayCellularNonEpsPath, &ltemodels.GatewayNonEpsConfigs{})...)
 	ret = append(ret, handlers.GetPartialGatewayHandlers(ManageGatewayConnectedEnodebsPath, &ltemodels.EnodebSerials{})...)
+
+	ret = append(ret, handlers.GetEntityLabelsHandlers(ManageEnodebLabelsPath, "enodeb_serial", lte.CellularEnodebType)...)
+	ret = append(ret, handlers.GetEntityLabelsHandlers(SubscriberLabelsPath, "subscriber_id", lte.SubscriberEntityType)...)
 	return ret
 }
 
@@ -173,7 +184,7 @@ func getGateway(c echo.Context) error {
 		return nerr
 	}
 
-	magmadModel, nerr := handlers.LoadMagmadGatewayModel(nid, gid)
+	magmadModel, nerr := handlers.LoadMagmadGatewayModel(access.GetVerifiedOperator(c), nid, gid)
 	if nerr != nil {
 		return nerr
 	}
@@ -261,18 +272,25 @@ func listEnodebs(c echo.Context) error {
 		return nerr
 	}
 
-	ents, err := configurator.LoadAllEntitiesInNetwork(
-		nid, lte.CellularEnodebType,
+	params, nerr := obsidian.GetPaginationParams(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	ents, nextPageToken, err := configurator.LoadEntitiesPage(
+		nid, swag.String(lte.CellularEnodebType),
+		params.KeyPrefix, params.PageSize, params.PageToken,
 		configurator.EntityLoadCriteria{LoadMetadata: true, LoadConfig: true, LoadAssocsToThis: true},
 	)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 
 	ret := make(map[string]*ltemodels.Enodeb, len(ents))
 	for _, ent := range ents {
 		ret[ent.Key] = (&ltemodels.Enodeb{}).FromBackendModels(ent)
 	}
+	obsidian.SetNextPageToken(c, nextPageToken)
 	return c.JSON(http.StatusOK, ret)
 }
 
@@ -293,7 +311,7 @@ func createEnodeb(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "attached_gateway_id is a read-only property")
 	}
 
-	_, err := configurator.CreateEntity(nid, configurator.NetworkEntity{
+	_, err := configurator.CreateEntityAs(access.GetVerifiedOperator(c), nid, configurator.NetworkEntity{
 		Type:       lte.CellularEnodebType,
 		Key:        payload.Serial,
 		Name:       payload.Name,
@@ -348,7 +366,7 @@ func updateEnodeb(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "serial in body must match serial in path")
 	}
 
-	_, err := configurator.UpdateEntity(nid, payload.ToEntityUpdateCriteria())
+	_, err := configurator.UpdateEntityAs(access.GetVerifiedOperator(c), nid, payload.ToEntityUpdateCriteria())
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -361,7 +379,7 @@ func deleteEnodeb(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(nid, lte.CellularEnodebType, eid)
+	err := configurator.DeleteEntityAs(access.GetVerifiedOperator(c), nid, lte.CellularEnodebType, eid)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -407,7 +425,7 @@ func deleteConnectedEnodeb(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.UpdateEntity(networkID, (&ltemodels.EnodebSerials{}).ToDeleteUpdateCriteria(networkID, gatewayID, enodebSerial))
+	_, err := configurator.UpdateEntityAs(access.GetVerifiedOperator(c), networkID, (&ltemodels.EnodebSerials{}).ToDeleteUpdateCriteria(networkID, gatewayID, enodebSerial))
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -425,7 +443,7 @@ func addConnectedEnodeb(c echo.Context) error {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
 
-	_, err := configurator.UpdateEntity(networkID, (&ltemodels.EnodebSerials{}).ToCreateUpdateCriteria(networkID, gatewayID, enodebSerial))
+	_, err := configurator.UpdateEntityAs(access.GetVerifiedOperator(c), networkID, (&ltemodels.EnodebSerials{}).ToCreateUpdateCriteria(networkID, gatewayID, enodebSerial))
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -438,15 +456,25 @@ func listSubscribers(c echo.Context) error {
 		return nerr
 	}
 
-	ents, err := configurator.LoadAllEntitiesInNetwork(networkID, lte.SubscriberEntityType, configurator.EntityLoadCriteria{LoadConfig: true})
+	params, nerr := obsidian.GetPaginationParams(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	ents, nextPageToken, err := configurator.LoadEntitiesPage(
+		networkID, swag.String(lte.SubscriberEntityType),
+		params.KeyPrefix, params.PageSize, params.PageToken,
+		configurator.EntityLoadCriteria{LoadConfig: true},
+	)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 
 	ret := make(map[string]*ltemodels.Subscriber, len(ents))
 	for _, ent := range ents {
 		ret[ent.Key] = (&ltemodels.Subscriber{}).FromBackendModels(ent)
 	}
+	obsidian.SetNextPageToken(c, nextPageToken)
 	return c.JSON(http.StatusOK, ret)
 }
 
@@ -468,13 +496,13 @@ func createSubscriber(c echo.Context) error {
 		return nerr
 	}
 
-	_, err := configurator.CreateEntity(networkID, configurator.NetworkEntity{
+	_, err := configurator.CreateEntityAs(access.GetVerifiedOperator(c), networkID, configurator.NetworkEntity{
 		Type:   lte.SubscriberEntityType,
 		Key:    string(payload.ID),
 		Config: payload.Lte,
 	})
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 
 	return c.NoContent(http.StatusCreated)
@@ -524,9 +552,13 @@ func updateSubscriber(c echo.Context) error {
 		return nerr
 	}
 
-	err = configurator.CreateOrUpdateEntityConfig(networkID, lte.SubscriberEntityType, subscriberID, payload.Lte)
+	_, err = configurator.UpdateEntityAs(
+		access.GetVerifiedOperator(c),
+		networkID,
+		configurator.EntityUpdateCriteria{Type: lte.SubscriberEntityType, Key: subscriberID, NewConfig: payload.Lte},
+	)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -537,9 +569,9 @@ func deleteSubscriber(c echo.Context) error {
 		return nerr
 	}
 
-	err := configurator.DeleteEntity(networkID, lte.SubscriberEntityType, subscriberID)
+	err := configurator.DeleteEntityAs(access.GetVerifiedOperator(c), networkID, lte.SubscriberEntityType, subscriberID)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -572,9 +604,13 @@ func updateSubscriberProfile(c echo.Context) error {
 		return nerr
 	}
 
-	_, err = configurator.UpdateEntity(networkID, configurator.EntityUpdateCriteria{Type: lte.SubscriberEntityType, Key: subscriberID, NewConfig: desiredCfg})
+	_, err = configurator.UpdateEntityAs(
+		access.GetVerifiedOperator(c),
+		networkID,
+		configurator.EntityUpdateCriteria{Type: lte.SubscriberEntityType, Key: subscriberID, NewConfig: desiredCfg},
+	)
 	if err != nil {
-		return obsidian.HttpError(errors.Wrap(err, "failed to update profile"), http.StatusInternalServerError)
+		return obsidian.HttpError(errors.Wrap(err, "failed to update profile"), merrors.GetHttpStatusCode(err))
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -596,9 +632,13 @@ func makeSubscriberStateHandler(desiredState string) echo.HandlerFunc {
 
 		newConfig := cfg.(*ltemodels.LteSubscription)
 		newConfig.State = desiredState
-		err = configurator.CreateOrUpdateEntityConfig(networkID, lte.SubscriberEntityType, subscriberID, newConfig)
+		_, err = configurator.UpdateEntityAs(
+			access.GetVerifiedOperator(c),
+			networkID,
+			configurator.EntityUpdateCriteria{Type: lte.SubscriberEntityType, Key: subscriberID, NewConfig: newConfig},
+		)
 		if err != nil {
-			return obsidian.HttpError(err, http.StatusInternalServerError)
+			return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 		}
 		return c.NoContent(http.StatusOK)
 	}
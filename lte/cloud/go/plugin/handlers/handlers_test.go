@@ -12,6 +12,7 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -2616,6 +2617,65 @@ func TestListSubscribers(t *testing.T) {
 		}),
 	}
 	tests.RunUnitTest(t, e, tc)
+
+	// Paginated list: subscribers come back ordered by key
+	req := httptest.NewRequest(echo.GET, testURLRoot+"?page_size=1", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues("n1")
+	assert.NoError(t, listSubscribers(c))
+	assert.Equal(t, 200, rec.Code)
+	assert.Contains(t, rec.Body.String(), "IMSI0987654321")
+	assert.NotContains(t, rec.Body.String(), "IMSI1234567890")
+	nextToken := rec.Header().Get(obsidian.NextPageTokenHeader)
+	assert.NotEmpty(t, nextToken)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            fmt.Sprintf("%s?page_size=1&page_token=%s", testURLRoot, nextToken),
+		Handler:        listSubscribers,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler(map[string]*lteModels.Subscriber{
+			"IMSI1234567890": {
+				ID: "IMSI1234567890",
+				Lte: &lteModels.LteSubscription{
+					AuthAlgo:   "MILENAGE",
+					AuthKey:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+					AuthOpc:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+					State:      "ACTIVE",
+					SubProfile: "default",
+				},
+			},
+		}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Key prefix filter
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "?key_prefix=IMSI12",
+		Handler:        listSubscribers,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tc.ExpectedResult,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Invalid pagination params
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "?page_size=0",
+		Handler:        listSubscribers,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "invalid page_size 0",
+	}
+	tests.RunUnitTest(t, e, tc)
 }
 
 func TestGetSubscriber(t *testing.T) {
@@ -0,0 +1,551 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"magma/lte/cloud/go/lte"
+	ltemodels "magma/lte/cloud/go/plugin/models"
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+const (
+	SubscriberCSVFormat   = "csv"
+	SubscriberJSONLFormat = "jsonl"
+
+	MIMETextCSV          = "text/csv"
+	MIMEApplicationJSONL = "application/x-ndjson"
+
+	// subscriberImportChunkSize is the max number of subscribers created by
+	// a single configurator transaction during an import
+	subscriberImportChunkSize = 100
+	subscriberExportPageSize  = 500
+
+	imsiPrefix = "IMSI"
+)
+
+var subscriberRecordColumns = []string{"imsi", "auth_key", "auth_opc", "sub_profile", "state", "auth_algo"}
+
+// subscriberRecord is a single row of a subscriber import or export.
+// The IMSI may omit the IMSI prefix of subscriber IDs, and the keys are hex
+// strings, which is how SIM vendors deliver them.
+type subscriberRecord struct {
+	IMSI       string `json:"imsi"`
+	AuthKey    string `json:"auth_key"`
+	AuthOpc    string `json:"auth_opc,omitempty"`
+	SubProfile string `json:"sub_profile,omitempty"`
+	State      string `json:"state,omitempty"`
+	AuthAlgo   string `json:"auth_algo,omitempty"`
+}
+
+func (r *subscriberRecord) subscriberID() ltemodels.SubscriberID {
+	if strings.HasPrefix(r.IMSI, imsiPrefix) {
+		return ltemodels.SubscriberID(r.IMSI)
+	}
+	return ltemodels.SubscriberID(imsiPrefix + r.IMSI)
+}
+
+// toSubscriber converts the record to a validated subscriber. An empty sub
+// profile defaults to the default profile, an empty state to ACTIVE and an
+// empty auth algo to MILENAGE.
+func (r *subscriberRecord) toSubscriber() (*ltemodels.Subscriber, error) {
+	authKey, err := hex.DecodeString(r.AuthKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid auth_key")
+	}
+	authOpc, err := hex.DecodeString(r.AuthOpc)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid auth_opc")
+	}
+	ret := &ltemodels.Subscriber{
+		ID: r.subscriberID(),
+		Lte: &ltemodels.LteSubscription{
+			AuthAlgo:   r.AuthAlgo,
+			AuthKey:    authKey,
+			AuthOpc:    authOpc,
+			State:      r.State,
+			SubProfile: ltemodels.SubProfile(r.SubProfile),
+		},
+	}
+	if ret.Lte.State == "" {
+		ret.Lte.State = ltemodels.LteSubscriptionStateACTIVE
+	}
+	if ret.Lte.SubProfile == "" {
+		ret.Lte.SubProfile = "default"
+	}
+	if ret.Lte.AuthAlgo == "" {
+		ret.Lte.AuthAlgo = ltemodels.LteSubscriptionAuthAlgoMILENAGE
+	}
+	if err := ret.ValidateModel(); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func subscriberRecordFromEntity(ent configurator.NetworkEntity) (subscriberRecord, error) {
+	sub, ok := ent.Config.(*ltemodels.LteSubscription)
+	if !ok {
+		return subscriberRecord{}, errors.Errorf("subscriber %s has no lte config", ent.Key)
+	}
+	return subscriberRecord{
+		IMSI:       strings.TrimPrefix(ent.Key, imsiPrefix),
+		AuthKey:    hex.EncodeToString(sub.AuthKey),
+		AuthOpc:    hex.EncodeToString(sub.AuthOpc),
+		SubProfile: string(sub.SubProfile),
+		State:      sub.State,
+		AuthAlgo:   sub.AuthAlgo,
+	}, nil
+}
+
+// subscriberRecordReader reads the rows of a subscriber import. Next returns
+// io.EOF after the last row. Errors wrapped in a rowError only invalidate
+// the row they were returned for; any other error aborts the import.
+type subscriberRecordReader interface {
+	// Next returns the next record and its 1-based row number
+	Next() (subscriberRecord, uint32, error)
+}
+
+type rowError struct {
+	error
+}
+
+type csvSubscriberRecordReader struct {
+	reader *csv.Reader
+	// columnIndices maps the columns of the header row to their position
+	columnIndices map[string]int
+	row           uint32
+}
+
+func newCSVSubscriberRecordReader(r io.Reader) (*csvSubscriberRecordReader, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("missing csv header row")
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read csv header row")
+	}
+
+	columnIndices := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if !isSubscriberRecordColumn(column) {
+			return nil, errors.Errorf("unknown csv column %s; expected one of %s", column, strings.Join(subscriberRecordColumns, ", "))
+		}
+		if _, dup := columnIndices[column]; dup {
+			return nil, errors.Errorf("duplicate csv column %s", column)
+		}
+		columnIndices[column] = i
+	}
+	for _, required := range []string{"imsi", "auth_key"} {
+		if _, ok := columnIndices[required]; !ok {
+			return nil, errors.Errorf("missing required csv column %s", required)
+		}
+	}
+	return &csvSubscriberRecordReader{reader: reader, columnIndices: columnIndices}, nil
+}
+
+func (r *csvSubscriberRecordReader) Next() (subscriberRecord, uint32, error) {
+	fields, err := r.reader.Read()
+	if err == io.EOF {
+		return subscriberRecord{}, r.row, err
+	}
+	r.row++
+	if err != nil {
+		if _, isParseErr := err.(*csv.ParseError); isParseErr {
+			return subscriberRecord{}, r.row, rowError{err}
+		}
+		return subscriberRecord{}, r.row, err
+	}
+
+	field := func(column string) string {
+		if i, ok := r.columnIndices[column]; ok {
+			return strings.TrimSpace(fields[i])
+		}
+		return ""
+	}
+	return subscriberRecord{
+		IMSI:       field("imsi"),
+		AuthKey:    field("auth_key"),
+		AuthOpc:    field("auth_opc"),
+		SubProfile: field("sub_profile"),
+		State:      field("state"),
+		AuthAlgo:   field("auth_algo"),
+	}, r.row, nil
+}
+
+type jsonlSubscriberRecordReader struct {
+	scanner *bufio.Scanner
+	row     uint32
+}
+
+func newJSONLSubscriberRecordReader(r io.Reader) *jsonlSubscriberRecordReader {
+	return &jsonlSubscriberRecordReader{scanner: bufio.NewScanner(r)}
+}
+
+// Next skips blank lines, but they still count towards the row number so
+// that rows match the line numbers of the upload.
+func (r *jsonlSubscriberRecordReader) Next() (subscriberRecord, uint32, error) {
+	for r.scanner.Scan() {
+		r.row++
+		line := strings.TrimSpace(r.scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		record := subscriberRecord{}
+		decoder := json.NewDecoder(strings.NewReader(line))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&record); err != nil {
+			return subscriberRecord{}, r.row, rowError{errors.Wrap(err, "invalid json")}
+		}
+		return record, r.row, nil
+	}
+	if err := r.scanner.Err(); err != nil {
+		return subscriberRecord{}, r.row, err
+	}
+	return subscriberRecord{}, r.row, io.EOF
+}
+
+func isSubscriberRecordColumn(column string) bool {
+	for _, c := range subscriberRecordColumns {
+		if c == column {
+			return true
+		}
+	}
+	return false
+}
+
+func importSubscribers(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	reader, nerr := getSubscriberRecordReader(c.Request())
+	if nerr != nil {
+		return nerr
+	}
+
+	importer := &subscriberImporter{
+		networkID: networkID,
+		caller:    access.GetVerifiedOperator(c),
+		report:    &ltemodels.SubscriberImportReport{Results: []*ltemodels.SubscriberImportResult{}},
+		seenIDs:   map[ltemodels.SubscriberID]bool{},
+	}
+	for {
+		record, row, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if _, isRowErr := err.(rowError); err != nil && !isRowErr {
+			return obsidian.HttpError(errors.Wrap(err, "failed to read subscribers"), http.StatusBadRequest)
+		}
+		if err := importer.add(row, record, err); err != nil {
+			return err
+		}
+	}
+	if err := importer.flush(); err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, importer.report)
+}
+
+func getSubscriberRecordReader(req *http.Request) (subscriberRecordReader, *echo.HTTPError) {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get(echo.HeaderContentType))
+	if err != nil {
+		return nil, obsidian.HttpError(errors.Wrap(err, "invalid content type"), http.StatusUnsupportedMediaType)
+	}
+	switch mediaType {
+	case MIMETextCSV:
+		reader, err := newCSVSubscriberRecordReader(req.Body)
+		if err != nil {
+			return nil, obsidian.HttpError(err, http.StatusBadRequest)
+		}
+		return reader, nil
+	case MIMEApplicationJSONL:
+		return newJSONLSubscriberRecordReader(req.Body), nil
+	default:
+		return nil, obsidian.HttpError(errors.Errorf("unsupported content type %s; expected %s or %s", mediaType, MIMETextCSV, MIMEApplicationJSONL), http.StatusUnsupportedMediaType)
+	}
+}
+
+// subscriberImporter validates the rows of an import and creates the valid
+// ones in chunks. Each chunk is created in a single transaction, so a
+// failure to create a chunk fails all of its rows.
+type subscriberImporter struct {
+	networkID string
+	// caller is the operator on whose behalf subscribers are created
+	caller *protos.Identity
+	report *ltemodels.SubscriberImportReport
+
+	seenIDs map[ltemodels.SubscriberID]bool
+	// subProfiles are the sub profiles of the network, loaded on first use
+	subProfiles map[string]bool
+
+	pending        []*ltemodels.Subscriber
+	pendingResults []*ltemodels.SubscriberImportResult
+}
+
+// add validates a row and queues it for creation, flushing the queue when it
+// fills up. readErr is the row error returned by the reader, if any.
+// Returns an HTTP error only if the import has to be aborted.
+func (i *subscriberImporter) add(row uint32, record subscriberRecord, readErr error) error {
+	result := &ltemodels.SubscriberImportResult{Row: row}
+	i.report.Results = append(i.report.Results, result)
+	if readErr != nil {
+		i.fail(result, readErr)
+		return nil
+	}
+	if record.IMSI != "" {
+		result.ID = string(record.subscriberID())
+	}
+
+	sub, err := record.toSubscriber()
+	if err != nil {
+		i.fail(result, err)
+		return nil
+	}
+	if i.seenIDs[sub.ID] {
+		i.fail(result, errors.New("duplicate subscriber in import"))
+		return nil
+	}
+	i.seenIDs[sub.ID] = true
+
+	profileExists, nerr := i.subProfileExists(string(sub.Lte.SubProfile))
+	if nerr != nil {
+		return nerr
+	}
+	if !profileExists {
+		i.fail(result, errors.Errorf("subscriber profile %s does not exist for the network", sub.Lte.SubProfile))
+		return nil
+	}
+
+	i.pending = append(i.pending, sub)
+	i.pendingResults = append(i.pendingResults, result)
+	if len(i.pending) >= subscriberImportChunkSize {
+		return i.flush()
+	}
+	return nil
+}
+
+// flush creates the queued subscribers which don't exist yet
+func (i *subscriberImporter) flush() error {
+	if len(i.pending) == 0 {
+		return nil
+	}
+	defer func() {
+		i.pending, i.pendingResults = nil, nil
+	}()
+
+	ids := make([]storage.TypeAndKey, 0, len(i.pending))
+	for _, sub := range i.pending {
+		ids = append(ids, storage.TypeAndKey{Type: lte.SubscriberEntityType, Key: string(sub.ID)})
+	}
+	existing, _, err := configurator.LoadEntities(i.networkID, nil, nil, nil, ids, configurator.EntityLoadCriteria{})
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to load existing subscribers"), http.StatusInternalServerError)
+	}
+	existingKeys := make(map[string]bool, len(existing))
+	for _, ent := range existing {
+		existingKeys[ent.Key] = true
+	}
+
+	toCreate := make([]configurator.NetworkEntity, 0, len(i.pending))
+	createdResults := make([]*ltemodels.SubscriberImportResult, 0, len(i.pending))
+	for idx, sub := range i.pending {
+		if existingKeys[string(sub.ID)] {
+			i.fail(i.pendingResults[idx], errors.New("subscriber already exists"))
+			continue
+		}
+		toCreate = append(toCreate, configurator.NetworkEntity{
+			Type:   lte.SubscriberEntityType,
+			Key:    string(sub.ID),
+			Config: sub.Lte,
+		})
+		createdResults = append(createdResults, i.pendingResults[idx])
+	}
+	if len(toCreate) == 0 {
+		return nil
+	}
+
+	_, err = configurator.CreateEntitiesAs(i.caller, i.networkID, toCreate)
+	if err != nil {
+		for _, result := range createdResults {
+			i.fail(result, errors.Wrap(err, "failed to create subscriber"))
+		}
+		return nil
+	}
+	i.report.CreatedCount += uint32(len(toCreate))
+	return nil
+}
+
+func (i *subscriberImporter) fail(result *ltemodels.SubscriberImportResult, err error) {
+	result.Error = err.Error()
+	i.report.FailedCount++
+}
+
+func (i *subscriberImporter) subProfileExists(profile string) (bool, *echo.HTTPError) {
+	// the default profile is always available
+	if profile == "default" {
+		return true, nil
+	}
+	if i.subProfiles == nil {
+		i.subProfiles = map[string]bool{}
+		netConf, err := configurator.LoadNetworkConfig(i.networkID, lte.CellularNetworkType)
+		switch {
+		case err == merrors.ErrNotFound:
+			return false, nil
+		case err != nil:
+			return false, obsidian.HttpError(errors.Wrap(err, "failed to load cellular config"), http.StatusInternalServerError)
+		}
+		cellNetConf := netConf.(*ltemodels.NetworkCellularConfigs)
+		if cellNetConf.Epc != nil {
+			for name := range cellNetConf.Epc.SubProfiles {
+				i.subProfiles[name] = true
+			}
+		}
+	}
+	return i.subProfiles[profile], nil
+}
+
+// subscriberRecordWriter writes the rows of a subscriber export
+type subscriberRecordWriter interface {
+	Write(record subscriberRecord) error
+	Flush() error
+}
+
+type csvSubscriberRecordWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVSubscriberRecordWriter(w io.Writer) (*csvSubscriberRecordWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(subscriberRecordColumns); err != nil {
+		return nil, err
+	}
+	return &csvSubscriberRecordWriter{writer: writer}, nil
+}
+
+func (w *csvSubscriberRecordWriter) Write(record subscriberRecord) error {
+	return w.writer.Write([]string{record.IMSI, record.AuthKey, record.AuthOpc, record.SubProfile, record.State, record.AuthAlgo})
+}
+
+func (w *csvSubscriberRecordWriter) Flush() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+type jsonlSubscriberRecordWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *jsonlSubscriberRecordWriter) Write(record subscriberRecord) error {
+	return w.encoder.Encode(record)
+}
+
+func (w *jsonlSubscriberRecordWriter) Flush() error {
+	return nil
+}
+
+// exportSubscribers streams all subscribers of the network page by page, so
+// the full subscriber table is never held in memory.
+func exportSubscribers(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = SubscriberCSVFormat
+	}
+	var contentType string
+	switch format {
+	case SubscriberCSVFormat:
+		contentType = MIMETextCSV
+	case SubscriberJSONLFormat:
+		contentType = MIMEApplicationJSONL
+	default:
+		return obsidian.HttpError(errors.Errorf("unsupported export format %s; expected %s or %s", format, SubscriberCSVFormat, SubscriberJSONLFormat), http.StatusBadRequest)
+	}
+
+	// Load the first page before writing anything so that load errors can
+	// still be returned with an error status
+	caller := access.GetVerifiedOperator(c)
+	ents, nextPageToken, err := loadSubscriberExportPage(caller, networkID, "")
+	if err != nil {
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
+	}
+
+	resp := c.Response()
+	resp.Header().Set(echo.HeaderContentType, contentType)
+	resp.WriteHeader(http.StatusOK)
+	var writer subscriberRecordWriter
+	if format == SubscriberCSVFormat {
+		writer, err = newCSVSubscriberRecordWriter(resp)
+		if err != nil {
+			return err
+		}
+	} else {
+		writer = &jsonlSubscriberRecordWriter{encoder: json.NewEncoder(resp)}
+	}
+
+	for {
+		for _, ent := range ents {
+			record, err := subscriberRecordFromEntity(ent)
+			if err != nil {
+				return err
+			}
+			if err := writer.Write(record); err != nil {
+				return err
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		resp.Flush()
+
+		if nextPageToken == "" {
+			return nil
+		}
+		ents, nextPageToken, err = loadSubscriberExportPage(caller, networkID, nextPageToken)
+		if err != nil {
+			return errors.Wrap(err, "failed to load subscribers")
+		}
+	}
+}
+
+// loadSubscriberExportPage loads a page of the subscribers which the caller can
+// read. Pages may be empty when none of their subscribers are readable.
+func loadSubscriberExportPage(caller *protos.Identity, networkID string, pageToken string) (configurator.NetworkEntities, string, error) {
+	return configurator.LoadEntitiesPageAs(
+		caller, networkID, swag.String(lte.SubscriberEntityType),
+		"", subscriberExportPageSize, pageToken,
+		configurator.EntityLoadCriteria{LoadConfig: true},
+	)
+}
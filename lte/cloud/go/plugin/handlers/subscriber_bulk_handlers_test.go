@@ -0,0 +1,265 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"magma/lte/cloud/go/lte"
+	ltePlugin "magma/lte/cloud/go/plugin"
+	"magma/lte/cloud/go/plugin/handlers"
+	lteModels "magma/lte/cloud/go/plugin/models"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/go-openapi/swag"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAuthKeyHex = "11111111111111111111111111111111"
+	testAuthOpcHex = "22222222222222222222222222222222"
+)
+
+func TestImportSubscribers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	_ = plugin.RegisterPluginForTests(t, &ltePlugin.LteOrchestratorPlugin{})
+	test_init.StartTestService(t)
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{
+		Type: lte.SubscriberEntityType, Key: "IMSI1234567893",
+		Config: &lteModels.LteSubscription{
+			AuthAlgo:   "MILENAGE",
+			AuthKey:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+			State:      "ACTIVE",
+			SubProfile: "default",
+		},
+	})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	importSubscribers := tests.GetHandlerByPathAndMethod(t, handlers.GetHandlers(), handlers.ImportSubscribersPath, obsidian.POST).HandlerFunc
+
+	csvBody := strings.Join([]string{
+		"imsi,auth_key,auth_opc,sub_profile",
+		"1234567890," + testAuthKeyHex + "," + testAuthOpcHex + ",",
+		"IMSI1234567891," + testAuthKeyHex + ",,default",
+		"1234567892,nothex,,",
+		"1234567890," + testAuthKeyHex + ",,",
+		"1234567893," + testAuthKeyHex + ",,",
+		"1234567894," + testAuthKeyHex + ",,foo",
+		"1234567895,1111,,",
+		"1234567896",
+	}, "\n")
+	rec, err := runBulkSubscriberRequest(e, importSubscribers, "POST", handlers.ImportSubscribersPath, handlers.MIMETextCSV, csvBody)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, rec.Code)
+	report := &lteModels.SubscriberImportReport{}
+	assert.NoError(t, report.UnmarshalBinary(rec.Body.Bytes()))
+	assert.Equal(t, uint32(2), report.CreatedCount)
+	assert.Equal(t, uint32(6), report.FailedCount)
+	assert.Len(t, report.Results, 8)
+	expectedIDs := []string{
+		"IMSI1234567890", "IMSI1234567891", "IMSI1234567892", "IMSI1234567890",
+		"IMSI1234567893", "IMSI1234567894", "IMSI1234567895", "",
+	}
+	for i, result := range report.Results {
+		assert.Equal(t, uint32(i+1), result.Row)
+		assert.Equal(t, expectedIDs[i], result.ID)
+	}
+	assert.Empty(t, report.Results[0].Error)
+	assert.Empty(t, report.Results[1].Error)
+	assert.Contains(t, report.Results[2].Error, "invalid auth_key")
+	assert.Equal(t, "duplicate subscriber in import", report.Results[3].Error)
+	assert.Equal(t, "subscriber already exists", report.Results[4].Error)
+	assert.Equal(t, "subscriber profile foo does not exist for the network", report.Results[5].Error)
+	assert.Contains(t, report.Results[6].Error, "expected lte auth key to be 16 bytes")
+	assert.Contains(t, report.Results[7].Error, "wrong number of fields")
+
+	actual, err := configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI1234567890", configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Equal(t, &lteModels.LteSubscription{
+		AuthAlgo:   "MILENAGE",
+		AuthKey:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+		AuthOpc:    []byte("\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22"),
+		State:      "ACTIVE",
+		SubProfile: "default",
+	}, actual.Config)
+
+	// JSON lines, blank lines count towards row numbers
+	jsonlBody := strings.Join([]string{
+		`{"imsi": "1234567897", "auth_key": "` + testAuthKeyHex + `", "state": "INACTIVE"}`,
+		``,
+		`{"imsi": "1234567898", "auth_key": "` + testAuthKeyHex + `", "unknown": "field"}`,
+		`not json`,
+	}, "\n")
+	rec, err = runBulkSubscriberRequest(e, importSubscribers, "POST", handlers.ImportSubscribersPath, handlers.MIMEApplicationJSONL, jsonlBody)
+	assert.NoError(t, err)
+	assert.Equal(t, 200, rec.Code)
+	report = &lteModels.SubscriberImportReport{}
+	assert.NoError(t, report.UnmarshalBinary(rec.Body.Bytes()))
+	assert.Equal(t, uint32(1), report.CreatedCount)
+	assert.Equal(t, uint32(2), report.FailedCount)
+	assert.Len(t, report.Results, 3)
+	assert.Equal(t, &lteModels.SubscriberImportResult{Row: 1, ID: "IMSI1234567897"}, report.Results[0])
+	assert.Equal(t, uint32(3), report.Results[1].Row)
+	assert.Contains(t, report.Results[1].Error, "unknown field")
+	assert.Equal(t, uint32(4), report.Results[2].Row)
+	assert.Contains(t, report.Results[2].Error, "invalid json")
+
+	actual, err = configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI1234567897", configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "INACTIVE", actual.Config.(*lteModels.LteSubscription).State)
+
+	// TUAK subscribers need their 256 bit TOPc
+	jsonlBody = strings.Join([]string{
+		`{"imsi": "1234567899", "auth_key": "` + testAuthKeyHex + `", "auth_opc": "` + testAuthOpcHex + testAuthOpcHex + `", "auth_algo": "TUAK"}`,
+		`{"imsi": "1234567800", "auth_key": "` + testAuthKeyHex + `", "auth_algo": "TUAK"}`,
+	}, "\n")
+	rec, err = runBulkSubscriberRequest(e, importSubscribers, "POST", handlers.ImportSubscribersPath, handlers.MIMEApplicationJSONL, jsonlBody)
+	assert.NoError(t, err)
+	report = &lteModels.SubscriberImportReport{}
+	assert.NoError(t, report.UnmarshalBinary(rec.Body.Bytes()))
+	assert.Equal(t, uint32(1), report.CreatedCount)
+	assert.Equal(t, "expected TUAK auth opc to be 32 bytes but got 0 bytes", report.Results[1].Error)
+
+	actual, err = configurator.LoadEntity("n1", lte.SubscriberEntityType, "IMSI1234567899", configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "TUAK", actual.Config.(*lteModels.LteSubscription).AuthAlgo)
+
+	// Bad requests
+	_, err = runBulkSubscriberRequest(e, importSubscribers, "POST", handlers.ImportSubscribersPath, "application/json", "{}")
+	assert.EqualError(t, err, "code=415, message=unsupported content type application/json; expected text/csv or application/x-ndjson")
+	_, err = runBulkSubscriberRequest(e, importSubscribers, "POST", handlers.ImportSubscribersPath, handlers.MIMETextCSV, "imsi,ki\n")
+	assert.EqualError(t, err, "code=400, message=unknown csv column ki; expected one of imsi, auth_key, auth_opc, sub_profile, state, auth_algo")
+	_, err = runBulkSubscriberRequest(e, importSubscribers, "POST", handlers.ImportSubscribersPath, handlers.MIMETextCSV, "imsi,auth_opc\n")
+	assert.EqualError(t, err, "code=400, message=missing required csv column auth_key")
+	_, err = runBulkSubscriberRequest(e, importSubscribers, "POST", handlers.ImportSubscribersPath, handlers.MIMETextCSV, "")
+	assert.EqualError(t, err, "code=400, message=missing csv header row")
+}
+
+func TestExportSubscribers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	_ = plugin.RegisterPluginForTests(t, &ltePlugin.LteOrchestratorPlugin{})
+	test_init.StartTestService(t)
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetHandlers()
+	exportSubscribers := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, handlers.ExportSubscribersPath, obsidian.GET).HandlerFunc
+	importSubscribers := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, handlers.ImportSubscribersPath, obsidian.POST).HandlerFunc
+
+	rec, err := runBulkSubscriberRequest(e, exportSubscribers, "GET", handlers.ExportSubscribersPath, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, handlers.MIMETextCSV, rec.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "imsi,auth_key,auth_opc,sub_profile,state,auth_algo\n", rec.Body.String())
+
+	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+		{
+			Type: lte.SubscriberEntityType, Key: "IMSI1234567891",
+			Config: &lteModels.LteSubscription{
+				AuthAlgo:   "MILENAGE",
+				AuthKey:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+				State:      "INACTIVE",
+				SubProfile: "default",
+			},
+		},
+		{
+			Type: lte.SubscriberEntityType, Key: "IMSI1234567890",
+			Config: &lteModels.LteSubscription{
+				AuthAlgo:   "MILENAGE",
+				AuthKey:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+				AuthOpc:    []byte("\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22\x22"),
+				State:      "ACTIVE",
+				SubProfile: "foo",
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	rec, err = runBulkSubscriberRequest(e, exportSubscribers, "GET", handlers.ExportSubscribersPath+"?format=csv", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, rec.Code)
+	expectedCSV := strings.Join([]string{
+		"imsi,auth_key,auth_opc,sub_profile,state,auth_algo",
+		"1234567890," + testAuthKeyHex + "," + testAuthOpcHex + ",foo,ACTIVE,MILENAGE",
+		"1234567891," + testAuthKeyHex + ",,default,INACTIVE,MILENAGE",
+		"",
+	}, "\n")
+	assert.Equal(t, expectedCSV, rec.Body.String())
+
+	rec, err = runBulkSubscriberRequest(e, exportSubscribers, "GET", handlers.ExportSubscribersPath+"?format=jsonl", "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, 200, rec.Code)
+	assert.Equal(t, handlers.MIMEApplicationJSONL, rec.Header().Get(echo.HeaderContentType))
+	expectedJSONL := strings.Join([]string{
+		`{"imsi":"1234567890","auth_key":"` + testAuthKeyHex + `","auth_opc":"` + testAuthOpcHex + `","sub_profile":"foo","state":"ACTIVE","auth_algo":"MILENAGE"}`,
+		`{"imsi":"1234567891","auth_key":"` + testAuthKeyHex + `","sub_profile":"default","state":"INACTIVE","auth_algo":"MILENAGE"}`,
+		"",
+	}, "\n")
+	assert.Equal(t, expectedJSONL, rec.Body.String())
+
+	_, err = runBulkSubscriberRequest(e, exportSubscribers, "GET", handlers.ExportSubscribersPath+"?format=xml", "", "")
+	assert.EqualError(t, err, "code=400, message=unsupported export format xml; expected csv or jsonl")
+
+	// An export can be imported into another network as-is
+	err = configurator.CreateNetwork(configurator.Network{ID: "n2"})
+	assert.NoError(t, err)
+	err = configurator.CreateOrUpdateEntityConfig("n1", lte.SubscriberEntityType, "IMSI1234567890", &lteModels.LteSubscription{
+		AuthAlgo:   "MILENAGE",
+		AuthKey:    []byte("\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11\x11"),
+		State:      "ACTIVE",
+		SubProfile: "default",
+	})
+	assert.NoError(t, err)
+	rec, err = runBulkSubscriberRequest(e, exportSubscribers, "GET", handlers.ExportSubscribersPath, "", "")
+	assert.NoError(t, err)
+	rec, err = runBulkSubscriberRequestInNetwork(e, importSubscribers, "n2", "POST", handlers.ImportSubscribersPath, handlers.MIMETextCSV, rec.Body.String())
+	assert.NoError(t, err)
+	report := &lteModels.SubscriberImportReport{}
+	assert.NoError(t, report.UnmarshalBinary(rec.Body.Bytes()))
+	assert.Equal(t, uint32(2), report.CreatedCount)
+	assert.Equal(t, uint32(0), report.FailedCount)
+
+	expected, _, err := configurator.LoadEntities("n1", swag.String(lte.SubscriberEntityType), nil, nil, nil, configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	actual, _, err := configurator.LoadEntities("n2", swag.String(lte.SubscriberEntityType), nil, nil, nil, configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Len(t, actual, 2)
+	for i := range expected {
+		assert.Equal(t, expected[i].Key, actual[i].Key)
+		assert.Equal(t, expected[i].Config, actual[i].Config)
+	}
+}
+
+func runBulkSubscriberRequest(e *echo.Echo, handler echo.HandlerFunc, method, url, contentType, body string) (*httptest.ResponseRecorder, error) {
+	return runBulkSubscriberRequestInNetwork(e, handler, "n1", method, url, contentType, body)
+}
+
+func runBulkSubscriberRequestInNetwork(e *echo.Echo, handler echo.HandlerFunc, networkID, method, url, contentType, body string) (*httptest.ResponseRecorder, error) {
+	req := httptest.NewRequest(method, url, strings.NewReader(body))
+	if contentType != "" {
+		req.Header.Set(echo.HeaderContentType, contentType)
+	}
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("network_id")
+	c.SetParamValues(networkID)
+	return rec, handler(c)
+}
@@ -21,7 +21,7 @@ type LteSubscription struct {
 
 	// auth algo
 	// Required: true
-	// Enum: [MILENAGE]
+	// Enum: [MILENAGE TUAK]
 	AuthAlgo string `json:"auth_algo"`
 
 	// auth key
@@ -77,7 +77,7 @@ var lteSubscriptionTypeAuthAlgoPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["MILENAGE"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["MILENAGE","TUAK"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -89,6 +89,9 @@ const (
 
 	// LteSubscriptionAuthAlgoMILENAGE captures enum value "MILENAGE"
 	LteSubscriptionAuthAlgoMILENAGE string = "MILENAGE"
+
+	// LteSubscriptionAuthAlgoTUAK captures enum value "TUAK"
+	LteSubscriptionAuthAlgoTUAK string = "TUAK"
 )
 
 // prop value enum
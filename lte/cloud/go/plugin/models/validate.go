@@ -267,6 +267,10 @@ func (m *EnodebConfiguration) ValidateModel() error {
 const (
 	lteAuthKeyLength = 16
 	lteAuthOpcLength = 16
+
+	tuakAuthKeyLength     = 16
+	tuakAuthKeyLongLength = 32
+	tuakAuthOpcLength     = 32
 )
 
 func (m *Subscriber) ValidateModel() error {
@@ -283,6 +287,9 @@ func (m *LteSubscription) ValidateModel() error {
 	if err := m.Validate(strfmt.Default); err != nil {
 		return err
 	}
+	if m.AuthAlgo == LteSubscriptionAuthAlgoTUAK {
+		return m.validateTuak()
+	}
 
 	authKeyLen := len([]byte(m.AuthKey))
 	if authKeyLen != lteAuthKeyLength {
@@ -298,6 +305,20 @@ func (m *LteSubscription) ValidateModel() error {
 	return nil
 }
 
+// validateTuak checks the key lengths of a TUAK subscription. TOPc can't be
+// derived from the network's Milenage OP, so it is required.
+func (m *LteSubscription) validateTuak() error {
+	authKeyLen := len([]byte(m.AuthKey))
+	if authKeyLen != tuakAuthKeyLength && authKeyLen != tuakAuthKeyLongLength {
+		return models.ValidateErrorf("expected TUAK auth key to be %d or %d bytes but got %d bytes", tuakAuthKeyLength, tuakAuthKeyLongLength, authKeyLen)
+	}
+	authOpcLen := len([]byte(m.AuthOpc))
+	if authOpcLen != tuakAuthOpcLength {
+		return models.ValidateErrorf("expected TUAK auth opc to be %d bytes but got %d bytes", tuakAuthOpcLength, authOpcLen)
+	}
+	return nil
+}
+
 func (m *EnodebState) ValidateModel() error {
 	return m.Validate(strfmt.Default)
 }
@@ -9,8 +9,10 @@
 package models
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/stretchr/testify/assert"
 )
@@ -64,3 +66,53 @@ func TestGatewayNonEpsConfigs_ValidateModel(t *testing.T) {
 		}
 	}
 }
+
+func TestLteSubscription_ValidateModel(t *testing.T) {
+	key16 := strfmt.Base64(bytes.Repeat([]byte{0x11}, 16))
+	key32 := strfmt.Base64(bytes.Repeat([]byte{0x11}, 32))
+	testCases := []struct {
+		sub           *LteSubscription
+		expectedError string
+	}{
+		// Milenage with OPc derived from the network OP
+		{
+			sub:           &LteSubscription{AuthAlgo: "MILENAGE", AuthKey: key16, State: "ACTIVE", SubProfile: "default"},
+			expectedError: "",
+		},
+		{
+			sub:           &LteSubscription{AuthAlgo: "MILENAGE", AuthKey: key32, State: "ACTIVE", SubProfile: "default"},
+			expectedError: "expected lte auth key to be 16 bytes but got 32 bytes",
+		},
+
+		// TUAK accepts 128 and 256 bit keys, but requires TOPc
+		{
+			sub:           &LteSubscription{AuthAlgo: "TUAK", AuthKey: key16, AuthOpc: key32, State: "ACTIVE", SubProfile: "default"},
+			expectedError: "",
+		},
+		{
+			sub:           &LteSubscription{AuthAlgo: "TUAK", AuthKey: key32, AuthOpc: key32, State: "ACTIVE", SubProfile: "default"},
+			expectedError: "",
+		},
+		{
+			sub:           &LteSubscription{AuthAlgo: "TUAK", AuthKey: key16, State: "ACTIVE", SubProfile: "default"},
+			expectedError: "expected TUAK auth opc to be 32 bytes but got 0 bytes",
+		},
+		{
+			sub:           &LteSubscription{AuthAlgo: "TUAK", AuthKey: key16[:15], AuthOpc: key32, State: "ACTIVE", SubProfile: "default"},
+			expectedError: "expected TUAK auth key to be 16 or 32 bytes but got 15 bytes",
+		},
+		{
+			sub:           &LteSubscription{AuthAlgo: "TUAK", AuthKey: key16, AuthOpc: key16, State: "ACTIVE", SubProfile: "default"},
+			expectedError: "expected TUAK auth opc to be 32 bytes but got 16 bytes",
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.sub.ValidateModel()
+		if err == nil {
+			assert.Equal(t, "", tc.expectedError)
+		} else {
+			assert.Equal(t, err.Error(), tc.expectedError)
+		}
+	}
+}
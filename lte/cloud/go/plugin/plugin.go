@@ -17,6 +17,7 @@ import (
 	subscriberStreamer "magma/lte/cloud/go/services/subscriberdb/streamer"
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/plugin"
+	orc8rhandlers "magma/orc8r/cloud/go/pluginimpl/handlers"
 	"magma/orc8r/cloud/go/registry"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/service/config"
@@ -44,7 +45,7 @@ func (*LteOrchestratorPlugin) GetServices() []registry.ServiceLocation {
 
 func (*LteOrchestratorPlugin) GetSerdes() []serde.Serde {
 	return []serde.Serde{
-		state.NewStateSerde(lte.EnodebStateType, &lteModels.EnodebState{}),
+		state.NewGatewayBoundStateSerde(lte.EnodebStateType, &lteModels.EnodebState{}, lte.EnodebStateTTL),
 
 		// Configurator serdes
 		configurator.NewNetworkConfigSerde(lte.CellularNetworkType, &lteModels.NetworkCellularConfigs{}),
@@ -71,6 +72,7 @@ func (*LteOrchestratorPlugin) GetMetricsProfiles(metricsConfig *config.ConfigMap
 }
 
 func (*LteOrchestratorPlugin) GetObsidianHandlers(metricsConfig *config.ConfigMap) []obsidian.Handler {
+	orc8rhandlers.RegisterSearchableEntityTypes(lte.CellularEnodebType, subscriberdb.EntityType)
 	return plugin.FlattenHandlerLists(
 		handlers.GetHandlers(),
 	)
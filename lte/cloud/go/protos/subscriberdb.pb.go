@@ -156,14 +156,17 @@ type LTESubscription_LTEAuthAlgo int32
 
 const (
 	LTESubscription_MILENAGE LTESubscription_LTEAuthAlgo = 0
+	LTESubscription_TUAK     LTESubscription_LTEAuthAlgo = 1
 )
 
 var LTESubscription_LTEAuthAlgo_name = map[int32]string{
 	0: "MILENAGE",
+	1: "TUAK",
 }
 
 var LTESubscription_LTEAuthAlgo_value = map[string]int32{
 	"MILENAGE": 0,
+	"TUAK":     1,
 }
 
 func (x LTESubscription_LTEAuthAlgo) String() string {
@@ -421,9 +424,10 @@ func (m *GSMSubscription) GetAuthTuples() [][]byte {
 type LTESubscription struct {
 	State    LTESubscription_LTESubscriptionState `protobuf:"varint,1,opt,name=state,proto3,enum=magma.lte.LTESubscription_LTESubscriptionState" json:"state,omitempty"`
 	AuthAlgo LTESubscription_LTEAuthAlgo          `protobuf:"varint,2,opt,name=auth_algo,json=authAlgo,proto3,enum=magma.lte.LTESubscription_LTEAuthAlgo" json:"auth_algo,omitempty"`
-	// Authentication key (k).
+	// Authentication key (k). 128 bits, or 128 or 256 bits for TUAK.
 	AuthKey []byte `protobuf:"bytes,3,opt,name=auth_key,json=authKey,proto3" json:"auth_key,omitempty"`
-	// Operator configuration field (Op) signed with authentication key (k)
+	// Operator configuration field (Op) signed with authentication key (k).
+	// For TUAK this is the 256 bit TOPc.
 	AuthOpc              []byte   `protobuf:"bytes,4,opt,name=auth_opc,json=authOpc,proto3" json:"auth_opc,omitempty"`
 	AssignedBaseNames    []string `protobuf:"bytes,10,rep,name=assigned_base_names,json=assignedBaseNames,proto3" json:"assigned_base_names,omitempty"`
 	AssignedPolicies     []string `protobuf:"bytes,11,rep,name=assigned_policies,json=assignedPolicies,proto3" json:"assigned_policies,omitempty"`
@@ -1200,111 +1204,111 @@ func init() {
 func init() { proto.RegisterFile("lte/protos/subscriberdb.proto", fileDescriptor_d870e4203d378ec0) }
 
 var fileDescriptor_d870e4203d378ec0 = []byte{
-	// 1650 bytes of a gzipped FileDescriptorProto
+	// 1660 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x72, 0xe3, 0x48,
-	0x15, 0xf6, 0x5f, 0x7e, 0x7c, 0x9c, 0x1f, 0xa5, 0xc9, 0x4e, 0x1c, 0x67, 0x87, 0x0d, 0xda, 0x02,
-	0xb2, 0xbb, 0xac, 0x3d, 0xe5, 0x61, 0x97, 0x85, 0xad, 0x02, 0xe4, 0xd8, 0x93, 0x51, 0x61, 0x2b,
-	0xa6, 0xed, 0x64, 0x60, 0xb9, 0x50, 0xb5, 0xa5, 0x8e, 0x47, 0x15, 0xfd, 0x45, 0xdd, 0x9a, 0x4d,
-	0x1e, 0x84, 0x97, 0xe0, 0x05, 0xa8, 0xe2, 0x82, 0x47, 0xe0, 0x92, 0x1b, 0xee, 0x79, 0x0d, 0xa8,
-	0x6e, 0x4b, 0xb6, 0xe2, 0xc8, 0x66, 0x07, 0xaa, 0xb8, 0x4a, 0xf7, 0xf9, 0xeb, 0x3e, 0xdf, 0xf9,
-	0x74, 0xfa, 0x38, 0xf0, 0xdc, 0xe5, 0xb4, 0x15, 0x46, 0x01, 0x0f, 0x58, 0x8b, 0xc5, 0x13, 0x66,
-	0x45, 0xce, 0x84, 0x46, 0xf6, 0xa4, 0x29, 0x65, 0xa8, 0xea, 0x91, 0xa9, 0x47, 0x9a, 0x2e, 0xa7,
-	0x8d, 0xe3, 0x20, 0xb2, 0xbe, 0x8a, 0x52, 0x5b, 0x2b, 0xf0, 0xbc, 0xc0, 0x9f, 0x59, 0x35, 0x4e,
-	0xa7, 0x41, 0x30, 0x75, 0x93, 0x38, 0x93, 0xf8, 0xa6, 0x75, 0xe3, 0x50, 0xd7, 0x36, 0x3d, 0xc2,
-	0x6e, 0x67, 0x16, 0xea, 0x0d, 0xec, 0x8c, 0xe6, 0xd1, 0xf5, 0x2e, 0xda, 0x83, 0x92, 0x63, 0xd7,
-	0x8b, 0xa7, 0xc5, 0xb3, 0x2a, 0x2e, 0x39, 0x36, 0x6a, 0x43, 0x85, 0x3f, 0x84, 0xb4, 0x5e, 0x3a,
-	0x2d, 0x9e, 0xed, 0xb5, 0xbf, 0xdf, 0x9c, 0x1f, 0xdb, 0xcc, 0xba, 0x35, 0xf5, 0xee, 0xf8, 0x21,
-	0xa4, 0x58, 0xda, 0xaa, 0x08, 0x36, 0x67, 0x7b, 0xb4, 0x0d, 0x15, 0x7d, 0x30, 0xd2, 0x95, 0x82,
-	0xfa, 0x4b, 0xd8, 0xcf, 0x3a, 0x8c, 0x28, 0x47, 0x9f, 0x41, 0x85, 0x39, 0x36, 0xab, 0x17, 0x4f,
-	0xcb, 0x67, 0xb5, 0xf6, 0xd1, 0x8a, 0xd0, 0x58, 0x1a, 0xa9, 0x7f, 0x2e, 0xc1, 0xfe, 0xc5, 0x68,
-	0x90, 0x68, 0x42, 0xee, 0x04, 0x3e, 0xea, 0xc1, 0x06, 0xe3, 0x84, 0x53, 0x79, 0xdd, 0xbd, 0x76,
-	0x2b, 0x13, 0x61, 0xc9, 0x74, 0x79, 0x3f, 0x12, 0x6e, 0x78, 0xe6, 0x8d, 0xce, 0xa1, 0x4a, 0x62,
-	0xfe, 0xd6, 0x24, 0xee, 0x34, 0x48, 0xf2, 0xfc, 0xd1, 0xfa, 0x50, 0x5a, 0xcc, 0xdf, 0x6a, 0xee,
-	0x34, 0xc0, 0xdb, 0x24, 0x59, 0xa1, 0x63, 0x90, 0x6b, 0xf3, 0x96, 0x3e, 0xd4, 0xcb, 0xa7, 0xc5,
-	0xb3, 0x1d, 0xbc, 0x25, 0xf6, 0xbf, 0xa1, 0x0f, 0xe8, 0x23, 0xa8, 0x49, 0x15, 0x8f, 0x43, 0x97,
-	0xb2, 0x7a, 0xe5, 0xb4, 0x7c, 0xb6, 0x83, 0x41, 0x88, 0xc6, 0x52, 0xa2, 0xbe, 0x80, 0xc3, 0xbc,
-	0xfb, 0xa1, 0x1d, 0xd8, 0xd6, 0x0d, 0xed, 0x7c, 0xac, 0x5f, 0xf7, 0x94, 0x02, 0x02, 0xd8, 0x4c,
-	0xd6, 0x45, 0xf5, 0x53, 0xa8, 0x65, 0xae, 0x81, 0x4e, 0xe0, 0x68, 0x88, 0x7b, 0xe7, 0x97, 0x83,
-	0xe1, 0xd5, 0xb8, 0xd7, 0x35, 0xb5, 0xab, 0xf1, 0x6b, 0x73, 0x7c, 0x35, 0xec, 0xf7, 0x46, 0x4a,
-	0x41, 0xfd, 0x57, 0x09, 0xf6, 0xfb, 0xe3, 0xde, 0x77, 0x45, 0x6e, 0xc9, 0x74, 0x79, 0xff, 0x3e,
-	0xc8, 0xe5, 0x84, 0x7a, 0x3f, 0xe4, 0x52, 0x55, 0x10, 0x5a, 0xf5, 0xca, 0x42, 0x75, 0x19, 0x5a,
-	0xa8, 0x09, 0xdf, 0x23, 0x8c, 0x39, 0x53, 0x9f, 0xda, 0xe6, 0x84, 0x30, 0x6a, 0xfa, 0xc4, 0xa3,
-	0xac, 0x0e, 0xa7, 0xe5, 0xb3, 0x2a, 0x3e, 0x48, 0x55, 0x1d, 0xc2, 0xa8, 0x21, 0x14, 0xe8, 0x33,
-	0x98, 0x0b, 0xcd, 0x30, 0x70, 0x1d, 0xcb, 0xa1, 0xac, 0x5e, 0x93, 0xd6, 0x4a, 0xaa, 0x18, 0x26,
-	0x72, 0x51, 0x90, 0xbc, 0xb4, 0xd7, 0x14, 0xe4, 0x04, 0x6a, 0x99, 0xec, 0x84, 0xe1, 0x40, 0xef,
-	0xf7, 0x0c, 0xed, 0xa2, 0xa7, 0x14, 0xd4, 0x3f, 0x15, 0xb3, 0xe4, 0x9f, 0x85, 0xfa, 0x04, 0x0e,
-	0x5c, 0x4e, 0x4d, 0x99, 0x9e, 0x4f, 0xef, 0xb9, 0xc9, 0xe8, 0x9d, 0xac, 0x46, 0x05, 0xef, 0xb9,
-	0x9c, 0x8a, 0x48, 0x06, 0xbd, 0xe7, 0x23, 0x7a, 0x87, 0x5a, 0x70, 0xc8, 0xa7, 0x61, 0x68, 0x12,
-	0x42, 0x4c, 0x46, 0xa3, 0x77, 0x34, 0x92, 0xc9, 0x4a, 0xc0, 0xab, 0xf8, 0x40, 0xe8, 0x34, 0x42,
-	0x46, 0x52, 0x23, 0x92, 0x45, 0x5f, 0x43, 0x63, 0xd9, 0x21, 0xa2, 0x53, 0x87, 0x71, 0x1a, 0x51,
-	0x5b, 0x62, 0xbc, 0x8d, 0x8f, 0x1e, 0xb9, 0xe1, 0xb9, 0x5a, 0xfd, 0x63, 0x05, 0x14, 0x6d, 0x68,
-	0x9c, 0x07, 0xfe, 0x8d, 0x33, 0x8d, 0x23, 0x22, 0xf9, 0xf2, 0x1c, 0xc0, 0x0a, 0x7c, 0x2e, 0xee,
-	0x99, 0x74, 0x87, 0x5d, 0x5c, 0x4d, 0x24, 0xba, 0x2d, 0xc0, 0x15, 0xe7, 0x38, 0x16, 0x35, 0x19,
-	0x75, 0xa9, 0x25, 0x7c, 0x92, 0xeb, 0x29, 0x89, 0x62, 0x94, 0xca, 0xd1, 0x05, 0xd4, 0xee, 0x02,
-	0x66, 0x86, 0x51, 0x70, 0xe3, 0xb8, 0x54, 0x5e, 0xa7, 0xf6, 0x88, 0x36, 0xcb, 0xa7, 0x37, 0x7f,
-	0x1b, 0x8c, 0x86, 0x33, 0x6b, 0x0c, 0x77, 0x01, 0x4b, 0xd6, 0xe8, 0x67, 0x50, 0x21, 0xde, 0x24,
-	0x92, 0xcc, 0xa8, 0xb5, 0x3f, 0xce, 0x46, 0x98, 0x4e, 0x23, 0x3a, 0x25, 0x9c, 0xda, 0x03, 0x72,
-	0xef, 0x78, 0xb1, 0xd7, 0x71, 0x78, 0x24, 0x78, 0x2b, 0x1d, 0xd0, 0x17, 0x50, 0x0e, 0x6d, 0xbf,
-	0xbe, 0x21, 0x09, 0xfb, 0xf1, 0xba, 0x93, 0x87, 0x5d, 0x43, 0xf6, 0x35, 0x61, 0xdf, 0xf8, 0x6b,
-	0x11, 0x60, 0x71, 0x15, 0x41, 0x4e, 0xcb, 0x25, 0x8c, 0xa5, 0x88, 0x6c, 0xe0, 0x2d, 0xb9, 0xd7,
-	0x6d, 0xf4, 0x43, 0xd8, 0x0b, 0x23, 0x27, 0x88, 0x1c, 0xfe, 0x60, 0xba, 0xf4, 0x1d, 0x75, 0x25,
-	0x18, 0xbb, 0x78, 0x37, 0x95, 0xf6, 0x85, 0x10, 0xbd, 0x84, 0x0f, 0xc2, 0x88, 0x52, 0x4f, 0x32,
-	0xcc, 0xb4, 0x48, 0x48, 0x26, 0x8e, 0xeb, 0xf0, 0x87, 0xa4, 0x44, 0x87, 0x0b, 0xe5, 0xf9, 0x5c,
-	0x87, 0x7e, 0x0e, 0xf5, 0x8c, 0xd3, 0xbb, 0xd8, 0xf5, 0x69, 0x94, 0xfa, 0x55, 0x66, 0xa5, 0x5d,
-	0xe8, 0xaf, 0xb3, 0x6a, 0xf5, 0x6b, 0xd8, 0x4a, 0x12, 0x92, 0x8d, 0x79, 0x78, 0xfd, 0x53, 0xa5,
-	0x90, 0xac, 0xbe, 0x54, 0x8a, 0x82, 0xcf, 0x42, 0x76, 0xfd, 0xa5, 0x52, 0x42, 0x0a, 0xec, 0x88,
-	0xb5, 0x79, 0x89, 0x4d, 0xa9, 0x2d, 0xab, 0x3e, 0xd4, 0x57, 0xc1, 0x8a, 0xce, 0x40, 0xf1, 0xc8,
-	0xbd, 0x39, 0x21, 0xbe, 0xfd, 0xad, 0x63, 0xf3, 0xb7, 0x66, 0xec, 0x26, 0x24, 0xd9, 0xf3, 0xc8,
-	0x7d, 0x27, 0x15, 0x5f, 0xb9, 0x4f, 0x2d, 0xed, 0x14, 0x9b, 0x47, 0x96, 0x5d, 0x57, 0xfd, 0x5b,
-	0x05, 0x90, 0x11, 0xf8, 0x2f, 0x2f, 0x86, 0xc3, 0x2b, 0x46, 0xa3, 0x14, 0xf5, 0x67, 0xb0, 0xe9,
-	0x31, 0x87, 0xd9, 0x7e, 0xf2, 0x46, 0x25, 0x3b, 0xf4, 0x0d, 0x20, 0x3f, 0xf0, 0xcd, 0x97, 0x82,
-	0xf7, 0x4e, 0x68, 0x12, 0xcb, 0xa2, 0x8c, 0x25, 0x3d, 0xe9, 0xf3, 0x4c, 0x89, 0x9f, 0x86, 0x4c,
-	0x45, 0xfa, 0x50, 0x93, 0x4e, 0x78, 0xdf, 0x0f, 0x7c, 0x11, 0x47, 0x0f, 0x67, 0x02, 0x64, 0xc3,
-	0xb3, 0xa7, 0xb1, 0x4d, 0x12, 0xfa, 0xb2, 0x50, 0x7b, 0xed, 0x17, 0xef, 0x15, 0x5f, 0x1b, 0x1a,
-	0x18, 0x2d, 0x1d, 0xa1, 0x85, 0xfe, 0x7f, 0x4f, 0xe7, 0x5f, 0x00, 0x90, 0xd0, 0x37, 0x2d, 0xc9,
-	0x5c, 0xc9, 0xea, 0x5a, 0xfb, 0x64, 0x0d, 0xab, 0x71, 0x95, 0x84, 0xfe, 0x4c, 0x82, 0x5e, 0xc1,
-	0x6e, 0x92, 0x8e, 0x4f, 0xe5, 0xb7, 0xbd, 0x29, 0x33, 0x52, 0xb3, 0xee, 0x52, 0x6f, 0x50, 0xfe,
-	0x6d, 0x10, 0xdd, 0xea, 0x36, 0xf5, 0xb9, 0x73, 0xe3, 0xd0, 0x08, 0xd7, 0x48, 0xaa, 0xd0, 0x6d,
-	0xf5, 0x1a, 0xf6, 0x97, 0xd2, 0x44, 0x3f, 0x80, 0xe7, 0xc6, 0xa5, 0x61, 0x0a, 0x99, 0x39, 0xba,
-	0xea, 0x8c, 0xce, 0xb1, 0x3e, 0x1c, 0xeb, 0x97, 0x86, 0xa9, 0xf5, 0xfb, 0x97, 0x6f, 0x7a, 0x5d,
-	0xa5, 0x80, 0x4e, 0xe1, 0xc3, 0x7c, 0x93, 0x8e, 0x86, 0x71, 0xaf, 0xab, 0x14, 0x55, 0x7d, 0x4e,
-	0x82, 0x0c, 0x7c, 0xa8, 0x0e, 0x87, 0x73, 0x3f, 0x6d, 0x68, 0x8c, 0xcc, 0x9e, 0xa1, 0x75, 0xfa,
-	0xa2, 0x27, 0x1f, 0xc3, 0x07, 0x8f, 0x35, 0x5d, 0x7d, 0x24, 0x55, 0x45, 0xf5, 0x1f, 0x25, 0xd8,
-	0x5b, 0x74, 0xe1, 0x2e, 0xe1, 0x04, 0x7d, 0x02, 0x65, 0x96, 0x7c, 0xbd, 0x6b, 0x06, 0x10, 0x61,
-	0x83, 0x7e, 0x02, 0xe5, 0x29, 0xf3, 0x24, 0xa1, 0x6a, 0xed, 0xc6, 0xea, 0xf1, 0x00, 0x0b, 0x33,
-	0x61, 0xed, 0xf2, 0xb4, 0xb7, 0x35, 0x56, 0x3f, 0x89, 0x58, 0x98, 0xa1, 0x2f, 0x00, 0xfc, 0x19,
-	0xbc, 0xa2, 0x02, 0xb3, 0xfa, 0x3f, 0x4b, 0x9c, 0xe4, 0x6c, 0xd7, 0x4c, 0xd1, 0xef, 0xe2, 0xaa,
-	0x9f, 0x16, 0x02, 0xbd, 0x48, 0x1f, 0xf1, 0x8d, 0x27, 0xc7, 0x2c, 0xbd, 0x36, 0xe9, 0x7b, 0xfd,
-	0x11, 0xd4, 0x58, 0x3c, 0x99, 0xb7, 0xde, 0x4d, 0xf9, 0x05, 0x01, 0x8b, 0x27, 0xe9, 0xd7, 0xf5,
-	0x15, 0x6c, 0xa7, 0x4c, 0xaf, 0x6f, 0xc9, 0xa8, 0xcf, 0xd7, 0x72, 0x1b, 0x6f, 0x25, 0x44, 0x56,
-	0xef, 0x40, 0x59, 0x1c, 0x7a, 0x15, 0xda, 0xe2, 0xb8, 0xcf, 0xa1, 0x62, 0x13, 0x4e, 0x12, 0x7c,
-	0x8f, 0x73, 0xef, 0x27, 0xea, 0x80, 0xa5, 0x19, 0x6a, 0x42, 0x45, 0x0c, 0xa6, 0x73, 0x8c, 0x67,
-	0xb3, 0x6b, 0x33, 0x9d, 0x5d, 0x9b, 0xaf, 0xc4, 0xec, 0x3a, 0x20, 0xec, 0x16, 0x4b, 0x3b, 0x75,
-	0x0a, 0x47, 0x3d, 0x9f, 0x4c, 0x5c, 0x2a, 0x72, 0x74, 0x2c, 0x1c, 0xbb, 0x14, 0xd3, 0xbb, 0x98,
-	0x32, 0x8e, 0x10, 0x54, 0x1c, 0x8f, 0x39, 0x49, 0x8f, 0x90, 0x6b, 0xd1, 0xaf, 0xa3, 0xd8, 0xa5,
-	0xa6, 0x18, 0x39, 0x4b, 0xf2, 0xe1, 0xdf, 0x12, 0x7b, 0xdd, 0x66, 0xe2, 0x79, 0xcb, 0xcc, 0x10,
-	0x65, 0xa9, 0xac, 0x4e, 0xd2, 0xd9, 0x41, 0x7d, 0x0b, 0xf5, 0xae, 0xc3, 0xfe, 0x1f, 0x27, 0xf1,
-	0x2c, 0x8a, 0xfd, 0x20, 0xb8, 0x8d, 0xc3, 0x25, 0x76, 0x14, 0xbf, 0x2b, 0x3b, 0x12, 0x6e, 0x97,
-	0xfe, 0x33, 0xb7, 0xd5, 0x3f, 0xc0, 0x87, 0x17, 0x94, 0x6b, 0xae, 0xbb, 0x54, 0x16, 0xca, 0xc2,
-	0xc0, 0x67, 0x62, 0x9e, 0xa8, 0x2d, 0x7e, 0x81, 0xa4, 0xf3, 0xfa, 0x9a, 0x72, 0x66, 0xad, 0x3f,
-	0x7d, 0x05, 0x47, 0x2b, 0x3a, 0x88, 0x78, 0x7a, 0x5e, 0xe3, 0xa1, 0x68, 0x04, 0x55, 0xd8, 0x78,
-	0xa3, 0x0f, 0xb4, 0xdf, 0x29, 0x45, 0x21, 0x7c, 0xd3, 0xd7, 0x0c, 0xa5, 0x24, 0x86, 0xa8, 0xde,
-	0xf8, 0x75, 0x0f, 0x1b, 0xbd, 0xb1, 0x52, 0x6e, 0xff, 0xa5, 0x08, 0x0d, 0x39, 0xa0, 0x3d, 0x68,
-	0x72, 0x5c, 0xf3, 0xa8, 0xcf, 0xcf, 0x03, 0x9f, 0x47, 0x81, 0xeb, 0xd2, 0x08, 0xf5, 0xe1, 0x60,
-	0x99, 0x0c, 0x0c, 0x65, 0xdb, 0xd8, 0x0a, 0xaa, 0x34, 0x0e, 0x1e, 0x41, 0x79, 0x1d, 0x38, 0xb6,
-	0x5a, 0x40, 0x06, 0xa0, 0x27, 0x15, 0x67, 0x28, 0xdb, 0x93, 0x57, 0x11, 0x22, 0x37, 0x5e, 0xfb,
-	0x9f, 0xa5, 0xec, 0xcf, 0xac, 0x6e, 0x07, 0xfd, 0x0a, 0x76, 0x35, 0xdb, 0x5e, 0x88, 0xd0, 0x6a,
-	0x38, 0xf3, 0x6f, 0xf8, 0x6b, 0x50, 0xba, 0xd4, 0xa5, 0x9c, 0x66, 0x62, 0xac, 0xaa, 0x72, 0x7e,
-	0x84, 0x2e, 0x28, 0xb3, 0xef, 0x34, 0x13, 0xe1, 0x24, 0x37, 0xc2, 0xcc, 0x2c, 0x3f, 0x8a, 0x0e,
-	0x07, 0x17, 0x94, 0x2f, 0xf5, 0xd5, 0x95, 0x17, 0x59, 0x9d, 0xa5, 0x5a, 0x40, 0x1d, 0xd8, 0xef,
-	0x3b, 0x2c, 0x13, 0x8b, 0xa1, 0xa7, 0x47, 0x36, 0x1a, 0x2b, 0x62, 0x8f, 0x28, 0x57, 0x0b, 0xed,
-	0xbf, 0x97, 0xe1, 0x59, 0x16, 0xe8, 0x0c, 0x43, 0xfe, 0x67, 0xc8, 0xbb, 0x39, 0x90, 0xe7, 0x03,
-	0x36, 0xfb, 0x72, 0xf3, 0xa3, 0x74, 0x72, 0x60, 0x7f, 0xdf, 0x9b, 0x0c, 0xf2, 0x40, 0x5f, 0x7b,
-	0x95, 0xb5, 0xc0, 0x5f, 0x3c, 0x05, 0x7e, 0x45, 0x83, 0x59, 0x8f, 0x3e, 0xfa, 0x3d, 0x1c, 0xe6,
-	0x35, 0x92, 0x95, 0xd1, 0x7e, 0x9c, 0x7d, 0x47, 0xd7, 0x74, 0x20, 0xb5, 0xd0, 0x39, 0xf9, 0xe6,
-	0x58, 0xda, 0xb6, 0x5c, 0x4e, 0x5b, 0x96, 0x1b, 0xc4, 0x76, 0x6b, 0x1a, 0x24, 0xff, 0xf3, 0x98,
-	0x6c, 0xca, 0xbf, 0x2f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xa6, 0x13, 0x8a, 0x34, 0x11,
-	0x00, 0x00,
+	0x15, 0x8e, 0x7f, 0xf2, 0xe3, 0xe3, 0xfc, 0x28, 0x4d, 0x76, 0xe2, 0x78, 0x76, 0xd8, 0xa0, 0xad,
+	0x85, 0xec, 0x2e, 0xeb, 0x4c, 0x79, 0xd8, 0x65, 0x61, 0xab, 0x00, 0x39, 0xf6, 0x64, 0x54, 0xeb,
+	0x28, 0xa2, 0xed, 0x64, 0x60, 0xb9, 0x50, 0xb5, 0xa5, 0x8e, 0x47, 0x15, 0xfd, 0x45, 0xdd, 0x9a,
+	0x4d, 0x1e, 0x81, 0x07, 0xe0, 0x25, 0x78, 0x01, 0xaa, 0xb8, 0xe0, 0x11, 0xb8, 0xe4, 0x86, 0x7b,
+	0x9e, 0x83, 0xea, 0x96, 0x14, 0x2b, 0x8e, 0x6c, 0x66, 0xa0, 0x8a, 0xab, 0xa8, 0xcf, 0x5f, 0xf7,
+	0xf9, 0xce, 0xd7, 0xa7, 0x4f, 0x0c, 0xcf, 0x3c, 0x4e, 0x8f, 0xa3, 0x38, 0xe4, 0x21, 0x3b, 0x66,
+	0xc9, 0x84, 0xd9, 0xb1, 0x3b, 0xa1, 0xb1, 0x33, 0xe9, 0x48, 0x19, 0x6a, 0xf8, 0x64, 0xea, 0x93,
+	0x8e, 0xc7, 0x69, 0xfb, 0x20, 0x8c, 0xed, 0xaf, 0xe3, 0xdc, 0xd6, 0x0e, 0x7d, 0x3f, 0x0c, 0x52,
+	0xab, 0xf6, 0xe1, 0x34, 0x0c, 0xa7, 0x5e, 0x16, 0x67, 0x92, 0x5c, 0x1d, 0x5f, 0xb9, 0xd4, 0x73,
+	0x2c, 0x9f, 0xb0, 0xeb, 0xd4, 0x42, 0xbd, 0x82, 0xcd, 0xd1, 0x7d, 0x74, 0xbd, 0x8f, 0xb6, 0xa1,
+	0xea, 0x3a, 0xad, 0xca, 0x61, 0xe5, 0xa8, 0x81, 0xab, 0xae, 0x83, 0xba, 0x50, 0xe7, 0x77, 0x11,
+	0x6d, 0x55, 0x0f, 0x2b, 0x47, 0xdb, 0xdd, 0x1f, 0x76, 0xee, 0xb7, 0xed, 0x14, 0xdd, 0x3a, 0x7a,
+	0x7f, 0x7c, 0x17, 0x51, 0x2c, 0x6d, 0x55, 0x04, 0x6b, 0xe9, 0x1a, 0x6d, 0x40, 0x5d, 0x3f, 0x1b,
+	0xe9, 0xca, 0x8a, 0xfa, 0x2b, 0xd8, 0x29, 0x3a, 0x8c, 0x28, 0x47, 0x9f, 0x43, 0x9d, 0xb9, 0x0e,
+	0x6b, 0x55, 0x0e, 0x6b, 0x47, 0xcd, 0xee, 0xfe, 0x82, 0xd0, 0x58, 0x1a, 0xa9, 0x7f, 0xa9, 0xc2,
+	0xce, 0xe9, 0xe8, 0x2c, 0xd3, 0x44, 0xdc, 0x0d, 0x03, 0x34, 0x80, 0x55, 0xc6, 0x09, 0xa7, 0xf2,
+	0xb8, 0xdb, 0xdd, 0xe3, 0x42, 0x84, 0x39, 0xd3, 0xf9, 0xf5, 0x48, 0xb8, 0xe1, 0xd4, 0x1b, 0x9d,
+	0x40, 0x83, 0x24, 0xfc, 0x8d, 0x45, 0xbc, 0x69, 0x98, 0xe5, 0xf9, 0xe3, 0xe5, 0xa1, 0xb4, 0x84,
+	0xbf, 0xd1, 0xbc, 0x69, 0x88, 0x37, 0x48, 0xf6, 0x85, 0x0e, 0x40, 0x7e, 0x5b, 0xd7, 0xf4, 0xae,
+	0x55, 0x3b, 0xac, 0x1c, 0x6d, 0xe2, 0x75, 0xb1, 0xfe, 0x96, 0xde, 0xa1, 0x8f, 0xa0, 0x29, 0x55,
+	0x3c, 0x89, 0x3c, 0xca, 0x5a, 0xf5, 0xc3, 0xda, 0xd1, 0x26, 0x06, 0x21, 0x1a, 0x4b, 0x89, 0xfa,
+	0x1c, 0xf6, 0xca, 0xce, 0x87, 0x36, 0x61, 0x43, 0x37, 0xb4, 0x93, 0xb1, 0x7e, 0x39, 0x50, 0x56,
+	0x10, 0xc0, 0x5a, 0xf6, 0x5d, 0x51, 0x3f, 0x83, 0x66, 0xe1, 0x18, 0xe8, 0x29, 0xec, 0x9b, 0x78,
+	0x70, 0x72, 0x7e, 0x66, 0x5e, 0x8c, 0x07, 0x7d, 0x4b, 0xbb, 0x18, 0xbf, 0xb2, 0xc6, 0x17, 0xe6,
+	0x70, 0x30, 0x52, 0x56, 0xd4, 0x3f, 0xd6, 0x60, 0x67, 0x38, 0x1e, 0xbc, 0x2b, 0x72, 0x73, 0xa6,
+	0xf3, 0xeb, 0xf7, 0x41, 0xae, 0x24, 0xd4, 0xfb, 0x21, 0x97, 0xab, 0xc2, 0xc8, 0x6e, 0xd5, 0x67,
+	0xaa, 0xf3, 0xc8, 0x46, 0x1d, 0xf8, 0x01, 0x61, 0xcc, 0x9d, 0x06, 0xd4, 0xb1, 0x26, 0x84, 0x51,
+	0x2b, 0x20, 0x3e, 0x65, 0x2d, 0x38, 0xac, 0x1d, 0x35, 0xf0, 0x6e, 0xae, 0xea, 0x11, 0x46, 0x0d,
+	0xa1, 0x40, 0x9f, 0xc3, 0xbd, 0xd0, 0x8a, 0x42, 0xcf, 0xb5, 0x5d, 0xca, 0x5a, 0x4d, 0x69, 0xad,
+	0xe4, 0x0a, 0x33, 0x93, 0x8b, 0x82, 0x94, 0xa5, 0xbd, 0xa4, 0x20, 0x9f, 0x40, 0xb3, 0x90, 0x9d,
+	0x30, 0x3c, 0xd3, 0x87, 0x03, 0x43, 0x3b, 0x15, 0x86, 0x1b, 0x50, 0x1f, 0x5f, 0x68, 0xdf, 0x2a,
+	0x15, 0xf5, 0xcf, 0x95, 0xe2, 0x35, 0x48, 0x83, 0x7e, 0x0a, 0xbb, 0x1e, 0xa7, 0x96, 0x4c, 0x34,
+	0xa0, 0xb7, 0xdc, 0x62, 0xf4, 0x46, 0xd6, 0xa5, 0x8e, 0xb7, 0x3d, 0x4e, 0x45, 0x4c, 0x83, 0xde,
+	0xf2, 0x11, 0xbd, 0x41, 0xc7, 0xb0, 0xc7, 0xa7, 0x51, 0x64, 0x11, 0x42, 0x2c, 0x46, 0xe3, 0xb7,
+	0x34, 0x96, 0x69, 0x4b, 0xe8, 0x1b, 0x78, 0x57, 0xe8, 0x34, 0x42, 0x46, 0x52, 0x23, 0xd2, 0x46,
+	0xdf, 0x40, 0x7b, 0xde, 0x21, 0xa6, 0x53, 0x97, 0x71, 0x1a, 0x53, 0x47, 0xa2, 0xbd, 0x81, 0xf7,
+	0x1f, 0xb8, 0xe1, 0x7b, 0xb5, 0xfa, 0xa7, 0x3a, 0x28, 0x9a, 0x69, 0x9c, 0x84, 0xc1, 0x95, 0x3b,
+	0x4d, 0x62, 0x22, 0x99, 0xf3, 0x0c, 0xc0, 0x0e, 0x03, 0x2e, 0xce, 0x99, 0xf5, 0x89, 0x2d, 0xdc,
+	0xc8, 0x24, 0xba, 0x23, 0x60, 0x16, 0xfb, 0xb8, 0x36, 0xb5, 0x18, 0xf5, 0xa8, 0x2d, 0x7c, 0xb2,
+	0xe3, 0x29, 0x99, 0x62, 0x94, 0xcb, 0xd1, 0x29, 0x34, 0x6f, 0x42, 0x66, 0x45, 0x71, 0x78, 0xe5,
+	0x7a, 0x54, 0x1e, 0xa7, 0xf9, 0x80, 0x40, 0xf3, 0xbb, 0x77, 0x7e, 0x1b, 0x8e, 0xcc, 0xd4, 0x1a,
+	0xc3, 0x4d, 0xc8, 0xb2, 0x6f, 0xf4, 0x73, 0xa8, 0x13, 0x7f, 0x12, 0x4b, 0x8e, 0x34, 0xbb, 0x1f,
+	0x17, 0x23, 0x4c, 0xa7, 0x31, 0x9d, 0x12, 0x4e, 0x9d, 0x33, 0x72, 0xeb, 0xfa, 0x89, 0xdf, 0x73,
+	0x79, 0x2c, 0x18, 0x2c, 0x1d, 0xd0, 0x97, 0x50, 0x8b, 0x9c, 0xa0, 0xb5, 0x2a, 0xa9, 0xfb, 0xf1,
+	0xb2, 0x9d, 0xcd, 0xbe, 0x21, 0x3b, 0x9c, 0xb0, 0x6f, 0xff, 0xad, 0x02, 0x30, 0x3b, 0x8a, 0xa0,
+	0xa9, 0xed, 0x11, 0xc6, 0x72, 0x44, 0x56, 0xf1, 0xba, 0x5c, 0xeb, 0x0e, 0xfa, 0x04, 0xb6, 0xa3,
+	0xd8, 0x0d, 0x63, 0x97, 0xdf, 0x59, 0x1e, 0x7d, 0x4b, 0x3d, 0x09, 0xc6, 0x16, 0xde, 0xca, 0xa5,
+	0x43, 0x21, 0x44, 0x2f, 0xe0, 0x83, 0x28, 0xa6, 0xd4, 0x97, 0x5c, 0xb3, 0x6c, 0x12, 0x91, 0x89,
+	0xeb, 0xb9, 0xfc, 0x2e, 0x2b, 0xd1, 0xde, 0x4c, 0x79, 0x72, 0xaf, 0x43, 0xbf, 0x80, 0x56, 0xc1,
+	0xe9, 0x6d, 0xe2, 0x05, 0x34, 0xce, 0xfd, 0xea, 0x69, 0x69, 0x67, 0xfa, 0xcb, 0xa2, 0x5a, 0xfd,
+	0x06, 0xd6, 0xb3, 0x84, 0x64, 0x8b, 0x36, 0x2f, 0x7f, 0x96, 0xd2, 0x54, 0x37, 0x2f, 0xbf, 0x52,
+	0x2a, 0x82, 0xd9, 0x42, 0x76, 0xf9, 0x95, 0x52, 0x45, 0x0a, 0x6c, 0x8a, 0x6f, 0xeb, 0x1c, 0x5b,
+	0x52, 0x5b, 0x53, 0x03, 0x68, 0x2d, 0x82, 0x15, 0x1d, 0x81, 0xe2, 0x93, 0x5b, 0x6b, 0x42, 0x02,
+	0xe7, 0x7b, 0xd7, 0xe1, 0x6f, 0xac, 0xc4, 0xcb, 0x48, 0xb2, 0xed, 0x93, 0xdb, 0x5e, 0x2e, 0xbe,
+	0xf0, 0x1e, 0x5b, 0x3a, 0x39, 0x36, 0x0f, 0x2c, 0xfb, 0x9e, 0xfa, 0xf7, 0x3a, 0x20, 0x23, 0x0c,
+	0x5e, 0x9c, 0x9a, 0xe6, 0x05, 0xa3, 0x71, 0x8e, 0xfa, 0x13, 0x58, 0xf3, 0x99, 0xcb, 0x9c, 0x20,
+	0x7b, 0xad, 0xb2, 0x15, 0xfa, 0x0e, 0x50, 0x10, 0x06, 0xd6, 0x0b, 0xc1, 0x7b, 0x37, 0xb2, 0x88,
+	0x6d, 0x53, 0xc6, 0xb2, 0xee, 0xf4, 0x45, 0xa1, 0xc4, 0x8f, 0x43, 0xe6, 0x22, 0xdd, 0xd4, 0xa4,
+	0x13, 0xde, 0x09, 0xc2, 0x40, 0xc4, 0xd1, 0xa3, 0x54, 0x80, 0x1c, 0x78, 0xf2, 0x38, 0xb6, 0x45,
+	0xa2, 0x40, 0x16, 0x6a, 0xbb, 0xfb, 0xfc, 0xbd, 0xe2, 0x6b, 0xa6, 0x81, 0xd1, 0xdc, 0x16, 0x5a,
+	0x14, 0xfc, 0xf7, 0x74, 0xfe, 0x25, 0x00, 0x89, 0x02, 0xcb, 0x96, 0xcc, 0x95, 0xac, 0x6e, 0x76,
+	0x9f, 0x2e, 0x61, 0x35, 0x6e, 0x90, 0x28, 0x48, 0x25, 0xe8, 0x25, 0x6c, 0x65, 0xe9, 0x04, 0x54,
+	0xde, 0xed, 0x35, 0x99, 0x91, 0x5a, 0x74, 0x97, 0x7a, 0x83, 0xf2, 0xef, 0xc3, 0xf8, 0x5a, 0x77,
+	0x68, 0xc0, 0xdd, 0x2b, 0x97, 0xc6, 0xb8, 0x49, 0x72, 0x85, 0xee, 0xa8, 0x97, 0xb0, 0x33, 0x97,
+	0x26, 0xfa, 0x11, 0x3c, 0x33, 0xce, 0x0d, 0x4b, 0xc8, 0xac, 0xd1, 0x45, 0x6f, 0x74, 0x82, 0x75,
+	0x73, 0xac, 0x9f, 0x1b, 0x96, 0x36, 0x1c, 0x9e, 0xbf, 0x1e, 0xf4, 0x95, 0x15, 0x74, 0x08, 0x1f,
+	0x96, 0x9b, 0xf4, 0x34, 0x8c, 0x07, 0x7d, 0xa5, 0xa2, 0xea, 0x80, 0xe6, 0xe2, 0x6a, 0xa6, 0x81,
+	0x5a, 0xb0, 0x77, 0xef, 0xa7, 0x99, 0xc6, 0xc8, 0x1a, 0x18, 0x5a, 0x6f, 0x28, 0x9a, 0xee, 0x01,
+	0x7c, 0xf0, 0x50, 0xd3, 0xd7, 0x47, 0x52, 0x55, 0x51, 0xff, 0x59, 0x85, 0xed, 0x59, 0x17, 0xee,
+	0x13, 0x4e, 0xd0, 0xa7, 0x50, 0x63, 0xd9, 0xed, 0x5d, 0x32, 0x8a, 0x08, 0x1b, 0xf4, 0x53, 0xa8,
+	0x4d, 0x99, 0x2f, 0x09, 0xd5, 0xec, 0xb6, 0x17, 0x0f, 0x0a, 0x58, 0x98, 0x09, 0x6b, 0x8f, 0xe7,
+	0xbd, 0xad, 0xbd, 0xf8, 0x71, 0xc4, 0xc2, 0x0c, 0x7d, 0x09, 0x10, 0xa4, 0xf0, 0x8a, 0x0a, 0xa4,
+	0xf5, 0x7f, 0x92, 0x39, 0xc9, 0x29, 0xaf, 0x93, 0xa3, 0xdf, 0xc7, 0x8d, 0x20, 0x2f, 0x04, 0x7a,
+	0x9e, 0x3f, 0xe7, 0xab, 0x8f, 0xb6, 0x99, 0x7b, 0x6d, 0xf2, 0x97, 0xfb, 0x23, 0x68, 0xb2, 0x64,
+	0x72, 0xdf, 0x7a, 0xd7, 0xe4, 0x0d, 0x02, 0x96, 0x4c, 0xf2, 0xdb, 0xf5, 0x35, 0x6c, 0xe4, 0x4c,
+	0x6f, 0xad, 0xcb, 0xa8, 0xcf, 0x96, 0x72, 0x1b, 0xaf, 0x67, 0x44, 0x56, 0x6f, 0x40, 0x99, 0x6d,
+	0x7a, 0x11, 0x39, 0x62, 0xbb, 0x2f, 0xa0, 0xee, 0x10, 0x4e, 0x32, 0x7c, 0x0f, 0x4a, 0xcf, 0x27,
+	0xea, 0x80, 0xa5, 0x19, 0xea, 0x40, 0x5d, 0x8c, 0xa8, 0xf7, 0x18, 0xa7, 0x53, 0x6c, 0x27, 0x9f,
+	0x62, 0x3b, 0x2f, 0xc5, 0x14, 0x7b, 0x46, 0xd8, 0x35, 0x96, 0x76, 0xea, 0x14, 0xf6, 0x07, 0x01,
+	0x99, 0x78, 0x54, 0xe4, 0xe8, 0xda, 0x38, 0xf1, 0x28, 0xa6, 0x37, 0x09, 0x65, 0x1c, 0x21, 0xa8,
+	0xbb, 0x3e, 0x73, 0xb3, 0x1e, 0x21, 0xbf, 0x45, 0xbf, 0x8e, 0x13, 0x8f, 0x5a, 0x62, 0xf8, 0xac,
+	0xca, 0x11, 0x60, 0x5d, 0xac, 0x75, 0x87, 0x89, 0xe7, 0xad, 0x30, 0x4d, 0xd4, 0xa4, 0xb2, 0x31,
+	0xc9, 0xa7, 0x08, 0xf5, 0x0d, 0xb4, 0xfa, 0x2e, 0xfb, 0x7f, 0xec, 0xc4, 0x8b, 0x28, 0x0e, 0xc3,
+	0xf0, 0x3a, 0x89, 0xe6, 0xd8, 0x51, 0x79, 0x57, 0x76, 0x64, 0xdc, 0xae, 0xfe, 0x67, 0x6e, 0xab,
+	0x7f, 0x80, 0x0f, 0x4f, 0x29, 0xd7, 0x3c, 0x6f, 0xae, 0x2c, 0x94, 0x45, 0x61, 0xc0, 0xc4, 0x3c,
+	0xd1, 0x9c, 0xfd, 0x2f, 0x92, 0x4f, 0xee, 0x4b, 0xca, 0x59, 0xb4, 0xfe, 0xec, 0x25, 0xec, 0x2f,
+	0xe8, 0x20, 0xe2, 0xe9, 0x79, 0x85, 0x4d, 0xd1, 0x08, 0x1a, 0xb0, 0xfa, 0x5a, 0x3f, 0xd3, 0x7e,
+	0xa7, 0x54, 0x84, 0xf0, 0xf5, 0x50, 0x33, 0x94, 0xaa, 0x18, 0xa7, 0x06, 0xe3, 0x57, 0x03, 0x6c,
+	0x0c, 0xc6, 0x4a, 0xad, 0xfb, 0xd7, 0x0a, 0xb4, 0xe5, 0xa8, 0x76, 0xa7, 0xc9, 0xc1, 0xcd, 0xa7,
+	0x01, 0x3f, 0x09, 0x03, 0x1e, 0x87, 0x9e, 0x47, 0x63, 0x34, 0x84, 0xdd, 0x79, 0x32, 0x30, 0x54,
+	0x6c, 0x63, 0x0b, 0xa8, 0xd2, 0xde, 0x7d, 0x00, 0xe5, 0x65, 0xe8, 0x3a, 0xea, 0x0a, 0x32, 0x00,
+	0x3d, 0xaa, 0x38, 0x43, 0xc5, 0x9e, 0xbc, 0x88, 0x10, 0xa5, 0xf1, 0xba, 0xff, 0xaa, 0x16, 0xff,
+	0xe1, 0xea, 0xf7, 0xd0, 0xaf, 0x61, 0x4b, 0x73, 0x9c, 0x99, 0x08, 0x2d, 0x86, 0xb3, 0xfc, 0x84,
+	0xbf, 0x01, 0xa5, 0x4f, 0x3d, 0xca, 0x69, 0x21, 0xc6, 0xa2, 0x2a, 0x97, 0x47, 0xe8, 0x83, 0x92,
+	0xde, 0xd3, 0x42, 0x84, 0xa7, 0xa5, 0x11, 0x52, 0xb3, 0xf2, 0x28, 0x3a, 0xec, 0x9e, 0x52, 0x3e,
+	0xd7, 0x57, 0x17, 0x1e, 0x64, 0x71, 0x96, 0xea, 0x0a, 0xea, 0xc1, 0xce, 0xd0, 0x65, 0x85, 0x58,
+	0x0c, 0x3d, 0xde, 0xb2, 0xdd, 0x5e, 0x10, 0x7b, 0x44, 0xb9, 0xba, 0xd2, 0xfd, 0x47, 0x0d, 0x9e,
+	0x14, 0x81, 0x2e, 0x30, 0xe4, 0x7f, 0x86, 0xbc, 0x5f, 0x02, 0x79, 0x39, 0x60, 0xe9, 0xcd, 0x2d,
+	0x8f, 0xd2, 0x2b, 0x81, 0xfd, 0x7d, 0x4f, 0x72, 0x56, 0x06, 0xfa, 0xd2, 0xa3, 0x2c, 0x05, 0xfe,
+	0xf4, 0x31, 0xf0, 0x0b, 0x1a, 0xcc, 0x72, 0xf4, 0xd1, 0xef, 0x61, 0xaf, 0xac, 0x91, 0x2c, 0x8c,
+	0xf6, 0x93, 0xe2, 0x3b, 0xba, 0xa4, 0x03, 0xa9, 0x2b, 0xbd, 0xa7, 0xdf, 0x1d, 0x48, 0xdb, 0x63,
+	0xf1, 0x03, 0x89, 0xed, 0x85, 0x89, 0x73, 0x3c, 0x0d, 0xb3, 0x5f, 0x3f, 0x26, 0x6b, 0xf2, 0xef,
+	0x8b, 0x7f, 0x0f, 0x00, 0x28, 0x34, 0xf9, 0xdb, 0x3e, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -70,18 +70,18 @@ func (srv *EPSAuthServer) AuthenticationInformation(ctx context.Context, air *lt
 		return &lteprotos.AuthenticationInformationAnswer{ErrorCode: lteprotos.ErrorCode_AUTHENTICATION_DATA_UNAVAILABLE}, err
 	}
 
-	milenage, err := crypto.NewMilenageCipher(config.LteAuthAmf)
+	ciphers, err := NewAuthCiphers(config.LteAuthAmf)
 	if err != nil {
-		glog.V(2).Infof("could not create milenage cipher: %v", err.Error())
+		glog.V(2).Infof("could not create auth ciphers: %v", err.Error())
 		metrics.AuthErrors.Inc()
 		metrics.AuthErrorsByNetwork.With(prometheus.Labels{mcommon.NetworkLabelName: networkID}).Inc()
 		return &lteprotos.AuthenticationInformationAnswer{ErrorCode: lteprotos.ErrorCode_AUTHORIZATION_REJECTED},
-			status.Errorf(codes.FailedPrecondition, "Could not create milenage cipher: %s", err.Error())
+			status.Errorf(codes.FailedPrecondition, "Could not create auth ciphers: %s", err.Error())
 	}
 
 	vectors, lteAuthNextSeq, err := GenerateLteAuthVectors(
 		air.NumRequestedEutranVectors,
-		milenage,
+		ciphers,
 		subscriber,
 		air.VisitedPlmn,
 		config.LteAuthOp,
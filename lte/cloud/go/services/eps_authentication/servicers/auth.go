@@ -40,19 +40,65 @@ const (
 	maxSeqDelta = 1 << 28
 )
 
+// AuthCiphers are the ciphers of the LTE auth algorithms, keyed by algorithm.
+type AuthCiphers map[protos.LTESubscription_LTEAuthAlgo]crypto.AuthCipher
+
+// NewAuthCiphers creates the ciphers of all supported LTE auth algorithms.
+func NewAuthCiphers(amf []byte) (AuthCiphers, error) {
+	ciphers := AuthCiphers{}
+	for algo := range protos.LTESubscription_LTEAuthAlgo_name {
+		cipher, err := NewAuthCipher(protos.LTESubscription_LTEAuthAlgo(algo), amf)
+		if err != nil {
+			return nil, err
+		}
+		ciphers[protos.LTESubscription_LTEAuthAlgo(algo)] = cipher
+	}
+	return ciphers, nil
+}
+
+// Get returns the cipher of the auth algorithm, or an auth rejected error if
+// there is none.
+func (ciphers AuthCiphers) Get(algo protos.LTESubscription_LTEAuthAlgo) (crypto.AuthCipher, error) {
+	cipher, ok := ciphers[algo]
+	if !ok {
+		return nil, NewAuthRejectedError(fmt.Sprintf("No cipher for crypto algorithm: %v", algo))
+	}
+	return cipher, nil
+}
+
+// NewAuthCipher creates the cipher of an LTE auth algorithm.
+func NewAuthCipher(algo protos.LTESubscription_LTEAuthAlgo, amf []byte) (crypto.AuthCipher, error) {
+	switch algo {
+	case protos.LTESubscription_MILENAGE:
+		milenage, err := crypto.NewMilenageCipher(amf)
+		if err != nil {
+			return nil, err
+		}
+		return milenage, nil
+	case protos.LTESubscription_TUAK:
+		tuak, err := crypto.NewTuakCipher(amf)
+		if err != nil {
+			return nil, err
+		}
+		return tuak, nil
+	default:
+		return nil, fmt.Errorf("Unsupported crypto algorithm: %v", algo)
+	}
+}
+
 // GenerateLteAuthVectors generates at most `numVectors` lte auth vectors.
 // Inputs:
 //   numVectors: The maximum number of vectors to generate
-//   milenage: The cipher to use to generate the vector
+//   ciphers: The ciphers to generate the vectors with, the subscriber's auth algo selects one
 //   subscriber: The subscriber data for the subscriber we want to generate auth vectors for
 //   plmn: 24 bit network identifier
 //   authSqnInd: the IND of the current vector being generated
 // Returns: The E-UTRAN vectors and the next value to set the subscriber's LteAuthNextSeq to (or an error).
-func GenerateLteAuthVectors(numVectors uint32, milenage *crypto.MilenageCipher, subscriber *protos.SubscriberData, plmn, lteAuthOp []byte, authSqnInd uint64) ([]*crypto.EutranVector, uint64, error) {
+func GenerateLteAuthVectors(numVectors uint32, ciphers AuthCiphers, subscriber *protos.SubscriberData, plmn, lteAuthOp []byte, authSqnInd uint64) ([]*crypto.EutranVector, uint64, error) {
 	var vectors = make([]*crypto.EutranVector, 0, numVectors)
 	lteAuthNextSeq := subscriber.GetState().GetLteAuthNextSeq()
 	for i := uint32(0); i < numVectors; i++ {
-		vector, nextSeq, err := GenerateLteAuthVector(milenage, subscriber, plmn, lteAuthOp, authSqnInd)
+		vector, nextSeq, err := GenerateLteAuthVector(ciphers, subscriber, plmn, lteAuthOp, authSqnInd)
 		lteAuthNextSeq = nextSeq
 		if err != nil {
 			// If we have already generated an auth vector successfully, then we can
@@ -71,12 +117,12 @@ func GenerateLteAuthVectors(numVectors uint32, milenage *crypto.MilenageCipher,
 
 // GenerateLteAuthVector returns the lte auth vector for the subscriber.
 // Inputs:
-//   milenage: The cipher to use to generate the vector
+//   ciphers: The ciphers to generate the vector with, the subscriber's auth algo selects one
 //   subscriber: The subscriber data for the subscriber we want to generate auth vectors for
 //   plmn: 24 bit network identifier
 //   authSqnInd: the IND of the current vector being generated
 // Returns: A E-UTRAN vector and the next value to set the subscriber's LteAuthNextSeq to (or an error).
-func GenerateLteAuthVector(milenage *crypto.MilenageCipher, subscriber *protos.SubscriberData, plmn, lteAuthOp []byte, authSqnInd uint64) (*crypto.EutranVector, uint64, error) {
+func GenerateLteAuthVector(ciphers AuthCiphers, subscriber *protos.SubscriberData, plmn, lteAuthOp []byte, authSqnInd uint64) (*crypto.EutranVector, uint64, error) {
 	lte := subscriber.Lte
 	if err := ValidateLteSubscription(lte); err != nil {
 		return nil, 0, NewAuthRejectedError(err.Error())
@@ -84,6 +130,10 @@ func GenerateLteAuthVector(milenage *crypto.MilenageCipher, subscriber *protos.S
 	if subscriber.State == nil {
 		return nil, 0, NewAuthRejectedError("Subscriber data missing subscriber state")
 	}
+	cipher, err := ciphers.Get(lte.AuthAlgo)
+	if err != nil {
+		return nil, 0, err
+	}
 
 	opc, err := GetOrGenerateOpc(lte, lteAuthOp)
 	if err != nil {
@@ -91,7 +141,7 @@ func GenerateLteAuthVector(milenage *crypto.MilenageCipher, subscriber *protos.S
 	}
 
 	sqn := SeqToSqn(subscriber.State.LteAuthNextSeq, authSqnInd)
-	vector, err := milenage.GenerateEutranVector(lte.AuthKey, opc, sqn, plmn)
+	vector, err := cipher.GenerateEutranVector(lte.AuthKey, opc, sqn, plmn)
 	if err != nil {
 		return vector, 0, NewAuthRejectedError(err.Error())
 	}
@@ -119,7 +169,7 @@ func ResyncLteAuthSeq(subscriber *protos.SubscriberData, resyncInfo, lteAuthOp [
 	}
 
 	// Use dummy AMF for re-synchronization. See 3GPP TS 33.102 section 6.3.3.
-	milenage, err := crypto.NewMilenageCipher(make([]byte, crypto.ExpectedAmfBytes))
+	cipher, err := NewAuthCipher(lte.AuthAlgo, make([]byte, crypto.ExpectedAmfBytes))
 	if err != nil {
 		return 0, NewAuthDataUnavailableError(err.Error())
 	}
@@ -129,7 +179,7 @@ func ResyncLteAuthSeq(subscriber *protos.SubscriberData, resyncInfo, lteAuthOp [
 	if err != nil {
 		return 0, err
 	}
-	sqnMs, macS, err := milenage.GenerateResync(auts, subscriber.Lte.AuthKey, opc, rand)
+	sqnMs, macS, err := cipher.GenerateResync(auts, subscriber.Lte.AuthKey, opc, rand)
 	if err != nil {
 		return 0, NewAuthDataUnavailableError(err.Error())
 	}
@@ -163,7 +213,7 @@ func GetNextLteAuthSqnAfterResync(state *protos.SubscriberState, sqn uint64) (ui
 }
 
 // ValidateLteSubscription returns an error if and only if the lte proto is not
-// configured up to use the milenage authentication algorithm.
+// active or not configured to use a supported authentication algorithm.
 func ValidateLteSubscription(lte *protos.LTESubscription) error {
 	if lte == nil {
 		return fmt.Errorf("Subscriber data missing LTE subscription")
@@ -171,14 +221,18 @@ func ValidateLteSubscription(lte *protos.LTESubscription) error {
 	if lte.State != protos.LTESubscription_ACTIVE {
 		return fmt.Errorf("LTE Service not active")
 	}
-	if lte.AuthAlgo != protos.LTESubscription_MILENAGE {
+	if _, ok := protos.LTESubscription_LTEAuthAlgo_name[int32(lte.AuthAlgo)]; !ok {
 		return fmt.Errorf("Unsupported crypto algorithm: %v", lte.AuthAlgo)
 	}
 	return nil
 }
 
-// GetOrGenerateOpc returns lte.AuthOpc and generates if it isn't stored in the proto
+// GetOrGenerateOpc returns lte.AuthOpc and generates if it isn't stored in the proto.
+// lteAuthOp is a Milenage OP, so the TOPc of TUAK subscribers can't be generated.
 func GetOrGenerateOpc(lte *protos.LTESubscription, lteAuthOp []byte) ([]byte, error) {
+	if lte.GetAuthAlgo() == protos.LTESubscription_TUAK && len(lte.AuthOpc) == 0 {
+		return nil, NewAuthDataUnavailableError("TUAK subscriber is missing TOPc")
+	}
 	if lte == nil || len(lte.AuthOpc) == 0 {
 		opc, err := crypto.GenerateOpc(lte.AuthKey, lteAuthOp)
 		if err != nil {
@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 import (
+	"bytes"
 	"testing"
 
 	"magma/lte/cloud/go/crypto"
@@ -23,6 +24,10 @@ var (
 	defaultLteAuthOp  = []byte("\xcd\xc2\x02\xd5\x12> \xf6+mgj\xc7,\xb3\x18")
 	defaultLteAuthAmf = []byte("\x80\x00")
 	defaultAuthSqnInd = uint64(0)
+
+	// K and TOPc of 3GPP TS 35.232 test set 1
+	tuakKey  = bytes.Repeat([]byte{0xab}, 16)
+	tuakTopc = []byte("\xbd\x04\xd9S\x0e\x87Q<]\x83z\xc2\xad\x95F#\xa8\xe23\x0c\x11S\x05\xa7>\xb4]\x1f@\xcc\xcb\xff")
 )
 
 func TestSeqToSqn(t *testing.T) {
@@ -54,6 +59,15 @@ func TestGetOrGenerateOpc(t *testing.T) {
 	expectedOpc, err := crypto.GenerateOpc(lte.AuthKey, defaultLteAuthOp)
 	assert.NoError(t, err)
 	assert.Equal(t, expectedOpc[:], opc)
+
+	lte = &protos.LTESubscription{AuthAlgo: protos.LTESubscription_TUAK, AuthKey: tuakKey}
+	_, err = GetOrGenerateOpc(lte, defaultLteAuthOp)
+	assert.Exactly(t, NewAuthDataUnavailableError("TUAK subscriber is missing TOPc"), err)
+
+	lte.AuthOpc = tuakTopc
+	opc, err = GetOrGenerateOpc(lte, defaultLteAuthOp)
+	assert.NoError(t, err)
+	assert.Equal(t, tuakTopc, opc)
 }
 
 func TestGenerateLteAuthVector_MissingLTE(t *testing.T) {
@@ -61,7 +75,7 @@ func TestGenerateLteAuthVector_MissingLTE(t *testing.T) {
 	assert.NoError(t, err)
 
 	subscriber := &protos.SubscriberData{State: &protos.SubscriberState{}}
-	_, _, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
+	_, _, err = GenerateLteAuthVector(AuthCiphers{protos.LTESubscription_MILENAGE: milenage}, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
 	assert.Exactly(t, NewAuthRejectedError("Subscriber data missing LTE subscription"), err)
 }
 
@@ -75,7 +89,7 @@ func TestGenerateLteAuthVector_MissingSubscriberState(t *testing.T) {
 			AuthAlgo: protos.LTESubscription_MILENAGE,
 		},
 	}
-	_, _, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
+	_, _, err = GenerateLteAuthVector(AuthCiphers{protos.LTESubscription_MILENAGE: milenage}, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
 	assert.Exactly(t, NewAuthRejectedError("Subscriber data missing subscriber state"), err)
 }
 
@@ -90,7 +104,7 @@ func TestGenerateLteAuthVector_InactiveLTESubscription(t *testing.T) {
 		},
 		State: &protos.SubscriberState{},
 	}
-	_, _, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
+	_, _, err = GenerateLteAuthVector(AuthCiphers{protos.LTESubscription_MILENAGE: milenage}, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
 	assert.Exactly(t, NewAuthRejectedError("LTE Service not active"), err)
 }
 
@@ -105,7 +119,7 @@ func TestGenerateLteAuthVector_UnknownLTEAuthAlgo(t *testing.T) {
 		},
 		State: &protos.SubscriberState{},
 	}
-	_, _, err = GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
+	_, _, err = GenerateLteAuthVector(AuthCiphers{protos.LTESubscription_MILENAGE: milenage}, subscriber, defaultPlmn, defaultLteAuthOp, defaultAuthSqnInd)
 	assert.Exactly(t, NewAuthRejectedError("Unsupported crypto algorithm: 10"), err)
 }
 
@@ -124,7 +138,7 @@ func TestGenerateLteAuthVector_Success(t *testing.T) {
 		},
 		State: &protos.SubscriberState{LteAuthNextSeq: 229},
 	}
-	vector, lteAuthNextSeq, err := GenerateLteAuthVector(milenage, subscriber, defaultPlmn, defaultLteAuthOp, 23)
+	vector, lteAuthNextSeq, err := GenerateLteAuthVector(AuthCiphers{protos.LTESubscription_MILENAGE: milenage}, subscriber, defaultPlmn, defaultLteAuthOp, 23)
 	assert.NoError(t, err)
 	assert.Equal(t, uint64(230), lteAuthNextSeq)
 
@@ -134,6 +148,54 @@ func TestGenerateLteAuthVector_Success(t *testing.T) {
 	assert.Equal(t, []byte("\x87H\xc1\xc0\xa2\x82o\xa4\x05\xb1\xe2~\xa1\x04CJ\xe5V\xc7e\xe8\xf0a\xeb\xdb\x8a\xe2\x86\xc4F\x16\xc2"), vector.Kasme[:])
 }
 
+func TestGenerateLteAuthVector_Tuak(t *testing.T) {
+	rand := bytes.Repeat([]byte{0x42}, 16)
+	tuak, err := crypto.NewMockTuakCipher(defaultLteAuthAmf, rand)
+	assert.NoError(t, err)
+
+	subscriber := &protos.SubscriberData{
+		Sid: &protos.SubscriberID{Id: "sub1"},
+		Lte: &protos.LTESubscription{
+			State:    protos.LTESubscription_ACTIVE,
+			AuthAlgo: protos.LTESubscription_TUAK,
+			AuthKey:  tuakKey,
+			AuthOpc:  tuakTopc,
+		},
+		State: &protos.SubscriberState{LteAuthNextSeq: 229},
+	}
+	_, _, err = GenerateLteAuthVector(AuthCiphers{protos.LTESubscription_MILENAGE: tuak}, subscriber, defaultPlmn, defaultLteAuthOp, 23)
+	assert.Exactly(t, NewAuthRejectedError("No cipher for crypto algorithm: TUAK"), err)
+
+	vector, lteAuthNextSeq, err := GenerateLteAuthVector(AuthCiphers{protos.LTESubscription_TUAK: tuak}, subscriber, defaultPlmn, defaultLteAuthOp, 23)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(230), lteAuthNextSeq)
+
+	expected, err := tuak.GenerateEutranVector(tuakKey, tuakTopc, SeqToSqn(229, 23), defaultPlmn)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, vector)
+}
+
+func TestResyncLteAuthSeq_Tuak(t *testing.T) {
+	subscriber := &protos.SubscriberData{
+		Lte: &protos.LTESubscription{
+			State:    protos.LTESubscription_ACTIVE,
+			AuthAlgo: protos.LTESubscription_TUAK,
+			AuthKey:  tuakKey,
+			AuthOpc:  tuakTopc,
+		},
+		State: &protos.SubscriberState{LteAuthNextSeq: 1},
+	}
+	// AUTS of SQN_MS = SeqToSqn(0x1234, 0) under RAND = 0x42...42
+	resyncInfo := append(bytes.Repeat([]byte{0x42}, 16), []byte("\xe7\xafk?H\xb8\xf1\xd7T\x03\xbd\xf9\xc9r")...)
+	lteAuthNextSeq, err := ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0x1235), lteAuthNextSeq)
+
+	resyncInfo[len(resyncInfo)-1] ^= 0xFF
+	_, err = ResyncLteAuthSeq(subscriber, resyncInfo, defaultLteAuthOp)
+	assert.Exactly(t, NewAuthRejectedError("Invalid resync authentication code"), err)
+}
+
 func TestResyncLteAuthSeq(t *testing.T) {
 	subscriber := test_utils.GetTestSubscribers()[0]
 	lteAuthNextSeq, err := ResyncLteAuthSeq(subscriber, nil, defaultLteAuthOp)
@@ -198,6 +260,10 @@ func TestValidateLteSubscription(t *testing.T) {
 	}
 	err = ValidateLteSubscription(lte)
 	assert.NoError(t, err)
+
+	lte.AuthAlgo = protos.LTESubscription_TUAK
+	err = ValidateLteSubscription(lte)
+	assert.NoError(t, err)
 }
 
 func TestIsAllZero(t *testing.T) {
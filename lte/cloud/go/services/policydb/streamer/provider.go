@@ -22,6 +22,8 @@ import (
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/streamer/providers"
+	"magma/orc8r/cloud/go/storage"
 )
 
 const (
@@ -55,6 +57,23 @@ func (provider *PoliciesProvider) GetUpdates(gatewayId string, extraArgs *any.An
 	return rulesToUpdates(ruleProtos)
 }
 
+func (provider *PoliciesProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any, cursor string) (*providers.DeltaUpdates, error) {
+	return providers.GetConfiguratorDeltaUpdates(provider, gatewayId, extraArgs, cursor, loadChangedRules)
+}
+
+func loadChangedRules(networkID string, changes configurator.EntityChanges) ([]*protos.DataUpdate, []string, bool, error) {
+	ruleEnts, deletedKeys, err := loadChangedEntities(networkID, changes, lte.PolicyRuleEntityType, configurator.EntityLoadCriteria{LoadConfig: true})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	ruleProtos := make([]*lteProtos.PolicyRule, 0, len(ruleEnts))
+	for _, rule := range ruleEnts {
+		ruleProtos = append(ruleProtos, createRuleProtoFromEnt(rule))
+	}
+	updates, err := rulesToUpdates(ruleProtos)
+	return updates, deletedKeys, false, err
+}
+
 func createRuleProtoFromEnt(ruleEnt configurator.NetworkEntity) *lteProtos.PolicyRule {
 	if ruleEnt.Config == nil {
 		return &lteProtos.PolicyRule{Id: ruleEnt.Key}
@@ -98,6 +117,28 @@ func (provider *BaseNamesProvider) GetUpdates(gatewayId string, extraArgs *any.A
 		return nil, err
 	}
 
+	return bnsToUpdates(bnEntsToProtos(bnEnts))
+}
+
+func (provider *BaseNamesProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any, cursor string) (*providers.DeltaUpdates, error) {
+	return providers.GetConfiguratorDeltaUpdates(provider, gatewayId, extraArgs, cursor, loadChangedBaseNames)
+}
+
+func loadChangedBaseNames(networkID string, changes configurator.EntityChanges) ([]*protos.DataUpdate, []string, bool, error) {
+	bnEnts, deletedKeys, err := loadChangedEntities(
+		networkID,
+		changes,
+		lte.BaseNameEntityType,
+		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true},
+	)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	updates, err := bnsToUpdates(bnEntsToProtos(bnEnts))
+	return updates, deletedKeys, false, err
+}
+
+func bnEntsToProtos(bnEnts []configurator.NetworkEntity) []*lteProtos.ChargingRuleBaseNameRecord {
 	bnProtos := make([]*lteProtos.ChargingRuleBaseNameRecord, 0, len(bnEnts))
 	for _, bn := range bnEnts {
 		baseNameRecord := (&lteModels.BaseNameRecord{}).FromEntity(bn)
@@ -107,7 +148,7 @@ func (provider *BaseNamesProvider) GetUpdates(gatewayId string, extraArgs *any.A
 		}
 		bnProtos = append(bnProtos, bnProto)
 	}
-	return bnsToUpdates(bnProtos)
+	return bnProtos
 }
 
 func bnsToUpdates(bns []*lteProtos.ChargingRuleBaseNameRecord) ([]*protos.DataUpdate, error) {
@@ -169,6 +210,53 @@ func (r *RuleMappingsProvider) GetUpdates(gatewayId string, extraArgs *any.Any)
 	return ret, nil
 }
 
+// GetDeltaUpdates returns the policies assigned to the subscribers which
+// changed since the cursor. Assigning a policy or base name to a subscriber or
+// deleting it records a change to the subscriber, so the changed subscribers
+// are the only ones whose mappings can be affected.
+func (r *RuleMappingsProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any, cursor string) (*providers.DeltaUpdates, error) {
+	return providers.GetConfiguratorDeltaUpdates(r, gatewayId, extraArgs, cursor, r.loadChangedMappings)
+}
+
+func (r *RuleMappingsProvider) loadChangedMappings(networkID string, changes configurator.EntityChanges) ([]*protos.DataUpdate, []string, bool, error) {
+	subEnts, deletedKeys, err := loadChangedEntities(networkID, changes, lte.SubscriberEntityType, configurator.EntityLoadCriteria{LoadAssocsToThis: true})
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	ret := make([]*protos.DataUpdate, 0, len(subEnts))
+	for _, sub := range subEnts {
+		policies := &lteProtos.AssignedPolicies{}
+		for _, tk := range sub.ParentAssociations {
+			switch tk.Type {
+			case lte.PolicyRuleEntityType:
+				policies.AssignedPolicies = append(policies.AssignedPolicies, tk.Key)
+			case lte.BaseNameEntityType:
+				policies.AssignedBaseNames = append(policies.AssignedBaseNames, tk.Key)
+			}
+		}
+		// Subscribers without any policies aren't part of the stream
+		if len(policies.AssignedPolicies) == 0 && len(policies.AssignedBaseNames) == 0 {
+			deletedKeys = append(deletedKeys, sub.Key)
+			continue
+		}
+		if r.DeterministicReturn {
+			sort.Strings(policies.AssignedBaseNames)
+			sort.Strings(policies.AssignedPolicies)
+		}
+		marshaledPolicies, err := proto.Marshal(policies)
+		if err != nil {
+			return nil, nil, false, errors.Wrap(err, "failed to marshal active policies")
+		}
+		ret = append(ret, &protos.DataUpdate{Key: sub.Key, Value: marshaledPolicies})
+	}
+	if r.DeterministicReturn {
+		sortUpdates(ret)
+		sort.Strings(deletedKeys)
+	}
+	return ret, deletedKeys, false, nil
+}
+
 func (r *RuleMappingsProvider) getAssignedPoliciesBySid(policyRules []configurator.NetworkEntity, baseNames []configurator.NetworkEntity) (map[string]*lteProtos.AssignedPolicies, error) {
 	allEnts := make([]configurator.NetworkEntity, 0, len(policyRules)+len(baseNames))
 	allEnts = append(allEnts, policyRules...)
@@ -207,6 +295,33 @@ func (r *RuleMappingsProvider) getAssignedPoliciesBySid(policyRules []configurat
 	return policiesBySid, nil
 }
 
+// loadChangedEntities loads the changed entities of the given type, and
+// returns the keys of the ones which were deleted
+func loadChangedEntities(
+	networkID string,
+	changes configurator.EntityChanges,
+	entityType string,
+	criteria configurator.EntityLoadCriteria,
+) ([]configurator.NetworkEntity, []string, error) {
+	keys := providers.ChangedEntityKeys(changes, entityType)
+	if len(keys) == 0 {
+		return nil, []string{}, nil
+	}
+	ids := make([]storage.TypeAndKey, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, storage.TypeAndKey{Type: entityType, Key: key})
+	}
+	ents, notFound, err := configurator.LoadEntities(networkID, nil, nil, nil, ids, criteria)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to load changed entities of type %s", entityType)
+	}
+	deletedKeys := make([]string, 0, len(notFound))
+	for _, id := range notFound {
+		deletedKeys = append(deletedKeys, id.Key)
+	}
+	return ents, deletedKeys, nil
+}
+
 func sortUpdates(updates []*protos.DataUpdate) {
 	sort.Slice(updates, func(i, j int) bool { return updates[i].Key < updates[j].Key })
 }
@@ -245,3 +360,16 @@ func (r *NetworkWideRulesProvider) GetUpdates(gatewayId string, extraArgs *any.A
 	}
 	return []*protos.DataUpdate{{Key: "", Value: marshaledPolicies}}, nil
 }
+
+// GetDeltaUpdates resends the network wide rules whenever the network's
+// configs changed since the cursor
+func (r *NetworkWideRulesProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any, cursor string) (*providers.DeltaUpdates, error) {
+	return providers.GetConfiguratorDeltaUpdates(r, gatewayId, extraArgs, cursor, loadChangedNetworkWideRules)
+}
+
+func loadChangedNetworkWideRules(networkID string, changes configurator.EntityChanges) ([]*protos.DataUpdate, []string, bool, error) {
+	if changes.NetworkChanged {
+		return nil, nil, true, nil
+	}
+	return []*protos.DataUpdate{}, nil, false, nil
+}
@@ -140,6 +140,11 @@ func TestPolicyStreamers(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
 
+	delta, err := policyPro.GetDeltaUpdates("hw1", nil, "")
+	assert.NoError(t, err)
+	assert.True(t, delta.Resync)
+	assert.Equal(t, expected, delta.Updates)
+
 	bnPro := &pdbstreamer.BaseNamesProvider{}
 	expectedBNProtos := []*protos.ChargingRuleBaseNameRecord{
 		{Name: "b1", RuleNamesSet: &protos.ChargingRuleNameSet{RuleNames: []string{"r1", "r2"}}},
@@ -156,6 +161,34 @@ func TestPolicyStreamers(t *testing.T) {
 	actual, err = bnPro.GetUpdates("hw1", nil)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
+
+	// Unchanged rules and base names aren't sent again
+	bnDelta, err := bnPro.GetDeltaUpdates("hw1", nil, "")
+	assert.NoError(t, err)
+	assert.True(t, bnDelta.Resync)
+	_, err = configurator.UpdateEntity("n1", configurator.EntityUpdateCriteria{
+		Type: lte.PolicyRuleEntityType, Key: "r2",
+		NewConfig: &models.PolicyRuleConfig{Priority: swag.Uint32(43)},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, configurator.DeleteEntity("n1", lte.PolicyRuleEntityType, "r3"))
+
+	data, err := proto.Marshal(&protos.PolicyRule{Id: "r2", Priority: 43})
+	assert.NoError(t, err)
+	actualDelta, err := policyPro.GetDeltaUpdates("hw1", nil, delta.Cursor)
+	assert.NoError(t, err)
+	assert.False(t, actualDelta.Resync)
+	assert.Equal(t, []*orcprotos.DataUpdate{{Key: "r2", Value: data}}, actualDelta.Updates)
+	assert.Equal(t, []string{"r3"}, actualDelta.DeletedKeys)
+
+	// Deleting r3 changed the rule names of b2
+	data, err = proto.Marshal(&protos.ChargingRuleNameSet{})
+	assert.NoError(t, err)
+	actualDelta, err = bnPro.GetDeltaUpdates("hw1", nil, bnDelta.Cursor)
+	assert.NoError(t, err)
+	assert.False(t, actualDelta.Resync)
+	assert.Equal(t, []*orcprotos.DataUpdate{{Key: "b2", Value: data}}, actualDelta.Updates)
+	assert.Empty(t, actualDelta.DeletedKeys)
 }
 
 func TestRuleMappingsProvider(t *testing.T) {
@@ -211,6 +244,27 @@ func TestRuleMappingsProvider(t *testing.T) {
 	actual, err := mappingPro.GetUpdates("hw1", nil)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
+
+	// Only the mappings of subscribers whose policies changed are sent
+	delta, err := mappingPro.GetDeltaUpdates("hw1", nil, "")
+	assert.NoError(t, err)
+	assert.True(t, delta.Resync)
+	assert.Equal(t, expected, delta.Updates)
+
+	_, err = configurator.UpdateEntity("n1", configurator.EntityUpdateCriteria{
+		Type: lte.PolicyRuleEntityType, Key: "r2",
+		AssociationsToAdd: []storage.TypeAndKey{{Type: lte.SubscriberEntityType, Key: "s3"}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, configurator.DeleteEntity("n1", lte.SubscriberEntityType, "s1"))
+
+	data, err := proto.Marshal(&protos.AssignedPolicies{AssignedPolicies: []string{"r2"}})
+	assert.NoError(t, err)
+	actualDelta, err := mappingPro.GetDeltaUpdates("hw1", nil, delta.Cursor)
+	assert.NoError(t, err)
+	assert.False(t, actualDelta.Resync)
+	assert.Equal(t, []*orcprotos.DataUpdate{{Key: "s3", Value: data}}, actualDelta.Updates)
+	assert.Equal(t, []string{"s1"}, actualDelta.DeletedKeys)
 }
 
 func TestNetworkWideRulesProvider(t *testing.T) {
@@ -260,4 +314,25 @@ func TestNetworkWideRulesProvider(t *testing.T) {
 	actual, err := mappingPro.GetUpdates("hw1", nil)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
+
+	// Entity changes don't affect the network wide rules
+	delta, err := mappingPro.GetDeltaUpdates("hw1", nil, "")
+	assert.NoError(t, err)
+	assert.True(t, delta.Resync)
+	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: lte.PolicyRuleEntityType, Key: "r4"})
+	assert.NoError(t, err)
+	actualDelta, err := mappingPro.GetDeltaUpdates("hw1", nil, delta.Cursor)
+	assert.NoError(t, err)
+	assert.False(t, actualDelta.Resync)
+	assert.Empty(t, actualDelta.Updates)
+
+	// Network config changes resend them
+	config.NetworkWideRuleNames = []string{"r1", "r4"}
+	assert.NoError(t, configurator.UpdateNetworkConfig("n1", lte.NetworkSubscriberConfigType, config))
+	actualDelta, err = mappingPro.GetDeltaUpdates("hw1", nil, actualDelta.Cursor)
+	assert.NoError(t, err)
+	assert.True(t, actualDelta.Resync)
+	data, err := proto.Marshal(&protos.AssignedPolicies{AssignedBaseNames: []string{"b1", "b2"}, AssignedPolicies: []string{"r1", "r4"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*orcprotos.DataUpdate{{Value: data}}, actualDelta.Updates)
 }
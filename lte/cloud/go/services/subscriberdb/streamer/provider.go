@@ -16,6 +16,8 @@ import (
 	protos2 "magma/lte/cloud/go/protos"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/streamer/providers"
+	"magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
@@ -51,6 +53,49 @@ func (provider *SubscribersProvider) GetUpdates(gatewayId string, extraArgs *any
 	return subscribersToUpdates(subProtos)
 }
 
+// GetDeltaUpdates only returns the subscribers which changed since the
+// cursor, so gateways don't receive the whole subscriberdb on every poll
+func (provider *SubscribersProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any, cursor string) (*providers.DeltaUpdates, error) {
+	return providers.GetConfiguratorDeltaUpdates(provider, gatewayId, extraArgs, cursor, loadChangedSubscribers)
+}
+
+// loadChangedSubscribers loads the subscribers which changed. Changes to the
+// policies and base names assigned to a subscriber are recorded against the
+// subscriber as well, so no other entity types need to be looked at.
+func loadChangedSubscribers(networkID string, changes configurator.EntityChanges) ([]*protos.DataUpdate, []string, bool, error) {
+	keys := providers.ChangedEntityKeys(changes, lte.SubscriberEntityType)
+	if len(keys) == 0 {
+		return []*protos.DataUpdate{}, nil, false, nil
+	}
+	ids := make([]storage.TypeAndKey, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, storage.TypeAndKey{Type: lte.SubscriberEntityType, Key: key})
+	}
+	subEnts, notFound, err := configurator.LoadEntities(networkID, nil, nil, nil, ids, configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsToThis: true})
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	subProtos := make([]*protos2.SubscriberData, 0, len(subEnts))
+	for _, sub := range subEnts {
+		subProto, err := subscriberToMconfig(sub)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		subProto.NetworkId = &protos.NetworkID{Id: networkID}
+		subProtos = append(subProtos, subProto)
+	}
+	updates, err := subscribersToUpdates(subProtos)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	deletedKeys := make([]string, 0, len(notFound))
+	for _, id := range notFound {
+		deletedKeys = append(deletedKeys, id.Key)
+	}
+	return updates, deletedKeys, false, nil
+}
+
 func subscribersToUpdates(subs []*protos2.SubscriberData) ([]*protos.DataUpdate, error) {
 	ret := make([]*protos.DataUpdate, 0, len(subs))
 	for _, sub := range subs {
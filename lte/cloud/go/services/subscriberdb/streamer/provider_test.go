@@ -113,4 +113,42 @@ func TestSubscriberdbStreamer(t *testing.T) {
 	actual, err = pro.GetUpdates("hw1", nil)
 	assert.NoError(t, err)
 	assert.Equal(t, expected, actual)
+
+	// A delta without a cursor resyncs all subscribers
+	delta, err := pro.GetDeltaUpdates("hw1", nil, "")
+	assert.NoError(t, err)
+	assert.True(t, delta.Resync)
+	assert.Equal(t, expected, delta.Updates)
+
+	// No changes since the cursor
+	unchanged, err := pro.GetDeltaUpdates("hw1", nil, delta.Cursor)
+	assert.NoError(t, err)
+	assert.False(t, unchanged.Resync)
+	assert.Empty(t, unchanged.Updates)
+	assert.Empty(t, unchanged.DeletedKeys)
+	assert.Equal(t, delta.Cursor, unchanged.Cursor)
+
+	// Delta since the cursor only contains the updated subscriber and the
+	// deleted one
+	_, err = configurator.UpdateEntity("n1", configurator.EntityUpdateCriteria{
+		Type: lte.SubscriberEntityType, Key: "IMSI12345",
+		NewConfig: &models2.LteSubscription{State: "INACTIVE", SubProfile: "foo"},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, configurator.DeleteEntity("n1", lte.SubscriberEntityType, "IMSI67890"))
+
+	expectedProtos[0].Lte = &protos.LTESubscription{
+		State:             protos.LTESubscription_INACTIVE,
+		AssignedPolicies:  []string{"r1", "r2"},
+		AssignedBaseNames: []string{"bn1"},
+	}
+	expectedProtos[0].SubProfile = "foo"
+	data, err := proto.Marshal(expectedProtos[0])
+	assert.NoError(t, err)
+	actualDelta, err := pro.GetDeltaUpdates("hw1", nil, delta.Cursor)
+	assert.NoError(t, err)
+	assert.False(t, actualDelta.Resync)
+	assert.Equal(t, []*orcprotos.DataUpdate{{Key: "IMSI12345", Value: data}}, actualDelta.Updates)
+	assert.Equal(t, []string{"IMSI67890"}, actualDelta.DeletedKeys)
+	assert.NotEqual(t, delta.Cursor, actualDelta.Cursor)
 }
@@ -234,6 +234,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	}, nil
 }
 
+// ExecQuerier returns the underlying database/sql transaction of the Tx, for
+// running queries which ent doesn't generate within the same transaction.
+func (tx *Tx) ExecQuerier() sql.ExecQuerier {
+	return tx.config.driver.(*txDriver).tx.(*sql.Tx).ExecQuerier
+}
+
 // keys returns the keys/ids from the edge map.
 func keys(m map[int]struct{}) []int {
 	s := make([]int, 0, len(m))
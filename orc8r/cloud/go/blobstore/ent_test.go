@@ -122,3 +122,28 @@ func TestIntegration(t *testing.T) {
 	fact := blobstore.NewEntStorage("states", db, sqorc.GetSqlBuilder())
 	integration(t, fact)
 }
+
+func TestGetSQLTx(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	require.NoError(t, err)
+	fact := blobstore.NewEntStorage("states", db, sqorc.GetSqlBuilder())
+	require.NoError(t, fact.InitializeFactory())
+	store, err := fact.StartTransaction(nil)
+	require.NoError(t, err)
+
+	// Writes through the SQL transaction commit along with the blobs
+	tx, ok := blobstore.GetSQLTx(store)
+	require.True(t, ok)
+	_, err = tx.Exec("CREATE TABLE extra (k TEXT)")
+	require.NoError(t, err)
+	require.NoError(t, store.CreateOrUpdate("n1", []blobstore.Blob{{Type: "t", Key: "k", Value: []byte("v")}}))
+	require.NoError(t, store.Commit())
+	_, err = db.Exec("SELECT k FROM extra")
+	require.NoError(t, err)
+
+	store, err = blobstore.NewMemoryBlobStorageFactory().StartTransaction(nil)
+	require.NoError(t, err)
+	defer store.Rollback()
+	_, ok = blobstore.GetSQLTx(store)
+	require.False(t, ok)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package blobstore
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/storage"
+)
+
+// ErrHistoryNotEnabled is returned by GetManyAt when the blobstore was not
+// created with a HistoryPolicy.
+var ErrHistoryNotEnabled = errors.New("history is not enabled for this blobstore")
+
+// HistoryPolicy configures how much history a blobstore retains for each
+// blob. Every write and delete of a blob is recorded as a version; the most
+// recent version of a blob is always retained.
+type HistoryPolicy struct {
+	// MaxVersions is the number of most recent versions retained per blob,
+	// including the current one. 0 means no limit.
+	MaxVersions int
+	// MaxAge is how long a version is retained after it was written.
+	// 0 means no limit.
+	MaxAge time.Duration
+}
+
+// HistoricalBlobStorage is a TransactionalBlobStorage which can read blobs
+// as they were at a prior point in time.
+type HistoricalBlobStorage interface {
+	TransactionalBlobStorage
+
+	// GetManyAt loads and returns a collection of blobs matching the
+	// specified IDs, as they were at time `at`. If a blob did not exist at
+	// that time, or the history for that time has been pruned, the returned
+	// list will not have a corresponding Blob. Only committed writes are
+	// visible.
+	// If the blobstore was not created with a HistoryPolicy,
+	// ErrHistoryNotEnabled is returned.
+	GetManyAt(networkID string, ids []storage.TypeAndKey, at time.Time) ([]Blob, error)
+}
+
+// historyEntry is a single recorded version of a blob
+type historyEntry struct {
+	blob      Blob
+	deleted   bool
+	writtenAt int64
+}
+
+// pruneHistory returns the entries of a single blob's history which should
+// be retained under the policy at time now, given entries sorted from oldest
+// to newest.
+func (policy *HistoryPolicy) pruneHistory(entries []historyEntry, now time.Time) []historyEntry {
+	if len(entries) == 0 {
+		return entries
+	}
+	start := 0
+	if policy.MaxVersions > 0 && len(entries) > policy.MaxVersions {
+		start = len(entries) - policy.MaxVersions
+	}
+	if policy.MaxAge > 0 {
+		cutoff := toMillis(now.Add(-policy.MaxAge))
+		// Always retain the most recent entry
+		for start < len(entries)-1 && entries[start].writtenAt < cutoff {
+			start++
+		}
+	}
+	return entries[start:]
+}
+
+// getEntryAt returns the entry which was current at time `at`, given entries
+// sorted from oldest to newest.
+func getEntryAt(entries []historyEntry, at int64) (historyEntry, bool) {
+	idx := sort.Search(len(entries), func(i int) bool { return entries[i].writtenAt > at })
+	if idx == 0 {
+		return historyEntry{}, false
+	}
+	return entries[idx-1], true
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
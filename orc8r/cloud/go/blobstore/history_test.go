@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package blobstore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryBlobStorage_History(t *testing.T) {
+	historyIntegration(t, blobstore.NewMemoryBlobStorageFactoryWithHistory(blobstore.HistoryPolicy{MaxVersions: 3, MaxAge: time.Hour}))
+}
+
+func TestSqlBlobStorage_History(t *testing.T) {
+	// History is read outside the caller's transaction, which an in-memory
+	// sqlite3 db limited to a single connection can't serve
+	dir, err := ioutil.TempDir("", "blobstore_history")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	db, err := sqorc.Open("sqlite3", filepath.Join(dir, "history.db"))
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	builder := sqorc.GetSqlBuilder()
+	fact := blobstore.NewSQLHistoryFactory(
+		blobstore.NewSQLBlobStorageFactory("network_table", db, builder),
+		"network_table", db, builder,
+		blobstore.HistoryPolicy{MaxVersions: 3, MaxAge: time.Hour},
+	)
+	historyIntegration(t, fact)
+}
+
+func TestHistoryNotEnabled(t *testing.T) {
+	fact := blobstore.NewMemoryBlobStorageFactory()
+	store, err := fact.StartTransaction(nil)
+	assert.NoError(t, err)
+	_, err = store.(blobstore.HistoricalBlobStorage).GetManyAt("network", nil, time.Now())
+	assert.Equal(t, blobstore.ErrHistoryNotEnabled, err)
+	assert.NoError(t, store.Rollback())
+}
+
+func historyIntegration(t *testing.T, fact blobstore.BlobStorageFactory) {
+	assert.NoError(t, fact.InitializeFactory())
+	defer clock.GetUnfreezeClockDeferFunc(t)()
+
+	id1 := storage.TypeAndKey{Type: "t", Key: "k1"}
+	id2 := storage.TypeAndKey{Type: "t", Key: "k2"}
+	t0 := time.Unix(1000000, 0)
+
+	// k1 is written at t0, t0+1m, t0+2m, t0+3m; k2 at t0 and deleted at t0+2m
+	write(t, fact, t0, blobstore.Blob{Type: "t", Key: "k1", Value: []byte("v1")}, blobstore.Blob{Type: "t", Key: "k2", Value: []byte("a")})
+	write(t, fact, t0.Add(time.Minute), blobstore.Blob{Type: "t", Key: "k1", Value: []byte("v2")})
+	write(t, fact, t0.Add(2*time.Minute), blobstore.Blob{Type: "t", Key: "k1", Value: []byte("v3")})
+	del(t, fact, t0.Add(2*time.Minute), id2)
+
+	assert.Empty(t, getManyAt(t, fact, t0.Add(-time.Second), id1, id2))
+	assert.Equal(t,
+		[]blobstore.Blob{{Type: "t", Key: "k1", Value: []byte("v1"), Version: 0}, {Type: "t", Key: "k2", Value: []byte("a"), Version: 0}},
+		getManyAt(t, fact, t0, id1, id2),
+	)
+	assert.Equal(t,
+		[]blobstore.Blob{{Type: "t", Key: "k1", Value: []byte("v2"), Version: 1}, {Type: "t", Key: "k2", Value: []byte("a"), Version: 0}},
+		getManyAt(t, fact, t0.Add(90*time.Second), id1, id2),
+	)
+	assert.Equal(t,
+		[]blobstore.Blob{{Type: "t", Key: "k1", Value: []byte("v3"), Version: 2}},
+		getManyAt(t, fact, t0.Add(2*time.Minute), id1, id2),
+	)
+
+	// A 4th version of k1 prunes the oldest one (MaxVersions = 3)
+	write(t, fact, t0.Add(3*time.Minute), blobstore.Blob{Type: "t", Key: "k1", Value: []byte("v4")})
+	assert.Empty(t, getManyAt(t, fact, t0, id1))
+	assert.Equal(t,
+		[]blobstore.Blob{{Type: "t", Key: "k1", Value: []byte("v2"), Version: 1}},
+		getManyAt(t, fact, t0.Add(time.Minute), id1),
+	)
+
+	// Writing 2 hours later prunes everything older than 1 hour (MaxAge)
+	write(t, fact, t0.Add(2*time.Hour), blobstore.Blob{Type: "t", Key: "k1", Value: []byte("v5")})
+	assert.Empty(t, getManyAt(t, fact, t0.Add(3*time.Minute), id1))
+	assert.Equal(t,
+		[]blobstore.Blob{{Type: "t", Key: "k1", Value: []byte("v5"), Version: 4}},
+		getManyAt(t, fact, t0.Add(3*time.Hour), id1),
+	)
+
+	// The latest version is retained even when it is older than MaxAge
+	write(t, fact, t0.Add(5*time.Hour), blobstore.Blob{Type: "t", Key: "k2", Value: []byte("b")})
+	write(t, fact, t0.Add(10*time.Hour), blobstore.Blob{Type: "t", Key: "k3", Value: []byte("c")})
+	assert.Equal(t,
+		[]blobstore.Blob{{Type: "t", Key: "k1", Value: []byte("v5"), Version: 4}, {Type: "t", Key: "k2", Value: []byte("b"), Version: 0}},
+		getManyAt(t, fact, t0.Add(10*time.Hour), id1, id2),
+	)
+
+	// Rolled back writes aren't recorded
+	clock.SetAndFreezeClock(t, t0.Add(11*time.Hour))
+	store, err := fact.StartTransaction(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, store.CreateOrUpdate("network", []blobstore.Blob{{Type: "t", Key: "k1", Value: []byte("v6")}}))
+	assert.NoError(t, store.Rollback())
+	assert.Equal(t,
+		[]blobstore.Blob{{Type: "t", Key: "k1", Value: []byte("v5"), Version: 4}},
+		getManyAt(t, fact, t0.Add(11*time.Hour), id1),
+	)
+}
+
+// write writes the blobs at time `at`, then prunes the history if the
+// factory prunes it separately from writes
+func write(t *testing.T, fact blobstore.BlobStorageFactory, at time.Time, blobs ...blobstore.Blob) {
+	clock.SetAndFreezeClock(t, at)
+	store, err := fact.StartTransaction(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, store.CreateOrUpdate("network", blobs))
+	assert.NoError(t, store.Commit())
+	if historicalFact, ok := fact.(blobstore.HistoricalBlobStorageFactory); ok {
+		assert.NoError(t, historicalFact.PruneHistory())
+	}
+}
+
+func del(t *testing.T, fact blobstore.BlobStorageFactory, at time.Time, ids ...storage.TypeAndKey) {
+	clock.SetAndFreezeClock(t, at)
+	store, err := fact.StartTransaction(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Delete("network", ids))
+	assert.NoError(t, store.Commit())
+}
+
+func getManyAt(t *testing.T, fact blobstore.BlobStorageFactory, at time.Time, ids ...storage.TypeAndKey) []blobstore.Blob {
+	store, err := fact.StartTransaction(nil)
+	assert.NoError(t, err)
+	blobs, err := store.(blobstore.HistoricalBlobStorage).GetManyAt("network", ids, at)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+	return blobs
+}
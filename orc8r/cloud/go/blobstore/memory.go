@@ -13,7 +13,9 @@ import (
 	"fmt"
 	"sort"
 	"sync"
+	"time"
 
+	"magma/orc8r/cloud/go/clock"
 	magmaerrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/storage"
 
@@ -45,6 +47,10 @@ type blobTable map[tNetworkID]blobsByID
 
 type keySet map[string]interface{}
 
+// historyTable maps networkIDs to the recorded versions of each blob, sorted
+// from oldest to newest
+type historyTable map[tNetworkID]map[storage.TypeAndKey][]historyEntry
+
 type memoryBlobStorage struct {
 	// guards both transactionExists flag and changes
 	sync.RWMutex
@@ -60,11 +66,18 @@ type memoryBlobStorage struct {
 type sharedMemoryBlobTables struct {
 	*sync.RWMutex
 	table blobTable
+
+	// historyPolicy is nil if history is not enabled
+	historyPolicy *HistoryPolicy
+	history       historyTable
 }
 
 type memoryBlobStoreFactory struct {
 	sync.RWMutex
 	table blobTable
+
+	historyPolicy *HistoryPolicy
+	history       historyTable
 }
 
 type networkIDAndTK struct {
@@ -80,9 +93,22 @@ func NewMemoryBlobStorageFactory() BlobStorageFactory {
 	return &memoryBlobStoreFactory{table: blobTable{}}
 }
 
+// NewMemoryBlobStorageFactoryWithHistory returns a BlobStorageFactory
+// implementation backed by an in-memory map, which retains the history of
+// each blob according to the policy. The returned storage APIs implement
+// HistoricalBlobStorage.
+func NewMemoryBlobStorageFactoryWithHistory(policy HistoryPolicy) BlobStorageFactory {
+	return &memoryBlobStoreFactory{table: blobTable{}, historyPolicy: &policy, history: historyTable{}}
+}
+
 func (fact *memoryBlobStoreFactory) StartTransaction(opts *storage.TxOptions) (TransactionalBlobStorage, error) {
 	return &memoryBlobStorage{
-		shared:            sharedMemoryBlobTables{RWMutex: &fact.RWMutex, table: fact.table},
+		shared: sharedMemoryBlobTables{
+			RWMutex:       &fact.RWMutex,
+			table:         fact.table,
+			historyPolicy: fact.historyPolicy,
+			history:       fact.history,
+		},
 		transactionExists: true,
 		changes:           transactionTable{}}, nil
 }
@@ -265,6 +291,32 @@ func (store *memoryBlobStorage) IncrementVersion(networkID string, id storage.Ty
 	return nil
 }
 
+// GetManyAt looks up the committed history of each blob corresponding to the
+// ids and returns the version of each which was current at time `at`.
+func (store *memoryBlobStorage) GetManyAt(networkID string, ids []storage.TypeAndKey, at time.Time) ([]Blob, error) {
+	store.RLock()
+	defer store.RUnlock()
+
+	if err := store.validateTx(); err != nil {
+		return nil, err
+	}
+	if store.shared.historyPolicy == nil {
+		return nil, ErrHistoryNotEnabled
+	}
+
+	store.shared.RLock()
+	defer store.shared.RUnlock()
+	ret := []Blob{}
+	atMs := toMillis(at)
+	for _, id := range ids {
+		entry, ok := getEntryAt(store.shared.history[networkID][id], atMs)
+		if ok && !entry.deleted {
+			ret = append(ret, entry.blob)
+		}
+	}
+	return ret, nil
+}
+
 func (store *memoryBlobStorage) getExistingKeysInNetwork(networkID string, keySet keySet) ([]string, error) {
 	store.shared.RLock()
 	_, ok := store.shared.table[networkID]
@@ -348,14 +400,17 @@ func (store *memoryBlobStorage) validateTx() error {
 // shared map. Must be called with write lock on both local and shared maps.
 func (store *memoryBlobStorage) applyChangesToShared() error {
 	fact := store.shared.table
+	now := clock.Now()
 	for networkID, perNetworkChangeMap := range store.changes {
 		for id, change := range perNetworkChangeMap {
 			switch change.cType {
 			case Delete:
 				delete(fact[networkID], id)
+				store.recordHistoryUnsafe(networkID, historyEntry{blob: Blob{Type: id.Type, Key: id.Key}, deleted: true}, now)
 			case CreateOrUpdate:
 				fact.initializeNetworkTable(networkID)
 				fact[networkID][id] = change.blob
+				store.recordHistoryUnsafe(networkID, historyEntry{blob: change.blob}, now)
 			default:
 				return fmt.Errorf("This transcaction contains ill-formatted changes.")
 			}
@@ -364,6 +419,22 @@ func (store *memoryBlobStorage) applyChangesToShared() error {
 	return nil
 }
 
+// Appends an entry to the history of its blob and prunes that history, if
+// history is enabled. Must be called with write lock on shared map.
+func (store *memoryBlobStorage) recordHistoryUnsafe(networkID string, entry historyEntry, now time.Time) {
+	policy := store.shared.historyPolicy
+	if policy == nil {
+		return
+	}
+	history := store.shared.history
+	if _, ok := history[networkID]; !ok {
+		history[networkID] = map[storage.TypeAndKey][]historyEntry{}
+	}
+	id := entry.blob.toID()
+	entry.writtenAt = toMillis(now)
+	history[networkID][id] = policy.pruneHistory(append(history[networkID][id], entry), now)
+}
+
 // Must be called with write lock on change map.
 func (store *memoryBlobStorage) resetTransaction() {
 	store.transactionExists = false
@@ -387,3 +387,20 @@ func getSortedTypeAndKeys(blobsToChange map[storage.TypeAndKey]blobChange) []sto
 	sort.Slice(ret, func(i, j int) bool { return ret[i].String() < ret[j].String() })
 	return ret
 }
+
+// GetSQLTx returns the SQL transaction backing the blob storage, so callers
+// can write their own tables within the same transaction as the blobs. ok is
+// false if the storage isn't backed by a SQL transaction.
+func GetSQLTx(store TransactionalBlobStorage) (tx *sql.Tx, ok bool) {
+	switch s := store.(type) {
+	case *sqlBlobStorage:
+		return s.tx, s.tx != nil
+	case *entStorage:
+		tx, ok = s.ExecQuerier().(*sql.Tx)
+		return tx, ok
+	case *sqlHistoryStorage:
+		return GetSQLTx(s.TransactionalBlobStorage)
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,401 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package blobstore
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	histDeletedCol   = "deleted"
+	histWrittenAtCol = "written_at"
+
+	// historyPruneInterval is how often history which falls out of the
+	// policy is deleted from the history table
+	historyPruneInterval = 10 * time.Minute
+	// historyInsertBatchSize caps the number of rows per history insert
+	historyInsertBatchSize = 500
+)
+
+// HistoricalBlobStorageFactory is a BlobStorageFactory whose storage APIs
+// implement HistoricalBlobStorage.
+type HistoricalBlobStorageFactory interface {
+	BlobStorageFactory
+
+	// PruneHistory deletes the recorded history which falls out of the
+	// factory's HistoryPolicy.
+	PruneHistory() error
+}
+
+// NewSQLHistoryFactory wraps factory to retain the history of each blob
+// according to the policy, in a separate SQL table named after tableName.
+// The storages of factory must be backed by SQL transactions.
+//
+// Each transaction inserts the versions it wrote into the history table just
+// before it commits, so history commits or rolls back along with the writes
+// and point-in-time reads observe every committed write. History is pruned
+// every 10 minutes.
+func NewSQLHistoryFactory(factory BlobStorageFactory, tableName string, db *sql.DB, sqlBuilder sqorc.StatementBuilder, policy HistoryPolicy) HistoricalBlobStorageFactory {
+	fact := &sqlHistoryFactory{
+		BlobStorageFactory: factory,
+		tableName:          getHistoryTableName(tableName),
+		db:                 db,
+		builder:            sqlBuilder,
+		policy:             policy,
+	}
+	go fact.prunePeriodically()
+	return fact
+}
+
+type sqlHistoryFactory struct {
+	BlobStorageFactory
+	tableName string
+	db        *sql.DB
+	builder   sqorc.StatementBuilder
+	policy    HistoryPolicy
+}
+
+// networkHistoryEntry is a history entry of a blob of a network
+type networkHistoryEntry struct {
+	networkID string
+	historyEntry
+}
+
+func getHistoryTableName(tableName string) string {
+	return tableName + "_history"
+}
+
+func (fact *sqlHistoryFactory) InitializeFactory() error {
+	err := fact.BlobStorageFactory.InitializeFactory()
+	if err != nil {
+		return err
+	}
+
+	tx, err := fact.db.Begin()
+	if err != nil {
+		return err
+	}
+	err = fact.initHistoryTable(tx)
+	if err != nil {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			glog.Errorf("error rolling back transaction initializing blob history table: %s", err)
+		}
+		return err
+	}
+	return tx.Commit()
+}
+
+func (fact *sqlHistoryFactory) initHistoryTable(tx *sql.Tx) error {
+	_, err := fact.builder.CreateTable(fact.tableName).
+		IfNotExists().
+		Column(nidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(typeCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(keyCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(valCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(verCol).Type(sqorc.ColumnTypeInt).NotNull().Default(0).EndColumn().
+		Column(histDeletedCol).Type(sqorc.ColumnTypeBool).NotNull().Default(false).EndColumn().
+		Column(histWrittenAtCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+	_, err = fact.builder.CreateIndex(fact.tableName+"_lookup_idx").
+		IfNotExists().
+		On(fact.tableName).
+		Columns(nidCol, typeCol, keyCol, histWrittenAtCol).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func (fact *sqlHistoryFactory) StartTransaction(opts *storage.TxOptions) (TransactionalBlobStorage, error) {
+	store, err := fact.BlobStorageFactory.StartTransaction(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &sqlHistoryStorage{TransactionalBlobStorage: store, fact: fact, changes: map[string]map[storage.TypeAndKey]bool{}}, nil
+}
+
+func (fact *sqlHistoryFactory) PruneHistory() error {
+	candidates, err := fact.getPruneCandidates(clock.Now())
+	if err != nil {
+		return err
+	}
+	for _, candidate := range candidates {
+		err = fact.pruneBlobHistory(candidate.networkID, candidate.blob.toID(), clock.Now())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (fact *sqlHistoryFactory) prunePeriodically() {
+	for range time.Tick(historyPruneInterval) {
+		if err := fact.PruneHistory(); err != nil {
+			glog.Errorf("Failed to prune blob history: %v", err)
+		}
+	}
+}
+
+// insertHistory inserts the entries into the history table within tx
+func (fact *sqlHistoryFactory) insertHistory(tx *sql.Tx, entries []networkHistoryEntry) error {
+	for start := 0; start < len(entries); start += historyInsertBatchSize {
+		end := start + historyInsertBatchSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+		insertBuilder := fact.builder.Insert(fact.tableName).
+			Columns(nidCol, typeCol, keyCol, valCol, verCol, histDeletedCol, histWrittenAtCol)
+		for _, entry := range entries[start:end] {
+			insertBuilder = insertBuilder.Values(
+				entry.networkID, entry.blob.Type, entry.blob.Key, entry.blob.Value, entry.blob.Version, entry.deleted, entry.writtenAt,
+			)
+		}
+		_, err := insertBuilder.RunWith(tx).Exec()
+		if err != nil {
+			return errors.Wrap(err, "failed to record blob history")
+		}
+	}
+	return nil
+}
+
+// getPruneCandidates returns the blobs with more recorded versions than the
+// policy allows, or with versions older than its maximum age besides the
+// latest one.
+func (fact *sqlHistoryFactory) getPruneCandidates(now time.Time) ([]networkHistoryEntry, error) {
+	var having sq.Or
+	if fact.policy.MaxVersions > 0 {
+		having = append(having, sq.Expr("COUNT(*) > ?", fact.policy.MaxVersions))
+	}
+	if fact.policy.MaxAge > 0 {
+		cutoff := toMillis(now.Add(-fact.policy.MaxAge))
+		having = append(having, sq.Expr(fmt.Sprintf("(COUNT(*) > 1 AND MIN(%s) < ?)", histWrittenAtCol), cutoff))
+	}
+	if len(having) == 0 {
+		return nil, nil
+	}
+
+	rows, err := fact.builder.Select(nidCol, typeCol, keyCol).
+		From(fact.tableName).
+		GroupBy(nidCol, typeCol, keyCol).
+		Having(having).
+		RunWith(fact.db).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query blob history")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "getPruneCandidates")
+
+	var ret []networkHistoryEntry
+	for rows.Next() {
+		var nid, t, k string
+		if err = rows.Scan(&nid, &t, &k); err != nil {
+			return nil, err
+		}
+		ret = append(ret, networkHistoryEntry{networkID: nid, historyEntry: historyEntry{blob: Blob{Type: t, Key: k}}})
+	}
+	return ret, rows.Err()
+}
+
+func (fact *sqlHistoryFactory) pruneBlobHistory(networkID string, id storage.TypeAndKey, now time.Time) error {
+	idCondition := sq.And{
+		sq.Eq{nidCol: networkID},
+		sq.Eq{typeCol: id.Type},
+		sq.Eq{keyCol: id.Key},
+	}
+	rows, err := fact.builder.Select(histWrittenAtCol).
+		From(fact.tableName).
+		Where(idCondition).
+		OrderBy(histWrittenAtCol).
+		RunWith(fact.db).
+		Query()
+	if err != nil {
+		return errors.Wrap(err, "failed to query blob history")
+	}
+	var entries []historyEntry
+	for rows.Next() {
+		var writtenAt int64
+		if err = rows.Scan(&writtenAt); err != nil {
+			sqorc.CloseRowsLogOnError(rows, "pruneBlobHistory")
+			return err
+		}
+		entries = append(entries, historyEntry{writtenAt: writtenAt})
+	}
+	sqorc.CloseRowsLogOnError(rows, "pruneBlobHistory")
+
+	retained := fact.policy.pruneHistory(entries, now)
+	if len(retained) == len(entries) {
+		return nil
+	}
+	_, err = fact.builder.Delete(fact.tableName).
+		Where(sq.And{idCondition, sq.Lt{histWrittenAtCol: retained[0].writtenAt}}).
+		RunWith(fact.db).
+		Exec()
+	if err != nil {
+		return fmt.Errorf("failed to prune history of blob (%s, %s, %s): %s", networkID, id.Type, id.Key, err)
+	}
+	return nil
+}
+
+// sqlHistoryStorage tracks the blobs written and deleted in a transaction,
+// and records their final versions in the history table when it commits.
+type sqlHistoryStorage struct {
+	TransactionalBlobStorage
+	fact *sqlHistoryFactory
+
+	// changes holds the blobs changed in the transaction, keyed by network
+	// ID. The value is true if the blob was deleted.
+	changes map[string]map[storage.TypeAndKey]bool
+}
+
+func (store *sqlHistoryStorage) CreateOrUpdate(networkID string, blobs []Blob) error {
+	err := store.TransactionalBlobStorage.CreateOrUpdate(networkID, blobs)
+	if err != nil {
+		return err
+	}
+	store.trackChanges(networkID, getBlobIDs(blobs), false)
+	return nil
+}
+
+func (store *sqlHistoryStorage) Delete(networkID string, ids []storage.TypeAndKey) error {
+	err := store.TransactionalBlobStorage.Delete(networkID, ids)
+	if err != nil {
+		return err
+	}
+	store.trackChanges(networkID, ids, true)
+	return nil
+}
+
+func (store *sqlHistoryStorage) IncrementVersion(networkID string, id storage.TypeAndKey) error {
+	err := store.TransactionalBlobStorage.IncrementVersion(networkID, id)
+	if err != nil {
+		return err
+	}
+	store.trackChanges(networkID, []storage.TypeAndKey{id}, false)
+	return nil
+}
+
+// Commit reads the final version of every blob written in the transaction
+// with one query per network, and inserts those versions along with
+// tombstones for the deleted blobs into the history table before committing.
+func (store *sqlHistoryStorage) Commit() error {
+	var entries []networkHistoryEntry
+	for networkID, changes := range store.changes {
+		var writtenIDs []storage.TypeAndKey
+		for id, deleted := range changes {
+			if deleted {
+				entries = append(entries, networkHistoryEntry{
+					networkID:    networkID,
+					historyEntry: historyEntry{blob: Blob{Type: id.Type, Key: id.Key}, deleted: true},
+				})
+			} else {
+				writtenIDs = append(writtenIDs, id)
+			}
+		}
+		if len(writtenIDs) == 0 {
+			continue
+		}
+		blobs, err := store.TransactionalBlobStorage.GetMany(networkID, writtenIDs)
+		if err != nil {
+			store.TransactionalBlobStorage.Rollback()
+			return err
+		}
+		for _, blob := range blobs {
+			entries = append(entries, networkHistoryEntry{networkID: networkID, historyEntry: historyEntry{blob: blob}})
+		}
+	}
+
+	if len(entries) == 0 {
+		return store.TransactionalBlobStorage.Commit()
+	}
+	tx, ok := GetSQLTx(store.TransactionalBlobStorage)
+	if !ok {
+		store.TransactionalBlobStorage.Rollback()
+		return errors.New("blob history requires a SQL blob storage")
+	}
+	writtenAt := toMillis(clock.Now())
+	for i := range entries {
+		entries[i].writtenAt = writtenAt
+	}
+	err := store.fact.insertHistory(tx, entries)
+	if err != nil {
+		store.TransactionalBlobStorage.Rollback()
+		return err
+	}
+	return store.TransactionalBlobStorage.Commit()
+}
+
+// GetManyAt reads the history table for the latest version of each blob
+// written at or before `at`.
+func (store *sqlHistoryStorage) GetManyAt(networkID string, ids []storage.TypeAndKey, at time.Time) ([]Blob, error) {
+	if len(ids) == 0 {
+		return []Blob{}, nil
+	}
+	rows, err := store.fact.builder.Select(typeCol, keyCol, valCol, verCol, histDeletedCol).
+		From(store.fact.tableName).
+		Where(sq.And{
+			getWhereCondition(networkID, ids),
+			sq.LtOrEq{histWrittenAtCol: toMillis(at)},
+		}).
+		OrderBy(histWrittenAtCol).
+		RunWith(store.fact.db).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query blob history")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "GetManyAt")
+
+	// Rows are ordered by write time, so the last row seen for each blob is
+	// the one which was current at `at`
+	latestByID := map[storage.TypeAndKey]historyEntry{}
+	for rows.Next() {
+		var t, k string
+		var val []byte
+		var version uint64
+		var deleted bool
+		err = rows.Scan(&t, &k, &val, &version, &deleted)
+		if err != nil {
+			return nil, err
+		}
+		latestByID[storage.TypeAndKey{Type: t, Key: k}] = historyEntry{
+			blob:    Blob{Type: t, Key: k, Value: val, Version: version},
+			deleted: deleted,
+		}
+	}
+
+	ret := []Blob{}
+	for _, id := range ids {
+		entry, ok := latestByID[id]
+		if ok && !entry.deleted {
+			ret = append(ret, entry.blob)
+		}
+	}
+	return ret, nil
+}
+
+func (store *sqlHistoryStorage) trackChanges(networkID string, ids []storage.TypeAndKey, deleted bool) {
+	if _, ok := store.changes[networkID]; !ok {
+		store.changes[networkID] = map[storage.TypeAndKey]bool{}
+	}
+	for _, id := range ids {
+		store.changes[networkID][id] = deleted
+	}
+}
@@ -29,7 +29,6 @@ func GetHttpStatusCode(err error) int {
 		case codes.Unknown:
 		case codes.DeadlineExceeded:
 		case codes.ResourceExhausted:
-		case codes.Aborted:
 		case codes.OutOfRange:
 		case codes.Internal:
 		case codes.DataLoss:
@@ -43,6 +42,9 @@ func GetHttpStatusCode(err error) int {
 		case codes.NotFound:
 			return http.StatusNotFound
 
+		case codes.Aborted:
+			return http.StatusConflict
+
 		case codes.PermissionDenied:
 			return http.StatusForbidden
 
@@ -20,6 +20,22 @@ import (
 	"github.com/labstack/echo"
 )
 
+// operatorContextKey is the echo context key under which Middleware stores
+// the request's verified operator identity
+const operatorContextKey = "magma_request_operator"
+
+// GetVerifiedOperator returns the Identity of the request's Operator as
+// verified by the access Middleware. Handlers pass it to service APIs which
+// enforce per-entity permissions. nil is returned if the request didn't go
+// through the Middleware.
+func GetVerifiedOperator(c echo.Context) *protos.Identity {
+	if c == nil {
+		return nil
+	}
+	oper, _ := c.Get(operatorContextKey).(*protos.Identity)
+	return oper
+}
+
 // RequestOperator returns Identity of request's Operator (client)
 // If either the request is missing TLS certificate headers or the certificate's
 // SN is not found by Certifier or one of certificate & its identity checks fail
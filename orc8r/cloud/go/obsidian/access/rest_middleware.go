@@ -73,7 +73,8 @@ func Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 					c, http.StatusForbidden, "Access Denied (%s)", err)
 			}
 		}
-		// all good, call next handler
+		// all good, pass the operator through and call next handler
+		c.Set(operatorContextKey, oper)
 		if next != nil {
 			return next(c)
 		}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package obsidian
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	PageSizeQueryParam  = "page_size"
+	PageTokenQueryParam = "page_token"
+	KeyPrefixQueryParam = "key_prefix"
+
+	// NextPageTokenHeader is the response header of a paginated list
+	// endpoint which holds the token of the next page. The header is absent
+	// on the last page.
+	NextPageTokenHeader = "X-Magma-Next-Page-Token"
+)
+
+// PaginationParams are the query params of a list endpoint which supports
+// pagination and key prefix filtering
+type PaginationParams struct {
+	// PageSize is 0 if the request isn't paginated
+	PageSize  uint32
+	PageToken string
+	KeyPrefix string
+}
+
+// GetPaginationParams parses the pagination query params of the request.
+// Returns a status bad request HTTP error if a param is invalid.
+func GetPaginationParams(c echo.Context) (PaginationParams, *echo.HTTPError) {
+	ret := PaginationParams{
+		PageToken: c.QueryParam(PageTokenQueryParam),
+		KeyPrefix: c.QueryParam(KeyPrefixQueryParam),
+	}
+	if pageSize := c.QueryParam(PageSizeQueryParam); pageSize != "" {
+		parsed, err := strconv.ParseUint(pageSize, 10, 32)
+		if err != nil || parsed == 0 {
+			return ret, HttpError(fmt.Errorf("invalid %s %s", PageSizeQueryParam, pageSize), http.StatusBadRequest)
+		}
+		ret.PageSize = uint32(parsed)
+	}
+	if ret.PageToken != "" && ret.PageSize == 0 {
+		return ret, HttpError(fmt.Errorf("%s requires %s", PageTokenQueryParam, PageSizeQueryParam), http.StatusBadRequest)
+	}
+	return ret, nil
+}
+
+// SetNextPageToken sets the next page token header of the response, if
+// there is a next page
+func SetNextPageToken(c echo.Context, nextPageToken string) {
+	if nextPageToken != "" {
+		c.Response().Header().Set(NextPageTokenHeader, nextPageToken)
+	}
+}
@@ -8,6 +8,8 @@ LICENSE file in the root directory of this source tree.
 
 package orc8r
 
+import "time"
+
 const (
 	ModuleName string = "orc8r"
 
@@ -22,3 +24,14 @@ const (
 
 	DnsdNetworkType = "dnsd_network"
 )
+
+const (
+	// GatewayStateTTL is how long a gateway status stays valid after it was
+	// last reported. Gateways report their status every minute, so only
+	// decommissioned gateways outlive it.
+	GatewayStateTTL = 7 * 24 * time.Hour
+
+	// DirectoryRecordTTL is how long a directory record stays valid after
+	// both the UE's last attach and its gateway's last check-in.
+	DirectoryRecordTTL = 24 * time.Hour
+)
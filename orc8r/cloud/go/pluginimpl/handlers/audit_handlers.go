@@ -0,0 +1,86 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+)
+
+const (
+	entityTypeQueryParam = "entity_type"
+	operatorQueryParam   = "operator"
+	startQueryParam      = "start"
+	endQueryParam        = "end"
+	limitQueryParam      = "limit"
+)
+
+func listNetworkAuditEventsHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	filter, nerr := getAuditEventFilter(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	events, err := configurator.ListAuditEvents(networkID, filter)
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to load audit events"), http.StatusInternalServerError)
+	}
+	ret := make([]*models.AuditEvent, 0, len(events))
+	for _, event := range events {
+		ret = append(ret, (&models.AuditEvent{}).FromConfiguratorAuditEvent(event))
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func getAuditEventFilter(c echo.Context) (configurator.AuditEventFilter, *echo.HTTPError) {
+	ret := configurator.AuditEventFilter{}
+	if entityType := c.QueryParam(entityTypeQueryParam); entityType != "" {
+		ret.EntityType = &entityType
+	}
+	if operator := c.QueryParam(operatorQueryParam); operator != "" {
+		ret.Operator = &operator
+	}
+
+	var err error
+	if start := c.QueryParam(startQueryParam); start != "" {
+		ret.StartTime, err = strconv.ParseInt(start, 10, 64)
+		if err != nil {
+			return ret, obsidian.HttpError(fmt.Errorf("invalid %s query param: %v", startQueryParam, err), http.StatusBadRequest)
+		}
+	}
+	if end := c.QueryParam(endQueryParam); end != "" {
+		ret.EndTime, err = strconv.ParseInt(end, 10, 64)
+		if err != nil {
+			return ret, obsidian.HttpError(fmt.Errorf("invalid %s query param: %v", endQueryParam, err), http.StatusBadRequest)
+		}
+	}
+	if ret.StartTime > 0 && ret.EndTime > 0 && ret.StartTime > ret.EndTime {
+		return ret, obsidian.HttpError(fmt.Errorf("%s must not be after %s", startQueryParam, endQueryParam), http.StatusBadRequest)
+	}
+	if limit := c.QueryParam(limitQueryParam); limit != "" {
+		parsedLimit, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			return ret, obsidian.HttpError(fmt.Errorf("invalid %s query param: %v", limitQueryParam, err), http.StatusBadRequest)
+		}
+		ret.Limit = uint32(parsedLimit)
+	}
+	return ret, nil
+}
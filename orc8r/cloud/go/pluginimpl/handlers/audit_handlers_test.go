@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/pluginimpl/handlers"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListNetworkAuditEvents(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	test_init.StartTestService(t)
+	defer clock.UnfreezeClock(t)
+
+	clock.SetAndFreezeClock(t, time.Unix(1000, 0))
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	clock.SetAndFreezeClock(t, time.Unix(1001, 0))
+	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{
+		Type:   orc8r.UpgradeTierEntityType,
+		Key:    "t1",
+		Config: &models.Tier{ID: "t1", Name: "tier", Version: "1.0.0-0", Images: []*models.TierImage{}, Gateways: models.TierGateways{}},
+	})
+	assert.NoError(t, err)
+
+	events, err := configurator.ListAuditEvents("n1", configurator.AuditEventFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	tierEvent := (&models.AuditEvent{}).FromConfiguratorAuditEvent(events[0])
+	networkEvent := (&models.AuditEvent{}).FromConfiguratorAuditEvent(events[1])
+	assert.Equal(t, orc8r.UpgradeTierEntityType, tierEvent.EntityType)
+	assert.Equal(t, "t1", tierEvent.EntityKey)
+	assert.Equal(t, models.AuditEventActionCREATE, tierEvent.Action)
+	assert.NotEmpty(t, tierEvent.AfterDigest)
+	assert.Empty(t, networkEvent.EntityType)
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/networks/n1/audit"
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listEvents := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/audit", obsidian.GET).HandlerFunc
+
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot,
+		Handler:        listEvents,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.AuditEvent{tierEvent, networkEvent}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "?entity_type=" + orc8r.UpgradeTierEntityType
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.AuditEvent{tierEvent})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "?start=900&end=1000"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.AuditEvent{networkEvent})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "?limit=1"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.AuditEvent{tierEvent})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "?operator=bob"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.AuditEvent{})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "?start=yesterday"
+	tc.ExpectedStatus = 400
+	tc.ExpectedResult = nil
+	tc.ExpectedError = "invalid start query param: strconv.ParseInt: parsing \"yesterday\": invalid syntax"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "?start=1001&end=1000"
+	tc.ExpectedError = "start must not be after end"
+	tests.RunUnitTest(t, e, tc)
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/certifier"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListGatewayCertificatesHandler lists the active and revoked certificates,
+// which are not expired yet, of a gateway.
+func ListGatewayCertificatesHandler(c echo.Context) error {
+	gatewayIdentity, nerr := getGatewayCertificateIdentity(c)
+	if nerr != nil {
+		return nerr
+	}
+	activeCerts, err := getActiveGatewayCertificates(gatewayIdentity)
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to find certificates"), http.StatusInternalServerError)
+	}
+	revokedCerts, err := certifier.FindRevokedCertificates(gatewayIdentity)
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to find revoked certificates"), http.StatusInternalServerError)
+	}
+
+	ret := make([]*models.GatewayCertificate, 0, len(activeCerts)+len(revokedCerts))
+	ret = append(ret, activeCerts...)
+	for _, revokedCert := range revokedCerts {
+		ret = append(ret, (&models.GatewayCertificate{}).FromRevokedCertificate(revokedCert))
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+// RevokeGatewayCertificatesHandler revokes all active certificates of a
+// gateway. The gateway has to bootstrap again to get a new certificate.
+func RevokeGatewayCertificatesHandler(c echo.Context) error {
+	gatewayIdentity, nerr := getGatewayCertificateIdentity(c)
+	if nerr != nil {
+		return nerr
+	}
+	nerr = checkGatewayWritePermission(c)
+	if nerr != nil {
+		return nerr
+	}
+	sns, err := certifier.FindCertificates(gatewayIdentity)
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to find certificates"), http.StatusInternalServerError)
+	}
+	for _, sn := range sns {
+		err = certifier.RevokeCertificateSN(sn)
+		if err != nil {
+			return obsidian.HttpError(errors.Wrapf(err, "failed to revoke certificate %s", sn), http.StatusInternalServerError)
+		}
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RevokeGatewayCertificateHandler revokes an active certificate of a gateway.
+func RevokeGatewayCertificateHandler(c echo.Context) error {
+	gatewayIdentity, nerr := getGatewayCertificateIdentity(c)
+	if nerr != nil {
+		return nerr
+	}
+	nerr = checkGatewayWritePermission(c)
+	if nerr != nil {
+		return nerr
+	}
+	params, nerr := obsidian.GetParamValues(c, "serial_number")
+	if nerr != nil {
+		return nerr
+	}
+	sn := strings.ToUpper(strings.TrimLeft(params[0], "0"))
+
+	sns, err := certifier.FindCertificates(gatewayIdentity)
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to find certificates"), http.StatusInternalServerError)
+	}
+	if !funk.ContainsString(sns, sn) {
+		return echo.ErrNotFound
+	}
+	err = certifier.RevokeCertificateSN(sn)
+	if err != nil {
+		return obsidian.HttpError(errors.Wrapf(err, "failed to revoke certificate %s", sn), http.StatusInternalServerError)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// getGatewayCertificateIdentity returns the identity with which the
+// certificates of the gateway in the request URL are registered in certifier.
+func getGatewayCertificateIdentity(c echo.Context) (*protos.Identity, *echo.HTTPError) {
+	networkID, gatewayID, nerr := obsidian.GetNetworkAndGatewayIDs(c)
+	if nerr != nil {
+		return nil, nerr
+	}
+	ent, err := configurator.LoadEntityAs(
+		access.GetVerifiedOperator(c),
+		networkID, orc8r.MagmadGatewayType, gatewayID,
+		configurator.EntityLoadCriteria{},
+	)
+	switch {
+	case err == merrors.ErrNotFound:
+		return nil, echo.ErrNotFound
+	case err != nil:
+		return nil, obsidian.HttpError(errors.Wrap(err, "failed to load gateway"), http.StatusInternalServerError)
+	}
+	// certificates are bound to the hardware ID of the gateway
+	return protos.NewGatewayIdentity(ent.PhysicalID, networkID, gatewayID), nil
+}
+
+// checkGatewayWritePermission returns a 403 unless the operator may write the
+// gateway in the request URL, as revoking its certificates requires.
+func checkGatewayWritePermission(c echo.Context) *echo.HTTPError {
+	networkID, gatewayID, nerr := obsidian.GetNetworkAndGatewayIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := configurator.CheckEntityPermissionAs(
+		access.GetVerifiedOperator(c),
+		networkID, orc8r.MagmadGatewayType, gatewayID,
+		storage.ACL_WRITE,
+	)
+	switch {
+	case status.Code(err) == codes.PermissionDenied:
+		return obsidian.HttpError(err, http.StatusForbidden)
+	case err != nil:
+		return obsidian.HttpError(errors.Wrap(err, "failed to check gateway permissions"), http.StatusInternalServerError)
+	}
+	return nil
+}
+
+func getActiveGatewayCertificates(gatewayIdentity *protos.Identity) ([]*models.GatewayCertificate, error) {
+	sns, err := certifier.FindCertificates(gatewayIdentity)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(sns)
+	ret := make([]*models.GatewayCertificate, 0, len(sns))
+	for _, sn := range sns {
+		certInfo, err := certifier.GetCertificateIdentity(sn)
+		// expired certificates are not listed
+		if status.Code(err) == codes.OutOfRange {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, (&models.GatewayCertificate{}).FromCertificateInfo(sn, certInfo))
+	}
+	return ret, nil
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/pluginimpl/handlers"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/certifier"
+	certifierTestInit "magma/orc8r/cloud/go/services/certifier/test_init"
+	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGatewayCertificateHandlers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	test_init.StartTestService(t)
+	certifierTestInit.StartTestService(t)
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(
+		"n1",
+		[]configurator.NetworkEntity{
+			{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1"},
+			{Type: orc8r.MagmadGatewayType, Key: "g2", PhysicalID: "hw2"},
+		},
+	)
+	assert.NoError(t, err)
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/networks/n1/gateways"
+
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listCerts := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/certificates", obsidian.GET).HandlerFunc
+	revokeCerts := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/certificates", obsidian.DELETE).HandlerFunc
+	revokeCert := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/certificates/:serial_number", obsidian.DELETE).HandlerFunc
+
+	// empty case
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "/g1/certificates",
+		Handler:        listCerts,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.GatewayCertificate{}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// unknown gateway
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "/g3/certificates",
+		Handler:        listCerts,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g3"},
+		ExpectedStatus: 404,
+		ExpectedError:  "Not Found",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	sn1 := signGatewayCertificate(t, "hw1", "n1", "g1")
+	sn2 := signGatewayCertificate(t, "hw1", "n1", "g1")
+	snOther := signGatewayCertificate(t, "hw2", "n1", "g2")
+	info1, err := certifier.GetCertificateIdentity(sn1)
+	assert.NoError(t, err)
+	info2, err := certifier.GetCertificateIdentity(sn2)
+	assert.NoError(t, err)
+
+	tc.URL = testURLRoot + "/g1/certificates"
+	tc.ParamValues = []string{"n1", "g1"}
+	tc.ExpectedStatus = 200
+	tc.ExpectedError = ""
+	expected := []*models.GatewayCertificate{
+		(&models.GatewayCertificate{}).FromCertificateInfo(sn1, info1),
+		(&models.GatewayCertificate{}).FromCertificateInfo(sn2, info2),
+	}
+	// active certificates are listed by serial number
+	if sn1 > sn2 {
+		expected[0], expected[1] = expected[1], expected[0]
+	}
+	tc.ExpectedResult = tests.JSONMarshaler(expected)
+	tests.RunUnitTest(t, e, tc)
+
+	// certificates of other gateways can't be revoked through g1
+	tc = tests.Test{
+		Method:         "DELETE",
+		URL:            testURLRoot + "/g1/certificates/" + snOther,
+		Handler:        revokeCert,
+		ParamNames:     []string{"network_id", "gateway_id", "serial_number"},
+		ParamValues:    []string{"n1", "g1", snOther},
+		ExpectedStatus: 404,
+		ExpectedError:  "Not Found",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// revoke one certificate
+	tc.URL = testURLRoot + "/g1/certificates/" + sn1
+	tc.ParamValues = []string{"n1", "g1", sn1}
+	tc.ExpectedStatus = 204
+	tc.ExpectedError = ""
+	tests.RunUnitTest(t, e, tc)
+
+	revoked, err := certifier.FindRevokedCertificates(protos.NewGatewayIdentity("hw1", "", ""))
+	assert.NoError(t, err)
+	assert.Len(t, revoked, 1)
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "/g1/certificates",
+		Handler:        listCerts,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.GatewayCertificate{
+			(&models.GatewayCertificate{}).FromCertificateInfo(sn2, info2),
+			(&models.GatewayCertificate{}).FromRevokedCertificate(revoked[0]),
+		}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// revoke all certificates
+	tc = tests.Test{
+		Method:         "DELETE",
+		URL:            testURLRoot + "/g1/certificates",
+		Handler:        revokeCerts,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	sns, err := certifier.FindCertificates(protos.NewGatewayIdentity("hw1", "", ""))
+	assert.NoError(t, err)
+	assert.Empty(t, sns)
+	sns, err = certifier.FindCertificates(protos.NewGatewayIdentity("hw2", "", ""))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{snOther}, sns)
+	revoked, err = certifier.FindRevokedCertificates(protos.NewGatewayIdentity("hw1", "", ""))
+	assert.NoError(t, err)
+	assert.Len(t, revoked, 2)
+}
+
+func signGatewayCertificate(t *testing.T, hwID, networkID, gatewayID string) string {
+	csr, err := certifier_test_utils.CreateCSRForId(time.Hour*24, protos.NewGatewayIdentity(hwID, networkID, gatewayID))
+	assert.NoError(t, err)
+	cert, err := certifier.SignCSR(csr)
+	assert.NoError(t, err)
+	return cert.Sn.Sn
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/labstack/echo"
+)
+
+const dryRunQueryParam = "dry_run"
+
+// isDryRun returns true if the request sets the dry_run query param
+func isDryRun(c echo.Context) (bool, *echo.HTTPError) {
+	param := c.QueryParam(dryRunQueryParam)
+	if param == "" {
+		return false, nil
+	}
+	ret, err := strconv.ParseBool(param)
+	if err != nil {
+		return false, obsidian.HttpError(fmt.Errorf("invalid %s query param: %v", dryRunQueryParam, err), http.StatusBadRequest)
+	}
+	return ret, nil
+}
+
+// applyNetworkUpdates applies the updates on behalf of the request's operator
+// and responds with a 204. For dry runs, the updates are rolled back instead
+// and the response is a 200 with the report of their mconfig impact.
+func applyNetworkUpdates(c echo.Context, updates ...configurator.NetworkUpdateCriteria) error {
+	dryRun, nerr := isDryRun(c)
+	if nerr != nil {
+		return nerr
+	}
+	if dryRun {
+		report, err := configurator.DryRunUpdateNetworksAs(access.GetVerifiedOperator(c), updates)
+		if err != nil {
+			return obsidian.HttpError(err, http.StatusInternalServerError)
+		}
+		return c.JSON(http.StatusOK, (&models.MconfigImpactReport{}).FromConfiguratorReport(report))
+	}
+
+	err := configurator.UpdateNetworksAs(access.GetVerifiedOperator(c), updates)
+	if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// applyEntityUpdates is applyNetworkUpdates for updates to entities of a
+// network
+func applyEntityUpdates(c echo.Context, networkID string, updates ...configurator.EntityUpdateCriteria) error {
+	dryRun, nerr := isDryRun(c)
+	if nerr != nil {
+		return nerr
+	}
+	if dryRun {
+		writes := make([]configurator.EntityWriteOperation, 0, len(updates))
+		for _, update := range updates {
+			writes = append(writes, update)
+		}
+		report, err := configurator.DryRunWriteEntitiesAs(access.GetVerifiedOperator(c), networkID, writes...)
+		if err != nil {
+			return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
+		}
+		return c.JSON(http.StatusOK, (&models.MconfigImpactReport{}).FromConfiguratorReport(report))
+	}
+
+	_, err := configurator.UpdateEntitiesAs(access.GetVerifiedOperator(c), networkID, updates)
+	if err != nil {
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
+	}
+	return c.NoContent(http.StatusNoContent)
+}
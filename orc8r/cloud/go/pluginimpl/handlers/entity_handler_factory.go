@@ -111,11 +111,7 @@ func GetPartialUpdateEntityHandler(path string, paramName string, model PartialE
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusBadRequest)
 			}
-			_, err = configurator.UpdateEntities(networkID, updates)
-			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
-			}
-			return c.NoContent(http.StatusNoContent)
+			return applyEntityUpdates(c, networkID, updates...)
 		},
 	}
 }
@@ -13,6 +13,7 @@ import (
 
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/pluginimpl/models"
 	"magma/orc8r/cloud/go/serde"
@@ -124,11 +125,7 @@ func GetPartialUpdateGatewayHandler(path string, model PartialGatewayModel) obsi
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusBadRequest)
 			}
-			_, err = configurator.UpdateEntities(networkID, updates)
-			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
-			}
-			return c.NoContent(http.StatusNoContent)
+			return applyEntityUpdates(c, networkID, updates...)
 		},
 	}
 }
@@ -213,42 +210,42 @@ func GetListGatewaysHandler(path string, gatewayType string, makeTypedGateways M
 				return nerr
 			}
 
-			ids, err := configurator.ListEntityKeys(nid, gatewayType)
-			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
-			}
-			// for each ID, we want to load the gateway and the magmad gateway
-			magmadTKs := make([]storage.TypeAndKey, 0, len(ids))
-			for _, id := range ids {
-				magmadTKs = append(
-					magmadTKs,
-					storage.TypeAndKey{Type: orc8r.MagmadGatewayType, Key: id},
-				)
-			}
-			gwTKs := make([]storage.TypeAndKey, 0, len(ids))
-			for _, id := range ids {
-				gwTKs = append(
-					gwTKs,
-					storage.TypeAndKey{Type: gatewayType, Key: id},
-				)
+			params, nerr := obsidian.GetPaginationParams(c)
+			if nerr != nil {
+				return nerr
 			}
 
-			// we need the two calls because LoadEntities only takes one type filter per call
-			// and we need the type filters in case the TKs are empty
-			magmadGWEnts, _, err := configurator.LoadEntities(nid, swag.String(orc8r.MagmadGatewayType), nil, nil, magmadTKs, configurator.FullEntityLoadCriteria())
-			gwEnts, _, err := configurator.LoadEntities(nid, swag.String(gatewayType), nil, nil, gwTKs, configurator.FullEntityLoadCriteria())
-			ents := configurator.NetworkEntities{}
-			for _, m := range magmadGWEnts {
-				ents = append(ents, m)
+			caller := access.GetVerifiedOperator(c)
+			gwEnts, nextPageToken, err := configurator.LoadEntitiesPageAs(
+				caller, nid, swag.String(gatewayType),
+				params.KeyPrefix, params.PageSize, params.PageToken,
+				configurator.FullEntityLoadCriteria(),
+			)
+			if err != nil {
+				return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 			}
-			for _, g := range gwEnts {
-				ents = append(ents, g)
+			// for each typed gateway, we also want to load the magmad gateway
+			ents := configurator.NetworkEntities{}
+			if len(gwEnts) > 0 {
+				magmadTKs := make([]storage.TypeAndKey, 0, len(gwEnts))
+				for _, gwEnt := range gwEnts {
+					magmadTKs = append(
+						magmadTKs,
+						storage.TypeAndKey{Type: orc8r.MagmadGatewayType, Key: gwEnt.Key},
+					)
+				}
+				magmadGWEnts, _, err := configurator.LoadEntitiesAs(caller, nid, nil, nil, nil, magmadTKs, configurator.FullEntityLoadCriteria())
+				if err != nil {
+					return obsidian.HttpError(err, http.StatusInternalServerError)
+				}
+				ents = append(ents, magmadGWEnts...)
+				ents = append(ents, gwEnts...)
 			}
 			entsByTK := ents.ToEntitiesByID()
 
 			// for each magmad gateway, we have to load its corresponding device and
 			// its reported status
-			deviceIDs := make([]string, 0, len(ids))
+			deviceIDs := make([]string, 0, len(gwEnts))
 			for tk, ent := range entsByTK {
 				if tk.Type == orc8r.MagmadGatewayType && ent.PhysicalID != "" {
 					deviceIDs = append(deviceIDs, ent.PhysicalID)
@@ -262,6 +259,7 @@ func GetListGatewaysHandler(path string, gatewayType string, makeTypedGateways M
 			if err != nil {
 				return obsidian.HttpError(errors.Wrap(err, "failed to load statuses"), http.StatusInternalServerError)
 			}
+			obsidian.SetNextPageToken(c, nextPageToken)
 			return c.JSON(http.StatusOK, makeTypedGateways(entsByTK, devicesByID, statusesByID))
 		},
 	}
@@ -277,8 +275,9 @@ func GetDeleteGatewayHandler(path string, gatewayType string) obsidian.Handler {
 				return nerr
 			}
 
-			existingEnt, err := configurator.LoadEntity(
-				nid, orc8r.MagmadGatewayType, gid,
+			caller := access.GetVerifiedOperator(c)
+			existingEnt, err := configurator.LoadEntityAs(
+				caller, nid, orc8r.MagmadGatewayType, gid,
 				configurator.EntityLoadCriteria{LoadMetadata: true},
 			)
 			switch {
@@ -288,7 +287,8 @@ func GetDeleteGatewayHandler(path string, gatewayType string) obsidian.Handler {
 				return obsidian.HttpError(errors.Wrap(err, "failed to load gateway"), http.StatusInternalServerError)
 			}
 
-			err = configurator.DeleteEntities(
+			err = configurator.DeleteEntitiesAs(
+				caller,
 				nid,
 				[]storage.TypeAndKey{
 					{Type: orc8r.MagmadGatewayType, Key: gid},
@@ -296,7 +296,7 @@ func GetDeleteGatewayHandler(path string, gatewayType string) obsidian.Handler {
 				},
 			)
 			if err != nil {
-				return obsidian.HttpError(errors.Wrap(err, "failed to delete gateway"), http.StatusInternalServerError)
+				return obsidian.HttpError(errors.Wrap(err, "failed to delete gateway"), merrors.GetHttpStatusCode(err))
 			}
 
 			// Now we delete the associated device. Even though we error out
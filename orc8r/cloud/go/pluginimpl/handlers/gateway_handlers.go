@@ -9,12 +9,16 @@
 package handlers
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/device"
@@ -24,8 +28,12 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+const timestampQueryParam = "timestamp"
+
 // MagmadEncompassingGateway is an interface for a gateway API model which
 // wraps the magmad gateway with more fields that translate into additional
 // network entities in the storage layer.
@@ -74,9 +82,18 @@ func ListGatewaysHandler(c echo.Context) error {
 		return nerr
 	}
 
-	ents, _, err := configurator.LoadEntities(nid, swag.String(orc8r.MagmadGatewayType), nil, nil, nil, configurator.FullEntityLoadCriteria())
+	params, nerr := obsidian.GetPaginationParams(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	ents, nextPageToken, err := configurator.LoadEntitiesPageAs(
+		access.GetVerifiedOperator(c), nid, swag.String(orc8r.MagmadGatewayType),
+		params.KeyPrefix, params.PageSize, params.PageToken,
+		configurator.FullEntityLoadCriteria(),
+	)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 	entsByTK := ents.ToEntitiesByID()
 
@@ -97,6 +114,7 @@ func ListGatewaysHandler(c echo.Context) error {
 	if err != nil {
 		return obsidian.HttpError(errors.Wrap(err, "failed to load statuses"), http.StatusInternalServerError)
 	}
+	obsidian.SetNextPageToken(c, nextPageToken)
 	return c.JSON(http.StatusOK, makeGateways(entsByTK, devicesByID, statusesByID))
 }
 
@@ -145,6 +163,8 @@ func CreateMagmadGatewayFromModel(c echo.Context, model MagmadEncompassingGatewa
 	case err != nil:
 		return obsidian.HttpError(errors.Wrap(err, "failed to check if physical device is already registered"), http.StatusConflict)
 	default: // err == nil
+		// The assignment check must see gateways in every network, so it
+		// isn't made on behalf of the caller
 		assignedEnt, err := configurator.LoadEntityForPhysicalID(deviceID, configurator.EntityLoadCriteria{})
 		switch {
 		case err == nil:
@@ -175,8 +195,8 @@ func CreateMagmadGatewayFromModel(c echo.Context, model MagmadEncompassingGatewa
 		writes = append(writes, encompassingGateway.GetAdditionalWritesOnCreate()...)
 	}
 
-	if err = configurator.WriteEntities(nid, writes...); err != nil {
-		return obsidian.HttpError(errors.Wrap(err, "failed to create gateway"), http.StatusInternalServerError)
+	if err = configurator.WriteEntitiesAs(access.GetVerifiedOperator(c), nid, writes...); err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to create gateway"), merrors.GetHttpStatusCode(err))
 	}
 	return nil
 }
@@ -186,16 +206,17 @@ func GetGatewayHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	ret, nerr := LoadMagmadGatewayModel(nid, gid)
+	ret, nerr := LoadMagmadGatewayModel(access.GetVerifiedOperator(c), nid, gid)
 	if nerr != nil {
 		return nerr
 	}
 	return c.JSON(http.StatusOK, ret)
 }
 
-func LoadMagmadGatewayModel(networkID string, gatewayID string) (*models.MagmadGateway, *echo.HTTPError) {
-	ent, err := configurator.LoadEntity(
-		networkID, orc8r.MagmadGatewayType, gatewayID,
+// LoadMagmadGatewayModel loads the magmad gateway on behalf of the caller
+func LoadMagmadGatewayModel(caller *protos.Identity, networkID string, gatewayID string) (*models.MagmadGateway, *echo.HTTPError) {
+	ent, err := configurator.LoadEntityAs(
+		caller, networkID, orc8r.MagmadGatewayType, gatewayID,
 		configurator.EntityLoadCriteria{
 			LoadMetadata:       true,
 			LoadConfig:         true,
@@ -257,8 +278,8 @@ func UpdateMagmadGatewayFromModel(c echo.Context, nid string, gid string, model
 		entsToLoad = append(entsToLoad, encompassingGateway.GetAdditionalEntitiesToLoadOnUpdate(gid)...)
 	}
 
-	loadedEnts, _, err := configurator.LoadEntities(
-		nid,
+	loadedEnts, _, err := configurator.LoadEntitiesAs(
+		access.GetVerifiedOperator(c), nid,
 		nil, nil, nil,
 		entsToLoad,
 		configurator.FullEntityLoadCriteria(),
@@ -272,9 +293,9 @@ func UpdateMagmadGatewayFromModel(c echo.Context, nid string, gid string, model
 		return nerr
 	}
 
-	err = configurator.WriteEntities(nid, writes...)
+	err = configurator.WriteEntitiesAs(access.GetVerifiedOperator(c), nid, writes...)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 
 	// device info is cheap to update, so just do it all the time if
@@ -324,8 +345,9 @@ func DeleteGatewayHandler(c echo.Context) error {
 		return nerr
 	}
 
-	existingEnt, err := configurator.LoadEntity(
-		nid, orc8r.MagmadGatewayType, gid,
+	caller := access.GetVerifiedOperator(c)
+	existingEnt, err := configurator.LoadEntityAs(
+		caller, nid, orc8r.MagmadGatewayType, gid,
 		configurator.EntityLoadCriteria{LoadMetadata: true, LoadAssocsToThis: true},
 	)
 	switch {
@@ -335,9 +357,9 @@ func DeleteGatewayHandler(c echo.Context) error {
 		return obsidian.HttpError(errors.Wrap(err, "failed to load gateway"), http.StatusInternalServerError)
 	}
 
-	err = configurator.DeleteEntity(nid, orc8r.MagmadGatewayType, gid)
+	err = configurator.DeleteEntityAs(caller, nid, orc8r.MagmadGatewayType, gid)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 
 	if existingEnt.PhysicalID != "" {
@@ -373,6 +395,41 @@ func GetStateHandler(c echo.Context) error {
 	return c.JSON(http.StatusOK, st)
 }
 
+// GetStateAtHandler returns the status of a gateway as it was at the time
+// given by the timestamp query param, formatted as RFC 3339.
+func GetStateAtHandler(c echo.Context) error {
+	networkID, gatewayID, nerr := obsidian.GetNetworkAndGatewayIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	timestamp := c.QueryParam(timestampQueryParam)
+	if timestamp == "" {
+		return obsidian.HttpError(fmt.Errorf("missing %s query param", timestampQueryParam), http.StatusBadRequest)
+	}
+	at, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return obsidian.HttpError(fmt.Errorf("invalid %s query param: %v", timestampQueryParam, err), http.StatusBadRequest)
+	}
+
+	physicalID, err := configurator.GetPhysicalIDOfEntity(networkID, orc8r.MagmadGatewayType, gatewayID)
+	if err == merrors.ErrNotFound {
+		return obsidian.HttpError(err, http.StatusNotFound)
+	} else if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	st, err := state.GetGatewayStatusAt(networkID, physicalID, at)
+	if err == merrors.ErrNotFound {
+		return obsidian.HttpError(err, http.StatusNotFound)
+	} else if status.Code(err) == codes.Unimplemented {
+		return obsidian.HttpError(err, http.StatusNotImplemented)
+	} else if err != nil {
+		return obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+
+	return c.JSON(http.StatusOK, st)
+}
+
 func makeGateways(
 	entsByTK map[storage.TypeAndKey]configurator.NetworkEntity,
 	devicesByID map[string]interface{},
@@ -674,6 +674,7 @@ func TestGetPartialReadHandlers(t *testing.T) {
 	getGatewayName := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/name", obsidian.GET).HandlerFunc
 	getGatewayDescription := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/description", obsidian.GET).HandlerFunc
 	getGatewayState := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/status", obsidian.GET).HandlerFunc
+	getGatewayStateAt := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/status/history", obsidian.GET).HandlerFunc
 	getGatewayDevice := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/device", obsidian.GET).HandlerFunc
 	getGatewayConfig := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/magmad", obsidian.GET).HandlerFunc
 
@@ -729,6 +730,42 @@ func TestGetPartialReadHandlers(t *testing.T) {
 	}
 	tests.RunUnitTest(t, e, tc)
 
+	// happy path state at a point in time
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "/g1/status/history?timestamp=" + time.Unix(1000000, 0).UTC().Format(time.RFC3339),
+		Handler:        getGatewayStateAt,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 200,
+		ExpectedResult: expectedState,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// 404 state before the gateway checked in
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "/g1/status/history?timestamp=" + time.Unix(999999, 0).UTC().Format(time.RFC3339),
+		Handler:        getGatewayStateAt,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 404,
+		ExpectedError:  "Not found",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// 400 missing timestamp
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "/g1/status/history",
+		Handler:        getGatewayStateAt,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "missing timestamp query param",
+	}
+	tests.RunUnitTest(t, e, tc)
+
 	// happy path device
 	tc = tests.Test{
 		Method:         "GET",
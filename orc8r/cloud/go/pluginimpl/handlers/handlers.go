@@ -33,16 +33,28 @@ const (
 	ManageNetworkDNSPath               = ManageNetworkPath + obsidian.UrlSep + "dns"
 	ManageNetworkDNSRecordsPath        = ManageNetworkDNSPath + obsidian.UrlSep + "records"
 	ManageNetworkDNSRecordByDomainPath = ManageNetworkDNSRecordsPath + obsidian.UrlSep + ":domain"
-
-	Gateways                     = "gateways"
-	ListGatewaysPath             = ManageNetworkPath + obsidian.UrlSep + Gateways
-	ManageGatewayPath            = ListGatewaysPath + obsidian.UrlSep + ":gateway_id"
-	ManageGatewayNamePath        = ManageGatewayPath + obsidian.UrlSep + "name"
-	ManageGatewayDescriptionPath = ManageGatewayPath + obsidian.UrlSep + "description"
-	ManageGatewayConfigPath      = ManageGatewayPath + obsidian.UrlSep + "magmad"
-	ManageGatewayDevicePath      = ManageGatewayPath + obsidian.UrlSep + "device"
-	ManageGatewayStatePath       = ManageGatewayPath + obsidian.UrlSep + "status"
-	ManageGatewayTierPath        = ManageGatewayPath + obsidian.UrlSep + "tier"
+	ListNetworkRevisionsPath           = ManageNetworkPath + obsidian.UrlSep + "revisions"
+	DiffNetworkRevisionsPath           = ListNetworkRevisionsPath + obsidian.UrlSep + "diff"
+	RollbackNetworkPath                = ListNetworkRevisionsPath + obsidian.UrlSep + ":version" + obsidian.UrlSep + "rollback"
+	ListNetworkAuditEventsPath         = ManageNetworkPath + obsidian.UrlSep + "audit"
+	SearchNetworkPath                  = ManageNetworkPath + obsidian.UrlSep + "search"
+
+	Gateways                      = "gateways"
+	ListGatewaysPath              = ManageNetworkPath + obsidian.UrlSep + Gateways
+	ManageGatewayPath             = ListGatewaysPath + obsidian.UrlSep + ":gateway_id"
+	ManageGatewayNamePath         = ManageGatewayPath + obsidian.UrlSep + "name"
+	ManageGatewayDescriptionPath  = ManageGatewayPath + obsidian.UrlSep + "description"
+	ManageGatewayConfigPath       = ManageGatewayPath + obsidian.UrlSep + "magmad"
+	ManageGatewayDevicePath       = ManageGatewayPath + obsidian.UrlSep + "device"
+	ManageGatewayStatePath        = ManageGatewayPath + obsidian.UrlSep + "status"
+	ManageGatewayStateHistoryPath = ManageGatewayStatePath + obsidian.UrlSep + "history"
+	ManageGatewayTierPath         = ManageGatewayPath + obsidian.UrlSep + "tier"
+	ManageGatewayLabelsPath       = ManageGatewayPath + obsidian.UrlSep + "labels"
+	ManageGatewayCertificatesPath = ManageGatewayPath + obsidian.UrlSep + "certificates"
+	ManageGatewayCertificatePath  = ManageGatewayCertificatesPath + obsidian.UrlSep + ":serial_number"
+	ListGatewayRevisionsPath      = ManageGatewayPath + obsidian.UrlSep + "revisions"
+	DiffGatewayRevisionsPath      = ListGatewayRevisionsPath + obsidian.UrlSep + "diff"
+	RollbackGatewayPath           = ListGatewayRevisionsPath + obsidian.UrlSep + ":version" + obsidian.UrlSep + "rollback"
 
 	Channels               = "channels"
 	ListChannelsPath       = obsidian.V1Root + Channels
@@ -56,6 +68,10 @@ const (
 	ManageTierImagePath    = ManageTierImagesPath + obsidian.UrlSep + ":image_name"
 	ManageTierGatewaysPath = ManageTiersPath + obsidian.UrlSep + "gateways"
 	ManageTierGatewayPath  = ManageTierGatewaysPath + obsidian.UrlSep + ":gateway_id"
+	ManageTierLabelsPath   = ManageTiersPath + obsidian.UrlSep + "labels"
+	ManageTierRolloutPath  = ManageTiersPath + obsidian.UrlSep + "rollout"
+	PauseTierRolloutPath   = ManageTierRolloutPath + obsidian.UrlSep + "pause"
+	ResumeTierRolloutPath  = ManageTierRolloutPath + obsidian.UrlSep + "resume"
 
 	LogQueryPath = ManageNetworkPath + obsidian.UrlSep + "logs"
 )
@@ -75,6 +91,12 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: ManageNetworkDNSRecordByDomainPath, Methods: obsidian.PUT, HandlerFunc: UpdateDNSRecord},
 		{Path: ManageNetworkDNSRecordByDomainPath, Methods: obsidian.DELETE, HandlerFunc: DeleteDNSRecord},
 
+		{Path: ListNetworkRevisionsPath, Methods: obsidian.GET, HandlerFunc: listNetworkRevisionsHandler},
+		{Path: DiffNetworkRevisionsPath, Methods: obsidian.GET, HandlerFunc: diffNetworkRevisionsHandler},
+		{Path: RollbackNetworkPath, Methods: obsidian.POST, HandlerFunc: rollbackNetworkHandler},
+		{Path: ListNetworkAuditEventsPath, Methods: obsidian.GET, HandlerFunc: listNetworkAuditEventsHandler},
+		{Path: SearchNetworkPath, Methods: obsidian.GET, HandlerFunc: searchNetworkHandler},
+
 		// Magma V1 Gateways
 		{Path: ListGatewaysPath, Methods: obsidian.GET, HandlerFunc: ListGatewaysHandler},
 		{Path: ListGatewaysPath, Methods: obsidian.POST, HandlerFunc: CreateGatewayHandler},
@@ -82,6 +104,13 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: ManageGatewayPath, Methods: obsidian.PUT, HandlerFunc: UpdateGatewayHandler},
 		{Path: ManageGatewayPath, Methods: obsidian.DELETE, HandlerFunc: DeleteGatewayHandler},
 		{Path: ManageGatewayStatePath, Methods: obsidian.GET, HandlerFunc: GetStateHandler},
+		{Path: ManageGatewayStateHistoryPath, Methods: obsidian.GET, HandlerFunc: GetStateAtHandler},
+		{Path: ManageGatewayCertificatesPath, Methods: obsidian.GET, HandlerFunc: ListGatewayCertificatesHandler},
+		{Path: ManageGatewayCertificatesPath, Methods: obsidian.DELETE, HandlerFunc: RevokeGatewayCertificatesHandler},
+		{Path: ManageGatewayCertificatePath, Methods: obsidian.DELETE, HandlerFunc: RevokeGatewayCertificateHandler},
+		{Path: ListGatewayRevisionsPath, Methods: obsidian.GET, HandlerFunc: listGatewayRevisionsHandler},
+		{Path: DiffGatewayRevisionsPath, Methods: obsidian.GET, HandlerFunc: diffGatewayRevisionsHandler},
+		{Path: RollbackGatewayPath, Methods: obsidian.POST, HandlerFunc: rollbackGatewayHandler},
 
 		// Upgrades
 		{Path: ListChannelsPath, Methods: obsidian.GET, HandlerFunc: listChannelsHandler},
@@ -98,6 +127,10 @@ func GetObsidianHandlers() []obsidian.Handler {
 		{Path: ManageTierImagePath, Methods: obsidian.DELETE, HandlerFunc: deleteImage},
 		{Path: ManageTierGatewaysPath, Methods: obsidian.POST, HandlerFunc: createTierGateway},
 		{Path: ManageTierGatewayPath, Methods: obsidian.DELETE, HandlerFunc: deleteTierGateway},
+		{Path: ManageTierRolloutPath, Methods: obsidian.GET, HandlerFunc: readTierRolloutHandler},
+		{Path: ManageTierRolloutPath, Methods: obsidian.POST, HandlerFunc: startTierRolloutHandler},
+		{Path: PauseTierRolloutPath, Methods: obsidian.POST, HandlerFunc: pauseTierRolloutHandler},
+		{Path: ResumeTierRolloutPath, Methods: obsidian.POST, HandlerFunc: resumeTierRolloutHandler},
 	}
 	ret = append(ret, GetPartialNetworkHandlers(ManageNetworkNamePath, new(models.NetworkName), "")...)
 	ret = append(ret, GetPartialNetworkHandlers(ManageNetworkTypePath, new(models.NetworkType), "")...)
@@ -110,12 +143,14 @@ func GetObsidianHandlers() []obsidian.Handler {
 	ret = append(ret, GetPartialGatewayHandlers(ManageGatewayDescriptionPath, new(models.GatewayDescription))...)
 	ret = append(ret, GetPartialGatewayHandlers(ManageGatewayConfigPath, &models2.MagmadGatewayConfigs{})...)
 	ret = append(ret, GetPartialGatewayHandlers(ManageGatewayTierPath, new(models2.TierID))...)
+	ret = append(ret, GetPartialGatewayHandlers(ManageGatewayLabelsPath, new(models2.EntityLabels))...)
 	ret = append(ret, GetGatewayDeviceHandlers(ManageGatewayDevicePath)...)
 
 	ret = append(ret, GetPartialEntityHandlers(ManageTierNamePath, "tier_id", new(models2.TierName))...)
 	ret = append(ret, GetPartialEntityHandlers(ManageTierVersionPath, "tier_id", new(models2.TierVersion))...)
 	ret = append(ret, GetPartialEntityHandlers(ManageTierImagesPath, "tier_id", new(models2.TierImages))...)
 	ret = append(ret, GetPartialEntityHandlers(ManageTierGatewaysPath, "tier_id", new(models2.TierGateways))...)
+	ret = append(ret, GetEntityLabelsHandlers(ManageTierLabelsPath, "tier_id", orc8r.UpgradeTierEntityType)...)
 
 	// Elastic
 	elasticConfig, err := config.GetServiceConfig(orc8r.ModuleName, "elastic")
@@ -16,6 +16,7 @@ import (
 
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
 
@@ -141,11 +142,7 @@ func GetPartialUpdateNetworkHandler(path string, model PartialNetworkModel) obsi
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusBadRequest)
 			}
-			err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{updateCriteria})
-			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
-			}
-			return c.NoContent(http.StatusNoContent)
+			return applyNetworkUpdates(c, updateCriteria)
 		},
 	}
 }
@@ -169,7 +166,7 @@ func GetPartialDeleteNetworkHandler(path string, key string) obsidian.Handler {
 				ID:              networkID,
 				ConfigsToDelete: []string{key},
 			}
-			err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update})
+			err := configurator.UpdateNetworksAs(access.GetVerifiedOperator(c), []configurator.NetworkUpdateCriteria{update})
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -212,7 +209,7 @@ func getCreateTypedNetworkHandler(path string, networkType string, network Netwo
 			if err != nil {
 				return err
 			}
-			err = configurator.CreateNetwork(payload.ToConfiguratorNetwork())
+			err = configurator.CreateNetworkAs(access.GetVerifiedOperator(c), payload.ToConfiguratorNetwork())
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
@@ -275,11 +272,7 @@ func getUpdateTypedNetworkHandler(path string, networkType string, networkModel
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a <%s> network", nid, networkType))
 			}
 
-			err = configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{payload.ToUpdateCriteria()})
-			if err != nil {
-				return obsidian.HttpError(err, http.StatusInternalServerError)
-			}
-			return c.NoContent(http.StatusNoContent)
+			return applyNetworkUpdates(c, payload.ToUpdateCriteria())
 		},
 	}
 }
@@ -305,7 +298,7 @@ func getDeleteTypedNetworkHandler(path string, networkType string) obsidian.Hand
 				return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("network %s is not a <%s> network", nid, networkType))
 			}
 
-			err = configurator.DeleteNetwork(nid)
+			err = configurator.DeleteNetworkAs(access.GetVerifiedOperator(c), nid)
 			if err != nil {
 				return obsidian.HttpError(err, http.StatusInternalServerError)
 			}
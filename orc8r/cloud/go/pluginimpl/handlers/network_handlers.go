@@ -14,6 +14,7 @@ import (
 
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/pluginimpl/models"
 	"magma/orc8r/cloud/go/services/configurator"
@@ -38,7 +39,7 @@ func registerNetwork(c echo.Context) error {
 		return nerr
 	}
 	network := payload.(*models.Network).ToConfiguratorNetwork()
-	createdNetworks, err := configurator.CreateNetworks([]configurator.Network{network})
+	createdNetworks, err := configurator.CreateNetworksAs(access.GetVerifiedOperator(c), []configurator.Network{network})
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusBadRequest)
 	}
@@ -67,11 +68,7 @@ func updateNetwork(c echo.Context) error {
 		return nerr
 	}
 	update := network.(*models.Network).ToUpdateCriteria()
-	err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{update})
-	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
-	}
-	return c.NoContent(http.StatusNoContent)
+	return applyNetworkUpdates(c, update)
 }
 
 func deleteNetwork(c echo.Context) error {
@@ -79,7 +76,7 @@ func deleteNetwork(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.DeleteNetwork(networkID)
+	err := configurator.DeleteNetworkAs(access.GetVerifiedOperator(c), networkID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -110,7 +107,7 @@ func CreateDNSRecord(c echo.Context) error {
 	}
 
 	dnsConfig.Records = append(dnsConfig.Records, record)
-	nerr = updateDNSConfig(networkID, dnsConfig)
+	nerr = updateDNSConfig(c, networkID, dnsConfig)
 	if nerr != nil {
 		return nerr
 	}
@@ -136,7 +133,7 @@ func UpdateDNSRecord(c echo.Context) error {
 	for i, existingRecord := range dnsConfig.Records {
 		if existingRecord.Domain == domain {
 			dnsConfig.Records[i] = record
-			nerr = updateDNSConfig(networkID, dnsConfig)
+			nerr = updateDNSConfig(c, networkID, dnsConfig)
 			if nerr != nil {
 				return nerr
 			}
@@ -182,7 +179,7 @@ func DeleteDNSRecord(c echo.Context) error {
 			} else {
 				dnsConfig.Records = append(dnsConfig.Records[:i], dnsConfig.Records[i+1:]...)
 			}
-			nerr = updateDNSConfig(networkID, dnsConfig)
+			nerr = updateDNSConfig(c, networkID, dnsConfig)
 			if nerr != nil {
 				return nerr
 			}
@@ -192,8 +189,8 @@ func DeleteDNSRecord(c echo.Context) error {
 	return echo.NewHTTPError(http.StatusNotFound)
 }
 
-func updateDNSConfig(networkID string, dnsConfig *models.NetworkDNSConfig) *echo.HTTPError {
-	err := configurator.UpdateNetworks([]configurator.NetworkUpdateCriteria{
+func updateDNSConfig(c echo.Context, networkID string, dnsConfig *models.NetworkDNSConfig) *echo.HTTPError {
+	err := configurator.UpdateNetworksAs(access.GetVerifiedOperator(c), []configurator.NetworkUpdateCriteria{
 		{
 			ID:                   networkID,
 			ConfigsToAddOrUpdate: map[string]interface{}{orc8r.DnsdNetworkType: dnsConfig},
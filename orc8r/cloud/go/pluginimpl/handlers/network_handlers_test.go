@@ -1033,6 +1033,59 @@ func Test_DeleteNetworkDNSHandlers(t *testing.T) {
 
 }
 
+func Test_DryRunNetworkDNSHandlers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	test_init.StartTestService(t)
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/networks"
+
+	seedNetworks(t)
+	_, err := configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "gw1"})
+	assert.NoError(t, err)
+
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	updateDNS := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/dns", obsidian.PUT).HandlerFunc
+
+	newDNS := models.NewDefaultDNSConfig()
+	newDNS.LocalTTL = swag.Uint32(120)
+	report, err := configurator.DryRunUpdateNetworksAs(nil, []configurator.NetworkUpdateCriteria{
+		{ID: "n1", ConfigsToAddOrUpdate: map[string]interface{}{orc8r.DnsdNetworkType: newDNS}},
+	})
+	assert.NoError(t, err)
+	expected := (&models.MconfigImpactReport{}).FromConfiguratorReport(report)
+	assert.Len(t, expected.Gateways, 1)
+	assert.Equal(t, "gw1", expected.Gateways[0].GatewayID)
+	assert.Equal(
+		t,
+		[]*models.ConfigChange{{Op: models.ConfigChangeOpReplace, Path: "/dnsd/localTTL", From: float64(60), To: float64(120)}},
+		expected.Gateways[0].Changes,
+	)
+
+	tc := tests.Test{
+		Method:         "PUT",
+		URL:            fmt.Sprintf("%s/%s/dns/?dry_run=true", testURLRoot, "n1"),
+		Payload:        tests.JSONMarshaler(newDNS),
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		Handler:        updateDNS,
+		ExpectedStatus: 200,
+		ExpectedResult: expected,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// dry runs leave the config untouched
+	config, err := configurator.LoadNetworkConfig("n1", orc8r.DnsdNetworkType)
+	assert.NoError(t, err)
+	assert.Equal(t, models.NewDefaultDNSConfig(), config)
+
+	tc.URL = fmt.Sprintf("%s/%s/dns/?dry_run=maybe", testURLRoot, "n1")
+	tc.ExpectedStatus = 400
+	tc.ExpectedResult = nil
+	tc.ExpectedError = "invalid dry_run query param: strconv.ParseBool: parsing \"maybe\": invalid syntax"
+	tests.RunUnitTest(t, e, tc)
+}
+
 func seedNetworks(t *testing.T) {
 	_, err := configurator.CreateNetworks(
 		[]configurator.Network{
@@ -0,0 +1,229 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/labstack/echo"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	fromVersionQueryParam = "from"
+	toVersionQueryParam   = "to"
+)
+
+func listNetworkRevisionsHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	revisions, err := configurator.ListNetworkRevisionsAs(access.GetVerifiedOperator(c), networkID)
+	if nerr := revisionsErrorToHttpError(err); nerr != nil {
+		return nerr
+	}
+
+	ret := make([]*models.ConfigRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		ret = append(ret, (&models.ConfigRevision{}).FromNetworkRevision(revision))
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func diffNetworkRevisionsHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	revisions, err := configurator.ListNetworkRevisionsAs(access.GetVerifiedOperator(c), networkID)
+	if nerr := revisionsErrorToHttpError(err); nerr != nil {
+		return nerr
+	}
+
+	versions := make([]uint64, 0, len(revisions))
+	for _, revision := range revisions {
+		versions = append(versions, revision.Version)
+	}
+	fromIdx, toIdx, nerr := getRevisionsToDiff(c, versions)
+	if nerr != nil {
+		return nerr
+	}
+	changes, err := configurator.DiffNetworkConfigs(revisions[fromIdx].Configs, revisions[toIdx].Configs)
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to diff revisions"), http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, toConfigChangeModels(changes))
+}
+
+func rollbackNetworkHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	version, nerr := getRevisionVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := configurator.RollbackNetworkAs(access.GetVerifiedOperator(c), networkID, version)
+	if err != nil {
+		return rollbackErrorToHttpError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func listGatewayRevisionsHandler(c echo.Context) error {
+	networkID, gatewayID, nerr := obsidian.GetNetworkAndGatewayIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	revisions, err := configurator.ListEntityRevisionsAs(access.GetVerifiedOperator(c), networkID, orc8r.MagmadGatewayType, gatewayID)
+	if nerr := revisionsErrorToHttpError(err); nerr != nil {
+		return nerr
+	}
+
+	ret := make([]*models.ConfigRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		ret = append(ret, (&models.ConfigRevision{}).FromEntityRevision(revision))
+	}
+	return c.JSON(http.StatusOK, ret)
+}
+
+func diffGatewayRevisionsHandler(c echo.Context) error {
+	networkID, gatewayID, nerr := obsidian.GetNetworkAndGatewayIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	revisions, err := configurator.ListEntityRevisionsAs(access.GetVerifiedOperator(c), networkID, orc8r.MagmadGatewayType, gatewayID)
+	if nerr := revisionsErrorToHttpError(err); nerr != nil {
+		return nerr
+	}
+
+	versions := make([]uint64, 0, len(revisions))
+	for _, revision := range revisions {
+		versions = append(versions, revision.Version)
+	}
+	fromIdx, toIdx, nerr := getRevisionsToDiff(c, versions)
+	if nerr != nil {
+		return nerr
+	}
+	changes, err := configurator.DiffEntityConfigs(revisions[fromIdx].Config, revisions[toIdx].Config)
+	if err != nil {
+		return obsidian.HttpError(errors.Wrap(err, "failed to diff revisions"), http.StatusInternalServerError)
+	}
+	return c.JSON(http.StatusOK, toConfigChangeModels(changes))
+}
+
+func rollbackGatewayHandler(c echo.Context) error {
+	networkID, gatewayID, nerr := obsidian.GetNetworkAndGatewayIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	version, nerr := getRevisionVersion(c)
+	if nerr != nil {
+		return nerr
+	}
+	err := configurator.RollbackEntityAs(access.GetVerifiedOperator(c), networkID, orc8r.MagmadGatewayType, gatewayID, version)
+	if err != nil {
+		return rollbackErrorToHttpError(err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+// revisionsErrorToHttpError maps the error of a revision list. Callers
+// without permission on the network are forbidden, while entities they can't
+// read aren't found.
+func revisionsErrorToHttpError(err error) *echo.HTTPError {
+	switch {
+	case err == nil:
+		return nil
+	case err == merrors.ErrNotFound:
+		return echo.ErrNotFound
+	case status.Code(err) == codes.PermissionDenied:
+		return obsidian.HttpError(err, http.StatusForbidden)
+	default:
+		return obsidian.HttpError(errors.Wrap(err, "failed to load revisions"), http.StatusInternalServerError)
+	}
+}
+
+func getRevisionVersion(c echo.Context) (uint64, *echo.HTTPError) {
+	params, nerr := obsidian.GetParamValues(c, "version")
+	if nerr != nil {
+		return 0, nerr
+	}
+	version, err := strconv.ParseUint(params[0], 10, 64)
+	if err != nil {
+		return 0, obsidian.HttpError(fmt.Errorf("invalid version %s: %v", params[0], err), http.StatusBadRequest)
+	}
+	return version, nil
+}
+
+// getRevisionsToDiff returns the indexes in versions of the from and to
+// query params. to defaults to the last, i.e. current, version.
+func getRevisionsToDiff(c echo.Context, versions []uint64) (int, int, *echo.HTTPError) {
+	fromParam := c.QueryParam(fromVersionQueryParam)
+	if fromParam == "" {
+		return 0, 0, obsidian.HttpError(fmt.Errorf("missing %s query param", fromVersionQueryParam), http.StatusBadRequest)
+	}
+	fromIdx, nerr := getVersionIndex(versions, fromVersionQueryParam, fromParam)
+	if nerr != nil {
+		return 0, 0, nerr
+	}
+
+	toParam := c.QueryParam(toVersionQueryParam)
+	if toParam == "" {
+		return fromIdx, len(versions) - 1, nil
+	}
+	toIdx, nerr := getVersionIndex(versions, toVersionQueryParam, toParam)
+	if nerr != nil {
+		return 0, 0, nerr
+	}
+	return fromIdx, toIdx, nil
+}
+
+func getVersionIndex(versions []uint64, paramName string, paramValue string) (int, *echo.HTTPError) {
+	version, err := strconv.ParseUint(paramValue, 10, 64)
+	if err != nil {
+		return 0, obsidian.HttpError(fmt.Errorf("invalid %s query param: %v", paramName, err), http.StatusBadRequest)
+	}
+	for i, v := range versions {
+		if v == version {
+			return i, nil
+		}
+	}
+	return 0, obsidian.HttpError(fmt.Errorf("revision %d not found", version), http.StatusNotFound)
+}
+
+func toConfigChangeModels(changes []configurator.ConfigChange) []*models.ConfigChange {
+	ret := make([]*models.ConfigChange, 0, len(changes))
+	for _, change := range changes {
+		ret = append(ret, (&models.ConfigChange{}).FromConfiguratorChange(change))
+	}
+	return ret
+}
+
+// rollbackErrorToHttpError maps the status of a failed rollback. Rolling back
+// to the current version is a client error rather than an internal one.
+func rollbackErrorToHttpError(err error) *echo.HTTPError {
+	if status.Code(err) == codes.InvalidArgument {
+		return obsidian.HttpError(err, http.StatusBadRequest)
+	}
+	return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
+}
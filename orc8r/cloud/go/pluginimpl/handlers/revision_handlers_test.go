@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"testing"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/pluginimpl/handlers"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkRevisionHandlers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	test_init.StartTestService(t)
+	err := configurator.CreateNetwork(configurator.Network{
+		ID:      "n1",
+		Configs: map[string]interface{}{orc8r.NetworkFeaturesConfig: &models.NetworkFeatures{Features: map[string]string{"foo": "bar"}}},
+	})
+	assert.NoError(t, err)
+	err = configurator.UpdateNetworkConfig("n1", orc8r.NetworkFeaturesConfig, &models.NetworkFeatures{Features: map[string]string{"foo": "baz", "hello": "world"}})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/networks/n1/revisions"
+
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listRevisions := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/revisions", obsidian.GET).HandlerFunc
+	diffRevisions := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/revisions/diff", obsidian.GET).HandlerFunc
+	rollback := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/revisions/:version/rollback", obsidian.POST).HandlerFunc
+
+	revisions, err := configurator.ListNetworkRevisions("n1")
+	assert.NoError(t, err)
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot,
+		Handler:        listRevisions,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.ConfigRevision{
+			{Version: 0, ReplacedAt: revisions[0].ReplacedAt},
+			{Version: 1, ReplacedAt: 0},
+		}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// unknown network
+	tc.URL = "/magma/v1/networks/n2/revisions"
+	tc.ParamValues = []string{"n2"}
+	tc.ExpectedStatus = 404
+	tc.ExpectedError = "Not Found"
+	tests.RunUnitTest(t, e, tc)
+
+	// diff to the current version by default
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "/diff?from=0",
+		Handler:        diffRevisions,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.ConfigChange{
+			{Op: models.ConfigChangeOpReplace, Path: "/orc8r_features/features/foo", From: "bar", To: "baz"},
+			{Op: models.ConfigChangeOpAdd, Path: "/orc8r_features/features/hello", To: "world"},
+		}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "/diff?from=1&to=0"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.ConfigChange{
+		{Op: models.ConfigChangeOpReplace, Path: "/orc8r_features/features/foo", From: "baz", To: "bar"},
+		{Op: models.ConfigChangeOpRemove, Path: "/orc8r_features/features/hello", From: "world"},
+	})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "/diff?from=5"
+	tc.ExpectedStatus = 404
+	tc.ExpectedError = "revision 5 not found"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "/diff"
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "missing from query param"
+	tests.RunUnitTest(t, e, tc)
+
+	// roll back to the first version
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            testURLRoot + "/0/rollback",
+		Handler:        rollback,
+		ParamNames:     []string{"network_id", "version"},
+		ParamValues:    []string{"n1", "0"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+	features, err := configurator.LoadNetworkConfig("n1", orc8r.NetworkFeaturesConfig)
+	assert.NoError(t, err)
+	assert.Equal(t, &models.NetworkFeatures{Features: map[string]string{"foo": "bar"}}, features)
+
+	// rolling back to the current version is rejected
+	tc.URL = testURLRoot + "/2/rollback"
+	tc.ParamValues = []string{"n1", "2"}
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "version 2 is the current version of network n1"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = testURLRoot + "/foo/rollback"
+	tc.ParamValues = []string{"n1", "foo"}
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "invalid version foo: strconv.ParseUint: parsing \"foo\": invalid syntax"
+	tests.RunUnitTest(t, e, tc)
+}
+
+func TestGatewayRevisionHandlers(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	test_init.StartTestService(t)
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "g1", Config: &models.MagmadGatewayConfigs{CheckinInterval: 15}})
+	assert.NoError(t, err)
+	err = configurator.CreateOrUpdateEntityConfig("n1", orc8r.MagmadGatewayType, "g1", &models.MagmadGatewayConfigs{CheckinInterval: 60})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	testURLRoot := "/magma/v1/networks/n1/gateways/g1/revisions"
+
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	listRevisions := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/revisions", obsidian.GET).HandlerFunc
+	diffRevisions := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/revisions/diff", obsidian.GET).HandlerFunc
+	rollback := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/revisions/:version/rollback", obsidian.POST).HandlerFunc
+
+	revisions, err := configurator.ListEntityRevisions("n1", orc8r.MagmadGatewayType, "g1")
+	assert.NoError(t, err)
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot,
+		Handler:        listRevisions,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.ConfigRevision{
+			{Version: 0, ReplacedAt: revisions[0].ReplacedAt},
+			{Version: 1, ReplacedAt: 0},
+		}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// unknown gateway
+	tc.URL = "/magma/v1/networks/n1/gateways/g2/revisions"
+	tc.ParamValues = []string{"n1", "g2"}
+	tc.ExpectedStatus = 404
+	tc.ExpectedError = "Not Found"
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            testURLRoot + "/diff?from=0",
+		Handler:        diffRevisions,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.ConfigChange{
+			{Op: models.ConfigChangeOpReplace, Path: "/checkin_interval", From: float64(15), To: float64(60)},
+		}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            testURLRoot + "/0/rollback",
+		Handler:        rollback,
+		ParamNames:     []string{"network_id", "gateway_id", "version"},
+		ParamValues:    []string{"n1", "g1", "0"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+	config, err := configurator.LoadEntityConfig("n1", orc8r.MagmadGatewayType, "g1")
+	assert.NoError(t, err)
+	assert.Equal(t, &models.MagmadGatewayConfigs{CheckinInterval: 15}, config)
+
+	// pruned or unknown versions can't be rolled back to
+	tc.URL = testURLRoot + "/7/rollback"
+	tc.ParamValues = []string{"n1", "g1", "7"}
+	tc.ExpectedStatus = 404
+	tc.ExpectedError = "revision 7 of entity magmad_gateway-g1 not found"
+	tests.RunUnitTest(t, e, tc)
+}
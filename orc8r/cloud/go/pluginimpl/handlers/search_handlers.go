@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/labstack/echo"
+)
+
+const (
+	searchTextQueryParam  = "q"
+	searchLabelQueryParam = "label"
+	searchTypeQueryParam  = "type"
+)
+
+type searchableTypeRegistry struct {
+	sync.RWMutex
+	types map[string]bool
+}
+
+var searchableTypeRegistryInstance = &searchableTypeRegistry{
+	types: map[string]bool{
+		orc8r.MagmadGatewayType:     true,
+		orc8r.UpgradeTierEntityType: true,
+	},
+}
+
+// RegisterSearchableEntityTypes makes entities of the given types available
+// to the network search endpoint. Gateways and upgrade tiers are always
+// searchable.
+func RegisterSearchableEntityTypes(entityTypes ...string) {
+	searchableTypeRegistryInstance.Lock()
+	defer searchableTypeRegistryInstance.Unlock()
+	for _, entityType := range entityTypes {
+		searchableTypeRegistryInstance.types[entityType] = true
+	}
+}
+
+func getSearchableEntityTypes() map[string]bool {
+	searchableTypeRegistryInstance.RLock()
+	defer searchableTypeRegistryInstance.RUnlock()
+	ret := make(map[string]bool, len(searchableTypeRegistryInstance.types))
+	for entityType := range searchableTypeRegistryInstance.types {
+		ret[entityType] = true
+	}
+	return ret
+}
+
+func searchNetworkHandler(c echo.Context) error {
+	networkID, nerr := obsidian.GetNetworkId(c)
+	if nerr != nil {
+		return nerr
+	}
+	filter, nerr := getEntitySearchFilter(c)
+	if nerr != nil {
+		return nerr
+	}
+	params, nerr := obsidian.GetPaginationParams(c)
+	if nerr != nil {
+		return nerr
+	}
+
+	ents, nextPageToken, err := configurator.SearchEntitiesAs(
+		access.GetVerifiedOperator(c), networkID, filter,
+		params.PageSize, params.PageToken,
+		configurator.EntityLoadCriteria{LoadMetadata: true, LoadLabels: true},
+	)
+	if err != nil {
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
+	}
+	ret := make([]*models.EntitySearchResult, 0, len(ents))
+	for _, ent := range ents {
+		ret = append(ret, (&models.EntitySearchResult{}).FromConfiguratorEntity(ent))
+	}
+	obsidian.SetNextPageToken(c, nextPageToken)
+	return c.JSON(http.StatusOK, ret)
+}
+
+func getEntitySearchFilter(c echo.Context) (configurator.EntitySearchFilter, *echo.HTTPError) {
+	ret := configurator.EntitySearchFilter{Text: c.QueryParam(searchTextQueryParam)}
+
+	searchableTypes := getSearchableEntityTypes()
+	queryParams := c.QueryParams()
+	for _, entityType := range queryParams[searchTypeQueryParam] {
+		if !searchableTypes[entityType] {
+			return ret, obsidian.HttpError(fmt.Errorf("entities of type %s are not searchable", entityType), http.StatusBadRequest)
+		}
+		ret.Types = append(ret.Types, entityType)
+	}
+	if len(ret.Types) == 0 {
+		for entityType := range searchableTypes {
+			ret.Types = append(ret.Types, entityType)
+		}
+		sort.Strings(ret.Types)
+	}
+
+	for _, label := range queryParams[searchLabelQueryParam] {
+		kv := strings.SplitN(label, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return ret, obsidian.HttpError(fmt.Errorf("invalid %s query param %s, expected key:value", searchLabelQueryParam, label), http.StatusBadRequest)
+		}
+		if ret.Labels == nil {
+			ret.Labels = map[string]string{}
+		}
+		ret.Labels[kv[0]] = kv[1]
+	}
+	return ret, nil
+}
+
+// GetEntityLabelsHandlers returns GET and PUT handlers to read and replace the
+// labels of entities of the given type, so they can be searched by label.
+// - path: 	the url at which the handlers will be registered.
+// - paramName: the parameter name in the url at which the entity key is stored
+// - entityType: the configurator type of the entities
+func GetEntityLabelsHandlers(path string, paramName string, entityType string) []obsidian.Handler {
+	return []obsidian.Handler{
+		{
+			Path:    path,
+			Methods: obsidian.GET,
+			HandlerFunc: func(c echo.Context) error {
+				networkID, key, nerr := getNetworkAndEntityIDs(c, paramName)
+				if nerr != nil {
+					return nerr
+				}
+				ret := models.EntityLabels{}
+				err := ret.FromEntity(networkID, entityType, key)
+				if err == merrors.ErrNotFound {
+					return obsidian.HttpError(err, http.StatusNotFound)
+				} else if err != nil {
+					return obsidian.HttpError(err, http.StatusInternalServerError)
+				}
+				return c.JSON(http.StatusOK, ret)
+			},
+		},
+		{
+			Path:    path,
+			Methods: obsidian.PUT,
+			HandlerFunc: func(c echo.Context) error {
+				networkID, key, nerr := getNetworkAndEntityIDs(c, paramName)
+				if nerr != nil {
+					return nerr
+				}
+				payload, nerr := GetAndValidatePayload(c, &models.EntityLabels{})
+				if nerr != nil {
+					return nerr
+				}
+				updates, err := payload.(*models.EntityLabels).ToEntityUpdateCriteria(networkID, entityType, key)
+				if err != nil {
+					return obsidian.HttpError(err, http.StatusBadRequest)
+				}
+				return applyEntityUpdates(c, networkID, updates...)
+			},
+		},
+	}
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package handlers_test
+
+import (
+	"testing"
+
+	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/tests"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/pluginimpl/handlers"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/test_init"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchNetwork(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	test_init.StartTestService(t)
+
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+		{Type: orc8r.MagmadGatewayType, Key: "g1", Name: "Rooftop gateway", PhysicalID: "hw1"},
+		{Type: orc8r.MagmadGatewayType, Key: "g2", Description: "basement", PhysicalID: "hw2"},
+		{
+			Type:   orc8r.UpgradeTierEntityType,
+			Key:    "t1",
+			Name:   "rooftop tier",
+			Config: &models.Tier{ID: "t1", Name: "rooftop tier", Version: "1.0.0-0", Images: []*models.TierImage{}, Gateways: models.TierGateways{}},
+		},
+		// Entities of types which aren't searchable are never returned
+		{Type: "search_other", Key: "rooftop"},
+	})
+	assert.NoError(t, err)
+
+	e := echo.New()
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	search := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/search", obsidian.GET).HandlerFunc
+	getLabels := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/labels", obsidian.GET).HandlerFunc
+	updateLabels := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/gateways/:gateway_id/labels", obsidian.PUT).HandlerFunc
+
+	g1 := &models.EntitySearchResult{Type: orc8r.MagmadGatewayType, ID: "g1", Name: "Rooftop gateway", HardwareID: "hw1"}
+	g2 := &models.EntitySearchResult{Type: orc8r.MagmadGatewayType, ID: "g2", Description: "basement", HardwareID: "hw2"}
+	t1 := &models.EntitySearchResult{Type: orc8r.UpgradeTierEntityType, ID: "t1", Name: "rooftop tier"}
+
+	searchURLRoot := "/magma/v1/networks/n1/search"
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            searchURLRoot,
+		Handler:        search,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.EntitySearchResult{g1, g2, t1}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = searchURLRoot + "?q=ROOFTOP"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.EntitySearchResult{g1, t1})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = searchURLRoot + "?q=rooftop&type=" + orc8r.UpgradeTierEntityType
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.EntitySearchResult{t1})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = searchURLRoot + "?q=hw2"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.EntitySearchResult{g2})
+	tests.RunUnitTest(t, e, tc)
+
+	// Set labels on a gateway
+	labelsURLRoot := "/magma/v1/networks/n1/gateways/g2/labels"
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            labelsURLRoot,
+		Payload:        tests.JSONMarshaler(models.EntityLabels{"site": "north", "rack": "3"}),
+		Handler:        updateLabels,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g2"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc.Payload = tests.JSONMarshaler(models.EntityLabels{"site": "north"})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.Payload = tests.JSONMarshaler(models.EntityLabels{"": "north"})
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "label keys must not be empty"
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            labelsURLRoot,
+		Handler:        getLabels,
+		ParamNames:     []string{"network_id", "gateway_id"},
+		ParamValues:    []string{"n1", "g2"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler(models.EntityLabels{"site": "north"}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Search by label
+	g2.Labels = models.EntityLabels{"site": "north"}
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            searchURLRoot + "?label=site:north",
+		Handler:        search,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.EntitySearchResult{g2}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = searchURLRoot + "?label=site:north&label=rack:3"
+	tc.ExpectedResult = tests.JSONMarshaler([]*models.EntitySearchResult{})
+	tests.RunUnitTest(t, e, tc)
+
+	// Labels of other searchable entities
+	getTierLabels := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/tiers/:tier_id/labels", obsidian.GET).HandlerFunc
+	updateTierLabels := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, "/magma/v1/networks/:network_id/tiers/:tier_id/labels", obsidian.PUT).HandlerFunc
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            "/magma/v1/networks/n1/tiers/t1/labels",
+		Payload:        tests.JSONMarshaler(models.EntityLabels{"site": "north"}),
+		Handler:        updateTierLabels,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "t1"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc.Method = "GET"
+	tc.Payload = nil
+	tc.Handler = getTierLabels
+	tc.ExpectedStatus = 200
+	tc.ExpectedResult = tests.JSONMarshaler(models.EntityLabels{"site": "north"})
+	tests.RunUnitTest(t, e, tc)
+
+	tc.ParamValues = []string{"n1", "t2"}
+	tc.ExpectedStatus = 404
+	tc.ExpectedResult = nil
+	tc.ExpectedError = "Not found"
+	tests.RunUnitTest(t, e, tc)
+
+	t1.Labels = models.EntityLabels{"site": "north"}
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            searchURLRoot + "?label=site:north",
+		Handler:        search,
+		ParamNames:     []string{"network_id"},
+		ParamValues:    []string{"n1"},
+		ExpectedStatus: 200,
+		ExpectedResult: tests.JSONMarshaler([]*models.EntitySearchResult{g2, t1}),
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// Invalid params
+	tc.URL = searchURLRoot + "?label=site"
+	tc.ExpectedStatus = 400
+	tc.ExpectedResult = nil
+	tc.ExpectedError = "invalid label query param site, expected key:value"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.URL = searchURLRoot + "?type=search_other"
+	tc.ExpectedError = "entities of type search_other are not searchable"
+	tests.RunUnitTest(t, e, tc)
+}
@@ -14,14 +14,27 @@ import (
 	"net/http"
 	"sort"
 
+	"magma/orc8r/cloud/go/clock"
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/obsidian"
+	"magma/orc8r/cloud/go/obsidian/access"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/pluginimpl/models"
 	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/storage"
 
 	"github.com/go-openapi/swag"
 	"github.com/labstack/echo"
+	"github.com/thoas/go-funk"
+)
+
+const (
+	// percentage of the gateways of a tier upgraded in each batch of a
+	// rollout, unless the rollout specifies otherwise
+	defaultRolloutBatchPercent = 10
+	// seconds between batches of a rollout, unless the rollout specifies
+	// otherwise
+	defaultRolloutBatchInterval = 600
 )
 
 func listChannelsHandler(c echo.Context) error {
@@ -46,7 +59,7 @@ func createChannelHandler(c echo.Context) error {
 		Name:   string(channel.Name),
 		Config: channel,
 	}
-	_, err := configurator.CreateInternalEntity(entity)
+	_, err := configurator.CreateEntityAs(access.GetVerifiedOperator(c), storage.InternalNetworkID, entity)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -86,7 +99,7 @@ func updateChannelHandler(c echo.Context) error {
 		NewName:   swag.String(string(channel.Name)),
 		NewConfig: channel,
 	}
-	_, err := configurator.UpdateInternalEntity(update)
+	_, err := configurator.UpdateEntityAs(access.GetVerifiedOperator(c), storage.InternalNetworkID, update)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -98,7 +111,7 @@ func deleteChannelHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.DeleteInternalEntity(orc8r.UpgradeReleaseChannelEntityType, channelID)
+	err := configurator.DeleteEntityAs(access.GetVerifiedOperator(c), storage.InternalNetworkID, orc8r.UpgradeReleaseChannelEntityType, channelID)
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
@@ -128,10 +141,12 @@ func createTierHandler(c echo.Context) error {
 		return nerr
 	}
 	tier := payload.(*models.Tier)
+	// rollouts are only started through the rollout endpoints
+	tier.Rollout = nil
 	entity := tier.ToNetworkEntity()
-	_, err := configurator.CreateEntity(networkID, entity)
+	_, err := configurator.CreateEntityAs(access.GetVerifiedOperator(c), networkID, entity)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 	return c.NoContent(http.StatusCreated)
 }
@@ -150,12 +165,16 @@ func updateTierHandler(c echo.Context) error {
 	if string(tier.ID) != tierID {
 		return obsidian.HttpError(fmt.Errorf("TierID in URL and payload do not match."), http.StatusBadRequest)
 	}
-	update := tier.ToUpdateCriteria()
-	_, err := configurator.UpdateEntity(networkID, update)
-	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+	// keep the rollout of the tier, it's only updated through the rollout
+	// endpoints
+	existingTier, version, nerr := loadTier(c, networkID, tierID)
+	if nerr != nil {
+		return nerr
 	}
-	return c.NoContent(http.StatusNoContent)
+	tier.Rollout = existingTier.Rollout
+	update := tier.ToUpdateCriteria()
+	update.ExpectedVersion = swag.Uint64(version)
+	return applyEntityUpdates(c, networkID, update)
 }
 
 func readTierHandler(c echo.Context) error {
@@ -163,18 +182,11 @@ func readTierHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	entity, err := configurator.LoadEntity(
-		networkID, orc8r.UpgradeTierEntityType, tierID,
-		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true, LoadMetadata: true},
-	)
-	if err == merrors.ErrNotFound {
-		return obsidian.HttpError(err, http.StatusNotFound)
-	}
-	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+	tier, _, nerr := loadTier(c, networkID, tierID)
+	if nerr != nil {
+		return nerr
 	}
-	tier := &models.Tier{}
-	return c.JSON(http.StatusOK, tier.FromBackendModel(entity))
+	return c.JSON(http.StatusOK, tier)
 }
 
 func deleteTierHandler(c echo.Context) error {
@@ -182,9 +194,9 @@ func deleteTierHandler(c echo.Context) error {
 	if nerr != nil {
 		return nerr
 	}
-	err := configurator.DeleteEntity(networkID, orc8r.UpgradeTierEntityType, tierID)
+	err := configurator.DeleteEntityAs(access.GetVerifiedOperator(c), networkID, orc8r.UpgradeTierEntityType, tierID)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -206,9 +218,9 @@ func createTierImage(c echo.Context) error {
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
-	_, err = configurator.UpdateEntities(networkID, updates)
+	_, err = configurator.UpdateEntitiesAs(access.GetVerifiedOperator(c), networkID, updates)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -229,9 +241,9 @@ func deleteImage(c echo.Context) error {
 	if err != nil {
 		return obsidian.HttpError(err, http.StatusInternalServerError)
 	}
-	_, err = configurator.UpdateEntity(networkID, update)
+	_, err = configurator.UpdateEntityAs(access.GetVerifiedOperator(c), networkID, update)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -247,9 +259,9 @@ func createTierGateway(c echo.Context) error {
 	}
 
 	update := (&models.TierGateways{}).ToAddGatewayUpdateCriteria(tierID, gatewayID)
-	_, err := configurator.UpdateEntity(networkID, update)
+	_, err := configurator.UpdateEntityAs(access.GetVerifiedOperator(c), networkID, update)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
 	}
 	return c.NoContent(http.StatusNoContent)
 }
@@ -264,13 +276,163 @@ func deleteTierGateway(c echo.Context) error {
 		return nerr
 	}
 	update := (&models.TierGateways{}).ToDeleteGatewayUpdateCriteria(tierID, gatewayID)
-	_, err := configurator.UpdateEntity(networkID, update)
+	_, err := configurator.UpdateEntityAs(access.GetVerifiedOperator(c), networkID, update)
 	if err != nil {
-		return obsidian.HttpError(err, http.StatusInternalServerError)
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func readTierRolloutHandler(c echo.Context) error {
+	networkID, tierID, nerr := getNetworkAndTierIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	tier, _, nerr := loadTier(c, networkID, tierID)
+	if nerr != nil {
+		return nerr
+	}
+	if tier.Rollout == nil {
+		return obsidian.HttpError(merrors.ErrNotFound, http.StatusNotFound)
+	}
+	return c.JSON(http.StatusOK, tier.Rollout)
+}
+
+func startTierRolloutHandler(c echo.Context) error {
+	networkID, tierID, nerr := getNetworkAndTierIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	payload, nerr := GetAndValidatePayload(c, &models.TierRollout{})
+	if nerr != nil {
+		return nerr
+	}
+	rollout := payload.(*models.TierRollout)
+
+	tier, version, nerr := loadTier(c, networkID, tierID)
+	if nerr != nil {
+		return nerr
+	}
+	if tier.Rollout.IsInProgress() {
+		return obsidian.HttpError(errors.New("a rollout is already in progress for this tier"), http.StatusConflict)
+	}
+	for _, wave := range rollout.Waves {
+		for _, gatewayID := range wave {
+			if !funk.Contains(tier.Gateways, gatewayID) {
+				return obsidian.HttpError(fmt.Errorf("gateway %s of rollout wave is not part of the tier", gatewayID), http.StatusBadRequest)
+			}
+		}
+	}
+	// completing the rollout upgrades the whole tier, so the waves have to
+	// cover all of its gateways
+	if len(rollout.Waves) > 0 {
+		waveGateways := map[string]bool{}
+		for _, wave := range rollout.Waves {
+			for _, gatewayID := range wave {
+				waveGateways[string(gatewayID)] = true
+			}
+		}
+		for _, gatewayID := range tier.Gateways {
+			if !waveGateways[string(gatewayID)] {
+				return obsidian.HttpError(fmt.Errorf("gateway %s of the tier is not part of any rollout wave", gatewayID), http.StatusBadRequest)
+			}
+		}
+	}
+	if len(rollout.Waves) == 0 && rollout.BatchPercent == 0 {
+		rollout.BatchPercent = defaultRolloutBatchPercent
+	}
+	if rollout.BatchInterval == 0 {
+		rollout.BatchInterval = defaultRolloutBatchInterval
+	}
+	now := clock.Now().Unix()
+	rollout.Status = &models.TierRolloutStatus{
+		State:     models.TierRolloutStatusStateRUNNING,
+		StartedAt: now,
+		UpdatedAt: now,
+	}
+
+	tier.Rollout = rollout
+	nerr = updateTierConfig(c, networkID, tier, version)
+	if nerr != nil {
+		return nerr
+	}
+	return c.NoContent(http.StatusCreated)
+}
+
+func pauseTierRolloutHandler(c echo.Context) error {
+	return setTierRolloutState(c, models.TierRolloutStatusStateRUNNING, models.TierRolloutStatusStatePAUSED)
+}
+
+func resumeTierRolloutHandler(c echo.Context) error {
+	return setTierRolloutState(c, models.TierRolloutStatusStatePAUSED, models.TierRolloutStatusStateRUNNING)
+}
+
+// setTierRolloutState moves the rollout of the tier from one state to
+// another. Resuming a rollout gives the upgraded gateways a full batch
+// interval to check in before the next health check.
+func setTierRolloutState(c echo.Context, from string, to string) error {
+	networkID, tierID, nerr := getNetworkAndTierIDs(c)
+	if nerr != nil {
+		return nerr
+	}
+	tier, version, nerr := loadTier(c, networkID, tierID)
+	if nerr != nil {
+		return nerr
+	}
+	if tier.Rollout == nil || tier.Rollout.Status == nil {
+		return obsidian.HttpError(merrors.ErrNotFound, http.StatusNotFound)
+	}
+	status := tier.Rollout.Status
+	if status.State != from {
+		return obsidian.HttpError(fmt.Errorf("rollout is %s, expected %s", status.State, from), http.StatusBadRequest)
+	}
+	status.State = to
+	status.Message = ""
+	status.UnhealthyGateways = nil
+	status.UpdatedAt = clock.Now().Unix()
+
+	nerr = updateTierConfig(c, networkID, tier, version)
+	if nerr != nil {
+		return nerr
 	}
 	return c.NoContent(http.StatusNoContent)
 }
 
+// loadTier returns the tier along with the version of its entity
+func loadTier(c echo.Context, networkID string, tierID string) (*models.Tier, uint64, *echo.HTTPError) {
+	entity, err := configurator.LoadEntityAs(
+		access.GetVerifiedOperator(c), networkID, orc8r.UpgradeTierEntityType, tierID,
+		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true, LoadMetadata: true},
+	)
+	if err == merrors.ErrNotFound {
+		return nil, 0, obsidian.HttpError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		return nil, 0, obsidian.HttpError(err, http.StatusInternalServerError)
+	}
+	return (&models.Tier{}).FromBackendModel(entity), entity.Version, nil
+}
+
+// updateTierConfig writes the tier's config, unless the tier was changed
+// since its entity was at the given version. The rollout engine updates tiers
+// concurrently, so a stale tier would undo its progress.
+func updateTierConfig(c echo.Context, networkID string, tier *models.Tier, version uint64) *echo.HTTPError {
+	_, err := configurator.UpdateEntityAs(
+		access.GetVerifiedOperator(c),
+		networkID,
+		configurator.EntityUpdateCriteria{
+			Type:            orc8r.UpgradeTierEntityType,
+			Key:             string(tier.ID),
+			NewConfig:       tier,
+			ExpectedVersion: swag.Uint64(version),
+		},
+	)
+	if err != nil {
+		return obsidian.HttpError(err, merrors.GetHttpStatusCode(err))
+	}
+	return nil
+}
+
 func getChannelID(c echo.Context) (string, *echo.HTTPError) {
 	channelID := c.Param("channel_id")
 	if channelID == "" {
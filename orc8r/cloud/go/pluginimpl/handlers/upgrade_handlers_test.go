@@ -10,7 +10,9 @@ package handlers_test
 
 import (
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/clock"
 	models1 "magma/orc8r/cloud/go/models"
 	"magma/orc8r/cloud/go/obsidian"
 	"magma/orc8r/cloud/go/obsidian/tests"
@@ -820,3 +822,183 @@ func TestPartialTierUpdates(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, expectedTier, actualTier)
 }
+
+func TestTierRollout(t *testing.T) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	test_init.StartTestService(t)
+	clock.SetAndFreezeClock(t, time.Unix(1000000, 0))
+	defer clock.UnfreezeClock(t)
+
+	e := echo.New()
+	manageTiers := "/magma/v1/networks/:network_id/tiers/:tier_id"
+	manageRollout := manageTiers + "/rollout"
+	obsidianHandlers := handlers.GetObsidianHandlers()
+	updateTier := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageTiers, obsidian.PUT).HandlerFunc
+	readRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageRollout, obsidian.GET).HandlerFunc
+	startRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageRollout, obsidian.POST).HandlerFunc
+	pauseRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageRollout+"/pause", obsidian.POST).HandlerFunc
+	resumeRollout := tests.GetHandlerByPathAndMethod(t, obsidianHandlers, manageRollout+"/resume", obsidian.POST).HandlerFunc
+
+	assert.NoError(t, configurator.CreateNetwork(configurator.Network{ID: "n1"}))
+	test_utils.RegisterGateway(t, "n1", "g1", nil)
+	test_utils.RegisterGateway(t, "n1", "g2", nil)
+	test_utils.RegisterGateway(t, "n1", "g3", nil)
+	tier := &models.Tier{ID: "tier1", Images: models.TierImages{}, Gateways: models.TierGateways{"g1", "g2"}, Version: "1.0.0"}
+	_, err := configurator.CreateEntity("n1", tier.ToNetworkEntity())
+	assert.NoError(t, err)
+
+	// no rollout yet
+	tc := tests.Test{
+		Method:         "GET",
+		URL:            manageRollout,
+		Handler:        readRollout,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier1"},
+		ExpectedStatus: 404,
+		ExpectedError:  "Not found",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// unknown tier
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            manageRollout,
+		Payload:        &models.TierRollout{TargetVersion: "2.0.0"},
+		Handler:        startRollout,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier2"},
+		ExpectedStatus: 404,
+		ExpectedError:  "Not found",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// validation failures
+	tc.ParamValues = []string{"n1", "tier1"}
+	tc.Payload = &models.TierRollout{TargetVersion: "2.0.0", BatchPercent: 101}
+	tc.ExpectedStatus = 400
+	tc.ExpectedError = "validation failure list:\n" +
+		"batch_percent in body should be less than or equal to 100"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.Payload = &models.TierRollout{TargetVersion: "2.0.0", Waves: []models.TierGateways{{"g1"}, {"g1", "g2"}}}
+	tc.ExpectedError = "gateway g1 is part of more than one rollout wave"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.Payload = &models.TierRollout{TargetVersion: "2.0.0", Waves: []models.TierGateways{{"g1"}, {"g3"}}}
+	tc.ExpectedError = "gateway g3 of rollout wave is not part of the tier"
+	tests.RunUnitTest(t, e, tc)
+
+	tc.Payload = &models.TierRollout{TargetVersion: "2.0.0", Waves: []models.TierGateways{{"g1"}}}
+	tc.ExpectedError = "gateway g2 of the tier is not part of any rollout wave"
+	tests.RunUnitTest(t, e, tc)
+
+	// happy case start
+	tc.Payload = &models.TierRollout{TargetVersion: "2.0.0", Waves: []models.TierGateways{{"g1"}, {"g2"}}}
+	tc.ExpectedStatus = 201
+	tc.ExpectedError = ""
+	tests.RunUnitTest(t, e, tc)
+
+	expected := &models.TierRollout{
+		TargetVersion: "2.0.0",
+		Waves:         []models.TierGateways{{"g1"}, {"g2"}},
+		BatchInterval: 600,
+		Status: &models.TierRolloutStatus{
+			State:     models.TierRolloutStatusStateRUNNING,
+			StartedAt: 1000000,
+			UpdatedAt: 1000000,
+		},
+	}
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            manageRollout,
+		Handler:        readRollout,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier1"},
+		ExpectedStatus: 200,
+		ExpectedResult: expected,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// only one rollout at a time
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            manageRollout,
+		Payload:        &models.TierRollout{TargetVersion: "3.0.0"},
+		Handler:        startRollout,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier1"},
+		ExpectedStatus: 409,
+		ExpectedError:  "a rollout is already in progress for this tier",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	// updating the tier keeps its rollout
+	tier.Name = "new name"
+	tc = tests.Test{
+		Method:         "PUT",
+		URL:            manageTiers,
+		Payload:        tier,
+		Handler:        updateTier,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier1"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+	iConfig, err := configurator.LoadEntityConfig("n1", orc8r.UpgradeTierEntityType, "tier1")
+	assert.NoError(t, err)
+	assert.Equal(t, expected, iConfig.(*models.Tier).Rollout)
+
+	// pause and resume
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            manageRollout + "/resume",
+		Handler:        resumeRollout,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier1"},
+		ExpectedStatus: 400,
+		ExpectedError:  "rollout is RUNNING, expected PAUSED",
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	clock.SetAndFreezeClock(t, time.Unix(1000100, 0))
+	tc.URL = manageRollout + "/pause"
+	tc.Handler = pauseRollout
+	tc.ExpectedStatus = 204
+	tc.ExpectedError = ""
+	tests.RunUnitTest(t, e, tc)
+
+	expected.Status.State = models.TierRolloutStatusStatePAUSED
+	expected.Status.UpdatedAt = 1000100
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            manageRollout,
+		Handler:        readRollout,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier1"},
+		ExpectedStatus: 200,
+		ExpectedResult: expected,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	tc = tests.Test{
+		Method:         "POST",
+		URL:            manageRollout + "/resume",
+		Handler:        resumeRollout,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier1"},
+		ExpectedStatus: 204,
+	}
+	tests.RunUnitTest(t, e, tc)
+
+	expected.Status.State = models.TierRolloutStatusStateRUNNING
+	tc = tests.Test{
+		Method:         "GET",
+		URL:            manageRollout,
+		Handler:        readRollout,
+		ParamNames:     []string{"network_id", "tier_id"},
+		ParamValues:    []string{"n1", "tier1"},
+		ExpectedStatus: 200,
+		ExpectedResult: expected,
+	}
+	tests.RunUnitTest(t, e, tc)
+}
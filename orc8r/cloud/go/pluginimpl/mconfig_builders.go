@@ -77,7 +77,8 @@ func getPackageVersionAndImages(magmadGateway configurator.NetworkEntity, graph
 	for _, image := range tierConfig.Images {
 		retImages = append(retImages, &mconfig.ImageSpec{Name: swag.StringValue(image.Name), Order: swag.Int64Value(image.Order)})
 	}
-	return tierConfig.Version.ToString(), retImages, nil
+	version := tierConfig.GetGatewayVersion(magmadGateway.Key)
+	return version.ToString(), retImages, nil
 }
 
 func getFluentbitMconfig(networkID string, gatewayID string, mdGw *models.MagmadGatewayConfigs) *mconfig.FluentBit {
@@ -173,6 +173,28 @@ func TestBaseOrchestratorMconfigBuilder_Build(t *testing.T) {
 		},
 	}
 	assert.Equal(t, expected, actual)
+
+	// Gateways upgraded by a rollout of the tier get its target version
+	tier.Config.(*models.Tier).Rollout = &models.TierRollout{
+		TargetVersion: "2.0.0-0",
+		Status: &models.TierRolloutStatus{
+			State:            models.TierRolloutStatusStateRUNNING,
+			UpgradedGateways: models.TierGateways{"gw1"},
+		},
+	}
+	actual = map[string]proto.Message{}
+	err = builder.Build("n1", "gw1", graph, nw, actual)
+	assert.NoError(t, err)
+	expected["magmad"].(*mconfig.MagmaD).PackageVersion = "2.0.0-0"
+	assert.Equal(t, expected, actual)
+
+	// Rolled back gateways return to the version of the tier
+	tier.Config.(*models.Tier).Rollout.Status.State = models.TierRolloutStatusStateROLLEDBACK
+	actual = map[string]proto.Message{}
+	err = builder.Build("n1", "gw1", graph, nw, actual)
+	assert.NoError(t, err)
+	expected["magmad"].(*mconfig.MagmaD).PackageVersion = "1.0.0-0"
+	assert.Equal(t, expected, actual)
 }
 
 func TestDnsdMconfigBuilder_Build(t *testing.T) {
@@ -10,14 +10,17 @@ package models
 
 import (
 	"fmt"
+	"sort"
 
 	merrors "magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/models"
 	"magma/orc8r/cloud/go/orc8r"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/storage"
 
 	"github.com/go-openapi/swag"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
 )
@@ -364,6 +367,24 @@ func (m *Tier) FromBackendModel(entity configurator.NetworkEntity) *Tier {
 	return tier
 }
 
+// GetGatewayVersion returns the version the given gateway of the tier should
+// run. Gateways which were upgraded by an ongoing rollout run its target
+// version, all others run the version of the tier.
+func (m *Tier) GetGatewayVersion(gatewayID string) TierVersion {
+	if m.Rollout.IsInProgress() && funk.Contains(m.Rollout.Status.UpgradedGateways, models.GatewayID(gatewayID)) {
+		return m.Rollout.TargetVersion
+	}
+	return m.Version
+}
+
+// IsInProgress returns true if the rollout is running or paused
+func (m *TierRollout) IsInProgress() bool {
+	if m == nil || m.Status == nil {
+		return false
+	}
+	return m.Status.State == TierRolloutStatusStateRUNNING || m.Status.State == TierRolloutStatusStatePAUSED
+}
+
 func (m *TierName) ToUpdateCriteria(networkID string, key string) ([]configurator.EntityUpdateCriteria, error) {
 	return []configurator.EntityUpdateCriteria{
 		configurator.EntityUpdateCriteria{
@@ -391,14 +412,16 @@ func (m *TierVersion) FromBackendModels(networkID string, key string) error {
 }
 
 func (m *TierVersion) ToUpdateCriteria(networkID, key string) ([]configurator.EntityUpdateCriteria, error) {
-	iConfig, err := configurator.LoadEntityConfig(networkID, orc8r.UpgradeTierEntityType, key)
+	// the rollout engine updates tiers concurrently, so only write the
+	// config back if the tier hasn't changed since it was loaded
+	entity, err := configurator.LoadEntity(networkID, orc8r.UpgradeTierEntityType, key, configurator.EntityLoadCriteria{LoadConfig: true})
 	if err != nil {
 		return []configurator.EntityUpdateCriteria{}, err
 	}
-	tier := iConfig.(*Tier)
+	tier := entity.Config.(*Tier)
 	tier.Version = *m
 	return []configurator.EntityUpdateCriteria{
-		configurator.EntityUpdateCriteria{Type: orc8r.UpgradeTierEntityType, Key: key, NewConfig: tier},
+		configurator.EntityUpdateCriteria{Type: orc8r.UpgradeTierEntityType, Key: key, NewConfig: tier, ExpectedVersion: swag.Uint64(entity.Version)},
 	}, nil
 }
 
@@ -416,15 +439,16 @@ func (m *TierImages) FromBackendModels(networkID string, key string) error {
 }
 
 func (m *TierImages) ToUpdateCriteria(networkID, key string) ([]configurator.EntityUpdateCriteria, error) {
-	iConfig, err := configurator.LoadEntityConfig(networkID, orc8r.UpgradeTierEntityType, key)
+	entity, err := configurator.LoadEntity(networkID, orc8r.UpgradeTierEntityType, key, configurator.EntityLoadCriteria{LoadConfig: true})
 	if err != nil {
 		return []configurator.EntityUpdateCriteria{}, err
 	}
-	tier := iConfig.(*Tier)
+	tier := entity.Config.(*Tier)
 	tier.Images = *m
 	return []configurator.EntityUpdateCriteria{
 		configurator.EntityUpdateCriteria{
 			Type: orc8r.UpgradeTierEntityType, Key: key, NewConfig: tier,
+			ExpectedVersion: swag.Uint64(entity.Version),
 		},
 	}, nil
 }
@@ -462,23 +486,23 @@ func (m *TierGateways) ToDeleteGatewayUpdateCriteria(tierID, gatewayID string) c
 }
 
 func (m *TierImage) ToUpdateCriteria(networkID string, key string) ([]configurator.EntityUpdateCriteria, error) {
-	iConfig, err := configurator.LoadEntityConfig(networkID, orc8r.UpgradeTierEntityType, key)
+	entity, err := configurator.LoadEntity(networkID, orc8r.UpgradeTierEntityType, key, configurator.EntityLoadCriteria{LoadConfig: true})
 	if err != nil {
 		return []configurator.EntityUpdateCriteria{}, err
 	}
-	tier := iConfig.(*Tier)
+	tier := entity.Config.(*Tier)
 	tier.Images = append(tier.Images, m)
 	return []configurator.EntityUpdateCriteria{
-		configurator.EntityUpdateCriteria{Type: orc8r.UpgradeTierEntityType, Key: key, NewConfig: tier},
+		configurator.EntityUpdateCriteria{Type: orc8r.UpgradeTierEntityType, Key: key, NewConfig: tier, ExpectedVersion: swag.Uint64(entity.Version)},
 	}, nil
 }
 
 func (m *TierImage) ToDeleteImageUpdateCriteria(networkID, tierID, imageName string) (configurator.EntityUpdateCriteria, error) {
-	iConfig, err := configurator.LoadEntityConfig(networkID, orc8r.UpgradeTierEntityType, tierID)
+	entity, err := configurator.LoadEntity(networkID, orc8r.UpgradeTierEntityType, tierID, configurator.EntityLoadCriteria{LoadConfig: true})
 	if err != nil {
 		return configurator.EntityUpdateCriteria{}, err
 	}
-	tier := iConfig.(*Tier)
+	tier := entity.Config.(*Tier)
 	for i, image := range tier.Images {
 		if swag.StringValue(image.Name) == imageName {
 			if i == len(tier.Images)-1 {
@@ -486,12 +510,142 @@ func (m *TierImage) ToDeleteImageUpdateCriteria(networkID, tierID, imageName str
 			} else {
 				tier.Images = append(tier.Images[:i], tier.Images[i+1:]...)
 			}
-			return configurator.EntityUpdateCriteria{Type: orc8r.UpgradeTierEntityType, Key: tierID, NewConfig: tier}, nil
+			return configurator.EntityUpdateCriteria{Type: orc8r.UpgradeTierEntityType, Key: tierID, NewConfig: tier, ExpectedVersion: swag.Uint64(entity.Version)}, nil
 		}
 	}
 	return configurator.EntityUpdateCriteria{}, merrors.ErrNotFound
 }
 
+func (m *GatewayCertificate) FromCertificateInfo(sn string, info *certprotos.CertificateInfo) *GatewayCertificate {
+	notBefore, _ := ptypes.Timestamp(info.NotBefore)
+	notAfter, _ := ptypes.Timestamp(info.NotAfter)
+	m.SerialNumber = sn
+	m.CertType = info.CertType.String()
+	m.NotBefore = notBefore.Unix()
+	m.NotAfter = notAfter.Unix()
+	m.Revoked = swag.Bool(false)
+	return m
+}
+
+func (m *GatewayCertificate) FromRevokedCertificate(revoked *certprotos.RevokedCertificate) *GatewayCertificate {
+	notBefore, _ := ptypes.Timestamp(revoked.NotBefore)
+	notAfter, _ := ptypes.Timestamp(revoked.NotAfter)
+	revokedAt, _ := ptypes.Timestamp(revoked.RevokedAt)
+	m.SerialNumber = revoked.Sn
+	m.CertType = revoked.CertType.String()
+	m.NotBefore = notBefore.Unix()
+	m.NotAfter = notAfter.Unix()
+	m.Revoked = swag.Bool(true)
+	m.RevokedAt = revokedAt.Unix()
+	return m
+}
+
+func (m *ConfigRevision) FromNetworkRevision(revision configurator.NetworkRevision) *ConfigRevision {
+	m.Version = revision.Version
+	m.ReplacedAt = revision.ReplacedAt
+	return m
+}
+
+func (m *ConfigRevision) FromEntityRevision(revision configurator.EntityRevision) *ConfigRevision {
+	m.Version = revision.Version
+	m.ReplacedAt = revision.ReplacedAt
+	return m
+}
+
+func (m *ConfigChange) FromConfiguratorChange(change configurator.ConfigChange) *ConfigChange {
+	m.Op = change.Op
+	m.Path = change.Path
+	m.From = change.From
+	m.To = change.To
+	return m
+}
+
+func (m *AuditEvent) FromConfiguratorAuditEvent(event configurator.AuditEvent) *AuditEvent {
+	m.ID = event.ID
+	if event.Entity != nil {
+		m.EntityType = event.Entity.Type
+		m.EntityKey = event.Entity.Key
+	}
+	m.Action = event.Action
+	m.Operator = event.Operator
+	m.Timestamp = event.Timestamp
+	m.BeforeDigest = event.BeforeDigest
+	m.AfterDigest = event.AfterDigest
+	return m
+}
+
+func (m *MconfigImpactReport) FromConfiguratorReport(report configurator.MconfigImpactReport) *MconfigImpactReport {
+	m.Gateways = make([]*GatewayMconfigImpact, 0, len(report.Gateways))
+	for _, impact := range report.Gateways {
+		m.Gateways = append(m.Gateways, (&GatewayMconfigImpact{}).FromConfiguratorImpact(impact))
+	}
+	return m
+}
+
+func (m *GatewayMconfigImpact) FromConfiguratorImpact(impact configurator.GatewayMconfigImpact) *GatewayMconfigImpact {
+	m.NetworkID = impact.NetworkID
+	m.GatewayID = impact.GatewayID
+	m.BeforeDigest = impact.BeforeDigest
+	m.AfterDigest = impact.AfterDigest
+	m.Changes = make([]*ConfigChange, 0, len(impact.Changes))
+	for _, change := range impact.Changes {
+		m.Changes = append(m.Changes, (&ConfigChange{}).FromConfiguratorChange(change))
+	}
+	return m
+}
+
+func (m *EntityLabels) FromBackendModels(networkID string, gatewayID string) error {
+	return m.FromEntity(networkID, orc8r.MagmadGatewayType, gatewayID)
+}
+
+func (m *EntityLabels) ToUpdateCriteria(networkID string, gatewayID string) ([]configurator.EntityUpdateCriteria, error) {
+	return m.ToEntityUpdateCriteria(networkID, orc8r.MagmadGatewayType, gatewayID)
+}
+
+// FromEntity loads the labels of the entity with the given type and key.
+func (m *EntityLabels) FromEntity(networkID string, entityType string, key string) error {
+	entity, err := configurator.LoadEntity(networkID, entityType, key, configurator.EntityLoadCriteria{LoadLabels: true})
+	if err != nil {
+		return err
+	}
+	*m = EntityLabels(entity.Labels)
+	if *m == nil {
+		*m = EntityLabels{}
+	}
+	return nil
+}
+
+// ToEntityUpdateCriteria returns the update which replaces the labels of the
+// entity with the given type and key by these labels.
+func (m *EntityLabels) ToEntityUpdateCriteria(networkID string, entityType string, key string) ([]configurator.EntityUpdateCriteria, error) {
+	entity, err := configurator.LoadEntity(networkID, entityType, key, configurator.EntityLoadCriteria{LoadLabels: true})
+	if err != nil {
+		return nil, err
+	}
+	update := configurator.EntityUpdateCriteria{
+		Type:                entityType,
+		Key:                 key,
+		LabelsToAddOrUpdate: *m,
+	}
+	for key := range entity.Labels {
+		if _, exists := (*m)[key]; !exists {
+			update.LabelsToDelete = append(update.LabelsToDelete, key)
+		}
+	}
+	sort.Strings(update.LabelsToDelete)
+	return []configurator.EntityUpdateCriteria{update}, nil
+}
+
+func (m *EntitySearchResult) FromConfiguratorEntity(entity configurator.NetworkEntity) *EntitySearchResult {
+	m.Type = entity.Type
+	m.ID = entity.Key
+	m.Name = entity.Name
+	m.Description = entity.Description
+	m.HardwareID = entity.PhysicalID
+	m.Labels = entity.Labels
+	return m
+}
+
 func getGatewayTKs(gateways []models.GatewayID) []storage.TypeAndKey {
 	return funk.Map(
 		gateways,
@@ -32,6 +32,9 @@ type Tier struct {
 	// name
 	Name TierName `json:"name,omitempty"`
 
+	// rollout
+	Rollout *TierRollout `json:"rollout,omitempty"`
+
 	// version
 	// Required: true
 	Version TierVersion `json:"version"`
@@ -57,6 +60,10 @@ func (m *Tier) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateRollout(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVersion(formats); err != nil {
 		res = append(res, err)
 	}
@@ -127,6 +134,24 @@ func (m *Tier) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Tier) validateRollout(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Rollout) { // not required
+		return nil
+	}
+
+	if m.Rollout != nil {
+		if err := m.Rollout.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("rollout")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Tier) validateVersion(formats strfmt.Registry) error {
 
 	if err := m.Version.Validate(formats); err != nil {
@@ -110,6 +110,37 @@ func (m *TierImage) ValidateModel() error {
 	return m.Validate(strfmt.Default)
 }
 
+func (m *TierRollout) ValidateModel() error {
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	seen := map[string]bool{}
+	for _, wave := range m.Waves {
+		if len(wave) == 0 {
+			return errors.New("rollout waves must not be empty")
+		}
+		for _, gwID := range wave {
+			if seen[string(gwID)] {
+				return fmt.Errorf("gateway %s is part of more than one rollout wave", gwID)
+			}
+			seen[string(gwID)] = true
+		}
+	}
+	return nil
+}
+
 func (m *GatewayStatus) ValidateModel() error {
 	return m.Validate(strfmt.Default)
 }
+
+func (m *EntityLabels) ValidateModel() error {
+	if err := m.Validate(strfmt.Default); err != nil {
+		return err
+	}
+	for key := range *m {
+		if key == "" {
+			return errors.New("label keys must not be empty")
+		}
+	}
+	return nil
+}
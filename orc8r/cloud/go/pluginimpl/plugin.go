@@ -31,7 +31,9 @@ import (
 	"magma/orc8r/cloud/go/services/metricsd/collection"
 	"magma/orc8r/cloud/go/services/metricsd/confignames"
 	"magma/orc8r/cloud/go/services/metricsd/exporters"
+	influxExp "magma/orc8r/cloud/go/services/metricsd/influxdb/exporters"
 	metricsdh "magma/orc8r/cloud/go/services/metricsd/obsidian/handlers"
+	otlpExp "magma/orc8r/cloud/go/services/metricsd/otlp/exporters"
 	promeExp "magma/orc8r/cloud/go/services/metricsd/prometheus/exporters"
 	"magma/orc8r/cloud/go/services/state"
 	stateh "magma/orc8r/cloud/go/services/state/obsidian/handlers"
@@ -60,11 +62,11 @@ func (*BaseOrchestratorPlugin) GetServices() []registry.ServiceLocation {
 func (*BaseOrchestratorPlugin) GetSerdes() []serde.Serde {
 	return []serde.Serde{
 		// State service serdes
-		state.NewStateSerde(orc8r.GatewayStateType, &models.GatewayStatus{}),
+		state.NewExpiringStateSerde(orc8r.GatewayStateType, &models.GatewayStatus{}, orc8r.GatewayStateTTL),
 		// For checkin_cli.py to test cloud < - > gateway connection
 		state.NewStateSerde(state.StringMapSerdeType, &state.StringToStringMap{}),
 		// For DirectoryD records
-		state.NewStateSerde(orc8r.DirectoryRecordType, &directoryd.DirectoryRecord{}),
+		state.NewGatewayBoundStateSerde(orc8r.DirectoryRecordType, &directoryd.DirectoryRecord{}, orc8r.DirectoryRecordTTL),
 
 		// Device service serdes
 		serde.NewBinarySerde(device.SerdeDomain, orc8r.AccessGatewayRecordType, &models.GatewayDevice{}),
@@ -124,6 +126,8 @@ func (*BaseOrchestratorPlugin) GetStreamerProviders() []providers.StreamProvider
 
 const (
 	ProfileNamePrometheus = "prometheus"
+	ProfileNameOTLP       = "otlp"
+	ProfileNameInfluxDB   = "influxdb"
 	ProfileNameExportAll  = "exportall"
 )
 
@@ -149,15 +153,41 @@ func getMetricsProfiles(metricsConfig *config.ConfigMap) []metricsd.MetricsProfi
 		Exporters:  []exporters.Exporter{prometheusCustomPushExporter},
 	}
 
+	profiles := []metricsd.MetricsProfile{prometheusProfile}
+	allExporters := []exporters.Exporter{prometheusCustomPushExporter}
+
+	// OTLP and InfluxDB profiles - Only available when their address is configured
+	if otlpEndpoint, err := metricsConfig.GetStringParam(confignames.OTLPEndpoint); err == nil && otlpEndpoint != "" {
+		otlpConfig := exporters.DefaultRemoteWriteConfig()
+		otlpConfig.Headers, _ = metricsConfig.GetStringMapParam(confignames.OTLPHeaders)
+		otlpExporter := otlpExp.NewOTLPExporter(otlpEndpoint, otlpConfig)
+		profiles = append(profiles, metricsd.MetricsProfile{
+			Name:       ProfileNameOTLP,
+			Collectors: controllerCollectors,
+			Exporters:  []exporters.Exporter{otlpExporter},
+		})
+		allExporters = append(allExporters, otlpExporter)
+	}
+	if influxAddress, err := metricsConfig.GetStringParam(confignames.InfluxDBWriteAddress); err == nil && influxAddress != "" {
+		influxConfig := exporters.DefaultRemoteWriteConfig()
+		if token, err := metricsConfig.GetStringParam(confignames.InfluxDBToken); err == nil && token != "" {
+			influxConfig.Headers = map[string]string{"Authorization": "Token " + token}
+		}
+		influxExporter := influxExp.NewInfluxDBExporter(influxAddress, influxConfig)
+		profiles = append(profiles, metricsd.MetricsProfile{
+			Name:       ProfileNameInfluxDB,
+			Collectors: controllerCollectors,
+			Exporters:  []exporters.Exporter{influxExporter},
+		})
+		allExporters = append(allExporters, influxExporter)
+	}
+
 	// ExportAllProfile - Exports to all exporters
 	exportAllProfile := metricsd.MetricsProfile{
 		Name:       ProfileNameExportAll,
 		Collectors: controllerCollectors,
-		Exporters:  []exporters.Exporter{prometheusCustomPushExporter},
+		Exporters:  allExporters,
 	}
 
-	return []metricsd.MetricsProfile{
-		prometheusProfile,
-		exportAllProfile,
-	}
+	return append(profiles, exportAllProfile)
 }
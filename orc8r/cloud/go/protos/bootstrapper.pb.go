@@ -157,6 +157,7 @@ type Response struct {
 	//	*Response_EchoResponse
 	//	*Response_RsaResponse
 	//	*Response_EcdsaResponse
+	//	*Response_CertResponse
 	Response             isResponse_Response `protobuf_oneof:"response"`
 	Csr                  *CSR                `protobuf:"bytes,6,opt,name=csr,proto3" json:"csr,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
@@ -219,12 +220,18 @@ type Response_EcdsaResponse struct {
 	EcdsaResponse *Response_ECDSA `protobuf:"bytes,5,opt,name=ecdsa_response,json=ecdsaResponse,proto3,oneof"`
 }
 
+type Response_CertResponse struct {
+	CertResponse *Response_Cert `protobuf:"bytes,7,opt,name=cert_response,json=certResponse,proto3,oneof"`
+}
+
 func (*Response_EchoResponse) isResponse_Response() {}
 
 func (*Response_RsaResponse) isResponse_Response() {}
 
 func (*Response_EcdsaResponse) isResponse_Response() {}
 
+func (*Response_CertResponse) isResponse_Response() {}
+
 func (m *Response) GetResponse() isResponse_Response {
 	if m != nil {
 		return m.Response
@@ -253,6 +260,13 @@ func (m *Response) GetEcdsaResponse() *Response_ECDSA {
 	return nil
 }
 
+func (m *Response) GetCertResponse() *Response_Cert {
+	if x, ok := m.GetResponse().(*Response_CertResponse); ok {
+		return x.CertResponse
+	}
+	return nil
+}
+
 func (m *Response) GetCsr() *CSR {
 	if m != nil {
 		return m.Csr
@@ -266,6 +280,7 @@ func (*Response) XXX_OneofWrappers() []interface{} {
 		(*Response_EchoResponse)(nil),
 		(*Response_RsaResponse)(nil),
 		(*Response_EcdsaResponse)(nil),
+		(*Response_CertResponse)(nil),
 	}
 }
 
@@ -394,6 +409,58 @@ func (m *Response_ECDSA) GetS() []byte {
 	return nil
 }
 
+// Renews the certificate of a gateway with its current, valid certificate
+// instead of the challenge key
+type Response_Cert struct {
+	// Current certificate of the gateway encoded in DER format
+	CertDer []byte `protobuf:"bytes,1,opt,name=cert_der,json=certDer,proto3" json:"cert_der,omitempty"`
+	// Signature of the challenge by the private key of the certificate,
+	// using the SHA256 hash and RSA PKCS1v15 or ASN.1 encoded ECDSA
+	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Response_Cert) Reset()         { *m = Response_Cert{} }
+func (m *Response_Cert) String() string { return proto.CompactTextString(m) }
+func (*Response_Cert) ProtoMessage()    {}
+func (*Response_Cert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b592b3c4e9ae6813, []int{2, 3}
+}
+
+func (m *Response_Cert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Response_Cert.Unmarshal(m, b)
+}
+func (m *Response_Cert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Response_Cert.Marshal(b, m, deterministic)
+}
+func (m *Response_Cert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Response_Cert.Merge(m, src)
+}
+func (m *Response_Cert) XXX_Size() int {
+	return xxx_messageInfo_Response_Cert.Size(m)
+}
+func (m *Response_Cert) XXX_DiscardUnknown() {
+	xxx_messageInfo_Response_Cert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Response_Cert proto.InternalMessageInfo
+
+func (m *Response_Cert) GetCertDer() []byte {
+	if m != nil {
+		return m.CertDer
+	}
+	return nil
+}
+
+func (m *Response_Cert) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("magma.orc8r.ChallengeKey_KeyType", ChallengeKey_KeyType_name, ChallengeKey_KeyType_value)
 	proto.RegisterType((*Challenge)(nil), "magma.orc8r.Challenge")
@@ -402,44 +469,48 @@ func init() {
 	proto.RegisterType((*Response_Echo)(nil), "magma.orc8r.Response.Echo")
 	proto.RegisterType((*Response_RSA)(nil), "magma.orc8r.Response.RSA")
 	proto.RegisterType((*Response_ECDSA)(nil), "magma.orc8r.Response.ECDSA")
+	proto.RegisterType((*Response_Cert)(nil), "magma.orc8r.Response.Cert")
 }
 
 func init() { proto.RegisterFile("orc8r/protos/bootstrapper.proto", fileDescriptor_b592b3c4e9ae6813) }
 
 var fileDescriptor_b592b3c4e9ae6813 = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x5d, 0x6f, 0xda, 0x30,
-	0x14, 0x4d, 0x0a, 0x6d, 0xe9, 0x25, 0xad, 0x90, 0xa7, 0x6e, 0x34, 0x30, 0xad, 0x4b, 0x5f, 0xfa,
-	0x14, 0x34, 0xa6, 0x4d, 0x7b, 0x98, 0xa6, 0x85, 0x8f, 0x42, 0xd5, 0x87, 0x4a, 0x4e, 0xa5, 0x49,
-	0x7b, 0x41, 0xa9, 0xb9, 0x0b, 0x11, 0x14, 0x67, 0xb6, 0x11, 0xca, 0x3f, 0xd9, 0x3f, 0xd8, 0xff,
-	0xd8, 0x2f, 0x9b, 0x62, 0x42, 0x42, 0x24, 0xd6, 0x97, 0x3d, 0xc5, 0xf6, 0x39, 0xf7, 0x9c, 0x9b,
-	0x63, 0x5f, 0x78, 0xc3, 0x05, 0xfb, 0x24, 0x3a, 0xb1, 0xe0, 0x8a, 0xcb, 0xce, 0x23, 0xe7, 0x4a,
-	0x2a, 0x11, 0xc4, 0x31, 0x0a, 0x57, 0x9f, 0x91, 0xfa, 0x53, 0x10, 0x3e, 0x05, 0xae, 0xa6, 0xd9,
-	0xed, 0x12, 0x9b, 0xa1, 0x50, 0xd1, 0x8f, 0x68, 0x4b, 0xb5, 0x5b, 0x25, 0x34, 0x9a, 0xe2, 0x52,
-	0x45, 0x2a, 0xd9, 0x80, 0x4e, 0x08, 0x27, 0xfd, 0x59, 0xb0, 0x58, 0xe0, 0x32, 0x44, 0xf2, 0x19,
-	0x6a, 0x73, 0x4c, 0x26, 0x2a, 0x89, 0xb1, 0x69, 0x5e, 0x9a, 0xd7, 0x67, 0xdd, 0xb7, 0xee, 0x8e,
-	0x8f, 0x9b, 0x33, 0xef, 0x30, 0x71, 0xef, 0x30, 0x79, 0x48, 0x62, 0xa4, 0xc7, 0xf3, 0xcd, 0x82,
-	0xb4, 0xe1, 0x84, 0x6d, 0x09, 0xcd, 0x83, 0x4b, 0xf3, 0xda, 0xa2, 0xc5, 0x81, 0xf3, 0xdb, 0x04,
-	0x6b, 0xb7, 0xfe, 0x3f, 0xcd, 0x1a, 0x50, 0x99, 0x63, 0x92, 0xd9, 0xa4, 0x4b, 0x67, 0x04, 0xc7,
-	0x19, 0x8b, 0xd4, 0xa0, 0x3a, 0xec, 0x8f, 0xef, 0x1b, 0x06, 0x79, 0x05, 0x2f, 0xfc, 0xfb, 0x9b,
-	0x87, 0x6f, 0x1e, 0x1d, 0x4e, 0xa8, 0xef, 0x4d, 0xfc, 0xb1, 0xd7, 0xfd, 0xf0, 0xb1, 0x61, 0x92,
-	0x0b, 0x38, 0xcf, 0x81, 0x61, 0x7f, 0x50, 0x40, 0x07, 0xce, 0x9f, 0x0a, 0xd4, 0x28, 0xca, 0x98,
-	0x2f, 0x25, 0x92, 0x77, 0x70, 0x38, 0x5b, 0x4f, 0xa2, 0xa9, 0x6e, 0xb1, 0xde, 0x6d, 0x97, 0x5a,
-	0xf4, 0x18, 0x43, 0x29, 0x47, 0x81, 0xc2, 0x75, 0x90, 0xdc, 0x0e, 0x68, 0x75, 0xb6, 0xbe, 0x9d,
-	0x3e, 0x9f, 0x03, 0xf1, 0xe0, 0x14, 0xd9, 0x8c, 0x4f, 0x44, 0xe6, 0xd0, 0xac, 0x68, 0x61, 0xbb,
-	0x24, 0xbc, 0xb5, 0x77, 0x87, 0x6c, 0xc6, 0xc7, 0x06, 0xb5, 0xd2, 0x92, 0xbc, 0xa7, 0x2f, 0x60,
-	0x09, 0x19, 0x14, 0x0a, 0x55, 0xad, 0x70, 0xb1, 0x5f, 0x81, 0xfa, 0xde, 0xd8, 0xa0, 0x75, 0x21,
-	0x83, 0xbc, 0x7e, 0x00, 0x67, 0xc8, 0xa6, 0xbb, 0x0a, 0x87, 0x5a, 0xa1, 0xf5, 0x8f, 0x1e, 0xd2,
-	0x78, 0xc6, 0x06, 0x3d, 0xd5, 0x45, 0xb9, 0x8a, 0x03, 0x15, 0x26, 0x45, 0xf3, 0x48, 0x97, 0x36,
-	0xca, 0x57, 0xe7, 0x53, 0x9a, 0x82, 0xb6, 0x03, 0xd5, 0xf4, 0x0f, 0x88, 0x0d, 0xb5, 0xdc, 0xcb,
-	0xd4, 0x89, 0xe4, 0x7b, 0xfb, 0x0a, 0x2a, 0xd4, 0xf7, 0xd2, 0xd4, 0x64, 0x14, 0x2e, 0x03, 0xb5,
-	0x12, 0x5b, 0x4e, 0x71, 0x60, 0x5f, 0xc1, 0xa1, 0x6e, 0x83, 0x58, 0x60, 0x8a, 0x0c, 0x36, 0x45,
-	0xba, 0x93, 0x59, 0xc4, 0xa6, 0xec, 0x41, 0xe1, 0xd2, 0xfd, 0x65, 0x82, 0xd5, 0xdb, 0x19, 0x1b,
-	0x72, 0x03, 0xd6, 0x08, 0x55, 0xf1, 0xd6, 0x9f, 0xbd, 0x49, 0xfb, 0xe5, 0xfe, 0xa7, 0xe8, 0x18,
-	0xe4, 0x2b, 0xd4, 0x29, 0xfe, 0x5c, 0xa1, 0x54, 0x7e, 0x14, 0x2e, 0xc9, 0xf9, 0xde, 0xcc, 0xec,
-	0x66, 0xb9, 0x7e, 0x33, 0x91, 0x2c, 0x50, 0xe8, 0x18, 0xbd, 0xd7, 0xdf, 0x5b, 0x1a, 0xec, 0x6c,
-	0xe6, 0x92, 0x2d, 0xf8, 0x6a, 0xda, 0x09, 0x79, 0x36, 0xa0, 0x8f, 0x47, 0xfa, 0xfb, 0xfe, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x97, 0xaa, 0x0d, 0x91, 0x03, 0x04, 0x00, 0x00,
+	// 547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x4f, 0xd6, 0x6c, 0xed, 0x5e, 0xb3, 0xa9, 0x32, 0x1a, 0x74, 0xd9, 0x10, 0x23, 0xbb, 0xec,
+	0x94, 0x8a, 0x22, 0x10, 0x07, 0x04, 0x64, 0x6d, 0xd7, 0x4e, 0x3b, 0x4c, 0x72, 0x26, 0x21, 0x71,
+	0xa9, 0xb2, 0xe4, 0x91, 0x46, 0xed, 0x9a, 0x60, 0xbb, 0xaa, 0xf2, 0x4d, 0xf8, 0x06, 0x7c, 0x13,
+	0x3e, 0x17, 0xb2, 0x9b, 0x26, 0x0d, 0x2a, 0xbd, 0x70, 0x4a, 0xec, 0xdf, 0xbf, 0xf7, 0x5e, 0x62,
+	0xc3, 0xab, 0x84, 0x05, 0x1f, 0x58, 0x27, 0x65, 0x89, 0x48, 0x78, 0xe7, 0x31, 0x49, 0x04, 0x17,
+	0xcc, 0x4f, 0x53, 0x64, 0x8e, 0xda, 0x23, 0xcd, 0x27, 0x3f, 0x7a, 0xf2, 0x1d, 0x45, 0xb3, 0xce,
+	0x2b, 0xec, 0x00, 0x99, 0x88, 0xbf, 0xc7, 0x6b, 0xaa, 0x75, 0x56, 0x41, 0xe3, 0x10, 0xe7, 0x22,
+	0x16, 0xd9, 0x0a, 0xb4, 0x23, 0x38, 0xec, 0x4d, 0xfc, 0xd9, 0x0c, 0xe7, 0x11, 0x92, 0x8f, 0xd0,
+	0x98, 0x62, 0x36, 0x16, 0x59, 0x8a, 0x6d, 0xfd, 0x42, 0xbf, 0x3a, 0xee, 0xbe, 0x76, 0x36, 0x72,
+	0x9c, 0x82, 0x79, 0x87, 0x99, 0x73, 0x87, 0xd9, 0x43, 0x96, 0x22, 0xad, 0x4f, 0x57, 0x2f, 0xe4,
+	0x1c, 0x0e, 0x83, 0x35, 0xa1, 0xbd, 0x77, 0xa1, 0x5f, 0x99, 0xb4, 0xdc, 0xb0, 0x7f, 0xe9, 0x60,
+	0x6e, 0xea, 0xff, 0x33, 0xac, 0x05, 0xb5, 0x29, 0x66, 0x79, 0x8c, 0x7c, 0xb5, 0x87, 0x50, 0xcf,
+	0x59, 0xa4, 0x01, 0xc6, 0xa0, 0x37, 0xba, 0x6f, 0x69, 0xe4, 0x05, 0x3c, 0xf3, 0xee, 0x6f, 0x1e,
+	0xbe, 0xba, 0x74, 0x30, 0xa6, 0x9e, 0x3b, 0xf6, 0x46, 0x6e, 0xf7, 0xdd, 0xfb, 0x96, 0x4e, 0x4e,
+	0xe1, 0xa4, 0x00, 0x06, 0xbd, 0x7e, 0x09, 0xed, 0xd9, 0xbf, 0x0d, 0x68, 0x50, 0xe4, 0x69, 0x32,
+	0xe7, 0x48, 0xde, 0xc0, 0xfe, 0x64, 0x39, 0x8e, 0x43, 0x55, 0x62, 0xb3, 0x7b, 0x5e, 0x29, 0xd1,
+	0x0d, 0x02, 0xe4, 0x7c, 0xe8, 0x0b, 0x5c, 0xfa, 0xd9, 0x6d, 0x9f, 0x1a, 0x93, 0xe5, 0x6d, 0xb8,
+	0x7b, 0x0e, 0xc4, 0x85, 0x23, 0x0c, 0x26, 0xc9, 0x98, 0xe5, 0x09, 0xed, 0x9a, 0x32, 0xb6, 0x2a,
+	0xc6, 0xeb, 0x78, 0x67, 0x10, 0x4c, 0x92, 0x91, 0x46, 0x4d, 0x29, 0x29, 0x6a, 0xfa, 0x04, 0x26,
+	0xe3, 0x7e, 0xe9, 0x60, 0x28, 0x87, 0xd3, 0xed, 0x0e, 0xd4, 0x73, 0x47, 0x1a, 0x6d, 0x32, 0xee,
+	0x17, 0xfa, 0x3e, 0x1c, 0x63, 0x10, 0x6e, 0x3a, 0xec, 0x2b, 0x87, 0xb3, 0x7f, 0xd4, 0x20, 0xc7,
+	0x33, 0xd2, 0xe8, 0x91, 0x12, 0x15, 0x2e, 0x2e, 0x1c, 0xc9, 0x3f, 0xad, 0x34, 0xa9, 0xef, 0x6a,
+	0xa4, 0x87, 0x4c, 0xc8, 0x46, 0xa4, 0xa4, 0xb0, 0xb0, 0xa1, 0x16, 0x70, 0xd6, 0x3e, 0x50, 0xc2,
+	0x56, 0xf5, 0xeb, 0x7b, 0x94, 0x4a, 0xd0, 0xb2, 0xc1, 0x90, 0x43, 0x20, 0x16, 0x34, 0x8a, 0x24,
+	0x5d, 0x0d, 0xb5, 0x58, 0x5b, 0x97, 0x50, 0xa3, 0x9e, 0x2b, 0x07, 0xcf, 0xe3, 0x68, 0xee, 0x8b,
+	0x05, 0x5b, 0x73, 0xca, 0x0d, 0xeb, 0x12, 0xf6, 0x55, 0x27, 0xc4, 0x04, 0x9d, 0xe5, 0xb0, 0xce,
+	0xe4, 0x8a, 0xe7, 0x5f, 0x49, 0xe7, 0xd6, 0x67, 0x30, 0x64, 0xa5, 0xe4, 0x14, 0x1a, 0xaa, 0xb9,
+	0x10, 0xd7, 0xd4, 0xba, 0x5c, 0xf7, 0x91, 0x55, 0x53, 0xf6, 0xfe, 0x4a, 0xb9, 0x86, 0xb2, 0xcc,
+	0xee, 0x4f, 0x1d, 0xcc, 0xeb, 0x8d, 0xa3, 0x4b, 0x6e, 0xc0, 0x1c, 0xa2, 0x28, 0xcf, 0xdb, 0xce,
+	0xbf, 0xc9, 0x7a, 0xbe, 0xfd, 0x38, 0xd8, 0x1a, 0xf9, 0x02, 0x4d, 0x8a, 0x3f, 0x16, 0xc8, 0x85,
+	0x17, 0x47, 0x73, 0x72, 0xb2, 0x75, 0xe4, 0x56, 0xbb, 0xaa, 0x5f, 0xdd, 0x0a, 0x81, 0x2f, 0xd0,
+	0xd6, 0xae, 0x5f, 0x7e, 0x3b, 0x53, 0x60, 0x67, 0x75, 0x37, 0x04, 0xb3, 0x64, 0x11, 0x76, 0xa2,
+	0x24, 0xbf, 0x24, 0x1e, 0x0f, 0xd4, 0xf3, 0xed, 0x9f, 0x01, 0x00, 0x97, 0xe1, 0x7a, 0x04, 0x87,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
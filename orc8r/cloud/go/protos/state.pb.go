@@ -24,6 +24,34 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type StateEvent_EventType int32
+
+const (
+	StateEvent_CREATE StateEvent_EventType = 0
+	StateEvent_UPDATE StateEvent_EventType = 1
+	StateEvent_DELETE StateEvent_EventType = 2
+)
+
+var StateEvent_EventType_name = map[int32]string{
+	0: "CREATE",
+	1: "UPDATE",
+	2: "DELETE",
+}
+
+var StateEvent_EventType_value = map[string]int32{
+	"CREATE": 0,
+	"UPDATE": 1,
+	"DELETE": 2,
+}
+
+func (x StateEvent_EventType) String() string {
+	return proto.EnumName(StateEvent_EventType_name, int32(x))
+}
+
+func (StateEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_645e93724c8b4dfe, []int{12, 0}
+}
+
 type StateID struct {
 	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	DeviceID             string   `protobuf:"bytes,2,opt,name=deviceID,proto3" json:"deviceID,omitempty"`
@@ -157,6 +185,63 @@ func (m *GetStatesResponse) GetStates() []*State {
 	return nil
 }
 
+type GetStatesAtRequest struct {
+	NetworkID string     `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Ids       []*StateID `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	// timeMs is the point in time, in milliseconds since epoch, at which to
+	// read the states
+	TimeMs               int64    `protobuf:"varint,3,opt,name=timeMs,proto3" json:"timeMs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStatesAtRequest) Reset()         { *m = GetStatesAtRequest{} }
+func (m *GetStatesAtRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStatesAtRequest) ProtoMessage()    {}
+func (*GetStatesAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_645e93724c8b4dfe, []int{3}
+}
+
+func (m *GetStatesAtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStatesAtRequest.Unmarshal(m, b)
+}
+func (m *GetStatesAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStatesAtRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStatesAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStatesAtRequest.Merge(m, src)
+}
+func (m *GetStatesAtRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStatesAtRequest.Size(m)
+}
+func (m *GetStatesAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStatesAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStatesAtRequest proto.InternalMessageInfo
+
+func (m *GetStatesAtRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *GetStatesAtRequest) GetIds() []*StateID {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *GetStatesAtRequest) GetTimeMs() int64 {
+	if m != nil {
+		return m.TimeMs
+	}
+	return 0
+}
+
 type ReportStatesRequest struct {
 	States               []*State `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -168,7 +253,7 @@ func (m *ReportStatesRequest) Reset()         { *m = ReportStatesRequest{} }
 func (m *ReportStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportStatesRequest) ProtoMessage()    {}
 func (*ReportStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_645e93724c8b4dfe, []int{3}
+	return fileDescriptor_645e93724c8b4dfe, []int{4}
 }
 
 func (m *ReportStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -207,7 +292,7 @@ func (m *ReportStatesResponse) Reset()         { *m = ReportStatesResponse{} }
 func (m *ReportStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*ReportStatesResponse) ProtoMessage()    {}
 func (*ReportStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_645e93724c8b4dfe, []int{4}
+	return fileDescriptor_645e93724c8b4dfe, []int{5}
 }
 
 func (m *ReportStatesResponse) XXX_Unmarshal(b []byte) error {
@@ -248,7 +333,7 @@ func (m *IDAndError) Reset()         { *m = IDAndError{} }
 func (m *IDAndError) String() string { return proto.CompactTextString(m) }
 func (*IDAndError) ProtoMessage()    {}
 func (*IDAndError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_645e93724c8b4dfe, []int{5}
+	return fileDescriptor_645e93724c8b4dfe, []int{6}
 }
 
 func (m *IDAndError) XXX_Unmarshal(b []byte) error {
@@ -302,7 +387,7 @@ func (m *DeleteStatesRequest) Reset()         { *m = DeleteStatesRequest{} }
 func (m *DeleteStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteStatesRequest) ProtoMessage()    {}
 func (*DeleteStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_645e93724c8b4dfe, []int{6}
+	return fileDescriptor_645e93724c8b4dfe, []int{7}
 }
 
 func (m *DeleteStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -348,7 +433,7 @@ func (m *SyncStatesRequest) Reset()         { *m = SyncStatesRequest{} }
 func (m *SyncStatesRequest) String() string { return proto.CompactTextString(m) }
 func (*SyncStatesRequest) ProtoMessage()    {}
 func (*SyncStatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_645e93724c8b4dfe, []int{7}
+	return fileDescriptor_645e93724c8b4dfe, []int{8}
 }
 
 func (m *SyncStatesRequest) XXX_Unmarshal(b []byte) error {
@@ -388,7 +473,7 @@ func (m *IDAndVersion) Reset()         { *m = IDAndVersion{} }
 func (m *IDAndVersion) String() string { return proto.CompactTextString(m) }
 func (*IDAndVersion) ProtoMessage()    {}
 func (*IDAndVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_645e93724c8b4dfe, []int{8}
+	return fileDescriptor_645e93724c8b4dfe, []int{9}
 }
 
 func (m *IDAndVersion) XXX_Unmarshal(b []byte) error {
@@ -434,7 +519,7 @@ func (m *SyncStatesResponse) Reset()         { *m = SyncStatesResponse{} }
 func (m *SyncStatesResponse) String() string { return proto.CompactTextString(m) }
 func (*SyncStatesResponse) ProtoMessage()    {}
 func (*SyncStatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_645e93724c8b4dfe, []int{9}
+	return fileDescriptor_645e93724c8b4dfe, []int{10}
 }
 
 func (m *SyncStatesResponse) XXX_Unmarshal(b []byte) error {
@@ -462,10 +547,182 @@ func (m *SyncStatesResponse) GetUnsyncedStates() []*IDAndVersion {
 	return nil
 }
 
+type WatchStatesRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// types restricts the watch to states of these types. If empty, states
+	// of every registered type are watched.
+	Types []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	// deviceIDs restricts the watch to states reported for these device IDs.
+	// If empty, states of every device are watched.
+	DeviceIDs []string `protobuf:"bytes,3,rep,name=deviceIDs,proto3" json:"deviceIDs,omitempty"`
+	// cursor is an opaque resume token from a previous WatchStatesResponse.
+	// If set, the stream starts with every change which happened since the
+	// cursor was issued. If empty, the stream starts from the current state.
+	// If the changes since the cursor are no longer retained, the stream fails
+	// with OUT_OF_RANGE and must be restarted without a cursor.
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchStatesRequest) Reset()         { *m = WatchStatesRequest{} }
+func (m *WatchStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchStatesRequest) ProtoMessage()    {}
+func (*WatchStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_645e93724c8b4dfe, []int{11}
+}
+
+func (m *WatchStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchStatesRequest.Unmarshal(m, b)
+}
+func (m *WatchStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchStatesRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchStatesRequest.Merge(m, src)
+}
+func (m *WatchStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchStatesRequest.Size(m)
+}
+func (m *WatchStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchStatesRequest proto.InternalMessageInfo
+
+func (m *WatchStatesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *WatchStatesRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *WatchStatesRequest) GetDeviceIDs() []string {
+	if m != nil {
+		return m.DeviceIDs
+	}
+	return nil
+}
+
+func (m *WatchStatesRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type StateEvent struct {
+	Type StateEvent_EventType `protobuf:"varint,1,opt,name=type,proto3,enum=magma.orc8r.StateEvent_EventType" json:"type,omitempty"`
+	// state is the state after the event. For DELETE events, only type and
+	// deviceID are set.
+	State                *State   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StateEvent) Reset()         { *m = StateEvent{} }
+func (m *StateEvent) String() string { return proto.CompactTextString(m) }
+func (*StateEvent) ProtoMessage()    {}
+func (*StateEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_645e93724c8b4dfe, []int{12}
+}
+
+func (m *StateEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StateEvent.Unmarshal(m, b)
+}
+func (m *StateEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StateEvent.Marshal(b, m, deterministic)
+}
+func (m *StateEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateEvent.Merge(m, src)
+}
+func (m *StateEvent) XXX_Size() int {
+	return xxx_messageInfo_StateEvent.Size(m)
+}
+func (m *StateEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateEvent proto.InternalMessageInfo
+
+func (m *StateEvent) GetType() StateEvent_EventType {
+	if m != nil {
+		return m.Type
+	}
+	return StateEvent_CREATE
+}
+
+func (m *StateEvent) GetState() *State {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+type WatchStatesResponse struct {
+	Events []*StateEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	// cursor can be passed to a subsequent WatchStatesRequest to resume the
+	// watch after the events in this response.
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchStatesResponse) Reset()         { *m = WatchStatesResponse{} }
+func (m *WatchStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchStatesResponse) ProtoMessage()    {}
+func (*WatchStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_645e93724c8b4dfe, []int{13}
+}
+
+func (m *WatchStatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchStatesResponse.Unmarshal(m, b)
+}
+func (m *WatchStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchStatesResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchStatesResponse.Merge(m, src)
+}
+func (m *WatchStatesResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchStatesResponse.Size(m)
+}
+func (m *WatchStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchStatesResponse proto.InternalMessageInfo
+
+func (m *WatchStatesResponse) GetEvents() []*StateEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *WatchStatesResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("magma.orc8r.StateEvent_EventType", StateEvent_EventType_name, StateEvent_EventType_value)
 	proto.RegisterType((*StateID)(nil), "magma.orc8r.StateID")
 	proto.RegisterType((*GetStatesRequest)(nil), "magma.orc8r.GetStatesRequest")
 	proto.RegisterType((*GetStatesResponse)(nil), "magma.orc8r.GetStatesResponse")
+	proto.RegisterType((*GetStatesAtRequest)(nil), "magma.orc8r.GetStatesAtRequest")
 	proto.RegisterType((*ReportStatesRequest)(nil), "magma.orc8r.ReportStatesRequest")
 	proto.RegisterType((*ReportStatesResponse)(nil), "magma.orc8r.ReportStatesResponse")
 	proto.RegisterType((*IDAndError)(nil), "magma.orc8r.IDAndError")
@@ -473,42 +730,57 @@ func init() {
 	proto.RegisterType((*SyncStatesRequest)(nil), "magma.orc8r.SyncStatesRequest")
 	proto.RegisterType((*IDAndVersion)(nil), "magma.orc8r.IDAndVersion")
 	proto.RegisterType((*SyncStatesResponse)(nil), "magma.orc8r.SyncStatesResponse")
+	proto.RegisterType((*WatchStatesRequest)(nil), "magma.orc8r.WatchStatesRequest")
+	proto.RegisterType((*StateEvent)(nil), "magma.orc8r.StateEvent")
+	proto.RegisterType((*WatchStatesResponse)(nil), "magma.orc8r.WatchStatesResponse")
 }
 
 func init() { proto.RegisterFile("orc8r/protos/state.proto", fileDescriptor_645e93724c8b4dfe) }
 
 var fileDescriptor_645e93724c8b4dfe = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x5f, 0xd3, 0xb1, 0xd1, 0xb7, 0x0a, 0xad, 0x6f, 0x95, 0xc8, 0x02, 0x85, 0x62, 0x21, 0x34,
-	0x71, 0x68, 0x61, 0xbd, 0xc0, 0x09, 0x15, 0x32, 0x50, 0x25, 0x04, 0x92, 0x2b, 0x06, 0x62, 0xa7,
-	0x12, 0x3f, 0x4d, 0x11, 0xab, 0x5d, 0x6c, 0x77, 0xa8, 0x9f, 0x91, 0x2f, 0x85, 0xea, 0x64, 0x6d,
-	0x9c, 0xac, 0x93, 0x76, 0xd8, 0xa9, 0x79, 0xfe, 0xfd, 0xe9, 0xcf, 0xcf, 0xcf, 0x86, 0x50, 0xe9,
-	0xe4, 0x8d, 0xee, 0xcf, 0xb4, 0xb2, 0xca, 0xf4, 0x8d, 0x9d, 0x58, 0xea, 0xb9, 0x02, 0xf7, 0xa6,
-	0x93, 0xf3, 0xe9, 0xa4, 0xe7, 0xf0, 0xe8, 0xd0, 0xa3, 0x25, 0x6a, 0x3a, 0x55, 0x32, 0xe3, 0x45,
-	0x1d, 0xdf, 0x81, 0xf4, 0x65, 0x9a, 0xd0, 0xe0, 0xd5, 0x20, 0x83, 0xd9, 0x5b, 0xd8, 0x1d, 0x2f,
-	0x5d, 0x47, 0x31, 0x22, 0x6c, 0xdb, 0xc5, 0x8c, 0xc2, 0x5a, 0xb7, 0x76, 0xd4, 0xe0, 0xee, 0x1b,
-	0x23, 0xb8, 0x2f, 0x68, 0xa9, 0x18, 0xc5, 0x61, 0xe0, 0xd6, 0x57, 0x35, 0xfb, 0x01, 0xfb, 0x9f,
-	0xc8, 0x3a, 0xb5, 0xe1, 0xf4, 0x67, 0x4e, 0xc6, 0xe2, 0x63, 0x68, 0x48, 0xb2, 0x7f, 0x95, 0xfe,
-	0x3d, 0x8a, 0x73, 0xa3, 0xf5, 0x02, 0xbe, 0x80, 0x7a, 0x2a, 0x4c, 0x18, 0x74, 0xeb, 0x47, 0x7b,
-	0xc7, 0xed, 0x5e, 0x61, 0x07, 0xbd, 0x3c, 0x04, 0x5f, 0x12, 0xd8, 0x3b, 0x68, 0x15, 0x9c, 0xcd,
-	0x4c, 0x49, 0x43, 0xf8, 0x12, 0x76, 0xdc, 0xfe, 0x4d, 0x58, 0x73, 0x7a, 0xac, 0xea, 0x79, 0xce,
-	0x60, 0x43, 0x38, 0xe0, 0x34, 0x53, 0xba, 0x94, 0xee, 0x36, 0x16, 0x67, 0xd0, 0xf6, 0x2d, 0xf2,
-	0x18, 0x1f, 0x60, 0x7f, 0x2e, 0xb5, 0x43, 0x48, 0x8c, 0x8b, 0x6e, 0x0f, 0x3d, 0xb7, 0x51, 0x3c,
-	0x94, 0xe2, 0x44, 0x6b, 0xa5, 0x79, 0x45, 0xc0, 0x38, 0xc0, 0x1a, 0xbf, 0x6d, 0xe3, 0xb1, 0x0d,
-	0xf7, 0x68, 0x29, 0x0c, 0xeb, 0x0e, 0xc8, 0x0a, 0x76, 0x06, 0x07, 0x31, 0x5d, 0x90, 0xa5, 0xbb,
-	0x38, 0x91, 0x8f, 0xd0, 0x1a, 0x2f, 0x64, 0xe2, 0x5b, 0xbf, 0x2e, 0xb5, 0xf3, 0xb0, 0xda, 0x80,
-	0x53, 0xd2, 0x26, 0x55, 0x72, 0xd5, 0xd5, 0x2f, 0xd0, 0x2c, 0xae, 0xe3, 0x73, 0x08, 0x52, 0xe1,
-	0x62, 0x6d, 0xfa, 0xfb, 0x20, 0x15, 0x18, 0xc2, 0xee, 0x65, 0x26, 0x70, 0xbd, 0xd8, 0xe6, 0x57,
-	0x25, 0xfb, 0x0e, 0x58, 0xcc, 0x95, 0x9f, 0xd1, 0x10, 0x1e, 0xcc, 0xa5, 0x59, 0xc8, 0xa4, 0x74,
-	0x42, 0x37, 0x04, 0x2c, 0x09, 0x8e, 0xff, 0x05, 0xd0, 0x74, 0x9f, 0xe3, 0xec, 0xc6, 0xe0, 0x67,
-	0x68, 0xac, 0x66, 0x12, 0x3b, 0x9e, 0x51, 0xf9, 0x16, 0x44, 0x4f, 0x36, 0xc1, 0x59, 0x3e, 0xb6,
-	0x85, 0xdf, 0xa0, 0x59, 0x9c, 0x2e, 0xec, 0x7a, 0x8a, 0x6b, 0x66, 0x37, 0x7a, 0x76, 0x03, 0x63,
-	0x65, 0x7b, 0x02, 0xcd, 0xe2, 0x0c, 0x94, 0x6c, 0xaf, 0x19, 0x8f, 0xa8, 0xe5, 0x31, 0x4e, 0x55,
-	0x2a, 0xd8, 0x16, 0x7e, 0x05, 0x58, 0x77, 0x15, 0xfd, 0xdd, 0x54, 0xc6, 0x20, 0x7a, 0xba, 0x11,
-	0xbf, 0xca, 0xf5, 0xbe, 0xf3, 0xf3, 0x91, 0xe3, 0xf4, 0xb3, 0xc7, 0x28, 0xb9, 0x50, 0x73, 0xd1,
-	0x3f, 0x57, 0xf9, 0xab, 0xf4, 0x6b, 0xc7, 0xfd, 0x0e, 0xfe, 0x07, 0x00, 0x00, 0xff, 0xff, 0x25,
-	0x92, 0x8a, 0xcd, 0xee, 0x04, 0x00, 0x00,
+	// 666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x5f, 0x4f, 0x13, 0x4f,
+	0x14, 0x65, 0x5b, 0x28, 0xbf, 0xde, 0x36, 0xa4, 0x5c, 0x9a, 0x9f, 0xcb, 0x2a, 0x52, 0x26, 0xc6,
+	0x34, 0x3e, 0xb4, 0x08, 0x31, 0xd1, 0x27, 0x53, 0xd9, 0xd5, 0x34, 0x41, 0x25, 0xd3, 0x02, 0x46,
+	0x12, 0x93, 0xba, 0x3b, 0xc1, 0x8d, 0x74, 0xa7, 0xce, 0x4e, 0x31, 0x7d, 0xf1, 0xa3, 0xf8, 0x25,
+	0xfd, 0x02, 0x66, 0x66, 0x97, 0xed, 0xfe, 0x69, 0x89, 0x24, 0xfa, 0x02, 0x73, 0xe7, 0x9e, 0x73,
+	0x7a, 0xe7, 0xcc, 0xdc, 0xbb, 0x60, 0x72, 0xe1, 0x3e, 0x17, 0xdd, 0x89, 0xe0, 0x92, 0x87, 0xdd,
+	0x50, 0x8e, 0x24, 0xeb, 0xe8, 0x00, 0x6b, 0xe3, 0xd1, 0xe5, 0x78, 0xd4, 0xd1, 0x79, 0x6b, 0x3b,
+	0x03, 0x73, 0xf9, 0x78, 0xcc, 0x83, 0x08, 0x67, 0xed, 0x64, 0x15, 0x98, 0xb8, 0xf6, 0x5d, 0x76,
+	0xb8, 0x7f, 0x18, 0xa5, 0xc9, 0x0b, 0x58, 0x1f, 0x28, 0xd5, 0xbe, 0x8d, 0x08, 0xab, 0x72, 0x36,
+	0x61, 0xa6, 0xd1, 0x32, 0xda, 0x55, 0xaa, 0xd7, 0x68, 0xc1, 0x7f, 0x1e, 0x53, 0x8c, 0xbe, 0x6d,
+	0x96, 0xf4, 0x7e, 0x12, 0x93, 0x0f, 0xd0, 0x78, 0xc3, 0xa4, 0x66, 0x87, 0x94, 0x7d, 0x9b, 0xb2,
+	0x50, 0xe2, 0x03, 0xa8, 0x06, 0x4c, 0x7e, 0xe7, 0xe2, 0x6b, 0xdf, 0x8e, 0x85, 0xe6, 0x1b, 0xf8,
+	0x18, 0xca, 0xbe, 0x17, 0x9a, 0xa5, 0x56, 0xb9, 0x5d, 0x3b, 0x68, 0x76, 0x52, 0x27, 0xe8, 0xc4,
+	0x45, 0x50, 0x05, 0x20, 0x2f, 0x61, 0x33, 0xa5, 0x1c, 0x4e, 0x78, 0x10, 0x32, 0x7c, 0x02, 0x15,
+	0x7d, 0xfe, 0xd0, 0x34, 0x34, 0x1f, 0x8b, 0x7c, 0x1a, 0x23, 0x88, 0x00, 0x4c, 0x04, 0x7a, 0xf2,
+	0xaf, 0x16, 0x87, 0xff, 0x43, 0x45, 0xfa, 0x63, 0xf6, 0x36, 0x34, 0xcb, 0x2d, 0xa3, 0x5d, 0xa6,
+	0x71, 0x44, 0x7a, 0xb0, 0x45, 0xd9, 0x84, 0x8b, 0x9c, 0x23, 0x77, 0x29, 0xfb, 0x02, 0x9a, 0x59,
+	0x89, 0xf8, 0xe8, 0x47, 0xd0, 0x98, 0x06, 0x42, 0x67, 0x98, 0x37, 0x48, 0xab, 0xdd, 0xcb, 0xa8,
+	0xf5, 0xed, 0x5e, 0xe0, 0x39, 0x42, 0x70, 0x41, 0x0b, 0x04, 0x42, 0x01, 0xe6, 0xf9, 0xbb, 0x5e,
+	0x36, 0x36, 0x61, 0x8d, 0x29, 0xa2, 0x3e, 0x74, 0x95, 0x46, 0x01, 0xb9, 0x80, 0x2d, 0x9b, 0x5d,
+	0x31, 0xc9, 0xfe, 0xc5, 0x2b, 0x78, 0x0d, 0x9b, 0x83, 0x59, 0xe0, 0x66, 0xa5, 0x9f, 0xe6, 0xec,
+	0xdc, 0x2e, 0x1a, 0x70, 0xc6, 0x44, 0xe8, 0xf3, 0x20, 0x71, 0xf5, 0x1d, 0xd4, 0xd3, 0xfb, 0xf8,
+	0x08, 0x4a, 0xbe, 0xa7, 0xcb, 0x5a, 0xf6, 0xf3, 0x25, 0xdf, 0x43, 0x13, 0xd6, 0xaf, 0x23, 0x82,
+	0xf6, 0x62, 0x95, 0xde, 0x84, 0xe4, 0x1c, 0x30, 0x5d, 0x57, 0x7c, 0x47, 0x3d, 0xd8, 0x98, 0x06,
+	0xe1, 0x2c, 0x70, 0x73, 0x37, 0x74, 0x4b, 0x81, 0x39, 0x02, 0xf9, 0x01, 0x78, 0x3e, 0x92, 0xee,
+	0x97, 0xbb, 0x98, 0xd9, 0x84, 0x35, 0x75, 0x77, 0x91, 0x9d, 0x55, 0x1a, 0x05, 0x8a, 0x73, 0x73,
+	0x73, 0xea, 0x99, 0xaa, 0xcc, 0x7c, 0x43, 0xbd, 0x60, 0x77, 0x2a, 0x42, 0x2e, 0xcc, 0x55, 0x2d,
+	0x17, 0x47, 0xe4, 0xa7, 0x01, 0xa0, 0x7f, 0xdb, 0xb9, 0x66, 0x81, 0xc4, 0x67, 0xa9, 0x27, 0xb2,
+	0x71, 0xb0, 0x57, 0x74, 0x4a, 0xc3, 0x3a, 0xfa, 0xef, 0x70, 0x36, 0x61, 0xf1, 0x2b, 0x6a, 0xc3,
+	0x9a, 0x36, 0x5e, 0xdb, 0xb6, 0xf8, 0xbd, 0x47, 0x00, 0xd2, 0x85, 0x6a, 0x42, 0x46, 0x80, 0xca,
+	0x11, 0x75, 0x7a, 0x43, 0xa7, 0xb1, 0xa2, 0xd6, 0xa7, 0x27, 0xb6, 0x5a, 0x1b, 0x6a, 0x6d, 0x3b,
+	0xc7, 0xce, 0xd0, 0x69, 0x94, 0xc8, 0x27, 0xd8, 0xca, 0x18, 0x14, 0x5b, 0xdf, 0x85, 0x0a, 0x53,
+	0x3a, 0x8b, 0x9b, 0x62, 0x5e, 0x2a, 0x8d, 0x61, 0x29, 0x03, 0x4a, 0x69, 0x03, 0x0e, 0x7e, 0x95,
+	0xa1, 0xae, 0xe1, 0x83, 0x68, 0x4c, 0xe2, 0x31, 0x54, 0x93, 0x39, 0x82, 0x3b, 0x19, 0xd9, 0xfc,
+	0xe8, 0xb3, 0x1e, 0x2e, 0x4b, 0x47, 0x55, 0x92, 0x15, 0x3c, 0x81, 0x5a, 0x6a, 0x2a, 0xe1, 0xee,
+	0x62, 0x42, 0x4f, 0xfe, 0xb9, 0xe2, 0x29, 0xd4, 0xd3, 0x03, 0x03, 0x5b, 0x19, 0xc6, 0x82, 0x71,
+	0x64, 0xed, 0xdd, 0x82, 0x48, 0x64, 0x1d, 0xa8, 0xa7, 0xdb, 0x3a, 0x27, 0xbb, 0xa0, 0xe3, 0xad,
+	0xcd, 0x0c, 0xe2, 0x8c, 0xfb, 0x1e, 0x59, 0xc1, 0xf7, 0x00, 0xf3, 0x46, 0xc1, 0xec, 0x69, 0x0a,
+	0x9d, 0x6d, 0xed, 0x2e, 0xcd, 0x27, 0x75, 0x0d, 0xa1, 0x96, 0xba, 0xff, 0x9c, 0x81, 0xc5, 0xd6,
+	0xb1, 0x5a, 0xcb, 0x01, 0x37, 0x9a, 0xfb, 0xc6, 0xab, 0x9d, 0x8f, 0xf7, 0x35, 0xac, 0x1b, 0x7d,
+	0x29, 0xdd, 0x2b, 0x3e, 0xf5, 0xba, 0x97, 0x3c, 0xfe, 0x64, 0x7e, 0xae, 0xe8, 0xff, 0x87, 0xbf,
+	0x07, 0x00, 0x42, 0xfa, 0xae, 0x70, 0x8b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -524,9 +796,15 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type StateServiceClient interface {
 	GetStates(ctx context.Context, in *GetStatesRequest, opts ...grpc.CallOption) (*GetStatesResponse, error)
+	// GetStatesAt returns states as they were at a prior point in time.
+	// This requires the state service to be configured to retain history.
+	GetStatesAt(ctx context.Context, in *GetStatesAtRequest, opts ...grpc.CallOption) (*GetStatesResponse, error)
 	ReportStates(ctx context.Context, in *ReportStatesRequest, opts ...grpc.CallOption) (*ReportStatesResponse, error)
 	DeleteStates(ctx context.Context, in *DeleteStatesRequest, opts ...grpc.CallOption) (*Void, error)
 	SyncStates(ctx context.Context, in *SyncStatesRequest, opts ...grpc.CallOption) (*SyncStatesResponse, error)
+	// WatchStates streams create, update and delete events for the states
+	// matching the request's filters.
+	WatchStates(ctx context.Context, in *WatchStatesRequest, opts ...grpc.CallOption) (StateService_WatchStatesClient, error)
 }
 
 type stateServiceClient struct {
@@ -546,6 +824,15 @@ func (c *stateServiceClient) GetStates(ctx context.Context, in *GetStatesRequest
 	return out, nil
 }
 
+func (c *stateServiceClient) GetStatesAt(ctx context.Context, in *GetStatesAtRequest, opts ...grpc.CallOption) (*GetStatesResponse, error) {
+	out := new(GetStatesResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.StateService/GetStatesAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *stateServiceClient) ReportStates(ctx context.Context, in *ReportStatesRequest, opts ...grpc.CallOption) (*ReportStatesResponse, error) {
 	out := new(ReportStatesResponse)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.StateService/ReportStates", in, out, opts...)
@@ -573,12 +860,50 @@ func (c *stateServiceClient) SyncStates(ctx context.Context, in *SyncStatesReque
 	return out, nil
 }
 
+func (c *stateServiceClient) WatchStates(ctx context.Context, in *WatchStatesRequest, opts ...grpc.CallOption) (StateService_WatchStatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_StateService_serviceDesc.Streams[0], "/magma.orc8r.StateService/WatchStates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateServiceWatchStatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type StateService_WatchStatesClient interface {
+	Recv() (*WatchStatesResponse, error)
+	grpc.ClientStream
+}
+
+type stateServiceWatchStatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateServiceWatchStatesClient) Recv() (*WatchStatesResponse, error) {
+	m := new(WatchStatesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // StateServiceServer is the server API for StateService service.
 type StateServiceServer interface {
 	GetStates(context.Context, *GetStatesRequest) (*GetStatesResponse, error)
+	// GetStatesAt returns states as they were at a prior point in time.
+	// This requires the state service to be configured to retain history.
+	GetStatesAt(context.Context, *GetStatesAtRequest) (*GetStatesResponse, error)
 	ReportStates(context.Context, *ReportStatesRequest) (*ReportStatesResponse, error)
 	DeleteStates(context.Context, *DeleteStatesRequest) (*Void, error)
 	SyncStates(context.Context, *SyncStatesRequest) (*SyncStatesResponse, error)
+	// WatchStates streams create, update and delete events for the states
+	// matching the request's filters.
+	WatchStates(*WatchStatesRequest, StateService_WatchStatesServer) error
 }
 
 // UnimplementedStateServiceServer can be embedded to have forward compatible implementations.
@@ -588,6 +913,9 @@ type UnimplementedStateServiceServer struct {
 func (*UnimplementedStateServiceServer) GetStates(ctx context.Context, req *GetStatesRequest) (*GetStatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStates not implemented")
 }
+func (*UnimplementedStateServiceServer) GetStatesAt(ctx context.Context, req *GetStatesAtRequest) (*GetStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatesAt not implemented")
+}
 func (*UnimplementedStateServiceServer) ReportStates(ctx context.Context, req *ReportStatesRequest) (*ReportStatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportStates not implemented")
 }
@@ -597,6 +925,9 @@ func (*UnimplementedStateServiceServer) DeleteStates(ctx context.Context, req *D
 func (*UnimplementedStateServiceServer) SyncStates(ctx context.Context, req *SyncStatesRequest) (*SyncStatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncStates not implemented")
 }
+func (*UnimplementedStateServiceServer) WatchStates(req *WatchStatesRequest, srv StateService_WatchStatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchStates not implemented")
+}
 
 func RegisterStateServiceServer(s *grpc.Server, srv StateServiceServer) {
 	s.RegisterService(&_StateService_serviceDesc, srv)
@@ -620,6 +951,24 @@ func _StateService_GetStates_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_GetStatesAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatesAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServiceServer).GetStatesAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.StateService/GetStatesAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServiceServer).GetStatesAt(ctx, req.(*GetStatesAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _StateService_ReportStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReportStatesRequest)
 	if err := dec(in); err != nil {
@@ -674,6 +1023,27 @@ func _StateService_SyncStates_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StateService_WatchStates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchStatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateServiceServer).WatchStates(m, &stateServiceWatchStatesServer{stream})
+}
+
+type StateService_WatchStatesServer interface {
+	Send(*WatchStatesResponse) error
+	grpc.ServerStream
+}
+
+type stateServiceWatchStatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateServiceWatchStatesServer) Send(m *WatchStatesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _StateService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.StateService",
 	HandlerType: (*StateServiceServer)(nil),
@@ -682,6 +1052,10 @@ var _StateService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStates",
 			Handler:    _StateService_GetStates_Handler,
 		},
+		{
+			MethodName: "GetStatesAt",
+			Handler:    _StateService_GetStatesAt_Handler,
+		},
 		{
 			MethodName: "ReportStates",
 			Handler:    _StateService_ReportStates_Handler,
@@ -695,6 +1069,12 @@ var _StateService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _StateService_SyncStates_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchStates",
+			Handler:       _StateService_WatchStates_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "orc8r/protos/state.proto",
 }
@@ -317,37 +317,39 @@ func init() {
 }
 
 var fileDescriptor_22887391e8a5ac6c = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcb, 0x6a, 0xdb, 0x40,
-	0x14, 0xed, 0xd8, 0xf1, 0x23, 0xd7, 0xa9, 0x53, 0x86, 0x10, 0x44, 0x9c, 0x16, 0xe3, 0x42, 0x51,
-	0x37, 0x72, 0x71, 0x29, 0x98, 0xec, 0x6a, 0x13, 0xfa, 0x58, 0x95, 0xc9, 0xaa, 0xdd, 0x84, 0x89,
-	0x74, 0xb1, 0x4c, 0x64, 0x8d, 0x7c, 0x67, 0xe4, 0xa0, 0x55, 0x3f, 0xa4, 0x1f, 0xd2, 0xdf, 0xea,
-	0x17, 0x94, 0xa2, 0x91, 0xe4, 0x47, 0x30, 0xee, 0x22, 0x2b, 0xcf, 0x3d, 0x73, 0x1f, 0xe7, 0xdc,
-	0x63, 0x0d, 0xbc, 0x56, 0xe4, 0x8f, 0x69, 0x98, 0x90, 0x32, 0x4a, 0x0f, 0x75, 0x16, 0xfb, 0xb7,
-	0x94, 0xf8, 0xb7, 0x1a, 0x69, 0x35, 0xf7, 0xd1, 0xb3, 0x38, 0xef, 0x2c, 0xe4, 0x6c, 0x21, 0x3d,
-	0x9b, 0x3a, 0xf8, 0xc3, 0xa0, 0xfb, 0x49, 0x1a, 0x7c, 0x90, 0x99, 0xc0, 0x65, 0x8a, 0xda, 0x70,
-	0x0e, 0x47, 0xb3, 0x87, 0x2f, 0x81, 0xc3, 0xfa, 0xcc, 0x3d, 0x16, 0xf6, 0xcc, 0x2f, 0xe1, 0x58,
-	0xa6, 0x26, 0x54, 0x34, 0x37, 0x99, 0x53, 0xb3, 0x17, 0x1b, 0x20, 0xaf, 0x48, 0xa4, 0x09, 0x9d,
-	0x7a, 0x51, 0x91, 0x9f, 0xf9, 0x04, 0x5a, 0x21, 0xca, 0x00, 0x49, 0x3b, 0x8d, 0x7e, 0xdd, 0xed,
-	0x8c, 0x5c, 0x6f, 0x6b, 0xae, 0xb7, 0x3b, 0xd3, 0xfb, 0x5c, 0xa4, 0x5e, 0xc7, 0x86, 0x32, 0x51,
-	0x15, 0x72, 0x07, 0x5a, 0x89, 0xcc, 0x22, 0x25, 0x03, 0xa7, 0xd9, 0x67, 0xee, 0x89, 0xa8, 0xc2,
-	0x8b, 0x2b, 0x38, 0xd9, 0x2e, 0xe1, 0x2f, 0xa0, 0x7e, 0x8f, 0x59, 0x49, 0x39, 0x3f, 0xf2, 0x33,
-	0x68, 0xac, 0x64, 0x94, 0x62, 0xc9, 0xb6, 0x08, 0xae, 0x6a, 0x63, 0x36, 0xf8, 0xcb, 0xe0, 0x74,
-	0x3d, 0x5e, 0x27, 0x2a, 0xd6, 0xc8, 0xcf, 0xa1, 0xa9, 0x8d, 0x34, 0xa9, 0x2e, 0x5b, 0x94, 0x11,
-	0x9f, 0x6e, 0x54, 0xd4, 0xac, 0x8a, 0xb7, 0xfb, 0x55, 0x14, 0x6d, 0xfe, 0x2f, 0xa3, 0xbe, 0x23,
-	0x23, 0xa7, 0x8d, 0x44, 0xce, 0x51, 0x41, 0x1b, 0x89, 0xf8, 0x1b, 0xe8, 0xde, 0x23, 0x26, 0x53,
-	0x15, 0xc7, 0x1f, 0x7d, 0x33, 0x5f, 0xa1, 0xd3, 0xe8, 0x33, 0xb7, 0x2d, 0x1e, 0xa1, 0x4f, 0x5a,
-	0xc0, 0x2f, 0x06, 0xdd, 0x9b, 0x2c, 0xf6, 0xc5, 0xb7, 0x69, 0xe5, 0xf9, 0x19, 0x34, 0x08, 0x97,
-	0xa5, 0xe9, 0xcf, 0x45, 0x11, 0xf0, 0x0f, 0xd0, 0x22, 0x5c, 0x4e, 0x54, 0x50, 0x78, 0xde, 0x19,
-	0xf5, 0x0e, 0x78, 0x28, 0xaa, 0xdc, 0xfc, 0xcf, 0x12, 0xa2, 0x24, 0x33, 0x41, 0x69, 0xac, 0xe2,
-	0xb6, 0xd8, 0x00, 0xfc, 0x15, 0x80, 0xaf, 0xe2, 0x78, 0x1a, 0x29, 0x8d, 0x81, 0x95, 0xde, 0x16,
-	0x5b, 0xc8, 0xe0, 0x27, 0x9c, 0xae, 0xc9, 0x95, 0xee, 0xec, 0x67, 0x37, 0x86, 0x36, 0xa1, 0x4e,
-	0xb6, 0xe8, 0x5d, 0x1e, 0x32, 0x47, 0xac, 0xb3, 0x0f, 0x13, 0x1c, 0xfd, 0xde, 0xac, 0xe7, 0xa6,
-	0xf8, 0x70, 0xf8, 0x77, 0x38, 0xbf, 0xd6, 0x46, 0xde, 0x45, 0x73, 0x1d, 0x56, 0x57, 0x86, 0x50,
-	0x2e, 0xf8, 0xee, 0xc8, 0x47, 0xc4, 0x2f, 0x7a, 0xfb, 0x6f, 0xed, 0xbe, 0x06, 0xcf, 0x5c, 0xf6,
-	0x8e, 0xf1, 0xaf, 0xd0, 0x2a, 0xf1, 0x27, 0xf7, 0x9a, 0xbc, 0xfc, 0xd1, 0xb3, 0x39, 0xc3, 0xe2,
-	0x19, 0xf0, 0x23, 0x95, 0x06, 0xc3, 0x99, 0x2a, 0xdf, 0x83, 0xbb, 0xa6, 0xfd, 0x7d, 0xff, 0x2f,
-	0x00, 0x00, 0xff, 0xff, 0xc3, 0x69, 0x82, 0xd4, 0x26, 0x04, 0x00, 0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0x1a, 0x31,
+	0x10, 0x8e, 0x21, 0xfc, 0x64, 0x48, 0x49, 0x65, 0xa5, 0xd1, 0x0a, 0xd2, 0x0a, 0x51, 0xa9, 0xda,
+	0x5e, 0x20, 0xa2, 0xaa, 0x84, 0x72, 0x2b, 0x28, 0xfd, 0x3b, 0x55, 0x8e, 0x54, 0xa9, 0xbd, 0x44,
+	0xce, 0xee, 0x08, 0x50, 0x60, 0xbd, 0x8c, 0x0d, 0x68, 0x4f, 0x7d, 0x90, 0x3e, 0x48, 0x5f, 0xab,
+	0x4f, 0x50, 0x55, 0x78, 0xbd, 0xfc, 0x09, 0x91, 0x43, 0x4e, 0xeb, 0xf9, 0x3c, 0xe3, 0xf9, 0xbe,
+	0xf9, 0xd6, 0x86, 0xd7, 0x8a, 0x82, 0x2e, 0xb5, 0x63, 0x52, 0x46, 0xe9, 0xb6, 0x4e, 0xa2, 0xe0,
+	0x8e, 0xe2, 0xe0, 0x4e, 0x23, 0xcd, 0x47, 0x01, 0xb6, 0x2c, 0xce, 0x2b, 0x13, 0x39, 0x98, 0xc8,
+	0x96, 0x4d, 0x6d, 0xfe, 0x65, 0x50, 0xfd, 0x24, 0x0d, 0x2e, 0x64, 0x22, 0x70, 0x3a, 0x43, 0x6d,
+	0x38, 0x87, 0xe3, 0xc1, 0xe2, 0x4b, 0xe8, 0xb1, 0x06, 0xf3, 0x4f, 0x84, 0x5d, 0xf3, 0x4b, 0x38,
+	0x91, 0x33, 0x33, 0x54, 0x34, 0x32, 0x89, 0x97, 0xb3, 0x1b, 0x6b, 0x60, 0x59, 0x11, 0x4b, 0x33,
+	0xf4, 0xf2, 0x69, 0xc5, 0x72, 0xcd, 0x7b, 0x50, 0x1a, 0xa2, 0x0c, 0x91, 0xb4, 0x57, 0x68, 0xe4,
+	0xfd, 0x4a, 0xc7, 0x6f, 0x6d, 0xf4, 0x6d, 0x6d, 0xf7, 0x6c, 0x7d, 0x4e, 0x53, 0x6f, 0x22, 0x43,
+	0x89, 0xc8, 0x0a, 0xb9, 0x07, 0xa5, 0x58, 0x26, 0x63, 0x25, 0x43, 0xaf, 0xd8, 0x60, 0xfe, 0xa9,
+	0xc8, 0xc2, 0xda, 0x35, 0x9c, 0x6e, 0x96, 0xf0, 0xe7, 0x90, 0x7f, 0xc0, 0xc4, 0x51, 0x5e, 0x2e,
+	0xf9, 0x39, 0x14, 0xe6, 0x72, 0x3c, 0x43, 0xc7, 0x36, 0x0d, 0xae, 0x73, 0x5d, 0xd6, 0xfc, 0xc7,
+	0xe0, 0x6c, 0xd5, 0x5e, 0xc7, 0x2a, 0xd2, 0xc8, 0x2f, 0xa0, 0xa8, 0x8d, 0x34, 0x33, 0xed, 0x8e,
+	0x70, 0x11, 0xef, 0xaf, 0x55, 0xe4, 0xac, 0x8a, 0xb7, 0xfb, 0x55, 0xa4, 0xc7, 0x3c, 0x2e, 0x23,
+	0xbf, 0x25, 0x63, 0x49, 0x1b, 0x89, 0xbc, 0xe3, 0x94, 0x36, 0x12, 0xf1, 0x37, 0x50, 0x7d, 0x40,
+	0x8c, 0xfb, 0x2a, 0x8a, 0x3e, 0x04, 0x66, 0x34, 0x47, 0xaf, 0xd0, 0x60, 0x7e, 0x59, 0xec, 0xa0,
+	0x4f, 0x1a, 0xc0, 0x6f, 0x06, 0xd5, 0xdb, 0x24, 0x0a, 0xc4, 0xb7, 0x7e, 0xe6, 0xf9, 0x39, 0x14,
+	0x08, 0xa7, 0xce, 0xf4, 0x67, 0x22, 0x0d, 0xf8, 0x7b, 0x28, 0x11, 0x4e, 0x7b, 0x2a, 0x4c, 0x3d,
+	0xaf, 0x74, 0xea, 0x07, 0x3c, 0x14, 0x59, 0xee, 0xf2, 0x67, 0x19, 0xa2, 0x24, 0xd3, 0x43, 0x69,
+	0xac, 0xe2, 0xb2, 0x58, 0x03, 0xfc, 0x15, 0x40, 0xa0, 0xa2, 0xa8, 0x3f, 0x56, 0x1a, 0x43, 0x2b,
+	0xbd, 0x2c, 0x36, 0x90, 0xe6, 0x2f, 0x38, 0x5b, 0x91, 0x73, 0xee, 0xec, 0x67, 0xd7, 0x85, 0x32,
+	0xa1, 0x8e, 0x37, 0xe8, 0x5d, 0x1e, 0x32, 0x47, 0xac, 0xb2, 0x0f, 0x13, 0xec, 0xfc, 0x59, 0x8f,
+	0xe7, 0x36, 0xbd, 0x38, 0xfc, 0x07, 0x5c, 0xdc, 0x68, 0x23, 0xef, 0xc7, 0x23, 0x3d, 0xcc, 0xb6,
+	0x0c, 0xa1, 0x9c, 0xf0, 0xed, 0x96, 0x3b, 0xc4, 0x6b, 0xf5, 0xfd, 0xbb, 0x76, 0x5e, 0xcd, 0x23,
+	0x9f, 0x5d, 0x31, 0xfe, 0x15, 0x4a, 0x0e, 0x7f, 0xf2, 0x59, 0x1d, 0x02, 0xcf, 0xe1, 0x1f, 0x15,
+	0x2d, 0x24, 0x85, 0xa3, 0x68, 0x90, 0x49, 0xf8, 0x0e, 0x2f, 0x1c, 0xb8, 0x73, 0xdd, 0x0f, 0x79,
+	0x5a, 0x3b, 0x38, 0xd1, 0xe6, 0xd1, 0x15, 0xeb, 0xbd, 0xfc, 0x59, 0xb7, 0x29, 0xed, 0xf4, 0xe9,
+	0x09, 0xc6, 0x6a, 0x16, 0xb6, 0x07, 0xca, 0xbd, 0x41, 0xf7, 0x45, 0xfb, 0x7d, 0xf7, 0x7f, 0x00,
+	0x2f, 0xac, 0x93, 0xe2, 0x9a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -537,3 +539,108 @@ var _SyncRPCService_serviceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "orc8r/protos/sync_rpc_service.proto",
 }
+
+// SyncRPCForwardingServiceClient is the client API for SyncRPCForwardingService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SyncRPCForwardingServiceClient interface {
+	// forwards a GatewayRequest to the dispatcher replica which holds the
+	// SyncRPC stream of the gateway, and streams back its GatewayResponses
+	// until the response is complete.
+	ForwardGatewayRequest(ctx context.Context, in *GatewayRequest, opts ...grpc.CallOption) (SyncRPCForwardingService_ForwardGatewayRequestClient, error)
+}
+
+type syncRPCForwardingServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSyncRPCForwardingServiceClient(cc *grpc.ClientConn) SyncRPCForwardingServiceClient {
+	return &syncRPCForwardingServiceClient{cc}
+}
+
+func (c *syncRPCForwardingServiceClient) ForwardGatewayRequest(ctx context.Context, in *GatewayRequest, opts ...grpc.CallOption) (SyncRPCForwardingService_ForwardGatewayRequestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_SyncRPCForwardingService_serviceDesc.Streams[0], "/magma.orc8r.SyncRPCForwardingService/ForwardGatewayRequest", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &syncRPCForwardingServiceForwardGatewayRequestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SyncRPCForwardingService_ForwardGatewayRequestClient interface {
+	Recv() (*GatewayResponse, error)
+	grpc.ClientStream
+}
+
+type syncRPCForwardingServiceForwardGatewayRequestClient struct {
+	grpc.ClientStream
+}
+
+func (x *syncRPCForwardingServiceForwardGatewayRequestClient) Recv() (*GatewayResponse, error) {
+	m := new(GatewayResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// SyncRPCForwardingServiceServer is the server API for SyncRPCForwardingService service.
+type SyncRPCForwardingServiceServer interface {
+	// forwards a GatewayRequest to the dispatcher replica which holds the
+	// SyncRPC stream of the gateway, and streams back its GatewayResponses
+	// until the response is complete.
+	ForwardGatewayRequest(*GatewayRequest, SyncRPCForwardingService_ForwardGatewayRequestServer) error
+}
+
+// UnimplementedSyncRPCForwardingServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSyncRPCForwardingServiceServer struct {
+}
+
+func (*UnimplementedSyncRPCForwardingServiceServer) ForwardGatewayRequest(req *GatewayRequest, srv SyncRPCForwardingService_ForwardGatewayRequestServer) error {
+	return status.Errorf(codes.Unimplemented, "method ForwardGatewayRequest not implemented")
+}
+
+func RegisterSyncRPCForwardingServiceServer(s *grpc.Server, srv SyncRPCForwardingServiceServer) {
+	s.RegisterService(&_SyncRPCForwardingService_serviceDesc, srv)
+}
+
+func _SyncRPCForwardingService_ForwardGatewayRequest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GatewayRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SyncRPCForwardingServiceServer).ForwardGatewayRequest(m, &syncRPCForwardingServiceForwardGatewayRequestServer{stream})
+}
+
+type SyncRPCForwardingService_ForwardGatewayRequestServer interface {
+	Send(*GatewayResponse) error
+	grpc.ServerStream
+}
+
+type syncRPCForwardingServiceForwardGatewayRequestServer struct {
+	grpc.ServerStream
+}
+
+func (x *syncRPCForwardingServiceForwardGatewayRequestServer) Send(m *GatewayResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _SyncRPCForwardingService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "magma.orc8r.SyncRPCForwardingService",
+	HandlerType: (*SyncRPCForwardingServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ForwardGatewayRequest",
+			Handler:       _SyncRPCForwardingService_ForwardGatewayRequest_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "orc8r/protos/sync_rpc_service.proto",
+}
@@ -95,6 +95,43 @@ func Deserialize(domain string, typeVal string, data []byte) (interface{}, error
 	return subregistry.deserialize(typeVal, data)
 }
 
+// GetSerde returns the Serde registered for the given domain and type. This
+// function is thread-safe.
+func GetSerde(domain string, typeVal string) (Serde, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+	subregistry, ok := registry.serdeRegistriesByDomain[domain]
+	if !ok {
+		return nil, fmt.Errorf("No serdes registered for domain %s", domain)
+	}
+	subregistry.RLock()
+	defer subregistry.RUnlock()
+	return subregistry.getSerdeUnsafe(typeVal)
+}
+
+// GetSerdesForDomain returns all Serdes registered for the given domain,
+// sorted by type. This function is thread-safe.
+func GetSerdesForDomain(domain string) []Serde {
+	registry.RLock()
+	defer registry.RUnlock()
+	subregistry, ok := registry.serdeRegistriesByDomain[domain]
+	if !ok {
+		return []Serde{}
+	}
+	subregistry.RLock()
+	defer subregistry.RUnlock()
+	types := make([]string, 0, len(subregistry.serdesByKey))
+	for t := range subregistry.serdesByKey {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	ret := make([]Serde, 0, len(types))
+	for _, t := range types {
+		ret = append(ret, subregistry.serdesByKey[t])
+	}
+	return ret
+}
+
 func getSerdesByDomain(serdesToGroup []Serde) map[string][]Serde {
 	ret := map[string][]Serde{}
 	for _, s := range serdesToGroup {
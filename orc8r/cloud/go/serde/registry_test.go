@@ -66,3 +66,32 @@ func TestDeserialize(t *testing.T) {
 	assert.EqualError(t, err, "No Serde found for type baz")
 
 }
+
+func TestGetSerde(t *testing.T) {
+	serde.UnregisterAllSerdes(t)
+	defer func() {
+		serde.UnregisterAllSerdes(t)
+	}()
+
+	mockSerde1 := &mocks.Serde{}
+	mockSerde1.On("GetDomain").Return("foo")
+	mockSerde1.On("GetType").Return("bar")
+	mockSerde2 := &mocks.Serde{}
+	mockSerde2.On("GetDomain").Return("foo")
+	mockSerde2.On("GetType").Return("baz")
+
+	err := serde.RegisterSerdes(mockSerde2, mockSerde1)
+	assert.NoError(t, err)
+
+	actual, err := serde.GetSerde("foo", "bar")
+	assert.NoError(t, err)
+	assert.Equal(t, mockSerde1, actual)
+
+	_, err = serde.GetSerde("bar", "foo")
+	assert.EqualError(t, err, "No serdes registered for domain bar")
+	_, err = serde.GetSerde("foo", "qux")
+	assert.EqualError(t, err, "No Serde found for type qux")
+
+	assert.Equal(t, []serde.Serde{mockSerde1, mockSerde2}, serde.GetSerdesForDomain("foo"))
+	assert.Empty(t, serde.GetSerdesForDomain("bar"))
+}
@@ -99,6 +99,28 @@ func (cfgMap *ConfigMap) GetRequiredStringArrayParam(key string) []string {
 	return param
 }
 
+// GetStringMapParam is used to retrieve a map of string params from a YML file
+func (cfgMap *ConfigMap) GetStringMapParam(key string) (map[string]string, error) {
+	paramIface, ok := cfgMap.RawMap[key]
+	if !ok {
+		return map[string]string{}, fmt.Errorf("Could not find key %s", key)
+	}
+	rawMap, ok := paramIface.(map[interface{}]interface{})
+	if !ok {
+		return map[string]string{}, fmt.Errorf("Could not convert param to string map for key %s", key)
+	}
+	ret := make(map[string]string, len(rawMap))
+	for k, v := range rawMap {
+		kStr, kOk := k.(string)
+		vStr, vOk := v.(string)
+		if !kOk || !vOk {
+			return map[string]string{}, fmt.Errorf("Could not convert param to string map for key %s", key)
+		}
+		ret[kStr] = vStr
+	}
+	return ret, nil
+}
+
 func getServiceConfigImpl(moduleName, serviceName, configDir, oldConfigDir, configOverrideDir string) (*ConfigMap, error) {
 	// Filenames should be lower case
 	moduleName = strings.ToLower(moduleName)
@@ -30,6 +30,9 @@ baz:
   - first
   - second
   - third
+
+qux:
+  Authorization: Bearer token
 `
 	TestOverrideYML = `---
 # TEST YML
@@ -70,6 +73,12 @@ func TestGetConfigWithoutOverride(t *testing.T) {
 	assert.Equal(t, "second", baz[1])
 	assert.Equal(t, "third", baz[2])
 
+	qux, err := configMap.GetStringMapParam("qux")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, qux)
+	_, err = configMap.GetStringMapParam("baz")
+	assert.EqualError(t, err, "Could not convert param to string map for key baz")
+
 	os.Remove(filepath.Join(TestConfigDir, serviceFileName))
 	os.Remove(TestConfigOverrideDir)
 
@@ -50,6 +50,10 @@ func (srv *testStateServer) GetStates(ctx context.Context, req *protos.GetStates
 	return nil, nil
 }
 
+func (srv *testStateServer) GetStatesAt(ctx context.Context, req *protos.GetStatesAtRequest) (*protos.GetStatesResponse, error) {
+	return nil, nil
+}
+
 func (srv *testStateServer) ReportStates(ctx context.Context, req *protos.ReportStatesRequest) (*protos.ReportStatesResponse, error) {
 	srv.lastClientIdentity = proto.Clone(protos.GetClientIdentity(ctx)).(*protos.Identity)
 	srv.lastClientCertExpTime = protos.GetClientCertExpiration(ctx)
@@ -68,6 +72,10 @@ func (srv *testStateServer) SyncStates(ctx context.Context, req *protos.SyncStat
 	return &protos.SyncStatesResponse{UnsyncedStates: []*protos.IDAndVersion{}}, nil
 }
 
+func (srv *testStateServer) WatchStates(req *protos.WatchStatesRequest, stream protos.StateService_WatchStatesServer) error {
+	return nil
+}
+
 func TestIdentityInjector(t *testing.T) {
 	configuratorTestInit.StartTestService(t)
 	deviceTestInit.StartTestService(t)
@@ -12,11 +12,13 @@ import (
 	"crypto/rsa"
 	"flag"
 	"log"
+	"time"
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/key"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/bootstrapper"
 	"magma/orc8r/cloud/go/services/bootstrapper/servicers"
 )
@@ -37,7 +39,7 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to read private key: %s", err)
 	}
-	servicer, err := servicers.NewBootstrapperServer(privKey.(*rsa.PrivateKey))
+	servicer, err := servicers.NewBootstrapperServerWithRenewalWindow(privKey.(*rsa.PrivateKey), getRenewalWindow(srv.Config))
 	if err != nil {
 		log.Fatalf("Failed to create bootstrapper servicer: %s", err)
 	}
@@ -50,3 +52,16 @@ func main() {
 		log.Fatalf("Error running service: %s", err)
 	}
 }
+
+// getRenewalWindow returns how long before their expiry gateway certificates
+// can be renewed with themselves, as set in the service config.
+func getRenewalWindow(cfg *config.ConfigMap) time.Duration {
+	if cfg == nil {
+		return servicers.DefaultGatewayCertificateRenewalWindow
+	}
+	renewalWindowHours, err := cfg.GetIntParam("cert_renewal_window_hours")
+	if err != nil || renewalWindowHours <= 0 {
+		return servicers.DefaultGatewayCertificateRenewalWindow
+	}
+	return time.Hour * time.Duration(renewalWindowHours)
+}
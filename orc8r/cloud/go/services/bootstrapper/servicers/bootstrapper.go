@@ -22,10 +22,13 @@ import (
 	"math/big"
 	"time"
 
+	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/orc8r"
 	models2 "magma/orc8r/cloud/go/pluginimpl/models"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/cert"
 	"magma/orc8r/cloud/go/services/certifier"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/device"
 
@@ -41,16 +44,29 @@ const TimeLength = 8 // length of time encoded in byte array from int64
 const MinKeyLength = 1024
 const GatewayCertificateDuration = time.Hour * 97 // 4 days, lifetime of GW Certificate
 
+// By default, GW Certificates can be renewed with themselves within 2 days of
+// expiry, without the challenge key
+const DefaultGatewayCertificateRenewalWindow = time.Hour * 48
+
 type BootstrapperServer struct {
-	privKey *rsa.PrivateKey
+	privKey       *rsa.PrivateKey
+	renewalWindow time.Duration
 }
 
 func NewBootstrapperServer(privKey *rsa.PrivateKey) (*BootstrapperServer, error) {
+	return NewBootstrapperServerWithRenewalWindow(privKey, DefaultGatewayCertificateRenewalWindow)
+}
+
+// NewBootstrapperServerWithRenewalWindow returns a bootstrapper which lets
+// gateways renew their certificates with themselves within renewalWindow of
+// expiry.
+func NewBootstrapperServerWithRenewalWindow(privKey *rsa.PrivateKey, renewalWindow time.Duration) (*BootstrapperServer, error) {
 	srv := new(BootstrapperServer)
 	if privKey.N.BitLen() < MinKeyLength {
 		return nil, errorLogger(fmt.Errorf("Private key is too short"))
 	}
 	srv.privKey = privKey
+	srv.renewalWindow = renewalWindow
 	return srv, nil
 }
 
@@ -111,15 +127,20 @@ func (srv *BootstrapperServer) RequestSign(
 	}
 
 	// verify authentication / real response
-	switch keyType {
-	case protos.ChallengeKey_ECHO:
-		err = verifyEcho(resp)
-	case protos.ChallengeKey_SOFTWARE_RSA_SHA256:
-		err = verifySoftwareRSASHA256(resp, key)
-	case protos.ChallengeKey_SOFTWARE_ECDSA_SHA256:
-		err = verifySoftwareECDSASHA256(resp, key)
-	default:
-		err = fmt.Errorf("Unsupported key type: %s", keyType)
+	if resp.GetCertResponse() != nil {
+		// renewal with the current certificate instead of the challenge key
+		err = srv.verifyCertRenewal(resp)
+	} else {
+		switch keyType {
+		case protos.ChallengeKey_ECHO:
+			err = verifyEcho(resp)
+		case protos.ChallengeKey_SOFTWARE_RSA_SHA256:
+			err = verifySoftwareRSASHA256(resp, key)
+		case protos.ChallengeKey_SOFTWARE_ECDSA_SHA256:
+			err = verifySoftwareECDSASHA256(resp, key)
+		default:
+			err = fmt.Errorf("Unsupported key type: %s", keyType)
+		}
 	}
 	if err != nil {
 		return nil, errorLogger(status.Errorf(
@@ -254,6 +275,67 @@ func verifySoftwareECDSASHA256(resp *protos.Response, key []byte) error {
 	return nil
 }
 
+// verify response signed with the private key of the current gateway
+// certificate, which must be valid and expire within the renewal window
+func (srv *BootstrapperServer) verifyCertRenewal(resp *protos.Response) error {
+	response := resp.GetCertResponse()
+	if response == nil {
+		return fmt.Errorf("Wrong type of response, expected Cert")
+	}
+	gwCert, err := x509.ParseCertificate(response.CertDer)
+	if err != nil {
+		return fmt.Errorf("Failed to parse gateway certificate: %s", err)
+	}
+	sn := cert.SerialToString(gwCert.SerialNumber)
+
+	// the certificate must be the one issued by certifier & not revoked
+	certInfo, err := certifier.GetIdentity(&protos.Certificate_SN{Sn: sn})
+	if err != nil {
+		return fmt.Errorf("Invalid gateway certificate %s: %s", sn, err)
+	}
+	if certInfo.Id.GetGateway().GetHardwareId() != resp.HwId.Id {
+		return fmt.Errorf("Certificate %s is not issued to gateway %s", sn, resp.HwId.Id)
+	}
+	caCertMsg, err := certifier.GetCACert(&certprotos.GetCARequest{CertType: certInfo.CertType})
+	if err != nil {
+		return fmt.Errorf("Failed to get CA certificate: %s", err)
+	}
+	caCert, err := x509.ParseCertificate(caCertMsg.Cert)
+	if err != nil {
+		return fmt.Errorf("Failed to parse CA certificate: %s", err)
+	}
+	err = caCert.CheckSignature(gwCert.SignatureAlgorithm, gwCert.RawTBSCertificate, gwCert.Signature)
+	if err != nil {
+		return fmt.Errorf("Certificate %s is not signed by the CA: %s", sn, err)
+	}
+	notAfter, _ := ptypes.Timestamp(certInfo.NotAfter)
+	if clock.Now().UTC().Add(srv.renewalWindow).Before(notAfter) {
+		return fmt.Errorf("Certificate %s expires at %s, it can only be renewed within %s of its expiry",
+			sn, notAfter, srv.renewalWindow)
+	}
+
+	// the challenge must be signed with the private key of the certificate
+	var algorithm x509.SignatureAlgorithm
+	switch gwCert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		algorithm = x509.SHA256WithRSA
+	case *ecdsa.PublicKey:
+		algorithm = x509.ECDSAWithSHA256
+	default:
+		return fmt.Errorf("Unsupported public key type: %T", gwCert.PublicKey)
+	}
+	err = gwCert.CheckSignature(algorithm, resp.Challenge, response.Signature)
+	if err != nil {
+		return fmt.Errorf("Wrong response: %s", err)
+	}
+
+	// the new certificate must be for the same gateway
+	if resp.Csr.GetId().GetGateway().GetHardwareId() != resp.HwId.Id {
+		return fmt.Errorf("CSR identity must be gateway %s", resp.HwId.Id)
+	}
+	return nil
+}
+
 func getChallengeKey(hwID string) (protos.ChallengeKey_KeyType, []byte, error) {
 	var empty protos.ChallengeKey_KeyType
 	entity, err := configurator.LoadEntityForPhysicalID(hwID, configurator.EntityLoadCriteria{})
@@ -15,15 +15,18 @@ import (
 	"crypto/rsa"
 	"crypto/sha256"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"testing"
 	"time"
 
+	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/pluginimpl/models"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/key"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/bootstrapper/servicers"
+	"magma/orc8r/cloud/go/services/certifier"
 	certifierTestInit "magma/orc8r/cloud/go/services/certifier/test_init"
 	certifierTestUtils "magma/orc8r/cloud/go/services/certifier/test_utils"
 	"magma/orc8r/cloud/go/services/configurator"
@@ -33,6 +36,7 @@ import (
 	deviceTestInit "magma/orc8r/cloud/go/services/device/test_init"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/metadata"
@@ -178,6 +182,130 @@ func testWithECDSA(
 	assert.NotNil(t, cert)
 }
 
+func testRenewWithCert(
+	t *testing.T, networkId string, srv *servicers.BootstrapperServer, widerSrv *servicers.BootstrapperServer, ctx context.Context) {
+
+	testAgHwId := "test_ag_renew"
+	challengeKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	marshaledPubKey, err := x509.MarshalPKIXPublicKey(key.PublicKey(challengeKey))
+	assert.NoError(t, err)
+
+	pubKey := strfmt.Base64(marshaledPubKey)
+	configuratorTestUtils.RegisterGateway(
+		t,
+		networkId,
+		testAgHwId,
+		&models.GatewayDevice{
+			HardwareID: testAgHwId,
+			Key: &models.ChallengeKey{
+				KeyType: ecdsaType,
+				Key:     &pubKey,
+			},
+		},
+	)
+
+	// current gateway certificate, expiring within the renewal window
+	gwKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	gwCert, err := certifier.SignCSR(createGatewayCSR(t, testAgHwId, time.Hour*24, gwKey))
+	assert.NoError(t, err)
+	newKey, err := key.GenerateKey("P256", 0)
+	assert.NoError(t, err)
+	newCsr := createGatewayCSR(t, testAgHwId, time.Hour*24*10, newKey)
+
+	// renew with the certificate instead of the (lost) challenge key
+	resp := createCertResponse(t, srv, ctx, testAgHwId, gwCert.CertDer, gwKey, newCsr)
+	cert, err := srv.RequestSign(ctx, resp)
+	assert.NoError(t, err)
+	assert.NotNil(t, cert)
+	certInfo, err := certifier.GetCertificateIdentity(cert.Sn.Sn)
+	assert.NoError(t, err)
+	assert.Equal(t, testAgHwId, certInfo.Id.GetGateway().GetHardwareId())
+	// the requested duration is capped
+	notAfter, err := ptypes.Timestamp(cert.NotAfter)
+	assert.NoError(t, err)
+	assert.True(t, notAfter.Before(time.Now().Add(servicers.GatewayCertificateDuration+time.Minute)))
+
+	// the new certificate does not expire within the renewal window yet
+	resp = createCertResponse(t, srv, ctx, testAgHwId, cert.CertDer, newKey, newCsr)
+	_, err = srv.RequestSign(ctx, resp)
+	assert.Error(t, err)
+
+	// it does once the clock is within the renewal window of its expiry
+	clock.SetAndFreezeClock(t, notAfter.Add(-servicers.DefaultGatewayCertificateRenewalWindow+time.Minute))
+	resp = createCertResponse(t, srv, ctx, testAgHwId, cert.CertDer, newKey, newCsr)
+	_, err = srv.RequestSign(ctx, resp)
+	assert.NoError(t, err)
+	clock.UnfreezeClock(t)
+
+	// or with a longer renewal window
+	resp = createCertResponse(t, widerSrv, ctx, testAgHwId, cert.CertDer, newKey, newCsr)
+	_, err = widerSrv.RequestSign(ctx, resp)
+	assert.NoError(t, err)
+
+	// challenge signed with another key
+	resp = createCertResponse(t, srv, ctx, testAgHwId, gwCert.CertDer, newKey, newCsr)
+	_, err = srv.RequestSign(ctx, resp)
+	assert.Error(t, err)
+
+	// certificate of another gateway
+	resp = createCertResponse(t, srv, ctx, "test_ag_echo", gwCert.CertDer, gwKey, newCsr)
+	_, err = srv.RequestSign(ctx, resp)
+	assert.Error(t, err)
+
+	// CSR for another gateway
+	otherCsr := createGatewayCSR(t, "test_ag_echo", time.Hour*24, newKey)
+	resp = createCertResponse(t, srv, ctx, testAgHwId, gwCert.CertDer, gwKey, otherCsr)
+	_, err = srv.RequestSign(ctx, resp)
+	assert.Error(t, err)
+
+	// revoked certificates can't be renewed
+	err = certifier.RevokeCertificate(gwCert.Sn)
+	assert.NoError(t, err)
+	resp = createCertResponse(t, srv, ctx, testAgHwId, gwCert.CertDer, gwKey, newCsr)
+	_, err = srv.RequestSign(ctx, resp)
+	assert.Error(t, err)
+}
+
+func createGatewayCSR(t *testing.T, hwId string, validTime time.Duration, privateKey interface{}) *protos.CSR {
+	template := x509.CertificateRequest{Subject: pkix.Name{CommonName: hwId}}
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, &template, privateKey)
+	assert.NoError(t, err)
+	return &protos.CSR{
+		Id:        protos.NewGatewayIdentity(hwId, "", ""),
+		ValidTime: ptypes.DurationProto(validTime),
+		CsrDer:    csrDER,
+	}
+}
+
+func createCertResponse(
+	t *testing.T,
+	srv *servicers.BootstrapperServer,
+	ctx context.Context,
+	hwId string,
+	certDER []byte,
+	privateKey interface{},
+	csr *protos.CSR,
+) *protos.Response {
+	challenge, err := srv.GetChallenge(ctx, &protos.AccessGatewayID{Id: hwId})
+	assert.NoError(t, err)
+
+	// sign challenge with the private key of the certificate
+	hashed := sha256.Sum256(challenge.Challenge)
+	signature, err := privateKey.(*ecdsa.PrivateKey).Sign(rand.Reader, hashed[:], crypto.SHA256)
+	assert.NoError(t, err)
+
+	return &protos.Response{
+		HwId:      &protos.AccessGatewayID{Id: hwId},
+		Challenge: challenge.Challenge,
+		Response: &protos.Response_CertResponse{
+			CertResponse: &protos.Response_Cert{CertDer: certDER, Signature: signature},
+		},
+		Csr: csr,
+	}
+}
+
 func testNegative(
 	t *testing.T, networkId string, srv *servicers.BootstrapperServer, ctx context.Context) {
 
@@ -323,4 +451,7 @@ func TestBootstrapperServer(t *testing.T) {
 		context.Background(),
 		metadata.Pairs("x-magma-client-cert-cn", "bla"))
 	testNegative(t, testNetworkID, srv, ctx)
+	widerSrv, err := servicers.NewBootstrapperServerWithRenewalWindow(privateKey.(*rsa.PrivateKey), servicers.GatewayCertificateDuration)
+	assert.NoError(t, err)
+	testRenewWithCert(t, testNetworkID, srv, widerSrv, context.Background())
 }
@@ -10,15 +10,21 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"log"
+	"net/http"
+	"os"
 	"time"
 
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/registry"
 	"magma/orc8r/cloud/go/security/cert"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/certifier"
+	"magma/orc8r/cloud/go/services/certifier/httpserver"
 	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 	"magma/orc8r/cloud/go/services/certifier/servicers"
 	"magma/orc8r/cloud/go/sqorc"
@@ -35,6 +41,16 @@ var (
 	vpnKeyFile  = flag.String("vpnk", "vpn_ca.key", "VPN CA's Private Key file")
 
 	gcHours = flag.Int64("gc-hours", 12, "Garbage Collection time interval (in hours)")
+
+	crlHours = flag.Int64("crl-hours", 1, "CRL signing time interval (in hours)")
+)
+
+const (
+	// revocationProxyAlias is the proxy alias of certifier in the service
+	// registry under which the HTTP server publishing CRLs and OCSP responses
+	// listens
+	revocationProxyAlias = "revocation"
+	revocationURLEnv     = "CERTIFIER_REVOCATION_URL"
 )
 
 func main() {
@@ -69,7 +85,7 @@ func main() {
 	} else {
 		caMap[protos.CertType_VPN] = &servicers.CAInfo{Cert: vpnCert, PrivKey: vpnPrivKey}
 	}
-	servicer, err := servicers.NewCertifierServer(store, caMap)
+	servicer, err := servicers.NewCertifierServerWithRevocationURL(store, caMap, getRevocationURL(srv.Config))
 	if err != nil {
 		log.Fatalf("Failed to create certifier server: %s", err)
 	}
@@ -87,9 +103,57 @@ func main() {
 		}
 	}()
 
+	// Start CRL Signing Ticker, so that CRLs are re-signed before they expire
+	crl := time.Tick(time.Hour * time.Duration(*crlHours))
+	go func() {
+		for range crl {
+			err := servicer.UpdateCRLs()
+			if err != nil {
+				glog.Errorf("error signing CRLs for certifier: %s", err)
+			}
+		}
+	}()
+
+	// Publish CRLs and OCSP responses over HTTP
+	revocationPort := getRevocationPort()
+	go func() {
+		err := http.ListenAndServe(fmt.Sprintf(":%d", revocationPort), httpserver.NewRevocationHandler(servicer))
+		if err != nil {
+			log.Fatalf("Error running revocation HTTP server: %s", err)
+		}
+	}()
+
 	// Run the service
 	err = srv.Run()
 	if err != nil {
 		log.Fatalf("Error running service: %s", err)
 	}
 }
+
+func getRevocationPort() int {
+	aliases, err := registry.GetServiceProxyAliases(certifier.ServiceName)
+	if err != nil {
+		log.Fatalf("Failed to get proxy aliases of certifier: %s", err)
+	}
+	port, ok := aliases[revocationProxyAlias]
+	if !ok || port == 0 {
+		log.Fatalf("Proxy alias %s of certifier is not registered", revocationProxyAlias)
+	}
+	return port
+}
+
+func getRevocationURL(cfg *config.ConfigMap) string {
+	if revocationURL := os.Getenv(revocationURLEnv); revocationURL != "" {
+		return revocationURL
+	}
+	if cfg == nil {
+		glog.Warning("Issued certificates will not point to the revocation server: no revocation URL configured")
+		return ""
+	}
+	revocationURL, err := cfg.GetStringParam("revocation_url")
+	if err != nil || revocationURL == "" {
+		glog.Warning("Issued certificates will not point to the revocation server: no revocation URL configured")
+		return ""
+	}
+	return revocationURL
+}
@@ -154,6 +154,30 @@ func FindCertificates(id *protos.Identity) ([]string, error) {
 	return slist.Sns, err
 }
 
+// FindRevokedCertificates returns all revoked, not yet expired Certificates
+// associated with the given Identity
+func FindRevokedCertificates(id *protos.Identity) ([]*certifierprotos.RevokedCertificate, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	revoked, err := client.FindRevokedCertificates(context.Background(), id)
+	if err != nil || revoked == nil {
+		return nil, err
+	}
+	return revoked.Certificates, nil
+}
+
+// GetCertificateExpiration returns the serial number & expiration time of
+// the active Certificate of the given Identity, which expires last
+func GetCertificateExpiration(id *protos.Identity) (*certifierprotos.CertificateExpiration, error) {
+	client, err := getCertifierClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.GetCertificateExpiration(context.Background(), id)
+}
+
 // GetAll returns all Certificates Records
 func GetAll() (map[string]*certifierprotos.CertificateInfo, error) {
 	client, err := getCertifierClient()
@@ -106,4 +106,27 @@ func TestCertifier(t *testing.T) {
 	if len(operSNs) > 0 {
 		assert.Equal(t, security_cert.SerialToString(firstCertSN), operSNs[0])
 	}
+
+	// test certificate expiration tracking
+	expiration, err := certifier.GetCertificateExpiration(oper)
+	assert.NoError(t, err)
+	assert.Equal(t, security_cert.SerialToString(firstCertSN), expiration.Sn)
+
+	csrMsg, err = certifier_test_utils.CreateCSR(time.Duration(time.Hour*2), "testOperator", "testOperator")
+	assert.NoError(t, err)
+	certMsg, err = certifier.SignCSR(csrMsg)
+	assert.NoError(t, err, "Failed to sign CSR")
+	expiration, err = certifier.GetCertificateExpiration(oper)
+	assert.NoError(t, err)
+	assert.Equal(t, security_cert.SerialToString(firstCertSN), expiration.Sn)
+
+	err = certifier.RevokeCertificateSN(security_cert.SerialToString(firstCertSN))
+	assert.NoError(t, err, "Failed to revoke cert")
+	expiration, err = certifier.GetCertificateExpiration(oper)
+	assert.NoError(t, err)
+	assert.Equal(t, certMsg.Sn.Sn, expiration.Sn)
+	assert.Equal(t, certMsg.NotAfter.Seconds, expiration.NotAfter.Seconds)
+
+	_, err = certifier.GetCertificateExpiration(protos.NewOperatorIdentity("unknownOperator"))
+	assert.Equal(t, codes.NotFound, grpc.Code(err))
 }
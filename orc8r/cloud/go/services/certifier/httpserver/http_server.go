@@ -0,0 +1,123 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package httpserver publishes the certificate revocation status of the
+// certifier over HTTP, for nghttpx, the feg relay and gateways to consume:
+//
+//	GET  /crl/{cert_type}       DER encoded CRL of the CA of cert_type (e.g. /crl/default)
+//	POST /ocsp                  OCSP request in body, per RFC 6960 appendix A.1
+//	GET  /ocsp/{base64 request} URL encoded OCSP request, per RFC 6960 appendix A.1
+package httpserver
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+const (
+	CRLPath  = "/crl/"
+	OCSPPath = "/ocsp"
+
+	crlContentType          = "application/pkix-crl"
+	ocspRequestContentType  = "application/ocsp-request"
+	ocspResponseContentType = "application/ocsp-response"
+
+	// maxOCSPRequestSize bounds OCSP request bodies, which are a few hundred bytes
+	maxOCSPRequestSize = 1 << 16
+)
+
+// RevocationStatusProvider signs the CRLs and OCSP responses published by
+// the HTTP server. It is implemented by the certifier servicer.
+type RevocationStatusProvider interface {
+	GetCRL(certType protos.CertType) ([]byte, error)
+	RespondOCSP(reqDER []byte) ([]byte, error)
+}
+
+// NewRevocationHandler returns the http.Handler serving the CRLs and OCSP
+// responses of provider
+func NewRevocationHandler(provider RevocationStatusProvider) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(CRLPath, func(w http.ResponseWriter, req *http.Request) {
+		handleCRL(provider, w, req)
+	})
+	ocspHandler := func(w http.ResponseWriter, req *http.Request) {
+		handleOCSP(provider, w, req)
+	}
+	mux.HandleFunc(OCSPPath, ocspHandler)
+	mux.HandleFunc(OCSPPath+"/", ocspHandler)
+	return mux
+}
+
+func handleCRL(provider RevocationStatusProvider, w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	certTypeName := strings.ToUpper(strings.TrimPrefix(req.URL.Path, CRLPath))
+	certType, ok := protos.CertType_value[certTypeName]
+	if !ok {
+		http.Error(w, fmt.Sprintf("unknown cert type %q", certTypeName), http.StatusNotFound)
+		return
+	}
+	crlDER, err := provider.GetCRL(protos.CertType(certType))
+	if err != nil {
+		glog.Errorf("Failed to get CRL for cert type %s: %s", certTypeName, err)
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", crlContentType)
+	w.Write(crlDER)
+}
+
+func handleOCSP(provider RevocationStatusProvider, w http.ResponseWriter, req *http.Request) {
+	var reqDER []byte
+	var err error
+	switch req.Method {
+	case http.MethodGet:
+		reqDER, err = decodeOCSPRequestPath(strings.TrimPrefix(req.URL.EscapedPath(), OCSPPath))
+	case http.MethodPost:
+		if contentType := req.Header.Get("Content-Type"); contentType != ocspRequestContentType {
+			http.Error(w, fmt.Sprintf("unsupported content type %q", contentType), http.StatusUnsupportedMediaType)
+			return
+		}
+		reqDER, err = ioutil.ReadAll(http.MaxBytesReader(w, req.Body, maxOCSPRequestSize))
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid OCSP request: %s", err), http.StatusBadRequest)
+		return
+	}
+	respDER, err := provider.RespondOCSP(reqDER)
+	if err != nil {
+		glog.Errorf("Failed to respond to OCSP request: %s", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", ocspResponseContentType)
+	w.Write(respDER)
+}
+
+// decodeOCSPRequestPath decodes the URL encoded, base64 encoded DER OCSP
+// request of a GET request path
+func decodeOCSPRequestPath(path string) ([]byte, error) {
+	encoded, err := url.PathUnescape(strings.TrimPrefix(path, "/"))
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(encoded)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package httpserver_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/certifier/httpserver"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeProvider struct {
+	ocspRequests [][]byte
+}
+
+func (p *fakeProvider) GetCRL(certType protos.CertType) ([]byte, error) {
+	if certType == protos.CertType_VPN {
+		return nil, errors.New("no CRL")
+	}
+	return []byte("crl " + certType.String()), nil
+}
+
+func (p *fakeProvider) RespondOCSP(reqDER []byte) ([]byte, error) {
+	p.ocspRequests = append(p.ocspRequests, reqDER)
+	return []byte("ocsp response"), nil
+}
+
+func TestRevocationHandler_CRL(t *testing.T) {
+	server := httptest.NewServer(httpserver.NewRevocationHandler(&fakeProvider{}))
+	defer server.Close()
+
+	resp, body := doRequest(t, http.MethodGet, server.URL+"/crl/default", "", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/pkix-crl", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "crl DEFAULT", string(body))
+
+	resp, _ = doRequest(t, http.MethodGet, server.URL+"/crl/vpn", "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, _ = doRequest(t, http.MethodGet, server.URL+"/crl/foo", "", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	resp, _ = doRequest(t, http.MethodPost, server.URL+"/crl/default", "", nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
+
+func TestRevocationHandler_OCSP(t *testing.T) {
+	provider := &fakeProvider{}
+	server := httptest.NewServer(httpserver.NewRevocationHandler(provider))
+	defer server.Close()
+	// encodes to base64 with '/' and '+'
+	ocspReq := []byte{0xff, 0xef, 0xbe, 0xfb}
+
+	resp, body := doRequest(t, http.MethodPost, server.URL+"/ocsp", "application/ocsp-request", ocspReq)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "application/ocsp-response", resp.Header.Get("Content-Type"))
+	assert.Equal(t, "ocsp response", string(body))
+
+	encoded := url.PathEscape(base64.StdEncoding.EncodeToString(ocspReq))
+	resp, body = doRequest(t, http.MethodGet, server.URL+"/ocsp/"+encoded, "", nil)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "ocsp response", string(body))
+	assert.Equal(t, [][]byte{ocspReq, ocspReq}, provider.ocspRequests)
+
+	resp, _ = doRequest(t, http.MethodPost, server.URL+"/ocsp", "text/plain", ocspReq)
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	resp, _ = doRequest(t, http.MethodGet, server.URL+"/ocsp/not-base64!", "", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Len(t, provider.ocspRequests, 2)
+}
+
+func doRequest(t *testing.T, method, url, contentType string, body []byte) (*http.Response, []byte) {
+	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+	assert.NoError(t, err)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	respBody, err := ioutil.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	return resp, respBody
+}
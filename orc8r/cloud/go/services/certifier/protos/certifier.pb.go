@@ -261,6 +261,171 @@ func (m *GetCARequest) GetCertType() protos.CertType {
 	return protos.CertType_DEFAULT
 }
 
+type RevokedCertificate struct {
+	Sn                   string               `protobuf:"bytes,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	Id                   *protos.Identity     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	CertType             protos.CertType      `protobuf:"varint,3,opt,name=cert_type,json=certType,proto3,enum=magma.orc8r.CertType" json:"cert_type,omitempty"`
+	NotBefore            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter             *timestamp.Timestamp `protobuf:"bytes,5,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	RevokedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RevokedCertificate) Reset()         { *m = RevokedCertificate{} }
+func (m *RevokedCertificate) String() string { return proto.CompactTextString(m) }
+func (*RevokedCertificate) ProtoMessage()    {}
+func (*RevokedCertificate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515f9a7ba5ef1ab9, []int{5}
+}
+
+func (m *RevokedCertificate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokedCertificate.Unmarshal(m, b)
+}
+func (m *RevokedCertificate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokedCertificate.Marshal(b, m, deterministic)
+}
+func (m *RevokedCertificate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokedCertificate.Merge(m, src)
+}
+func (m *RevokedCertificate) XXX_Size() int {
+	return xxx_messageInfo_RevokedCertificate.Size(m)
+}
+func (m *RevokedCertificate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokedCertificate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokedCertificate proto.InternalMessageInfo
+
+func (m *RevokedCertificate) GetSn() string {
+	if m != nil {
+		return m.Sn
+	}
+	return ""
+}
+
+func (m *RevokedCertificate) GetId() *protos.Identity {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *RevokedCertificate) GetCertType() protos.CertType {
+	if m != nil {
+		return m.CertType
+	}
+	return protos.CertType_DEFAULT
+}
+
+func (m *RevokedCertificate) GetNotBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *RevokedCertificate) GetNotAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
+}
+
+func (m *RevokedCertificate) GetRevokedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return nil
+}
+
+type RevokedCertificates struct {
+	Certificates         []*RevokedCertificate `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *RevokedCertificates) Reset()         { *m = RevokedCertificates{} }
+func (m *RevokedCertificates) String() string { return proto.CompactTextString(m) }
+func (*RevokedCertificates) ProtoMessage()    {}
+func (*RevokedCertificates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515f9a7ba5ef1ab9, []int{6}
+}
+
+func (m *RevokedCertificates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokedCertificates.Unmarshal(m, b)
+}
+func (m *RevokedCertificates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokedCertificates.Marshal(b, m, deterministic)
+}
+func (m *RevokedCertificates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokedCertificates.Merge(m, src)
+}
+func (m *RevokedCertificates) XXX_Size() int {
+	return xxx_messageInfo_RevokedCertificates.Size(m)
+}
+func (m *RevokedCertificates) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokedCertificates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokedCertificates proto.InternalMessageInfo
+
+func (m *RevokedCertificates) GetCertificates() []*RevokedCertificate {
+	if m != nil {
+		return m.Certificates
+	}
+	return nil
+}
+
+type CertificateExpiration struct {
+	Sn                   string               `protobuf:"bytes,1,opt,name=sn,proto3" json:"sn,omitempty"`
+	NotAfter             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CertificateExpiration) Reset()         { *m = CertificateExpiration{} }
+func (m *CertificateExpiration) String() string { return proto.CompactTextString(m) }
+func (*CertificateExpiration) ProtoMessage()    {}
+func (*CertificateExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_515f9a7ba5ef1ab9, []int{7}
+}
+
+func (m *CertificateExpiration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertificateExpiration.Unmarshal(m, b)
+}
+func (m *CertificateExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertificateExpiration.Marshal(b, m, deterministic)
+}
+func (m *CertificateExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertificateExpiration.Merge(m, src)
+}
+func (m *CertificateExpiration) XXX_Size() int {
+	return xxx_messageInfo_CertificateExpiration.Size(m)
+}
+func (m *CertificateExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertificateExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertificateExpiration proto.InternalMessageInfo
+
+func (m *CertificateExpiration) GetSn() string {
+	if m != nil {
+		return m.Sn
+	}
+	return ""
+}
+
+func (m *CertificateExpiration) GetNotAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*CertificateInfo)(nil), "magma.orc8r.certifier.CertificateInfo")
 	proto.RegisterType((*CertificateInfoMap)(nil), "magma.orc8r.certifier.CertificateInfoMap")
@@ -268,49 +433,59 @@ func init() {
 	proto.RegisterType((*AddCertRequest)(nil), "magma.orc8r.certifier.AddCertRequest")
 	proto.RegisterType((*SerialNumbers)(nil), "magma.orc8r.certifier.SerialNumbers")
 	proto.RegisterType((*GetCARequest)(nil), "magma.orc8r.certifier.GetCARequest")
+	proto.RegisterType((*RevokedCertificate)(nil), "magma.orc8r.certifier.RevokedCertificate")
+	proto.RegisterType((*RevokedCertificates)(nil), "magma.orc8r.certifier.RevokedCertificates")
+	proto.RegisterType((*CertificateExpiration)(nil), "magma.orc8r.certifier.CertificateExpiration")
 }
 
 func init() { proto.RegisterFile("certifier.proto", fileDescriptor_515f9a7ba5ef1ab9) }
 
 var fileDescriptor_515f9a7ba5ef1ab9 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdf, 0x8e, 0xd2, 0x40,
-	0x14, 0xc6, 0x5b, 0x90, 0x15, 0x0e, 0xc8, 0xc2, 0x98, 0x4d, 0xba, 0x5d, 0x13, 0xb1, 0xba, 0x06,
-	0x6f, 0x4a, 0x82, 0x17, 0xe2, 0x9f, 0x1b, 0xc0, 0x15, 0x37, 0xd9, 0xdd, 0xc4, 0x42, 0xbc, 0xf0,
-	0x86, 0x94, 0xf6, 0xd0, 0x4c, 0xb6, 0xed, 0xe0, 0x74, 0xd8, 0x84, 0x17, 0xf0, 0x4d, 0x7c, 0x2d,
-	0xe3, 0xa3, 0x98, 0xfe, 0x5b, 0x29, 0x14, 0xed, 0x15, 0x33, 0x73, 0xbe, 0xf9, 0x7d, 0x67, 0xbe,
-	0x19, 0x0a, 0xc7, 0x16, 0x72, 0x41, 0x97, 0x14, 0xb9, 0xbe, 0xe2, 0x4c, 0x30, 0x72, 0xe2, 0x99,
-	0x8e, 0x67, 0xea, 0x8c, 0x5b, 0x03, 0xae, 0xdf, 0x17, 0xd5, 0x27, 0xd1, 0x42, 0x2f, 0xd2, 0x04,
-	0xbd, 0x9d, 0x4d, 0xea, 0x69, 0xb6, 0xca, 0x3c, 0x8f, 0xf9, 0x49, 0xe9, 0x2c, 0x53, 0xa2, 0x36,
-	0xfa, 0x82, 0x8a, 0x4d, 0x52, 0x7c, 0xea, 0x30, 0xe6, 0xb8, 0x18, 0x57, 0x17, 0xeb, 0x65, 0x4f,
-	0x50, 0x0f, 0x03, 0x61, 0x7a, 0xab, 0x58, 0xa0, 0xfd, 0x96, 0xe1, 0x78, 0x1c, 0x9b, 0x59, 0xa6,
-	0xc0, 0x4b, 0x7f, 0xc9, 0xc8, 0x39, 0x94, 0xa8, 0xad, 0xc8, 0x1d, 0xb9, 0x5b, 0xef, 0x9f, 0xe8,
-	0xdb, 0xed, 0x5e, 0x26, 0x74, 0xa3, 0x44, 0x6d, 0xf2, 0x16, 0xc0, 0x67, 0x62, 0xbe, 0xc0, 0x25,
-	0xe3, 0xa8, 0x94, 0x22, 0xb9, 0xaa, 0xc7, 0x86, 0x7a, 0x6a, 0xa8, 0xcf, 0x52, 0x43, 0xa3, 0xe6,
-	0x33, 0x31, 0x8a, 0xc4, 0xe4, 0x0d, 0x84, 0x93, 0xb9, 0xb9, 0x14, 0xc8, 0x95, 0xf2, 0x7f, 0x77,
-	0x56, 0x7d, 0x26, 0x86, 0xa1, 0x96, 0xf4, 0xa1, 0x16, 0x46, 0x33, 0x17, 0x9b, 0x15, 0x2a, 0x0f,
-	0x3a, 0x72, 0xb7, 0xb9, 0xd3, 0x61, 0x78, 0x96, 0xd9, 0x66, 0x85, 0x46, 0xd5, 0x4a, 0x46, 0xda,
-	0x2f, 0x19, 0xc8, 0xce, 0x11, 0xaf, 0xcd, 0x15, 0x99, 0x43, 0xc3, 0xfa, 0xbb, 0x1a, 0x28, 0x72,
-	0xa7, 0xdc, 0xad, 0xf7, 0xdf, 0xeb, 0xb9, 0xd7, 0xa3, 0xef, 0x03, 0xb6, 0x97, 0x82, 0x0b, 0x5f,
-	0xf0, 0x8d, 0x91, 0x01, 0xaa, 0x0e, 0xb4, 0xf7, 0x24, 0xa4, 0x05, 0xe5, 0x5b, 0xdc, 0x44, 0xe1,
-	0xd6, 0x8c, 0x70, 0x48, 0x3e, 0x40, 0xe5, 0xce, 0x74, 0xd7, 0x69, 0x82, 0x2f, 0x8b, 0x35, 0x60,
-	0xc4, 0x9b, 0xde, 0x95, 0x06, 0xb2, 0xf6, 0x43, 0x86, 0xe6, 0xd0, 0xb6, 0x43, 0x85, 0x81, 0xdf,
-	0xd7, 0x18, 0x88, 0xa2, 0x57, 0x78, 0x0a, 0x51, 0x4c, 0x73, 0x1b, 0x79, 0x64, 0xdf, 0x30, 0x1e,
-	0x86, 0xf3, 0x8f, 0xbb, 0x49, 0x97, 0x8b, 0x25, 0xfd, 0x0c, 0x1e, 0x4d, 0x91, 0x53, 0xd3, 0xbd,
-	0x59, 0x7b, 0x0b, 0xe4, 0x41, 0x78, 0xda, 0xc0, 0x8f, 0xa3, 0xad, 0x19, 0xe1, 0x50, 0x1b, 0x41,
-	0x63, 0x82, 0x62, 0x3c, 0x4c, 0x1b, 0xcd, 0xd8, 0xc8, 0x85, 0x6c, 0xfa, 0x3f, 0x2b, 0x50, 0x1b,
-	0xa7, 0xc1, 0x90, 0x31, 0x54, 0x22, 0x22, 0x79, 0x7e, 0x20, 0xb9, 0x6d, 0x3f, 0xf5, 0x71, 0x16,
-	0x3e, 0x0c, 0x39, 0x9a, 0x44, 0x46, 0x40, 0xa6, 0xd4, 0xf1, 0x93, 0x14, 0x93, 0x9c, 0x49, 0x2b,
-	0x2b, 0x9e, 0x1a, 0xaa, 0xb2, 0xd7, 0x5b, 0xa2, 0xd5, 0x24, 0x32, 0x83, 0xfa, 0x04, 0x45, 0x9a,
-	0x2f, 0x39, 0x3b, 0x24, 0xd5, 0xa7, 0x37, 0x6a, 0xc1, 0x5b, 0xd6, 0x24, 0x72, 0x01, 0x6d, 0x03,
-	0xef, 0xd8, 0x2d, 0x6e, 0x37, 0xf6, 0x4f, 0x76, 0x3b, 0x53, 0xfc, 0xca, 0xa8, 0xad, 0x49, 0xe4,
-	0xea, 0xfe, 0x89, 0xa4, 0x8c, 0xf3, 0x03, 0x2d, 0x64, 0x5f, 0x52, 0x3e, 0xed, 0x0b, 0xb4, 0x3e,
-	0x51, 0x7f, 0x1b, 0x17, 0x90, 0xfc, 0x67, 0xa6, 0xbe, 0x38, 0x60, 0x93, 0x79, 0x28, 0x9a, 0x44,
-	0xae, 0xa1, 0x75, 0x45, 0x03, 0x91, 0x41, 0xee, 0x7b, 0x17, 0xc6, 0x7d, 0x86, 0xa3, 0x09, 0x8a,
-	0xa1, 0xeb, 0xe6, 0x41, 0x5e, 0x15, 0xfe, 0x93, 0x6b, 0x12, 0x19, 0x40, 0x73, 0xcc, 0x5c, 0x17,
-	0x2d, 0x31, 0x31, 0xf9, 0xc2, 0x74, 0x30, 0x8f, 0x98, 0x97, 0xd2, 0xa8, 0xfa, 0xed, 0x28, 0xfe,
-	0x2a, 0x2f, 0xe2, 0xdf, 0xd7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa9, 0xfc, 0xb5, 0x0d,
-	0x06, 0x00, 0x00,
+	// 704 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0x6e, 0xd2, 0xad, 0xac, 0x67, 0xa3, 0xeb, 0x3c, 0x4d, 0x64, 0x19, 0x12, 0x23, 0x30, 0xb4,
+	0x21, 0x94, 0x49, 0xe5, 0x82, 0x0d, 0xb8, 0x69, 0xcb, 0x28, 0x93, 0xb6, 0x49, 0xa4, 0x13, 0x17,
+	0x5c, 0x50, 0xd2, 0xc6, 0xad, 0xac, 0x25, 0x71, 0x71, 0xdc, 0x89, 0xbe, 0x00, 0x8f, 0xc3, 0x23,
+	0x21, 0x2e, 0x79, 0x0c, 0xe4, 0xfc, 0x6c, 0x75, 0x93, 0xd0, 0xb0, 0xab, 0x3a, 0x3e, 0xc7, 0xdf,
+	0x77, 0xce, 0xe7, 0xef, 0x58, 0x85, 0xf5, 0x01, 0x66, 0x9c, 0x0c, 0x09, 0x66, 0xe6, 0x98, 0x51,
+	0x4e, 0xd1, 0x96, 0x67, 0x8f, 0x3c, 0xdb, 0xa4, 0x6c, 0x70, 0xc4, 0xcc, 0x9b, 0xa0, 0xfe, 0x30,
+	0xdc, 0x38, 0x0c, 0x73, 0x82, 0xc3, 0xb9, 0x43, 0xfa, 0xb6, 0x1c, 0xa5, 0x9e, 0x47, 0xfd, 0x38,
+	0xb4, 0x23, 0x85, 0x88, 0x83, 0x7d, 0x4e, 0xf8, 0x34, 0x0e, 0x3e, 0x1a, 0x51, 0x3a, 0x72, 0x71,
+	0x14, 0xed, 0x4f, 0x86, 0x87, 0x9c, 0x78, 0x38, 0xe0, 0xb6, 0x37, 0x8e, 0x12, 0x8c, 0xdf, 0x0a,
+	0xac, 0xb7, 0x23, 0xb2, 0x81, 0xcd, 0xf1, 0xa9, 0x3f, 0xa4, 0x68, 0x0f, 0x54, 0xe2, 0x68, 0xca,
+	0xae, 0xb2, 0xbf, 0xda, 0xd8, 0x32, 0x67, 0xcb, 0x3d, 0x8d, 0xd1, 0x2d, 0x95, 0x38, 0xe8, 0x18,
+	0xc0, 0xa7, 0xbc, 0xd7, 0xc7, 0x43, 0xca, 0xb0, 0xa6, 0x86, 0xe9, 0xba, 0x19, 0x11, 0x9a, 0x09,
+	0xa1, 0x79, 0x99, 0x10, 0x5a, 0x55, 0x9f, 0xf2, 0x56, 0x98, 0x8c, 0x5e, 0x81, 0xf8, 0xe8, 0xd9,
+	0x43, 0x8e, 0x99, 0x56, 0x5e, 0x78, 0x72, 0xc5, 0xa7, 0xbc, 0x29, 0x72, 0x51, 0x03, 0xaa, 0x42,
+	0x9a, 0x1e, 0x9f, 0x8e, 0xb1, 0xb6, 0xb4, 0xab, 0xec, 0xd7, 0xe6, 0x2a, 0x14, 0xbd, 0x5c, 0x4e,
+	0xc7, 0xd8, 0x5a, 0x19, 0xc4, 0x2b, 0xe3, 0x97, 0x02, 0x68, 0xae, 0xc5, 0x73, 0x7b, 0x8c, 0x7a,
+	0xb0, 0x36, 0xb8, 0xdd, 0x0d, 0x34, 0x65, 0xb7, 0xbc, 0xbf, 0xda, 0x78, 0x63, 0x66, 0x5e, 0x8f,
+	0x99, 0x06, 0x98, 0xdd, 0x0a, 0x4e, 0x7c, 0xce, 0xa6, 0x96, 0x04, 0xa8, 0x8f, 0x60, 0x23, 0x95,
+	0x82, 0xea, 0x50, 0xbe, 0xc2, 0xd3, 0x50, 0xdc, 0xaa, 0x25, 0x96, 0xe8, 0x2d, 0x2c, 0x5f, 0xdb,
+	0xee, 0x24, 0x51, 0xf0, 0x59, 0xb1, 0x02, 0xac, 0xe8, 0xd0, 0x6b, 0xf5, 0x48, 0x31, 0x7e, 0x28,
+	0x50, 0x6b, 0x3a, 0x8e, 0xc8, 0xb0, 0xf0, 0xb7, 0x09, 0x0e, 0x78, 0xd1, 0x2b, 0xdc, 0x86, 0x50,
+	0xa6, 0x9e, 0x83, 0x59, 0x48, 0xbf, 0x66, 0xdd, 0x13, 0xdf, 0xef, 0xe6, 0x95, 0x2e, 0x17, 0x53,
+	0xfa, 0x31, 0xdc, 0xef, 0x62, 0x46, 0x6c, 0xf7, 0x62, 0xe2, 0xf5, 0x31, 0x0b, 0x44, 0xb7, 0x81,
+	0x1f, 0x49, 0x5b, 0xb5, 0xc4, 0xd2, 0x68, 0xc1, 0x5a, 0x07, 0xf3, 0x76, 0x33, 0x29, 0x54, 0xa2,
+	0x51, 0x8a, 0xd1, 0xfc, 0x54, 0x01, 0x59, 0xf8, 0x9a, 0x5e, 0x61, 0x67, 0x46, 0x15, 0x54, 0x03,
+	0x35, 0xf0, 0x63, 0x65, 0xd5, 0xc0, 0x8f, 0x35, 0x50, 0x17, 0x69, 0x70, 0x87, 0x46, 0xe7, 0xac,
+	0xbf, 0x74, 0x67, 0xeb, 0x2f, 0xff, 0x87, 0xf5, 0x8f, 0x01, 0x58, 0xd4, 0x74, 0xcf, 0xe6, 0x5a,
+	0x65, 0x31, 0x67, 0x9c, 0xdd, 0xe4, 0x86, 0x03, 0x9b, 0x69, 0xbd, 0x02, 0x74, 0x9e, 0x39, 0x01,
+	0x07, 0x39, 0x06, 0x4c, 0x23, 0xc8, 0x7e, 0x37, 0xbe, 0xc2, 0xd6, 0x4c, 0xf0, 0xe4, 0xfb, 0x98,
+	0x30, 0x9b, 0x13, 0xea, 0xa7, 0x2e, 0x46, 0x92, 0x40, 0x2d, 0x2e, 0x41, 0xe3, 0x4f, 0x05, 0xaa,
+	0xed, 0xa4, 0x20, 0xd4, 0x86, 0xe5, 0xd0, 0x4a, 0xe8, 0x49, 0x4e, 0xc5, 0xb3, 0x46, 0xd3, 0x37,
+	0xe5, 0x3b, 0x6d, 0x0a, 0x1c, 0xa3, 0x84, 0x5a, 0x80, 0xba, 0x64, 0xe4, 0xc7, 0xe3, 0x93, 0x58,
+	0xa9, 0x2e, 0x27, 0x77, 0x2d, 0x5d, 0x4b, 0x59, 0x22, 0xce, 0x35, 0x4a, 0xe8, 0x12, 0x56, 0x3b,
+	0x98, 0x27, 0xa6, 0x42, 0x3b, 0x79, 0xa9, 0x66, 0xf7, 0x42, 0x2f, 0x38, 0xde, 0x46, 0x09, 0x9d,
+	0xc0, 0x46, 0x24, 0xf9, 0x6c, 0x61, 0xff, 0xc4, 0xde, 0x90, 0x82, 0x9f, 0x28, 0x71, 0x8c, 0x12,
+	0x3a, 0xbb, 0x79, 0x1b, 0x12, 0x8c, 0xbd, 0x9c, 0x12, 0xe4, 0x27, 0x24, 0x1b, 0xed, 0x23, 0xd4,
+	0xdf, 0x13, 0x5f, 0xb6, 0x51, 0xf6, 0x6c, 0xe9, 0x4f, 0x73, 0x68, 0xa4, 0x17, 0xc2, 0x28, 0xa1,
+	0x73, 0xa8, 0x9f, 0x91, 0x80, 0x4b, 0x90, 0x69, 0xee, 0xc2, 0x70, 0x1f, 0xa0, 0xd2, 0xc1, 0xbc,
+	0xe9, 0xba, 0x59, 0x20, 0x07, 0x85, 0x5f, 0x77, 0xa3, 0x84, 0xbe, 0xc0, 0x03, 0xd1, 0x6b, 0xd6,
+	0xe4, 0xe4, 0xb4, 0xfc, 0xbc, 0xf0, 0xe8, 0x88, 0x4a, 0x6d, 0xd0, 0x84, 0x43, 0x33, 0x47, 0x26,
+	0x87, 0xe0, 0xc5, 0xe2, 0xfa, 0x6f, 0x41, 0x8c, 0x12, 0x3a, 0x82, 0x5a, 0x9b, 0xba, 0x2e, 0x1e,
+	0xf0, 0x8e, 0xcd, 0xfa, 0xf6, 0x08, 0x67, 0x89, 0x92, 0x75, 0xd1, 0xad, 0x95, 0xcf, 0x95, 0xe8,
+	0x1f, 0x45, 0x3f, 0xfa, 0x7d, 0xf9, 0x77, 0x00, 0x87, 0xbf, 0x66, 0x48, 0xc9, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -349,6 +524,13 @@ type CertifierClient interface {
 	ListCertificates(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*SerialNumbers, error)
 	// Returns all registered Certificates
 	GetAll(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*CertificateInfoMap, error)
+	// Finds & returns all revoked, not yet expired Certificates associated
+	// with the given Identity
+	FindRevokedCertificates(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*RevokedCertificates, error)
+	// Returns the Serial Number & expiration time of the active Certificate
+	// of the given Identity, which expires last.
+	// Throws NOT_FOUND if the Identity has no active certificates.
+	GetCertificateExpiration(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*CertificateExpiration, error)
 	// cleanup expired certificates
 	//
 	CollectGarbage(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Void, error)
@@ -434,6 +616,24 @@ func (c *certifierClient) GetAll(ctx context.Context, in *protos.Void, opts ...g
 	return out, nil
 }
 
+func (c *certifierClient) FindRevokedCertificates(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*RevokedCertificates, error) {
+	out := new(RevokedCertificates)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/FindRevokedCertificates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *certifierClient) GetCertificateExpiration(ctx context.Context, in *protos.Identity, opts ...grpc.CallOption) (*CertificateExpiration, error) {
+	out := new(CertificateExpiration)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/GetCertificateExpiration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *certifierClient) CollectGarbage(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*protos.Void, error) {
 	out := new(protos.Void)
 	err := c.cc.Invoke(ctx, "/magma.orc8r.certifier.Certifier/CollectGarbage", in, out, opts...)
@@ -469,6 +669,13 @@ type CertifierServer interface {
 	ListCertificates(context.Context, *protos.Void) (*SerialNumbers, error)
 	// Returns all registered Certificates
 	GetAll(context.Context, *protos.Void) (*CertificateInfoMap, error)
+	// Finds & returns all revoked, not yet expired Certificates associated
+	// with the given Identity
+	FindRevokedCertificates(context.Context, *protos.Identity) (*RevokedCertificates, error)
+	// Returns the Serial Number & expiration time of the active Certificate
+	// of the given Identity, which expires last.
+	// Throws NOT_FOUND if the Identity has no active certificates.
+	GetCertificateExpiration(context.Context, *protos.Identity) (*CertificateExpiration, error)
 	// cleanup expired certificates
 	//
 	CollectGarbage(context.Context, *protos.Void) (*protos.Void, error)
@@ -502,6 +709,12 @@ func (*UnimplementedCertifierServer) ListCertificates(ctx context.Context, req *
 func (*UnimplementedCertifierServer) GetAll(ctx context.Context, req *protos.Void) (*CertificateInfoMap, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAll not implemented")
 }
+func (*UnimplementedCertifierServer) FindRevokedCertificates(ctx context.Context, req *protos.Identity) (*RevokedCertificates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindRevokedCertificates not implemented")
+}
+func (*UnimplementedCertifierServer) GetCertificateExpiration(ctx context.Context, req *protos.Identity) (*CertificateExpiration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCertificateExpiration not implemented")
+}
 func (*UnimplementedCertifierServer) CollectGarbage(ctx context.Context, req *protos.Void) (*protos.Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CollectGarbage not implemented")
 }
@@ -654,6 +867,42 @@ func _Certifier_GetAll_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Certifier_FindRevokedCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Identity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).FindRevokedCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/FindRevokedCertificates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).FindRevokedCertificates(ctx, req.(*protos.Identity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Certifier_GetCertificateExpiration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(protos.Identity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CertifierServer).GetCertificateExpiration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.certifier.Certifier/GetCertificateExpiration",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CertifierServer).GetCertificateExpiration(ctx, req.(*protos.Identity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Certifier_CollectGarbage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(protos.Void)
 	if err := dec(in); err != nil {
@@ -708,6 +957,14 @@ var _Certifier_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAll",
 			Handler:    _Certifier_GetAll_Handler,
 		},
+		{
+			MethodName: "FindRevokedCertificates",
+			Handler:    _Certifier_FindRevokedCertificates_Handler,
+		},
+		{
+			MethodName: "GetCertificateExpiration",
+			Handler:    _Certifier_GetCertificateExpiration_Handler,
+		},
 		{
 			MethodName: "CollectGarbage",
 			Handler:    _Certifier_CollectGarbage_Handler,
@@ -21,6 +21,7 @@ import (
 	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/security/cert"
+	"magma/orc8r/cloud/go/services/certifier/httpserver"
 	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
 
 	"github.com/golang/glog"
@@ -49,11 +50,27 @@ type CAInfo struct {
 type CertifierServer struct {
 	store datastore.Api
 	CAs   map[protos.CertType]*CAInfo
+
+	// revocationURL is the base URL of the HTTP server publishing CRLs and
+	// OCSP responses. Issued certificates point to it if it is set.
+	revocationURL string
 }
 
 func NewCertifierServer(store datastore.Api, CAs map[protos.CertType]*CAInfo) (srv *CertifierServer, err error) {
+	return NewCertifierServerWithRevocationURL(store, CAs, "")
+}
+
+// NewCertifierServerWithRevocationURL returns a certifier which adds the CRL
+// distribution point and the OCSP server under revocationURL to the
+// certificates it issues.
+func NewCertifierServerWithRevocationURL(
+	store datastore.Api,
+	CAs map[protos.CertType]*CAInfo,
+	revocationURL string,
+) (srv *CertifierServer, err error) {
 	srv = new(CertifierServer)
 	srv.store = store
+	srv.revocationURL = strings.TrimSuffix(revocationURL, "/")
 	if CAs == nil {
 		return nil, fmt.Errorf("CA info not provided to certifier")
 	}
@@ -61,6 +78,10 @@ func NewCertifierServer(store datastore.Api, CAs map[protos.CertType]*CAInfo) (s
 		return nil, fmt.Errorf("No Certificates are provided to certifier")
 	}
 	srv.CAs = CAs
+	err = srv.UpdateCRLs()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to sign CRLs: %s", err)
+	}
 	return srv, nil
 }
 
@@ -128,6 +149,12 @@ func (srv *CertifierServer) signCSR(
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
 		BasicConstraintsValid: true,
 	}
+	if srv.revocationURL != "" {
+		template.CRLDistributionPoints = []string{
+			srv.revocationURL + httpserver.CRLPath + strings.ToLower(certType.String()),
+		}
+		template.OCSPServer = []string{srv.revocationURL + httpserver.OCSPPath}
+	}
 
 	clientCertDER, err := x509.CreateCertificate(
 		rand.Reader, &template, signingCert, csr.PublicKey, signingKey)
@@ -316,14 +343,38 @@ func (srv *CertifierServer) RevokeCertificate(
 	if snMsg != nil {
 		certSN = strings.TrimLeft(snMsg.Sn, "0")
 	}
-	_, _, err := srv.store.Get(CERTIFICATE_INFO_TABLE, certSN)
+	certInfo, err := srv.getCertInfo(certSN)
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, "Cannot find certificate with SN: %s", certSN)
 	}
+	// record the revocation before deleting the certificate, so that it is
+	// listed in the CRL of its CA until it expires
+	revokedAtProto, _ := ptypes.TimestampProto(clock.Now().UTC())
+	revokedCert := &certprotos.RevokedCertificate{
+		Sn:        certSN,
+		Id:        certInfo.Id,
+		CertType:  certInfo.CertType,
+		NotBefore: certInfo.NotBefore,
+		NotAfter:  certInfo.NotAfter,
+		RevokedAt: revokedAtProto,
+	}
+	marshaledRevokedCert, err := proto.Marshal(revokedCert)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "Marshalling error in RevokedCertificate: %s", err)
+	}
+	err = srv.store.Put(REVOKED_CERTIFICATE_TABLE, certSN, marshaledRevokedCert)
+	if err != nil {
+		return nil, status.Errorf(codes.Aborted, "Failed to record certificate revocation: %s", err)
+	}
 	err = srv.store.Delete(CERTIFICATE_INFO_TABLE, certSN)
 	if err != nil {
 		return nil, status.Errorf(codes.Aborted, "Failed to delete certificate: %s", err)
 	}
+	err = srv.UpdateCRLs()
+	if err != nil {
+		// The CRLs are also re-signed periodically, so only log.
+		glog.Errorf("Failed to update CRLs after revoking certificate %s: %s", certSN, err)
+	}
 	return &protos.Void{}, nil
 }
 
@@ -435,6 +486,39 @@ func (srv *CertifierServer) GetAll(context.Context, *protos.Void) (*certprotos.C
 	return res, nil
 }
 
+// GetCertificateExpiration returns the Serial Number & expiration time of the
+// active Certificate of the given Identity, which expires last
+func (srv *CertifierServer) GetCertificateExpiration(ctx context.Context, id *protos.Identity) (*certprotos.CertificateExpiration, error) {
+	if id == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Nil Identity")
+	}
+	snList, err := srv.FindCertificates(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := clock.Now().UTC()
+	var res *certprotos.CertificateExpiration
+	var lastNotAfter time.Time
+	for _, sn := range snList.Sns {
+		certInfo, err := srv.getCertInfo(sn)
+		if err != nil {
+			return nil, err
+		}
+		notAfter, err := ptypes.Timestamp(certInfo.NotAfter)
+		if err != nil || !notAfter.After(now) {
+			continue
+		}
+		if res == nil || notAfter.After(lastNotAfter) {
+			res = &certprotos.CertificateExpiration{Sn: sn, NotAfter: certInfo.NotAfter}
+			lastNotAfter = notAfter
+		}
+	}
+	if res == nil {
+		return nil, status.Errorf(codes.NotFound, "No active certificates for identity %s", id.HashString())
+	}
+	return res, nil
+}
+
 func (srv *CertifierServer) CollectGarbage(ctx context.Context, void *protos.Void) (*protos.Void, error) {
 	res := &protos.Void{}
 	snList, err := srv.ListCertificates(ctx, void)
@@ -465,6 +549,7 @@ func (srv *CertifierServer) CollectGarbage(ctx context.Context, void *protos.Voi
 			}
 		}
 	}
+	revokedErr := srv.collectRevokedGarbage()
 	if count > 0 {
 		glog.V(2).Infof("Removed %d stale certificates", count)
 	}
@@ -476,5 +561,5 @@ func (srv *CertifierServer) CollectGarbage(ctx context.Context, void *protos.Voi
 		glog.Error(msg)
 		return res, status.Errorf(codes.Internal, msg)
 	}
-	return res, nil
+	return res, revokedErr
 }
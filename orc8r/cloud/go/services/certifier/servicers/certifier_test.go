@@ -34,7 +34,7 @@ func TestCertifier(t *testing.T) {
 
 	// just test with default
 	caMap := map[protos.CertType]*servicers.CAInfo{
-		protos.CertType_DEFAULT: {caCert, caKey},
+		protos.CertType_DEFAULT: {Cert: caCert, PrivKey: caKey},
 	}
 	srv, err := servicers.NewCertifierServer(ds, caMap)
 	assert.NoError(t, err)
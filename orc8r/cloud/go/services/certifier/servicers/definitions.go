@@ -9,5 +9,7 @@ LICENSE file in the root directory of this source tree.
 package servicers
 
 const (
-	CERTIFICATE_INFO_TABLE = "certificate_info_db"
+	CERTIFICATE_INFO_TABLE    = "certificate_info_db"
+	REVOKED_CERTIFICATE_TABLE = "revoked_certificate_db"
+	CRL_TABLE                 = "certifier_crl_db"
 )
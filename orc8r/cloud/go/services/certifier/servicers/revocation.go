@@ -0,0 +1,250 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/cert"
+	certprotos "magma/orc8r/cloud/go/services/certifier/protos"
+
+	"github.com/golang/glog"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/crypto/ocsp"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	CRLValidity  time.Duration // time until the next update of a signed CRL
+	OCSPValidity time.Duration // time until the next update of an OCSP response
+)
+
+func init() {
+	CRLValidity = time.Duration(time.Hour * 24)
+	OCSPValidity = time.Duration(time.Hour)
+}
+
+// GetCRL returns the latest DER encoded CRL signed by the CA of certType.
+// CRLs are read from the datastore, so that a revocation made through any
+// certifier replica is published by all of them.
+func (srv *CertifierServer) GetCRL(certType protos.CertType) ([]byte, error) {
+	if _, ok := srv.CAs[certType]; !ok {
+		return nil, fmt.Errorf("No CA found for given cert type: %s", certType.String())
+	}
+	crlDER, _, err := srv.store.Get(CRL_TABLE, certType.String())
+	if err != nil {
+		return nil, fmt.Errorf("No CRL signed for cert type %s: %s", certType.String(), err)
+	}
+	return crlDER, nil
+}
+
+// UpdateCRLs signs new CRLs for all CAs, listing their revoked certificates
+// which are not expired yet, and stores them.
+func (srv *CertifierServer) UpdateCRLs() error {
+	revokedCerts, err := srv.getRevokedCertificates()
+	if err != nil {
+		return err
+	}
+	now := clock.Now().UTC()
+	revokedByType := map[protos.CertType][]pkix.RevokedCertificate{}
+	for _, revokedCert := range revokedCerts {
+		notAfter, _ := ptypes.Timestamp(revokedCert.NotAfter)
+		if now.After(notAfter) {
+			continue
+		}
+		sn, ok := new(big.Int).SetString(revokedCert.Sn, 16)
+		if !ok {
+			glog.Errorf("Invalid serial number of revoked certificate: %s", revokedCert.Sn)
+			continue
+		}
+		revokedAt, _ := ptypes.Timestamp(revokedCert.RevokedAt)
+		revokedByType[revokedCert.CertType] = append(
+			revokedByType[revokedCert.CertType],
+			pkix.RevokedCertificate{SerialNumber: sn, RevocationTime: revokedAt},
+		)
+	}
+	crls := map[string][]byte{}
+	for certType, ca := range srv.CAs {
+		crlDER, err := ca.Cert.CreateCRL(rand.Reader, ca.PrivKey, revokedByType[certType], now, now.Add(CRLValidity))
+		if err != nil {
+			return fmt.Errorf("Failed to sign CRL for cert type %s: %s", certType.String(), err)
+		}
+		crls[certType.String()] = crlDER
+	}
+	failedKeys, err := srv.store.PutMany(CRL_TABLE, crls)
+	if err != nil {
+		return fmt.Errorf("Failed to store CRLs: %s", err)
+	}
+	if len(failedKeys) > 0 {
+		return fmt.Errorf("Failed to store CRLs for cert type[s]: %v", failedKeys)
+	}
+	return nil
+}
+
+// RespondOCSP returns the DER encoded OCSP response to the DER encoded OCSP
+// request, signed by the CA which issued the requested certificate.
+func (srv *CertifierServer) RespondOCSP(reqDER []byte) ([]byte, error) {
+	req, err := ocsp.ParseRequest(reqDER)
+	if err != nil {
+		return ocsp.MalformedRequestErrorResponse, nil
+	}
+	certType, ca, ok := srv.findOCSPIssuer(req)
+	if !ok {
+		return ocsp.UnauthorizedErrorResponse, nil
+	}
+	signer, ok := ca.PrivKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("Private key of CA for cert type %s cannot sign OCSP responses", certType.String())
+	}
+
+	now := clock.Now().UTC()
+	template := ocsp.Response{
+		Status:       ocsp.Unknown,
+		SerialNumber: req.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(OCSPValidity),
+	}
+	sn := cert.SerialToString(req.SerialNumber)
+	revokedCert, err := srv.getRevokedCertificate(sn)
+	if err == nil && revokedCert.CertType == certType {
+		template.Status = ocsp.Revoked
+		template.RevokedAt, _ = ptypes.Timestamp(revokedCert.RevokedAt)
+		template.RevocationReason = ocsp.Unspecified
+	} else if certInfo, err := srv.getCertInfo(sn); err == nil && certInfo.CertType == certType {
+		template.Status = ocsp.Good
+	}
+	return ocsp.CreateResponse(ca.Cert, ca.Cert, template, signer)
+}
+
+// findOCSPIssuer returns the CA which issued the certificate requested by req
+func (srv *CertifierServer) findOCSPIssuer(req *ocsp.Request) (protos.CertType, *CAInfo, bool) {
+	if !req.HashAlgorithm.Available() {
+		return 0, nil, false
+	}
+	for certType, ca := range srv.CAs {
+		var publicKeyInfo struct {
+			Algorithm pkix.AlgorithmIdentifier
+			PublicKey asn1.BitString
+		}
+		_, err := asn1.Unmarshal(ca.Cert.RawSubjectPublicKeyInfo, &publicKeyInfo)
+		if err != nil {
+			continue
+		}
+		nameHash := req.HashAlgorithm.New()
+		nameHash.Write(ca.Cert.RawSubject)
+		keyHash := req.HashAlgorithm.New()
+		keyHash.Write(publicKeyInfo.PublicKey.RightAlign())
+		if bytes.Equal(nameHash.Sum(nil), req.IssuerNameHash) && bytes.Equal(keyHash.Sum(nil), req.IssuerKeyHash) {
+			return certType, ca, true
+		}
+	}
+	return 0, nil, false
+}
+
+// Finds & returns all revoked Certificates, which are not expired yet,
+// associated with the given Identity
+func (srv *CertifierServer) FindRevokedCertificates(ctx context.Context, id *protos.Identity) (*certprotos.RevokedCertificates, error) {
+	res := &certprotos.RevokedCertificates{}
+	if id == nil {
+		return res, nil
+	}
+	revokedCerts, err := srv.getRevokedCertificates()
+	if err != nil {
+		return res, status.Errorf(codes.Internal, "Failed to get revoked certificates: %s", err)
+	}
+	idKey := id.HashString()
+	now := clock.Now().UTC()
+	for _, revokedCert := range revokedCerts {
+		notAfter, _ := ptypes.Timestamp(revokedCert.NotAfter)
+		if revokedCert.Id.HashString() == idKey && !now.After(notAfter) {
+			res.Certificates = append(res.Certificates, revokedCert)
+		}
+	}
+	return res, nil
+}
+
+func (srv *CertifierServer) getRevokedCertificate(sn string) (*certprotos.RevokedCertificate, error) {
+	marshaledRevokedCert, _, err := srv.store.Get(REVOKED_CERTIFICATE_TABLE, sn)
+	if err != nil {
+		return nil, err
+	}
+	revokedCert := &certprotos.RevokedCertificate{}
+	err = proto.Unmarshal(marshaledRevokedCert, revokedCert)
+	return revokedCert, err
+}
+
+// getRevokedCertificates returns all revoked certificates, sorted by serial
+// number
+func (srv *CertifierServer) getRevokedCertificates() ([]*certprotos.RevokedCertificate, error) {
+	snList, err := srv.store.ListKeys(REVOKED_CERTIFICATE_TABLE)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(snList)
+	values, err := srv.store.GetMany(REVOKED_CERTIFICATE_TABLE, snList)
+	if err != nil {
+		return nil, err
+	}
+	revokedCerts := make([]*certprotos.RevokedCertificate, 0, len(values))
+	for _, sn := range snList {
+		val, ok := values[sn]
+		if !ok {
+			continue
+		}
+		revokedCert := &certprotos.RevokedCertificate{}
+		err = proto.Unmarshal(val.Value, revokedCert)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to unmarshal revoked certificate with serial number %s: %s", sn, err)
+		}
+		revokedCerts = append(revokedCerts, revokedCert)
+	}
+	return revokedCerts, nil
+}
+
+// collectRevokedGarbage removes the revocation records of certificates
+// which have been expired for CollectGarbageAfter
+func (srv *CertifierServer) collectRevokedGarbage() error {
+	revokedCerts, err := srv.getRevokedCertificates()
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to get revoked certificates: %s", err)
+	}
+	now := clock.Now().UTC()
+	var staleSNs []string
+	for _, revokedCert := range revokedCerts {
+		notAfter, _ := ptypes.Timestamp(revokedCert.NotAfter)
+		if now.After(notAfter.Add(CollectGarbageAfter)) {
+			staleSNs = append(staleSNs, revokedCert.Sn)
+		}
+	}
+	if len(staleSNs) == 0 {
+		return nil
+	}
+	failedSNs, err := srv.store.DeleteMany(REVOKED_CERTIFICATE_TABLE, staleSNs)
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to delete revoked certificates: %s", err)
+	}
+	if len(failedSNs) > 0 {
+		return status.Errorf(codes.Internal, "Failed to delete revoked certificate[s]: %v", failedSNs)
+	}
+	glog.V(2).Infof("Removed %d stale revoked certificates", len(staleSNs))
+	return nil
+}
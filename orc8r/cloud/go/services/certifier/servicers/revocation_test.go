@@ -0,0 +1,154 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers_test
+
+import (
+	"crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/security/cert"
+	"magma/orc8r/cloud/go/services/certifier/servicers"
+	certifier_test_utils "magma/orc8r/cloud/go/services/certifier/test_utils"
+	"magma/orc8r/cloud/go/test_utils"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ocsp"
+	"golang.org/x/net/context"
+)
+
+func TestCertifierRevocation(t *testing.T) {
+	ds := test_utils.NewMockDatastore()
+	ctx := context.Background()
+
+	caCert, caKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+	vpnCert, vpnKey, err := certifier_test_utils.CreateSignedCertAndPrivKey(
+		time.Duration(time.Hour * 24 * 10))
+	assert.NoError(t, err)
+	caMap := map[protos.CertType]*servicers.CAInfo{
+		protos.CertType_DEFAULT: {Cert: caCert, PrivKey: caKey},
+		protos.CertType_VPN:     {Cert: vpnCert, PrivKey: vpnKey},
+	}
+	srv, err := servicers.NewCertifierServerWithRevocationURL(ds, caMap, "https://revocation.magma.test:9444/")
+	assert.NoError(t, err)
+	// another replica sharing the datastore
+	replica, err := servicers.NewCertifierServer(ds, caMap)
+	assert.NoError(t, err)
+
+	// empty CRLs are signed on creation
+	assertCRL(t, srv, protos.CertType_DEFAULT, caCert, []*x509.Certificate{})
+	assertCRL(t, srv, protos.CertType_VPN, vpnCert, []*x509.Certificate{})
+
+	gwId := protos.NewGatewayIdentity("hw1", "nw1", "gw1")
+	csrMsg, err := certifier_test_utils.CreateCSRForId(time.Duration(time.Hour*24), gwId)
+	assert.NoError(t, err)
+	revokedMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	revokedCert, err := x509.ParseCertificate(revokedMsg.CertDer)
+	assert.NoError(t, err)
+	goodMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	goodCert, err := x509.ParseCertificate(goodMsg.CertDer)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"https://revocation.magma.test:9444/crl/default"}, goodCert.CRLDistributionPoints)
+	assert.Equal(t, []string{"https://revocation.magma.test:9444/ocsp"}, goodCert.OCSPServer)
+
+	assertOCSPStatus(t, srv, goodCert, caCert, ocsp.Good)
+	assertOCSPStatus(t, srv, revokedCert, caCert, ocsp.Good)
+
+	// revoke
+	_, err = srv.RevokeCertificate(ctx, revokedMsg.Sn)
+	assert.NoError(t, err)
+	assertCRL(t, srv, protos.CertType_DEFAULT, caCert, []*x509.Certificate{revokedCert})
+	assertCRL(t, srv, protos.CertType_VPN, vpnCert, []*x509.Certificate{})
+	assertCRL(t, replica, protos.CertType_DEFAULT, caCert, []*x509.Certificate{revokedCert})
+	assertOCSPStatus(t, srv, goodCert, caCert, ocsp.Good)
+	assertOCSPStatus(t, srv, revokedCert, caCert, ocsp.Revoked)
+
+	revokedCerts, err := srv.FindRevokedCertificates(ctx, protos.NewGatewayIdentity("hw1", "", ""))
+	assert.NoError(t, err)
+	assert.Len(t, revokedCerts.Certificates, 1)
+	assert.Equal(t, revokedMsg.Sn.Sn, revokedCerts.Certificates[0].Sn)
+	assert.Equal(t, protos.CertType_DEFAULT, revokedCerts.Certificates[0].CertType)
+	assert.True(t, proto.Equal(gwId, revokedCerts.Certificates[0].Id))
+	revokedCerts, err = srv.FindRevokedCertificates(ctx, protos.NewGatewayIdentity("hw2", "", ""))
+	assert.NoError(t, err)
+	assert.Empty(t, revokedCerts.Certificates)
+
+	// revoking twice fails
+	_, err = srv.RevokeCertificate(ctx, revokedMsg.Sn)
+	assert.Error(t, err)
+
+	// unknown serial numbers
+	unknownCert := *goodCert
+	unknownCert.SerialNumber = big.NewInt(42)
+	assertOCSPStatus(t, srv, &unknownCert, caCert, ocsp.Unknown)
+
+	// certificates issued by other CAs
+	assertOCSPStatus(t, srv, goodCert, vpnCert, ocsp.Unknown)
+	otherCert, _, err := certifier_test_utils.CreateSignedCertAndPrivKey(time.Duration(time.Hour * 24))
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.UnauthorizedErrorResponse, respondOCSP(t, srv, goodCert, otherCert))
+	resp, err := srv.RespondOCSP([]byte("malformed"))
+	assert.NoError(t, err)
+	assert.Equal(t, ocsp.MalformedRequestErrorResponse, resp)
+
+	// expired revoked certificates are dropped from the CRL and collected
+	servicers.CollectGarbageAfter = time.Duration(0)
+	csrMsg, err = certifier_test_utils.CreateCSRForId(0, gwId)
+	assert.NoError(t, err)
+	expiredMsg, err := srv.SignAddCertificate(ctx, csrMsg)
+	assert.NoError(t, err)
+	_, err = srv.RevokeCertificate(ctx, expiredMsg.Sn)
+	assert.NoError(t, err)
+	assertCRL(t, srv, protos.CertType_DEFAULT, caCert, []*x509.Certificate{revokedCert})
+	revokedSns, err := ds.ListKeys(servicers.REVOKED_CERTIFICATE_TABLE)
+	assert.NoError(t, err)
+	assert.Len(t, revokedSns, 2)
+	_, err = srv.CollectGarbage(ctx, &protos.Void{})
+	assert.NoError(t, err)
+	revokedSns, err = ds.ListKeys(servicers.REVOKED_CERTIFICATE_TABLE)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{revokedMsg.Sn.Sn}, revokedSns)
+}
+
+func assertCRL(t *testing.T, srv *servicers.CertifierServer, certType protos.CertType, caCert *x509.Certificate, expected []*x509.Certificate) {
+	crlDER, err := srv.GetCRL(certType)
+	assert.NoError(t, err)
+	crl, err := x509.ParseRevocationList(crlDER)
+	assert.NoError(t, err)
+	assert.NoError(t, caCert.CheckSignature(crl.SignatureAlgorithm, crl.RawTBSRevocationList, crl.Signature))
+	var actualSns, expectedSns []string
+	for _, revoked := range crl.RevokedCertificateEntries {
+		actualSns = append(actualSns, cert.SerialToString(revoked.SerialNumber))
+	}
+	for _, expectedCert := range expected {
+		expectedSns = append(expectedSns, cert.SerialToString(expectedCert.SerialNumber))
+	}
+	assert.Equal(t, expectedSns, actualSns)
+}
+
+func assertOCSPStatus(t *testing.T, srv *servicers.CertifierServer, clientCert, caCert *x509.Certificate, expected int) {
+	resp, err := ocsp.ParseResponseForCert(respondOCSP(t, srv, clientCert, caCert), clientCert, caCert)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, resp.Status)
+}
+
+func respondOCSP(t *testing.T, srv *servicers.CertifierServer, clientCert, caCert *x509.Certificate) []byte {
+	req, err := ocsp.CreateRequest(clientCert, caCert, nil)
+	assert.NoError(t, err)
+	resp, err := srv.RespondOCSP(req)
+	assert.NoError(t, err)
+	return resp
+}
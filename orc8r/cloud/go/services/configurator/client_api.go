@@ -23,6 +23,8 @@ import (
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func getNBConfiguratorClient() (protos.NorthboundConfiguratorClient, error) {
@@ -71,18 +73,29 @@ func ListNetworksOfType(networkType string) ([]string, error) {
 }
 
 func CreateNetwork(network Network) error {
-	_, err := CreateNetworks([]Network{network})
+	return CreateNetworkAs(nil, network)
+}
+
+// CreateNetworkAs is CreateNetwork on behalf of a caller
+func CreateNetworkAs(caller *commonProtos.Identity, network Network) error {
+	_, err := CreateNetworksAs(caller, []Network{network})
 	return err
 }
 
 // CreateNetworks registers the given list of Networks and returns the created networks
 func CreateNetworks(networks []Network) ([]Network, error) {
+	return CreateNetworksAs(nil, networks)
+}
+
+// CreateNetworksAs is CreateNetworks on behalf of a caller. The caller is
+// recorded in the audit trail.
+func CreateNetworksAs(caller *commonProtos.Identity, networks []Network) ([]Network, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
 	}
 
-	req := &protos.CreateNetworksRequest{Networks: make([]*storage.Network, 0, len(networks))}
+	req := &protos.CreateNetworksRequest{Networks: make([]*storage.Network, 0, len(networks)), Caller: caller}
 	for _, n := range networks {
 		pNet, err := n.toStorageProto()
 		if err != nil {
@@ -108,44 +121,82 @@ func CreateNetworks(networks []Network) ([]Network, error) {
 
 // UpdateNetworks updates the specified networks and returns the updated networks
 func UpdateNetworks(updates []NetworkUpdateCriteria) error {
+	return UpdateNetworksAs(nil, updates)
+}
+
+// UpdateNetworksAs is UpdateNetworks on behalf of a caller. The caller is
+// recorded in the audit trail.
+func UpdateNetworksAs(caller *commonProtos.Identity, updates []NetworkUpdateCriteria) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
 
-	request := &protos.UpdateNetworksRequest{Updates: make([]*storage.NetworkUpdateCriteria, 0, len(updates))}
+	request, err := newUpdateNetworksRequest(caller, updates)
+	if err != nil {
+		return err
+	}
+	_, err = client.UpdateNetworks(context.Background(), request)
+	return err
+}
+
+// DryRunUpdateNetworksAs applies the updates on behalf of a caller without
+// committing them, and reports how they would change the mconfigs of the
+// networks' gateways.
+func DryRunUpdateNetworksAs(caller *commonProtos.Identity, updates []NetworkUpdateCriteria) (MconfigImpactReport, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return MconfigImpactReport{}, err
+	}
+
+	request, err := newUpdateNetworksRequest(caller, updates)
+	if err != nil {
+		return MconfigImpactReport{}, err
+	}
+	request.DryRun = true
+	res, err := client.UpdateNetworks(context.Background(), request)
+	if err != nil {
+		return MconfigImpactReport{}, err
+	}
+	return (MconfigImpactReport{}).fromProto(res.MconfigImpact)
+}
+
+func newUpdateNetworksRequest(caller *commonProtos.Identity, updates []NetworkUpdateCriteria) (*protos.UpdateNetworksRequest, error) {
+	request := &protos.UpdateNetworksRequest{Updates: make([]*storage.NetworkUpdateCriteria, 0, len(updates)), Caller: caller}
 	for _, update := range updates {
 		protoUpdate, err := update.toStorageProto()
 		if err != nil {
-			return err
+			return nil, err
 		}
 		request.Updates = append(request.Updates, protoUpdate)
 	}
-	_, err = client.UpdateNetworks(context.Background(), request)
-	return err
+	return request, nil
 }
 
 // DeleteNetworks deletes the network specified by networkID
 func DeleteNetworks(networkIDs []string) error {
+	return DeleteNetworksAs(nil, networkIDs)
+}
+
+// DeleteNetworksAs is DeleteNetworks on behalf of a caller. The caller is
+// recorded in the audit trail.
+func DeleteNetworksAs(caller *commonProtos.Identity, networkIDs []string) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
-	_, err = client.DeleteNetworks(context.Background(), &protos.DeleteNetworksRequest{NetworkIDs: networkIDs})
+	_, err = client.DeleteNetworks(context.Background(), &protos.DeleteNetworksRequest{NetworkIDs: networkIDs, Caller: caller})
 	return err
 }
 
 // DeleteNetwork deletes a network.
 func DeleteNetwork(networkID string) error {
-	client, err := getNBConfiguratorClient()
-	if err != nil {
-		return err
-	}
-	_, err = client.DeleteNetworks(
-		context.Background(),
-		&protos.DeleteNetworksRequest{NetworkIDs: []string{networkID}},
-	)
-	return err
+	return DeleteNetworksAs(nil, []string{networkID})
+}
+
+// DeleteNetworkAs is DeleteNetwork on behalf of a caller
+func DeleteNetworkAs(caller *commonProtos.Identity, networkID string) error {
+	return DeleteNetworksAs(caller, []string{networkID})
 }
 
 // DoesNetworkExist returns a boolean that indicates whether the networkID
@@ -280,40 +331,81 @@ func GetNetworkConfigsByType(networkID string, configType string) (interface{},
 // This function is all-or-nothing - any failure or error encountered during
 // any operation will rollback the entire batch.
 func WriteEntities(networkID string, writes ...EntityWriteOperation) error {
+	return WriteEntitiesAs(nil, networkID, writes...)
+}
+
+// WriteEntitiesAs is WriteEntities on behalf of a caller. When ACLs are
+// enforced, the writes fail with a PermissionDenied error unless the caller's
+// ACLs grant WRITE on every written and associated entity. The caller is
+// recorded in the audit trail.
+func WriteEntitiesAs(caller *commonProtos.Identity, networkID string, writes ...EntityWriteOperation) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
 	}
 
-	req := &protos.WriteEntitiesRequest{NetworkID: networkID}
+	req, err := newWriteEntitiesRequest(caller, networkID, writes)
+	if err != nil {
+		return err
+	}
+	_, err = client.WriteEntities(context.Background(), req)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DryRunWriteEntitiesAs executes the writes on behalf of a caller without
+// committing them, and reports how they would change the mconfigs of the
+// gateways whose entity graphs contain the written entities.
+func DryRunWriteEntitiesAs(caller *commonProtos.Identity, networkID string, writes ...EntityWriteOperation) (MconfigImpactReport, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return MconfigImpactReport{}, err
+	}
+
+	req, err := newWriteEntitiesRequest(caller, networkID, writes)
+	if err != nil {
+		return MconfigImpactReport{}, err
+	}
+	req.DryRun = true
+	res, err := client.WriteEntities(context.Background(), req)
+	if err != nil {
+		return MconfigImpactReport{}, err
+	}
+	return (MconfigImpactReport{}).fromProto(res.MconfigImpact)
+}
+
+func newWriteEntitiesRequest(caller *commonProtos.Identity, networkID string, writes []EntityWriteOperation) (*protos.WriteEntitiesRequest, error) {
+	req := &protos.WriteEntitiesRequest{NetworkID: networkID, Caller: caller}
 	for _, write := range writes {
 		switch op := write.(type) {
 		case NetworkEntity:
 			protoEnt, err := op.toStorageProto()
 			if err != nil {
-				return err
+				return nil, err
 			}
 			req.Writes = append(req.Writes, &protos.WriteEntityRequest{Request: &protos.WriteEntityRequest_Create{Create: protoEnt}})
 		case EntityUpdateCriteria:
 			protoEuc, err := op.toStorageProto()
 			if err != nil {
-				return err
+				return nil, err
 			}
 			req.Writes = append(req.Writes, &protos.WriteEntityRequest{Request: &protos.WriteEntityRequest_Update{Update: protoEuc}})
 		default:
-			return errors.Errorf("unrecognized entity write operation %T", op)
+			return nil, errors.Errorf("unrecognized entity write operation %T", op)
 		}
 	}
-
-	_, err = client.WriteEntities(context.Background(), req)
-	if err != nil {
-		return err
-	}
-	return nil
+	return req, nil
 }
 
 func CreateEntity(networkID string, entity NetworkEntity) (NetworkEntity, error) {
-	ret, err := CreateEntities(networkID, []NetworkEntity{entity})
+	return CreateEntityAs(nil, networkID, entity)
+}
+
+// CreateEntityAs is CreateEntity on behalf of a caller
+func CreateEntityAs(caller *commonProtos.Identity, networkID string, entity NetworkEntity) (NetworkEntity, error) {
+	ret, err := CreateEntitiesAs(caller, networkID, []NetworkEntity{entity})
 	if err != nil {
 		return NetworkEntity{}, err
 	}
@@ -322,12 +414,20 @@ func CreateEntity(networkID string, entity NetworkEntity) (NetworkEntity, error)
 
 // CreateEntities registers the given entities and returns the created network entities
 func CreateEntities(networkID string, entities []NetworkEntity) ([]NetworkEntity, error) {
+	return CreateEntitiesAs(nil, networkID, entities)
+}
+
+// CreateEntitiesAs is CreateEntities on behalf of a caller. When ACLs are
+// enforced, the create fails with a PermissionDenied error unless the caller's
+// ACLs grant WRITE on every created and associated entity. The caller is
+// recorded in the audit trail.
+func CreateEntitiesAs(caller *commonProtos.Identity, networkID string, entities []NetworkEntity) ([]NetworkEntity, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
 	}
 
-	request := &protos.CreateEntitiesRequest{NetworkID: networkID, Entities: make([]*storage.NetworkEntity, 0, len(entities))}
+	request := &protos.CreateEntitiesRequest{NetworkID: networkID, Entities: make([]*storage.NetworkEntity, 0, len(entities)), Caller: caller}
 	for _, ent := range entities {
 		protoEnt, err := ent.toStorageProto()
 		if err != nil {
@@ -358,7 +458,12 @@ func CreateInternalEntity(entity NetworkEntity) (NetworkEntity, error) {
 }
 
 func UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity, error) {
-	retMap, err := UpdateEntities(networkID, []EntityUpdateCriteria{update})
+	return UpdateEntityAs(nil, networkID, update)
+}
+
+// UpdateEntityAs is UpdateEntity on behalf of a caller
+func UpdateEntityAs(caller *commonProtos.Identity, networkID string, update EntityUpdateCriteria) (NetworkEntity, error) {
+	retMap, err := UpdateEntitiesAs(caller, networkID, []EntityUpdateCriteria{update})
 	if err != nil {
 		return NetworkEntity{}, err
 	}
@@ -370,12 +475,19 @@ func UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity,
 
 // UpdateEntities updates the registered entities and returns the updated entities
 func UpdateEntities(networkID string, updates []EntityUpdateCriteria) (map[string]NetworkEntity, error) {
+	return UpdateEntitiesAs(nil, networkID, updates)
+}
+
+// UpdateEntitiesAs is UpdateEntities on behalf of a caller. When ACLs are
+// enforced, the update fails with a PermissionDenied error unless the caller's
+// ACLs grant WRITE on every updated and associated entity.
+func UpdateEntitiesAs(caller *commonProtos.Identity, networkID string, updates []EntityUpdateCriteria) (map[string]NetworkEntity, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return nil, err
 	}
 
-	request := &protos.UpdateEntitiesRequest{NetworkID: networkID, Updates: make([]*storage.EntityUpdateCriteria, 0, len(updates))}
+	request := &protos.UpdateEntitiesRequest{NetworkID: networkID, Updates: make([]*storage.EntityUpdateCriteria, 0, len(updates)), Caller: caller}
 	for _, update := range updates {
 		upProto, err := update.toStorageProto()
 		if err != nil {
@@ -429,9 +541,21 @@ func DeleteEntity(networkID string, entityType string, entityKey string) error {
 	return DeleteEntities(networkID, []storage2.TypeAndKey{{Type: entityType, Key: entityKey}})
 }
 
+// DeleteEntityAs is DeleteEntity on behalf of a caller
+func DeleteEntityAs(caller *commonProtos.Identity, networkID string, entityType string, entityKey string) error {
+	return DeleteEntitiesAs(caller, networkID, []storage2.TypeAndKey{{Type: entityType, Key: entityKey}})
+}
+
 // DeleteEntity deletes the entity specified by networkID, type, key
 // We also have cascading deletes to delete foreign keys for assocs
 func DeleteEntities(networkID string, ids []storage2.TypeAndKey) error {
+	return DeleteEntitiesAs(nil, networkID, ids)
+}
+
+// DeleteEntitiesAs is DeleteEntities on behalf of a caller. When ACLs are
+// enforced, the delete fails with a PermissionDenied error unless the caller's
+// ACLs grant WRITE on every deleted entity.
+func DeleteEntitiesAs(caller *commonProtos.Identity, networkID string, ids []storage2.TypeAndKey) error {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
 		return err
@@ -441,6 +565,7 @@ func DeleteEntities(networkID string, ids []storage2.TypeAndKey) error {
 		&protos.DeleteEntitiesRequest{
 			NetworkID: networkID,
 			ID:        tksToEntIDs(ids),
+			Caller:    caller,
 		},
 	)
 	return err
@@ -503,8 +628,15 @@ func ListInternalEntityKeys(entityType string) ([]string, error) {
 }
 
 func LoadEntity(networkID string, entityType string, entityKey string, criteria EntityLoadCriteria) (NetworkEntity, error) {
+	return LoadEntityAs(nil, networkID, entityType, entityKey, criteria)
+}
+
+// LoadEntityAs is LoadEntity on behalf of a caller. If the caller does not
+// have READ permission on the entity, ErrNotFound is returned.
+func LoadEntityAs(caller *commonProtos.Identity, networkID string, entityType string, entityKey string, criteria EntityLoadCriteria) (NetworkEntity, error) {
 	ret := NetworkEntity{}
-	loaded, notFound, err := LoadEntities(
+	loaded, notFound, err := LoadEntitiesAs(
+		caller,
 		networkID,
 		nil, nil, nil,
 		[]storage2.TypeAndKey{{Type: entityType, Key: entityKey}},
@@ -570,6 +702,21 @@ func LoadEntities(
 	physicalID *string,
 	ids []storage2.TypeAndKey,
 	criteria EntityLoadCriteria,
+) (NetworkEntities, []storage2.TypeAndKey, error) {
+	return LoadEntitiesAs(nil, networkID, typeFilter, keyFilter, physicalID, ids, criteria)
+}
+
+// LoadEntitiesAs is LoadEntities on behalf of a caller. When ACLs are enforced,
+// entities which the caller's ACLs don't grant READ on are excluded from the
+// returned entities.
+func LoadEntitiesAs(
+	caller *commonProtos.Identity,
+	networkID string,
+	typeFilter *string,
+	keyFilter *string,
+	physicalID *string,
+	ids []storage2.TypeAndKey,
+	criteria EntityLoadCriteria,
 ) (NetworkEntities, []storage2.TypeAndKey, error) {
 	client, err := getNBConfiguratorClient()
 	if err != nil {
@@ -587,6 +734,7 @@ func LoadEntities(
 				IDs:        tksToEntIDs(ids),
 			},
 			Criteria: criteria.toStorageProto(),
+			Caller:   caller,
 		},
 	)
 	if err != nil {
@@ -604,6 +752,124 @@ func LoadEntities(
 	return ret, entIDsToTKs(resp.EntitiesNotFound), nil
 }
 
+// LoadEntitiesPage loads a single page of the entities in the network which
+// match the type filter and whose keys start with keyPrefix. Entities are
+// ordered by (type, key). An empty pageToken loads the first page. The
+// returned token loads the next page, and is empty if this is the last page.
+// A pageSize of 0 loads all matching entities in a single page.
+func LoadEntitiesPage(
+	networkID string,
+	typeFilter *string,
+	keyPrefix string,
+	pageSize uint32,
+	pageToken string,
+	criteria EntityLoadCriteria,
+) (NetworkEntities, string, error) {
+	return LoadEntitiesPageAs(nil, networkID, typeFilter, keyPrefix, pageSize, pageToken, criteria)
+}
+
+// LoadEntitiesPageAs is LoadEntitiesPage on behalf of a caller. Entities which
+// the caller's ACLs don't grant READ on are excluded from the page, so pages
+// may contain fewer than pageSize entities.
+func LoadEntitiesPageAs(
+	caller *commonProtos.Identity,
+	networkID string,
+	typeFilter *string,
+	keyPrefix string,
+	pageSize uint32,
+	pageToken string,
+	criteria EntityLoadCriteria,
+) (NetworkEntities, string, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, "", err
+	}
+
+	resp, err := client.LoadEntities(
+		context.Background(),
+		&protos.LoadEntitiesRequest{
+			NetworkID: networkID,
+			Filter: &storage.EntityLoadFilter{
+				TypeFilter: protos.GetStringWrapper(typeFilter),
+				KeyPrefix:  &wrappers.StringValue{Value: keyPrefix},
+				PageSize:   pageSize,
+				PageToken:  pageToken,
+			},
+			Criteria: criteria.toStorageProto(),
+			Caller:   caller,
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ret := make([]NetworkEntity, len(resp.Entities))
+	for i, protoEnt := range resp.Entities {
+		ent, err := ret[i].fromStorageProto(protoEnt)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "request succeeded but deserialization failed")
+		}
+		ret[i] = ent
+	}
+	return ret, resp.NextPageToken, nil
+}
+
+// SearchEntities loads a single page of the entities in the network which
+// match the search filter. Pagination works the same way as in
+// LoadEntitiesPage.
+func SearchEntities(
+	networkID string,
+	filter EntitySearchFilter,
+	pageSize uint32,
+	pageToken string,
+	criteria EntityLoadCriteria,
+) (NetworkEntities, string, error) {
+	return SearchEntitiesAs(nil, networkID, filter, pageSize, pageToken, criteria)
+}
+
+// SearchEntitiesAs is SearchEntities on behalf of a caller. Entities which
+// the caller's ACLs don't grant READ on are excluded from the page, so pages
+// may contain fewer than pageSize entities.
+func SearchEntitiesAs(
+	caller *commonProtos.Identity,
+	networkID string,
+	filter EntitySearchFilter,
+	pageSize uint32,
+	pageToken string,
+	criteria EntityLoadCriteria,
+) (NetworkEntities, string, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, "", err
+	}
+
+	protoFilter := filter.toStorageProto()
+	protoFilter.PageSize = pageSize
+	protoFilter.PageToken = pageToken
+	resp, err := client.LoadEntities(
+		context.Background(),
+		&protos.LoadEntitiesRequest{
+			NetworkID: networkID,
+			Filter:    protoFilter,
+			Criteria:  criteria.toStorageProto(),
+			Caller:    caller,
+		},
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	ret := make([]NetworkEntity, len(resp.Entities))
+	for i, protoEnt := range resp.Entities {
+		ent, err := ret[i].fromStorageProto(protoEnt)
+		if err != nil {
+			return nil, "", errors.Wrap(err, "request succeeded but deserialization failed")
+		}
+		ret[i] = ent
+	}
+	return ret, resp.NextPageToken, nil
+}
+
 // LoadInternalEntity calls LoadEntity with the internal networkID
 func LoadInternalEntity(entityType string, entityKey string, criteria EntityLoadCriteria) (NetworkEntity, error) {
 	return LoadEntity(storage.InternalNetworkID, entityType, entityKey, criteria)
@@ -682,6 +948,254 @@ func LoadAllEntitiesInNetwork(networkID string, entityType string, criteria Enti
 	return ret, nil
 }
 
+// ListNetworkRevisions returns the retained revisions of the configs of a
+// network ordered by version. The last revision is the current version of
+// the network.
+func ListNetworkRevisions(networkID string) ([]NetworkRevision, error) {
+	return ListNetworkRevisionsAs(nil, networkID)
+}
+
+// ListNetworkRevisionsAs is ListNetworkRevisions on behalf of a caller. When
+// ACLs are enforced, the list fails with a PermissionDenied error unless the
+// caller's ACLs grant READ on the network.
+func ListNetworkRevisionsAs(caller *commonProtos.Identity, networkID string) ([]NetworkRevision, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.ListNetworkRevisions(context.Background(), &protos.ListNetworkRevisionsRequest{NetworkID: networkID, Caller: caller})
+	if status.Code(err) == codes.NotFound {
+		return nil, merrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]NetworkRevision, 0, len(resp.Revisions))
+	for _, protoRev := range resp.Revisions {
+		revision, err := (NetworkRevision{}).fromStorageProto(protoRev)
+		if err != nil {
+			return nil, errors.Wrap(err, "request succeeded but deserialization failed")
+		}
+		ret = append(ret, revision)
+	}
+	return ret, nil
+}
+
+// RollbackNetwork restores the configs of a network to those of a retained
+// revision. The rollback creates a new version of the network, so it can
+// itself be rolled back.
+func RollbackNetwork(networkID string, version uint64) error {
+	return RollbackNetworkAs(nil, networkID, version)
+}
+
+// RollbackNetworkAs is RollbackNetwork on behalf of a caller. When ACLs are
+// enforced, the rollback fails with a PermissionDenied error unless the
+// caller's ACLs grant WRITE on all entities of the network. The caller is
+// recorded in the audit trail.
+func RollbackNetworkAs(caller *commonProtos.Identity, networkID string, version uint64) error {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.RollbackNetwork(context.Background(), &protos.RollbackNetworkRequest{NetworkID: networkID, Version: version, Caller: caller})
+	return err
+}
+
+// ListEntityRevisions returns the retained revisions of the config of an
+// entity ordered by version. The last revision is the current version of
+// the entity.
+func ListEntityRevisions(networkID string, entityType string, entityKey string) ([]EntityRevision, error) {
+	return ListEntityRevisionsAs(nil, networkID, entityType, entityKey)
+}
+
+// ListEntityRevisionsAs is ListEntityRevisions on behalf of a caller. When
+// ACLs are enforced, entities which the caller's ACLs don't grant READ on
+// are treated as not found.
+func ListEntityRevisionsAs(caller *commonProtos.Identity, networkID string, entityType string, entityKey string) ([]EntityRevision, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.ListEntityRevisions(
+		context.Background(),
+		&protos.ListEntityRevisionsRequest{
+			NetworkID: networkID,
+			ID:        &storage.EntityID{Type: entityType, Key: entityKey},
+			Caller:    caller,
+		},
+	)
+	if status.Code(err) == codes.NotFound {
+		return nil, merrors.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]EntityRevision, 0, len(resp.Revisions))
+	for _, protoRev := range resp.Revisions {
+		revision, err := (EntityRevision{}).fromStorageProto(protoRev)
+		if err != nil {
+			return nil, errors.Wrap(err, "request succeeded but deserialization failed")
+		}
+		ret = append(ret, revision)
+	}
+	return ret, nil
+}
+
+// RollbackEntity restores the config of an entity to that of a retained
+// revision
+func RollbackEntity(networkID string, entityType string, entityKey string, version uint64) error {
+	return RollbackEntityAs(nil, networkID, entityType, entityKey, version)
+}
+
+// RollbackEntityAs is RollbackEntity on behalf of a caller. When ACLs are
+// enforced, the rollback fails with a PermissionDenied error unless the
+// caller's ACLs grant WRITE on the entity.
+func RollbackEntityAs(caller *commonProtos.Identity, networkID string, entityType string, entityKey string, version uint64) error {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.RollbackEntity(
+		context.Background(),
+		&protos.RollbackEntityRequest{
+			NetworkID: networkID,
+			ID:        &storage.EntityID{Type: entityType, Key: entityKey},
+			Version:   version,
+			Caller:    caller,
+		},
+	)
+	return err
+}
+
+// CheckEntityPermissionAs returns a PermissionDenied error if ACLs are
+// enforced and the caller's ACLs don't grant the permission on the entity.
+// It guards operations on entities which are carried out by other services,
+// e.g. revoking the certificates of a gateway.
+func CheckEntityPermissionAs(caller *commonProtos.Identity, networkID string, entityType string, entityKey string, permission storage.ACL_Permission) error {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return err
+	}
+	_, err = client.CheckEntityPermission(
+		context.Background(),
+		&protos.CheckEntityPermissionRequest{
+			NetworkID:  networkID,
+			ID:         &storage.EntityID{Type: entityType, Key: entityKey},
+			Permission: permission,
+			Caller:     caller,
+		},
+	)
+	return err
+}
+
+// ListAuditEvents returns the audit events of a network matching the filter,
+// most recent first. Events are retained after the network or entity they
+// are about is deleted.
+func ListAuditEvents(networkID string, filter AuditEventFilter) ([]AuditEvent, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.ListAuditEvents(context.Background(), &protos.ListAuditEventsRequest{Filter: filter.toStorageProto(networkID)})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]AuditEvent, 0, len(resp.Events))
+	for _, protoEvent := range resp.Events {
+		ret = append(ret, (AuditEvent{}).fromStorageProto(protoEvent))
+	}
+	return ret, nil
+}
+
+// ExportNetwork returns everything configurator stores about a network: the
+// network itself and all of its entities along with their associations and
+// labels. Configs in the export are kept serialized.
+func ExportNetwork(networkID string) (*protos.NetworkExport, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.ExportNetwork(context.Background(), &protos.ExportNetworkRequest{NetworkID: networkID})
+}
+
+// ImportNetwork writes an exported network into the target network of the
+// options in a single transaction. Nothing is written if the import fails.
+func ImportNetwork(export *protos.NetworkExport, opts ImportNetworkOptions) (ImportNetworkResult, error) {
+	return ImportNetworkAs(nil, export, opts)
+}
+
+// ImportNetworkAs is ImportNetwork on behalf of a caller. When ACLs are
+// enforced, the import fails with a PermissionDenied error unless the caller's
+// ACLs grant WRITE on every written and associated entity.
+func ImportNetworkAs(caller *commonProtos.Identity, export *protos.NetworkExport, opts ImportNetworkOptions) (ImportNetworkResult, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return ImportNetworkResult{}, err
+	}
+	res, err := client.ImportNetwork(context.Background(), opts.toProto(caller, export))
+	if err != nil {
+		return ImportNetworkResult{}, err
+	}
+	return (ImportNetworkResult{}).fromProto(res), nil
+}
+
+// ErrChangesUnavailable is returned by LoadEntityChanges when the changes
+// since the requested sequence number can't be listed, e.g. because they are
+// no longer retained. The caller has to reload everything it is interested in.
+var ErrChangesUnavailable = errors.New("entity changes since the requested sequence number are unavailable")
+
+// LoadEntityChanges returns the entities of the network which changed after
+// sequence number `since`. If since is nil, only the current sequence number
+// of the network is returned.
+func LoadEntityChanges(networkID string, since *uint64) (EntityChanges, error) {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return EntityChanges{}, err
+	}
+	req := &protos.LoadEntityChangesRequest{NetworkID: networkID}
+	if since != nil {
+		req.Since = &wrappers.UInt64Value{Value: *since}
+	}
+	res, err := client.LoadEntityChanges(context.Background(), req)
+	if status.Code(err) == codes.OutOfRange {
+		return EntityChanges{}, ErrChangesUnavailable
+	}
+	if err != nil {
+		return EntityChanges{}, err
+	}
+	return (EntityChanges{}).fromStorageProto(res), nil
+}
+
+// WatchEntityChanges calls handler with the current change sequence number of
+// the network, then again each time it advances, until ctx is cancelled, the
+// stream breaks, or handler returns an error. Sequence numbers passed to
+// handler can be passed to LoadEntityChanges to list what changed.
+func WatchEntityChanges(ctx context.Context, networkID string, handler func(sequence uint64) error) error {
+	client, err := getNBConfiguratorClient()
+	if err != nil {
+		return err
+	}
+	stream, err := client.WatchEntityChanges(ctx, &protos.WatchEntityChangesRequest{NetworkID: networkID})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if err := handler(res.Sequence); err != nil {
+			return err
+		}
+	}
+}
+
 func getSBConfiguratorClient() (protos.SouthboundConfiguratorClient, error) {
 	conn, err := registry.GetConnection(ServiceName)
 	if err != nil {
@@ -8,16 +8,31 @@ LICENSE file in the root directory of this source tree.
 package configurator_test
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/clock"
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/identity"
+	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/serde"
 	"magma/orc8r/cloud/go/services/configurator"
+	configuratorProtos "magma/orc8r/cloud/go/services/configurator/protos"
+	"magma/orc8r/cloud/go/services/configurator/servicers"
+	configuratorStorage "magma/orc8r/cloud/go/services/configurator/storage"
 	"magma/orc8r/cloud/go/services/configurator/test_init"
 	"magma/orc8r/cloud/go/storage"
 
 	"github.com/go-openapi/swag"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -263,6 +278,555 @@ func TestConfiguratorService(t *testing.T) {
 	assert.Equal(t, "foobar", entities[0].Name)
 }
 
+func TestConfiguratorACLs(t *testing.T) {
+	test_init.StartTestServiceWithACLPolicy(t, servicers.ACLPolicy{Enforce: true, AdminOperators: []string{"admin"}})
+	_, err := configurator.CreateNetworks([]configurator.Network{{ID: networkID1}, {ID: networkID2}})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(networkID1, []configurator.NetworkEntity{
+		{Type: "acl_foo", Key: "1"},
+		{Type: "acl_foo", Key: "2"},
+		{Type: "acl_bar", Key: "1"},
+	})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(networkID2, []configurator.NetworkEntity{{Type: "acl_foo", Key: "1"}})
+	assert.NoError(t, err)
+
+	// The operator can read everything in network 1, but write only acl_foo 1
+	_, err = configurator.CreateInternalEntity(configurator.NetworkEntity{
+		Type: configurator.OperatorEntityType,
+		Key:  "operator1",
+		Permissions: []*configuratorStorage.ACL{
+			{
+				Permission: configuratorStorage.ACL_READ,
+				Type:       &configuratorStorage.ACL_TypeWildcard{TypeWildcard: configuratorStorage.ACL_WILDCARD_ALL},
+				Scope:      &configuratorStorage.ACL_ScopeNetworkIDs{ScopeNetworkIDs: &configuratorStorage.ACL_NetworkIDs{IDs: []string{networkID1}}},
+			},
+			{
+				Permission: configuratorStorage.ACL_WRITE,
+				Type:       &configuratorStorage.ACL_EntityType{EntityType: "acl_foo"},
+				Scope:      &configuratorStorage.ACL_ScopeNetworkIDs{ScopeNetworkIDs: &configuratorStorage.ACL_NetworkIDs{IDs: []string{networkID1}}},
+				IDFilter:   []string{"1"},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	operator := identity.NewOperator("operator1")
+
+	loaded, err := configurator.LoadInternalEntity(configurator.OperatorEntityType, "operator1", configurator.EntityLoadCriteria{LoadPermissions: true})
+	assert.NoError(t, err)
+	assert.Len(t, loaded.Permissions, 2)
+
+	// Loads are filtered to readable entities
+	entities, _, err := configurator.LoadEntitiesAs(operator, networkID1, nil, nil, nil, nil, configurator.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Len(t, entities, 3)
+	entities, _, err = configurator.LoadEntitiesAs(operator, networkID2, nil, nil, nil, nil, configurator.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Empty(t, entities)
+	_, err = configurator.LoadEntityAs(operator, networkID2, "acl_foo", "1", configurator.EntityLoadCriteria{})
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// Revisions are only listed for readable networks and entities
+	_, err = configurator.ListNetworkRevisionsAs(operator, networkID1)
+	assert.NoError(t, err)
+	_, err = configurator.ListNetworkRevisionsAs(operator, networkID2)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	revisions, err := configurator.ListEntityRevisionsAs(operator, networkID1, "acl_foo", "1")
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 1)
+	_, err = configurator.ListEntityRevisionsAs(operator, networkID2, "acl_foo", "1")
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// Writes fail unless every entity is writable
+	_, err = configurator.UpdateEntitiesAs(operator, networkID1, []configurator.EntityUpdateCriteria{{Type: "acl_foo", Key: "1", NewName: swag.String("one")}})
+	assert.NoError(t, err)
+	_, err = configurator.UpdateEntitiesAs(operator, networkID1, []configurator.EntityUpdateCriteria{
+		{Type: "acl_foo", Key: "1", NewName: swag.String("uno")},
+		{Type: "acl_foo", Key: "2", NewName: swag.String("dos")},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	ent, err := configurator.LoadEntity(networkID1, "acl_foo", "1", configurator.EntityLoadCriteria{LoadMetadata: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "one", ent.Name)
+
+	_, err = configurator.CreateEntityAs(operator, networkID1, configurator.NetworkEntity{Type: "acl_bar", Key: "2"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = configurator.WriteEntitiesAs(operator, networkID1, configurator.EntityUpdateCriteria{Type: "acl_bar", Key: "1", NewName: swag.String("one")})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	// Associating an entity with one which isn't writable is denied as well
+	err = configurator.WriteEntitiesAs(operator, networkID1, configurator.EntityUpdateCriteria{
+		Type:              "acl_foo",
+		Key:               "1",
+		AssociationsToAdd: []storage.TypeAndKey{{Type: "acl_bar", Key: "1"}},
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = configurator.UpdateEntitiesAs(operator, networkID1, []configurator.EntityUpdateCriteria{{
+		Type:              "acl_foo",
+		Key:               "1",
+		AssociationsToAdd: []storage.TypeAndKey{{Type: "acl_bar", Key: "1"}},
+	}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = configurator.WriteEntitiesAs(operator, networkID1, configurator.EntityUpdateCriteria{Type: "acl_foo", Key: "1", NewName: swag.String("one")})
+	assert.NoError(t, err)
+
+	// Permission checks for operations which other services carry out
+	assert.NoError(t, configurator.CheckEntityPermissionAs(operator, networkID1, "acl_foo", "1", configuratorStorage.ACL_WRITE))
+	assert.NoError(t, configurator.CheckEntityPermissionAs(operator, networkID1, "acl_bar", "1", configuratorStorage.ACL_READ))
+	err = configurator.CheckEntityPermissionAs(operator, networkID1, "acl_bar", "1", configuratorStorage.ACL_WRITE)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = configurator.CheckEntityPermissionAs(operator, networkID2, "acl_foo", "1", configuratorStorage.ACL_READ)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = configurator.DeleteEntityAs(operator, networkID1, "acl_bar", "1")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = configurator.DeleteEntityAs(operator, networkID1, "acl_foo", "1")
+	assert.NoError(t, err)
+	exists, err := configurator.DoesEntityExist(networkID1, "acl_foo", "1")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+
+	// Unregistered operators and non-operator callers are denied
+	_, _, err = configurator.LoadEntitiesAs(identity.NewOperator("operator2"), networkID1, nil, nil, nil, nil, configurator.EntityLoadCriteria{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	err = configurator.DeleteEntityAs(identity.NewNetwork(networkID1), networkID1, "acl_bar", "1")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Admin operators bypass ACLs without being registered
+	admin := identity.NewOperator("admin")
+	entities, _, err = configurator.LoadEntitiesAs(admin, networkID2, nil, nil, nil, nil, configurator.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Len(t, entities, 1)
+	err = configurator.DeleteEntityAs(admin, networkID1, "acl_bar", "1")
+	assert.NoError(t, err)
+}
+
+func TestConfiguratorACLs_NotEnforced(t *testing.T) {
+	test_init.StartTestService(t)
+	_, err := configurator.CreateNetworks([]configurator.Network{{ID: networkID1}})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(networkID1, []configurator.NetworkEntity{{Type: "acl_foo", Key: "1"}})
+	assert.NoError(t, err)
+
+	// Unregistered operators aren't locked out when ACLs aren't enforced
+	operator := identity.NewOperator("operator1")
+	entities, _, err := configurator.LoadEntitiesAs(operator, networkID1, nil, nil, nil, nil, configurator.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Len(t, entities, 1)
+	_, err = configurator.UpdateEntitiesAs(operator, networkID1, []configurator.EntityUpdateCriteria{{Type: "acl_foo", Key: "1", NewName: swag.String("one")}})
+	assert.NoError(t, err)
+	assert.NoError(t, configurator.CheckEntityPermissionAs(operator, networkID1, "acl_foo", "1", configuratorStorage.ACL_WRITE))
+	err = configurator.DeleteEntityAs(operator, networkID1, "acl_foo", "1")
+	assert.NoError(t, err)
+}
+
+func TestConfiguratorRevisions(t *testing.T) {
+	test_init.StartTestServiceWithACLPolicy(t, servicers.ACLPolicy{Enforce: true})
+	err := serde.RegisterSerdes(
+		&mockSerde{domain: configurator.NetworkConfigSerdeDomain, serdeType: "rev_foo"},
+		&mockSerde{domain: configurator.NetworkConfigSerdeDomain, serdeType: "rev_bar"},
+		&mockSerde{domain: configurator.NetworkEntitySerdeDomain, serdeType: "rev_foo"},
+	)
+	assert.NoError(t, err)
+
+	_, err = configurator.CreateNetworks([]configurator.Network{{ID: networkID1, Configs: map[string]interface{}{"rev_foo": "foo0"}}})
+	assert.NoError(t, err)
+	assert.NoError(t, configurator.UpdateNetworkConfig(networkID1, "rev_foo", "foo1"))
+	assert.NoError(t, configurator.UpdateNetworkConfig(networkID1, "rev_bar", "bar2"))
+
+	revisions, err := configurator.ListNetworkRevisions(networkID1)
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 3)
+	assert.Equal(t, []uint64{0, 1, 2}, []uint64{revisions[0].Version, revisions[1].Version, revisions[2].Version})
+	assert.Equal(t, map[string]interface{}{"rev_foo": "foo0"}, revisions[0].Configs)
+	assert.Equal(t, map[string]interface{}{"rev_foo": "foo1", "rev_bar": "bar2"}, revisions[2].Configs)
+	assert.NotZero(t, revisions[0].ReplacedAt)
+	assert.Zero(t, revisions[2].ReplacedAt)
+
+	changes, err := configurator.DiffNetworkConfigs(revisions[0].Configs, revisions[2].Configs)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]configurator.ConfigChange{
+			{Op: configurator.ConfigChangeAdd, Path: "/rev_bar", To: "bar2"},
+			{Op: configurator.ConfigChangeReplace, Path: "/rev_foo", From: "foo0", To: "foo1"},
+		},
+		changes,
+	)
+
+	// Roll back to the first version, which deletes the config added since
+	err = configurator.RollbackNetworkAs(identity.NewNetwork(networkID1), networkID1, 0)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.NoError(t, configurator.RollbackNetwork(networkID1, 0))
+	network, err := configurator.LoadNetwork(networkID1, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"rev_foo": "foo0"}, network.Configs)
+	// The rollback can itself be rolled back
+	revisions, err = configurator.ListNetworkRevisions(networkID1)
+	assert.NoError(t, err)
+	assert.Len(t, revisions, 4)
+	assert.Equal(t, map[string]interface{}{"rev_foo": "foo1", "rev_bar": "bar2"}, revisions[2].Configs)
+
+	err = configurator.RollbackNetwork(networkID1, revisions[3].Version)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = configurator.RollbackNetwork(networkID1, 42)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = configurator.ListNetworkRevisions(networkID2)
+	assert.Equal(t, merrors.ErrNotFound, err)
+
+	// Entities
+	_, err = configurator.CreateEntity(networkID1, configurator.NetworkEntity{Type: "rev_foo", Key: "1", Config: "ent0"})
+	assert.NoError(t, err)
+	assert.NoError(t, configurator.CreateOrUpdateEntityConfig(networkID1, "rev_foo", "1", "ent1"))
+	entRevisions, err := configurator.ListEntityRevisions(networkID1, "rev_foo", "1")
+	assert.NoError(t, err)
+	assert.Len(t, entRevisions, 2)
+	assert.Equal(t, "ent0", entRevisions[0].Config)
+	assert.Equal(t, "ent1", entRevisions[1].Config)
+
+	err = configurator.RollbackEntityAs(identity.NewNetwork(networkID1), networkID1, "rev_foo", "1", entRevisions[0].Version)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.NoError(t, configurator.RollbackEntity(networkID1, "rev_foo", "1", entRevisions[0].Version))
+	config, err := configurator.LoadEntityConfig(networkID1, "rev_foo", "1")
+	assert.NoError(t, err)
+	assert.Equal(t, "ent0", config)
+
+	_, err = configurator.ListEntityRevisions(networkID1, "rev_foo", "2")
+	assert.Equal(t, merrors.ErrNotFound, err)
+}
+
+func TestConfiguratorAuditEvents(t *testing.T) {
+	test_init.StartTestService(t)
+	err := serde.RegisterSerdes(
+		&mockSerde{domain: configurator.NetworkConfigSerdeDomain, serdeType: "audit_foo"},
+		&mockSerde{domain: configurator.NetworkEntitySerdeDomain, serdeType: "audit_foo"},
+	)
+	assert.NoError(t, err)
+	defer clock.UnfreezeClock(t)
+	operator := identity.NewOperator("operator1")
+
+	clock.SetAndFreezeClock(t, time.Unix(1000, 0))
+	_, err = configurator.CreateNetworksAs(operator, []configurator.Network{{ID: networkID1, Configs: map[string]interface{}{"audit_foo": "n0"}}})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity(networkID1, configurator.NetworkEntity{Type: "audit_foo", Key: "1", Config: "e0"})
+	assert.NoError(t, err)
+
+	clock.SetAndFreezeClock(t, time.Unix(1001, 0))
+	err = configurator.UpdateNetworksAs(operator, []configurator.NetworkUpdateCriteria{{ID: networkID1, ConfigsToAddOrUpdate: map[string]interface{}{"audit_foo": "n1"}}})
+	assert.NoError(t, err)
+	assert.NoError(t, configurator.CreateOrUpdateEntityConfig(networkID1, "audit_foo", "1", "e1"))
+
+	clock.SetAndFreezeClock(t, time.Unix(1002, 0))
+	assert.NoError(t, configurator.DeleteEntity(networkID1, "audit_foo", "1"))
+	// Updates to entities which don't exist aren't recorded
+	assert.NoError(t, configurator.DeleteEntity(networkID1, "audit_foo", "2"))
+	assert.NoError(t, configurator.DeleteNetworkAs(operator, networkID1))
+
+	// Events outlive the network
+	events, err := configurator.ListAuditEvents(networkID1, configurator.AuditEventFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 6)
+	entTK := &storage.TypeAndKey{Type: "audit_foo", Key: "1"}
+	digest0, digest1 := sha256Hex("e0"), sha256Hex("e1")
+	actions := make([]string, 0, len(events))
+	for _, event := range events {
+		assert.NotEmpty(t, event.ID)
+		assert.Equal(t, networkID1, event.NetworkID)
+		actions = append(actions, event.Action)
+	}
+	assert.Equal(
+		t,
+		[]string{
+			configuratorStorage.AuditActionDelete, configuratorStorage.AuditActionDelete,
+			configuratorStorage.AuditActionUpdate, configuratorStorage.AuditActionUpdate,
+			configuratorStorage.AuditActionCreate, configuratorStorage.AuditActionCreate,
+		},
+		actions,
+	)
+
+	events, err = configurator.ListAuditEvents(networkID1, configurator.AuditEventFilter{EntityType: strPointer("audit_foo")})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]configurator.AuditEvent{
+			{ID: events[0].ID, NetworkID: networkID1, Entity: entTK, Action: configuratorStorage.AuditActionDelete, Timestamp: 1002, BeforeDigest: digest1},
+			{ID: events[1].ID, NetworkID: networkID1, Entity: entTK, Action: configuratorStorage.AuditActionUpdate, Timestamp: 1001, BeforeDigest: digest0, AfterDigest: digest1},
+			{ID: events[2].ID, NetworkID: networkID1, Entity: entTK, Action: configuratorStorage.AuditActionCreate, Timestamp: 1000, AfterDigest: digest0},
+		},
+		events,
+	)
+
+	events, err = configurator.ListAuditEvents(networkID1, configurator.AuditEventFilter{Operator: strPointer("operator1")})
+	assert.NoError(t, err)
+	assert.Len(t, events, 3)
+	for _, event := range events {
+		assert.Nil(t, event.Entity)
+		assert.Equal(t, "operator1", event.Operator)
+	}
+	// Network configs are digested as a whole
+	assert.Equal(t, "", events[0].AfterDigest)
+	assert.Equal(t, events[1].AfterDigest, events[0].BeforeDigest)
+	assert.Equal(t, events[2].AfterDigest, events[1].BeforeDigest)
+	assert.NotEqual(t, events[1].BeforeDigest, events[1].AfterDigest)
+
+	events, err = configurator.ListAuditEvents(networkID1, configurator.AuditEventFilter{StartTime: 1001, EndTime: 1001})
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+	events, err = configurator.ListAuditEvents(networkID1, configurator.AuditEventFilter{Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+}
+
+func TestConfiguratorDryRun(t *testing.T) {
+	test_init.StartTestService(t)
+	err := serde.RegisterSerdes(
+		&mockSerde{domain: configurator.NetworkConfigSerdeDomain, serdeType: "dry_run_foo"},
+		&mockSerde{domain: configurator.NetworkEntitySerdeDomain, serdeType: "dry_run_foo"},
+	)
+	assert.NoError(t, err)
+	configurator.RegisterMconfigBuilders(dryRunMconfigBuilder{})
+	defer configurator.ClearMconfigBuilders(t)
+
+	_, err = configurator.CreateNetworks([]configurator.Network{{ID: networkID1, Configs: map[string]interface{}{"dry_run_foo": "n0"}}})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(networkID1, []configurator.NetworkEntity{
+		{Type: "dry_run_foo", Key: "f1", Config: "e0"},
+		{Type: orc8r.MagmadGatewayType, Key: "gw1", Associations: []storage.TypeAndKey{{Type: "dry_run_foo", Key: "f1"}}},
+		{Type: orc8r.MagmadGatewayType, Key: "gw2"},
+	})
+	assert.NoError(t, err)
+
+	// Network updates affect every gateway of the network
+	report, err := configurator.DryRunUpdateNetworksAs(nil, []configurator.NetworkUpdateCriteria{{ID: networkID1, ConfigsToAddOrUpdate: map[string]interface{}{"dry_run_foo": "n1"}}})
+	assert.NoError(t, err)
+	assert.Len(t, report.Gateways, 2)
+	for i, gatewayID := range []string{"gw1", "gw2"} {
+		impact := report.Gateways[i]
+		assert.Equal(t, networkID1, impact.NetworkID)
+		assert.Equal(t, gatewayID, impact.GatewayID)
+		assert.True(t, impact.Changed())
+		assert.NotEmpty(t, impact.BeforeDigest)
+		assert.Equal(
+			t,
+			[]configurator.ConfigChange{{Op: configurator.ConfigChangeReplace, Path: "/network/value", From: "n0", To: "n1"}},
+			impact.Changes,
+		)
+	}
+	network, err := configurator.LoadNetwork(networkID1, false, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "n0", network.Configs["dry_run_foo"])
+
+	// Entity writes only affect the gateways whose graphs contain the written
+	// entities, including gateways created by the write
+	report, err = configurator.DryRunWriteEntitiesAs(
+		nil,
+		networkID1,
+		configurator.EntityUpdateCriteria{Type: "dry_run_foo", Key: "f1", NewConfig: "e1"},
+		configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: "gw3", Associations: []storage.TypeAndKey{{Type: "dry_run_foo", Key: "f1"}}},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, report.Gateways, 2)
+	gw1Impact, gw3Impact := report.Gateways[0], report.Gateways[1]
+	assert.Equal(t, "gw1", gw1Impact.GatewayID)
+	assert.Equal(
+		t,
+		[]configurator.ConfigChange{{Op: configurator.ConfigChangeReplace, Path: "/entity_f1/value", From: "e0", To: "e1"}},
+		gw1Impact.Changes,
+	)
+	assert.Equal(t, "gw3", gw3Impact.GatewayID)
+	assert.Empty(t, gw3Impact.BeforeDigest)
+	assert.Equal(t, gw1Impact.AfterDigest, gw3Impact.AfterDigest)
+	assert.Len(t, gw3Impact.Changes, 2)
+
+	exists, err := configurator.DoesEntityExist(networkID1, orc8r.MagmadGatewayType, "gw3")
+	assert.NoError(t, err)
+	assert.False(t, exists)
+	ent, err := configurator.LoadEntity(networkID1, "dry_run_foo", "f1", configurator.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Equal(t, "e0", ent.Config)
+
+	// Dry runs aren't audited
+	events, err := configurator.ListAuditEvents(networkID1, configurator.AuditEventFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 4)
+}
+
+func TestConfiguratorSearch(t *testing.T) {
+	test_init.StartTestService(t)
+	err := configurator.CreateNetwork(configurator.Network{ID: networkID1})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(networkID1, []configurator.NetworkEntity{
+		{Type: "search_gw", Key: "gw1", Name: "Rooftop", Labels: map[string]string{"site": "north"}},
+		{Type: "search_gw", Key: "gw2", PhysicalID: "search-hw-2", Labels: map[string]string{"site": "south"}},
+		{Type: "search_tier", Key: "t1", Description: "rooftop gateways"},
+	})
+	assert.NoError(t, err)
+
+	search := func(filter configurator.EntitySearchFilter) []storage.TypeAndKey {
+		ents, token, err := configurator.SearchEntities(networkID1, filter, 0, "", configurator.EntityLoadCriteria{})
+		assert.NoError(t, err)
+		assert.Empty(t, token)
+		ret := []storage.TypeAndKey{}
+		for _, ent := range ents {
+			ret = append(ret, ent.GetTypeAndKey())
+		}
+		return ret
+	}
+	assert.Equal(
+		t,
+		[]storage.TypeAndKey{{Type: "search_gw", Key: "gw1"}, {Type: "search_tier", Key: "t1"}},
+		search(configurator.EntitySearchFilter{Text: "ROOF"}),
+	)
+	assert.Equal(
+		t,
+		[]storage.TypeAndKey{{Type: "search_gw", Key: "gw1"}},
+		search(configurator.EntitySearchFilter{Text: "roof", Types: []string{"search_gw"}}),
+	)
+	assert.Equal(
+		t,
+		[]storage.TypeAndKey{{Type: "search_gw", Key: "gw2"}},
+		search(configurator.EntitySearchFilter{Text: "hw-2"}),
+	)
+	assert.Equal(
+		t,
+		[]storage.TypeAndKey{{Type: "search_gw", Key: "gw2"}},
+		search(configurator.EntitySearchFilter{Labels: map[string]string{"site": "south"}}),
+	)
+
+	// Pages of search results
+	ents, token, err := configurator.SearchEntities(networkID1, configurator.EntitySearchFilter{Types: []string{"search_gw", "search_tier"}}, 2, "", configurator.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Len(t, ents, 2)
+	assert.NotEmpty(t, token)
+	ents, token, err = configurator.SearchEntities(networkID1, configurator.EntitySearchFilter{Types: []string{"search_gw", "search_tier"}}, 2, token, configurator.EntityLoadCriteria{})
+	assert.NoError(t, err)
+	assert.Equal(t, []storage.TypeAndKey{{Type: "search_tier", Key: "t1"}}, []storage.TypeAndKey{ents[0].GetTypeAndKey()})
+	assert.Empty(t, token)
+
+	// Update labels
+	_, err = configurator.UpdateEntity(networkID1, configurator.EntityUpdateCriteria{
+		Type: "search_gw", Key: "gw1",
+		LabelsToAddOrUpdate: map[string]string{"rack": "3"},
+		LabelsToDelete:      []string{"site"},
+	})
+	assert.NoError(t, err)
+	ent, err := configurator.LoadEntity(networkID1, "search_gw", "gw1", configurator.FullEntityLoadCriteria())
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"rack": "3"}, ent.Labels)
+	assert.Equal(
+		t,
+		[]storage.TypeAndKey{},
+		search(configurator.EntitySearchFilter{Labels: map[string]string{"site": "north"}}),
+	)
+}
+
+func TestConfiguratorExportImport(t *testing.T) {
+	test_init.StartTestService(t)
+	err := serde.RegisterSerdes(
+		&mockSerde{domain: configurator.NetworkConfigSerdeDomain, serdeType: "export_foo"},
+		&mockSerde{domain: configurator.NetworkEntitySerdeDomain, serdeType: "export_foo"},
+	)
+	assert.NoError(t, err)
+
+	err = configurator.CreateNetwork(configurator.Network{ID: networkID1, Name: "staging", Configs: map[string]interface{}{"export_foo": "net"}})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities(networkID1, []configurator.NetworkEntity{
+		{Type: "export_foo", Key: "gw1", PhysicalID: "export-hw-1", Config: "gw1", Labels: map[string]string{"site": "north"}},
+		{Type: "export_a_tier", Key: "t1", Associations: []storage.TypeAndKey{{Type: "export_foo", Key: "gw1"}}},
+	})
+	assert.NoError(t, err)
+
+	export, err := configurator.ExportNetwork(networkID1)
+	assert.NoError(t, err)
+	assert.Equal(t, networkID1, export.Network.ID)
+	assert.Equal(t, "staging", export.Network.Name)
+	assert.Equal(t, map[string][]byte{"export_foo": []byte("net")}, export.Network.Configs)
+	assert.Len(t, export.Entities, 2)
+	assert.Equal(t, "t1", export.Entities[0].Key)
+	assert.Equal(t, []*configuratorStorage.EntityID{{Type: "export_foo", Key: "gw1"}}, export.Entities[0].Associations)
+	assert.Equal(t, []byte("gw1"), export.Entities[1].Config)
+	assert.Equal(t, map[string]string{"site": "north"}, export.Entities[1].Labels)
+
+	_, err = configurator.ExportNetwork(networkID2)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Clone into a new network, remapping IDs
+	opts := configurator.ImportNetworkOptions{
+		TargetNetworkID:  networkID2,
+		KeyRemaps:        map[storage.TypeAndKey]string{{Type: "export_foo", Key: "gw1"}: "gw9"},
+		PhysicalIDRemaps: map[string]string{"export-hw-1": "export-hw-2"},
+	}
+	res, err := configurator.ImportNetwork(export, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, configurator.ImportNetworkResult{
+		NetworkCreated: true,
+		Created:        []storage.TypeAndKey{{Type: "export_foo", Key: "gw9"}, {Type: "export_a_tier", Key: "t1"}},
+	}, res)
+	network, err := configurator.LoadNetwork(networkID2, true, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "staging", network.Name)
+	assert.Equal(t, map[string]interface{}{"export_foo": "net"}, network.Configs)
+	gateway, err := configurator.LoadEntity(networkID2, "export_foo", "gw9", configurator.FullEntityLoadCriteria())
+	assert.NoError(t, err)
+	assert.Equal(t, "export-hw-2", gateway.PhysicalID)
+	assert.Equal(t, "gw1", gateway.Config)
+	assert.Equal(t, map[string]string{"site": "north"}, gateway.Labels)
+	assert.Equal(t, []storage.TypeAndKey{{Type: "export_a_tier", Key: "t1"}}, gateway.ParentAssociations)
+
+	// Conflicts
+	_, err = configurator.ImportNetwork(export, opts)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	opts.ConflictPolicy = configuratorProtos.ImportNetworkRequest_SKIP
+	res, err = configurator.ImportNetwork(export, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, configurator.ImportNetworkResult{
+		Skipped: []storage.TypeAndKey{{Type: "export_a_tier", Key: "t1"}, {Type: "export_foo", Key: "gw9"}},
+	}, res)
+
+	export.Network.Configs = map[string][]byte{}
+	export.Entities[1].Config = []byte("gw1-new")
+	export.Entities[1].Labels = map[string]string{"rack": "3"}
+	opts.ConflictPolicy = configuratorProtos.ImportNetworkRequest_OVERWRITE
+	res, err = configurator.ImportNetwork(export, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, configurator.ImportNetworkResult{
+		Overwritten: []storage.TypeAndKey{{Type: "export_foo", Key: "gw9"}, {Type: "export_a_tier", Key: "t1"}},
+	}, res)
+	network, err = configurator.LoadNetwork(networkID2, true, true)
+	assert.NoError(t, err)
+	assert.Empty(t, network.Configs)
+	gateway, err = configurator.LoadEntity(networkID2, "export_foo", "gw9", configurator.FullEntityLoadCriteria())
+	assert.NoError(t, err)
+	assert.Equal(t, "gw1-new", gateway.Config)
+	assert.Equal(t, map[string]string{"rack": "3"}, gateway.Labels)
+
+	// Remapping two entities onto the same ID
+	opts.KeyRemaps = map[storage.TypeAndKey]string{{Type: "export_a_tier", Key: "t1"}: "t2"}
+	export.Entities[1].Type = "export_a_tier"
+	export.Entities[1].Key = "t2"
+	_, err = configurator.ImportNetwork(export, opts)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// dryRunMconfigBuilder builds an mconfig out of the dry_run_foo configs of
+// the network and the gateway's entity graph
+type dryRunMconfigBuilder struct{}
+
+func (dryRunMconfigBuilder) Build(networkID string, gatewayID string, graph configurator.EntityGraph, network configurator.Network, mconfigOut map[string]proto.Message) error {
+	mconfigOut["network"] = &wrappers.StringValue{Value: network.Configs["dry_run_foo"].(string)}
+	for _, ent := range graph.Entities {
+		if ent.Type == "dry_run_foo" {
+			mconfigOut["entity_"+ent.Key] = &wrappers.StringValue{Value: ent.Config.(string)}
+		}
+	}
+	return nil
+}
+
+func sha256Hex(str string) string {
+	sum := sha256.Sum256([]byte(str))
+	return hex.EncodeToString(sum[:])
+}
+
 func strPointer(str string) *string {
 	return &str
 }
@@ -290,3 +854,70 @@ func (m *mockSerde) Serialize(in interface{}) ([]byte, error) {
 func (m *mockSerde) Deserialize(in []byte) (interface{}, error) {
 	return string(in), nil
 }
+
+func TestConfiguratorEntityChanges(t *testing.T) {
+	test_init.StartTestService(t)
+	err := serde.RegisterSerdes(
+		&mockSerde{domain: configurator.NetworkConfigSerdeDomain, serdeType: "chg_foo"},
+		&mockSerde{domain: configurator.NetworkEntitySerdeDomain, serdeType: "chg_foo"},
+	)
+	assert.NoError(t, err)
+	assert.NoError(t, configurator.CreateNetwork(configurator.Network{ID: networkID1}))
+
+	start, err := configurator.LoadEntityChanges(networkID1, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, start.Entities)
+
+	// Watch the network's sequence while writing to it
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	sequences := make(chan uint64, 10)
+	go configurator.WatchEntityChanges(ctx, networkID1, func(sequence uint64) error {
+		sequences <- sequence
+		return nil
+	})
+	assert.Equal(t, start.Sequence, receiveSequence(t, sequences))
+
+	_, err = configurator.CreateEntity(networkID1, configurator.NetworkEntity{Type: "chg_foo", Key: "1", Config: "foo"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity(
+		networkID1,
+		configurator.NetworkEntity{Type: "chg_foo", Key: "2", Associations: []storage.TypeAndKey{{Type: "chg_foo", Key: "1"}}},
+	)
+	assert.NoError(t, err)
+	sequence := receiveSequence(t, sequences)
+	for sequence < start.Sequence+2 {
+		sequence = receiveSequence(t, sequences)
+	}
+	assert.Equal(t, start.Sequence+2, sequence)
+
+	changes, err := configurator.LoadEntityChanges(networkID1, &start.Sequence)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		configurator.EntityChanges{
+			Sequence: start.Sequence + 2,
+			Entities: []storage.TypeAndKey{{Type: "chg_foo", Key: "1"}, {Type: "chg_foo", Key: "2"}},
+		},
+		changes,
+	)
+
+	assert.NoError(t, configurator.UpdateNetworkConfig(networkID1, "chg_foo", "bar"))
+	changes, err = configurator.LoadEntityChanges(networkID1, &sequence)
+	assert.NoError(t, err)
+	assert.Equal(t, configurator.EntityChanges{Sequence: sequence + 1, NetworkChanged: true}, changes)
+
+	future := sequence + 100
+	_, err = configurator.LoadEntityChanges(networkID1, &future)
+	assert.Equal(t, configurator.ErrChangesUnavailable, err)
+}
+
+func receiveSequence(t *testing.T, sequences <-chan uint64) uint64 {
+	select {
+	case sequence := <-sequences:
+		return sequence
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the change sequence")
+		return 0
+	}
+}
@@ -14,9 +14,12 @@ and meta data for the network and network entity structures.
 package main
 
 import (
+	"time"
+
 	"magma/orc8r/cloud/go/datastore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/configurator/protos"
 	"magma/orc8r/cloud/go/services/configurator/servicers"
@@ -37,18 +40,22 @@ func main() {
 		glog.Fatalf("Failed to connect to database: %s", err)
 	}
 
-	factory := storage.NewSQLConfiguratorStorageFactory(db, &storage.DefaultIDGenerator{}, sqorc.GetSqlBuilder())
+	factory := storage.NewSQLConfiguratorStorageFactoryWithMaxRevisions(db, &storage.DefaultIDGenerator{}, sqorc.GetSqlBuilder(), getMaxConfigRevisions(srv.Config))
 	err = factory.InitializeServiceStorage()
 	if err != nil {
 		glog.Fatalf("Failed to initialize configurator database: %s", err)
 	}
 
-	nbServicer, err := servicers.NewNorthboundConfiguratorServicer(factory)
+	nbServicer, err := servicers.NewNorthboundConfiguratorServicerWithWatchInterval(factory, getACLPolicy(srv.Config), getWatchPollInterval(srv.Config))
 	if err != nil {
 		glog.Fatalf("Failed to instantiate the user-facing configurator servicer: %v", nbServicer)
 	}
 	protos.RegisterNorthboundConfiguratorServer(srv.GrpcServer, nbServicer)
 
+	if retention := getAuditRetention(srv.Config); retention > 0 {
+		go servicers.PruneAuditEventsPeriodically(factory, retention)
+	}
+
 	sbServicer, err := servicers.NewSouthboundConfiguratorServicer(factory)
 	if err != nil {
 		glog.Fatalf("Failed to instantiate the device-facing configurator servicer: %v", sbServicer)
@@ -60,3 +67,57 @@ func main() {
 		glog.Fatalf("Failed to start configurator service: %v", err)
 	}
 }
+
+// getMaxConfigRevisions returns the number of previous versions of configs to
+// retain, as set in the service config.
+func getMaxConfigRevisions(cfg *config.ConfigMap) int {
+	if cfg == nil {
+		return storage.DefaultMaxRevisions
+	}
+	maxRevisions, err := cfg.GetIntParam("max_config_revisions")
+	if err != nil {
+		return storage.DefaultMaxRevisions
+	}
+	return maxRevisions
+}
+
+// getWatchPollInterval returns how often to check watched networks for
+// changes, as set in the service config.
+func getWatchPollInterval(cfg *config.ConfigMap) time.Duration {
+	if cfg == nil {
+		return servicers.DefaultWatchPollInterval
+	}
+	intervalMillis, err := cfg.GetIntParam("watch_poll_interval_millis")
+	if err != nil || intervalMillis <= 0 {
+		return servicers.DefaultWatchPollInterval
+	}
+	return time.Duration(intervalMillis) * time.Millisecond
+}
+
+// getACLPolicy returns the entity ACL enforcement policy set in the service
+// config. ACLs aren't enforced unless the config enables them.
+func getACLPolicy(cfg *config.ConfigMap) servicers.ACLPolicy {
+	if cfg == nil {
+		return servicers.ACLPolicy{}
+	}
+	enforce, err := cfg.GetBoolParam("enforce_entity_acls")
+	if err != nil {
+		return servicers.ACLPolicy{}
+	}
+	// The admin operators are optional
+	admins, _ := cfg.GetStringArrayParam("acl_admin_operators")
+	return servicers.ACLPolicy{Enforce: enforce, AdminOperators: admins}
+}
+
+// getAuditRetention returns how long to retain audit events, as set in the
+// service config. Audit events are retained forever if it's not positive.
+func getAuditRetention(cfg *config.ConfigMap) time.Duration {
+	if cfg == nil {
+		return servicers.DefaultAuditRetention
+	}
+	retentionDays, err := cfg.GetIntParam("audit_retention_days")
+	if err != nil {
+		return servicers.DefaultAuditRetention
+	}
+	return time.Duration(retentionDays) * 24 * time.Hour
+}
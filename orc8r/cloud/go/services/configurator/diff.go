@@ -0,0 +1,180 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package configurator
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ConfigChangeAdd     = "add"
+	ConfigChangeRemove  = "remove"
+	ConfigChangeReplace = "replace"
+)
+
+// ConfigChange is a single difference between the JSON representations of
+// two configs
+type ConfigChange struct {
+	// Op is one of ConfigChangeAdd, ConfigChangeRemove, ConfigChangeReplace
+	Op string
+	// Path is a JSON pointer (RFC 6901) to the changed value
+	Path string
+	// From is the value before the change. nil for additions.
+	From interface{}
+	// To is the value after the change. nil for removals.
+	To interface{}
+}
+
+// DiffNetworkConfigs returns the changes between two sets of deserialized
+// network configs. Paths are rooted at the config type, e.g.
+// /orc8r_features/features/foo.
+func DiffNetworkConfigs(from map[string]interface{}, to map[string]interface{}) ([]ConfigChange, error) {
+	if from == nil {
+		from = map[string]interface{}{}
+	}
+	if to == nil {
+		to = map[string]interface{}{}
+	}
+	return diffConfigs(from, to)
+}
+
+// DiffEntityConfigs returns the changes between two deserialized entity
+// configs. A nil config is treated as JSON null.
+func DiffEntityConfigs(from interface{}, to interface{}) ([]ConfigChange, error) {
+	return diffConfigs(from, to)
+}
+
+// diffMconfigs returns the changes between the JSON representations of two
+// mconfigs. Paths are rooted at the mconfig key, e.g. /magmad/checkinInterval.
+// A nil mconfig is treated as one without any configs.
+func diffMconfigs(from *protos.GatewayConfigs, to *protos.GatewayConfigs) ([]ConfigChange, error) {
+	genericFrom, err := mconfigToGenericJSON(from)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize old mconfig")
+	}
+	genericTo, err := mconfigToGenericJSON(to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize new mconfig")
+	}
+	ret := []ConfigChange{}
+	diffJSON("", genericFrom, genericTo, &ret)
+	return ret, nil
+}
+
+func diffConfigs(from interface{}, to interface{}) ([]ConfigChange, error) {
+	genericFrom, err := toGenericJSON(from)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize old config")
+	}
+	genericTo, err := toGenericJSON(to)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to serialize new config")
+	}
+	ret := []ConfigChange{}
+	diffJSON("", genericFrom, genericTo, &ret)
+	return ret, nil
+}
+
+// toGenericJSON converts a config to its JSON representation in terms of
+// maps, slices and scalars
+func toGenericJSON(config interface{}) (interface{}, error) {
+	marshaled, err := json.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var ret interface{}
+	err = json.Unmarshal(marshaled, &ret)
+	return ret, err
+}
+
+// mconfigToGenericJSON converts the configs of an mconfig to their JSON
+// representation, keyed by mconfig key
+func mconfigToGenericJSON(mconfig *protos.GatewayConfigs) (map[string]interface{}, error) {
+	ret := map[string]interface{}{}
+	for key, config := range mconfig.GetConfigsByKey() {
+		marshaled, err := protos.MarshalMconfig(config)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal mconfig key %s", key)
+		}
+		var generic interface{}
+		err = json.Unmarshal(marshaled, &generic)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal mconfig key %s", key)
+		}
+		ret[key] = generic
+	}
+	return ret, nil
+}
+
+func diffJSON(path string, from interface{}, to interface{}, changes *[]ConfigChange) {
+	switch fromVal := from.(type) {
+	case map[string]interface{}:
+		toVal, ok := to.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(fromVal)+len(toVal))
+		for k := range fromVal {
+			keys = append(keys, k)
+		}
+		for k := range toVal {
+			if _, exists := fromVal[k]; !exists {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			childPath := path + "/" + escapePointerToken(k)
+			fromChild, inFrom := fromVal[k]
+			toChild, inTo := toVal[k]
+			switch {
+			case !inFrom:
+				*changes = append(*changes, ConfigChange{Op: ConfigChangeAdd, Path: childPath, To: toChild})
+			case !inTo:
+				*changes = append(*changes, ConfigChange{Op: ConfigChangeRemove, Path: childPath, From: fromChild})
+			default:
+				diffJSON(childPath, fromChild, toChild, changes)
+			}
+		}
+		return
+	case []interface{}:
+		toVal, ok := to.([]interface{})
+		if !ok {
+			break
+		}
+		for i := 0; i < len(fromVal) || i < len(toVal); i++ {
+			childPath := path + "/" + strconv.Itoa(i)
+			switch {
+			case i >= len(fromVal):
+				*changes = append(*changes, ConfigChange{Op: ConfigChangeAdd, Path: childPath, To: toVal[i]})
+			case i >= len(toVal):
+				*changes = append(*changes, ConfigChange{Op: ConfigChangeRemove, Path: childPath, From: fromVal[i]})
+			default:
+				diffJSON(childPath, fromVal[i], toVal[i], changes)
+			}
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*changes = append(*changes, ConfigChange{Op: ConfigChangeReplace, Path: path, From: from, To: to})
+	}
+}
+
+func escapePointerToken(token string) string {
+	return strings.Replace(strings.Replace(token, "~", "~0", -1), "/", "~1", -1)
+}
@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package configurator_test
+
+import (
+	"testing"
+
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type diffTestConfig struct {
+	Name    string            `json:"name"`
+	Servers []string          `json:"servers,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+}
+
+func TestDiffEntityConfigs(t *testing.T) {
+	from := &diffTestConfig{
+		Name:    "gw",
+		Servers: []string{"a", "b"},
+		Labels:  map[string]string{"a/b": "1", "c~d": "2"},
+	}
+	to := &diffTestConfig{
+		Name:    "gw",
+		Servers: []string{"c"},
+		Labels:  map[string]string{"a/b": "3", "e": "4"},
+	}
+	changes, err := configurator.DiffEntityConfigs(from, to)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]configurator.ConfigChange{
+			{Op: configurator.ConfigChangeReplace, Path: "/labels/a~1b", From: "1", To: "3"},
+			{Op: configurator.ConfigChangeRemove, Path: "/labels/c~0d", From: "2"},
+			{Op: configurator.ConfigChangeAdd, Path: "/labels/e", To: "4"},
+			{Op: configurator.ConfigChangeReplace, Path: "/servers/0", From: "a", To: "c"},
+			{Op: configurator.ConfigChangeRemove, Path: "/servers/1", From: "b"},
+		},
+		changes,
+	)
+
+	// Identical configs have no changes
+	changes, err = configurator.DiffEntityConfigs(from, from)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// Entities without a config are null
+	changes, err = configurator.DiffEntityConfigs(nil, &diffTestConfig{Name: "gw"})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]configurator.ConfigChange{
+			{Op: configurator.ConfigChangeReplace, Path: "", To: map[string]interface{}{"name": "gw"}},
+		},
+		changes,
+	)
+}
+
+func TestDiffNetworkConfigs(t *testing.T) {
+	changes, err := configurator.DiffNetworkConfigs(
+		map[string]interface{}{"foo": &diffTestConfig{Name: "foo"}, "bar": "bar"},
+		map[string]interface{}{"foo": &diffTestConfig{Name: "baz"}},
+	)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]configurator.ConfigChange{
+			{Op: configurator.ConfigChangeRemove, Path: "/bar", From: "bar"},
+			{Op: configurator.ConfigChangeReplace, Path: "/foo/name", From: "foo", To: "baz"},
+		},
+		changes,
+	)
+
+	changes, err = configurator.DiffNetworkConfigs(nil, map[string]interface{}{"bar": "bar"})
+	assert.NoError(t, err)
+	assert.Equal(t, []configurator.ConfigChange{{Op: configurator.ConfigChangeAdd, Path: "/bar", To: "bar"}}, changes)
+}
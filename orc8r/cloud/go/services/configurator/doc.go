@@ -21,4 +21,9 @@ const (
 	NetworkEntitySerdeDomain = "configurator_entity_configs"
 
 	GatewayEntityType = "gateway"
+
+	// OperatorEntityType is the type of the internal network entities which
+	// hold the ACLs of operators. The key of an operator entity is its
+	// operator ID.
+	OperatorEntityType = "operator"
 )
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -26,6 +27,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ImportNetworkRequest_ConflictPolicy int32
+
+const (
+	// Fail the import if the target network or any imported entity
+	// already exists
+	ImportNetworkRequest_FAIL ImportNetworkRequest_ConflictPolicy = 0
+	// Leave the existing network and entities untouched
+	ImportNetworkRequest_SKIP ImportNetworkRequest_ConflictPolicy = 1
+	// Replace the existing network and entities with the exported ones
+	ImportNetworkRequest_OVERWRITE ImportNetworkRequest_ConflictPolicy = 2
+)
+
+var ImportNetworkRequest_ConflictPolicy_name = map[int32]string{
+	0: "FAIL",
+	1: "SKIP",
+	2: "OVERWRITE",
+}
+
+var ImportNetworkRequest_ConflictPolicy_value = map[string]int32{
+	"FAIL":      0,
+	"SKIP":      1,
+	"OVERWRITE": 2,
+}
+
+func (x ImportNetworkRequest_ConflictPolicy) String() string {
+	return proto.EnumName(ImportNetworkRequest_ConflictPolicy_name, int32(x))
+}
+
+func (ImportNetworkRequest_ConflictPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{28, 0}
+}
+
 type ListNetworkIDsResponse struct {
 	NetworkIDs           []string `protobuf:"bytes,1,rep,name=networkIDs,proto3" json:"networkIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -113,10 +146,13 @@ func (m *LoadNetworksRequest) GetFilter() *storage.NetworkLoadFilter {
 }
 
 type CreateNetworksRequest struct {
-	Networks             []*storage.Network `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Networks []*storage.Network `protobuf:"bytes,1,rep,name=networks,proto3" json:"networks,omitempty"`
+	// If caller is set, the operator it identifies is recorded in the audit
+	// trail.
+	Caller               *protos.Identity `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *CreateNetworksRequest) Reset()         { *m = CreateNetworksRequest{} }
@@ -151,6 +187,13 @@ func (m *CreateNetworksRequest) GetNetworks() []*storage.Network {
 	return nil
 }
 
+func (m *CreateNetworksRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
 type CreateNetworksResponse struct {
 	CreatedNetworks      []*storage.Network `protobuf:"bytes,1,rep,name=created_networks,json=createdNetworks,proto3" json:"created_networks,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
@@ -191,10 +234,17 @@ func (m *CreateNetworksResponse) GetCreatedNetworks() []*storage.Network {
 }
 
 type UpdateNetworksRequest struct {
-	Updates              []*storage.NetworkUpdateCriteria `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+	Updates []*storage.NetworkUpdateCriteria `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates,omitempty"`
+	// If caller is set, the operator it identifies is recorded in the audit
+	// trail.
+	Caller *protos.Identity `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	// If dry_run is set, the updates are rolled back instead of committed and
+	// the response reports their impact on the mconfigs of the networks'
+	// gateways.
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *UpdateNetworksRequest) Reset()         { *m = UpdateNetworksRequest{} }
@@ -229,11 +279,28 @@ func (m *UpdateNetworksRequest) GetUpdates() []*storage.NetworkUpdateCriteria {
 	return nil
 }
 
+func (m *UpdateNetworksRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
+func (m *UpdateNetworksRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type DeleteNetworksRequest struct {
-	NetworkIDs           []string `protobuf:"bytes,1,rep,name=networkIDs,proto3" json:"networkIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	NetworkIDs []string `protobuf:"bytes,1,rep,name=networkIDs,proto3" json:"networkIDs,omitempty"`
+	// If caller is set, the operator it identifies is recorded in the audit
+	// trail.
+	Caller               *protos.Identity `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *DeleteNetworksRequest) Reset()         { *m = DeleteNetworksRequest{} }
@@ -268,13 +335,23 @@ func (m *DeleteNetworksRequest) GetNetworkIDs() []string {
 	return nil
 }
 
+func (m *DeleteNetworksRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
 type LoadEntitiesRequest struct {
-	NetworkID            string                      `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	Filter               *storage.EntityLoadFilter   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	Criteria             *storage.EntityLoadCriteria `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	NetworkID string                      `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Filter    *storage.EntityLoadFilter   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Criteria  *storage.EntityLoadCriteria `protobuf:"bytes,3,opt,name=criteria,proto3" json:"criteria,omitempty"`
+	// If caller is set, only entities which the caller has READ permission
+	// on are returned.
+	Caller               *protos.Identity `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *LoadEntitiesRequest) Reset()         { *m = LoadEntitiesRequest{} }
@@ -323,12 +400,27 @@ func (m *LoadEntitiesRequest) GetCriteria() *storage.EntityLoadCriteria {
 	return nil
 }
 
+func (m *LoadEntitiesRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
 type WriteEntitiesRequest struct {
-	NetworkID            string                `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	Writes               []*WriteEntityRequest `protobuf:"bytes,2,rep,name=writes,proto3" json:"writes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	NetworkID string                `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Writes    []*WriteEntityRequest `protobuf:"bytes,2,rep,name=writes,proto3" json:"writes,omitempty"`
+	// If caller is set, the request fails with PermissionDenied unless the
+	// caller has WRITE permission on every written and associated entity. The
+	// operator it identifies is recorded in the audit trail.
+	Caller *protos.Identity `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	// If dry_run is set, the writes are rolled back instead of committed and
+	// the response reports their impact on the mconfigs of the gateways
+	// whose entity graphs contain the written entities.
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *WriteEntitiesRequest) Reset()         { *m = WriteEntitiesRequest{} }
@@ -370,6 +462,20 @@ func (m *WriteEntitiesRequest) GetWrites() []*WriteEntityRequest {
 	return nil
 }
 
+func (m *WriteEntitiesRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
+func (m *WriteEntitiesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
 type WriteEntityRequest struct {
 	// Types that are valid to be assigned to Request:
 	//	*WriteEntityRequest_Create
@@ -451,11 +557,13 @@ func (*WriteEntityRequest) XXX_OneofWrappers() []interface{} {
 }
 
 type WriteEntitiesResponse struct {
-	CreatedEntities      []*storage.NetworkEntity          `protobuf:"bytes,1,rep,name=created_entities,json=createdEntities,proto3" json:"created_entities,omitempty"`
-	UpdatedEntities      map[string]*storage.NetworkEntity `protobuf:"bytes,2,rep,name=updated_entities,json=updatedEntities,proto3" json:"updated_entities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
-	XXX_unrecognized     []byte                            `json:"-"`
-	XXX_sizecache        int32                             `json:"-"`
+	CreatedEntities []*storage.NetworkEntity          `protobuf:"bytes,1,rep,name=created_entities,json=createdEntities,proto3" json:"created_entities,omitempty"`
+	UpdatedEntities map[string]*storage.NetworkEntity `protobuf:"bytes,2,rep,name=updated_entities,json=updatedEntities,proto3" json:"updated_entities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Only set for dry runs
+	MconfigImpact        *MconfigImpactReport `protobuf:"bytes,3,opt,name=mconfig_impact,json=mconfigImpact,proto3" json:"mconfig_impact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
 func (m *WriteEntitiesResponse) Reset()         { *m = WriteEntitiesResponse{} }
@@ -497,12 +605,23 @@ func (m *WriteEntitiesResponse) GetUpdatedEntities() map[string]*storage.Network
 	return nil
 }
 
+func (m *WriteEntitiesResponse) GetMconfigImpact() *MconfigImpactReport {
+	if m != nil {
+		return m.MconfigImpact
+	}
+	return nil
+}
+
 type CreateEntitiesRequest struct {
-	NetworkID            string                   `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	Entities             []*storage.NetworkEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	NetworkID string                   `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Entities  []*storage.NetworkEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	// If caller is set, the request fails with PermissionDenied unless the
+	// caller has WRITE permission on every created and associated entity. The
+	// operator it identifies is recorded in the audit trail.
+	Caller               *protos.Identity `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *CreateEntitiesRequest) Reset()         { *m = CreateEntitiesRequest{} }
@@ -544,6 +663,13 @@ func (m *CreateEntitiesRequest) GetEntities() []*storage.NetworkEntity {
 	return nil
 }
 
+func (m *CreateEntitiesRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
 type CreateEntitiesResponse struct {
 	CreatedEntities      []*storage.NetworkEntity `protobuf:"bytes,1,rep,name=created_entities,json=createdEntities,proto3" json:"created_entities,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
@@ -584,11 +710,14 @@ func (m *CreateEntitiesResponse) GetCreatedEntities() []*storage.NetworkEntity {
 }
 
 type UpdateEntitiesRequest struct {
-	NetworkID            string                          `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	Updates              []*storage.EntityUpdateCriteria `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
-	XXX_unrecognized     []byte                          `json:"-"`
-	XXX_sizecache        int32                           `json:"-"`
+	NetworkID string                          `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Updates   []*storage.EntityUpdateCriteria `protobuf:"bytes,2,rep,name=updates,proto3" json:"updates,omitempty"`
+	// If caller is set, the request fails with PermissionDenied unless the
+	// caller has WRITE permission on every updated and associated entity.
+	Caller               *protos.Identity `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *UpdateEntitiesRequest) Reset()         { *m = UpdateEntitiesRequest{} }
@@ -630,6 +759,13 @@ func (m *UpdateEntitiesRequest) GetUpdates() []*storage.EntityUpdateCriteria {
 	return nil
 }
 
+func (m *UpdateEntitiesRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
 type UpdateEntitiesResponse struct {
 	UpdatedEntities      map[string]*storage.NetworkEntity `protobuf:"bytes,1,rep,name=updated_entities,json=updatedEntities,proto3" json:"updated_entities,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}                          `json:"-"`
@@ -670,11 +806,14 @@ func (m *UpdateEntitiesResponse) GetUpdatedEntities() map[string]*storage.Networ
 }
 
 type DeleteEntitiesRequest struct {
-	NetworkID            string              `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
-	ID                   []*storage.EntityID `protobuf:"bytes,2,rep,name=ID,proto3" json:"ID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	NetworkID string              `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	ID        []*storage.EntityID `protobuf:"bytes,2,rep,name=ID,proto3" json:"ID,omitempty"`
+	// If caller is set, the request fails with PermissionDenied unless the
+	// caller has WRITE permission on every deleted entity.
+	Caller               *protos.Identity `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *DeleteEntitiesRequest) Reset()         { *m = DeleteEntitiesRequest{} }
@@ -716,224 +855,1477 @@ func (m *DeleteEntitiesRequest) GetID() []*storage.EntityID {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ListNetworkIDsResponse)(nil), "magma.orc8r.configurator.ListNetworkIDsResponse")
-	proto.RegisterType((*LoadNetworksRequest)(nil), "magma.orc8r.configurator.LoadNetworksRequest")
-	proto.RegisterType((*CreateNetworksRequest)(nil), "magma.orc8r.configurator.CreateNetworksRequest")
-	proto.RegisterType((*CreateNetworksResponse)(nil), "magma.orc8r.configurator.CreateNetworksResponse")
-	proto.RegisterType((*UpdateNetworksRequest)(nil), "magma.orc8r.configurator.UpdateNetworksRequest")
-	proto.RegisterType((*DeleteNetworksRequest)(nil), "magma.orc8r.configurator.DeleteNetworksRequest")
-	proto.RegisterType((*LoadEntitiesRequest)(nil), "magma.orc8r.configurator.LoadEntitiesRequest")
-	proto.RegisterType((*WriteEntitiesRequest)(nil), "magma.orc8r.configurator.WriteEntitiesRequest")
-	proto.RegisterType((*WriteEntityRequest)(nil), "magma.orc8r.configurator.WriteEntityRequest")
-	proto.RegisterType((*WriteEntitiesResponse)(nil), "magma.orc8r.configurator.WriteEntitiesResponse")
-	proto.RegisterMapType((map[string]*storage.NetworkEntity)(nil), "magma.orc8r.configurator.WriteEntitiesResponse.UpdatedEntitiesEntry")
-	proto.RegisterType((*CreateEntitiesRequest)(nil), "magma.orc8r.configurator.CreateEntitiesRequest")
-	proto.RegisterType((*CreateEntitiesResponse)(nil), "magma.orc8r.configurator.CreateEntitiesResponse")
-	proto.RegisterType((*UpdateEntitiesRequest)(nil), "magma.orc8r.configurator.UpdateEntitiesRequest")
-	proto.RegisterType((*UpdateEntitiesResponse)(nil), "magma.orc8r.configurator.UpdateEntitiesResponse")
-	proto.RegisterMapType((map[string]*storage.NetworkEntity)(nil), "magma.orc8r.configurator.UpdateEntitiesResponse.UpdatedEntitiesEntry")
-	proto.RegisterType((*DeleteEntitiesRequest)(nil), "magma.orc8r.configurator.DeleteEntitiesRequest")
+func (m *DeleteEntitiesRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("northbound.proto", fileDescriptor_90b042c70967f647) }
+type ListNetworkRevisionsRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// If caller is set, the request fails with PermissionDenied unless the
+	// caller has READ permission on the network.
+	Caller               *protos.Identity `protobuf:"bytes,2,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
 
-var fileDescriptor_90b042c70967f647 = []byte{
-	// 809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x53, 0x7d, 0x69, 0x72, 0xfb, 0xd1, 0x86, 0xa1, 0x89, 0xa2, 0x08, 0x41, 0x35, 0xab,
-	0x82, 0x20, 0xae, 0x52, 0xa0, 0x55, 0x57, 0xa8, 0x75, 0x10, 0xa1, 0x55, 0xd5, 0x5a, 0xd0, 0x4a,
-	0x5d, 0x80, 0x5c, 0x67, 0x1a, 0x4c, 0x13, 0x4f, 0x3a, 0x1e, 0xb7, 0x0a, 0x4b, 0x36, 0x3c, 0x11,
-	0x3b, 0x78, 0x08, 0x96, 0x3c, 0x0d, 0x28, 0x9e, 0xb1, 0x63, 0x3b, 0x4e, 0x62, 0x77, 0x81, 0x58,
-	0xc5, 0x9a, 0x99, 0x7b, 0xce, 0xfd, 0x39, 0x73, 0xe7, 0x06, 0xca, 0x36, 0x65, 0xfc, 0xe3, 0x39,
-	0x75, 0xed, 0x4e, 0x63, 0xc0, 0x28, 0xa7, 0xa8, 0xd6, 0x37, 0xba, 0x7d, 0xa3, 0x41, 0x99, 0xb9,
-	0xcd, 0x1a, 0x26, 0xb5, 0x2f, 0xac, 0xae, 0xcb, 0x0c, 0x4e, 0x59, 0xfd, 0xa1, 0xb7, 0xa3, 0x7a,
-	0x3b, 0xaa, 0x77, 0xd8, 0x51, 0x4d, 0xda, 0xef, 0x53, 0x5b, 0x98, 0xd6, 0x5f, 0x86, 0x0f, 0x98,
-	0x3d, 0xea, 0x76, 0xd4, 0x2e, 0x55, 0x1d, 0xc2, 0xae, 0x2d, 0x93, 0x8c, 0xce, 0x8e, 0xc1, 0x54,
-	0x87, 0x53, 0x66, 0x74, 0x89, 0xff, 0x2b, 0x10, 0xf0, 0x36, 0x54, 0x0f, 0x2c, 0x87, 0x1f, 0x12,
-	0x7e, 0x43, 0xd9, 0x65, 0x5b, 0x73, 0x74, 0xe2, 0x0c, 0xa8, 0xed, 0x10, 0xf4, 0x00, 0xc0, 0x0e,
-	0x56, 0x6b, 0xca, 0xda, 0xc2, 0x7a, 0x49, 0x0f, 0xad, 0xe0, 0x6f, 0x0a, 0xdc, 0x3b, 0xa0, 0x46,
-	0x47, 0x9a, 0x3a, 0x3a, 0xb9, 0x72, 0x89, 0xc3, 0xd1, 0x31, 0x14, 0x4d, 0x66, 0x71, 0xc2, 0x2c,
-	0xa3, 0x96, 0x5f, 0x53, 0xd6, 0x97, 0x9a, 0xcf, 0x1b, 0xd3, 0x22, 0x6c, 0xf8, 0xce, 0x48, 0x90,
-	0x11, 0xde, 0x9e, 0x34, 0xd6, 0x03, 0x18, 0xb4, 0x0f, 0x85, 0x0b, 0xab, 0xc7, 0x09, 0xab, 0x2d,
-	0x78, 0x80, 0x9b, 0x99, 0x00, 0x5f, 0x79, 0xa6, 0xba, 0x84, 0xc0, 0xef, 0xa1, 0xb2, 0xc7, 0x88,
-	0xc1, 0x49, 0xdc, 0xf1, 0x16, 0x14, 0x65, 0x78, 0x22, 0xdc, 0xa5, 0xe6, 0xa3, 0xd4, 0x3c, 0x7a,
-	0x60, 0x8a, 0x6d, 0xa8, 0xc6, 0xf1, 0x65, 0x46, 0xdf, 0x42, 0xd9, 0xf4, 0x76, 0x3a, 0x1f, 0x6e,
-	0x4f, 0xb4, 0x22, 0x21, 0x7c, 0x74, 0xfc, 0x09, 0x2a, 0xef, 0x06, 0x9d, 0x84, 0x78, 0x8e, 0x61,
-	0xd1, 0xf5, 0x36, 0x7c, 0x96, 0xad, 0xd4, 0x2c, 0x02, 0x30, 0xa8, 0x84, 0x8f, 0x83, 0xb7, 0xa0,
-	0xa2, 0x91, 0x1e, 0x99, 0xe4, 0x9a, 0x27, 0x96, 0x9f, 0x52, 0x2c, 0x2d, 0x9b, 0x5b, 0xdc, 0x22,
-	0x81, 0xdd, 0x7d, 0x28, 0x05, 0xa7, 0x6a, 0xca, 0x9a, 0xb2, 0x5e, 0xd2, 0xc7, 0x0b, 0xe8, 0x4d,
-	0x50, 0x77, 0x21, 0xa4, 0xe6, 0xfc, 0x00, 0x3c, 0x82, 0xe1, 0x64, 0xd9, 0xd1, 0x51, 0x48, 0x96,
-	0x42, 0x45, 0xcf, 0xb2, 0xa0, 0x4d, 0xaa, 0x12, 0x7f, 0x86, 0xd5, 0xd3, 0xd1, 0x77, 0xb6, 0x98,
-	0x34, 0x28, 0xdc, 0x8c, 0xac, 0x9c, 0x5a, 0xde, 0x2b, 0xca, 0x93, 0xe9, 0x5e, 0x8c, 0xd1, 0x87,
-	0x12, 0x5b, 0x97, 0xb6, 0xf8, 0xbb, 0x02, 0x68, 0x72, 0x1b, 0xb5, 0xa1, 0x20, 0xe4, 0xe1, 0xf1,
-	0x2e, 0x35, 0xd5, 0xd4, 0x15, 0x17, 0x38, 0xaf, 0x73, 0xba, 0x04, 0x40, 0x47, 0x50, 0x10, 0x55,
-	0x97, 0xb9, 0x7f, 0x91, 0x36, 0x5b, 0x51, 0xed, 0x8c, 0x10, 0x05, 0xce, 0x6e, 0x09, 0x16, 0x99,
-	0xf0, 0x13, 0xff, 0xca, 0x43, 0x25, 0x96, 0x3b, 0x79, 0x47, 0xce, 0xc6, 0x77, 0x84, 0xc8, 0x3d,
-	0xa9, 0xde, 0xac, 0xb1, 0x04, 0x37, 0xc5, 0xe7, 0x40, 0x14, 0xca, 0xc2, 0x95, 0x10, 0xb6, 0x28,
-	0x82, 0x96, 0xa6, 0x08, 0x21, 0x37, 0x1b, 0x22, 0xc8, 0x00, 0xba, 0x65, 0x73, 0x36, 0xd4, 0x57,
-	0xdc, 0xe8, 0x6a, 0xdd, 0x81, 0xd5, 0xa4, 0x83, 0xa8, 0x0c, 0x0b, 0x97, 0x64, 0x28, 0xb5, 0x31,
-	0xfa, 0x44, 0x2d, 0xf8, 0xef, 0xda, 0xe8, 0xb9, 0x7e, 0xb2, 0x33, 0xc7, 0x2a, 0xac, 0x77, 0xf2,
-	0xdb, 0x0a, 0xfe, 0xa2, 0xf8, 0x0d, 0x2e, 0x9b, 0x30, 0xf7, 0xa1, 0x18, 0xcb, 0x4a, 0x66, 0x2f,
-	0x02, 0x00, 0xcc, 0xfd, 0x26, 0xf8, 0x37, 0x0b, 0x8c, 0xbf, 0x2a, 0x7e, 0x2f, 0xcc, 0x16, 0xfa,
-	0xd1, 0xb8, 0x53, 0x8a, 0xc8, 0x6f, 0x29, 0xf6, 0x71, 0xa3, 0xfc, 0xad, 0x40, 0x35, 0xee, 0x89,
-	0x4c, 0xc0, 0x20, 0x41, 0x85, 0x22, 0x01, 0xad, 0xe9, 0xac, 0xc9, 0x58, 0xff, 0xb2, 0x0c, 0xaf,
-	0xfc, 0xa7, 0x22, 0x5b, 0x29, 0x76, 0x20, 0xdf, 0xd6, 0x64, 0x15, 0x1e, 0xa7, 0xad, 0x42, 0x5b,
-	0xd3, 0xf3, 0x6d, 0xad, 0xf9, 0xa3, 0x08, 0xd5, 0xc3, 0x60, 0xba, 0xda, 0x0b, 0x9d, 0x47, 0xa7,
-	0xb0, 0x1c, 0x1d, 0x73, 0xd0, 0xdd, 0x08, 0xf8, 0x09, 0xb5, 0x3a, 0xf5, 0x8d, 0xe9, 0x7c, 0xc9,
-	0x33, 0x12, 0xce, 0x21, 0x17, 0x96, 0xa3, 0xaf, 0x3d, 0x9a, 0x91, 0xb4, 0xc4, 0xb9, 0x63, 0x16,
-	0x6d, 0xf2, 0x20, 0x81, 0x73, 0xe8, 0x04, 0x96, 0xa3, 0x8f, 0xfe, 0x2c, 0xda, 0xc4, 0xf1, 0xa0,
-	0x3e, 0x99, 0x00, 0x81, 0x1b, 0x7d, 0xe0, 0x67, 0xe1, 0x26, 0x8e, 0x02, 0xc9, 0xb8, 0x1c, 0xfe,
-	0x0f, 0xcf, 0x8a, 0xe8, 0xe9, 0x8c, 0x54, 0x4f, 0xce, 0x94, 0xf5, 0x6c, 0x03, 0x9f, 0x4e, 0x1c,
-	0xb7, 0xc7, 0x71, 0x0e, 0x31, 0xb8, 0x13, 0x69, 0xdf, 0xa8, 0x91, 0xba, 0xcf, 0x0b, 0x5e, 0x35,
-	0xe3, 0xbb, 0x10, 0x16, 0x44, 0x40, 0x3a, 0x57, 0x10, 0x71, 0xd6, 0x8d, 0xf4, 0x06, 0x61, 0xda,
-	0x68, 0x8f, 0x98, 0x2f, 0x88, 0x0c, 0xb4, 0xc9, 0xed, 0x27, 0xac, 0x97, 0x34, 0xb4, 0x89, 0xfd,
-	0x20, 0x59, 0x2f, 0x8e, 0xd0, 0x4b, 0x80, 0x3a, 0x47, 0x2f, 0x71, 0xcc, 0x4c, 0x83, 0xa2, 0x2f,
-	0x97, 0xdd, 0xe2, 0x59, 0x41, 0xfc, 0xc9, 0x3a, 0x17, 0xbf, 0x9b, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xda, 0x6f, 0xc2, 0x9d, 0xad, 0x0d, 0x00, 0x00,
+func (m *ListNetworkRevisionsRequest) Reset()         { *m = ListNetworkRevisionsRequest{} }
+func (m *ListNetworkRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNetworkRevisionsRequest) ProtoMessage()    {}
+func (*ListNetworkRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{15}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *ListNetworkRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNetworkRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListNetworkRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNetworkRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListNetworkRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNetworkRevisionsRequest.Merge(m, src)
+}
+func (m *ListNetworkRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNetworkRevisionsRequest.Size(m)
+}
+func (m *ListNetworkRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNetworkRevisionsRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ListNetworkRevisionsRequest proto.InternalMessageInfo
 
-// NorthboundConfiguratorClient is the client API for NorthboundConfigurator service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type NorthboundConfiguratorClient interface {
-	// ListNetworkIDs fetches the list of networkIDs registered
-	ListNetworkIDs(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*ListNetworkIDsResponse, error)
-	// CreateNetworks registers the given list of Networks and returns the created Networks
-	CreateNetworks(ctx context.Context, in *CreateNetworksRequest, opts ...grpc.CallOption) (*CreateNetworksResponse, error)
-	// UpdateNetworks updates the given list of registered Networks
-	UpdateNetworks(ctx context.Context, in *UpdateNetworksRequest, opts ...grpc.CallOption) (*protos.Void, error)
-	// DeleteNetworks deletes the given list of registered Networks
-	DeleteNetworks(ctx context.Context, in *DeleteNetworksRequest, opts ...grpc.CallOption) (*protos.Void, error)
-	// LoadNetworks fetches the set of Networks specified by the request
-	LoadNetworks(ctx context.Context, in *LoadNetworksRequest, opts ...grpc.CallOption) (*storage.NetworkLoadResult, error)
-	// Perform multiple operations (create/update/delete) in a single
-	// transaction
-	WriteEntities(ctx context.Context, in *WriteEntitiesRequest, opts ...grpc.CallOption) (*WriteEntitiesResponse, error)
-	// CreateEntities registers the given list of Entities and returns the created Entities
-	CreateEntities(ctx context.Context, in *CreateEntitiesRequest, opts ...grpc.CallOption) (*CreateEntitiesResponse, error)
-	// UpdateEntities updates the given list of registered Entities and returns the updated Entities
-	UpdateEntities(ctx context.Context, in *UpdateEntitiesRequest, opts ...grpc.CallOption) (*UpdateEntitiesResponse, error)
-	// DeleteEntities deletes the set of Entities registered
-	DeleteEntities(ctx context.Context, in *DeleteEntitiesRequest, opts ...grpc.CallOption) (*protos.Void, error)
-	// LoadEntities fetches the set of Entities specified by the request
-	LoadEntities(ctx context.Context, in *LoadEntitiesRequest, opts ...grpc.CallOption) (*storage.EntityLoadResult, error)
+func (m *ListNetworkRevisionsRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
 }
 
-type northboundConfiguratorClient struct {
-	cc *grpc.ClientConn
+func (m *ListNetworkRevisionsRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
 }
 
-func NewNorthboundConfiguratorClient(cc *grpc.ClientConn) NorthboundConfiguratorClient {
-	return &northboundConfiguratorClient{cc}
+type ListNetworkRevisionsResponse struct {
+	// Revisions ordered by version. The last revision is the current version
+	// of the network.
+	Revisions            []*storage.NetworkRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (c *northboundConfiguratorClient) ListNetworkIDs(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*ListNetworkIDsResponse, error) {
-	out := new(ListNetworkIDsResponse)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/ListNetworkIDs", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListNetworkRevisionsResponse) Reset()         { *m = ListNetworkRevisionsResponse{} }
+func (m *ListNetworkRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNetworkRevisionsResponse) ProtoMessage()    {}
+func (*ListNetworkRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{16}
 }
 
-func (c *northboundConfiguratorClient) CreateNetworks(ctx context.Context, in *CreateNetworksRequest, opts ...grpc.CallOption) (*CreateNetworksResponse, error) {
-	out := new(CreateNetworksResponse)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/CreateNetworks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ListNetworkRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNetworkRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ListNetworkRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNetworkRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListNetworkRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNetworkRevisionsResponse.Merge(m, src)
+}
+func (m *ListNetworkRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListNetworkRevisionsResponse.Size(m)
+}
+func (m *ListNetworkRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNetworkRevisionsResponse.DiscardUnknown(m)
 }
 
-func (c *northboundConfiguratorClient) UpdateNetworks(ctx context.Context, in *UpdateNetworksRequest, opts ...grpc.CallOption) (*protos.Void, error) {
-	out := new(protos.Void)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/UpdateNetworks", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ListNetworkRevisionsResponse proto.InternalMessageInfo
+
+func (m *ListNetworkRevisionsResponse) GetRevisions() []*storage.NetworkRevision {
+	if m != nil {
+		return m.Revisions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *northboundConfiguratorClient) DeleteNetworks(ctx context.Context, in *DeleteNetworksRequest, opts ...grpc.CallOption) (*protos.Void, error) {
-	out := new(protos.Void)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/DeleteNetworks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RollbackNetworkRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Version   uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// If caller is set, the request fails with PermissionDenied unless the
+	// caller has WRITE permission on all entities of the network. The
+	// operator it identifies is recorded in the audit trail.
+	Caller               *protos.Identity `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (c *northboundConfiguratorClient) LoadNetworks(ctx context.Context, in *LoadNetworksRequest, opts ...grpc.CallOption) (*storage.NetworkLoadResult, error) {
-	out := new(storage.NetworkLoadResult)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/LoadNetworks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RollbackNetworkRequest) Reset()         { *m = RollbackNetworkRequest{} }
+func (m *RollbackNetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackNetworkRequest) ProtoMessage()    {}
+func (*RollbackNetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{17}
 }
 
-func (c *northboundConfiguratorClient) WriteEntities(ctx context.Context, in *WriteEntitiesRequest, opts ...grpc.CallOption) (*WriteEntitiesResponse, error) {
-	out := new(WriteEntitiesResponse)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/WriteEntities", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RollbackNetworkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackNetworkRequest.Unmarshal(m, b)
+}
+func (m *RollbackNetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackNetworkRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackNetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackNetworkRequest.Merge(m, src)
+}
+func (m *RollbackNetworkRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackNetworkRequest.Size(m)
+}
+func (m *RollbackNetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackNetworkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackNetworkRequest proto.InternalMessageInfo
+
+func (m *RollbackNetworkRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *northboundConfiguratorClient) CreateEntities(ctx context.Context, in *CreateEntitiesRequest, opts ...grpc.CallOption) (*CreateEntitiesResponse, error) {
-	out := new(CreateEntitiesResponse)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/CreateEntities", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RollbackNetworkRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return out, nil
+	return 0
 }
 
-func (c *northboundConfiguratorClient) UpdateEntities(ctx context.Context, in *UpdateEntitiesRequest, opts ...grpc.CallOption) (*UpdateEntitiesResponse, error) {
-	out := new(UpdateEntitiesResponse)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/UpdateEntities", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RollbackNetworkRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
 	}
-	return out, nil
+	return nil
 }
 
-func (c *northboundConfiguratorClient) DeleteEntities(ctx context.Context, in *DeleteEntitiesRequest, opts ...grpc.CallOption) (*protos.Void, error) {
-	out := new(protos.Void)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/DeleteEntities", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ListEntityRevisionsRequest struct {
+	NetworkID string            `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	ID        *storage.EntityID `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	// If caller is set, the request fails with NotFound unless the caller has
+	// READ permission on the entity.
+	Caller               *protos.Identity `protobuf:"bytes,3,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListEntityRevisionsRequest) Reset()         { *m = ListEntityRevisionsRequest{} }
+func (m *ListEntityRevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListEntityRevisionsRequest) ProtoMessage()    {}
+func (*ListEntityRevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{18}
+}
+
+func (m *ListEntityRevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntityRevisionsRequest.Unmarshal(m, b)
+}
+func (m *ListEntityRevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntityRevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListEntityRevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntityRevisionsRequest.Merge(m, src)
+}
+func (m *ListEntityRevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListEntityRevisionsRequest.Size(m)
+}
+func (m *ListEntityRevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntityRevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntityRevisionsRequest proto.InternalMessageInfo
+
+func (m *ListEntityRevisionsRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
 	}
-	return out, nil
+	return ""
 }
 
-func (c *northboundConfiguratorClient) LoadEntities(ctx context.Context, in *LoadEntitiesRequest, opts ...grpc.CallOption) (*storage.EntityLoadResult, error) {
-	out := new(storage.EntityLoadResult)
-	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/LoadEntities", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ListEntityRevisionsRequest) GetID() *storage.EntityID {
+	if m != nil {
+		return m.ID
 	}
-	return out, nil
+	return nil
 }
 
-// NorthboundConfiguratorServer is the server API for NorthboundConfigurator service.
+func (m *ListEntityRevisionsRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
+type ListEntityRevisionsResponse struct {
+	// Revisions ordered by version. The last revision is the current version
+	// of the entity.
+	Revisions            []*storage.EntityRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListEntityRevisionsResponse) Reset()         { *m = ListEntityRevisionsResponse{} }
+func (m *ListEntityRevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListEntityRevisionsResponse) ProtoMessage()    {}
+func (*ListEntityRevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{19}
+}
+
+func (m *ListEntityRevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListEntityRevisionsResponse.Unmarshal(m, b)
+}
+func (m *ListEntityRevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListEntityRevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListEntityRevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListEntityRevisionsResponse.Merge(m, src)
+}
+func (m *ListEntityRevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListEntityRevisionsResponse.Size(m)
+}
+func (m *ListEntityRevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListEntityRevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListEntityRevisionsResponse proto.InternalMessageInfo
+
+func (m *ListEntityRevisionsResponse) GetRevisions() []*storage.EntityRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type RollbackEntityRequest struct {
+	NetworkID string            `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	ID        *storage.EntityID `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Version   uint64            `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// If caller is set, the request fails with PermissionDenied unless the
+	// caller has WRITE permission on the entity.
+	Caller               *protos.Identity `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *RollbackEntityRequest) Reset()         { *m = RollbackEntityRequest{} }
+func (m *RollbackEntityRequest) String() string { return proto.CompactTextString(m) }
+func (*RollbackEntityRequest) ProtoMessage()    {}
+func (*RollbackEntityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{20}
+}
+
+func (m *RollbackEntityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RollbackEntityRequest.Unmarshal(m, b)
+}
+func (m *RollbackEntityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RollbackEntityRequest.Marshal(b, m, deterministic)
+}
+func (m *RollbackEntityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackEntityRequest.Merge(m, src)
+}
+func (m *RollbackEntityRequest) XXX_Size() int {
+	return xxx_messageInfo_RollbackEntityRequest.Size(m)
+}
+func (m *RollbackEntityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackEntityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackEntityRequest proto.InternalMessageInfo
+
+func (m *RollbackEntityRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *RollbackEntityRequest) GetID() *storage.EntityID {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *RollbackEntityRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RollbackEntityRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
+type ListAuditEventsRequest struct {
+	Filter               *storage.AuditEventFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListAuditEventsRequest) Reset()         { *m = ListAuditEventsRequest{} }
+func (m *ListAuditEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsRequest) ProtoMessage()    {}
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{21}
+}
+
+func (m *ListAuditEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsRequest.Merge(m, src)
+}
+func (m *ListAuditEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsRequest.Size(m)
+}
+func (m *ListAuditEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsRequest proto.InternalMessageInfo
+
+func (m *ListAuditEventsRequest) GetFilter() *storage.AuditEventFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+type ListAuditEventsResponse struct {
+	// Events ordered by timestamp, most recent first
+	Events               []*storage.AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListAuditEventsResponse) Reset()         { *m = ListAuditEventsResponse{} }
+func (m *ListAuditEventsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAuditEventsResponse) ProtoMessage()    {}
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{22}
+}
+
+func (m *ListAuditEventsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditEventsResponse.Unmarshal(m, b)
+}
+func (m *ListAuditEventsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditEventsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAuditEventsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditEventsResponse.Merge(m, src)
+}
+func (m *ListAuditEventsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAuditEventsResponse.Size(m)
+}
+func (m *ListAuditEventsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditEventsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditEventsResponse proto.InternalMessageInfo
+
+func (m *ListAuditEventsResponse) GetEvents() []*storage.AuditEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type UpdateNetworksResponse struct {
+	// Only set for dry runs
+	MconfigImpact        *MconfigImpactReport `protobuf:"bytes,1,opt,name=mconfig_impact,json=mconfigImpact,proto3" json:"mconfig_impact,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UpdateNetworksResponse) Reset()         { *m = UpdateNetworksResponse{} }
+func (m *UpdateNetworksResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateNetworksResponse) ProtoMessage()    {}
+func (*UpdateNetworksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{23}
+}
+
+func (m *UpdateNetworksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNetworksResponse.Unmarshal(m, b)
+}
+func (m *UpdateNetworksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNetworksResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateNetworksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNetworksResponse.Merge(m, src)
+}
+func (m *UpdateNetworksResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateNetworksResponse.Size(m)
+}
+func (m *UpdateNetworksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNetworksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateNetworksResponse proto.InternalMessageInfo
+
+func (m *UpdateNetworksResponse) GetMconfigImpact() *MconfigImpactReport {
+	if m != nil {
+		return m.MconfigImpact
+	}
+	return nil
+}
+
+// MconfigImpactReport describes how a dry-run write would change the mconfigs
+// of the gateways it affects.
+type MconfigImpactReport struct {
+	Gateways             []*GatewayMconfigImpact `protobuf:"bytes,1,rep,name=gateways,proto3" json:"gateways,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *MconfigImpactReport) Reset()         { *m = MconfigImpactReport{} }
+func (m *MconfigImpactReport) String() string { return proto.CompactTextString(m) }
+func (*MconfigImpactReport) ProtoMessage()    {}
+func (*MconfigImpactReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{24}
+}
+
+func (m *MconfigImpactReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MconfigImpactReport.Unmarshal(m, b)
+}
+func (m *MconfigImpactReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MconfigImpactReport.Marshal(b, m, deterministic)
+}
+func (m *MconfigImpactReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MconfigImpactReport.Merge(m, src)
+}
+func (m *MconfigImpactReport) XXX_Size() int {
+	return xxx_messageInfo_MconfigImpactReport.Size(m)
+}
+func (m *MconfigImpactReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MconfigImpactReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MconfigImpactReport proto.InternalMessageInfo
+
+func (m *MconfigImpactReport) GetGateways() []*GatewayMconfigImpact {
+	if m != nil {
+		return m.Gateways
+	}
+	return nil
+}
+
+// GatewayMconfigImpact holds the mconfig of a gateway before and after a
+// dry-run write. before is unset for gateways which the write creates, and
+// after is unset for gateways which it deletes.
+type GatewayMconfigImpact struct {
+	NetworkID            string                 `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	GatewayID            string                 `protobuf:"bytes,2,opt,name=gatewayID,proto3" json:"gatewayID,omitempty"`
+	Before               *protos.GatewayConfigs `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	After                *protos.GatewayConfigs `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GatewayMconfigImpact) Reset()         { *m = GatewayMconfigImpact{} }
+func (m *GatewayMconfigImpact) String() string { return proto.CompactTextString(m) }
+func (*GatewayMconfigImpact) ProtoMessage()    {}
+func (*GatewayMconfigImpact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{25}
+}
+
+func (m *GatewayMconfigImpact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GatewayMconfigImpact.Unmarshal(m, b)
+}
+func (m *GatewayMconfigImpact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GatewayMconfigImpact.Marshal(b, m, deterministic)
+}
+func (m *GatewayMconfigImpact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GatewayMconfigImpact.Merge(m, src)
+}
+func (m *GatewayMconfigImpact) XXX_Size() int {
+	return xxx_messageInfo_GatewayMconfigImpact.Size(m)
+}
+func (m *GatewayMconfigImpact) XXX_DiscardUnknown() {
+	xxx_messageInfo_GatewayMconfigImpact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GatewayMconfigImpact proto.InternalMessageInfo
+
+func (m *GatewayMconfigImpact) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *GatewayMconfigImpact) GetGatewayID() string {
+	if m != nil {
+		return m.GatewayID
+	}
+	return ""
+}
+
+func (m *GatewayMconfigImpact) GetBefore() *protos.GatewayConfigs {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *GatewayMconfigImpact) GetAfter() *protos.GatewayConfigs {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+type ExportNetworkRequest struct {
+	NetworkID            string   `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportNetworkRequest) Reset()         { *m = ExportNetworkRequest{} }
+func (m *ExportNetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportNetworkRequest) ProtoMessage()    {}
+func (*ExportNetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{26}
+}
+
+func (m *ExportNetworkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportNetworkRequest.Unmarshal(m, b)
+}
+func (m *ExportNetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportNetworkRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportNetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportNetworkRequest.Merge(m, src)
+}
+func (m *ExportNetworkRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportNetworkRequest.Size(m)
+}
+func (m *ExportNetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportNetworkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportNetworkRequest proto.InternalMessageInfo
+
+func (m *ExportNetworkRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+// NetworkExport holds everything configurator stores about a network. Configs
+// are kept serialized so that an export can be imported without the plugins
+// which registered their serdes.
+type NetworkExport struct {
+	Network *storage.Network `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	// Entities ordered by (type, key)
+	Entities             []*storage.NetworkEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *NetworkExport) Reset()         { *m = NetworkExport{} }
+func (m *NetworkExport) String() string { return proto.CompactTextString(m) }
+func (*NetworkExport) ProtoMessage()    {}
+func (*NetworkExport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{27}
+}
+
+func (m *NetworkExport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkExport.Unmarshal(m, b)
+}
+func (m *NetworkExport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkExport.Marshal(b, m, deterministic)
+}
+func (m *NetworkExport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkExport.Merge(m, src)
+}
+func (m *NetworkExport) XXX_Size() int {
+	return xxx_messageInfo_NetworkExport.Size(m)
+}
+func (m *NetworkExport) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkExport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkExport proto.InternalMessageInfo
+
+func (m *NetworkExport) GetNetwork() *storage.Network {
+	if m != nil {
+		return m.Network
+	}
+	return nil
+}
+
+func (m *NetworkExport) GetEntities() []*storage.NetworkEntity {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+type ImportNetworkRequest struct {
+	Export *NetworkExport `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	// Network to import into. Defaults to the ID of the exported network.
+	TargetNetworkID string `protobuf:"bytes,2,opt,name=target_networkID,json=targetNetworkID,proto3" json:"target_networkID,omitempty"`
+	// New keys for exported entities. Associations to remapped entities are
+	// remapped as well.
+	KeyRemaps []*EntityKeyRemap `protobuf:"bytes,3,rep,name=key_remaps,json=keyRemaps,proto3" json:"key_remaps,omitempty"`
+	// New physical IDs keyed by exported physical ID. Physical IDs are unique
+	// across networks, so they have to be remapped when cloning a network.
+	PhysicalIDRemaps map[string]string                   `protobuf:"bytes,4,rep,name=physicalID_remaps,json=physicalIDRemaps,proto3" json:"physicalID_remaps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ConflictPolicy   ImportNetworkRequest_ConflictPolicy `protobuf:"varint,5,opt,name=conflict_policy,json=conflictPolicy,proto3,enum=magma.orc8r.configurator.ImportNetworkRequest_ConflictPolicy" json:"conflict_policy,omitempty"`
+	// If caller is set, the request fails with PermissionDenied unless the
+	// caller has WRITE permission on every written and associated entity. The
+	// operator it identifies is recorded in the audit trail.
+	Caller               *protos.Identity `protobuf:"bytes,6,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ImportNetworkRequest) Reset()         { *m = ImportNetworkRequest{} }
+func (m *ImportNetworkRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportNetworkRequest) ProtoMessage()    {}
+func (*ImportNetworkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{28}
+}
+
+func (m *ImportNetworkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportNetworkRequest.Unmarshal(m, b)
+}
+func (m *ImportNetworkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportNetworkRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportNetworkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportNetworkRequest.Merge(m, src)
+}
+func (m *ImportNetworkRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportNetworkRequest.Size(m)
+}
+func (m *ImportNetworkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportNetworkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportNetworkRequest proto.InternalMessageInfo
+
+func (m *ImportNetworkRequest) GetExport() *NetworkExport {
+	if m != nil {
+		return m.Export
+	}
+	return nil
+}
+
+func (m *ImportNetworkRequest) GetTargetNetworkID() string {
+	if m != nil {
+		return m.TargetNetworkID
+	}
+	return ""
+}
+
+func (m *ImportNetworkRequest) GetKeyRemaps() []*EntityKeyRemap {
+	if m != nil {
+		return m.KeyRemaps
+	}
+	return nil
+}
+
+func (m *ImportNetworkRequest) GetPhysicalIDRemaps() map[string]string {
+	if m != nil {
+		return m.PhysicalIDRemaps
+	}
+	return nil
+}
+
+func (m *ImportNetworkRequest) GetConflictPolicy() ImportNetworkRequest_ConflictPolicy {
+	if m != nil {
+		return m.ConflictPolicy
+	}
+	return ImportNetworkRequest_FAIL
+}
+
+func (m *ImportNetworkRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
+type EntityKeyRemap struct {
+	From                 *storage.EntityID `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	ToKey                string            `protobuf:"bytes,2,opt,name=to_key,json=toKey,proto3" json:"to_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *EntityKeyRemap) Reset()         { *m = EntityKeyRemap{} }
+func (m *EntityKeyRemap) String() string { return proto.CompactTextString(m) }
+func (*EntityKeyRemap) ProtoMessage()    {}
+func (*EntityKeyRemap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{29}
+}
+
+func (m *EntityKeyRemap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EntityKeyRemap.Unmarshal(m, b)
+}
+func (m *EntityKeyRemap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EntityKeyRemap.Marshal(b, m, deterministic)
+}
+func (m *EntityKeyRemap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityKeyRemap.Merge(m, src)
+}
+func (m *EntityKeyRemap) XXX_Size() int {
+	return xxx_messageInfo_EntityKeyRemap.Size(m)
+}
+func (m *EntityKeyRemap) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityKeyRemap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityKeyRemap proto.InternalMessageInfo
+
+func (m *EntityKeyRemap) GetFrom() *storage.EntityID {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *EntityKeyRemap) GetToKey() string {
+	if m != nil {
+		return m.ToKey
+	}
+	return ""
+}
+
+type ImportNetworkResponse struct {
+	NetworkCreated bool `protobuf:"varint,1,opt,name=network_created,json=networkCreated,proto3" json:"network_created,omitempty"`
+	// IDs of imported entities after remapping
+	Created              []*storage.EntityID `protobuf:"bytes,2,rep,name=created,proto3" json:"created,omitempty"`
+	Overwritten          []*storage.EntityID `protobuf:"bytes,3,rep,name=overwritten,proto3" json:"overwritten,omitempty"`
+	Skipped              []*storage.EntityID `protobuf:"bytes,4,rep,name=skipped,proto3" json:"skipped,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ImportNetworkResponse) Reset()         { *m = ImportNetworkResponse{} }
+func (m *ImportNetworkResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportNetworkResponse) ProtoMessage()    {}
+func (*ImportNetworkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{30}
+}
+
+func (m *ImportNetworkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportNetworkResponse.Unmarshal(m, b)
+}
+func (m *ImportNetworkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportNetworkResponse.Marshal(b, m, deterministic)
+}
+func (m *ImportNetworkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportNetworkResponse.Merge(m, src)
+}
+func (m *ImportNetworkResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportNetworkResponse.Size(m)
+}
+func (m *ImportNetworkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportNetworkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportNetworkResponse proto.InternalMessageInfo
+
+func (m *ImportNetworkResponse) GetNetworkCreated() bool {
+	if m != nil {
+		return m.NetworkCreated
+	}
+	return false
+}
+
+func (m *ImportNetworkResponse) GetCreated() []*storage.EntityID {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ImportNetworkResponse) GetOverwritten() []*storage.EntityID {
+	if m != nil {
+		return m.Overwritten
+	}
+	return nil
+}
+
+func (m *ImportNetworkResponse) GetSkipped() []*storage.EntityID {
+	if m != nil {
+		return m.Skipped
+	}
+	return nil
+}
+
+type LoadEntityChangesRequest struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// Sequence number after which to load changes. If unset, only the
+	// current sequence number is returned.
+	Since                *wrappers.UInt64Value `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *LoadEntityChangesRequest) Reset()         { *m = LoadEntityChangesRequest{} }
+func (m *LoadEntityChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadEntityChangesRequest) ProtoMessage()    {}
+func (*LoadEntityChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{31}
+}
+
+func (m *LoadEntityChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadEntityChangesRequest.Unmarshal(m, b)
+}
+func (m *LoadEntityChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadEntityChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadEntityChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadEntityChangesRequest.Merge(m, src)
+}
+func (m *LoadEntityChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadEntityChangesRequest.Size(m)
+}
+func (m *LoadEntityChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadEntityChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadEntityChangesRequest proto.InternalMessageInfo
+
+func (m *LoadEntityChangesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *LoadEntityChangesRequest) GetSince() *wrappers.UInt64Value {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+type WatchEntityChangesRequest struct {
+	NetworkID            string   `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchEntityChangesRequest) Reset()         { *m = WatchEntityChangesRequest{} }
+func (m *WatchEntityChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchEntityChangesRequest) ProtoMessage()    {}
+func (*WatchEntityChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{32}
+}
+
+func (m *WatchEntityChangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEntityChangesRequest.Unmarshal(m, b)
+}
+func (m *WatchEntityChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEntityChangesRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchEntityChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEntityChangesRequest.Merge(m, src)
+}
+func (m *WatchEntityChangesRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchEntityChangesRequest.Size(m)
+}
+func (m *WatchEntityChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEntityChangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEntityChangesRequest proto.InternalMessageInfo
+
+func (m *WatchEntityChangesRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+type WatchEntityChangesResponse struct {
+	// Sequence number of the most recent change to the network
+	Sequence             uint64   `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchEntityChangesResponse) Reset()         { *m = WatchEntityChangesResponse{} }
+func (m *WatchEntityChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*WatchEntityChangesResponse) ProtoMessage()    {}
+func (*WatchEntityChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{33}
+}
+
+func (m *WatchEntityChangesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEntityChangesResponse.Unmarshal(m, b)
+}
+func (m *WatchEntityChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEntityChangesResponse.Marshal(b, m, deterministic)
+}
+func (m *WatchEntityChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEntityChangesResponse.Merge(m, src)
+}
+func (m *WatchEntityChangesResponse) XXX_Size() int {
+	return xxx_messageInfo_WatchEntityChangesResponse.Size(m)
+}
+func (m *WatchEntityChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEntityChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEntityChangesResponse proto.InternalMessageInfo
+
+func (m *WatchEntityChangesResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+type CheckEntityPermissionRequest struct {
+	NetworkID  string                 `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	ID         *storage.EntityID      `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Permission storage.ACL_Permission `protobuf:"varint,3,opt,name=permission,proto3,enum=magma.orc8r.configurator.storage.ACL_Permission" json:"permission,omitempty"`
+	// Callers which ACLs aren't enforced on, including an unset caller, have
+	// every permission.
+	Caller               *protos.Identity `protobuf:"bytes,4,opt,name=caller,proto3" json:"caller,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CheckEntityPermissionRequest) Reset()         { *m = CheckEntityPermissionRequest{} }
+func (m *CheckEntityPermissionRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckEntityPermissionRequest) ProtoMessage()    {}
+func (*CheckEntityPermissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_90b042c70967f647, []int{34}
+}
+
+func (m *CheckEntityPermissionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckEntityPermissionRequest.Unmarshal(m, b)
+}
+func (m *CheckEntityPermissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckEntityPermissionRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckEntityPermissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckEntityPermissionRequest.Merge(m, src)
+}
+func (m *CheckEntityPermissionRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckEntityPermissionRequest.Size(m)
+}
+func (m *CheckEntityPermissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckEntityPermissionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckEntityPermissionRequest proto.InternalMessageInfo
+
+func (m *CheckEntityPermissionRequest) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *CheckEntityPermissionRequest) GetID() *storage.EntityID {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *CheckEntityPermissionRequest) GetPermission() storage.ACL_Permission {
+	if m != nil {
+		return m.Permission
+	}
+	return storage.ACL_NO_PERM
+}
+
+func (m *CheckEntityPermissionRequest) GetCaller() *protos.Identity {
+	if m != nil {
+		return m.Caller
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("magma.orc8r.configurator.ImportNetworkRequest_ConflictPolicy", ImportNetworkRequest_ConflictPolicy_name, ImportNetworkRequest_ConflictPolicy_value)
+	proto.RegisterType((*ListNetworkIDsResponse)(nil), "magma.orc8r.configurator.ListNetworkIDsResponse")
+	proto.RegisterType((*LoadNetworksRequest)(nil), "magma.orc8r.configurator.LoadNetworksRequest")
+	proto.RegisterType((*CreateNetworksRequest)(nil), "magma.orc8r.configurator.CreateNetworksRequest")
+	proto.RegisterType((*CreateNetworksResponse)(nil), "magma.orc8r.configurator.CreateNetworksResponse")
+	proto.RegisterType((*UpdateNetworksRequest)(nil), "magma.orc8r.configurator.UpdateNetworksRequest")
+	proto.RegisterType((*DeleteNetworksRequest)(nil), "magma.orc8r.configurator.DeleteNetworksRequest")
+	proto.RegisterType((*LoadEntitiesRequest)(nil), "magma.orc8r.configurator.LoadEntitiesRequest")
+	proto.RegisterType((*WriteEntitiesRequest)(nil), "magma.orc8r.configurator.WriteEntitiesRequest")
+	proto.RegisterType((*WriteEntityRequest)(nil), "magma.orc8r.configurator.WriteEntityRequest")
+	proto.RegisterType((*WriteEntitiesResponse)(nil), "magma.orc8r.configurator.WriteEntitiesResponse")
+	proto.RegisterMapType((map[string]*storage.NetworkEntity)(nil), "magma.orc8r.configurator.WriteEntitiesResponse.UpdatedEntitiesEntry")
+	proto.RegisterType((*CreateEntitiesRequest)(nil), "magma.orc8r.configurator.CreateEntitiesRequest")
+	proto.RegisterType((*CreateEntitiesResponse)(nil), "magma.orc8r.configurator.CreateEntitiesResponse")
+	proto.RegisterType((*UpdateEntitiesRequest)(nil), "magma.orc8r.configurator.UpdateEntitiesRequest")
+	proto.RegisterType((*UpdateEntitiesResponse)(nil), "magma.orc8r.configurator.UpdateEntitiesResponse")
+	proto.RegisterMapType((map[string]*storage.NetworkEntity)(nil), "magma.orc8r.configurator.UpdateEntitiesResponse.UpdatedEntitiesEntry")
+	proto.RegisterType((*DeleteEntitiesRequest)(nil), "magma.orc8r.configurator.DeleteEntitiesRequest")
+	proto.RegisterType((*ListNetworkRevisionsRequest)(nil), "magma.orc8r.configurator.ListNetworkRevisionsRequest")
+	proto.RegisterType((*ListNetworkRevisionsResponse)(nil), "magma.orc8r.configurator.ListNetworkRevisionsResponse")
+	proto.RegisterType((*RollbackNetworkRequest)(nil), "magma.orc8r.configurator.RollbackNetworkRequest")
+	proto.RegisterType((*ListEntityRevisionsRequest)(nil), "magma.orc8r.configurator.ListEntityRevisionsRequest")
+	proto.RegisterType((*ListEntityRevisionsResponse)(nil), "magma.orc8r.configurator.ListEntityRevisionsResponse")
+	proto.RegisterType((*RollbackEntityRequest)(nil), "magma.orc8r.configurator.RollbackEntityRequest")
+	proto.RegisterType((*ListAuditEventsRequest)(nil), "magma.orc8r.configurator.ListAuditEventsRequest")
+	proto.RegisterType((*ListAuditEventsResponse)(nil), "magma.orc8r.configurator.ListAuditEventsResponse")
+	proto.RegisterType((*UpdateNetworksResponse)(nil), "magma.orc8r.configurator.UpdateNetworksResponse")
+	proto.RegisterType((*MconfigImpactReport)(nil), "magma.orc8r.configurator.MconfigImpactReport")
+	proto.RegisterType((*GatewayMconfigImpact)(nil), "magma.orc8r.configurator.GatewayMconfigImpact")
+	proto.RegisterType((*ExportNetworkRequest)(nil), "magma.orc8r.configurator.ExportNetworkRequest")
+	proto.RegisterType((*NetworkExport)(nil), "magma.orc8r.configurator.NetworkExport")
+	proto.RegisterType((*ImportNetworkRequest)(nil), "magma.orc8r.configurator.ImportNetworkRequest")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.configurator.ImportNetworkRequest.PhysicalIDRemapsEntry")
+	proto.RegisterType((*EntityKeyRemap)(nil), "magma.orc8r.configurator.EntityKeyRemap")
+	proto.RegisterType((*ImportNetworkResponse)(nil), "magma.orc8r.configurator.ImportNetworkResponse")
+	proto.RegisterType((*LoadEntityChangesRequest)(nil), "magma.orc8r.configurator.LoadEntityChangesRequest")
+	proto.RegisterType((*WatchEntityChangesRequest)(nil), "magma.orc8r.configurator.WatchEntityChangesRequest")
+	proto.RegisterType((*WatchEntityChangesResponse)(nil), "magma.orc8r.configurator.WatchEntityChangesResponse")
+	proto.RegisterType((*CheckEntityPermissionRequest)(nil), "magma.orc8r.configurator.CheckEntityPermissionRequest")
+}
+
+func init() { proto.RegisterFile("northbound.proto", fileDescriptor_90b042c70967f647) }
+
+var fileDescriptor_90b042c70967f647 = []byte{
+	// 1829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4f, 0x73, 0xdb, 0x40,
+	0x15, 0x8f, 0x6c, 0xc7, 0xb1, 0x5f, 0x88, 0xe3, 0x6c, 0xe3, 0xd4, 0xa8, 0x99, 0x12, 0x74, 0x69,
+	0xca, 0x50, 0x3b, 0x75, 0xd2, 0x12, 0x3a, 0xc3, 0x9f, 0xd6, 0x76, 0x8b, 0x9b, 0xd0, 0xba, 0x4b,
+	0x9b, 0x30, 0xbd, 0x78, 0x14, 0x79, 0xed, 0xa8, 0xb1, 0x25, 0x57, 0x92, 0x93, 0x9a, 0x0b, 0xcc,
+	0xc0, 0x0c, 0x27, 0xbe, 0x00, 0x27, 0x38, 0x70, 0x66, 0x60, 0x06, 0x0e, 0x0c, 0x27, 0xbe, 0x00,
+	0x9f, 0x81, 0x8f, 0xc1, 0x09, 0x46, 0xda, 0x95, 0x2c, 0xc9, 0x6b, 0x5b, 0x5b, 0x28, 0x9c, 0x62,
+	0xad, 0xf6, 0xfd, 0xde, 0xbf, 0xdf, 0xee, 0xbe, 0x7d, 0x0a, 0x14, 0x0d, 0xd3, 0x72, 0x2e, 0x2f,
+	0xcc, 0xb1, 0xd1, 0xad, 0x8c, 0x2c, 0xd3, 0x31, 0x51, 0x79, 0xa8, 0xf6, 0x87, 0x6a, 0xc5, 0xb4,
+	0xb4, 0x63, 0xab, 0xa2, 0x99, 0x46, 0x4f, 0xef, 0x8f, 0x2d, 0xd5, 0x31, 0x2d, 0xf9, 0x6b, 0xde,
+	0x9b, 0xaa, 0xf7, 0xa6, 0xea, 0x4d, 0xb6, 0xab, 0x9a, 0x39, 0x1c, 0x9a, 0x06, 0x15, 0x95, 0xbf,
+	0xce, 0x99, 0xa0, 0x77, 0x89, 0xe1, 0xe8, 0xce, 0x84, 0x4d, 0xf9, 0x7e, 0x78, 0x8a, 0x36, 0x30,
+	0xc7, 0xdd, 0x6a, 0xdf, 0xac, 0xda, 0xc4, 0xba, 0xd6, 0x35, 0x62, 0x57, 0xc3, 0xfa, 0xaa, 0xb6,
+	0x63, 0x5a, 0x6a, 0x9f, 0xf8, 0x7f, 0x19, 0xc2, 0x1e, 0x47, 0xc9, 0x90, 0xca, 0xb1, 0x19, 0x77,
+	0xfb, 0xa6, 0xd9, 0x1f, 0x10, 0xfa, 0xf2, 0x62, 0xdc, 0xab, 0xde, 0x58, 0xea, 0x68, 0x44, 0x2c,
+	0x9b, 0xbe, 0x57, 0x8e, 0x61, 0xe7, 0x54, 0xb7, 0x9d, 0x57, 0xc4, 0xb9, 0x31, 0xad, 0xab, 0x56,
+	0xc3, 0xc6, 0xc4, 0x1e, 0x99, 0x86, 0x4d, 0xd0, 0x5d, 0x00, 0x23, 0x18, 0x2d, 0x4b, 0x7b, 0xe9,
+	0xfd, 0x3c, 0x0e, 0x8d, 0x28, 0x7f, 0x92, 0xe0, 0xd6, 0xa9, 0xa9, 0x76, 0x99, 0xa8, 0x8d, 0xc9,
+	0xc7, 0x31, 0xb1, 0x1d, 0xf4, 0x06, 0x72, 0x9a, 0xa5, 0x3b, 0xc4, 0xd2, 0xd5, 0x72, 0x6a, 0x4f,
+	0xda, 0x5f, 0xaf, 0x3d, 0xaa, 0xcc, 0x0b, 0x63, 0xc5, 0x77, 0x87, 0x81, 0xb8, 0x78, 0x75, 0x26,
+	0x8c, 0x03, 0x18, 0x74, 0x02, 0xd9, 0x9e, 0x3e, 0x70, 0x88, 0x55, 0x4e, 0x7b, 0x80, 0x87, 0x42,
+	0x80, 0xcf, 0x3d, 0x51, 0xcc, 0x20, 0x94, 0x5f, 0x49, 0x50, 0xaa, 0x5b, 0x44, 0x75, 0x48, 0xdc,
+	0xf2, 0x26, 0xe4, 0x98, 0x7f, 0xd4, 0xdf, 0xf5, 0xda, 0xfd, 0xc4, 0x8a, 0x70, 0x20, 0x8a, 0x1e,
+	0x40, 0x56, 0x53, 0x07, 0x03, 0x62, 0x31, 0xf7, 0x4b, 0x11, 0x90, 0x16, 0xe3, 0x00, 0x66, 0x93,
+	0x14, 0x03, 0x76, 0xe2, 0xe6, 0xb0, 0x0c, 0xbc, 0x85, 0xa2, 0xe6, 0xbd, 0xe9, 0x76, 0x3e, 0xdf,
+	0xae, 0x4d, 0x06, 0xe1, 0xa3, 0x2b, 0x7f, 0x94, 0xa0, 0xf4, 0x6e, 0xd4, 0xe5, 0xf8, 0xff, 0x06,
+	0xd6, 0xc6, 0xde, 0x0b, 0x5f, 0xcd, 0xb7, 0x12, 0xab, 0xa1, 0x80, 0x41, 0xea, 0x7c, 0x1c, 0xc1,
+	0x58, 0xa0, 0xdb, 0xb0, 0xd6, 0xb5, 0x26, 0x1d, 0x6b, 0x6c, 0x78, 0x99, 0xce, 0xe1, 0x6c, 0xd7,
+	0x9a, 0xe0, 0xb1, 0xa1, 0xf4, 0xa0, 0xd4, 0x20, 0x03, 0x32, 0x6b, 0xf3, 0x12, 0x96, 0x8a, 0x26,
+	0xe3, 0x67, 0x29, 0x4a, 0xea, 0xa6, 0x3b, 0xac, 0x93, 0x40, 0xcd, 0x2e, 0xe4, 0x03, 0xd0, 0xb2,
+	0xb4, 0x27, 0xed, 0xe7, 0xf1, 0x74, 0x00, 0xbd, 0x0c, 0xf8, 0x49, 0x95, 0xd4, 0x96, 0xc7, 0xcd,
+	0x53, 0x30, 0x99, 0xa5, 0x27, 0x6a, 0x87, 0x96, 0x0f, 0x65, 0xfb, 0x91, 0x08, 0x1a, 0x67, 0xf5,
+	0x4c, 0x43, 0x90, 0x49, 0x12, 0x82, 0xbf, 0x49, 0xb0, 0x7d, 0xee, 0xca, 0x8a, 0xc5, 0xa0, 0x01,
+	0xd9, 0x1b, 0x57, 0xca, 0x2e, 0xa7, 0x3c, 0xee, 0x7c, 0x73, 0xbe, 0xd5, 0x53, 0xf4, 0x09, 0xc3,
+	0xc6, 0x4c, 0x36, 0x64, 0x6b, 0x5a, 0x90, 0x2f, 0x99, 0x08, 0x5f, 0xfe, 0x2a, 0x01, 0x9a, 0x55,
+	0x83, 0x5a, 0x90, 0xa5, 0xcb, 0xc1, 0xb3, 0x7f, 0xbd, 0x56, 0x4d, 0x4c, 0x70, 0x8a, 0xf3, 0x83,
+	0x15, 0xcc, 0x00, 0x50, 0x1b, 0xb2, 0x94, 0xe4, 0x2c, 0xe7, 0x8f, 0x93, 0x66, 0x29, 0xba, 0x54,
+	0x5c, 0x44, 0x8a, 0xf3, 0x2c, 0x0f, 0x6b, 0x16, 0xb5, 0x53, 0xf9, 0x7d, 0x1a, 0x4a, 0xb1, 0x1c,
+	0xb0, 0x3d, 0xe1, 0xfd, 0x74, 0x4f, 0x20, 0xec, 0x1d, 0x5b, 0xac, 0xa2, 0xbe, 0x04, 0x3b, 0x83,
+	0xaf, 0x03, 0x99, 0x50, 0xa4, 0xa6, 0x84, 0xb0, 0x69, 0x32, 0x1b, 0x49, 0x92, 0x19, 0x32, 0xb3,
+	0x42, 0x9d, 0x0c, 0xa0, 0x9b, 0x86, 0x63, 0x4d, 0xf0, 0xe6, 0x38, 0x3a, 0x8a, 0xde, 0x42, 0x81,
+	0x9d, 0x56, 0x1d, 0x7d, 0x38, 0x52, 0x35, 0x87, 0x65, 0xfd, 0xc1, 0x7c, 0x75, 0x3f, 0xa4, 0x4f,
+	0x2d, 0x6f, 0x3a, 0x26, 0x23, 0xd3, 0x72, 0xf0, 0xc6, 0x30, 0x3c, 0x28, 0xdb, 0xb0, 0xcd, 0x53,
+	0x8f, 0x8a, 0x90, 0xbe, 0x22, 0x13, 0xc6, 0x5c, 0xf7, 0x27, 0x6a, 0xc2, 0xea, 0xb5, 0x3a, 0x18,
+	0xfb, 0x29, 0x14, 0x8e, 0x20, 0x95, 0x7e, 0x92, 0x3a, 0x96, 0x94, 0x3f, 0x04, 0xa7, 0x8a, 0xd8,
+	0xb2, 0x39, 0x81, 0x5c, 0x2c, 0xd6, 0xc2, 0x56, 0x04, 0x00, 0x82, 0xab, 0x47, 0x71, 0xfc, 0x93,
+	0xe7, 0x7f, 0xc9, 0x32, 0xe5, 0xcf, 0xc1, 0xf9, 0x23, 0x16, 0xa9, 0xf6, 0xf4, 0x74, 0xa2, 0x81,
+	0xfa, 0xcc, 0x15, 0xc7, 0x3b, 0x9c, 0x12, 0x85, 0xeb, 0x5f, 0x12, 0xec, 0xc4, 0x0d, 0x67, 0xf1,
+	0x1a, 0x71, 0x56, 0x0e, 0x8d, 0x57, 0x73, 0xbe, 0x91, 0x7c, 0xac, 0x64, 0x4b, 0xe7, 0xff, 0x43,
+	0xf2, 0xdf, 0x48, 0xfe, 0x31, 0x2c, 0x96, 0xba, 0x27, 0x90, 0x6a, 0x35, 0x58, 0xd6, 0xbe, 0x91,
+	0x34, 0x6b, 0xad, 0x06, 0x4e, 0xb5, 0x1a, 0xa2, 0x49, 0xfa, 0x00, 0x77, 0x42, 0xf5, 0x2c, 0x26,
+	0xd7, 0xba, 0xad, 0x9b, 0x46, 0x42, 0x3b, 0x05, 0x8b, 0x05, 0x13, 0x76, 0xf9, 0xba, 0x18, 0x2b,
+	0x5e, 0x43, 0xde, 0xf2, 0x07, 0x19, 0x1d, 0x1e, 0x26, 0x2f, 0xdc, 0x98, 0x24, 0x9e, 0x62, 0x28,
+	0x3f, 0x85, 0x1d, 0x6c, 0x0e, 0x06, 0x17, 0xaa, 0x76, 0x15, 0xcc, 0x4a, 0xe2, 0x57, 0x19, 0xd6,
+	0xae, 0x89, 0xe5, 0x62, 0x78, 0x8e, 0x65, 0xb0, 0xff, 0x28, 0x1a, 0xdd, 0xdf, 0x49, 0x20, 0xbb,
+	0x2e, 0xfb, 0xa7, 0xaa, 0x50, 0x74, 0x7d, 0x16, 0x48, 0x5f, 0x9e, 0x05, 0x43, 0xca, 0x82, 0x19,
+	0x33, 0x59, 0x62, 0x5e, 0xcd, 0x26, 0xe6, 0x20, 0xa9, 0x41, 0xbc, 0xbc, 0xfc, 0x45, 0x82, 0x92,
+	0x9f, 0x98, 0x68, 0xc1, 0xf1, 0xe5, 0x22, 0x12, 0xca, 0x69, 0x7a, 0x5e, 0x4e, 0x13, 0xd5, 0x7b,
+	0x5d, 0x7a, 0x03, 0x7c, 0x3a, 0xee, 0xea, 0x4e, 0xf3, 0x9a, 0x18, 0x4e, 0x90, 0xce, 0x69, 0x59,
+	0x2b, 0x25, 0x2d, 0x6b, 0xa7, 0x28, 0xb1, 0x5b, 0x57, 0x07, 0x6e, 0xcf, 0x68, 0x61, 0xd9, 0x68,
+	0x40, 0x96, 0x78, 0x23, 0x65, 0x69, 0x59, 0xe5, 0x38, 0xab, 0x06, 0x33, 0x59, 0xf7, 0x1a, 0x15,
+	0xbf, 0xd5, 0x04, 0xd7, 0xa8, 0x78, 0x95, 0x21, 0xfd, 0xe7, 0x55, 0x86, 0xa2, 0xc2, 0x2d, 0xce,
+	0x2c, 0xf4, 0x12, 0x72, 0x7d, 0xd5, 0x21, 0x37, 0xea, 0xc4, 0x77, 0xa7, 0x32, 0x5f, 0xcd, 0x0b,
+	0x3a, 0x33, 0x8a, 0x13, 0xc8, 0xbb, 0xb4, 0xda, 0xe6, 0x4d, 0x59, 0xc2, 0xaa, 0x5d, 0xc8, 0x33,
+	0x08, 0x46, 0xae, 0x3c, 0x9e, 0x0e, 0xa0, 0x43, 0xc8, 0x5e, 0x90, 0x9e, 0x69, 0x11, 0xb6, 0x92,
+	0xee, 0x44, 0xcc, 0x63, 0xea, 0xea, 0x9e, 0x36, 0x1b, 0xb3, 0xa9, 0xe8, 0x21, 0xac, 0xaa, 0x3d,
+	0x27, 0x60, 0xd4, 0x42, 0x19, 0x3a, 0x53, 0x39, 0x82, 0xed, 0xe6, 0x27, 0x37, 0x24, 0x22, 0x3b,
+	0x95, 0xf2, 0x5b, 0x09, 0x36, 0xfc, 0xe3, 0xc7, 0x93, 0x46, 0x75, 0x58, 0x63, 0xaf, 0x59, 0xda,
+	0x04, 0xee, 0xbe, 0xbe, 0xe4, 0x7f, 0xb5, 0xca, 0x52, 0xfe, 0x9e, 0x81, 0xed, 0xd6, 0x90, 0xe3,
+	0xda, 0xf7, 0x20, 0x4b, 0x3c, 0xa3, 0x99, 0xa5, 0xf7, 0xe6, 0xeb, 0x88, 0xf8, 0x88, 0x99, 0x18,
+	0xba, 0x0f, 0x45, 0x47, 0xb5, 0xfa, 0xc4, 0xe9, 0x4c, 0x43, 0x44, 0x13, 0xb8, 0x49, 0xc7, 0x83,
+	0x36, 0x0d, 0x7a, 0x01, 0x70, 0x45, 0x26, 0x1d, 0x8b, 0x0c, 0xd5, 0x91, 0x5d, 0x4e, 0x7b, 0x3e,
+	0xed, 0xcf, 0xd7, 0x47, 0x9d, 0x38, 0x21, 0x13, 0xec, 0x0a, 0xe0, 0xfc, 0x15, 0xfb, 0x65, 0xa3,
+	0x8f, 0xb0, 0x35, 0xba, 0x9c, 0xd8, 0xba, 0xa6, 0x0e, 0x5a, 0x0d, 0x1f, 0x2f, 0xb3, 0xac, 0xea,
+	0xe7, 0xf9, 0x5f, 0x69, 0x07, 0x38, 0x14, 0x9b, 0x96, 0x2e, 0xc5, 0x51, 0x6c, 0x18, 0xf5, 0x60,
+	0xd3, 0x05, 0x1b, 0xe8, 0x9a, 0xd3, 0x19, 0x99, 0x03, 0x5d, 0x9b, 0x94, 0x57, 0xf7, 0xa4, 0xfd,
+	0x42, 0xed, 0x3b, 0x82, 0x0a, 0xeb, 0x0c, 0xa5, 0xed, 0x81, 0xe0, 0x82, 0x16, 0x79, 0x0e, 0x6d,
+	0x84, 0xd9, 0x04, 0x1b, 0xa1, 0x5c, 0x87, 0x12, 0xd7, 0x03, 0x4e, 0x4d, 0xb5, 0x1d, 0xae, 0xa9,
+	0xf2, 0xe1, 0x12, 0xe9, 0x10, 0x0a, 0x51, 0xab, 0x50, 0x0e, 0x32, 0xcf, 0x9f, 0xb6, 0x4e, 0x8b,
+	0x2b, 0xee, 0xaf, 0x1f, 0x9d, 0xb4, 0xda, 0x45, 0x09, 0x6d, 0x40, 0xfe, 0xf5, 0x59, 0x13, 0x9f,
+	0xe3, 0xd6, 0xdb, 0x66, 0x31, 0xa5, 0xf4, 0xa1, 0x10, 0x4d, 0x10, 0xfa, 0x2e, 0x64, 0x7a, 0x96,
+	0x39, 0x2c, 0x4b, 0xc2, 0x67, 0x83, 0x27, 0x87, 0x4a, 0x90, 0x75, 0xcc, 0x8e, 0x6b, 0x35, 0xb3,
+	0xd0, 0x31, 0x4f, 0xc8, 0x44, 0xf9, 0x75, 0x0a, 0x4a, 0xb1, 0x48, 0xb2, 0x4d, 0xf2, 0x1e, 0x6c,
+	0x32, 0xce, 0x75, 0x58, 0xc1, 0xee, 0xe9, 0xce, 0xe1, 0x02, 0x1b, 0xa6, 0x37, 0x85, 0x2e, 0x6a,
+	0xc0, 0x9a, 0x3f, 0x41, 0xbc, 0xa0, 0xf3, 0x45, 0xd1, 0x29, 0xac, 0x9b, 0xd7, 0xc4, 0x72, 0x6f,
+	0xfd, 0x0e, 0x31, 0xca, 0x69, 0x61, 0xa4, 0xb0, 0xb8, 0x6b, 0x93, 0x7d, 0xa5, 0x8f, 0x46, 0xa4,
+	0x5b, 0xce, 0x08, 0x23, 0xf9, 0xa2, 0xca, 0x00, 0xca, 0x41, 0xeb, 0x67, 0x52, 0xbf, 0x54, 0x8d,
+	0x7e, 0xd2, 0xfa, 0xb6, 0x06, 0xab, 0xb6, 0x6e, 0x68, 0x7e, 0x89, 0xbd, 0x5b, 0xa1, 0x4d, 0xd7,
+	0x8a, 0xdf, 0x74, 0xad, 0xbc, 0x6b, 0x19, 0xce, 0xe3, 0xa3, 0x33, 0x97, 0x25, 0x98, 0x4e, 0x55,
+	0xbe, 0x0d, 0x5f, 0x3d, 0x57, 0x1d, 0xed, 0x52, 0x5c, 0x9d, 0x72, 0x0c, 0x32, 0x4f, 0x94, 0x65,
+	0x52, 0x86, 0x9c, 0xed, 0xc2, 0xb8, 0xf6, 0x48, 0x5e, 0x65, 0x10, 0x3c, 0x2b, 0xff, 0x94, 0x60,
+	0xb7, 0x7e, 0x49, 0xfc, 0x2a, 0xa5, 0x4d, 0xac, 0xa1, 0x6e, 0x7b, 0xd5, 0xcc, 0x17, 0xaf, 0x57,
+	0xda, 0x00, 0xa3, 0x40, 0x9d, 0x77, 0xf6, 0x14, 0x92, 0x14, 0x5d, 0x4f, 0xeb, 0xa7, 0x95, 0x90,
+	0x99, 0x21, 0x0c, 0xc1, 0x3a, 0xa7, 0xf6, 0x8f, 0x2d, 0xd8, 0x79, 0x15, 0x34, 0xf8, 0xeb, 0x21,
+	0x65, 0xe8, 0x1c, 0x0a, 0xd1, 0x26, 0x38, 0xda, 0x8a, 0x60, 0x9d, 0x99, 0x7a, 0x57, 0x5e, 0x60,
+	0x2c, 0xbf, 0x83, 0xae, 0xac, 0xa0, 0x31, 0x14, 0xa2, 0xbd, 0x5d, 0xb4, 0xe0, 0xdc, 0xe1, 0x36,
+	0xa5, 0xe5, 0x83, 0xe4, 0x02, 0x61, 0xb5, 0xd1, 0x5a, 0x68, 0x91, 0x5a, 0x6e, 0x2f, 0x58, 0x3e,
+	0x48, 0x2e, 0x10, 0xa8, 0x3d, 0x83, 0x42, 0xb4, 0x49, 0xbb, 0x48, 0x2d, 0xb7, 0x9d, 0x2b, 0xcf,
+	0xc6, 0x5d, 0x59, 0x41, 0x0e, 0x7c, 0x25, 0xfc, 0xa1, 0x01, 0x2d, 0x28, 0xdc, 0x38, 0x1f, 0x24,
+	0x64, 0xb1, 0xaf, 0x05, 0x98, 0xd8, 0xe3, 0x81, 0xa3, 0xac, 0x20, 0x0b, 0x36, 0x22, 0xbd, 0x2d,
+	0x54, 0x49, 0xdc, 0x04, 0xa3, 0x7a, 0xab, 0x82, 0x4d, 0xb3, 0x30, 0x5f, 0x02, 0xa5, 0x4b, 0xf9,
+	0x12, 0xd7, 0x7a, 0x90, 0x5c, 0x60, 0x96, 0x2f, 0x49, 0xd4, 0x72, 0x7b, 0x37, 0xf2, 0x41, 0x72,
+	0x81, 0x59, 0xbe, 0x24, 0x51, 0xcb, 0xed, 0x3b, 0xf0, 0xf9, 0x62, 0x53, 0xbe, 0x04, 0xa8, 0x4b,
+	0xf8, 0x12, 0xc7, 0x14, 0xea, 0xde, 0x07, 0x74, 0xf9, 0xa5, 0x04, 0xdb, 0xbc, 0x6e, 0x00, 0x7a,
+	0x94, 0x68, 0xdf, 0x88, 0xdf, 0xa5, 0xe5, 0xc7, 0xa2, 0x62, 0x41, 0x58, 0x7f, 0x0c, 0x9b, 0xb1,
+	0x2e, 0x01, 0x5a, 0x90, 0x1d, 0x7e, 0x43, 0x81, 0x1f, 0xd8, 0x5f, 0xb8, 0x9f, 0xfc, 0x66, 0xef,
+	0xd5, 0xe8, 0x68, 0xb1, 0xad, 0xfc, 0x6e, 0x81, 0xfc, 0x48, 0x50, 0x2a, 0xcc, 0x9b, 0xe8, 0x6d,
+	0x7b, 0x11, 0x6f, 0xb8, 0xf7, 0x72, 0xbe, 0x7b, 0x9f, 0x60, 0x33, 0x76, 0x47, 0x45, 0x4b, 0x36,
+	0xfd, 0xd9, 0x4b, 0xb3, 0xfc, 0x50, 0x40, 0x22, 0xf0, 0xe8, 0x03, 0x6c, 0x44, 0x2e, 0x4b, 0x8b,
+	0xf6, 0x1a, 0xde, 0xad, 0x4a, 0x4e, 0x7a, 0xd5, 0xa0, 0xfb, 0x5a, 0x6b, 0x98, 0x50, 0x17, 0xaf,
+	0xea, 0x96, 0xab, 0x89, 0xe7, 0x07, 0xfe, 0xfd, 0x04, 0xb6, 0x66, 0x4a, 0x2b, 0x54, 0x4b, 0xb0,
+	0x2c, 0x63, 0x85, 0x91, 0x5c, 0x4d, 0xba, 0x36, 0x99, 0x9c, 0xb2, 0x82, 0x7e, 0xee, 0x7e, 0x0a,
+	0x9a, 0x29, 0x97, 0xd0, 0x82, 0x53, 0x61, 0x6e, 0x5d, 0x26, 0x1f, 0x89, 0x09, 0xf9, 0xfe, 0x1f,
+	0x48, 0xa8, 0x0b, 0x25, 0x6e, 0xe1, 0x85, 0x16, 0xac, 0xf3, 0x45, 0x95, 0x1a, 0x97, 0xc1, 0xcf,
+	0x72, 0xef, 0xb3, 0xf4, 0xbf, 0x00, 0x2e, 0xe8, 0xdf, 0xc3, 0x7f, 0x0f, 0x00, 0x71, 0x28, 0xfb,
+	0xf9, 0xd4, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NorthboundConfiguratorClient is the client API for NorthboundConfigurator service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NorthboundConfiguratorClient interface {
+	// ListNetworkIDs fetches the list of networkIDs registered
+	ListNetworkIDs(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*ListNetworkIDsResponse, error)
+	// CreateNetworks registers the given list of Networks and returns the created Networks
+	CreateNetworks(ctx context.Context, in *CreateNetworksRequest, opts ...grpc.CallOption) (*CreateNetworksResponse, error)
+	// UpdateNetworks updates the given list of registered Networks
+	UpdateNetworks(ctx context.Context, in *UpdateNetworksRequest, opts ...grpc.CallOption) (*UpdateNetworksResponse, error)
+	// DeleteNetworks deletes the given list of registered Networks
+	DeleteNetworks(ctx context.Context, in *DeleteNetworksRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// LoadNetworks fetches the set of Networks specified by the request
+	LoadNetworks(ctx context.Context, in *LoadNetworksRequest, opts ...grpc.CallOption) (*storage.NetworkLoadResult, error)
+	// Perform multiple operations (create/update/delete) in a single
+	// transaction
+	WriteEntities(ctx context.Context, in *WriteEntitiesRequest, opts ...grpc.CallOption) (*WriteEntitiesResponse, error)
+	// CreateEntities registers the given list of Entities and returns the created Entities
+	CreateEntities(ctx context.Context, in *CreateEntitiesRequest, opts ...grpc.CallOption) (*CreateEntitiesResponse, error)
+	// UpdateEntities updates the given list of registered Entities and returns the updated Entities
+	UpdateEntities(ctx context.Context, in *UpdateEntitiesRequest, opts ...grpc.CallOption) (*UpdateEntitiesResponse, error)
+	// DeleteEntities deletes the set of Entities registered
+	DeleteEntities(ctx context.Context, in *DeleteEntitiesRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// LoadEntities fetches the set of Entities specified by the request
+	LoadEntities(ctx context.Context, in *LoadEntitiesRequest, opts ...grpc.CallOption) (*storage.EntityLoadResult, error)
+	// ListNetworkRevisions fetches the retained versions of the configs of a
+	// network, ending with the current version
+	ListNetworkRevisions(ctx context.Context, in *ListNetworkRevisionsRequest, opts ...grpc.CallOption) (*ListNetworkRevisionsResponse, error)
+	// RollbackNetwork restores the configs of a network to a retained version
+	RollbackNetwork(ctx context.Context, in *RollbackNetworkRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// ListEntityRevisions fetches the retained versions of the config of an
+	// entity, ending with the current version
+	ListEntityRevisions(ctx context.Context, in *ListEntityRevisionsRequest, opts ...grpc.CallOption) (*ListEntityRevisionsResponse, error)
+	// RollbackEntity restores the config of an entity to a retained version
+	RollbackEntity(ctx context.Context, in *RollbackEntityRequest, opts ...grpc.CallOption) (*protos.Void, error)
+	// ListAuditEvents fetches the audit trail of changes made to a network
+	// and its entities, most recent first
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	// ExportNetwork fetches a network along with all of its entities, their
+	// associations and their labels
+	ExportNetwork(ctx context.Context, in *ExportNetworkRequest, opts ...grpc.CallOption) (*NetworkExport, error)
+	// ImportNetwork writes an exported network into the same or a different
+	// network in a single transaction
+	ImportNetwork(ctx context.Context, in *ImportNetworkRequest, opts ...grpc.CallOption) (*ImportNetworkResponse, error)
+	// LoadEntityChanges fetches the entities of a network which changed after
+	// a point of the network's change sequence
+	LoadEntityChanges(ctx context.Context, in *LoadEntityChangesRequest, opts ...grpc.CallOption) (*storage.EntityChanges, error)
+	// WatchEntityChanges streams the change sequence of a network to the
+	// client, first the current one and then each time it advances
+	WatchEntityChanges(ctx context.Context, in *WatchEntityChangesRequest, opts ...grpc.CallOption) (NorthboundConfigurator_WatchEntityChangesClient, error)
+	// CheckEntityPermission fails with PermissionDenied unless the caller
+	// has the requested permission on an entity, for operations on entities
+	// which other services carry out
+	CheckEntityPermission(ctx context.Context, in *CheckEntityPermissionRequest, opts ...grpc.CallOption) (*protos.Void, error)
+}
+
+type northboundConfiguratorClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNorthboundConfiguratorClient(cc *grpc.ClientConn) NorthboundConfiguratorClient {
+	return &northboundConfiguratorClient{cc}
+}
+
+func (c *northboundConfiguratorClient) ListNetworkIDs(ctx context.Context, in *protos.Void, opts ...grpc.CallOption) (*ListNetworkIDsResponse, error) {
+	out := new(ListNetworkIDsResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/ListNetworkIDs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) CreateNetworks(ctx context.Context, in *CreateNetworksRequest, opts ...grpc.CallOption) (*CreateNetworksResponse, error) {
+	out := new(CreateNetworksResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/CreateNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) UpdateNetworks(ctx context.Context, in *UpdateNetworksRequest, opts ...grpc.CallOption) (*UpdateNetworksResponse, error) {
+	out := new(UpdateNetworksResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/UpdateNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) DeleteNetworks(ctx context.Context, in *DeleteNetworksRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/DeleteNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) LoadNetworks(ctx context.Context, in *LoadNetworksRequest, opts ...grpc.CallOption) (*storage.NetworkLoadResult, error) {
+	out := new(storage.NetworkLoadResult)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/LoadNetworks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) WriteEntities(ctx context.Context, in *WriteEntitiesRequest, opts ...grpc.CallOption) (*WriteEntitiesResponse, error) {
+	out := new(WriteEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/WriteEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) CreateEntities(ctx context.Context, in *CreateEntitiesRequest, opts ...grpc.CallOption) (*CreateEntitiesResponse, error) {
+	out := new(CreateEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/CreateEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) UpdateEntities(ctx context.Context, in *UpdateEntitiesRequest, opts ...grpc.CallOption) (*UpdateEntitiesResponse, error) {
+	out := new(UpdateEntitiesResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/UpdateEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) DeleteEntities(ctx context.Context, in *DeleteEntitiesRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/DeleteEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) LoadEntities(ctx context.Context, in *LoadEntitiesRequest, opts ...grpc.CallOption) (*storage.EntityLoadResult, error) {
+	out := new(storage.EntityLoadResult)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/LoadEntities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) ListNetworkRevisions(ctx context.Context, in *ListNetworkRevisionsRequest, opts ...grpc.CallOption) (*ListNetworkRevisionsResponse, error) {
+	out := new(ListNetworkRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/ListNetworkRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) RollbackNetwork(ctx context.Context, in *RollbackNetworkRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/RollbackNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) ListEntityRevisions(ctx context.Context, in *ListEntityRevisionsRequest, opts ...grpc.CallOption) (*ListEntityRevisionsResponse, error) {
+	out := new(ListEntityRevisionsResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/ListEntityRevisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) RollbackEntity(ctx context.Context, in *RollbackEntityRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/RollbackEntity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) ExportNetwork(ctx context.Context, in *ExportNetworkRequest, opts ...grpc.CallOption) (*NetworkExport, error) {
+	out := new(NetworkExport)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/ExportNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) ImportNetwork(ctx context.Context, in *ImportNetworkRequest, opts ...grpc.CallOption) (*ImportNetworkResponse, error) {
+	out := new(ImportNetworkResponse)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/ImportNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) LoadEntityChanges(ctx context.Context, in *LoadEntityChangesRequest, opts ...grpc.CallOption) (*storage.EntityChanges, error) {
+	out := new(storage.EntityChanges)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/LoadEntityChanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *northboundConfiguratorClient) WatchEntityChanges(ctx context.Context, in *WatchEntityChangesRequest, opts ...grpc.CallOption) (NorthboundConfigurator_WatchEntityChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NorthboundConfigurator_serviceDesc.Streams[0], "/magma.orc8r.configurator.NorthboundConfigurator/WatchEntityChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &northboundConfiguratorWatchEntityChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NorthboundConfigurator_WatchEntityChangesClient interface {
+	Recv() (*WatchEntityChangesResponse, error)
+	grpc.ClientStream
+}
+
+type northboundConfiguratorWatchEntityChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *northboundConfiguratorWatchEntityChangesClient) Recv() (*WatchEntityChangesResponse, error) {
+	m := new(WatchEntityChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *northboundConfiguratorClient) CheckEntityPermission(ctx context.Context, in *CheckEntityPermissionRequest, opts ...grpc.CallOption) (*protos.Void, error) {
+	out := new(protos.Void)
+	err := c.cc.Invoke(ctx, "/magma.orc8r.configurator.NorthboundConfigurator/CheckEntityPermission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NorthboundConfiguratorServer is the server API for NorthboundConfigurator service.
 type NorthboundConfiguratorServer interface {
 	// ListNetworkIDs fetches the list of networkIDs registered
 	ListNetworkIDs(context.Context, *protos.Void) (*ListNetworkIDsResponse, error)
 	// CreateNetworks registers the given list of Networks and returns the created Networks
 	CreateNetworks(context.Context, *CreateNetworksRequest) (*CreateNetworksResponse, error)
 	// UpdateNetworks updates the given list of registered Networks
-	UpdateNetworks(context.Context, *UpdateNetworksRequest) (*protos.Void, error)
+	UpdateNetworks(context.Context, *UpdateNetworksRequest) (*UpdateNetworksResponse, error)
 	// DeleteNetworks deletes the given list of registered Networks
 	DeleteNetworks(context.Context, *DeleteNetworksRequest) (*protos.Void, error)
 	// LoadNetworks fetches the set of Networks specified by the request
@@ -949,6 +2341,35 @@ type NorthboundConfiguratorServer interface {
 	DeleteEntities(context.Context, *DeleteEntitiesRequest) (*protos.Void, error)
 	// LoadEntities fetches the set of Entities specified by the request
 	LoadEntities(context.Context, *LoadEntitiesRequest) (*storage.EntityLoadResult, error)
+	// ListNetworkRevisions fetches the retained versions of the configs of a
+	// network, ending with the current version
+	ListNetworkRevisions(context.Context, *ListNetworkRevisionsRequest) (*ListNetworkRevisionsResponse, error)
+	// RollbackNetwork restores the configs of a network to a retained version
+	RollbackNetwork(context.Context, *RollbackNetworkRequest) (*protos.Void, error)
+	// ListEntityRevisions fetches the retained versions of the config of an
+	// entity, ending with the current version
+	ListEntityRevisions(context.Context, *ListEntityRevisionsRequest) (*ListEntityRevisionsResponse, error)
+	// RollbackEntity restores the config of an entity to a retained version
+	RollbackEntity(context.Context, *RollbackEntityRequest) (*protos.Void, error)
+	// ListAuditEvents fetches the audit trail of changes made to a network
+	// and its entities, most recent first
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	// ExportNetwork fetches a network along with all of its entities, their
+	// associations and their labels
+	ExportNetwork(context.Context, *ExportNetworkRequest) (*NetworkExport, error)
+	// ImportNetwork writes an exported network into the same or a different
+	// network in a single transaction
+	ImportNetwork(context.Context, *ImportNetworkRequest) (*ImportNetworkResponse, error)
+	// LoadEntityChanges fetches the entities of a network which changed after
+	// a point of the network's change sequence
+	LoadEntityChanges(context.Context, *LoadEntityChangesRequest) (*storage.EntityChanges, error)
+	// WatchEntityChanges streams the change sequence of a network to the
+	// client, first the current one and then each time it advances
+	WatchEntityChanges(*WatchEntityChangesRequest, NorthboundConfigurator_WatchEntityChangesServer) error
+	// CheckEntityPermission fails with PermissionDenied unless the caller
+	// has the requested permission on an entity, for operations on entities
+	// which other services carry out
+	CheckEntityPermission(context.Context, *CheckEntityPermissionRequest) (*protos.Void, error)
 }
 
 // UnimplementedNorthboundConfiguratorServer can be embedded to have forward compatible implementations.
@@ -961,7 +2382,7 @@ func (*UnimplementedNorthboundConfiguratorServer) ListNetworkIDs(ctx context.Con
 func (*UnimplementedNorthboundConfiguratorServer) CreateNetworks(ctx context.Context, req *CreateNetworksRequest) (*CreateNetworksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateNetworks not implemented")
 }
-func (*UnimplementedNorthboundConfiguratorServer) UpdateNetworks(ctx context.Context, req *UpdateNetworksRequest) (*protos.Void, error) {
+func (*UnimplementedNorthboundConfiguratorServer) UpdateNetworks(ctx context.Context, req *UpdateNetworksRequest) (*UpdateNetworksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateNetworks not implemented")
 }
 func (*UnimplementedNorthboundConfiguratorServer) DeleteNetworks(ctx context.Context, req *DeleteNetworksRequest) (*protos.Void, error) {
@@ -985,6 +2406,37 @@ func (*UnimplementedNorthboundConfiguratorServer) DeleteEntities(ctx context.Con
 func (*UnimplementedNorthboundConfiguratorServer) LoadEntities(ctx context.Context, req *LoadEntitiesRequest) (*storage.EntityLoadResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadEntities not implemented")
 }
+func (*UnimplementedNorthboundConfiguratorServer) ListNetworkRevisions(ctx context.Context, req *ListNetworkRevisionsRequest) (*ListNetworkRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNetworkRevisions not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) RollbackNetwork(ctx context.Context, req *RollbackNetworkRequest) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackNetwork not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) ListEntityRevisions(ctx context.Context, req *ListEntityRevisionsRequest) (*ListEntityRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntityRevisions not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) RollbackEntity(ctx context.Context, req *RollbackEntityRequest) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackEntity not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) ListAuditEvents(ctx context.Context, req *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) ExportNetwork(ctx context.Context, req *ExportNetworkRequest) (*NetworkExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportNetwork not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) ImportNetwork(ctx context.Context, req *ImportNetworkRequest) (*ImportNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportNetwork not implemented")
+}
+
+func (*UnimplementedNorthboundConfiguratorServer) LoadEntityChanges(ctx context.Context, req *LoadEntityChangesRequest) (*storage.EntityChanges, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadEntityChanges not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) WatchEntityChanges(req *WatchEntityChangesRequest, srv NorthboundConfigurator_WatchEntityChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEntityChanges not implemented")
+}
+func (*UnimplementedNorthboundConfiguratorServer) CheckEntityPermission(ctx context.Context, req *CheckEntityPermissionRequest) (*protos.Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckEntityPermission not implemented")
+}
 
 func RegisterNorthboundConfiguratorServer(s *grpc.Server, srv NorthboundConfiguratorServer) {
 	s.RegisterService(&_NorthboundConfigurator_serviceDesc, srv)
@@ -1170,6 +2622,189 @@ func _NorthboundConfigurator_LoadEntities_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NorthboundConfigurator_ListNetworkRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNetworkRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).ListNetworkRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/ListNetworkRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).ListNetworkRevisions(ctx, req.(*ListNetworkRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_RollbackNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).RollbackNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/RollbackNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).RollbackNetwork(ctx, req.(*RollbackNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_ListEntityRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntityRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).ListEntityRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/ListEntityRevisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).ListEntityRevisions(ctx, req.(*ListEntityRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_RollbackEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).RollbackEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/RollbackEntity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).RollbackEntity(ctx, req.(*RollbackEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_ExportNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).ExportNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/ExportNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).ExportNetwork(ctx, req.(*ExportNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_ImportNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).ImportNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/ImportNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).ImportNetwork(ctx, req.(*ImportNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_LoadEntityChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadEntityChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).LoadEntityChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/LoadEntityChanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).LoadEntityChanges(ctx, req.(*LoadEntityChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NorthboundConfigurator_WatchEntityChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEntityChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NorthboundConfiguratorServer).WatchEntityChanges(m, &northboundConfiguratorWatchEntityChangesServer{stream})
+}
+
+type NorthboundConfigurator_WatchEntityChangesServer interface {
+	Send(*WatchEntityChangesResponse) error
+	grpc.ServerStream
+}
+
+type northboundConfiguratorWatchEntityChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *northboundConfiguratorWatchEntityChangesServer) Send(m *WatchEntityChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NorthboundConfigurator_CheckEntityPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckEntityPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NorthboundConfiguratorServer).CheckEntityPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/magma.orc8r.configurator.NorthboundConfigurator/CheckEntityPermission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NorthboundConfiguratorServer).CheckEntityPermission(ctx, req.(*CheckEntityPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NorthboundConfigurator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "magma.orc8r.configurator.NorthboundConfigurator",
 	HandlerType: (*NorthboundConfiguratorServer)(nil),
@@ -1214,7 +2849,49 @@ var _NorthboundConfigurator_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LoadEntities",
 			Handler:    _NorthboundConfigurator_LoadEntities_Handler,
 		},
+		{
+			MethodName: "ListNetworkRevisions",
+			Handler:    _NorthboundConfigurator_ListNetworkRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackNetwork",
+			Handler:    _NorthboundConfigurator_RollbackNetwork_Handler,
+		},
+		{
+			MethodName: "ListEntityRevisions",
+			Handler:    _NorthboundConfigurator_ListEntityRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackEntity",
+			Handler:    _NorthboundConfigurator_RollbackEntity_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _NorthboundConfigurator_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "ExportNetwork",
+			Handler:    _NorthboundConfigurator_ExportNetwork_Handler,
+		},
+		{
+			MethodName: "ImportNetwork",
+			Handler:    _NorthboundConfigurator_ImportNetwork_Handler,
+		},
+		{
+			MethodName: "LoadEntityChanges",
+			Handler:    _NorthboundConfigurator_LoadEntityChanges_Handler,
+		},
+		{
+			MethodName: "CheckEntityPermission",
+			Handler:    _NorthboundConfigurator_CheckEntityPermission_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEntityChanges",
+			Handler:       _NorthboundConfigurator_WatchEntityChanges_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "northbound.proto",
 }
@@ -0,0 +1,136 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+	"strings"
+
+	commonProtos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	orc8rStorage "magma/orc8r/cloud/go/storage"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ACLPolicy configures the enforcement of entity ACLs on the callers of
+// northbound requests. Requests which don't specify a caller are trusted
+// internal calls and are never checked.
+type ACLPolicy struct {
+	// Enforce enables ACL enforcement. Since callers must be registered as
+	// operator entities once enforcement is enabled, it's disabled by default.
+	Enforce bool
+	// AdminOperators are the IDs of operators which bypass ACL checks
+	AdminOperators []string
+}
+
+// appliesTo returns true if the caller's requests must be checked against
+// the caller's ACLs
+func (p ACLPolicy) appliesTo(caller *commonProtos.Identity) bool {
+	if !p.Enforce || caller == nil {
+		return false
+	}
+	for _, admin := range p.AdminOperators {
+		if caller.GetOperator() == admin {
+			return false
+		}
+	}
+	return true
+}
+
+// loadCallerACLs returns the ACLs of the caller. Callers are resolved to an
+// entity in the internal network, so only operators registered as an
+// OperatorEntityType entity are allowed.
+func loadCallerACLs(store storage.ConfiguratorStorage, caller *commonProtos.Identity) ([]*storage.ACL, error) {
+	operatorID := caller.GetOperator()
+	if operatorID == "" {
+		return nil, status.Errorf(codes.PermissionDenied, "caller %s is not an operator", caller.HashString())
+	}
+	loadResult, err := store.LoadEntities(
+		storage.InternalNetworkID,
+		storage.EntityLoadFilter{
+			IDs: []*storage.EntityID{{Type: configurator.OperatorEntityType, Key: operatorID}},
+		},
+		storage.EntityLoadCriteria{LoadPermissions: true},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to load permissions of operator %s: %v", operatorID, err)
+	}
+	if len(loadResult.Entities) == 0 {
+		return nil, status.Errorf(codes.PermissionDenied, "operator %s is not registered", operatorID)
+	}
+	return loadResult.Entities[0].Permissions, nil
+}
+
+// checkCallerWritePermissions returns a PermissionDenied error if the caller
+// doesn't have WRITE permission on every one of the entities.
+func checkCallerWritePermissions(store storage.ConfiguratorStorage, caller *commonProtos.Identity, networkID string, ids []*storage.EntityID) error {
+	acls, err := loadCallerACLs(store, caller)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if !storage.ACLsGrant(acls, networkID, id.Type, id.Key, storage.ACL_WRITE) {
+			return status.Errorf(codes.PermissionDenied, "caller does not have write permission on entity %s", id.ToTypeAndKey())
+		}
+	}
+	return nil
+}
+
+// checkCallerNetworkPermission returns a PermissionDenied error if the
+// caller doesn't have the permission on the network itself
+func checkCallerNetworkPermission(store storage.ConfiguratorStorage, caller *commonProtos.Identity, networkID string, perm storage.ACL_Permission) error {
+	acls, err := loadCallerACLs(store, caller)
+	if err != nil {
+		return err
+	}
+	if !storage.ACLsGrantNetwork(acls, networkID, perm) {
+		return status.Errorf(codes.PermissionDenied, "caller does not have %s permission on network %s", strings.ToLower(perm.String()), networkID)
+	}
+	return nil
+}
+
+func (srv *nbConfiguratorServicer) CheckEntityPermission(context context.Context, req *protos.CheckEntityPermissionRequest) (*commonProtos.Void, error) {
+	void := &commonProtos.Void{}
+	if req.ID == nil {
+		return void, status.Error(codes.InvalidArgument, "entity ID is required")
+	}
+	if !srv.aclPolicy.appliesTo(req.Caller) {
+		return void, nil
+	}
+
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return void, err
+	}
+	acls, err := loadCallerACLs(store, req.Caller)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	if !storage.ACLsGrant(acls, req.NetworkID, req.ID.Type, req.ID.Key, req.Permission) {
+		storage.RollbackLogOnError(store)
+		return void, status.Errorf(codes.PermissionDenied, "caller does not have %s permission on entity %s", strings.ToLower(req.Permission.String()), req.ID.ToTypeAndKey())
+	}
+	return void, store.Commit()
+}
+
+// filterReadableEntities returns the entities which the ACLs grant READ on
+func filterReadableEntities(acls []*storage.ACL, networkID string, entities []*storage.NetworkEntity) []*storage.NetworkEntity {
+	ret := make([]*storage.NetworkEntity, 0, len(entities))
+	for _, ent := range entities {
+		if storage.ACLsGrant(acls, networkID, ent.Type, ent.Key, storage.ACL_READ) {
+			ret = append(ret, ent)
+		}
+	}
+	return ret
+}
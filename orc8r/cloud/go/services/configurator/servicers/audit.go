@@ -0,0 +1,182 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	commonProtos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	orc8rStorage "magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultAuditRetention is how long audit events are retained
+	DefaultAuditRetention = 90 * 24 * time.Hour
+
+	// auditPruneInterval is how often audit events which fall out of the
+	// retention period are deleted
+	auditPruneInterval = time.Hour
+)
+
+// auditLog collects the audit events of a single northbound call. The events
+// are written in the same transaction as the changes they record.
+type auditLog struct {
+	networkID string
+	operator  string
+	timestamp int64
+	events    []*storage.AuditEvent
+}
+
+func newAuditLog(networkID string, caller *commonProtos.Identity) *auditLog {
+	return &auditLog{networkID: networkID, operator: getAuditOperator(caller), timestamp: clock.Now().Unix()}
+}
+
+// getAuditOperator returns the name under which the caller's changes are
+// recorded. Callers which aren't operators are recorded by their identity.
+func getAuditOperator(caller *commonProtos.Identity) string {
+	if caller == nil {
+		return ""
+	}
+	if operatorID := caller.GetOperator(); operatorID != "" {
+		return operatorID
+	}
+	return caller.HashString()
+}
+
+func (l *auditLog) addNetworkEvent(networkID string, action string, beforeDigest string, afterDigest string) {
+	l.events = append(l.events, &storage.AuditEvent{
+		NetworkID:    networkID,
+		Action:       action,
+		Operator:     l.operator,
+		Timestamp:    l.timestamp,
+		BeforeDigest: beforeDigest,
+		AfterDigest:  afterDigest,
+	})
+}
+
+func (l *auditLog) addEntityEvent(id *storage.EntityID, action string, beforeDigest string, afterDigest string) {
+	l.events = append(l.events, &storage.AuditEvent{
+		NetworkID:    l.networkID,
+		Entity:       &storage.EntityID{Type: id.Type, Key: id.Key},
+		Action:       action,
+		Operator:     l.operator,
+		Timestamp:    l.timestamp,
+		BeforeDigest: beforeDigest,
+		AfterDigest:  afterDigest,
+	})
+}
+
+func (l *auditLog) write(store storage.ConfiguratorStorage) error {
+	return store.CreateAuditEvents(l.events)
+}
+
+// loadNetworkDigests returns the digests of the configs of the networks which
+// exist, keyed by network ID.
+func loadNetworkDigests(store storage.ConfiguratorStorage, networkIDs []string) (map[string]string, error) {
+	loadResult, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: networkIDs}, storage.NetworkLoadCriteria{LoadConfigs: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load networks for audit")
+	}
+	ret := make(map[string]string, len(loadResult.Networks))
+	for _, network := range loadResult.Networks {
+		ret[network.ID] = digestNetworkConfigs(network.Configs)
+	}
+	return ret, nil
+}
+
+// loadEntityDigests returns the digests of the configs of the entities which
+// exist, keyed by type and key.
+func loadEntityDigests(store storage.ConfiguratorStorage, networkID string, ids []*storage.EntityID) (map[orc8rStorage.TypeAndKey]string, error) {
+	ret := map[orc8rStorage.TypeAndKey]string{}
+	if len(ids) == 0 {
+		return ret, nil
+	}
+	loadResult, err := store.LoadEntities(networkID, storage.EntityLoadFilter{IDs: ids}, storage.EntityLoadCriteria{LoadConfig: true})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load entities for audit")
+	}
+	for _, ent := range loadResult.Entities {
+		ret[ent.GetTypeAndKey()] = digestConfig(ent.Config)
+	}
+	return ret, nil
+}
+
+// digestNetworkConfigs returns a digest of a set of network configs which is
+// independent of map ordering, or an empty string if there are no configs.
+func digestNetworkConfigs(configs map[string][]byte) string {
+	if len(configs) == 0 {
+		return ""
+	}
+	types := make([]string, 0, len(configs))
+	for configType := range configs {
+		types = append(types, configType)
+	}
+	sort.Strings(types)
+
+	// Length-prefix each type and value so that different sets of configs
+	// can't serialize to the same bytes
+	h := sha256.New()
+	lenBuf := make([]byte, 8)
+	for _, configType := range types {
+		for _, b := range [][]byte{[]byte(configType), configs[configType]} {
+			binary.BigEndian.PutUint64(lenBuf, uint64(len(b)))
+			h.Write(lenBuf)
+			h.Write(b)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// digestConfig returns a digest of a serialized entity config, or an empty
+// string if there is no config.
+func digestConfig(config []byte) string {
+	if len(config) == 0 {
+		return ""
+	}
+	sum := sha256.Sum256(config)
+	return hex.EncodeToString(sum[:])
+}
+
+// PruneAuditEvents deletes the audit events of all networks which were
+// recorded more than retention ago.
+func PruneAuditEvents(factory storage.ConfiguratorStorageFactory, retention time.Duration) error {
+	store, err := factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: false})
+	if err != nil {
+		return err
+	}
+	deleted, err := store.DeleteAuditEventsBefore(clock.Now().Add(-retention).Unix())
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return err
+	}
+	if deleted > 0 {
+		glog.Infof("Pruned %d audit events older than %s", deleted, retention)
+	}
+	return store.Commit()
+}
+
+// PruneAuditEventsPeriodically prunes the audit events which fall out of the
+// retention period once per hour. It never returns.
+func PruneAuditEventsPeriodically(factory storage.ConfiguratorStorageFactory, retention time.Duration) {
+	for range time.Tick(auditPruneInterval) {
+		if err := PruneAuditEvents(factory, retention); err != nil {
+			glog.Errorf("Failed to prune audit events: %v", err)
+		}
+	}
+}
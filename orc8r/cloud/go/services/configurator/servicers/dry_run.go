@@ -0,0 +1,240 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"sort"
+
+	"magma/orc8r/cloud/go/orc8r"
+	commonProtos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+)
+
+type networkGateway struct {
+	networkID string
+	gatewayID string
+}
+
+// mconfigPreview compares the mconfigs of a set of gateways before and after
+// a dry-run write. Gateways have to be added to the preview before the write
+// is applied so that their current mconfigs can be built, and the report is
+// built after the write, in the same transaction.
+type mconfigPreview struct {
+	gateways []networkGateway
+	before   map[networkGateway]*commonProtos.GatewayConfigs
+}
+
+func newMconfigPreview() *mconfigPreview {
+	return &mconfigPreview{before: map[networkGateway]*commonProtos.GatewayConfigs{}}
+}
+
+// addNetworkGateways adds every gateway of the networks to the preview
+func (p *mconfigPreview) addNetworkGateways(store storage.ConfiguratorStorage, networkIDs []string) error {
+	for _, networkID := range networkIDs {
+		loadResult, err := store.LoadEntities(
+			networkID,
+			storage.EntityLoadFilter{TypeFilter: &wrappers.StringValue{Value: orc8r.MagmadGatewayType}},
+			storage.EntityLoadCriteria{},
+		)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load gateways of network %s", networkID)
+		}
+		gatewayIDs := make([]string, 0, len(loadResult.Entities))
+		for _, ent := range loadResult.Entities {
+			gatewayIDs = append(gatewayIDs, ent.Key)
+		}
+		err = p.addGateways(store, networkID, gatewayIDs)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addEntityGateways adds the gateways whose entity graphs contain any of the
+// entities to the preview
+func (p *mconfigPreview) addEntityGateways(store storage.ConfiguratorStorage, networkID string, ids []*storage.EntityID) error {
+	gatewayIDs, err := loadGraphGatewayIDs(store, networkID, ids)
+	if err != nil {
+		return err
+	}
+	return p.addGateways(store, networkID, gatewayIDs)
+}
+
+// addCreatedGateways adds the gateways whose entity graphs contain any of the
+// written entities and which weren't added to the preview before the write.
+// As long as the entities the write associates with were added before the
+// write, the only such gateways are ones which the write created.
+func (p *mconfigPreview) addCreatedGateways(store storage.ConfiguratorStorage, networkID string, ids []*storage.EntityID) error {
+	gatewayIDs, err := loadGraphGatewayIDs(store, networkID, ids)
+	if err != nil {
+		return err
+	}
+	for _, gatewayID := range gatewayIDs {
+		gw := networkGateway{networkID: networkID, gatewayID: gatewayID}
+		if _, exists := p.before[gw]; !exists {
+			p.gateways = append(p.gateways, gw)
+			p.before[gw] = nil
+		}
+	}
+	return nil
+}
+
+func (p *mconfigPreview) addGateways(store storage.ConfiguratorStorage, networkID string, gatewayIDs []string) error {
+	newGatewayIDs := make([]string, 0, len(gatewayIDs))
+	for _, gatewayID := range gatewayIDs {
+		if _, exists := p.before[networkGateway{networkID: networkID, gatewayID: gatewayID}]; !exists {
+			newGatewayIDs = append(newGatewayIDs, gatewayID)
+		}
+	}
+	mconfigs, err := buildMconfigs(store, networkID, newGatewayIDs)
+	if err != nil {
+		return err
+	}
+	for _, gatewayID := range newGatewayIDs {
+		gw := networkGateway{networkID: networkID, gatewayID: gatewayID}
+		p.gateways = append(p.gateways, gw)
+		p.before[gw] = mconfigs[gatewayID]
+	}
+	return nil
+}
+
+// report builds the current mconfigs of the preview's gateways and returns
+// them alongside the mconfigs from before the write, ordered by network and
+// gateway ID.
+func (p *mconfigPreview) report(store storage.ConfiguratorStorage) (*protos.MconfigImpactReport, error) {
+	gatewayIDsByNetwork := map[string][]string{}
+	for _, gw := range p.gateways {
+		gatewayIDsByNetwork[gw.networkID] = append(gatewayIDsByNetwork[gw.networkID], gw.gatewayID)
+	}
+	afterMconfigs := map[networkGateway]*commonProtos.GatewayConfigs{}
+	for networkID, gatewayIDs := range gatewayIDsByNetwork {
+		mconfigs, err := buildMconfigs(store, networkID, gatewayIDs)
+		if err != nil {
+			return nil, err
+		}
+		for gatewayID, mconfig := range mconfigs {
+			afterMconfigs[networkGateway{networkID: networkID, gatewayID: gatewayID}] = mconfig
+		}
+	}
+
+	sort.Slice(p.gateways, func(i, j int) bool {
+		if p.gateways[i].networkID != p.gateways[j].networkID {
+			return p.gateways[i].networkID < p.gateways[j].networkID
+		}
+		return p.gateways[i].gatewayID < p.gateways[j].gatewayID
+	})
+	ret := &protos.MconfigImpactReport{Gateways: []*protos.GatewayMconfigImpact{}}
+	for _, gw := range p.gateways {
+		before, after := p.before[gw], afterMconfigs[gw]
+		// Gateways which the write both created and deleted
+		if before == nil && after == nil {
+			continue
+		}
+		ret.Gateways = append(ret.Gateways, &protos.GatewayMconfigImpact{
+			NetworkID: gw.networkID,
+			GatewayID: gw.gatewayID,
+			Before:    before,
+			After:     after,
+		})
+	}
+	return ret, nil
+}
+
+// getWriteEntityIDs returns the IDs of the entities which the writes create or
+// update, and the IDs of the entities which they associate with.
+func getWriteEntityIDs(writes []*protos.WriteEntityRequest) (written []*storage.EntityID, associated []*storage.EntityID) {
+	for _, write := range writes {
+		switch op := write.Request.(type) {
+		case *protos.WriteEntityRequest_Create:
+			written = append(written, op.Create.GetID())
+			associated = append(associated, op.Create.Associations...)
+		case *protos.WriteEntityRequest_Update:
+			written = append(written, op.Update.GetID())
+			associated = append(associated, op.Update.AssociationsToAdd...)
+			associated = append(associated, op.Update.GetAssociationsToSet().GetAssociationsToSet()...)
+		}
+	}
+	return written, associated
+}
+
+// loadGraphGatewayIDs returns the IDs of the gateways in the entity graphs of
+// the entities. Entities which don't exist are ignored.
+func loadGraphGatewayIDs(store storage.ConfiguratorStorage, networkID string, ids []*storage.EntityID) ([]string, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	loadResult, err := store.LoadEntities(networkID, storage.EntityLoadFilter{IDs: ids}, storage.EntityLoadCriteria{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load entities")
+	}
+
+	var ret []string
+	loadedGraphs := map[string]bool{}
+	for _, ent := range loadResult.Entities {
+		if loadedGraphs[ent.GraphID] {
+			continue
+		}
+		loadedGraphs[ent.GraphID] = true
+
+		graph, err := store.LoadGraphForEntity(networkID, *ent.GetID(), storage.EntityLoadCriteria{})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load entity graph of %s", ent.GetTypeAndKey())
+		}
+		for _, graphEnt := range graph.Entities {
+			if graphEnt.Type == orc8r.MagmadGatewayType {
+				ret = append(ret, graphEnt.Key)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// buildMconfigs builds the mconfigs of the gateways, keyed by gateway ID.
+// Gateways which don't exist are left out.
+func buildMconfigs(store storage.ConfiguratorStorage, networkID string, gatewayIDs []string) (map[string]*commonProtos.GatewayConfigs, error) {
+	ret := map[string]*commonProtos.GatewayConfigs{}
+	if len(gatewayIDs) == 0 {
+		return ret, nil
+	}
+
+	nwLoad, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{networkID}}, storage.FullNetworkLoadCriteria)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load network")
+	}
+	if len(nwLoad.Networks) == 0 {
+		return ret, nil
+	}
+
+	ids := make([]*storage.EntityID, 0, len(gatewayIDs))
+	for _, gatewayID := range gatewayIDs {
+		ids = append(ids, &storage.EntityID{Type: orc8r.MagmadGatewayType, Key: gatewayID})
+	}
+	loadResult, err := store.LoadEntities(networkID, storage.EntityLoadFilter{IDs: ids}, storage.EntityLoadCriteria{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load gateways")
+	}
+	for _, gw := range loadResult.Entities {
+		graph, err := store.LoadGraphForEntity(networkID, *gw.GetID(), storage.FullEntityLoadCriteria)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load entity graph of gateway %s", gw.Key)
+		}
+		mconfig, err := configurator.CreateMconfig(networkID, gw.Key, &graph, nwLoad.Networks[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to build mconfig of gateway %s", gw.Key)
+		}
+		ret[gw.Key] = mconfig
+	}
+	return ret, nil
+}
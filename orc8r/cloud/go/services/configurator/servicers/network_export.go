@@ -0,0 +1,296 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+	"sort"
+
+	commonProtos "magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	orc8rStorage "magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (srv *nbConfiguratorServicer) ExportNetwork(context context.Context, req *protos.ExportNetworkRequest) (*protos.NetworkExport, error) {
+	emptyRes := &protos.NetworkExport{}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return emptyRes, err
+	}
+
+	networks, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{req.NetworkID}}, storage.FullNetworkLoadCriteria)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+	if len(networks.Networks) == 0 {
+		storage.RollbackLogOnError(store)
+		return emptyRes, status.Errorf(codes.NotFound, "network %s not found", req.NetworkID)
+	}
+	loadResult, err := store.LoadEntities(req.NetworkID, storage.EntityLoadFilter{}, storage.FullEntityLoadCriteria)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+
+	network := networks.Networks[0]
+	network.Version = 0
+	entities := make([]*storage.NetworkEntity, 0, len(loadResult.Entities))
+	for _, entity := range loadResult.Entities {
+		entities = append(entities, &storage.NetworkEntity{
+			Type:         entity.Type,
+			Key:          entity.Key,
+			Name:         entity.Name,
+			Description:  entity.Description,
+			PhysicalID:   entity.PhysicalID,
+			Config:       entity.Config,
+			Associations: entity.Associations,
+			Permissions:  entity.Permissions,
+			Labels:       entity.Labels,
+		})
+	}
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].GetTypeAndKey().String() < entities[j].GetTypeAndKey().String()
+	})
+	return &protos.NetworkExport{Network: network, Entities: entities}, store.Commit()
+}
+
+func (srv *nbConfiguratorServicer) ImportNetwork(context context.Context, req *protos.ImportNetworkRequest) (*protos.ImportNetworkResponse, error) {
+	emptyRes := &protos.ImportNetworkResponse{}
+	if req.Export.GetNetwork() == nil {
+		return emptyRes, status.Error(codes.InvalidArgument, "network export is required")
+	}
+	networkID := req.TargetNetworkID
+	if networkID == "" {
+		networkID = req.Export.Network.ID
+	}
+	entities, err := remapExportedEntities(req)
+	if err != nil {
+		return emptyRes, err
+	}
+
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	if err != nil {
+		return emptyRes, err
+	}
+	res := &protos.ImportNetworkResponse{}
+	res.NetworkCreated, err = importNetwork(store, req.Caller, networkID, req.Export.Network, req.ConflictPolicy)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+
+	ids := make([]*storage.EntityID, 0, len(entities))
+	for _, entity := range entities {
+		ids = append(ids, entity.GetID())
+	}
+	existingEntities := map[orc8rStorage.TypeAndKey]*storage.NetworkEntity{}
+	if !res.NetworkCreated && len(ids) > 0 {
+		loadResult, err := store.LoadEntities(networkID, storage.EntityLoadFilter{IDs: ids}, storage.EntityLoadCriteria{LoadLabels: true})
+		if err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
+		}
+		for _, entity := range loadResult.Entities {
+			existingEntities[entity.GetTypeAndKey()] = entity
+		}
+	}
+	if len(existingEntities) > 0 && req.ConflictPolicy == protos.ImportNetworkRequest_FAIL {
+		storage.RollbackLogOnError(store)
+		return emptyRes, status.Errorf(codes.AlreadyExists, "%d of the imported entities already exist in network %s", len(existingEntities), networkID)
+	}
+
+	writtenIDs := make([]*storage.EntityID, 0, len(ids))
+	for _, entity := range entities {
+		if _, exists := existingEntities[entity.GetTypeAndKey()]; exists && req.ConflictPolicy == protos.ImportNetworkRequest_SKIP {
+			res.Skipped = append(res.Skipped, entity.GetID())
+			continue
+		}
+		writtenIDs = append(writtenIDs, entity.GetID())
+		writtenIDs = append(writtenIDs, entity.Associations...)
+	}
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		if err := checkCallerWritePermissions(store, req.Caller, networkID, writtenIDs); err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
+		}
+	}
+
+	// Entities are written in association order so the targets of each
+	// entity's associations exist by the time it is written
+	audit := newAuditLog(networkID, req.Caller)
+	for _, entity := range sortByAssociations(entities) {
+		existingEntity, exists := existingEntities[entity.GetTypeAndKey()]
+		switch {
+		case !exists:
+			_, err = createEntity(store, audit, networkID, entity)
+			res.Created = append(res.Created, entity.GetID())
+		case req.ConflictPolicy == protos.ImportNetworkRequest_OVERWRITE:
+			_, err = updateEntity(store, audit, networkID, getOverwriteCriteria(existingEntity, entity))
+			res.Overwritten = append(res.Overwritten, entity.GetID())
+		}
+		if err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, status.Errorf(codes.Internal, "failed to import entity %s: %s", entity.GetTypeAndKey(), err)
+		}
+	}
+	err = audit.write(store)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+	return res, store.Commit()
+}
+
+// remapExportedEntities returns copies of the exported entities with the
+// request's key and physical ID remaps applied.
+func remapExportedEntities(req *protos.ImportNetworkRequest) ([]*storage.NetworkEntity, error) {
+	keyRemaps := make(map[orc8rStorage.TypeAndKey]string, len(req.KeyRemaps))
+	for _, remap := range req.KeyRemaps {
+		if remap.From == nil || remap.ToKey == "" {
+			return nil, status.Error(codes.InvalidArgument, "key remaps must specify the entity to remap and its new key")
+		}
+		keyRemaps[remap.From.ToTypeAndKey()] = remap.ToKey
+	}
+	remapID := func(id *storage.EntityID) *storage.EntityID {
+		if newKey, ok := keyRemaps[id.ToTypeAndKey()]; ok {
+			return &storage.EntityID{Type: id.Type, Key: newKey}
+		}
+		return &storage.EntityID{Type: id.Type, Key: id.Key}
+	}
+
+	ret := make([]*storage.NetworkEntity, 0, len(req.Export.Entities))
+	seenIDs := map[orc8rStorage.TypeAndKey]bool{}
+	for _, exportedEntity := range req.Export.Entities {
+		entity := proto.Clone(exportedEntity).(*storage.NetworkEntity)
+		entity.Key = remapID(entity.GetID()).Key
+		if newPhysicalID, ok := req.PhysicalIDRemaps[entity.PhysicalID]; ok && entity.PhysicalID != "" {
+			entity.PhysicalID = newPhysicalID
+		}
+		for i, assoc := range entity.Associations {
+			entity.Associations[i] = remapID(assoc)
+		}
+		if seenIDs[entity.GetTypeAndKey()] {
+			return nil, status.Errorf(codes.InvalidArgument, "multiple imported entities have ID %s", entity.GetTypeAndKey())
+		}
+		seenIDs[entity.GetTypeAndKey()] = true
+		ret = append(ret, entity)
+	}
+	return ret, nil
+}
+
+// importNetwork creates the network if it doesn't exist yet and otherwise
+// resolves the conflict according to the policy. Returns true if the network
+// was created.
+func importNetwork(store storage.ConfiguratorStorage, caller *commonProtos.Identity, networkID string, exportedNetwork *storage.Network, policy protos.ImportNetworkRequest_ConflictPolicy) (bool, error) {
+	loadResult, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{networkID}}, storage.FullNetworkLoadCriteria)
+	if err != nil {
+		return false, err
+	}
+	if len(loadResult.Networks) == 0 {
+		if err := networkConfigsAreValid(exportedNetwork.Configs); err != nil {
+			return false, err
+		}
+		_, err = store.CreateNetwork(storage.Network{
+			ID:          networkID,
+			Type:        exportedNetwork.Type,
+			Name:        exportedNetwork.Name,
+			Description: exportedNetwork.Description,
+			Configs:     exportedNetwork.Configs,
+		})
+		if err != nil {
+			return false, err
+		}
+		audit := newAuditLog("", caller)
+		audit.addNetworkEvent(networkID, storage.AuditActionCreate, "", digestNetworkConfigs(exportedNetwork.Configs))
+		return true, audit.write(store)
+	}
+
+	switch policy {
+	case protos.ImportNetworkRequest_SKIP:
+		return false, nil
+	case protos.ImportNetworkRequest_OVERWRITE:
+		if err := networkConfigsAreValid(exportedNetwork.Configs); err != nil {
+			return false, err
+		}
+		update := storage.NetworkUpdateCriteria{
+			ID:                   networkID,
+			NewName:              &wrappers.StringValue{Value: exportedNetwork.Name},
+			NewDescription:       &wrappers.StringValue{Value: exportedNetwork.Description},
+			NewType:              &wrappers.StringValue{Value: exportedNetwork.Type},
+			ConfigsToAddOrUpdate: exportedNetwork.Configs,
+		}
+		for configType := range loadResult.Networks[0].Configs {
+			if _, ok := exportedNetwork.Configs[configType]; !ok {
+				update.ConfigsToDelete = append(update.ConfigsToDelete, configType)
+			}
+		}
+		sort.Strings(update.ConfigsToDelete)
+		return false, updateNetworks(store, caller, []storage.NetworkUpdateCriteria{update})
+	default:
+		return false, status.Errorf(codes.AlreadyExists, "network %s already exists", networkID)
+	}
+}
+
+// getOverwriteCriteria returns the update which replaces the existing entity
+// with the imported one. Permissions of the existing entity are kept.
+func getOverwriteCriteria(existingEntity *storage.NetworkEntity, entity *storage.NetworkEntity) *storage.EntityUpdateCriteria {
+	update := &storage.EntityUpdateCriteria{
+		Type:                entity.Type,
+		Key:                 entity.Key,
+		NewName:             &wrappers.StringValue{Value: entity.Name},
+		NewDescription:      &wrappers.StringValue{Value: entity.Description},
+		NewPhysicalID:       &wrappers.StringValue{Value: entity.PhysicalID},
+		NewConfig:           &wrappers.BytesValue{Value: entity.Config},
+		AssociationsToSet:   &storage.EntityAssociationsToSet{AssociationsToSet: entity.Associations},
+		LabelsToAddOrUpdate: entity.Labels,
+	}
+	for labelKey := range existingEntity.Labels {
+		if _, ok := entity.Labels[labelKey]; !ok {
+			update.LabelsToDelete = append(update.LabelsToDelete, labelKey)
+		}
+	}
+	sort.Strings(update.LabelsToDelete)
+	return update
+}
+
+// sortByAssociations orders the entities so that each entity comes after the
+// targets of its associations.
+func sortByAssociations(entities []*storage.NetworkEntity) []*storage.NetworkEntity {
+	entitiesByID := make(map[orc8rStorage.TypeAndKey]*storage.NetworkEntity, len(entities))
+	for _, entity := range entities {
+		entitiesByID[entity.GetTypeAndKey()] = entity
+	}
+
+	ret := make([]*storage.NetworkEntity, 0, len(entities))
+	visited := map[orc8rStorage.TypeAndKey]bool{}
+	var visit func(entity *storage.NetworkEntity)
+	visit = func(entity *storage.NetworkEntity) {
+		if visited[entity.GetTypeAndKey()] {
+			return
+		}
+		visited[entity.GetTypeAndKey()] = true
+		for _, assoc := range entity.Associations {
+			if target, ok := entitiesByID[assoc.ToTypeAndKey()]; ok {
+				visit(target)
+			}
+		}
+		ret = append(ret, entity)
+	}
+	for _, entity := range entities {
+		visit(entity)
+	}
+	return ret
+}
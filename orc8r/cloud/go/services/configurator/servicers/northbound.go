@@ -11,6 +11,7 @@ package servicers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	commonProtos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/serde"
@@ -19,20 +20,50 @@ import (
 	"magma/orc8r/cloud/go/services/configurator/storage"
 	orc8rStorage "magma/orc8r/cloud/go/storage"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type nbConfiguratorServicer struct {
-	factory storage.ConfiguratorStorageFactory
+	factory   storage.ConfiguratorStorageFactory
+	aclPolicy ACLPolicy
+	notifier  *changeNotifier
 }
 
 // NewNorthboundConfiguratorServicer returns a configurator server backed by storage passed in
 func NewNorthboundConfiguratorServicer(factory storage.ConfiguratorStorageFactory) (protos.NorthboundConfiguratorServer, error) {
+	return NewNorthboundConfiguratorServicerWithACLPolicy(factory, ACLPolicy{})
+}
+
+// NewNorthboundConfiguratorServicerWithACLPolicy returns a configurator server
+// backed by storage passed in, which checks the callers of requests against
+// their entity ACLs according to aclPolicy
+func NewNorthboundConfiguratorServicerWithACLPolicy(factory storage.ConfiguratorStorageFactory, aclPolicy ACLPolicy) (protos.NorthboundConfiguratorServer, error) {
+	return NewNorthboundConfiguratorServicerWithWatchInterval(factory, aclPolicy, DefaultWatchPollInterval)
+}
+
+// NewNorthboundConfiguratorServicerWithWatchInterval returns a configurator
+// server backed by storage passed in, which checks the callers of requests
+// according to aclPolicy and checks watched networks for changes every
+// watchPollInterval
+func NewNorthboundConfiguratorServicerWithWatchInterval(
+	factory storage.ConfiguratorStorageFactory,
+	aclPolicy ACLPolicy,
+	watchPollInterval time.Duration,
+) (protos.NorthboundConfiguratorServer, error) {
 	if factory == nil {
 		return nil, fmt.Errorf("Storage factory is nil")
 	}
-	return &nbConfiguratorServicer{factory}, nil
+	if watchPollInterval <= 0 {
+		return nil, fmt.Errorf("Watch poll interval must be positive")
+	}
+	return &nbConfiguratorServicer{
+		factory:   factory,
+		aclPolicy: aclPolicy,
+		notifier:  newChangeNotifier(factory, watchPollInterval),
+	}, nil
 }
 
 func (srv *nbConfiguratorServicer) LoadNetworks(context context.Context, req *protos.LoadNetworksRequest) (*storage.NetworkLoadResult, error) {
@@ -76,6 +107,7 @@ func (srv *nbConfiguratorServicer) CreateNetworks(context context.Context, req *
 		return emptyRes, err
 	}
 
+	audit := newAuditLog("", req.Caller)
 	createdNetworks := make([]*storage.Network, 0, len(req.Networks))
 	for _, network := range req.Networks {
 		err = networkConfigsAreValid(network.Configs)
@@ -88,31 +120,57 @@ func (srv *nbConfiguratorServicer) CreateNetworks(context context.Context, req *
 			return emptyRes, err
 		}
 		createdNetworks = append(createdNetworks, &createdNetwork)
+		audit.addNetworkEvent(createdNetwork.ID, storage.AuditActionCreate, "", digestNetworkConfigs(network.Configs))
+	}
+	err = audit.write(store)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
 	}
 	return &protos.CreateNetworksResponse{CreatedNetworks: createdNetworks}, store.Commit()
 }
 
-func (srv *nbConfiguratorServicer) UpdateNetworks(context context.Context, req *protos.UpdateNetworksRequest) (*commonProtos.Void, error) {
-	void := &commonProtos.Void{}
+func (srv *nbConfiguratorServicer) UpdateNetworks(context context.Context, req *protos.UpdateNetworksRequest) (*protos.UpdateNetworksResponse, error) {
+	emptyRes := &protos.UpdateNetworksResponse{}
 	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
 	if err != nil {
-		return void, err
+		return emptyRes, err
 	}
 
 	updates := []storage.NetworkUpdateCriteria{}
+	networkIDs := []string{}
 	for _, update := range req.Updates {
 		err = networkConfigsAreValid(update.ConfigsToAddOrUpdate)
 		if err != nil {
-			return void, err
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
 		}
 		updates = append(updates, *update)
+		networkIDs = append(networkIDs, update.ID)
 	}
-	err = store.UpdateNetworks(updates)
+
+	preview := newMconfigPreview()
+	if req.DryRun {
+		err = preview.addNetworkGateways(store, networkIDs)
+		if err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, status.Error(codes.Internal, err.Error())
+		}
+	}
+	err = updateNetworks(store, req.Caller, updates)
 	if err != nil {
 		storage.RollbackLogOnError(store)
-		return void, err
+		return emptyRes, err
 	}
-	return void, store.Commit()
+	if req.DryRun {
+		report, err := preview.report(store)
+		storage.RollbackLogOnError(store)
+		if err != nil {
+			return emptyRes, status.Error(codes.Internal, err.Error())
+		}
+		return &protos.UpdateNetworksResponse{MconfigImpact: report}, nil
+	}
+	return emptyRes, store.Commit()
 }
 
 func (srv *nbConfiguratorServicer) DeleteNetworks(context context.Context, req *protos.DeleteNetworksRequest) (*commonProtos.Void, error) {
@@ -126,7 +184,7 @@ func (srv *nbConfiguratorServicer) DeleteNetworks(context context.Context, req *
 	for _, networkID := range req.NetworkIDs {
 		deleteRequests = append(deleteRequests, storage.NetworkUpdateCriteria{ID: networkID, DeleteNetwork: true})
 	}
-	err = store.UpdateNetworks(deleteRequests)
+	err = updateNetworks(store, req.Caller, deleteRequests)
 	if err != nil {
 		storage.RollbackLogOnError(store)
 		return void, err
@@ -144,8 +202,19 @@ func (srv *nbConfiguratorServicer) LoadEntities(context context.Context, req *pr
 	loadResult, err := store.LoadEntities(req.NetworkID, *req.Filter, *req.Criteria)
 	if err != nil {
 		storage.RollbackLogOnError(store)
+		if errors.Cause(err) == storage.ErrInvalidPageToken {
+			return emptyRes, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return emptyRes, err
 	}
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		acls, err := loadCallerACLs(store, req.Caller)
+		if err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
+		}
+		loadResult.Entities = filterReadableEntities(acls, req.NetworkID, loadResult.Entities)
+	}
 	return &loadResult, store.Commit()
 }
 
@@ -156,20 +225,42 @@ func (srv *nbConfiguratorServicer) WriteEntities(context context.Context, req *p
 		return emptyRes, err
 	}
 
+	writtenIDs, associatedIDs := getWriteEntityIDs(req.Writes)
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		if err := checkCallerWritePermissions(store, req.Caller, req.NetworkID, append(writtenIDs, associatedIDs...)); err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
+		}
+	}
+
+	preview := newMconfigPreview()
+	if req.DryRun {
+		err = preview.addEntityGateways(store, req.NetworkID, append(writtenIDs, associatedIDs...))
+		if err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	audit := newAuditLog(req.NetworkID, req.Caller)
 	ret := &protos.WriteEntitiesResponse{
 		UpdatedEntities: map[string]*storage.NetworkEntity{},
 	}
 	for _, write := range req.Writes {
 		switch op := write.Request.(type) {
 		case *protos.WriteEntityRequest_Create:
-			createdEnt, err := createEntity(store, req.NetworkID, op.Create)
+			createdEnt, err := createEntity(store, audit, req.NetworkID, op.Create)
 			if err != nil {
 				storage.RollbackLogOnError(store)
 				return emptyRes, status.Error(codes.Internal, err.Error())
 			}
 			ret.CreatedEntities = append(ret.CreatedEntities, createdEnt)
 		case *protos.WriteEntityRequest_Update:
-			updatedEnt, err := updateEntity(store, req.NetworkID, op.Update)
+			updatedEnt, err := updateEntity(store, audit, req.NetworkID, op.Update)
+			if status.Code(err) == codes.Aborted {
+				storage.RollbackLogOnError(store)
+				return emptyRes, err
+			}
 			if err != nil {
 				storage.RollbackLogOnError(store)
 				return emptyRes, status.Error(codes.Internal, err.Error())
@@ -180,6 +271,22 @@ func (srv *nbConfiguratorServicer) WriteEntities(context context.Context, req *p
 			return emptyRes, status.Error(codes.InvalidArgument, fmt.Sprintf("write request %T not recognized", write))
 		}
 	}
+	if req.DryRun {
+		err = preview.addCreatedGateways(store, req.NetworkID, writtenIDs)
+		if err == nil {
+			ret.MconfigImpact, err = preview.report(store)
+		}
+		storage.RollbackLogOnError(store)
+		if err != nil {
+			return emptyRes, status.Error(codes.Internal, err.Error())
+		}
+		return ret, nil
+	}
+	err = audit.write(store)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
 	return ret, store.Commit()
 }
 
@@ -190,15 +297,33 @@ func (srv *nbConfiguratorServicer) CreateEntities(context context.Context, req *
 		return emptyRes, err
 	}
 
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		ids := make([]*storage.EntityID, 0, len(req.Entities))
+		for _, entity := range req.Entities {
+			ids = append(ids, entity.GetID())
+			ids = append(ids, entity.Associations...)
+		}
+		if err := checkCallerWritePermissions(store, req.Caller, req.NetworkID, ids); err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
+		}
+	}
+
+	audit := newAuditLog(req.NetworkID, req.Caller)
 	createdEntities := []*storage.NetworkEntity{}
 	for _, entity := range req.Entities {
-		createdEntity, err := createEntity(store, req.NetworkID, entity)
+		createdEntity, err := createEntity(store, audit, req.NetworkID, entity)
 		if err != nil {
 			storage.RollbackLogOnError(store)
 			return emptyRes, err
 		}
 		createdEntities = append(createdEntities, createdEntity)
 	}
+	err = audit.write(store)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
 	return &protos.CreateEntitiesResponse{CreatedEntities: createdEntities}, store.Commit()
 }
 
@@ -209,15 +334,34 @@ func (srv *nbConfiguratorServicer) UpdateEntities(context context.Context, req *
 		return emptyRes, err
 	}
 
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		ids := make([]*storage.EntityID, 0, len(req.Updates))
+		for _, update := range req.Updates {
+			ids = append(ids, update.GetID())
+			ids = append(ids, update.AssociationsToAdd...)
+			ids = append(ids, update.GetAssociationsToSet().GetAssociationsToSet()...)
+		}
+		if err := checkCallerWritePermissions(store, req.Caller, req.NetworkID, ids); err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
+		}
+	}
+
+	audit := newAuditLog(req.NetworkID, req.Caller)
 	updatedEntities := map[string]*storage.NetworkEntity{}
 	for _, update := range req.Updates {
-		updatedEntity, err := updateEntity(store, req.NetworkID, update)
+		updatedEntity, err := updateEntity(store, audit, req.NetworkID, update)
 		if err != nil {
 			storage.RollbackLogOnError(store)
 			return emptyRes, err
 		}
 		updatedEntities[update.Key] = updatedEntity
 	}
+	err = audit.write(store)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
 	return &protos.UpdateEntitiesResponse{UpdatedEntities: updatedEntities}, store.Commit()
 }
 
@@ -228,21 +372,241 @@ func (srv *nbConfiguratorServicer) DeleteEntities(context context.Context, req *
 		return void, err
 	}
 
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		if err := checkCallerWritePermissions(store, req.Caller, req.NetworkID, req.ID); err != nil {
+			storage.RollbackLogOnError(store)
+			return void, err
+		}
+	}
+
+	audit := newAuditLog(req.NetworkID, req.Caller)
 	for _, entityID := range req.ID {
-		request := storage.EntityUpdateCriteria{
+		request := &storage.EntityUpdateCriteria{
 			Type:         entityID.Type,
 			Key:          entityID.Key,
 			DeleteEntity: true,
 		}
-		_, err = store.UpdateEntity(req.NetworkID, request)
+		_, err = updateEntity(store, audit, req.NetworkID, request)
 		if err != nil {
 			storage.RollbackLogOnError(store)
 			return void, err
 		}
 	}
+	err = audit.write(store)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
 	return void, store.Commit()
 }
 
+func (srv *nbConfiguratorServicer) ListNetworkRevisions(context context.Context, req *protos.ListNetworkRevisionsRequest) (*protos.ListNetworkRevisionsResponse, error) {
+	emptyRes := &protos.ListNetworkRevisionsResponse{}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return emptyRes, err
+	}
+
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		if err := checkCallerNetworkPermission(store, req.Caller, req.NetworkID, storage.ACL_READ); err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
+		}
+	}
+
+	network, err := loadCurrentNetwork(store, req.NetworkID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+	revisions, err := store.LoadNetworkRevisions(req.NetworkID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+	revisions = append(revisions, &storage.NetworkRevision{NetworkID: network.ID, Version: network.Version, Configs: network.Configs})
+	return &protos.ListNetworkRevisionsResponse{Revisions: revisions}, store.Commit()
+}
+
+func (srv *nbConfiguratorServicer) RollbackNetwork(context context.Context, req *protos.RollbackNetworkRequest) (*commonProtos.Void, error) {
+	void := &commonProtos.Void{}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	if err != nil {
+		return void, err
+	}
+
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		if err := checkCallerNetworkPermission(store, req.Caller, req.NetworkID, storage.ACL_WRITE); err != nil {
+			storage.RollbackLogOnError(store)
+			return void, err
+		}
+	}
+
+	network, err := loadCurrentNetwork(store, req.NetworkID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	if network.Version == req.Version {
+		storage.RollbackLogOnError(store)
+		return void, status.Errorf(codes.InvalidArgument, "version %d is the current version of network %s", req.Version, req.NetworkID)
+	}
+	revisions, err := store.LoadNetworkRevisions(req.NetworkID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	var target *storage.NetworkRevision
+	for _, revision := range revisions {
+		if revision.Version == req.Version {
+			target = revision
+			break
+		}
+	}
+	if target == nil {
+		storage.RollbackLogOnError(store)
+		return void, status.Errorf(codes.NotFound, "revision %d of network %s not found", req.Version, req.NetworkID)
+	}
+	err = networkConfigsAreValid(target.Configs)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+
+	// Rolling back is an update like any other, so the configs it replaces
+	// are retained as a revision as well
+	update := storage.NetworkUpdateCriteria{ID: req.NetworkID, ConfigsToAddOrUpdate: target.Configs}
+	for configType := range network.Configs {
+		if _, exists := target.Configs[configType]; !exists {
+			update.ConfigsToDelete = append(update.ConfigsToDelete, configType)
+		}
+	}
+	err = updateNetworks(store, req.Caller, []storage.NetworkUpdateCriteria{update})
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	return void, store.Commit()
+}
+
+func (srv *nbConfiguratorServicer) ListEntityRevisions(context context.Context, req *protos.ListEntityRevisionsRequest) (*protos.ListEntityRevisionsResponse, error) {
+	emptyRes := &protos.ListEntityRevisionsResponse{}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return emptyRes, err
+	}
+
+	entity, err := loadCurrentEntity(store, req.NetworkID, req.ID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+	// Entities the caller can't read are hidden like they are from loads
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		acls, err := loadCallerACLs(store, req.Caller)
+		if err != nil {
+			storage.RollbackLogOnError(store)
+			return emptyRes, err
+		}
+		if len(filterReadableEntities(acls, req.NetworkID, []*storage.NetworkEntity{entity})) == 0 {
+			storage.RollbackLogOnError(store)
+			return emptyRes, status.Errorf(codes.NotFound, "entity %s not found", req.ID.ToTypeAndKey())
+		}
+	}
+	revisions, err := store.LoadEntityRevisions(req.NetworkID, *req.ID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+	revisions = append(revisions, &storage.EntityRevision{NetworkID: req.NetworkID, ID: req.ID, Version: entity.Version, Config: entity.Config})
+	return &protos.ListEntityRevisionsResponse{Revisions: revisions}, store.Commit()
+}
+
+func (srv *nbConfiguratorServicer) RollbackEntity(context context.Context, req *protos.RollbackEntityRequest) (*commonProtos.Void, error) {
+	void := &commonProtos.Void{}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: false})
+	if err != nil {
+		return void, err
+	}
+
+	if srv.aclPolicy.appliesTo(req.Caller) {
+		if err := checkCallerWritePermissions(store, req.Caller, req.NetworkID, []*storage.EntityID{req.ID}); err != nil {
+			storage.RollbackLogOnError(store)
+			return void, err
+		}
+	}
+
+	entity, err := loadCurrentEntity(store, req.NetworkID, req.ID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	if entity.Version == req.Version {
+		storage.RollbackLogOnError(store)
+		return void, status.Errorf(codes.InvalidArgument, "version %d is the current version of entity %s", req.Version, req.ID.ToTypeAndKey())
+	}
+	revisions, err := store.LoadEntityRevisions(req.NetworkID, *req.ID)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	var target *storage.EntityRevision
+	for _, revision := range revisions {
+		if revision.Version == req.Version {
+			target = revision
+			break
+		}
+	}
+	if target == nil {
+		storage.RollbackLogOnError(store)
+		return void, status.Errorf(codes.NotFound, "revision %d of entity %s not found", req.Version, req.ID.ToTypeAndKey())
+	}
+	// Entities may have been created without a config, which isn't
+	// deserializable
+	if len(target.Config) > 0 {
+		if err := entityConfigIsValid(req.ID.Type, target.Config); err != nil {
+			storage.RollbackLogOnError(store)
+			return void, err
+		}
+	}
+
+	_, err = store.UpdateEntity(req.NetworkID, storage.EntityUpdateCriteria{
+		Type:      req.ID.Type,
+		Key:       req.ID.Key,
+		NewConfig: &wrappers.BytesValue{Value: target.Config},
+	})
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	audit := newAuditLog(req.NetworkID, req.Caller)
+	audit.addEntityEvent(req.ID, storage.AuditActionUpdate, digestConfig(entity.Config), digestConfig(target.Config))
+	err = audit.write(store)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return void, err
+	}
+	return void, store.Commit()
+}
+
+func (srv *nbConfiguratorServicer) ListAuditEvents(context context.Context, req *protos.ListAuditEventsRequest) (*protos.ListAuditEventsResponse, error) {
+	emptyRes := &protos.ListAuditEventsResponse{}
+	if req.Filter == nil {
+		return emptyRes, status.Error(codes.InvalidArgument, "audit event filter is required")
+	}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return emptyRes, err
+	}
+
+	events, err := store.LoadAuditEvents(*req.Filter)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return emptyRes, err
+	}
+	return &protos.ListAuditEventsResponse{Events: events}, store.Commit()
+}
+
 func networkConfigsAreValid(configs map[string][]byte) error {
 	for typeVal, config := range configs {
 		_, err := serde.Deserialize(configurator.NetworkConfigSerdeDomain, typeVal, config)
@@ -261,24 +625,114 @@ func entityConfigIsValid(typeVal string, config []byte) error {
 	return nil
 }
 
-func createEntity(store storage.ConfiguratorStorage, networkID string, entity *storage.NetworkEntity) (*storage.NetworkEntity, error) {
+func createEntity(store storage.ConfiguratorStorage, audit *auditLog, networkID string, entity *storage.NetworkEntity) (*storage.NetworkEntity, error) {
 	if err := entityConfigIsValid(entity.Type, entity.Config); err != nil {
 		return nil, err
 	}
 	ent, err := store.CreateEntity(networkID, *entity)
-	return &ent, err
+	if err != nil {
+		return &ent, err
+	}
+	audit.addEntityEvent(entity.GetID(), storage.AuditActionCreate, "", digestConfig(entity.Config))
+	return &ent, nil
 }
 
-func updateEntity(store storage.ConfiguratorStorage, networkID string, update *storage.EntityUpdateCriteria) (*storage.NetworkEntity, error) {
+func updateEntity(store storage.ConfiguratorStorage, audit *auditLog, networkID string, update *storage.EntityUpdateCriteria) (*storage.NetworkEntity, error) {
 	if update.NewConfig != nil {
 		if err := entityConfigIsValid(update.Type, update.NewConfig.Value); err != nil {
 			return nil, err
 		}
 	}
 
+	beforeDigests, err := loadEntityDigests(store, networkID, []*storage.EntityID{update.GetID()})
+	if err != nil {
+		return nil, err
+	}
 	updatedEntity, err := store.UpdateEntity(networkID, *update)
+	if errors.Cause(err) == storage.ErrVersionMismatch {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
+
+	// Updates to entities which don't exist aren't changes worth auditing
+	beforeDigest, existed := beforeDigests[update.GetTypeAndKey()]
+	switch {
+	case !existed:
+	case update.DeleteEntity:
+		audit.addEntityEvent(update.GetID(), storage.AuditActionDelete, beforeDigest, "")
+	case update.NewConfig != nil:
+		audit.addEntityEvent(update.GetID(), storage.AuditActionUpdate, beforeDigest, digestConfig(update.NewConfig.Value))
+	default:
+		audit.addEntityEvent(update.GetID(), storage.AuditActionUpdate, beforeDigest, beforeDigest)
+	}
 	return &updatedEntity, nil
 }
+
+// updateNetworks applies the updates to networks and records them in the
+// audit trail. Updates to networks which don't exist aren't recorded.
+func updateNetworks(store storage.ConfiguratorStorage, caller *commonProtos.Identity, updates []storage.NetworkUpdateCriteria) error {
+	networkIDs := make([]string, 0, len(updates))
+	for _, update := range updates {
+		networkIDs = append(networkIDs, update.ID)
+	}
+	beforeDigests, err := loadNetworkDigests(store, networkIDs)
+	if err != nil {
+		return err
+	}
+	err = store.UpdateNetworks(updates)
+	if err != nil {
+		return err
+	}
+	afterDigests, err := loadNetworkDigests(store, networkIDs)
+	if err != nil {
+		return err
+	}
+
+	audit := newAuditLog("", caller)
+	for _, update := range updates {
+		beforeDigest, existed := beforeDigests[update.ID]
+		switch {
+		case !existed:
+		case update.DeleteNetwork:
+			audit.addNetworkEvent(update.ID, storage.AuditActionDelete, beforeDigest, "")
+		default:
+			audit.addNetworkEvent(update.ID, storage.AuditActionUpdate, beforeDigest, afterDigests[update.ID])
+		}
+	}
+	return audit.write(store)
+}
+
+// loadCurrentNetwork loads a network with its configs, returning a NotFound
+// error if the network doesn't exist.
+func loadCurrentNetwork(store storage.ConfiguratorStorage, networkID string) (storage.Network, error) {
+	loadResult, err := store.LoadNetworks(storage.NetworkLoadFilter{Ids: []string{networkID}}, storage.NetworkLoadCriteria{LoadConfigs: true})
+	if err != nil {
+		return storage.Network{}, err
+	}
+	if len(loadResult.Networks) == 0 {
+		return storage.Network{}, status.Errorf(codes.NotFound, "network %s not found", networkID)
+	}
+	return *loadResult.Networks[0], nil
+}
+
+// loadCurrentEntity loads an entity with its config, returning a NotFound
+// error if the entity doesn't exist.
+func loadCurrentEntity(store storage.ConfiguratorStorage, networkID string, id *storage.EntityID) (*storage.NetworkEntity, error) {
+	if id == nil {
+		return nil, status.Error(codes.InvalidArgument, "entity ID is required")
+	}
+	loadResult, err := store.LoadEntities(
+		networkID,
+		storage.EntityLoadFilter{IDs: []*storage.EntityID{id}},
+		storage.EntityLoadCriteria{LoadConfig: true},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(loadResult.Entities) == 0 {
+		return nil, status.Errorf(codes.NotFound, "entity %s not found", id.ToTypeAndKey())
+	}
+	return loadResult.Entities[0], nil
+}
@@ -0,0 +1,193 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	"magma/orc8r/cloud/go/services/configurator/storage"
+	orc8rStorage "magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultWatchPollInterval is how often the configurator servicer checks the
+// change sequence of each watched network for new changes.
+const DefaultWatchPollInterval = time.Second
+
+func (srv *nbConfiguratorServicer) LoadEntityChanges(context context.Context, req *protos.LoadEntityChangesRequest) (*storage.EntityChanges, error) {
+	if req.NetworkID == "" {
+		return nil, status.Error(codes.InvalidArgument, "network ID is required")
+	}
+	store, err := srv.factory.StartTransaction(context, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+
+	var since *uint64
+	if req.Since != nil {
+		since = &req.Since.Value
+	}
+	changes, err := store.LoadEntityChanges(req.NetworkID, since)
+	if err == storage.ErrChangesUnavailable {
+		storage.RollbackLogOnError(store)
+		return nil, status.Error(codes.OutOfRange, err.Error())
+	}
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return nil, err
+	}
+	return changes, store.Commit()
+}
+
+// WatchEntityChanges sends the current change sequence of the network, then
+// sends it again each time it advances. Sequence numbers which advance
+// between two polls are coalesced into one response.
+func (srv *nbConfiguratorServicer) WatchEntityChanges(req *protos.WatchEntityChangesRequest, stream protos.NorthboundConfigurator_WatchEntityChangesServer) error {
+	if req.NetworkID == "" {
+		return status.Error(codes.InvalidArgument, "network ID is required")
+	}
+
+	// Subscribe before the first read so no change is missed
+	changed, unsubscribe := srv.notifier.subscribe(req.NetworkID)
+	defer unsubscribe()
+	var sent *uint64
+	for {
+		sequence, err := srv.notifier.getSequence(stream.Context(), req.NetworkID)
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load change sequence: %v", err)
+		}
+		if sent == nil || *sent != sequence {
+			err = stream.Send(&protos.WatchEntityChangesResponse{Sequence: sequence})
+			if err != nil {
+				return err
+			}
+			sent = &sequence
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-changed:
+		}
+	}
+}
+
+// changeNotifier polls the change sequence of every watched network once per
+// interval on behalf of all the streams watching it, and wakes those streams
+// when the sequence advances. The sequence is read from storage, so changes
+// written through any replica of the configurator are noticed.
+type changeNotifier struct {
+	factory  storage.ConfiguratorStorageFactory
+	interval time.Duration
+	start    sync.Once
+
+	sync.Mutex
+	// subscribers holds the wake channels of the streams watching each
+	// network, keyed by network ID
+	subscribers map[string]map[chan struct{}]struct{}
+	// sequences holds the last polled sequence number of each network
+	sequences map[string]uint64
+}
+
+func newChangeNotifier(factory storage.ConfiguratorStorageFactory, interval time.Duration) *changeNotifier {
+	return &changeNotifier{
+		factory:     factory,
+		interval:    interval,
+		subscribers: map[string]map[chan struct{}]struct{}{},
+		sequences:   map[string]uint64{},
+	}
+}
+
+// subscribe returns a channel which receives a value whenever the network's
+// change sequence advances, and a function which cancels the subscription.
+func (n *changeNotifier) subscribe(networkID string) (<-chan struct{}, func()) {
+	n.start.Do(func() { go n.run() })
+
+	ch := make(chan struct{}, 1)
+	n.Lock()
+	defer n.Unlock()
+	if _, ok := n.subscribers[networkID]; !ok {
+		n.subscribers[networkID] = map[chan struct{}]struct{}{}
+	}
+	n.subscribers[networkID][ch] = struct{}{}
+
+	unsubscribe := func() {
+		n.Lock()
+		defer n.Unlock()
+		delete(n.subscribers[networkID], ch)
+		if len(n.subscribers[networkID]) == 0 {
+			delete(n.subscribers, networkID)
+			delete(n.sequences, networkID)
+		}
+	}
+	return ch, unsubscribe
+}
+
+func (n *changeNotifier) run() {
+	for range time.Tick(n.interval) {
+		n.Lock()
+		networkIDs := make([]string, 0, len(n.subscribers))
+		for networkID := range n.subscribers {
+			networkIDs = append(networkIDs, networkID)
+		}
+		n.Unlock()
+
+		for _, networkID := range networkIDs {
+			sequence, err := n.getSequence(context.Background(), networkID)
+			if err != nil {
+				glog.Errorf("Failed to poll configurator change sequence of network %s: %v", networkID, err)
+				continue
+			}
+			n.notify(networkID, sequence)
+		}
+	}
+}
+
+func (n *changeNotifier) getSequence(ctx context.Context, networkID string) (uint64, error) {
+	store, err := n.factory.StartTransaction(ctx, &orc8rStorage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	changes, err := store.LoadEntityChanges(networkID, nil)
+	if err != nil {
+		storage.RollbackLogOnError(store)
+		return 0, err
+	}
+	return changes.Sequence, store.Commit()
+}
+
+// notify wakes the network's subscribers if its sequence advanced since the
+// last poll. The first poll of a network always wakes its subscribers, since
+// they may have subscribed after a change which that poll observes.
+func (n *changeNotifier) notify(networkID string, sequence uint64) {
+	n.Lock()
+	defer n.Unlock()
+	subscribers, ok := n.subscribers[networkID]
+	if !ok {
+		return
+	}
+	last, polled := n.sequences[networkID]
+	n.sequences[networkID] = sequence
+	if polled && last == sequence {
+		return
+	}
+	for ch := range subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// The subscriber already has a pending wakeup
+		}
+	}
+}
@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage
+
+import (
+	"github.com/thoas/go-funk"
+)
+
+// Grants returns true if the ACL grants the requested permission on the
+// entity identified by (networkID, entityType, entityKey).
+func (m *ACL) Grants(networkID string, entityType string, entityKey string, perm ACL_Permission) bool {
+	return m.grantsPermission(perm) &&
+		m.appliesToNetwork(networkID) &&
+		m.appliesToType(entityType) &&
+		(len(m.IDFilter) == 0 || funk.ContainsString(m.IDFilter, entityKey))
+}
+
+// GrantsNetwork returns true if the ACL grants the requested permission on
+// the network itself, i.e. on all of its entities regardless of type and key.
+func (m *ACL) GrantsNetwork(networkID string, perm ACL_Permission) bool {
+	_, allTypes := m.Type.(*ACL_TypeWildcard)
+	return allTypes && m.Grants(networkID, "", "", perm) && len(m.IDFilter) == 0
+}
+
+func (m *ACL) grantsPermission(perm ACL_Permission) bool {
+	if perm == ACL_NO_PERM {
+		return false
+	}
+	return m.Permission == perm || m.Permission == ACL_OWN
+}
+
+func (m *ACL) appliesToNetwork(networkID string) bool {
+	switch scope := m.Scope.(type) {
+	case *ACL_ScopeWildcard:
+		return scope.ScopeWildcard == ACL_WILDCARD_ALL
+	case *ACL_ScopeNetworkIDs:
+		return scope.ScopeNetworkIDs != nil && funk.ContainsString(scope.ScopeNetworkIDs.IDs, networkID)
+	default:
+		return false
+	}
+}
+
+func (m *ACL) appliesToType(entityType string) bool {
+	switch t := m.Type.(type) {
+	case *ACL_TypeWildcard:
+		return t.TypeWildcard == ACL_WILDCARD_ALL
+	case *ACL_EntityType:
+		return t.EntityType == entityType
+	default:
+		return false
+	}
+}
+
+// ACLsGrant returns true if any of the ACLs grants the requested permission
+// on the entity identified by (networkID, entityType, entityKey).
+func ACLsGrant(acls []*ACL, networkID string, entityType string, entityKey string, perm ACL_Permission) bool {
+	for _, acl := range acls {
+		if acl.Grants(networkID, entityType, entityKey, perm) {
+			return true
+		}
+	}
+	return false
+}
+
+// ACLsGrantNetwork returns true if any of the ACLs grants the requested
+// permission on the network itself.
+func ACLsGrantNetwork(acls []*ACL, networkID string, perm ACL_Permission) bool {
+	for _, acl := range acls {
+		if acl.GrantsNetwork(networkID, perm) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage_test
+
+import (
+	"testing"
+
+	"magma/orc8r/cloud/go/services/configurator/storage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestACL_Grants(t *testing.T) {
+	wildcard := &storage.ACL{
+		Permission: storage.ACL_READ,
+		Type:       &storage.ACL_TypeWildcard{TypeWildcard: storage.ACL_WILDCARD_ALL},
+		Scope:      &storage.ACL_ScopeWildcard{ScopeWildcard: storage.ACL_WILDCARD_ALL},
+	}
+	assert.True(t, wildcard.Grants("n1", "foo", "k1", storage.ACL_READ))
+	assert.True(t, wildcard.Grants("n2", "bar", "k2", storage.ACL_READ))
+	assert.False(t, wildcard.Grants("n1", "foo", "k1", storage.ACL_WRITE))
+	assert.False(t, wildcard.Grants("n1", "foo", "k1", storage.ACL_NO_PERM))
+
+	scoped := &storage.ACL{
+		Permission: storage.ACL_WRITE,
+		Type:       &storage.ACL_EntityType{EntityType: "foo"},
+		Scope:      &storage.ACL_ScopeNetworkIDs{ScopeNetworkIDs: &storage.ACL_NetworkIDs{IDs: []string{"n1", "n2"}}},
+		IDFilter:   []string{"k1"},
+	}
+	assert.True(t, scoped.Grants("n1", "foo", "k1", storage.ACL_WRITE))
+	assert.True(t, scoped.Grants("n2", "foo", "k1", storage.ACL_WRITE))
+	assert.False(t, scoped.Grants("n3", "foo", "k1", storage.ACL_WRITE))
+	assert.False(t, scoped.Grants("n1", "bar", "k1", storage.ACL_WRITE))
+	assert.False(t, scoped.Grants("n1", "foo", "k2", storage.ACL_WRITE))
+	// WRITE doesn't grant READ
+	assert.False(t, scoped.Grants("n1", "foo", "k1", storage.ACL_READ))
+
+	own := &storage.ACL{
+		Permission: storage.ACL_OWN,
+		Type:       &storage.ACL_EntityType{EntityType: "foo"},
+		Scope:      &storage.ACL_ScopeWildcard{ScopeWildcard: storage.ACL_WILDCARD_ALL},
+	}
+	assert.True(t, own.Grants("n1", "foo", "k1", storage.ACL_READ))
+	assert.True(t, own.Grants("n1", "foo", "k1", storage.ACL_WRITE))
+
+	// Unset scope or type grants nothing
+	assert.False(t, (&storage.ACL{Permission: storage.ACL_OWN}).Grants("n1", "foo", "k1", storage.ACL_READ))
+
+	assert.True(t, storage.ACLsGrant([]*storage.ACL{scoped, wildcard}, "n3", "foo", "k1", storage.ACL_READ))
+	assert.False(t, storage.ACLsGrant([]*storage.ACL{scoped, wildcard}, "n3", "foo", "k1", storage.ACL_WRITE))
+	assert.False(t, storage.ACLsGrant(nil, "n1", "foo", "k1", storage.ACL_READ))
+
+	// Only ACLs on all entities of a network grant permissions on the network
+	network := &storage.ACL{
+		Permission: storage.ACL_WRITE,
+		Type:       &storage.ACL_TypeWildcard{TypeWildcard: storage.ACL_WILDCARD_ALL},
+		Scope:      &storage.ACL_ScopeNetworkIDs{ScopeNetworkIDs: &storage.ACL_NetworkIDs{IDs: []string{"n1"}}},
+	}
+	assert.True(t, network.GrantsNetwork("n1", storage.ACL_WRITE))
+	assert.False(t, network.GrantsNetwork("n2", storage.ACL_WRITE))
+	assert.False(t, network.GrantsNetwork("n1", storage.ACL_READ))
+	assert.False(t, scoped.GrantsNetwork("n1", storage.ACL_WRITE))
+	assert.False(t, own.GrantsNetwork("n1", storage.ACL_WRITE))
+	network.IDFilter = []string{"k1"}
+	assert.False(t, network.GrantsNetwork("n1", storage.ACL_WRITE))
+	assert.True(t, storage.ACLsGrantNetwork([]*storage.ACL{scoped, wildcard, own}, "n1", storage.ACL_READ))
+	assert.False(t, storage.ACLsGrantNetwork([]*storage.ACL{scoped, wildcard, own}, "n1", storage.ACL_WRITE))
+}
@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/pkg/errors"
+)
+
+// ErrInvalidPageToken is the cause of the error returned by LoadEntities if
+// the filter's page token was not returned by a previous load.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// pageToken is the serialized form of the (type, key) of the last entity of
+// a page. The next page starts after this entity.
+type pageToken struct {
+	Type string `json:"t"`
+	Key  string `json:"k"`
+}
+
+func encodePageToken(lastID storage.TypeAndKey) (string, error) {
+	marshaled, err := json.Marshal(pageToken{Type: lastID.Type, Key: lastID.Key})
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode page token")
+	}
+	return base64.RawURLEncoding.EncodeToString(marshaled), nil
+}
+
+func decodePageToken(token string) (storage.TypeAndKey, error) {
+	marshaled, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return storage.TypeAndKey{}, errors.Wrap(ErrInvalidPageToken, err.Error())
+	}
+	decoded := pageToken{}
+	if err := json.Unmarshal(marshaled, &decoded); err != nil {
+		return storage.TypeAndKey{}, errors.Wrap(ErrInvalidPageToken, err.Error())
+	}
+	return storage.TypeAndKey{Type: decoded.Type, Key: decoded.Key}, nil
+}
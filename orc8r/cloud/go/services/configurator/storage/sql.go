@@ -31,6 +31,15 @@ const (
 	entityTable      = "cfg_entities"
 	entityAssocTable = "cfg_assocs"
 	entityAclTable   = "cfg_acls"
+	entityLabelTable = "cfg_entity_labels"
+
+	networkRevisionTable = "cfg_network_revisions"
+	entityRevisionTable  = "cfg_entity_revisions"
+
+	auditEventTable = "cfg_audit_events"
+
+	changeSequenceTable = "cfg_change_sequences"
+	entityChangeTable   = "cfg_entity_changes"
 )
 
 const (
@@ -55,6 +64,10 @@ const (
 	entConfCol = "config"
 	entVerCol  = "version"
 
+	lblEntCol = "entity_pk"
+	lblKeyCol = "\"key\""
+	lblValCol = "value"
+
 	aFrCol = "from_pk"
 	aToCol = "to_pk"
 
@@ -65,8 +78,41 @@ const (
 	aclTypeCol     = "type"
 	aclIdFilterCol = "id_filter"
 	aclVerCol      = "version"
+
+	nwrIDCol       = "network_id"
+	nwrVerCol      = "version"
+	nwrConfsCol    = "configs"
+	nwrReplacedCol = "replaced_at"
+
+	entrPkCol       = "entity_pk"
+	entrVerCol      = "version"
+	entrConfCol     = "config"
+	entrReplacedCol = "replaced_at"
+
+	auditIDCol        = "id"
+	auditNidCol       = "network_id"
+	auditEntTypeCol   = "entity_type"
+	auditEntKeyCol    = "entity_key"
+	auditActionCol    = "action"
+	auditOperatorCol  = "operator"
+	auditTimestampCol = "timestamp"
+	auditBeforeCol    = "before_digest"
+	auditAfterCol     = "after_digest"
+
+	chgsNidCol    = "network_id"
+	chgsSeqCol    = "sequence"
+	chgsMinSeqCol = "min_sequence"
+
+	chgNidCol  = "network_id"
+	chgSeqCol  = "sequence"
+	chgTypeCol = "type"
+	chgKeyCol  = "\"key\""
 )
 
+// DefaultMaxRevisions is the number of previous versions of each network's
+// configs and each entity's config which are retained by default.
+const DefaultMaxRevisions = 10
+
 type IDGenerator interface {
 	New() string
 }
@@ -78,15 +124,26 @@ func (*DefaultIDGenerator) New() string {
 }
 
 // NewSQLConfiguratorStorageFactory returns a ConfiguratorStorageFactory
-// implementation backed by a SQL database.
+// implementation backed by a SQL database, which retains the
+// DefaultMaxRevisions previous versions of configs.
 func NewSQLConfiguratorStorageFactory(db *sql.DB, generator IDGenerator, sqlBuilder sqorc.StatementBuilder) ConfiguratorStorageFactory {
-	return &sqlConfiguratorStorageFactory{db: db, idGenerator: generator, builder: sqlBuilder}
+	return NewSQLConfiguratorStorageFactoryWithMaxRevisions(db, generator, sqlBuilder, DefaultMaxRevisions)
+}
+
+// NewSQLConfiguratorStorageFactoryWithMaxRevisions returns a
+// ConfiguratorStorageFactory implementation backed by a SQL database, which
+// retains up to maxRevisions previous versions of each network's configs and
+// each entity's config. Previous versions aren't retained if maxRevisions is
+// not positive.
+func NewSQLConfiguratorStorageFactoryWithMaxRevisions(db *sql.DB, generator IDGenerator, sqlBuilder sqorc.StatementBuilder, maxRevisions int) ConfiguratorStorageFactory {
+	return &sqlConfiguratorStorageFactory{db: db, idGenerator: generator, builder: sqlBuilder, maxRevisions: maxRevisions}
 }
 
 type sqlConfiguratorStorageFactory struct {
-	db          *sql.DB
-	idGenerator IDGenerator
-	builder     sqorc.StatementBuilder
+	db           *sql.DB
+	idGenerator  IDGenerator
+	builder      sqorc.StatementBuilder
+	maxRevisions int
 }
 
 func (fact *sqlConfiguratorStorageFactory) InitializeServiceStorage() (err error) {
@@ -184,6 +241,18 @@ func (fact *sqlConfiguratorStorageFactory) InitializeServiceStorage() (err error
 		return
 	}
 
+	// Paginated entity loads are ordered by (type, key) within a network
+	_, err = fact.builder.CreateIndex("cfg_entities_type_key_idx").
+		IfNotExists().
+		On(entityTable).
+		Columns(entNidCol, entTypeCol, entKeyCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create entity type and key index")
+		return
+	}
+
 	_, err = fact.builder.CreateTable(entityAssocTable).
 		IfNotExists().
 		Column(aFrCol).Type(sqorc.ColumnTypeText).EndColumn().
@@ -215,6 +284,20 @@ func (fact *sqlConfiguratorStorageFactory) InitializeServiceStorage() (err error
 		return
 	}
 
+	_, err = fact.builder.CreateTable(entityLabelTable).
+		IfNotExists().
+		Column(lblEntCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(lblKeyCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(lblValCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		PrimaryKey(lblEntCol, lblKeyCol).
+		ForeignKey(entityTable, map[string]string{lblEntCol: entPkCol}, sqorc.ColumnOnDeleteCascade).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create entity label table")
+		return
+	}
+
 	// Create indexes (index is not implicitly created on a referencing FK)
 	_, err = fact.builder.CreateIndex("graph_id_idx").
 		IfNotExists().
@@ -238,6 +321,120 @@ func (fact *sqlConfiguratorStorageFactory) InitializeServiceStorage() (err error
 		return
 	}
 
+	// Entities are searched by label (key, value) pairs
+	_, err = fact.builder.CreateIndex("cfg_entity_labels_key_value_idx").
+		IfNotExists().
+		On(entityLabelTable).
+		Columns(lblKeyCol, lblValCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create entity label index")
+		return
+	}
+
+	// Previous versions of configs. Revisions are deleted along with their
+	// network or entity.
+	_, err = fact.builder.CreateTable(networkRevisionTable).
+		IfNotExists().
+		Column(nwrIDCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(nwrVerCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(nwrConfsCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(nwrReplacedCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		PrimaryKey(nwrIDCol, nwrVerCol).
+		ForeignKey(networksTable, map[string]string{nwrIDCol: nwIDCol}, sqorc.ColumnOnDeleteCascade).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create network revisions table")
+		return
+	}
+
+	_, err = fact.builder.CreateTable(entityRevisionTable).
+		IfNotExists().
+		Column(entrPkCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(entrVerCol).Type(sqorc.ColumnTypeInt).NotNull().EndColumn().
+		Column(entrConfCol).Type(sqorc.ColumnTypeBytes).EndColumn().
+		Column(entrReplacedCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		PrimaryKey(entrPkCol, entrVerCol).
+		ForeignKey(entityTable, map[string]string{entrPkCol: entPkCol}, sqorc.ColumnOnDeleteCascade).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create entity revisions table")
+		return
+	}
+
+	// Audit events outlive the networks and entities they're about, so
+	// there are no foreign keys
+	_, err = fact.builder.CreateTable(auditEventTable).
+		IfNotExists().
+		Column(auditIDCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(auditNidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(auditEntTypeCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(auditEntKeyCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(auditActionCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(auditOperatorCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(auditTimestampCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(auditBeforeCol).Type(sqorc.ColumnTypeText).EndColumn().
+		Column(auditAfterCol).Type(sqorc.ColumnTypeText).EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create audit events table")
+		return
+	}
+
+	_, err = fact.builder.CreateIndex("audit_nid_ts_idx").
+		IfNotExists().
+		On(auditEventTable).
+		Columns(auditNidCol, auditTimestampCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create audit network ID index")
+		return
+	}
+
+	_, err = fact.builder.CreateIndex("audit_ts_idx").
+		IfNotExists().
+		On(auditEventTable).
+		Columns(auditTimestampCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create audit timestamp index")
+		return
+	}
+
+	// Change sequences are kept when their network is deleted, so there is
+	// no foreign key
+	_, err = fact.builder.CreateTable(changeSequenceTable).
+		IfNotExists().
+		Column(chgsNidCol).Type(sqorc.ColumnTypeText).PrimaryKey().EndColumn().
+		Column(chgsSeqCol).Type(sqorc.ColumnTypeBigInt).NotNull().Default(0).EndColumn().
+		Column(chgsMinSeqCol).Type(sqorc.ColumnTypeBigInt).NotNull().Default(0).EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create change sequences table")
+		return
+	}
+
+	_, err = fact.builder.CreateTable(entityChangeTable).
+		IfNotExists().
+		Column(chgNidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(chgSeqCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		Column(chgTypeCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(chgKeyCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		PrimaryKey(chgNidCol, chgSeqCol, chgTypeCol, chgKeyCol).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		err = errors.Wrap(err, "failed to create entity changes table")
+		return
+	}
+
 	// Create internal network(s)
 	_, err = fact.builder.Insert(networksTable).
 		Columns(nwIDCol, nwTypeCol, nwNameCol, nwDescCol).
@@ -258,7 +455,13 @@ func (fact *sqlConfiguratorStorageFactory) StartTransaction(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	return &sqlConfiguratorStorage{tx: tx, idGenerator: fact.idGenerator, builder: fact.builder}, nil
+	return &sqlConfiguratorStorage{
+		tx:              tx,
+		idGenerator:     fact.idGenerator,
+		builder:         fact.builder,
+		maxRevisions:    fact.maxRevisions,
+		changeSequences: map[string]uint64{},
+	}, nil
 }
 
 func getSqlOpts(opts *storage.TxOptions) *sql.TxOptions {
@@ -272,9 +475,13 @@ func getSqlOpts(opts *storage.TxOptions) *sql.TxOptions {
 }
 
 type sqlConfiguratorStorage struct {
-	tx          *sql.Tx
-	idGenerator IDGenerator
-	builder     sqorc.StatementBuilder
+	tx           *sql.Tx
+	idGenerator  IDGenerator
+	builder      sqorc.StatementBuilder
+	maxRevisions int
+	// changeSequences holds the change sequences incremented in this
+	// transaction, keyed by network ID
+	changeSequences map[string]uint64
 }
 
 func (store *sqlConfiguratorStorage) Commit() error {
@@ -418,9 +625,18 @@ func (store *sqlConfiguratorStorage) UpdateNetworks(updates []NetworkUpdateCrite
 		if err != nil {
 			return errors.WithStack(err)
 		}
+		err = store.recordEntityChanges(update.ID, []storage.TypeAndKey{networkChangeID})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	err := store.resetChanges(networksToDelete)
+	if err != nil {
+		return errors.WithStack(err)
 	}
 
-	_, err := store.builder.Delete(networkConfigTable).Where(sq.Eq{nwcIDCol: networksToDelete}).
+	_, err = store.builder.Delete(networkConfigTable).Where(sq.Eq{nwcIDCol: networksToDelete}).
 		RunWith(store.tx).
 		Exec()
 	if err != nil {
@@ -439,7 +655,7 @@ func (store *sqlConfiguratorStorage) LoadEntities(networkID string, filter Entit
 	ret := EntityLoadResult{Entities: []*NetworkEntity{}, EntitiesNotFound: []*EntityID{}}
 
 	// We load the requested entities in 3 steps:
-	// First, we load the entities and their ACLs
+	// First, we load the entities and their ACLs, and their labels if requested
 	// Then, we load assocs if requested by the load criteria. Note that the
 	// load criteria can specify to load edges to and/or from the requested
 	// entities.
@@ -449,10 +665,16 @@ func (store *sqlConfiguratorStorage) LoadEntities(networkID string, filter Entit
 	// be smart here and only load (type, key) for PKs which we don't know.
 	// Finally, we will update the entity objects to return with their edges.
 
-	entsByPk, err := store.loadFromEntitiesTable(networkID, filter, loadCriteria)
+	entsByPk, nextPageToken, err := store.loadFromEntitiesTable(networkID, filter, loadCriteria)
 	if err != nil {
 		return ret, err
 	}
+	if loadCriteria.LoadLabels {
+		err = store.loadFromLabelsTable(entsByPk)
+		if err != nil {
+			return ret, err
+		}
+	}
 	assocs, allAssocPks, err := store.loadFromAssocsTable(filter, loadCriteria, entsByPk)
 	if err != nil {
 		return ret, err
@@ -471,6 +693,7 @@ func (store *sqlConfiguratorStorage) LoadEntities(networkID string, filter Entit
 		ret.Entities = append(ret.Entities, ent)
 	}
 	ret.EntitiesNotFound = calculateEntitiesNotFound(entsByPk, filter.IDs)
+	ret.NextPageToken = nextPageToken
 
 	// Sort entities for deterministic returns
 	entComparator := func(a, b *NetworkEntity) bool {
@@ -511,6 +734,11 @@ func (store *sqlConfiguratorStorage) CreateEntity(networkID string, entity Netwo
 		return NetworkEntity{}, err
 	}
 
+	err = store.createLabels(createdEntWithPk.pk, createdEntWithPk.Labels)
+	if err != nil {
+		return NetworkEntity{}, err
+	}
+
 	allAssociatedEntsByTk, err := store.createEdges(networkID, createdEntWithPk)
 	if err != nil {
 		return NetworkEntity{}, err
@@ -522,6 +750,15 @@ func (store *sqlConfiguratorStorage) CreateEntity(networkID string, entity Netwo
 	}
 	createdEntWithPk.GraphID = newGraphID
 
+	changedIDs := []storage.TypeAndKey{entity.GetTypeAndKey()}
+	for _, id := range entity.Associations {
+		changedIDs = append(changedIDs, id.ToTypeAndKey())
+	}
+	err = store.recordEntityChanges(networkID, changedIDs)
+	if err != nil {
+		return NetworkEntity{}, err
+	}
+
 	// If we were given duplicate edges, get rid of those
 	if !funk.IsEmpty(createdEntWithPk.Associations) {
 		createdEntWithPk.Associations = funk.Chain(createdEntWithPk.Associations).
@@ -546,8 +783,15 @@ func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update Entit
 	}
 
 	if update.DeleteEntity {
+		// The entities associated with the deleted entity in either
+		// direction lose an association
+		associatedIDs, err := store.loadAssociatedIDs(entToUpdate.pk, true)
+		if err != nil {
+			return emptyRet, errors.WithStack(err)
+		}
+
 		// Cascading FK relations in the schema will handle the other tables
-		_, err := store.builder.Delete(entityTable).
+		_, err = store.builder.Delete(entityTable).
 			Where(sq.And{
 				sq.Eq{entNidCol: networkID},
 				sq.Eq{entTypeCol: update.Type},
@@ -565,9 +809,30 @@ func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update Entit
 			return emptyRet, errors.Wrap(err, "failed to fix entity graph after deletion")
 		}
 
+		err = store.recordEntityChanges(networkID, append(associatedIDs, update.GetID().ToTypeAndKey()))
+		if err != nil {
+			return emptyRet, errors.WithStack(err)
+		}
 		return emptyRet, nil
 	}
 
+	// The entities whose associations to set replace lose an association
+	var replacedIDs []storage.TypeAndKey
+	if update.AssociationsToSet != nil {
+		replacedIDs, err = store.loadAssociatedIDs(entToUpdate.pk, false)
+		if err != nil {
+			return emptyRet, errors.WithStack(err)
+		}
+	}
+
+	// Retain the current config before it's overwritten
+	if update.NewConfig != nil {
+		err = store.recordEntityRevision(entToUpdate.pk, entToUpdate.Version)
+		if err != nil {
+			return emptyRet, errors.WithStack(err)
+		}
+	}
+
 	// Then, update the fields on the entity table
 	entToUpdate.NetworkID = networkID
 	err = store.processEntityFieldsUpdate(entToUpdate.pk, update, &entToUpdate.NetworkEntity)
@@ -581,12 +846,23 @@ func (store *sqlConfiguratorStorage) UpdateEntity(networkID string, update Entit
 		return entToUpdate.NetworkEntity, errors.WithStack(err)
 	}
 
+	// Then, update labels
+	err = store.processLabelUpdates(entToUpdate.pk, update, &entToUpdate.NetworkEntity)
+	if err != nil {
+		return entToUpdate.NetworkEntity, errors.WithStack(err)
+	}
+
 	// Finally, process edge updates for the graph
 	err = store.processEdgeUpdates(networkID, update, entToUpdate)
 	if err != nil {
 		return entToUpdate.NetworkEntity, errors.WithStack(err)
 	}
 
+	err = store.recordEntityChanges(networkID, getUpdateChangedIDs(update, replacedIDs))
+	if err != nil {
+		return entToUpdate.NetworkEntity, errors.WithStack(err)
+	}
+
 	return entToUpdate.NetworkEntity, nil
 }
 
@@ -598,7 +874,7 @@ func (store *sqlConfiguratorStorage) LoadGraphForEntity(networkID string, entity
 
 	// We just care about getting the graph ID off this entity so use an empty
 	// load criteria
-	loadResult, err := store.loadFromEntitiesTable(networkID, EntityLoadFilter{IDs: []*EntityID{&entityID}}, EntityLoadCriteria{})
+	loadResult, _, err := store.loadFromEntitiesTable(networkID, EntityLoadFilter{IDs: []*EntityID{&entityID}}, EntityLoadCriteria{})
 	if err != nil {
 		return EntityGraph{}, errors.Wrap(err, "failed to load entity for graph query")
 	}
@@ -649,3 +925,104 @@ func (store *sqlConfiguratorStorage) LoadGraphForEntity(networkID string, entity
 		Edges:        edges,
 	}, nil
 }
+
+func (store *sqlConfiguratorStorage) LoadNetworkRevisions(networkID string) ([]*NetworkRevision, error) {
+	rows, err := store.builder.Select(nwrVerCol, nwrConfsCol, nwrReplacedCol).
+		From(networkRevisionTable).
+		Where(sq.Eq{nwrIDCol: networkID}).
+		OrderBy(nwrVerCol).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error querying for revisions of network %s", networkID)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "LoadNetworkRevisions")
+
+	return scanNetworkRevisionRows(networkID, rows)
+}
+
+func (store *sqlConfiguratorStorage) LoadEntityRevisions(networkID string, entityID EntityID) ([]*EntityRevision, error) {
+	// SELECT rev.version, rev.config, rev.replaced_at FROM cfg_entity_revisions AS rev
+	// JOIN cfg_entities AS ent ON ent.pk = rev.entity_pk
+	// WHERE (ent.network_id = $1 AND ent.type = $2 AND ent.key = $3)
+	// ORDER BY rev.version
+	rows, err := store.builder.Select(
+		fmt.Sprintf("rev.%s", entrVerCol),
+		fmt.Sprintf("rev.%s", entrConfCol),
+		fmt.Sprintf("rev.%s", entrReplacedCol),
+	).
+		From(fmt.Sprintf("%s AS rev", entityRevisionTable)).
+		Join(fmt.Sprintf("%s AS ent ON ent.%s = rev.%s", entityTable, entPkCol, entrPkCol)).
+		Where(sq.And{
+			sq.Eq{fmt.Sprintf("ent.%s", entNidCol): networkID},
+			sq.Eq{fmt.Sprintf("ent.%s", entTypeCol): entityID.Type},
+			sq.Eq{fmt.Sprintf("ent.%s", entKeyCol): entityID.Key},
+		}).
+		OrderBy(fmt.Sprintf("rev.%s", entrVerCol)).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error querying for revisions of entity %s", entityID.ToTypeAndKey())
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "LoadEntityRevisions")
+
+	return scanEntityRevisionRows(networkID, entityID, rows)
+}
+
+func (store *sqlConfiguratorStorage) CreateAuditEvents(events []*AuditEvent) error {
+	if funk.IsEmpty(events) {
+		return nil
+	}
+
+	insertBuilder := store.builder.Insert(auditEventTable).
+		Columns(auditIDCol, auditNidCol, auditEntTypeCol, auditEntKeyCol, auditActionCol, auditOperatorCol, auditTimestampCol, auditBeforeCol, auditAfterCol)
+	for _, event := range events {
+		var entType, entKey sql.NullString
+		if event.Entity != nil {
+			entType = sql.NullString{String: event.Entity.Type, Valid: true}
+			entKey = sql.NullString{String: event.Entity.Key, Valid: true}
+		}
+		event.Id = uuid.New().String()
+		insertBuilder = insertBuilder.Values(
+			event.Id, event.NetworkID, entType, entKey, event.Action,
+			event.Operator, event.Timestamp, event.BeforeDigest, event.AfterDigest,
+		)
+	}
+	_, err := insertBuilder.RunWith(store.tx).Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to insert audit events")
+	}
+	return nil
+}
+
+func (store *sqlConfiguratorStorage) LoadAuditEvents(filter AuditEventFilter) ([]*AuditEvent, error) {
+	selectBuilder := store.builder.Select(auditIDCol, auditNidCol, auditEntTypeCol, auditEntKeyCol, auditActionCol, auditOperatorCol, auditTimestampCol, auditBeforeCol, auditAfterCol).
+		From(auditEventTable).
+		Where(getLoadAuditEventsWhereClause(filter)).
+		OrderBy(fmt.Sprintf("%s DESC", auditTimestampCol), auditIDCol)
+	if filter.Limit > 0 {
+		selectBuilder = selectBuilder.Limit(uint64(filter.Limit))
+	}
+	rows, err := selectBuilder.RunWith(store.tx).Query()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error querying for audit events of network %s", filter.NetworkID)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "LoadAuditEvents")
+
+	return scanAuditEventRows(rows)
+}
+
+func (store *sqlConfiguratorStorage) DeleteAuditEventsBefore(timestamp int64) (int64, error) {
+	res, err := store.builder.Delete(auditEventTable).
+		Where(sq.Lt{auditTimestampCol: timestamp}).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to delete audit events")
+	}
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to count deleted audit events")
+	}
+	return deleted, nil
+}
@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+func getLoadAuditEventsWhereClause(filter AuditEventFilter) sq.Sqlizer {
+	ret := sq.And{sq.Eq{auditNidCol: filter.NetworkID}}
+	if filter.EntityType != nil {
+		ret = append(ret, sq.Eq{auditEntTypeCol: filter.EntityType.Value})
+	}
+	if filter.Operator != nil {
+		ret = append(ret, sq.Eq{auditOperatorCol: filter.Operator.Value})
+	}
+	if filter.StartTime > 0 {
+		ret = append(ret, sq.GtOrEq{auditTimestampCol: filter.StartTime})
+	}
+	if filter.EndTime > 0 {
+		ret = append(ret, sq.LtOrEq{auditTimestampCol: filter.EndTime})
+	}
+	return ret
+}
+
+func scanAuditEventRows(rows *sql.Rows) ([]*AuditEvent, error) {
+	ret := []*AuditEvent{}
+	for rows.Next() {
+		event := &AuditEvent{}
+		var entType, entKey, operator, before, after sql.NullString
+		err := rows.Scan(&event.Id, &event.NetworkID, &entType, &entKey, &event.Action, &operator, &event.Timestamp, &before, &after)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning audit event row: %s", err)
+		}
+		if entType.Valid {
+			event.Entity = &EntityID{Type: entType.String, Key: nullStringToValue(entKey)}
+		}
+		event.Operator = nullStringToValue(operator)
+		event.BeforeDigest = nullStringToValue(before)
+		event.AfterDigest = nullStringToValue(after)
+		ret = append(ret, event)
+	}
+	return ret, nil
+}
@@ -0,0 +1,274 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// networkChangeID is the ID under which changes to a network itself are
+// recorded in the change log
+var networkChangeID = storage.TypeAndKey{}
+
+// recordEntityChanges records that the entities with the given IDs changed
+// under the network's next change sequence number
+func (store *sqlConfiguratorStorage) recordEntityChanges(networkID string, ids []storage.TypeAndKey) error {
+	if funk.IsEmpty(ids) {
+		return nil
+	}
+	sequence, err := store.nextChangeSequence(networkID)
+	if err != nil {
+		return err
+	}
+
+	insertBuilder := store.builder.Insert(entityChangeTable).
+		Columns(chgNidCol, chgSeqCol, chgTypeCol, chgKeyCol)
+	seen := map[storage.TypeAndKey]bool{}
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		insertBuilder = insertBuilder.Values(networkID, sequence, id.Type, id.Key)
+	}
+	_, err = insertBuilder.RunWith(store.tx).Exec()
+	if err != nil {
+		return errors.Wrapf(err, "failed to record changes of network %s", networkID)
+	}
+	return nil
+}
+
+// nextChangeSequence increments the change sequence of the network and
+// returns the incremented value. Incrementing the sequence locks it until the
+// transaction commits, so sequence numbers are assigned in commit order. The
+// first increment in a transaction also prunes the changes which fall out of
+// retention.
+func (store *sqlConfiguratorStorage) nextChangeSequence(networkID string) (uint64, error) {
+	if sequence, ok := store.changeSequences[networkID]; ok {
+		err := store.incrementChangeSequence(networkID)
+		if err != nil {
+			return 0, err
+		}
+		store.changeSequences[networkID] = sequence + 1
+		return sequence + 1, nil
+	}
+
+	// INSERT INTO cfg_change_sequences (network_id) VALUES ($1) ON CONFLICT DO NOTHING
+	_, err := store.builder.Insert(changeSequenceTable).
+		Columns(chgsNidCol).
+		Values(networkID).
+		OnConflict(nil, chgsNidCol).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to initialize change sequence of network %s", networkID)
+	}
+	err = store.incrementChangeSequence(networkID)
+	if err != nil {
+		return 0, err
+	}
+	sequence, _, err := store.getChangeSequence(networkID)
+	if err != nil {
+		return 0, err
+	}
+	store.changeSequences[networkID] = sequence
+
+	if sequence > EntityChangeRetention {
+		_, err = store.builder.Delete(entityChangeTable).
+			Where(sq.And{
+				sq.Eq{chgNidCol: networkID},
+				sq.LtOrEq{chgSeqCol: sequence - EntityChangeRetention},
+			}).
+			RunWith(store.tx).
+			Exec()
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to prune changes of network %s", networkID)
+		}
+	}
+	return sequence, nil
+}
+
+func (store *sqlConfiguratorStorage) incrementChangeSequence(networkID string) error {
+	_, err := store.builder.Update(changeSequenceTable).
+		Set(chgsSeqCol, sq.Expr(fmt.Sprintf("%s.%s+1", changeSequenceTable, chgsSeqCol))).
+		Where(sq.Eq{chgsNidCol: networkID}).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return errors.Wrapf(err, "failed to increment change sequence of network %s", networkID)
+	}
+	return nil
+}
+
+// getChangeSequence returns the change sequence of the network and the
+// smallest sequence number after which the network's changes are complete.
+// Both are 0 if no change was ever recorded for the network.
+func (store *sqlConfiguratorStorage) getChangeSequence(networkID string) (uint64, uint64, error) {
+	var sequence, minSequence uint64
+	err := store.builder.Select(chgsSeqCol, chgsMinSeqCol).
+		From(changeSequenceTable).
+		Where(sq.Eq{chgsNidCol: networkID}).
+		RunWith(store.tx).
+		QueryRow().
+		Scan(&sequence, &minSequence)
+	if err == sql.ErrNoRows {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to load change sequence of network %s", networkID)
+	}
+	return sequence, minSequence, nil
+}
+
+// resetChanges deletes the recorded changes of deleted networks. Their
+// change sequences are kept and advanced, so changes since a sequence number
+// issued before the deletion can't be loaded if the network is recreated.
+func (store *sqlConfiguratorStorage) resetChanges(networkIDs []string) error {
+	if funk.IsEmpty(networkIDs) {
+		return nil
+	}
+	_, err := store.builder.Delete(entityChangeTable).
+		Where(sq.Eq{chgNidCol: networkIDs}).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to delete changes of networks")
+	}
+	_, err = store.builder.Update(changeSequenceTable).
+		Set(chgsSeqCol, sq.Expr(fmt.Sprintf("%s.%s+1", changeSequenceTable, chgsSeqCol))).
+		Set(chgsMinSeqCol, sq.Expr(fmt.Sprintf("%s.%s+1", changeSequenceTable, chgsSeqCol))).
+		Where(sq.Eq{chgsNidCol: networkIDs}).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to reset change sequences of networks")
+	}
+	for _, networkID := range networkIDs {
+		delete(store.changeSequences, networkID)
+	}
+	return nil
+}
+
+// loadAssociatedIDs returns the IDs of the entities the entity with the
+// given PK has associations to, and if withParents is set, also the IDs of
+// the entities which have associations to it
+func (store *sqlConfiguratorStorage) loadAssociatedIDs(pk string, withParents bool) ([]storage.TypeAndKey, error) {
+	// SELECT ent.type, ent.key FROM cfg_assocs AS a
+	// JOIN cfg_entities AS ent ON (ent.pk = a.to_pk AND a.from_pk = $1) OR (ent.pk = a.from_pk AND a.to_pk = $2)
+	joinClause := sq.Or{
+		sq.And{
+			sq.Expr(fmt.Sprintf("ent.%s = a.%s", entPkCol, aToCol)),
+			sq.Eq{fmt.Sprintf("a.%s", aFrCol): pk},
+		},
+	}
+	if withParents {
+		joinClause = append(joinClause, sq.And{
+			sq.Expr(fmt.Sprintf("ent.%s = a.%s", entPkCol, aFrCol)),
+			sq.Eq{fmt.Sprintf("a.%s", aToCol): pk},
+		})
+	}
+	joinSQL, joinArgs, err := joinClause.ToSql()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	rows, err := store.builder.Select(fmt.Sprintf("ent.%s", entTypeCol), fmt.Sprintf("ent.%s", entKeyCol)).
+		From(fmt.Sprintf("%s AS a", entityAssocTable)).
+		Join(fmt.Sprintf("%s AS ent ON %s", entityTable, joinSQL), joinArgs...).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query for associated entities")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "loadAssociatedIDs")
+
+	var ret []storage.TypeAndKey
+	for rows.Next() {
+		var id storage.TypeAndKey
+		err = rows.Scan(&id.Type, &id.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan associated entity")
+		}
+		ret = append(ret, id)
+	}
+	return ret, rows.Err()
+}
+
+// getUpdateChangedIDs returns the IDs of the entity an update changes and
+// of the entities the update adds or removes associations to. replacedIDs are
+// the IDs of the entities the update's associations to set replace.
+func getUpdateChangedIDs(update EntityUpdateCriteria, replacedIDs []storage.TypeAndKey) []storage.TypeAndKey {
+	ret := append([]storage.TypeAndKey{update.GetID().ToTypeAndKey()}, replacedIDs...)
+	for _, id := range update.getEdgesToCreate() {
+		ret = append(ret, id.ToTypeAndKey())
+	}
+	for _, id := range update.AssociationsToDelete {
+		ret = append(ret, id.ToTypeAndKey())
+	}
+	return ret
+}
+
+func (store *sqlConfiguratorStorage) LoadEntityChanges(networkID string, since *uint64) (*EntityChanges, error) {
+	sequence, minSequence, err := store.getChangeSequence(networkID)
+	if err != nil {
+		return nil, err
+	}
+	ret := &EntityChanges{Sequence: sequence, Entities: []*EntityID{}}
+	if since == nil || *since == sequence {
+		return ret, nil
+	}
+	if *since > sequence || *since < minSequence || sequence-*since > EntityChangeRetention {
+		return nil, ErrChangesUnavailable
+	}
+
+	// SELECT DISTINCT type, key FROM cfg_entity_changes
+	// WHERE network_id = $1 AND sequence > $2 ORDER BY type, key LIMIT $3
+	rows, err := store.builder.Select(chgTypeCol, chgKeyCol).
+		Distinct().
+		From(entityChangeTable).
+		Where(sq.And{
+			sq.Eq{chgNidCol: networkID},
+			sq.Gt{chgSeqCol: *since},
+		}).
+		OrderBy(chgTypeCol, chgKeyCol).
+		Limit(MaxLoadedEntityChanges + 1).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query for changes of network %s", networkID)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "LoadEntityChanges")
+
+	for rows.Next() {
+		var id storage.TypeAndKey
+		err = rows.Scan(&id.Type, &id.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan change")
+		}
+		if id == networkChangeID {
+			ret.NetworkChanged = true
+			continue
+		}
+		ret.Entities = append(ret.Entities, &EntityID{Type: id.Type, Key: id.Key})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to load changes")
+	}
+	if len(ret.Entities) > MaxLoadedEntityChanges {
+		return nil, ErrChangesUnavailable
+	}
+	return ret, nil
+}
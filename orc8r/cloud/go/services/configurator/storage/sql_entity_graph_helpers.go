@@ -26,13 +26,19 @@ type internalEntityGraph struct {
 // This function will NOT fill entities with associations.
 func (store *sqlConfiguratorStorage) loadGraphInternal(networkID string, graphID string, criteria EntityLoadCriteria) (internalEntityGraph, error) {
 	loadFilter := EntityLoadFilter{GraphID: &wrappers.StringValue{Value: graphID}}
-	entsByPk, err := store.loadFromEntitiesTable(networkID, loadFilter, criteria)
+	entsByPk, _, err := store.loadFromEntitiesTable(networkID, loadFilter, criteria)
 	if err != nil {
 		return internalEntityGraph{}, errors.Wrap(err, "failed to load entities for graph")
 	}
 	if funk.IsEmpty(entsByPk) {
 		return internalEntityGraph{}, nil
 	}
+	if criteria.LoadLabels {
+		err = store.loadFromLabelsTable(entsByPk)
+		if err != nil {
+			return internalEntityGraph{}, errors.Wrap(err, "failed to load labels for graph")
+		}
+	}
 
 	// always load all edges for a graph load
 	criteria.LoadAssocsFromThis, criteria.LoadAssocsToThis = true, true
@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"magma/orc8r/cloud/go/sqorc"
 	"magma/orc8r/cloud/go/storage"
@@ -23,14 +24,31 @@ import (
 	"github.com/thoas/go-funk"
 )
 
-func (store *sqlConfiguratorStorage) loadFromEntitiesTable(networkID string, filter EntityLoadFilter, criteria EntityLoadCriteria) (map[string]*NetworkEntity, error) {
+func (store *sqlConfiguratorStorage) loadFromEntitiesTable(networkID string, filter EntityLoadFilter, criteria EntityLoadCriteria) (map[string]*NetworkEntity, string, error) {
 	// Pointer values because we're modifying entities in-place with ACLs (LEFT JOIN)
 	entsByPk := map[string]*NetworkEntity{}
 
-	selectBuilder := store.getLoadEntitiesSelectBuilder(networkID, filter, criteria)
+	// The ACL LEFT JOIN can return multiple rows per entity, so we can't
+	// LIMIT the entity query directly. Instead we load the PKs of the page
+	// first, then load the entities with those PKs.
+	whereClause := getLoadEntitiesWhereClause(networkID, filter)
+	nextPageToken := ""
+	if filter.IsPaginated() {
+		pagePks, token, err := store.loadEntityPagePks(whereClause, filter)
+		if err != nil {
+			return entsByPk, "", err
+		}
+		if len(pagePks) == 0 {
+			return entsByPk, "", nil
+		}
+		whereClause = sq.Eq{fmt.Sprintf("ent.%s", entPkCol): pagePks}
+		nextPageToken = token
+	}
+
+	selectBuilder := store.getLoadEntitiesSelectBuilder(whereClause, criteria)
 	rows, err := selectBuilder.RunWith(store.tx).Query()
 	if err != nil {
-		return entsByPk, errors.Wrap(err, "error querying for entities")
+		return entsByPk, "", errors.Wrap(err, "error querying for entities")
 	}
 	defer func() {
 		if err := rows.Close(); err != nil {
@@ -41,24 +59,65 @@ func (store *sqlConfiguratorStorage) loadFromEntitiesTable(networkID string, fil
 	for rows.Next() {
 		err = scanNextEntityRow(rows, criteria, entsByPk)
 		if err != nil {
-			return entsByPk, err
+			return entsByPk, "", err
 		}
 	}
-	return entsByPk, nil
+	return entsByPk, nextPageToken, nil
 }
 
-func (store *sqlConfiguratorStorage) getLoadEntitiesSelectBuilder(networkID string, filter EntityLoadFilter, criteria EntityLoadCriteria) sq.SelectBuilder {
-	// SELECT ent.pk, ent.key, ent.type, ent.physical_id, ent.version, graph.graph_id, ent.name, ent.description, ent.config,
-	// [[ acl.id, acl.scope, acl.permission, acl.type, acl.id_filter, acl.version ]]
-	// FROM cfg_entities AS ent
-	// [[ LEFT JOIN cfg_acls AS acl ON acl.entity_pk = ent.pk ]]
-	// [[ WHERE (ent.network_id = $1 AND ent.key = $2 AND ent.type = $3) OR (ent.network_id ...) ... ]]
-	selectBuilder := store.builder.Select(getLoadEntitiesColumns(criteria)...).
-		From(fmt.Sprintf("%s AS ent", entityTable))
-	if criteria.LoadPermissions {
-		selectBuilder = selectBuilder.LeftJoin(fmt.Sprintf("%s AS acl ON acl.%s = ent.%s", entityAclTable, aclEntCol, entPkCol))
+// loadEntityPagePks returns the PKs of the page of entities matching the
+// where clause which is specified by the filter's page size and token, along
+// with the token of the next page.
+func (store *sqlConfiguratorStorage) loadEntityPagePks(whereClause sq.Sqlizer, filter EntityLoadFilter) ([]string, string, error) {
+	// SELECT ent.pk, ent.type, ent.key FROM cfg_entities AS ent
+	// WHERE ... [[ AND (ent.type > $1 OR (ent.type = $1 AND ent.key > $2)) ]]
+	// ORDER BY ent.type, ent.key
+	// LIMIT {page_size + 1}
+	typeCol, keyCol := fmt.Sprintf("ent.%s", entTypeCol), fmt.Sprintf("ent.%s", entKeyCol)
+	andClause := sq.And{whereClause}
+	if filter.PageToken != "" {
+		after, err := decodePageToken(filter.PageToken)
+		if err != nil {
+			return nil, "", err
+		}
+		andClause = append(andClause, sq.Or{
+			sq.Gt{typeCol: after.Type},
+			sq.And{sq.Eq{typeCol: after.Type}, sq.Gt{keyCol: after.Key}},
+		})
 	}
 
+	// Load one more than the page size to know whether there's a next page
+	rows, err := store.builder.Select(fmt.Sprintf("ent.%s", entPkCol), typeCol, keyCol).
+		From(fmt.Sprintf("%s AS ent", entityTable)).
+		Where(andClause).
+		OrderBy(typeCol, keyCol).
+		Limit(uint64(filter.PageSize) + 1).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return nil, "", errors.Wrap(err, "error querying for entity page")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "LoadEntities")
+
+	var pks []string
+	var lastID storage.TypeAndKey
+	for rows.Next() {
+		var pk string
+		var id storage.TypeAndKey
+		if err := rows.Scan(&pk, &id.Type, &id.Key); err != nil {
+			return nil, "", errors.Wrap(err, "error scanning entity page row")
+		}
+		if uint32(len(pks)) == filter.PageSize {
+			token, err := encodePageToken(lastID)
+			return pks, token, err
+		}
+		pks = append(pks, pk)
+		lastID = id
+	}
+	return pks, "", nil
+}
+
+func getLoadEntitiesWhereClause(networkID string, filter EntityLoadFilter) sq.Sqlizer {
 	// The WHERE has ORs if specific IDs are provided
 	if !funk.IsEmpty(filter.IDs) {
 		orClause := make(sq.Or, 0, len(filter.IDs))
@@ -69,25 +128,82 @@ func (store *sqlConfiguratorStorage) getLoadEntitiesSelectBuilder(networkID stri
 				sq.Eq{fmt.Sprintf("ent.%s", entTypeCol): id.Type},
 			})
 		})
-		selectBuilder = selectBuilder.Where(orClause)
-	} else {
-		if filter.PhysicalID != nil {
-			selectBuilder = selectBuilder.Where(sq.Eq{fmt.Sprintf("ent.%s", entPidCol): filter.PhysicalID.Value})
-		} else if filter.GraphID != nil {
-			selectBuilder = selectBuilder.Where(sq.Eq{fmt.Sprintf("ent.%s", entGidCol): filter.GraphID.Value})
-		} else {
-			andClause := sq.And{sq.Eq{fmt.Sprintf("ent.%s", entNidCol): networkID}}
-			if filter.KeyFilter != nil {
-				andClause = append(andClause, sq.Eq{fmt.Sprintf("ent.%s", entKeyCol): filter.KeyFilter.Value})
-			}
-			if filter.TypeFilter != nil {
-				andClause = append(andClause, sq.Eq{fmt.Sprintf("ent.%s", entTypeCol): filter.TypeFilter.Value})
-			}
-			selectBuilder = selectBuilder.Where(andClause)
+		return orClause
+	}
+	if filter.PhysicalID != nil {
+		return sq.Eq{fmt.Sprintf("ent.%s", entPidCol): filter.PhysicalID.Value}
+	}
+	if filter.GraphID != nil {
+		return sq.Eq{fmt.Sprintf("ent.%s", entGidCol): filter.GraphID.Value}
+	}
+
+	andClause := sq.And{sq.Eq{fmt.Sprintf("ent.%s", entNidCol): networkID}}
+	if filter.KeyFilter != nil {
+		andClause = append(andClause, sq.Eq{fmt.Sprintf("ent.%s", entKeyCol): filter.KeyFilter.Value})
+	}
+	if filter.TypeFilter != nil {
+		andClause = append(andClause, sq.Eq{fmt.Sprintf("ent.%s", entTypeCol): filter.TypeFilter.Value})
+	}
+	if filter.KeyPrefix != nil && filter.KeyPrefix.Value != "" {
+		// SUBSTR instead of LIKE to avoid escaping wildcards in the prefix
+		// and sqlite's case-insensitive LIKE
+		prefix := filter.KeyPrefix.Value
+		andClause = append(andClause, sq.Expr(
+			fmt.Sprintf("SUBSTR(ent.%s, 1, ?) = ?", entKeyCol),
+			utf8.RuneCountInString(prefix), prefix,
+		))
+	}
+	if !funk.IsEmpty(filter.Types) {
+		andClause = append(andClause, sq.Eq{fmt.Sprintf("ent.%s", entTypeCol): filter.Types})
+	}
+	if filter.SearchText != nil && filter.SearchText.Value != "" {
+		andClause = append(andClause, getSearchTextClause(filter.SearchText.Value))
+	}
+	if !funk.IsEmpty(filter.Labels) {
+		// Sort label keys for deterministic queries
+		labelKeys := funk.Keys(filter.Labels).([]string)
+		sort.Strings(labelKeys)
+		for _, labelKey := range labelKeys {
+			andClause = append(andClause, sq.Expr(
+				fmt.Sprintf(
+					"ent.%s IN (SELECT %s FROM %s WHERE %s = ? AND %s = ?)",
+					entPkCol, lblEntCol, entityLabelTable, lblKeyCol, lblValCol,
+				),
+				labelKey, filter.Labels[labelKey],
+			))
 		}
 	}
+	return andClause
+}
 
-	return selectBuilder
+// getSearchTextClause matches entities whose key, name, description, or
+// physical ID contains the text, case-insensitively. Substring matches can't
+// use an index, so searches scan the network's entities.
+func getSearchTextClause(text string) sq.Sqlizer {
+	// Escape LIKE wildcards in the text. sqlite has no default escape
+	// character so it has to be specified explicitly.
+	pattern := "%" + likeEscaper.Replace(strings.ToLower(text)) + "%"
+	orClause := sq.Or{}
+	for _, col := range []string{entKeyCol, entNameCol, entDescCol, entPidCol} {
+		orClause = append(orClause, sq.Expr(fmt.Sprintf("LOWER(ent.%s) LIKE ? ESCAPE '\\'", col), pattern))
+	}
+	return orClause
+}
+
+var likeEscaper = strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_")
+
+func (store *sqlConfiguratorStorage) getLoadEntitiesSelectBuilder(whereClause sq.Sqlizer, criteria EntityLoadCriteria) sq.SelectBuilder {
+	// SELECT ent.pk, ent.key, ent.type, ent.physical_id, ent.version, graph.graph_id, ent.name, ent.description, ent.config,
+	// [[ acl.id, acl.scope, acl.permission, acl.type, acl.id_filter, acl.version ]]
+	// FROM cfg_entities AS ent
+	// [[ LEFT JOIN cfg_acls AS acl ON acl.entity_pk = ent.pk ]]
+	// [[ WHERE (ent.network_id = $1 AND ent.key = $2 AND ent.type = $3) OR (ent.network_id ...) ... ]]
+	selectBuilder := store.builder.Select(getLoadEntitiesColumns(criteria)...).
+		From(fmt.Sprintf("%s AS ent", entityTable))
+	if criteria.LoadPermissions {
+		selectBuilder = selectBuilder.LeftJoin(fmt.Sprintf("%s AS acl ON acl.%s = ent.%s", entityAclTable, aclEntCol, entPkCol))
+	}
+	return selectBuilder.Where(whereClause)
 }
 
 func getLoadEntitiesColumns(criteria EntityLoadCriteria) []string {
@@ -192,6 +308,45 @@ func scanNextEntityRow(rows *sql.Rows, criteria EntityLoadCriteria, existingEnts
 	return nil
 }
 
+// loadFromLabelsTable fills the loaded entities with their labels.
+// entsByPk is an output parameter.
+func (store *sqlConfiguratorStorage) loadFromLabelsTable(entsByPk map[string]*NetworkEntity) error {
+	if len(entsByPk) == 0 {
+		return nil
+	}
+	entPks := funk.Keys(entsByPk).([]string)
+	sort.Strings(entPks)
+
+	// SELECT entity_pk, key, value FROM cfg_entity_labels
+	// WHERE entity_pk IN ($1, $2, ...)
+	rows, err := store.builder.Select(lblEntCol, lblKeyCol, lblValCol).
+		From(entityLabelTable).
+		Where(sq.Eq{lblEntCol: entPks}).
+		RunWith(store.tx).
+		Query()
+	if err != nil {
+		return errors.Wrap(err, "error querying for labels")
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "LoadEntities")
+
+	for rows.Next() {
+		var pk, key, value string
+		err := rows.Scan(&pk, &key, &value)
+		if err != nil {
+			return errors.Wrap(err, "error scanning label row")
+		}
+		ent, exists := entsByPk[pk]
+		if !exists {
+			continue
+		}
+		if ent.Labels == nil {
+			ent.Labels = map[string]string{}
+		}
+		ent.Labels[key] = value
+	}
+	return nil
+}
+
 func deserializeACLScope(aclScope string) isACL_Scope {
 	if aclScope == ACL_WILDCARD_ALL.String() {
 		return &ACL_ScopeWildcard{ScopeWildcard: ACL_WILDCARD_ALL}
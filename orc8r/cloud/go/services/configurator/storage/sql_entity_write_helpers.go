@@ -157,7 +157,7 @@ func (store *sqlConfiguratorStorage) loadEntsWithPksByTK(networkID string, tksTo
 			id.FromTypeAndKey(tk)
 			return id
 		}).Value().([]*EntityID)
-	loadedEntsByPk, err := store.loadFromEntitiesTable(networkID, EntityLoadFilter{IDs: uniqIDsToLoad}, EntityLoadCriteria{})
+	loadedEntsByPk, _, err := store.loadFromEntitiesTable(networkID, EntityLoadFilter{IDs: uniqIDsToLoad}, EntityLoadCriteria{})
 	if err != nil {
 		return ret, errors.WithStack(err)
 	}
@@ -218,7 +218,7 @@ func (store *sqlConfiguratorStorage) mergeGraphs(createdEntity entWithPk, allAss
 }
 
 func (store *sqlConfiguratorStorage) loadEntToUpdate(networkID string, update EntityUpdateCriteria) (*entWithPk, error) {
-	loadedEntByPk, err := store.loadFromEntitiesTable(
+	loadedEntByPk, _, err := store.loadFromEntitiesTable(
 		networkID,
 		EntityLoadFilter{IDs: []*EntityID{update.GetID()}},
 		EntityLoadCriteria{},
@@ -241,12 +241,21 @@ func (store *sqlConfiguratorStorage) loadEntToUpdate(networkID string, update En
 
 // entOut is an output parameter
 func (store *sqlConfiguratorStorage) processEntityFieldsUpdate(pk string, update EntityUpdateCriteria, entOut *NetworkEntity) error {
-	_, err := store.getEntityUpdateQueryBuilder(pk, update).
+	res, err := store.getEntityUpdateQueryBuilder(pk, update).
 		RunWith(store.tx).
 		Exec()
 	if err != nil {
 		return errors.Wrap(err, "failed to update entity fields")
 	}
+	if update.ExpectedVersion != nil {
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return errors.Wrap(err, "failed to check updated entity version")
+		}
+		if rowsAffected == 0 {
+			return errors.Wrapf(ErrVersionMismatch, "entity (%s, %s) is not at version %d", update.Type, update.Key, update.ExpectedVersion.Value)
+		}
+	}
 
 	if update.NewName != nil {
 		entOut.Name = (*update.NewName).Value
@@ -292,6 +301,71 @@ func (store *sqlConfiguratorStorage) processPermissionUpdates(networkID string,
 	return nil
 }
 
+func (store *sqlConfiguratorStorage) createLabels(pk string, labels map[string]string) error {
+	if funk.IsEmpty(labels) {
+		return nil
+	}
+
+	// Sort label keys for deterministic behavior
+	labelKeys := funk.Keys(labels).([]string)
+	sort.Strings(labelKeys)
+	insertBuilder := store.builder.Insert(entityLabelTable).
+		Columns(lblEntCol, lblKeyCol, lblValCol)
+	for _, labelKey := range labelKeys {
+		insertBuilder = insertBuilder.Values(pk, labelKey, labels[labelKey])
+	}
+	_, err := insertBuilder.RunWith(store.tx).Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to create labels")
+	}
+	return nil
+}
+
+// entOut is an output parameter
+func (store *sqlConfiguratorStorage) processLabelUpdates(entPk string, update EntityUpdateCriteria, entOut *NetworkEntity) error {
+	// Sort label keys for deterministic behavior on upserts
+	labelKeys := funk.Keys(update.LabelsToAddOrUpdate).([]string)
+	sort.Strings(labelKeys)
+	for _, labelKey := range labelKeys {
+		labelValue := update.LabelsToAddOrUpdate[labelKey]
+
+		// INSERT INTO cfg_entity_labels (entity_pk, key, value) VALUES ($1, $2, $3)
+		// ON CONFLICT (entity_pk, key) DO UPDATE SET value = $4
+		_, err := store.builder.Insert(entityLabelTable).
+			Columns(lblEntCol, lblKeyCol, lblValCol).
+			Values(entPk, labelKey, labelValue).
+			OnConflict(
+				[]sqorc.UpsertValue{{Column: lblValCol, Value: labelValue}},
+				lblEntCol, lblKeyCol,
+			).
+			RunWith(store.tx).
+			Exec()
+		if err != nil {
+			return errors.Wrapf(err, "failed to update label %s", labelKey)
+		}
+	}
+
+	if !funk.IsEmpty(update.LabelsToDelete) {
+		_, err := store.builder.Delete(entityLabelTable).
+			Where(sq.And{
+				sq.Eq{lblEntCol: entPk},
+				sq.Eq{lblKeyCol: update.LabelsToDelete},
+			}).
+			RunWith(store.tx).
+			Exec()
+		if err != nil {
+			return errors.Wrap(err, "failed to delete labels")
+		}
+	}
+
+	if len(labelKeys) == 0 && funk.IsEmpty(update.LabelsToDelete) {
+		return nil
+	}
+	// Updates are merged into the existing labels, so read back the full set
+	entOut.Labels = nil
+	return store.loadFromLabelsTable(map[string]*NetworkEntity{entPk: entOut})
+}
+
 // entToUpdateOut is an output parameter
 func (store *sqlConfiguratorStorage) processEdgeUpdates(networkID string, update EntityUpdateCriteria, entToUpdateOut *entWithPk) error {
 	assocsToSetSpecified := update.AssociationsToSet != nil
@@ -357,6 +431,10 @@ func (store *sqlConfiguratorStorage) getEntityUpdateQueryBuilder(pk string, upda
 	// UPDATE cfg_entities SET (name, description, physical_id, config, version) = ($1, $2, $3, $4, cfg_entities.version + 1)
 	// WHERE pk = $5
 	updateBuilder := store.builder.Update(entityTable).Where(sq.Eq{entPkCol: pk})
+	if update.ExpectedVersion != nil {
+		// Checking the version in the update itself makes the check atomic
+		updateBuilder = updateBuilder.Where(sq.Eq{entVerCol: update.ExpectedVersion.Value})
+	}
 	if update.NewName != nil {
 		updateBuilder = updateBuilder.Set(entNameCol, update.NewName.Value)
 	}
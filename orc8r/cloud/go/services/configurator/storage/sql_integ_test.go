@@ -12,14 +12,18 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/services/configurator/storage"
 	"magma/orc8r/cloud/go/sqorc"
 	orc8rStorage "magma/orc8r/cloud/go/storage"
 
+	"github.com/go-openapi/swag"
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -897,3 +901,533 @@ func TestSqlConfiguratorStorage_Integration(t *testing.T) {
 		allEnts,
 	)
 }
+
+func TestSqlConfiguratorStorage_PaginatedLoad(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder())
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1"})
+	assert.NoError(t, err)
+	// Each entity has 2 ACLs to make sure the ACL join doesn't affect the
+	// page size
+	acls := []*storage.ACL{
+		{
+			Permission: storage.ACL_READ,
+			Type:       &storage.ACL_TypeWildcard{TypeWildcard: storage.ACL_WILDCARD_ALL},
+			Scope:      &storage.ACL_ScopeWildcard{ScopeWildcard: storage.ACL_WILDCARD_ALL},
+		},
+		{
+			Permission: storage.ACL_WRITE,
+			Type:       &storage.ACL_EntityType{EntityType: "foo"},
+			Scope:      &storage.ACL_ScopeWildcard{ScopeWildcard: storage.ACL_WILDCARD_ALL},
+		},
+	}
+	for _, key := range []string{"a1", "a2", "b1", "a3", "a%", "A4"} {
+		_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: key, Permissions: acls})
+		assert.NoError(t, err)
+	}
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "bar", Key: "a0"})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	// loadAllPages loads every page of the filter and returns the keys of
+	// each page
+	loadAllPages := func(filter storage.EntityLoadFilter, criteria storage.EntityLoadCriteria) [][]string {
+		var pages [][]string
+		for {
+			store, err := factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+			assert.NoError(t, err)
+			res, err := store.LoadEntities("n1", filter, criteria)
+			assert.NoError(t, err)
+			assert.NoError(t, store.Commit())
+
+			var keys []string
+			for _, ent := range res.Entities {
+				keys = append(keys, ent.Type+"/"+ent.Key)
+			}
+			pages = append(pages, keys)
+			if res.NextPageToken == "" {
+				return pages
+			}
+			filter.PageToken = res.NextPageToken
+		}
+	}
+
+	assert.Equal(
+		t,
+		[][]string{{"foo/A4", "foo/a%", "foo/a1"}, {"foo/a2", "foo/a3", "foo/b1"}},
+		loadAllPages(storage.EntityLoadFilter{TypeFilter: &wrappers.StringValue{Value: "foo"}, PageSize: 3}, storage.FullEntityLoadCriteria),
+	)
+	assert.Equal(
+		t,
+		[][]string{{"bar/a0", "foo/A4", "foo/a%", "foo/a1"}, {"foo/a2", "foo/a3", "foo/b1"}},
+		loadAllPages(storage.EntityLoadFilter{PageSize: 4}, storage.EntityLoadCriteria{}),
+	)
+	// Key prefix is case-sensitive and doesn't treat % as a wildcard
+	assert.Equal(
+		t,
+		[][]string{{"foo/a%", "foo/a1"}, {"foo/a2", "foo/a3"}},
+		loadAllPages(storage.EntityLoadFilter{TypeFilter: &wrappers.StringValue{Value: "foo"}, KeyPrefix: &wrappers.StringValue{Value: "a"}, PageSize: 2}, storage.EntityLoadCriteria{}),
+	)
+	assert.Equal(
+		t,
+		[][]string{{"foo/a%"}},
+		loadAllPages(storage.EntityLoadFilter{KeyPrefix: &wrappers.StringValue{Value: "a%"}, PageSize: 2}, storage.EntityLoadCriteria{}),
+	)
+	assert.Equal(
+		t,
+		[][]string{nil},
+		loadAllPages(storage.EntityLoadFilter{KeyPrefix: &wrappers.StringValue{Value: "c"}, PageSize: 2}, storage.EntityLoadCriteria{}),
+	)
+
+	// ACLs are loaded for every entity of a page
+	store, err = factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+	assert.NoError(t, err)
+	res, err := store.LoadEntities("n1", storage.EntityLoadFilter{TypeFilter: &wrappers.StringValue{Value: "foo"}, PageSize: 2}, storage.EntityLoadCriteria{LoadPermissions: true})
+	assert.NoError(t, err)
+	assert.Len(t, res.Entities, 2)
+	for _, ent := range res.Entities {
+		assert.Len(t, ent.Permissions, 2)
+	}
+
+	_, err = store.LoadEntities("n1", storage.EntityLoadFilter{PageSize: 2, PageToken: "not a token"}, storage.EntityLoadCriteria{})
+	assert.Equal(t, storage.ErrInvalidPageToken, errors.Cause(err))
+	assert.NoError(t, store.Rollback())
+}
+
+func TestSqlConfiguratorStorage_LabelsAndSearch(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder())
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n2"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{
+		Type: "gw", Key: "gw1", Name: "Rooftop Gateway", PhysicalID: "hw-abc",
+		Labels: map[string]string{"site": "north", "tier": "prod"},
+	})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{
+		Type: "gw", Key: "gw2", Description: "Basement 100% uptime",
+		Labels: map[string]string{"site": "south", "tier": "prod"},
+	})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "sub", Key: "IMSI001", Labels: map[string]string{"site": "north"}})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n2", storage.NetworkEntity{Type: "gw", Key: "gw1", Name: "Rooftop", Labels: map[string]string{"site": "north"}})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	search := func(filter storage.EntityLoadFilter) []string {
+		store, err := factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+		assert.NoError(t, err)
+		res, err := store.LoadEntities("n1", filter, storage.EntityLoadCriteria{})
+		assert.NoError(t, err)
+		assert.NoError(t, store.Commit())
+
+		var keys []string
+		for _, ent := range res.Entities {
+			keys = append(keys, ent.Type+"/"+ent.Key)
+		}
+		return keys
+	}
+
+	// Search text matches the key, name, description, or physical ID,
+	// case-insensitively, and doesn't treat % as a wildcard
+	assert.Equal(t, []string{"gw/gw1"}, search(storage.EntityLoadFilter{SearchText: &wrappers.StringValue{Value: "rooftop"}}))
+	assert.Equal(t, []string{"gw/gw1"}, search(storage.EntityLoadFilter{SearchText: &wrappers.StringValue{Value: "HW-A"}}))
+	assert.Equal(t, []string{"gw/gw2"}, search(storage.EntityLoadFilter{SearchText: &wrappers.StringValue{Value: "0% up"}}))
+	assert.Equal(t, []string{"sub/IMSI001"}, search(storage.EntityLoadFilter{SearchText: &wrappers.StringValue{Value: "imsi"}}))
+	assert.Equal(t, []string(nil), search(storage.EntityLoadFilter{SearchText: &wrappers.StringValue{Value: "1%"}}))
+
+	// Labels have to all match
+	assert.Equal(t, []string{"gw/gw1", "sub/IMSI001"}, search(storage.EntityLoadFilter{Labels: map[string]string{"site": "north"}}))
+	assert.Equal(t, []string{"gw/gw2"}, search(storage.EntityLoadFilter{Labels: map[string]string{"site": "south", "tier": "prod"}}))
+	assert.Equal(t, []string(nil), search(storage.EntityLoadFilter{Labels: map[string]string{"site": "north", "tier": "dev"}}))
+
+	// Types, search text, and labels combine
+	assert.Equal(t, []string{"gw/gw1", "gw/gw2"}, search(storage.EntityLoadFilter{Types: []string{"gw", "tier"}}))
+	assert.Equal(
+		t,
+		[]string{"gw/gw1"},
+		search(storage.EntityLoadFilter{
+			Types:      []string{"gw"},
+			SearchText: &wrappers.StringValue{Value: "gw"},
+			Labels:     map[string]string{"site": "north"},
+		}),
+	)
+
+	// Update and delete labels
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	updated, err := store.UpdateEntity("n1", storage.EntityUpdateCriteria{
+		Type: "gw", Key: "gw1",
+		LabelsToAddOrUpdate: map[string]string{"site": "south", "rack": "3"},
+		LabelsToDelete:      []string{"tier"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"site": "south", "rack": "3"}, updated.Labels)
+
+	// Updates merge into the existing labels
+	updated, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{
+		Type: "gw", Key: "gw2",
+		LabelsToAddOrUpdate: map[string]string{"rack": "7"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"site": "south", "tier": "prod", "rack": "7"}, updated.Labels)
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+	assert.NoError(t, err)
+	res, err := store.LoadEntities("n1", storage.EntityLoadFilter{TypeFilter: &wrappers.StringValue{Value: "gw"}}, storage.EntityLoadCriteria{LoadLabels: true})
+	assert.NoError(t, err)
+	assert.Len(t, res.Entities, 2)
+	assert.Equal(t, map[string]string{"site": "south", "rack": "3"}, res.Entities[0].Labels)
+	assert.Equal(t, map[string]string{"site": "south", "tier": "prod", "rack": "7"}, res.Entities[1].Labels)
+
+	graph, err := store.LoadGraphForEntity("n1", storage.EntityID{Type: "sub", Key: "IMSI001"}, storage.EntityLoadCriteria{LoadLabels: true})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"site": "north"}, graph.Entities[0].Labels)
+	assert.NoError(t, store.Commit())
+	assert.Equal(t, []string{"gw/gw1", "gw/gw2"}, search(storage.EntityLoadFilter{Labels: map[string]string{"site": "south"}}))
+}
+
+func TestSqlConfiguratorStorage_ExpectedVersion(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder())
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1"})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "1", Config: []byte("v0")})
+	assert.NoError(t, err)
+	updated, err := store.UpdateEntity("n1", storage.EntityUpdateCriteria{
+		Type: "foo", Key: "1",
+		NewConfig:       &wrappers.BytesValue{Value: []byte("v1")},
+		ExpectedVersion: &wrappers.UInt64Value{Value: 0},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), updated.Version)
+	assert.NoError(t, store.Commit())
+
+	// The entity has moved on from version 0
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{
+		Type: "foo", Key: "1",
+		NewConfig:       &wrappers.BytesValue{Value: []byte("v2")},
+		ExpectedVersion: &wrappers.UInt64Value{Value: 0},
+	})
+	assert.Equal(t, storage.ErrVersionMismatch, errors.Cause(err))
+	assert.NoError(t, store.Rollback())
+
+	store, err = factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+	assert.NoError(t, err)
+	res, err := store.LoadEntities("n1", storage.EntityLoadFilter{}, storage.EntityLoadCriteria{LoadConfig: true})
+	assert.NoError(t, err)
+	assert.Len(t, res.Entities, 1)
+	assert.Equal(t, []byte("v1"), res.Entities[0].Config)
+	assert.Equal(t, uint64(1), res.Entities[0].Version)
+	assert.NoError(t, store.Commit())
+}
+
+func TestSqlConfiguratorStorage_EntityChanges(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder())
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	loadChanges := func(since *uint64) (*storage.EntityChanges, error) {
+		store, err := factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+		assert.NoError(t, err)
+		defer store.Commit()
+		return store.LoadEntityChanges("n1", since)
+	}
+	write := func(f func(store storage.ConfiguratorStorage) error) {
+		store, err := factory.StartTransaction(context.Background(), nil)
+		assert.NoError(t, err)
+		assert.NoError(t, f(store))
+		assert.NoError(t, store.Commit())
+	}
+	foo := &storage.EntityID{Type: "foo", Key: "1"}
+	bar := &storage.EntityID{Type: "bar", Key: "1"}
+
+	changes, err := loadChanges(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Entities: []*storage.EntityID{}}, changes)
+
+	write(func(store storage.ConfiguratorStorage) error {
+		_, err := store.CreateNetwork(storage.Network{ID: "n1"})
+		assert.NoError(t, err)
+		_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "foo", Key: "1"})
+		assert.NoError(t, err)
+		_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "bar", Key: "1", Associations: []*storage.EntityID{foo}})
+		return err
+	})
+	changes, err = loadChanges(swag.Uint64(0))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Sequence: 2, Entities: []*storage.EntityID{bar, foo}}, changes)
+	// Associating bar to foo changed foo too
+	changes, err = loadChanges(swag.Uint64(1))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Sequence: 2, Entities: []*storage.EntityID{bar, foo}}, changes)
+	changes, err = loadChanges(swag.Uint64(2))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Sequence: 2, Entities: []*storage.EntityID{}}, changes)
+
+	write(func(store storage.ConfiguratorStorage) error {
+		_, err := store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "foo", Key: "1", NewConfig: &wrappers.BytesValue{Value: []byte("v1")}})
+		return err
+	})
+	changes, err = loadChanges(swag.Uint64(2))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Sequence: 3, Entities: []*storage.EntityID{foo}}, changes)
+
+	write(func(store storage.ConfiguratorStorage) error {
+		return store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &wrappers.StringValue{Value: "name"}}})
+	})
+	changes, err = loadChanges(swag.Uint64(3))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Sequence: 4, Entities: []*storage.EntityID{}, NetworkChanged: true}, changes)
+
+	// Deleting bar removes its association to foo
+	write(func(store storage.ConfiguratorStorage) error {
+		_, err := store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "bar", Key: "1", DeleteEntity: true})
+		return err
+	})
+	changes, err = loadChanges(swag.Uint64(4))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Sequence: 5, Entities: []*storage.EntityID{bar, foo}}, changes)
+
+	_, err = loadChanges(swag.Uint64(6))
+	assert.Equal(t, storage.ErrChangesUnavailable, err)
+
+	// Sequence numbers issued before the network was deleted can't be used
+	// with a recreated network
+	write(func(store storage.ConfiguratorStorage) error {
+		return store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", DeleteNetwork: true}})
+	})
+	write(func(store storage.ConfiguratorStorage) error {
+		_, err := store.CreateNetwork(storage.Network{ID: "n1"})
+		return err
+	})
+	_, err = loadChanges(swag.Uint64(5))
+	assert.Equal(t, storage.ErrChangesUnavailable, err)
+	changes, err = loadChanges(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Sequence: 6, Entities: []*storage.EntityID{}}, changes)
+	changes, err = loadChanges(swag.Uint64(6))
+	assert.NoError(t, err)
+	assert.Equal(t, &storage.EntityChanges{Sequence: 6, Entities: []*storage.EntityID{}}, changes)
+}
+
+func TestSqlConfiguratorStorage_Revisions(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	// Retain 2 previous versions
+	factory := storage.NewSQLConfiguratorStorageFactoryWithMaxRevisions(db, &mockIDGenerator{}, sqorc.GetSqlBuilder(), 2)
+	assert.NoError(t, factory.InitializeServiceStorage())
+	clock.SetAndFreezeClock(t, time.Unix(1000, 0))
+	defer clock.UnfreezeClock(t)
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.CreateNetwork(storage.Network{ID: "n1", Configs: map[string][]byte{"foo": []byte("foo0")}})
+	assert.NoError(t, err)
+	_, err = store.CreateEntity("n1", storage.NetworkEntity{Type: "gw", Key: "g1", Config: []byte("g0")})
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+
+	// Updates which don't touch configs don't create revisions
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &wrappers.StringValue{Value: "name"}}}))
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", NewName: &wrappers.StringValue{Value: "name"}})
+	assert.NoError(t, err)
+	networkRevisions, err := store.LoadNetworkRevisions("n1")
+	assert.NoError(t, err)
+	assert.Empty(t, networkRevisions)
+	entityRevisions, err := store.LoadEntityRevisions("n1", storage.EntityID{Type: "gw", Key: "g1"})
+	assert.NoError(t, err)
+	assert.Empty(t, entityRevisions)
+	assert.NoError(t, store.Commit())
+
+	// Update configs 3 times, the oldest revision is pruned
+	for i := 1; i <= 3; i++ {
+		clock.SetAndFreezeClock(t, time.Unix(int64(1000+i), 0))
+		store, err = factory.StartTransaction(context.Background(), nil)
+		assert.NoError(t, err)
+		err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{
+			{
+				ID:                   "n1",
+				ConfigsToAddOrUpdate: map[string][]byte{"bar": []byte(fmt.Sprintf("bar%d", i))},
+				ConfigsToDelete:      []string{"foo"},
+			},
+		})
+		assert.NoError(t, err)
+		_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", NewConfig: &wrappers.BytesValue{Value: []byte(fmt.Sprintf("g%d", i))}})
+		assert.NoError(t, err)
+		assert.NoError(t, store.Commit())
+	}
+
+	store, err = factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+	assert.NoError(t, err)
+	networkRevisions, err = store.LoadNetworkRevisions("n1")
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]*storage.NetworkRevision{
+			{NetworkID: "n1", Version: 2, Configs: map[string][]byte{"bar": []byte("bar1")}, ReplacedAt: 1002},
+			{NetworkID: "n1", Version: 3, Configs: map[string][]byte{"bar": []byte("bar2")}, ReplacedAt: 1003},
+		},
+		networkRevisions,
+	)
+	entityRevisions, err = store.LoadEntityRevisions("n1", storage.EntityID{Type: "gw", Key: "g1"})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]*storage.EntityRevision{
+			{NetworkID: "n1", ID: &storage.EntityID{Type: "gw", Key: "g1"}, Version: 2, Config: []byte("g1"), ReplacedAt: 1002},
+			{NetworkID: "n1", ID: &storage.EntityID{Type: "gw", Key: "g1"}, Version: 3, Config: []byte("g2"), ReplacedAt: 1003},
+		},
+		entityRevisions,
+	)
+	assert.NoError(t, store.Commit())
+
+	// Versions bumped by updates which don't touch configs don't count
+	// against the retained revisions
+	clock.SetAndFreezeClock(t, time.Unix(1004, 0))
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", NewName: &wrappers.StringValue{Value: "name2"}}}))
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", NewName: &wrappers.StringValue{Value: "name2"}})
+	assert.NoError(t, err)
+	err = store.UpdateNetworks([]storage.NetworkUpdateCriteria{
+		{ID: "n1", ConfigsToAddOrUpdate: map[string][]byte{"bar": []byte("bar4")}},
+	})
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", NewConfig: &wrappers.BytesValue{Value: []byte("g4")}})
+	assert.NoError(t, err)
+	networkRevisions, err = store.LoadNetworkRevisions("n1")
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]*storage.NetworkRevision{
+			{NetworkID: "n1", Version: 3, Configs: map[string][]byte{"bar": []byte("bar2")}, ReplacedAt: 1003},
+			{NetworkID: "n1", Version: 5, Configs: map[string][]byte{"bar": []byte("bar3")}, ReplacedAt: 1004},
+		},
+		networkRevisions,
+	)
+	entityRevisions, err = store.LoadEntityRevisions("n1", storage.EntityID{Type: "gw", Key: "g1"})
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]*storage.EntityRevision{
+			{NetworkID: "n1", ID: &storage.EntityID{Type: "gw", Key: "g1"}, Version: 3, Config: []byte("g2"), ReplacedAt: 1003},
+			{NetworkID: "n1", ID: &storage.EntityID{Type: "gw", Key: "g1"}, Version: 5, Config: []byte("g3"), ReplacedAt: 1004},
+		},
+		entityRevisions,
+	)
+	assert.NoError(t, store.Commit())
+
+	// Revisions are deleted with the network and entity
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	_, err = store.UpdateEntity("n1", storage.EntityUpdateCriteria{Type: "gw", Key: "g1", DeleteEntity: true})
+	assert.NoError(t, err)
+	entityRevisions, err = store.LoadEntityRevisions("n1", storage.EntityID{Type: "gw", Key: "g1"})
+	assert.NoError(t, err)
+	assert.Empty(t, entityRevisions)
+	assert.NoError(t, store.UpdateNetworks([]storage.NetworkUpdateCriteria{{ID: "n1", DeleteNetwork: true}}))
+	networkRevisions, err = store.LoadNetworkRevisions("n1")
+	assert.NoError(t, err)
+	assert.Empty(t, networkRevisions)
+	assert.NoError(t, store.Commit())
+}
+
+func TestSqlConfiguratorStorage_AuditEvents(t *testing.T) {
+	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
+	if err != nil {
+		t.Fatalf("Could not initialize sqlite DB: %s", err)
+	}
+	factory := storage.NewSQLConfiguratorStorageFactory(db, &mockIDGenerator{}, sqorc.GetSqlBuilder())
+	assert.NoError(t, factory.InitializeServiceStorage())
+
+	store, err := factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	events := []*storage.AuditEvent{
+		{NetworkID: "n1", Action: storage.AuditActionCreate, Operator: "bob", Timestamp: 1000, AfterDigest: "a"},
+		{NetworkID: "n1", Entity: &storage.EntityID{Type: "gw", Key: "g1"}, Action: storage.AuditActionCreate, Operator: "bob", Timestamp: 1001, AfterDigest: "b"},
+		{NetworkID: "n1", Entity: &storage.EntityID{Type: "gw", Key: "g1"}, Action: storage.AuditActionUpdate, Operator: "alice", Timestamp: 1002, BeforeDigest: "b", AfterDigest: "c"},
+		{NetworkID: "n1", Entity: &storage.EntityID{Type: "sub", Key: "s1"}, Action: storage.AuditActionDelete, Timestamp: 1003, BeforeDigest: "d"},
+		{NetworkID: "n2", Action: storage.AuditActionDelete, Operator: "bob", Timestamp: 1004, BeforeDigest: "e"},
+	}
+	assert.NoError(t, store.CreateAuditEvents(events))
+	assert.NoError(t, store.Commit())
+	assert.NotEmpty(t, events[0].Id)
+
+	store, err = factory.StartTransaction(context.Background(), &orc8rStorage.TxOptions{ReadOnly: true})
+	assert.NoError(t, err)
+
+	// Most recent first
+	actual, err := store.LoadAuditEvents(storage.AuditEventFilter{NetworkID: "n1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.AuditEvent{events[3], events[2], events[1], events[0]}, actual)
+
+	actual, err = store.LoadAuditEvents(storage.AuditEventFilter{NetworkID: "n1", EntityType: &wrappers.StringValue{Value: "gw"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.AuditEvent{events[2], events[1]}, actual)
+
+	actual, err = store.LoadAuditEvents(storage.AuditEventFilter{NetworkID: "n1", Operator: &wrappers.StringValue{Value: "bob"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.AuditEvent{events[1], events[0]}, actual)
+
+	actual, err = store.LoadAuditEvents(storage.AuditEventFilter{NetworkID: "n1", StartTime: 1001, EndTime: 1002})
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.AuditEvent{events[2], events[1]}, actual)
+
+	actual, err = store.LoadAuditEvents(storage.AuditEventFilter{NetworkID: "n1", Limit: 1})
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.AuditEvent{events[3]}, actual)
+
+	actual, err = store.LoadAuditEvents(storage.AuditEventFilter{NetworkID: "n3"})
+	assert.NoError(t, err)
+	assert.Empty(t, actual)
+	assert.NoError(t, store.Commit())
+
+	// Pruning deletes old events of all networks
+	store, err = factory.StartTransaction(context.Background(), nil)
+	assert.NoError(t, err)
+	deleted, err := store.DeleteAuditEventsBefore(1002)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), deleted)
+	actual, err = store.LoadAuditEvents(storage.AuditEventFilter{NetworkID: "n1"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.AuditEvent{events[3], events[2]}, actual)
+	actual, err = store.LoadAuditEvents(storage.AuditEventFilter{NetworkID: "n2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*storage.AuditEvent{events[4]}, actual)
+	assert.NoError(t, store.Commit())
+}
@@ -147,6 +147,14 @@ func validateNetworkUpdates(updates []NetworkUpdateCriteria) error {
 }
 
 func (store *sqlConfiguratorStorage) updateNetwork(update NetworkUpdateCriteria, stmtCache *sq.StmtCache) error {
+	// Retain the current configs before they're overwritten
+	if !funk.IsEmpty(update.ConfigsToAddOrUpdate) || !funk.IsEmpty(update.ConfigsToDelete) {
+		err := store.recordNetworkRevision(update.ID)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Update the network table first
 	updateBuilder := store.builder.Update(networksTable).Where(sq.Eq{nwIDCol: update.ID})
 	if update.NewName != nil {
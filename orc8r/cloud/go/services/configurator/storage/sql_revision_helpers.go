@@ -0,0 +1,162 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+
+	"magma/orc8r/cloud/go/clock"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// recordNetworkRevision retains the current configs of a network before an
+// update overwrites them. The configs are serialized as a NetworkRevision
+// with only the configs field set.
+func (store *sqlConfiguratorStorage) recordNetworkRevision(networkID string) error {
+	if store.maxRevisions <= 0 {
+		return nil
+	}
+
+	loadResult, err := store.LoadNetworks(NetworkLoadFilter{Ids: []string{networkID}}, NetworkLoadCriteria{LoadConfigs: true})
+	if err != nil {
+		return errors.Wrapf(err, "failed to load configs of network %s", networkID)
+	}
+	// Updates to networks which don't exist are a no-op
+	if funk.IsEmpty(loadResult.Networks) {
+		return nil
+	}
+	network := loadResult.Networks[0]
+
+	marshaledConfigs, err := proto.Marshal(&NetworkRevision{Configs: network.Configs})
+	if err != nil {
+		return errors.Wrapf(err, "failed to serialize configs of network %s", networkID)
+	}
+	_, err = store.builder.Insert(networkRevisionTable).
+		Columns(nwrIDCol, nwrVerCol, nwrConfsCol, nwrReplacedCol).
+		Values(networkID, network.Version, marshaledConfigs, clock.Now().Unix()).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return errors.Wrapf(err, "failed to record revision %d of network %s", network.Version, networkID)
+	}
+
+	err = store.pruneRevisions(networkRevisionTable, nwrIDCol, nwrVerCol, networkID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete old revisions of network %s", networkID)
+	}
+	return nil
+}
+
+// recordEntityRevision retains the current config of an entity before an
+// update overwrites it. version is the current version of the entity.
+func (store *sqlConfiguratorStorage) recordEntityRevision(pk string, version uint64) error {
+	if store.maxRevisions <= 0 {
+		return nil
+	}
+
+	var config []byte
+	err := store.builder.Select(entConfCol).
+		From(entityTable).
+		Where(sq.Eq{entPkCol: pk}).
+		RunWith(store.tx).
+		QueryRow().
+		Scan(&config)
+	if err != nil {
+		return errors.Wrap(err, "failed to load config of entity")
+	}
+
+	_, err = store.builder.Insert(entityRevisionTable).
+		Columns(entrPkCol, entrVerCol, entrConfCol, entrReplacedCol).
+		Values(pk, version, config, clock.Now().Unix()).
+		RunWith(store.tx).
+		Exec()
+	if err != nil {
+		return errors.Wrapf(err, "failed to record revision %d of entity", version)
+	}
+
+	err = store.pruneRevisions(entityRevisionTable, entrPkCol, entrVerCol, pk)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete old revisions of entity")
+	}
+	return nil
+}
+
+// pruneRevisions deletes all but the maxRevisions newest revisions recorded
+// for id in table. Versions are also bumped by changes which don't record a
+// revision, so the revisions to retain are counted rather than derived from
+// the recorded version.
+func (store *sqlConfiguratorStorage) pruneRevisions(table string, idCol string, verCol string, id string) error {
+	var oldestRetained uint64
+	err := store.builder.Select(verCol).
+		From(table).
+		Where(sq.Eq{idCol: id}).
+		OrderBy(fmt.Sprintf("%s DESC", verCol)).
+		Limit(1).
+		Offset(uint64(store.maxRevisions - 1)).
+		RunWith(store.tx).
+		QueryRow().
+		Scan(&oldestRetained)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to load oldest retained revision")
+	}
+
+	_, err = store.builder.Delete(table).
+		Where(sq.And{
+			sq.Eq{idCol: id},
+			sq.Lt{verCol: oldestRetained},
+		}).
+		RunWith(store.tx).
+		Exec()
+	return err
+}
+
+func scanNetworkRevisionRows(networkID string, rows *sql.Rows) ([]*NetworkRevision, error) {
+	ret := []*NetworkRevision{}
+	for rows.Next() {
+		var version uint64
+		var marshaledConfigs []byte
+		var replacedAt int64
+		err := rows.Scan(&version, &marshaledConfigs, &replacedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning network revision row: %s", err)
+		}
+
+		revision := &NetworkRevision{}
+		err = proto.Unmarshal(marshaledConfigs, revision)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to deserialize revision %d of network %s", version, networkID)
+		}
+		revision.NetworkID = networkID
+		revision.Version = version
+		revision.ReplacedAt = replacedAt
+		ret = append(ret, revision)
+	}
+	return ret, nil
+}
+
+func scanEntityRevisionRows(networkID string, entityID EntityID, rows *sql.Rows) ([]*EntityRevision, error) {
+	ret := []*EntityRevision{}
+	for rows.Next() {
+		revision := &EntityRevision{NetworkID: networkID, ID: &EntityID{Type: entityID.Type, Key: entityID.Key}}
+		err := rows.Scan(&revision.Version, &revision.Config, &revision.ReplacedAt)
+		if err != nil {
+			return nil, fmt.Errorf("error while scanning entity revision row: %s", err)
+		}
+		ret = append(ret, revision)
+	}
+	return ret, nil
+}
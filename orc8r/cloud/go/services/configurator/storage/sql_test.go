@@ -21,6 +21,7 @@ import (
 	"magma/orc8r/cloud/go/sqorc"
 	storage2 "magma/orc8r/cloud/go/storage"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/stretchr/testify/assert"
 	"github.com/thoas/go-funk"
@@ -342,7 +343,9 @@ func TestSqlConfiguratorStorage_UpdateNetworks(t *testing.T) {
 		setup: func(m sqlmock.Sqlmock) {
 			prepWithNameAndDesc := m.ExpectPrepare("UPDATE cfg_networks").WillBeClosed()
 			prepWithNameAndDesc.ExpectExec().WithArgs(names[1], descs[1], "n2").WillReturnResult(mockResult)
+			expectChangeRecording(m, "n2", 1, true, storage2.TypeAndKey{})
 
+			expectNetworkRevision(m, "n3", 1, map[string][]byte{"hello": []byte("hello")})
 			prepWithOnlyVersion := m.ExpectPrepare("UPDATE cfg_networks").WillBeClosed()
 			prepWithOnlyVersion.ExpectExec().WithArgs("n3").WillReturnResult(mockResult)
 
@@ -350,12 +353,17 @@ func TestSqlConfiguratorStorage_UpdateNetworks(t *testing.T) {
 			upsertStmt.ExpectExec().WithArgs("n3", "baz", []byte("quz"), []byte("quz")).WillReturnResult(mockResult)
 			upsertStmt.ExpectExec().WithArgs("n3", "foo", []byte("bar"), []byte("bar")).WillReturnResult(mockResult)
 			m.ExpectExec("DELETE FROM cfg_network_configs").WithArgs("n3", "hello", "n3", "world").WillReturnResult(mockResult)
+			expectChangeRecording(m, "n3", 1, true, storage2.TypeAndKey{})
 
+			expectNetworkRevision(m, "n4", 2, map[string][]byte{"world": []byte("world")})
 			prepWithNameAndDesc.ExpectExec().WithArgs(names[2], "", "n4").WillReturnResult(mockResult)
 			upsertStmt.ExpectExec().WithArgs("n4", "baz", []byte("quz"), []byte("quz")).WillReturnResult(mockResult)
 			upsertStmt.ExpectExec().WithArgs("n4", "foo", []byte("bar"), []byte("bar")).WillReturnResult(mockResult)
 			m.ExpectExec("DELETE FROM cfg_network_configs").WithArgs("n4", "hello", "n4", "world").WillReturnResult(mockResult)
+			expectChangeRecording(m, "n4", 1, true, storage2.TypeAndKey{})
 
+			m.ExpectExec("DELETE FROM cfg_entity_changes").WithArgs("n1").WillReturnResult(mockResult)
+			m.ExpectExec("UPDATE cfg_change_sequences").WithArgs("n1").WillReturnResult(mockResult)
 			m.ExpectExec("DELETE FROM cfg_network_configs").WithArgs("n1").WillReturnResult(mockResult)
 			m.ExpectExec("DELETE FROM cfg_networks").WithArgs("n1").WillReturnResult(mockResult)
 		},
@@ -664,6 +672,12 @@ func TestSqlConfiguratorStorage_LoadEntities(t *testing.T) {
 						AddRow("network", "foobar", "bar", "foo", nil, 1, "42", "foobar", "foobar ent", []byte("foobar"), "foobar_acl_2", "n4", storage.ACL_READ, "baz", nil, 2).
 						AddRow("network", "foobaz", "baz", "foo", nil, 2, "42", "foobaz", "foobaz ent", []byte("foobaz"), "foobaz_acl_1", "WILDCARD_ALL", storage.ACL_WRITE, "WILDCARD_ALL", nil, 3),
 				)
+			m.ExpectQuery("SELECT entity_pk, \"key\", value FROM cfg_entity_labels").
+				WithArgs("foobar", "foobaz").
+				WillReturnRows(
+					sqlmock.NewRows([]string{"entity_pk", "key", "value"}).
+						AddRow("foobar", "env", "prod"),
+				)
 
 			expectAssocQuery(
 				m,
@@ -722,6 +736,7 @@ func TestSqlConfiguratorStorage_LoadEntities(t *testing.T) {
 					ParentAssociations: []*storage.EntityID{
 						{Type: "hello", Key: "world"},
 					},
+					Labels: map[string]string{"env": "prod"},
 				},
 				{
 					NetworkID: "network", Type: "foo", Key: "baz", GraphID: "42", Version: 2,
@@ -828,6 +843,7 @@ func TestSqlConfiguratorStorage_CreateEntity(t *testing.T) {
 			m.ExpectExec("INSERT INTO cfg_entities").
 				WithArgs("1", "network", "foo", "bar", "2", "foobar", "foobar ent", nil, nil).
 				WillReturnResult(mockResult)
+			expectChangeRecording(m, "network", 1, true, storage2.TypeAndKey{Type: "foo", Key: "bar"})
 		},
 		run: runFactory(
 			"network",
@@ -876,6 +892,7 @@ func TestSqlConfiguratorStorage_CreateEntity(t *testing.T) {
 				WillReturnResult(mockResult)
 
 			expectPermissionCreation(m, "1", 3, perms...)
+			expectChangeRecording(m, "network", 1, true, storage2.TypeAndKey{Type: "foo", Key: "bar"})
 		},
 		run: runFactory(
 			"network",
@@ -932,6 +949,12 @@ func TestSqlConfiguratorStorage_CreateEntity(t *testing.T) {
 			expectEdgeQueries(m, assocs, edgesByTk)
 			expectEdgeInsertions(m, assocsToEdges("1", assocs, edgesByTk))
 			expectMergeGraphs(m, [][2]string{{"2", "1"}, {"3", "1"}})
+			expectChangeRecording(
+				m, "network", 1, true,
+				storage2.TypeAndKey{Type: "foo", Key: "bar"},
+				storage2.TypeAndKey{Type: "bar", Key: "baz"},
+				storage2.TypeAndKey{Type: "baz", Key: "quz"},
+			)
 		},
 		run: runFactory(
 			"network",
@@ -1003,8 +1026,10 @@ func TestSqlConfiguratorStorage_UpdateEntity(t *testing.T) {
 	deleteCase := &testCase{
 		setup: func(m sqlmock.Sqlmock) {
 			expectBasicEntityQueries(m, expectedFooBarQuery)
+			expectAssociatedIDsQuery(m, []driver.Value{"1", "1"})
 			m.ExpectExec("DELETE FROM cfg_entities").WithArgs("network", "foo", "bar").WillReturnResult(mockResult)
 			expectBulkEntityQuery(m, []driver.Value{"g1"})
+			expectChangeRecording(m, "network", 1, true, storage2.TypeAndKey{Type: "foo", Key: "bar"})
 		},
 		run: runFactory("network", storage.EntityUpdateCriteria{Type: "foo", Key: "bar", DeleteEntity: true}),
 
@@ -1016,6 +1041,7 @@ func TestSqlConfiguratorStorage_UpdateEntity(t *testing.T) {
 	deleteWithPartition := &testCase{
 		setup: func(m sqlmock.Sqlmock) {
 			expectBasicEntityQueries(m, expectedFooBarQuery)
+			expectAssociatedIDsQuery(m, []driver.Value{"1", "1"}, storage2.TypeAndKey{Type: "bar", Key: "baz"}, storage2.TypeAndKey{Type: "baz", Key: "bar"})
 			m.ExpectExec("DELETE FROM cfg_entities").WithArgs("network", "foo", "bar").WillReturnResult(mockResult)
 			// make foobar the root of a tree so we partition the graph into
 			// 3 components:
@@ -1037,6 +1063,12 @@ func TestSqlConfiguratorStorage_UpdateEntity(t *testing.T) {
 			)
 			m.ExpectExec("UPDATE cfg_entities").WithArgs("1", "barfoo").WillReturnResult(mockResult)
 			m.ExpectExec("UPDATE cfg_entities").WithArgs("2", "bazbar").WillReturnResult(mockResult)
+			expectChangeRecording(
+				m, "network", 1, true,
+				storage2.TypeAndKey{Type: "bar", Key: "baz"},
+				storage2.TypeAndKey{Type: "baz", Key: "bar"},
+				storage2.TypeAndKey{Type: "foo", Key: "bar"},
+			)
 		},
 		run:            runFactory("network", storage.EntityUpdateCriteria{Type: "foo", Key: "bar", DeleteEntity: true}),
 		expectedResult: storage.NetworkEntity{Type: "foo", Key: "bar"},
@@ -1284,8 +1316,8 @@ func TestSqlConfiguratorStorage_UpdateEntity(t *testing.T) {
 				m,
 				[]*storage.EntityID{{Type: "quz", Key: "baz"}, {Type: "baz", Key: "bar"}},
 				map[storage2.TypeAndKey]expectedEntQueryResult{
-					{"quz", "baz"}: getBasicQueryExpect("quz", "baz"),
-					{"baz", "bar"}: getBasicQueryExpect("baz", "bar"),
+					{Type: "quz", Key: "baz"}: getBasicQueryExpect("quz", "baz"),
+					{Type: "baz", Key: "bar"}: getBasicQueryExpect("baz", "bar"),
 				},
 			)
 			expectEdgeDeletions(m, [][2]string{{"bazquz", "quzbaz"}, {"bazquz", "bazbar"}})
@@ -1309,6 +1341,12 @@ func TestSqlConfiguratorStorage_UpdateEntity(t *testing.T) {
 			)
 			m.ExpectExec("UPDATE cfg_entities").WithArgs("1", "quzbaz").WillReturnResult(mockResult)
 			m.ExpectExec("UPDATE cfg_entities").WithArgs("2", "barfoo", "bazbar").WillReturnResult(mockResult)
+			expectChangeRecording(
+				m, "network", 1, true,
+				storage2.TypeAndKey{Type: "baz", Key: "quz"},
+				storage2.TypeAndKey{Type: "quz", Key: "baz"},
+				storage2.TypeAndKey{Type: "baz", Key: "bar"},
+			)
 		},
 		run:            runFactory("network", storage.EntityUpdateCriteria{Type: "baz", Key: "quz", AssociationsToDelete: []*storage.EntityID{{Type: "quz", Key: "baz"}, {Type: "baz", Key: "bar"}}}),
 		expectedResult: storage.NetworkEntity{NetworkID: "network", Type: "baz", Key: "quz", GraphID: "g1", Version: 1},
@@ -1319,6 +1357,7 @@ func TestSqlConfiguratorStorage_UpdateEntity(t *testing.T) {
 		setup: func(m sqlmock.Sqlmock) {
 			// Load and change version, then clear assocs
 			expectBasicEntityQueries(m, getBasicQueryExpect("foo", "bar"))
+			expectAssociatedIDsQuery(m, []driver.Value{"foobar"}, storage2.TypeAndKey{Type: "bar", Key: "baz"})
 			m.ExpectExec("UPDATE cfg_entities").WithArgs("foobar").WillReturnResult(mockResult)
 			m.ExpectExec("DELETE FROM cfg_assocs").WithArgs("foobar").WillReturnResult(mockResult)
 
@@ -1333,6 +1372,13 @@ func TestSqlConfiguratorStorage_UpdateEntity(t *testing.T) {
 
 			// Graph partition update
 			m.ExpectExec("UPDATE cfg_entities").WithArgs("1", "barbaz").WillReturnResult(mockResult)
+
+			// Change recording
+			expectChangeRecording(
+				m, "network", 1, true,
+				storage2.TypeAndKey{Type: "foo", Key: "bar"},
+				storage2.TypeAndKey{Type: "bar", Key: "baz"},
+			)
 		},
 		run:            runFactory("network", storage.EntityUpdateCriteria{Type: "foo", Key: "bar", AssociationsToSet: &storage.EntityAssociationsToSet{}}),
 		expectedResult: storage.NetworkEntity{NetworkID: "network", Type: "foo", Key: "bar", GraphID: "g1", Version: 1},
@@ -1646,6 +1692,12 @@ func getTestCaseForEntityUpdate(
 		setup: func(m sqlmock.Sqlmock) {
 			// Basic fields
 			expectBasicEntityQueries(m, entToUpdate)
+			if update.AssociationsToSet != nil {
+				expectAssociatedIDsQuery(m, []driver.Value{entToUpdate.pk})
+			}
+			if update.NewConfig != nil {
+				expectEntityRevision(m, entToUpdate.pk, entToUpdate.version, []byte("old config"))
+			}
 			updateWithArgs := []driver.Value{}
 			if update.NewName != nil {
 				updateWithArgs = append(updateWithArgs, update.NewName.Value)
@@ -1709,6 +1761,21 @@ func getTestCaseForEntityUpdate(
 				expectBulkEntityQuery(m, []driver.Value{entToUpdate.graphID}, entToUpdate)
 				expectAssocQuery(m, []driver.Value{entToUpdate.pk, entToUpdate.pk})
 			}
+
+			// Change recording
+			changedIDs := []storage2.TypeAndKey{{Type: entToUpdate.entType, Key: entToUpdate.key}}
+			if update.AssociationsToSet != nil {
+				for _, id := range update.AssociationsToSet.AssociationsToSet {
+					changedIDs = append(changedIDs, id.ToTypeAndKey())
+				}
+			}
+			for _, id := range update.AssociationsToAdd {
+				changedIDs = append(changedIDs, id.ToTypeAndKey())
+			}
+			for _, id := range update.AssociationsToDelete {
+				changedIDs = append(changedIDs, id.ToTypeAndKey())
+			}
+			expectChangeRecording(m, "network", 1, true, changedIDs...)
 		},
 		run: func(store storage.ConfiguratorStorage) (interface{}, error) {
 			return store.UpdateEntity("network", update)
@@ -1792,6 +1859,68 @@ func expectEdgeDeletions(m sqlmock.Sqlmock, edges [][2]string) {
 	m.ExpectExec("DELETE FROM cfg_assocs").WithArgs(args...).WillReturnResult(mockResult)
 }
 
+// expectChangeRecording expects the network's change sequence to be
+// incremented to the given sequence number and the given entity IDs to be
+// recorded under it. If first is set, the sequence is also initialized and
+// loaded, as it is on the first change to a network in a transaction.
+func expectChangeRecording(m sqlmock.Sqlmock, networkID string, sequence uint64, first bool, ids ...storage2.TypeAndKey) {
+	if first {
+		m.ExpectExec("INSERT INTO cfg_change_sequences").WithArgs(networkID).WillReturnResult(mockResult)
+	}
+	m.ExpectExec("UPDATE cfg_change_sequences").WithArgs(networkID).WillReturnResult(mockResult)
+	if first {
+		m.ExpectQuery("SELECT sequence, min_sequence FROM cfg_change_sequences").
+			WithArgs(networkID).
+			WillReturnRows(sqlmock.NewRows([]string{"sequence", "min_sequence"}).AddRow(sequence, 0))
+	}
+	args := make([]driver.Value, 0, len(ids)*4)
+	for _, id := range ids {
+		args = append(args, networkID, sequence, id.Type, id.Key)
+	}
+	m.ExpectExec("INSERT INTO cfg_entity_changes").WithArgs(args...).WillReturnResult(mockResult)
+}
+
+func expectAssociatedIDsQuery(m sqlmock.Sqlmock, queryArgs []driver.Value, ids ...storage2.TypeAndKey) {
+	rows := sqlmock.NewRows([]string{"type", "key"})
+	for _, id := range ids {
+		rows.AddRow(id.Type, id.Key)
+	}
+	m.ExpectQuery(`SELECT ent.type, ent."key" FROM cfg_assocs AS a`).WithArgs(queryArgs...).WillReturnRows(rows)
+}
+
+func expectNetworkRevision(m sqlmock.Sqlmock, networkID string, version uint64, configs map[string][]byte) {
+	rows := sqlmock.NewRows([]string{"id", "type", "type", "value", "version"})
+	for configType, config := range configs {
+		rows.AddRow(networkID, "", configType, config, version)
+	}
+	m.ExpectQuery("SELECT cfg_networks.id, cfg_networks.type, cfg_network_configs.type, cfg_network_configs.value, cfg_networks.version FROM cfg_networks").
+		WithArgs(networkID).
+		WillReturnRows(rows)
+
+	marshaledConfigs, err := proto.Marshal(&storage.NetworkRevision{Configs: configs})
+	if err != nil {
+		panic(err)
+	}
+	m.ExpectExec("INSERT INTO cfg_network_revisions").
+		WithArgs(networkID, version, marshaledConfigs, sqlmock.AnyArg()).
+		WillReturnResult(mockResult)
+	m.ExpectQuery("SELECT version FROM cfg_network_revisions").
+		WithArgs(networkID).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}))
+}
+
+func expectEntityRevision(m sqlmock.Sqlmock, entPk string, version uint64, config []byte) {
+	m.ExpectQuery("SELECT config FROM cfg_entities").
+		WithArgs(entPk).
+		WillReturnRows(sqlmock.NewRows([]string{"config"}).AddRow(config))
+	m.ExpectExec("INSERT INTO cfg_entity_revisions").
+		WithArgs(entPk, version, config, sqlmock.AnyArg()).
+		WillReturnResult(mockResult)
+	m.ExpectQuery("SELECT version FROM cfg_entity_revisions").
+		WithArgs(entPk).
+		WillReturnRows(sqlmock.NewRows([]string{"version"}))
+}
+
 func expectPermissionCreation(m sqlmock.Sqlmock, entPk string, startId int, perms ...*storage.ACL) {
 	args := make([]driver.Value, 0, len(perms)*6)
 	for _, perm := range perms {
@@ -16,9 +16,29 @@ import (
 	"magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/glog"
+	"github.com/pkg/errors"
 	"github.com/thoas/go-funk"
 )
 
+// ErrVersionMismatch is the cause of the error returned by UpdateEntity if
+// the entity isn't at the expected version of the update.
+var ErrVersionMismatch = errors.New("entity version does not match the expected version")
+
+// ErrChangesUnavailable is returned by LoadEntityChanges if the changes since
+// the requested sequence number are no longer retained, or are too many to
+// load at once.
+var ErrChangesUnavailable = errors.New("changes since the requested sequence number are unavailable")
+
+const (
+	// EntityChangeRetention is the number of most recent change sequence
+	// numbers of each network whose changes are retained.
+	EntityChangeRetention = 10000
+
+	// MaxLoadedEntityChanges is the maximum number of changed entities
+	// LoadEntityChanges returns.
+	MaxLoadedEntityChanges = 10000
+)
+
 // ConfiguratorStorageFactory creates ConfiguratorStorage implementations bound
 // to transactions.
 type ConfiguratorStorageFactory interface {
@@ -77,6 +97,8 @@ type ConfiguratorStorage interface {
 	// The updates to the specified entity will be returned as a NetworkEntity
 	// object. Apart from identity fields, only fields which were updated will
 	// be filled out, with system-generated IDs included.
+	// If the update specifies an expected version which the entity isn't at,
+	// an error caused by ErrVersionMismatch is returned.
 	UpdateEntity(networkID string, update EntityUpdateCriteria) (NetworkEntity, error)
 
 	// =======================================================================
@@ -87,8 +109,59 @@ type ConfiguratorStorage interface {
 	// entity. The load criteria fields on associations are ignored, and the
 	// returned entities will always have both association fields filled out.
 	LoadGraphForEntity(networkID string, entityID EntityID, loadCriteria EntityLoadCriteria) (EntityGraph, error)
+
+	// =======================================================================
+	// Revision Operations
+	// =======================================================================
+
+	// LoadNetworkRevisions returns the retained previous versions of the
+	// configs of a network, ordered by version. Updates to the configs of a
+	// network retain the configs they replace.
+	LoadNetworkRevisions(networkID string) ([]*NetworkRevision, error)
+
+	// LoadEntityRevisions returns the retained previous versions of the
+	// config of an entity, ordered by version. Updates to the config of an
+	// entity retain the config they replace.
+	LoadEntityRevisions(networkID string, entityID EntityID) ([]*EntityRevision, error)
+
+	// =======================================================================
+	// Change Operations
+	// =======================================================================
+
+	// LoadEntityChanges returns the network's current change sequence number.
+	// If since is set, it also returns the entities which changed after that
+	// sequence number and whether the network itself changed. Writes to
+	// entities advance the sequence, and change the written entities along
+	// with the entities whose associations the write added or removed.
+	// If the changes since the sequence number can't be loaded,
+	// ErrChangesUnavailable is returned.
+	LoadEntityChanges(networkID string, since *uint64) (*EntityChanges, error)
+
+	// =======================================================================
+	// Audit Operations
+	// =======================================================================
+
+	// CreateAuditEvents records a set of audit events. A unique ID is
+	// generated for and set on each event.
+	CreateAuditEvents(events []*AuditEvent) error
+
+	// LoadAuditEvents returns the audit events matching the filter, ordered
+	// by timestamp, most recent first.
+	LoadAuditEvents(filter AuditEventFilter) ([]*AuditEvent, error)
+
+	// DeleteAuditEventsBefore deletes the audit events of all networks which
+	// were recorded before the given unix timestamp, and returns the number
+	// of deleted events.
+	DeleteAuditEventsBefore(timestamp int64) (int64, error)
 }
 
+// Actions of audit events
+const (
+	AuditActionCreate = "CREATE"
+	AuditActionUpdate = "UPDATE"
+	AuditActionDelete = "DELETE"
+)
+
 // RollbackLogOnError calls Rollback on the provided ConfiguratorStorage and
 // logs if Rollback resulted in an error.
 func RollbackLogOnError(store ConfiguratorStorage) {
@@ -153,7 +226,15 @@ func (m NetworkEntity) GetGraphEdges() []*GraphEdge {
 // IsLoadAllEntities return true if the EntityLoadFilter is specifying to load
 // all entities in a network, false if there are any filter conditions.
 func (m *EntityLoadFilter) IsLoadAllEntities() bool {
-	return m.TypeFilter == nil && m.KeyFilter == nil && m.GraphID == nil && funk.IsEmpty(m.IDs)
+	return m.TypeFilter == nil && m.KeyFilter == nil && m.GraphID == nil && funk.IsEmpty(m.IDs) &&
+		(m.KeyPrefix == nil || m.KeyPrefix.Value == "") && !m.IsPaginated() &&
+		funk.IsEmpty(m.Types) && (m.SearchText == nil || m.SearchText.Value == "") && funk.IsEmpty(m.Labels)
+}
+
+// IsPaginated returns true if the EntityLoadFilter is specifying to load a
+// single page of entities.
+func (m *EntityLoadFilter) IsPaginated() bool {
+	return m.PageSize > 0 && funk.IsEmpty(m.IDs)
 }
 
 // FullEntityLoadCriteria is an EntityLoadCriteria which loads everything
@@ -163,6 +244,7 @@ var FullEntityLoadCriteria = EntityLoadCriteria{
 	LoadAssocsToThis:   true,
 	LoadAssocsFromThis: true,
 	LoadPermissions:    true,
+	LoadLabels:         true,
 }
 
 func (m *EntityUpdateCriteria) GetID() *EntityID {
@@ -480,11 +480,13 @@ type NetworkEntity struct {
 	// creation.
 	ParentAssociations []*EntityID `protobuf:"bytes,51,rep,name=parent_associations,json=parentAssociations,proto3" json:"parent_associations,omitempty"`
 	// Permissions defines the access control for this entity.
-	Permissions          []*ACL   `protobuf:"bytes,60,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	Version              uint64   `protobuf:"varint,70,opt,name=version,proto3" json:"version,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Permissions []*ACL `protobuf:"bytes,60,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Version     uint64 `protobuf:"varint,70,opt,name=version,proto3" json:"version,omitempty"`
+	// Labels are arbitrary key-value pairs which entities can be searched by
+	Labels               map[string]string `protobuf:"bytes,80,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *NetworkEntity) Reset()         { *m = NetworkEntity{} }
@@ -596,6 +598,13 @@ func (m *NetworkEntity) GetVersion() uint64 {
 	return 0
 }
 
+func (m *NetworkEntity) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 // ACL (Access Control List) defines a specific permission for an entity on
 // access to other entities.
 type ACL struct {
@@ -816,10 +825,29 @@ type EntityLoadFilter struct {
 	GraphID *wrappers.StringValue `protobuf:"bytes,4,opt,name=graphID,proto3" json:"graphID,omitempty"`
 	// If PhysicalID is provided, the query will return all entities matching
 	// the provided ID. All other fields are ignored if this is set.
-	PhysicalID           *wrappers.StringValue `protobuf:"bytes,5,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	PhysicalID *wrappers.StringValue `protobuf:"bytes,5,opt,name=physicalID,proto3" json:"physicalID,omitempty"`
+	// If KeyPrefix is provided, the query will only return entities whose
+	// key starts with the given prefix. Ignored if IDs is provided.
+	KeyPrefix *wrappers.StringValue `protobuf:"bytes,6,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	// If PageSize is non-zero, the query will return at most PageSize
+	// entities, ordered by (type, key). To load the next page, pass the
+	// NextPageToken of the result as the PageToken of the same filter.
+	// Ignored if IDs is provided.
+	PageSize  uint32 `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// If Types is provided, the query will only return entities of one of the
+	// given types. Ignored if IDs is provided.
+	Types []string `protobuf:"bytes,9,rep,name=types,proto3" json:"types,omitempty"`
+	// If SearchText is provided, the query will only return entities whose
+	// key, name, description, or physical ID contains the given text,
+	// case-insensitively. Ignored if IDs is provided.
+	SearchText *wrappers.StringValue `protobuf:"bytes,10,opt,name=search_text,json=searchText,proto3" json:"search_text,omitempty"`
+	// If Labels is provided, the query will only return entities which have
+	// all of the given labels. Ignored if IDs is provided.
+	Labels               map[string]string `protobuf:"bytes,11,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *EntityLoadFilter) Reset()         { *m = EntityLoadFilter{} }
@@ -882,6 +910,48 @@ func (m *EntityLoadFilter) GetPhysicalID() *wrappers.StringValue {
 	return nil
 }
 
+func (m *EntityLoadFilter) GetKeyPrefix() *wrappers.StringValue {
+	if m != nil {
+		return m.KeyPrefix
+	}
+	return nil
+}
+
+func (m *EntityLoadFilter) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *EntityLoadFilter) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *EntityLoadFilter) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *EntityLoadFilter) GetSearchText() *wrappers.StringValue {
+	if m != nil {
+		return m.SearchText
+	}
+	return nil
+}
+
+func (m *EntityLoadFilter) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
 // EntityLoadCriteria specifies how much of an entity to load
 type EntityLoadCriteria struct {
 	// Set LoadMetadata to true to load the metadata fields (name, description)
@@ -890,6 +960,7 @@ type EntityLoadCriteria struct {
 	LoadAssocsToThis     bool     `protobuf:"varint,3,opt,name=load_assocs_to_this,json=loadAssocsToThis,proto3" json:"load_assocs_to_this,omitempty"`
 	LoadAssocsFromThis   bool     `protobuf:"varint,4,opt,name=load_assocs_from_this,json=loadAssocsFromThis,proto3" json:"load_assocs_from_this,omitempty"`
 	LoadPermissions      bool     `protobuf:"varint,5,opt,name=load_permissions,json=loadPermissions,proto3" json:"load_permissions,omitempty"`
+	LoadLabels           bool     `protobuf:"varint,6,opt,name=load_labels,json=loadLabels,proto3" json:"load_labels,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -955,12 +1026,22 @@ func (m *EntityLoadCriteria) GetLoadPermissions() bool {
 	return false
 }
 
+func (m *EntityLoadCriteria) GetLoadLabels() bool {
+	if m != nil {
+		return m.LoadLabels
+	}
+	return false
+}
+
 type EntityLoadResult struct {
-	Entities             []*NetworkEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
-	EntitiesNotFound     []*EntityID      `protobuf:"bytes,2,rep,name=entities_not_found,json=entitiesNotFound,proto3" json:"entities_not_found,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	Entities         []*NetworkEntity `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	EntitiesNotFound []*EntityID      `protobuf:"bytes,2,rep,name=entities_not_found,json=entitiesNotFound,proto3" json:"entities_not_found,omitempty"`
+	// NextPageToken is set if the filter specified a page size and there
+	// are more entities to load.
+	NextPageToken        string   `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *EntityLoadResult) Reset()         { *m = EntityLoadResult{} }
@@ -1002,6 +1083,13 @@ func (m *EntityLoadResult) GetEntitiesNotFound() []*EntityID {
 	return nil
 }
 
+func (m *EntityLoadResult) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
 // EntityUpdateCriteria specifies a patch operation on a network entity.
 type EntityUpdateCriteria struct {
 	// (Type, Key) of the entity to update
@@ -1020,12 +1108,17 @@ type EntityUpdateCriteria struct {
 	AssociationsToAdd    []*EntityID              `protobuf:"bytes,31,rep,name=associations_to_add,json=associationsToAdd,proto3" json:"associations_to_add,omitempty"`
 	AssociationsToDelete []*EntityID              `protobuf:"bytes,32,rep,name=associations_to_delete,json=associationsToDelete,proto3" json:"associations_to_delete,omitempty"`
 	// New ACLs to add. ACL IDs are ignored and generated by the system.
-	PermissionsToCreate  []*ACL   `protobuf:"bytes,40,rep,name=permissions_to_create,json=permissionsToCreate,proto3" json:"permissions_to_create,omitempty"`
-	PermissionsToUpdate  []*ACL   `protobuf:"bytes,41,rep,name=permissions_to_update,json=permissionsToUpdate,proto3" json:"permissions_to_update,omitempty"`
-	PermissionsToDelete  []string `protobuf:"bytes,42,rep,name=permissions_to_delete,json=permissionsToDelete,proto3" json:"permissions_to_delete,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	PermissionsToCreate []*ACL            `protobuf:"bytes,40,rep,name=permissions_to_create,json=permissionsToCreate,proto3" json:"permissions_to_create,omitempty"`
+	PermissionsToUpdate []*ACL            `protobuf:"bytes,41,rep,name=permissions_to_update,json=permissionsToUpdate,proto3" json:"permissions_to_update,omitempty"`
+	PermissionsToDelete []string          `protobuf:"bytes,42,rep,name=permissions_to_delete,json=permissionsToDelete,proto3" json:"permissions_to_delete,omitempty"`
+	LabelsToAddOrUpdate map[string]string `protobuf:"bytes,50,rep,name=labels_to_add_or_update,json=labelsToAddOrUpdate,proto3" json:"labels_to_add_or_update,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LabelsToDelete      []string          `protobuf:"bytes,51,rep,name=labels_to_delete,json=labelsToDelete,proto3" json:"labels_to_delete,omitempty"`
+	// If set, the update fails with ErrVersionMismatch unless the entity is
+	// at this version. Ignored when deleting the entity.
+	ExpectedVersion      *wrappers.UInt64Value `protobuf:"bytes,60,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *EntityUpdateCriteria) Reset()         { *m = EntityUpdateCriteria{} }
@@ -1144,6 +1237,27 @@ func (m *EntityUpdateCriteria) GetPermissionsToDelete() []string {
 	return nil
 }
 
+func (m *EntityUpdateCriteria) GetLabelsToAddOrUpdate() map[string]string {
+	if m != nil {
+		return m.LabelsToAddOrUpdate
+	}
+	return nil
+}
+
+func (m *EntityUpdateCriteria) GetLabelsToDelete() []string {
+	if m != nil {
+		return m.LabelsToDelete
+	}
+	return nil
+}
+
+func (m *EntityUpdateCriteria) GetExpectedVersion() *wrappers.UInt64Value {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return nil
+}
+
 type EntityAssociationsToSet struct {
 	AssociationsToSet    []*EntityID `protobuf:"bytes,1,rep,name=associations_to_set,json=associationsToSet,proto3" json:"associations_to_set,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -1290,6 +1404,397 @@ func (m *GraphEdge) GetFrom() *EntityID {
 	return nil
 }
 
+// NetworkRevision is a version of the configs of a network.
+type NetworkRevision struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	Version   uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Configs of the network at this version, keyed by type
+	Configs map[string][]byte `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Unix time in seconds at which this version was replaced by a newer one.
+	// 0 if this is the current version of the network.
+	ReplacedAt           int64    `protobuf:"varint,4,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkRevision) Reset()         { *m = NetworkRevision{} }
+func (m *NetworkRevision) String() string { return proto.CompactTextString(m) }
+func (*NetworkRevision) ProtoMessage()    {}
+func (*NetworkRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{15}
+}
+
+func (m *NetworkRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkRevision.Unmarshal(m, b)
+}
+func (m *NetworkRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkRevision.Marshal(b, m, deterministic)
+}
+func (m *NetworkRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkRevision.Merge(m, src)
+}
+func (m *NetworkRevision) XXX_Size() int {
+	return xxx_messageInfo_NetworkRevision.Size(m)
+}
+func (m *NetworkRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkRevision proto.InternalMessageInfo
+
+func (m *NetworkRevision) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *NetworkRevision) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *NetworkRevision) GetConfigs() map[string][]byte {
+	if m != nil {
+		return m.Configs
+	}
+	return nil
+}
+
+func (m *NetworkRevision) GetReplacedAt() int64 {
+	if m != nil {
+		return m.ReplacedAt
+	}
+	return 0
+}
+
+// EntityRevision is a version of the config of a network entity.
+type EntityRevision struct {
+	NetworkID string    `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	ID        *EntityID `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Version   uint64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// Serialized config of the entity at this version
+	Config []byte `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	// Unix time in seconds at which this version was replaced by a newer one.
+	// 0 if this is the current version of the entity.
+	ReplacedAt           int64    `protobuf:"varint,5,opt,name=replaced_at,json=replacedAt,proto3" json:"replaced_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EntityRevision) Reset()         { *m = EntityRevision{} }
+func (m *EntityRevision) String() string { return proto.CompactTextString(m) }
+func (*EntityRevision) ProtoMessage()    {}
+func (*EntityRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{16}
+}
+
+func (m *EntityRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EntityRevision.Unmarshal(m, b)
+}
+func (m *EntityRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EntityRevision.Marshal(b, m, deterministic)
+}
+func (m *EntityRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityRevision.Merge(m, src)
+}
+func (m *EntityRevision) XXX_Size() int {
+	return xxx_messageInfo_EntityRevision.Size(m)
+}
+func (m *EntityRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityRevision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityRevision proto.InternalMessageInfo
+
+func (m *EntityRevision) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *EntityRevision) GetID() *EntityID {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *EntityRevision) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EntityRevision) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func (m *EntityRevision) GetReplacedAt() int64 {
+	if m != nil {
+		return m.ReplacedAt
+	}
+	return 0
+}
+
+// AuditEvent records a single mutation of a network or network entity made
+// through the northbound configurator API.
+type AuditEvent struct {
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NetworkID string `protobuf:"bytes,2,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// Mutated entity. nil if the event is for the network itself.
+	Entity *EntityID `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	// One of CREATE, UPDATE, DELETE
+	Action string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	// Operator which made the change. Empty if the caller is unknown.
+	Operator string `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Unix time in seconds at which the change was made
+	Timestamp int64 `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Hex-encoded SHA-256 digests of the config before and after the change.
+	// Empty if there was no config.
+	BeforeDigest         string   `protobuf:"bytes,7,opt,name=before_digest,json=beforeDigest,proto3" json:"before_digest,omitempty"`
+	AfterDigest          string   `protobuf:"bytes,8,opt,name=after_digest,json=afterDigest,proto3" json:"after_digest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{17}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetEntity() *EntityID {
+	if m != nil {
+		return m.Entity
+	}
+	return nil
+}
+
+func (m *AuditEvent) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AuditEvent) GetBeforeDigest() string {
+	if m != nil {
+		return m.BeforeDigest
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetAfterDigest() string {
+	if m != nil {
+		return m.AfterDigest
+	}
+	return ""
+}
+
+// AuditEventFilter specifies which audit events to load.
+type AuditEventFilter struct {
+	NetworkID string `protobuf:"bytes,1,opt,name=networkID,proto3" json:"networkID,omitempty"`
+	// If set, only events for entities of this type are loaded
+	EntityType *wrappers.StringValue `protobuf:"bytes,2,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	// If set, only events made by this operator are loaded
+	Operator *wrappers.StringValue `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	// Inclusive bounds on the event timestamp in unix seconds. 0 means
+	// unbounded.
+	StartTime int64 `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Maximum number of events to load. 0 means no limit.
+	Limit                uint32   `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEventFilter) Reset()         { *m = AuditEventFilter{} }
+func (m *AuditEventFilter) String() string { return proto.CompactTextString(m) }
+func (*AuditEventFilter) ProtoMessage()    {}
+func (*AuditEventFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{18}
+}
+
+func (m *AuditEventFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEventFilter.Unmarshal(m, b)
+}
+func (m *AuditEventFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEventFilter.Marshal(b, m, deterministic)
+}
+func (m *AuditEventFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEventFilter.Merge(m, src)
+}
+func (m *AuditEventFilter) XXX_Size() int {
+	return xxx_messageInfo_AuditEventFilter.Size(m)
+}
+func (m *AuditEventFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEventFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEventFilter proto.InternalMessageInfo
+
+func (m *AuditEventFilter) GetNetworkID() string {
+	if m != nil {
+		return m.NetworkID
+	}
+	return ""
+}
+
+func (m *AuditEventFilter) GetEntityType() *wrappers.StringValue {
+	if m != nil {
+		return m.EntityType
+	}
+	return nil
+}
+
+func (m *AuditEventFilter) GetOperator() *wrappers.StringValue {
+	if m != nil {
+		return m.Operator
+	}
+	return nil
+}
+
+func (m *AuditEventFilter) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *AuditEventFilter) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *AuditEventFilter) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// EntityChanges are the changes made to a network and its entities after a
+// point of the network's change sequence.
+type EntityChanges struct {
+	// Sequence number of the most recent change to the network
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// Entities which were created, updated or deleted, or whose associations
+	// changed. Each entity is listed once.
+	Entities []*EntityID `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	// True if the network itself was updated
+	NetworkChanged       bool     `protobuf:"varint,3,opt,name=network_changed,json=networkChanged,proto3" json:"network_changed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EntityChanges) Reset()         { *m = EntityChanges{} }
+func (m *EntityChanges) String() string { return proto.CompactTextString(m) }
+func (*EntityChanges) ProtoMessage()    {}
+func (*EntityChanges) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d2c4ccf1453ffdb, []int{19}
+}
+
+func (m *EntityChanges) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EntityChanges.Unmarshal(m, b)
+}
+func (m *EntityChanges) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EntityChanges.Marshal(b, m, deterministic)
+}
+func (m *EntityChanges) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntityChanges.Merge(m, src)
+}
+func (m *EntityChanges) XXX_Size() int {
+	return xxx_messageInfo_EntityChanges.Size(m)
+}
+func (m *EntityChanges) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntityChanges.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntityChanges proto.InternalMessageInfo
+
+func (m *EntityChanges) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *EntityChanges) GetEntities() []*EntityID {
+	if m != nil {
+		return m.Entities
+	}
+	return nil
+}
+
+func (m *EntityChanges) GetNetworkChanged() bool {
+	if m != nil {
+		return m.NetworkChanged
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("magma.orc8r.configurator.storage.ACL_Permission", ACL_Permission_name, ACL_Permission_value)
 	proto.RegisterEnum("magma.orc8r.configurator.storage.ACL_Wildcard", ACL_Wildcard_name, ACL_Wildcard_value)
@@ -1302,108 +1807,153 @@ func init() {
 	proto.RegisterMapType((map[string][]byte)(nil), "magma.orc8r.configurator.storage.NetworkUpdateCriteria.ConfigsToAddOrUpdateEntry")
 	proto.RegisterType((*EntityID)(nil), "magma.orc8r.configurator.storage.EntityID")
 	proto.RegisterType((*NetworkEntity)(nil), "magma.orc8r.configurator.storage.NetworkEntity")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.configurator.storage.NetworkEntity.LabelsEntry")
 	proto.RegisterType((*ACL)(nil), "magma.orc8r.configurator.storage.ACL")
 	proto.RegisterType((*ACL_NetworkIDs)(nil), "magma.orc8r.configurator.storage.ACL.NetworkIDs")
 	proto.RegisterType((*EntityLoadFilter)(nil), "magma.orc8r.configurator.storage.EntityLoadFilter")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.configurator.storage.EntityLoadFilter.LabelsEntry")
 	proto.RegisterType((*EntityLoadCriteria)(nil), "magma.orc8r.configurator.storage.EntityLoadCriteria")
 	proto.RegisterType((*EntityLoadResult)(nil), "magma.orc8r.configurator.storage.EntityLoadResult")
 	proto.RegisterType((*EntityUpdateCriteria)(nil), "magma.orc8r.configurator.storage.EntityUpdateCriteria")
+	proto.RegisterMapType((map[string]string)(nil), "magma.orc8r.configurator.storage.EntityUpdateCriteria.LabelsToAddOrUpdateEntry")
 	proto.RegisterType((*EntityAssociationsToSet)(nil), "magma.orc8r.configurator.storage.EntityAssociationsToSet")
 	proto.RegisterType((*EntityGraph)(nil), "magma.orc8r.configurator.storage.EntityGraph")
 	proto.RegisterType((*GraphEdge)(nil), "magma.orc8r.configurator.storage.GraphEdge")
+	proto.RegisterType((*NetworkRevision)(nil), "magma.orc8r.configurator.storage.NetworkRevision")
+	proto.RegisterMapType((map[string][]byte)(nil), "magma.orc8r.configurator.storage.NetworkRevision.ConfigsEntry")
+	proto.RegisterType((*EntityRevision)(nil), "magma.orc8r.configurator.storage.EntityRevision")
+	proto.RegisterType((*AuditEvent)(nil), "magma.orc8r.configurator.storage.AuditEvent")
+	proto.RegisterType((*AuditEventFilter)(nil), "magma.orc8r.configurator.storage.AuditEventFilter")
+	proto.RegisterType((*EntityChanges)(nil), "magma.orc8r.configurator.storage.EntityChanges")
 }
 
 func init() { proto.RegisterFile("storage.proto", fileDescriptor_0d2c4ccf1453ffdb) }
 
 var fileDescriptor_0d2c4ccf1453ffdb = []byte{
-	// 1421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xdd, 0x6e, 0xdb, 0xc6,
-	0x12, 0x36, 0x25, 0xd9, 0x92, 0x86, 0x92, 0xad, 0xac, 0xe5, 0x84, 0xc7, 0x27, 0x70, 0x14, 0x1e,
-	0x04, 0x70, 0x72, 0x10, 0x25, 0x55, 0x80, 0x24, 0x75, 0xd3, 0x02, 0xb2, 0x28, 0x27, 0x42, 0x1d,
-	0xd9, 0x65, 0x94, 0xba, 0x4d, 0x11, 0xb0, 0x8c, 0xb8, 0x96, 0x09, 0x4b, 0x5c, 0x81, 0x5c, 0x45,
-	0xd0, 0x13, 0xb4, 0x45, 0xfb, 0x42, 0xbd, 0xed, 0xcb, 0xb4, 0x40, 0x81, 0xde, 0xf5, 0xbe, 0xd8,
-	0x1f, 0x52, 0x94, 0x9c, 0xc0, 0x64, 0x5a, 0xa0, 0x77, 0xe4, 0xec, 0x7e, 0xdf, 0xee, 0xfc, 0xec,
-	0x37, 0x03, 0xe5, 0x80, 0x12, 0xdf, 0x1e, 0xe0, 0xfa, 0xd8, 0x27, 0x94, 0xa0, 0xda, 0xc8, 0x1e,
-	0x8c, 0xec, 0x3a, 0xf1, 0xfb, 0x8f, 0xfd, 0x7a, 0x9f, 0x78, 0xa7, 0xee, 0x60, 0xe2, 0xdb, 0x94,
-	0xf8, 0x75, 0xb9, 0x6f, 0x7b, 0x67, 0x40, 0xc8, 0x60, 0x88, 0xef, 0xf1, 0xfd, 0x6f, 0x26, 0xa7,
-	0xf7, 0xa6, 0xbe, 0x3d, 0x1e, 0x63, 0x3f, 0x10, 0x0c, 0xfa, 0x8f, 0x19, 0xc8, 0x77, 0x31, 0x9d,
-	0x12, 0xff, 0x1c, 0xad, 0x43, 0xa6, 0x63, 0x68, 0x4a, 0x4d, 0xd9, 0x2d, 0x9a, 0x99, 0x8e, 0x81,
-	0x10, 0xe4, 0x7a, 0xb3, 0x31, 0xd6, 0x32, 0xdc, 0xc2, 0xbf, 0x99, 0xcd, 0xb3, 0x47, 0x58, 0x03,
-	0x61, 0x63, 0xdf, 0xa8, 0x06, 0xaa, 0x83, 0x83, 0xbe, 0xef, 0x8e, 0xa9, 0x4b, 0x3c, 0x4d, 0xe5,
-	0x4b, 0x71, 0x13, 0x3a, 0x86, 0xbc, 0xb8, 0x5d, 0xa0, 0x55, 0x6b, 0xd9, 0x5d, 0xb5, 0xf1, 0xb0,
-	0x7e, 0xd9, 0xcd, 0xeb, 0xf2, 0x56, 0xf5, 0x96, 0x00, 0xb6, 0x3d, 0xea, 0xcf, 0xcc, 0x90, 0x06,
-	0x69, 0x90, 0x7f, 0x8b, 0xfd, 0x80, 0x9d, 0xb7, 0x53, 0x53, 0x76, 0x73, 0x66, 0xf8, 0xbb, 0xbd,
-	0x07, 0xa5, 0x38, 0x04, 0x55, 0x20, 0x7b, 0x8e, 0x67, 0xd2, 0x2d, 0xf6, 0x89, 0xaa, 0xb0, 0xfa,
-	0xd6, 0x1e, 0x4e, 0x84, 0x63, 0x25, 0x53, 0xfc, 0xec, 0x65, 0x1e, 0x2b, 0xba, 0x03, 0x57, 0xe4,
-	0xb1, 0x87, 0xc4, 0x76, 0x0e, 0xdc, 0x21, 0xc5, 0x3e, 0x23, 0x70, 0x9d, 0x40, 0x53, 0x6a, 0x59,
-	0x46, 0xe0, 0x3a, 0x01, 0xfa, 0x14, 0x54, 0x3a, 0x1b, 0x63, 0xeb, 0x94, 0x6f, 0xe0, 0x34, 0x6a,
-	0xe3, 0x7a, 0x5d, 0x84, 0xba, 0x1e, 0x86, 0xba, 0xfe, 0x82, 0xfa, 0xae, 0x37, 0xf8, 0x92, 0xb1,
-	0x9b, 0xc0, 0x00, 0x82, 0x50, 0x7f, 0x0d, 0x9b, 0xb1, 0x53, 0x5a, 0xbe, 0x4b, 0xb1, 0xef, 0xda,
-	0xe8, 0x7f, 0x50, 0x1e, 0x12, 0xdb, 0xb1, 0x46, 0x98, 0xda, 0x8e, 0x4d, 0x6d, 0x7e, 0xe5, 0x82,
-	0x59, 0x62, 0xc6, 0xe7, 0xd2, 0x86, 0x6e, 0x02, 0xff, 0xb7, 0xc2, 0x70, 0x66, 0xf8, 0x1e, 0x95,
-	0xd9, 0xa4, 0xd7, 0xfa, 0x4f, 0xca, 0x82, 0x17, 0x26, 0x0e, 0x26, 0x43, 0x8a, 0xda, 0x50, 0xf0,
-	0x84, 0x51, 0xb8, 0xa2, 0x36, 0x6e, 0x27, 0xce, 0x81, 0x19, 0x41, 0xd1, 0x7d, 0xa8, 0xca, 0xef,
-	0x8e, 0x11, 0x58, 0x1e, 0xa1, 0xd6, 0x29, 0x99, 0x78, 0x8e, 0x96, 0xe1, 0xd1, 0x41, 0xf3, 0xb5,
-	0x2e, 0xa1, 0x07, 0x6c, 0x45, 0xff, 0x3e, 0x07, 0x5b, 0x92, 0xe7, 0xe5, 0xd8, 0xb1, 0x29, 0x8e,
-	0x1c, 0x5e, 0xae, 0xb7, 0x5b, 0xb0, 0xee, 0xe0, 0x21, 0xa6, 0xd8, 0x92, 0x34, 0xbc, 0xca, 0x0a,
-	0x66, 0x59, 0x58, 0xc3, 0x32, 0x7d, 0xc4, 0x3c, 0x99, 0x5a, 0xbc, 0x0c, 0xab, 0x09, 0x42, 0x9f,
-	0xf7, 0xf0, 0xb4, 0xcb, 0xea, 0xb4, 0x0d, 0x1b, 0x0c, 0x18, 0xaf, 0xd5, 0xad, 0x04, 0xf8, 0x75,
-	0x0f, 0x4f, 0x8d, 0x58, 0x31, 0xcb, 0xf3, 0x59, 0x42, 0xb5, 0xab, 0x09, 0xcf, 0xe7, 0x6f, 0xe7,
-	0x07, 0x05, 0x34, 0x99, 0x37, 0x8b, 0x12, 0xcb, 0x76, 0x1c, 0x8b, 0xf8, 0xd6, 0x84, 0x07, 0x45,
-	0xdb, 0xe1, 0x39, 0xf9, 0x22, 0x71, 0x4e, 0x16, 0x63, 0x19, 0xbe, 0x92, 0x1e, 0x69, 0x3a, 0xce,
-	0x91, 0x2f, 0x16, 0xc5, 0x93, 0xa9, 0xf6, 0xdf, 0xb1, 0x84, 0xee, 0xc0, 0x95, 0xd8, 0x55, 0x44,
-	0x80, 0xb5, 0x1b, 0x3c, 0x89, 0x1b, 0x11, 0xc0, 0xe0, 0xe6, 0xed, 0xa7, 0xf0, 0x9f, 0xf7, 0xd2,
-	0xa7, 0x7a, 0x5e, 0xf7, 0xa1, 0xd0, 0xf6, 0xa8, 0x4b, 0x67, 0x42, 0x5c, 0x78, 0x04, 0x05, 0x90,
-	0x7f, 0x87, 0x5c, 0x99, 0x88, 0x4b, 0xff, 0x3d, 0x0b, 0x65, 0xe9, 0xb0, 0x40, 0xa2, 0xeb, 0x50,
-	0x8c, 0x8a, 0x4c, 0x82, 0xe7, 0x86, 0x88, 0x35, 0x73, 0x91, 0x35, 0x3b, 0xbf, 0xe1, 0x87, 0x89,
-	0xd8, 0x0e, 0xc0, 0xf8, 0x6c, 0x16, 0xb8, 0x7d, 0x7b, 0xd8, 0x31, 0x78, 0xe5, 0x15, 0xcd, 0x98,
-	0x05, 0x5d, 0x85, 0x35, 0x11, 0x39, 0xae, 0x48, 0x25, 0x53, 0xfe, 0x31, 0xa9, 0x1a, 0xf8, 0xf6,
-	0xf8, 0xac, 0x63, 0x68, 0xbb, 0x1c, 0x14, 0xfe, 0xa2, 0x2e, 0x94, 0xec, 0x20, 0x20, 0x7d, 0xd7,
-	0x66, 0x07, 0x04, 0x5a, 0x83, 0xd7, 0xc0, 0x9d, 0xcb, 0x6b, 0x20, 0x8c, 0xa2, 0xb9, 0x80, 0x47,
-	0xdf, 0xc0, 0xe6, 0xd8, 0xf6, 0xb1, 0x47, 0xad, 0x05, 0xda, 0x07, 0xa9, 0x69, 0x91, 0xa0, 0x69,
-	0xc6, 0xc9, 0x9f, 0x82, 0x3a, 0xc6, 0xfe, 0xc8, 0x0d, 0x02, 0x4e, 0xfa, 0x84, 0x93, 0xde, 0xba,
-	0x9c, 0xb4, 0xd9, 0x3a, 0x34, 0xe3, 0xc8, 0xb8, 0x74, 0x1f, 0x2c, 0x48, 0xb7, 0xfe, 0x5b, 0x0e,
-	0xb2, 0xcd, 0xd6, 0xe1, 0x05, 0x61, 0x78, 0x0d, 0x95, 0xa0, 0x4f, 0xc6, 0x91, 0x2e, 0x74, 0x8c,
-	0x80, 0xe7, 0x4e, 0x6d, 0xdc, 0x4f, 0x74, 0x7e, 0xf8, 0x66, 0x3a, 0x46, 0xf0, 0x6c, 0xc5, 0xdc,
-	0xe0, 0x5c, 0x73, 0x13, 0x3a, 0x81, 0x75, 0x41, 0x3f, 0x75, 0x87, 0x4e, 0xdf, 0xf6, 0x1d, 0x9e,
-	0xfd, 0xf5, 0x46, 0x3d, 0x19, 0xf9, 0x89, 0x44, 0x3d, 0x5b, 0x31, 0xcb, 0x9c, 0x27, 0x34, 0xa0,
-	0x63, 0x80, 0xb9, 0xe3, 0xbc, 0x62, 0xd6, 0x93, 0xde, 0xf8, 0x38, 0xc2, 0x99, 0x31, 0x0e, 0x74,
-	0x13, 0x54, 0xcc, 0x93, 0x24, 0xe4, 0x87, 0x15, 0x5a, 0xf1, 0x99, 0x62, 0x82, 0x30, 0x72, 0x95,
-	0x79, 0x09, 0x65, 0xde, 0x9c, 0x22, 0x67, 0x6e, 0x7c, 0x90, 0x33, 0x8a, 0x59, 0x62, 0x34, 0x91,
-	0x2f, 0xdb, 0x50, 0xe8, 0x18, 0xa2, 0x81, 0x69, 0xbb, 0x5c, 0x27, 0xa2, 0xff, 0x78, 0x46, 0x1b,
-	0x8b, 0xcd, 0x78, 0x07, 0x20, 0x16, 0xe8, 0x0a, 0x64, 0x59, 0xea, 0x64, 0x27, 0xed, 0x18, 0x81,
-	0xfe, 0x08, 0x60, 0xee, 0x29, 0x52, 0x21, 0xdf, 0x3d, 0xb2, 0x8e, 0xdb, 0xe6, 0xf3, 0xca, 0x0a,
-	0x2a, 0x40, 0xce, 0x6c, 0x37, 0x8d, 0x8a, 0x82, 0x8a, 0xb0, 0x7a, 0x62, 0x76, 0x7a, 0xed, 0x4a,
-	0x06, 0xe5, 0x21, 0x7b, 0x74, 0xd2, 0xad, 0x64, 0xf5, 0xbb, 0x50, 0x88, 0xae, 0xb6, 0x01, 0x6a,
-	0xf7, 0xc8, 0x3a, 0xe9, 0x1c, 0x1a, 0xad, 0xa6, 0x69, 0x54, 0x56, 0x50, 0x05, 0x4a, 0xe1, 0x9f,
-	0xd5, 0x3c, 0x3c, 0xac, 0x28, 0xfb, 0x79, 0x58, 0xe5, 0xa9, 0xd9, 0x5f, 0x13, 0x02, 0xa1, 0xff,
-	0x92, 0x81, 0x8a, 0x28, 0xf7, 0x58, 0xa7, 0x5f, 0xea, 0xeb, 0x4a, 0xba, 0xbe, 0x8e, 0x3e, 0x01,
-	0x38, 0xc7, 0xb3, 0x34, 0x53, 0x41, 0xf1, 0x1c, 0xcf, 0x24, 0xf8, 0x89, 0x88, 0x4d, 0x36, 0xf5,
-	0x5b, 0x65, 0x30, 0xf4, 0x70, 0xae, 0x31, 0xb9, 0x24, 0x2d, 0x29, 0x54, 0xa0, 0x27, 0x0b, 0x9a,
-	0xb6, 0x9a, 0xc4, 0xe1, 0xf9, 0x7e, 0xfd, 0x57, 0x05, 0xd0, 0x3c, 0x88, 0xe9, 0x06, 0x99, 0x1b,
-	0xa0, 0xc6, 0x06, 0x19, 0x39, 0xc7, 0xc0, 0x7c, 0x8e, 0x41, 0x77, 0x61, 0x93, 0x6f, 0xe0, 0x52,
-	0xc6, 0xbb, 0x14, 0x3d, 0x73, 0x03, 0x2e, 0xe3, 0x05, 0xb3, 0xc2, 0x96, 0xb8, 0x3c, 0x05, 0x3d,
-	0xd2, 0x3b, 0x73, 0x03, 0xf4, 0x11, 0x6c, 0xc5, 0xb7, 0x9f, 0xfa, 0x64, 0x24, 0x00, 0x39, 0x0e,
-	0x40, 0x73, 0xc0, 0x81, 0x4f, 0x46, 0x1c, 0x72, 0x1b, 0x38, 0x8d, 0x15, 0x97, 0xb5, 0x55, 0xbe,
-	0x7b, 0x83, 0xd9, 0xe7, 0x85, 0x19, 0xe8, 0x3f, 0x2b, 0xf1, 0x72, 0x91, 0x23, 0xd5, 0xe7, 0x50,
-	0xe0, 0xef, 0xce, 0xc5, 0xe1, 0x48, 0x75, 0x2f, 0x71, 0xfb, 0x16, 0x64, 0x66, 0x44, 0x80, 0xbe,
-	0x02, 0x14, 0x7e, 0x2f, 0x8d, 0x55, 0xe9, 0xca, 0xa1, 0x12, 0xb2, 0x44, 0x03, 0xd8, 0x9f, 0x6b,
-	0x50, 0x15, 0xcb, 0x4b, 0xf3, 0x57, 0xa2, 0x16, 0xcc, 0xb2, 0x29, 0xa7, 0x32, 0x21, 0x32, 0x72,
-	0x28, 0x2b, 0x09, 0xa3, 0xec, 0xca, 0xff, 0xf6, 0x4c, 0xd6, 0x02, 0x66, 0xb1, 0x62, 0xb5, 0x9c,
-	0x64, 0x32, 0x2b, 0x7b, 0x78, 0x7a, 0x3c, 0x6f, 0xe0, 0x7b, 0x00, 0x8c, 0x44, 0x56, 0xe4, 0x35,
-	0x4e, 0xf0, 0xdf, 0x0b, 0x04, 0xfb, 0x33, 0x8a, 0x03, 0xf9, 0x7c, 0x3d, 0x3c, 0x95, 0xd5, 0xea,
-	0xc2, 0x66, 0xbc, 0xe7, 0xb2, 0x72, 0x0d, 0x30, 0xe5, 0x02, 0xad, 0x36, 0x3e, 0x4e, 0x9a, 0xbf,
-	0x78, 0xc3, 0xed, 0x91, 0x17, 0x98, 0x9a, 0x57, 0xec, 0x65, 0x13, 0x7a, 0x75, 0xf1, 0x28, 0xdb,
-	0x71, 0xf8, 0xf0, 0x96, 0xae, 0x54, 0x96, 0xb8, 0x9b, 0x8e, 0x83, 0xbe, 0x85, 0xab, 0xcb, 0xdc,
-	0x72, 0x36, 0xac, 0xa5, 0xa6, 0xaf, 0x2e, 0xd2, 0x8b, 0x61, 0x12, 0x7d, 0x0d, 0x5b, 0xb1, 0xf7,
-	0xc6, 0x0e, 0xe8, 0xfb, 0x98, 0x0d, 0xc0, 0xbb, 0x69, 0x06, 0x8a, 0xcd, 0x18, 0x47, 0x8f, 0xb4,
-	0x38, 0xc3, 0x3b, 0xa8, 0xe5, 0x6c, 0x7d, 0xfb, 0xc3, 0xa9, 0xe5, 0xb8, 0xdc, 0xb8, 0x40, 0x2d,
-	0xc3, 0x72, 0x87, 0xf7, 0xb2, 0x45, 0x8c, 0xf0, 0x54, 0x9f, 0xc0, 0xb5, 0xf7, 0x64, 0xf5, 0x5d,
-	0x29, 0x64, 0xd5, 0xa2, 0xfc, 0xdd, 0x14, 0xbe, 0xc0, 0x54, 0xff, 0x43, 0x01, 0x55, 0xac, 0x3f,
-	0x65, 0x22, 0xff, 0xcf, 0xaa, 0xd4, 0x11, 0x94, 0x7d, 0x42, 0xa8, 0x15, 0x31, 0xa6, 0x17, 0xa8,
-	0x12, 0x23, 0x68, 0x87, 0x84, 0x4d, 0x58, 0xc5, 0xce, 0x00, 0x87, 0x8d, 0xef, 0xff, 0x97, 0x13,
-	0x71, 0xaf, 0xda, 0xce, 0x00, 0x9b, 0x02, 0xa9, 0x7f, 0xa7, 0x40, 0x31, 0x32, 0xa2, 0x3d, 0xc8,
-	0x50, 0x22, 0x5b, 0x77, 0x9a, 0x6b, 0x65, 0x28, 0x41, 0x9f, 0x41, 0x8e, 0xf5, 0x0d, 0xd9, 0xba,
-	0xd3, 0xa0, 0x39, 0x6e, 0xbf, 0xf8, 0x2a, 0x2f, 0x57, 0xde, 0xac, 0x71, 0xbd, 0x78, 0xf0, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xe9, 0x2f, 0xc4, 0xb1, 0x11, 0x00, 0x00,
+	// 1988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
+	0x15, 0x37, 0x40, 0x4a, 0x24, 0x1f, 0x48, 0x8a, 0x5e, 0xc9, 0x36, 0xa2, 0x24, 0x92, 0xc2, 0x4e,
+	0x5a, 0xd9, 0x9d, 0xd0, 0x2e, 0xdd, 0x71, 0x1c, 0xc5, 0xc9, 0x0c, 0x25, 0x52, 0x36, 0xa7, 0x8a,
+	0xa4, 0x42, 0xb4, 0x95, 0xba, 0x93, 0x41, 0x61, 0x62, 0x45, 0x61, 0x44, 0x02, 0x28, 0xb0, 0xb4,
+	0xc4, 0x9c, 0x7a, 0xe9, 0x34, 0x9d, 0xf6, 0x03, 0xf4, 0xd0, 0x5b, 0xbf, 0x44, 0x2f, 0xfd, 0x30,
+	0xbd, 0xf5, 0xd4, 0x7b, 0xa7, 0x97, 0xce, 0xbe, 0x5d, 0xfc, 0x21, 0x65, 0x45, 0xa0, 0x9d, 0x99,
+	0xde, 0xb0, 0x6f, 0xf7, 0xfd, 0xf6, 0xbd, 0xb7, 0x6f, 0xdf, 0xfe, 0x1e, 0xa0, 0x12, 0x32, 0x2f,
+	0xb0, 0x06, 0xb4, 0xe1, 0x07, 0x1e, 0xf3, 0xc8, 0xc6, 0xc8, 0x1a, 0x8c, 0xac, 0x86, 0x17, 0xf4,
+	0x1f, 0x07, 0x8d, 0xbe, 0xe7, 0x9e, 0x38, 0x83, 0x71, 0x60, 0x31, 0x2f, 0x68, 0xc8, 0x75, 0xab,
+	0x6b, 0x03, 0xcf, 0x1b, 0x0c, 0xe9, 0x7d, 0x5c, 0xff, 0x6a, 0x7c, 0x72, 0xff, 0x3c, 0xb0, 0x7c,
+	0x9f, 0x06, 0xa1, 0x40, 0xa8, 0xff, 0x49, 0x85, 0xc2, 0x3e, 0x65, 0xe7, 0x5e, 0x70, 0x46, 0xaa,
+	0xa0, 0x76, 0xdb, 0xba, 0xb2, 0xa1, 0x6c, 0x96, 0x0c, 0xb5, 0xdb, 0x26, 0x04, 0xf2, 0xbd, 0x89,
+	0x4f, 0x75, 0x15, 0x25, 0xf8, 0xcd, 0x65, 0xae, 0x35, 0xa2, 0x3a, 0x08, 0x19, 0xff, 0x26, 0x1b,
+	0xa0, 0xd9, 0x34, 0xec, 0x07, 0x8e, 0xcf, 0x1c, 0xcf, 0xd5, 0x35, 0x9c, 0x4a, 0x8b, 0xc8, 0x21,
+	0x14, 0x84, 0x75, 0xa1, 0xbe, 0xb2, 0x91, 0xdb, 0xd4, 0x9a, 0x8f, 0x1a, 0xd7, 0x59, 0xde, 0x90,
+	0x56, 0x35, 0x76, 0x84, 0x62, 0xc7, 0x65, 0xc1, 0xc4, 0x88, 0x60, 0x88, 0x0e, 0x85, 0xd7, 0x34,
+	0x08, 0xf9, 0x7e, 0x6b, 0x1b, 0xca, 0x66, 0xde, 0x88, 0x86, 0xab, 0x5b, 0x50, 0x4e, 0xab, 0x90,
+	0x1a, 0xe4, 0xce, 0xe8, 0x44, 0xba, 0xc5, 0x3f, 0xc9, 0x0a, 0x2c, 0xbc, 0xb6, 0x86, 0x63, 0xe1,
+	0x58, 0xd9, 0x10, 0x83, 0x2d, 0xf5, 0xb1, 0x52, 0xb7, 0xe1, 0xa6, 0xdc, 0x76, 0xcf, 0xb3, 0xec,
+	0x5d, 0x67, 0xc8, 0x68, 0xc0, 0x01, 0x1c, 0x3b, 0xd4, 0x95, 0x8d, 0x1c, 0x07, 0x70, 0xec, 0x90,
+	0x7c, 0x01, 0x1a, 0x9b, 0xf8, 0xd4, 0x3c, 0xc1, 0x05, 0x08, 0xa3, 0x35, 0x3f, 0x68, 0x88, 0x50,
+	0x37, 0xa2, 0x50, 0x37, 0x8e, 0x58, 0xe0, 0xb8, 0x83, 0x17, 0x1c, 0xdd, 0x00, 0xae, 0x20, 0x00,
+	0xeb, 0xdf, 0xc0, 0x72, 0x6a, 0x97, 0x9d, 0xc0, 0x61, 0x34, 0x70, 0x2c, 0xf2, 0x23, 0xa8, 0x0c,
+	0x3d, 0xcb, 0x36, 0x47, 0x94, 0x59, 0xb6, 0xc5, 0x2c, 0x34, 0xb9, 0x68, 0x94, 0xb9, 0xf0, 0x2b,
+	0x29, 0x23, 0x1f, 0x01, 0x8e, 0xcd, 0x28, 0x9c, 0x2a, 0xae, 0xd1, 0xb8, 0x4c, 0x7a, 0x5d, 0xff,
+	0xb3, 0x32, 0xe5, 0x85, 0x41, 0xc3, 0xf1, 0x90, 0x91, 0x0e, 0x14, 0x5d, 0x21, 0x14, 0xae, 0x68,
+	0xcd, 0xbb, 0x99, 0xcf, 0xc0, 0x88, 0x55, 0xc9, 0x03, 0x58, 0x91, 0xdf, 0xdd, 0x76, 0x68, 0xba,
+	0x1e, 0x33, 0x4f, 0xbc, 0xb1, 0x6b, 0xeb, 0x2a, 0x46, 0x87, 0x24, 0x73, 0xfb, 0x1e, 0xdb, 0xe5,
+	0x33, 0xf5, 0xef, 0xf2, 0x70, 0x4b, 0xe2, 0x3c, 0xf7, 0x6d, 0x8b, 0xd1, 0xd8, 0xe1, 0xd9, 0x7c,
+	0xfb, 0x18, 0xaa, 0x36, 0x1d, 0x52, 0x46, 0x4d, 0x09, 0x83, 0x59, 0x56, 0x34, 0x2a, 0x42, 0x1a,
+	0xa5, 0xe9, 0xa7, 0xdc, 0x93, 0x73, 0x13, 0xd3, 0x70, 0x25, 0x43, 0xe8, 0x0b, 0x2e, 0x3d, 0xdf,
+	0xe7, 0x79, 0xda, 0x81, 0x25, 0xae, 0x98, 0xce, 0xd5, 0x5b, 0x19, 0xf4, 0xab, 0x2e, 0x3d, 0x6f,
+	0xa7, 0x92, 0x59, 0xee, 0xcf, 0x0f, 0x54, 0xbf, 0x9d, 0x71, 0x7f, 0xbc, 0x3b, 0x7f, 0x54, 0x40,
+	0x97, 0xe7, 0x66, 0x32, 0xcf, 0xb4, 0x6c, 0xdb, 0xf4, 0x02, 0x73, 0x8c, 0x41, 0xd1, 0xd7, 0xf0,
+	0x4c, 0x7e, 0x99, 0xf9, 0x4c, 0xa6, 0x63, 0x19, 0xdd, 0x92, 0x9e, 0xd7, 0xb2, 0xed, 0x83, 0x40,
+	0x4c, 0x8a, 0x2b, 0xb3, 0xd2, 0x7f, 0xc3, 0x14, 0xb9, 0x07, 0x37, 0x53, 0xa6, 0x88, 0x00, 0xeb,
+	0xeb, 0x78, 0x88, 0x4b, 0xb1, 0x42, 0x1b, 0xc5, 0xab, 0x4f, 0xe1, 0xbd, 0x2b, 0xe1, 0xe7, 0xba,
+	0x5e, 0x0f, 0xa0, 0xd8, 0x71, 0x99, 0xc3, 0x26, 0xa2, 0xb8, 0x60, 0x04, 0x85, 0x22, 0x7e, 0x47,
+	0x58, 0x6a, 0x8c, 0x55, 0xff, 0x6f, 0x1e, 0x2a, 0xd2, 0x61, 0xa1, 0x49, 0x3e, 0x80, 0x52, 0x9c,
+	0x64, 0x52, 0x39, 0x11, 0xc4, 0xa8, 0xea, 0x65, 0xd4, 0x5c, 0x62, 0xe1, 0xdb, 0x15, 0xb1, 0x35,
+	0x00, 0xff, 0x74, 0x12, 0x3a, 0x7d, 0x6b, 0xd8, 0x6d, 0x63, 0xe6, 0x95, 0x8c, 0x94, 0x84, 0xdc,
+	0x86, 0x45, 0x11, 0x39, 0xac, 0x48, 0x65, 0x43, 0x8e, 0x78, 0xa9, 0x1a, 0x04, 0x96, 0x7f, 0xda,
+	0x6d, 0xeb, 0x9b, 0xa8, 0x14, 0x0d, 0xc9, 0x3e, 0x94, 0xad, 0x30, 0xf4, 0xfa, 0x8e, 0xc5, 0x37,
+	0x08, 0xf5, 0x26, 0xe6, 0xc0, 0xbd, 0xeb, 0x73, 0x20, 0x8a, 0xa2, 0x31, 0xa5, 0x4f, 0x7e, 0x0d,
+	0xcb, 0xbe, 0x15, 0x50, 0x97, 0x99, 0x53, 0xb0, 0x0f, 0xe7, 0x86, 0x25, 0x02, 0xa6, 0x95, 0x06,
+	0x7f, 0x0a, 0x9a, 0x4f, 0x83, 0x91, 0x13, 0x86, 0x08, 0xfa, 0x04, 0x41, 0x3f, 0xbe, 0x1e, 0xb4,
+	0xb5, 0xb3, 0x67, 0xa4, 0x35, 0xd3, 0xa5, 0x7b, 0x77, 0xaa, 0x74, 0x93, 0x23, 0x58, 0x1c, 0x5a,
+	0xaf, 0xe8, 0x30, 0xd4, 0x0f, 0x11, 0xfd, 0xf3, 0xcc, 0xb7, 0x41, 0x58, 0xde, 0xd8, 0x43, 0x6d,
+	0x91, 0xf7, 0x12, 0x6a, 0xf5, 0x33, 0xd0, 0x52, 0xe2, 0xeb, 0xf2, 0xb5, 0x94, 0xce, 0xd7, 0x7f,
+	0xe5, 0x21, 0xd7, 0xda, 0xd9, 0xbb, 0x54, 0xa8, 0xbe, 0x81, 0x5a, 0xd8, 0xf7, 0xfc, 0xb8, 0x4e,
+	0x75, 0xdb, 0x21, 0xe6, 0x92, 0xd6, 0x7c, 0x90, 0x29, 0x1e, 0x91, 0xd5, 0xdd, 0x76, 0xf8, 0xec,
+	0x86, 0xb1, 0x84, 0x58, 0x89, 0x88, 0x1c, 0x43, 0x55, 0xc0, 0x9f, 0x3b, 0x43, 0xbb, 0x6f, 0x05,
+	0x36, 0x66, 0x63, 0xb5, 0xd9, 0xc8, 0x06, 0x7e, 0x2c, 0xb5, 0x9e, 0xdd, 0x30, 0x2a, 0x88, 0x13,
+	0x09, 0xc8, 0x21, 0x40, 0x72, 0x10, 0x98, 0xc1, 0xd5, 0xac, 0x16, 0x1f, 0xc6, 0x7a, 0x46, 0x0a,
+	0x83, 0x7c, 0x04, 0x1a, 0xc5, 0xd0, 0x8b, 0x72, 0xc8, 0x13, 0xbf, 0xf4, 0x4c, 0x31, 0x40, 0x08,
+	0xb1, 0xea, 0x3d, 0x87, 0x0a, 0x9b, 0xa4, 0x9d, 0x59, 0x7f, 0x2b, 0x67, 0x14, 0xa3, 0xcc, 0x61,
+	0x62, 0x5f, 0x56, 0xa1, 0xd8, 0x6d, 0x8b, 0x07, 0x55, 0xdf, 0xc4, 0xba, 0x15, 0x8f, 0xd3, 0x19,
+	0xd6, 0x9c, 0x26, 0x07, 0x6b, 0x00, 0xa9, 0x40, 0xd7, 0x20, 0xd7, 0x6d, 0x8b, 0xe7, 0xb0, 0x64,
+	0xf0, 0xcf, 0xfa, 0xa7, 0x00, 0x89, 0xa7, 0x44, 0x83, 0xc2, 0xfe, 0x81, 0x79, 0xd8, 0x31, 0xbe,
+	0xaa, 0xdd, 0x20, 0x45, 0xc8, 0x1b, 0x9d, 0x56, 0xbb, 0xa6, 0x90, 0x12, 0x2c, 0x1c, 0x1b, 0xdd,
+	0x5e, 0xa7, 0xa6, 0x92, 0x02, 0xe4, 0x0e, 0x8e, 0xf7, 0x6b, 0xb9, 0xfa, 0x27, 0x50, 0x8c, 0x4d,
+	0x5b, 0x02, 0x6d, 0xff, 0xc0, 0x3c, 0xee, 0xee, 0xb5, 0x77, 0x5a, 0x46, 0xbb, 0x76, 0x83, 0xd4,
+	0xa0, 0x1c, 0x8d, 0xcc, 0xd6, 0xde, 0x5e, 0x4d, 0xd9, 0x2e, 0xc0, 0x02, 0x1e, 0xcd, 0xf6, 0xa2,
+	0x28, 0x58, 0xf5, 0xbf, 0x2d, 0x40, 0x4d, 0x24, 0x71, 0x8a, 0x79, 0xcc, 0xf0, 0x0c, 0x65, 0x3e,
+	0x9e, 0x41, 0x3e, 0x07, 0x38, 0xa3, 0x93, 0x79, 0x58, 0x4a, 0xe9, 0x8c, 0x4e, 0xa4, 0xf2, 0x13,
+	0x11, 0x9b, 0xdc, 0xdc, 0xb5, 0x83, 0xab, 0x91, 0x47, 0x49, 0xcd, 0xcb, 0x67, 0x79, 0x22, 0xa3,
+	0x8a, 0xf8, 0x64, 0xaa, 0xc6, 0x2e, 0x64, 0x71, 0x38, 0x59, 0x1f, 0x39, 0xec, 0x07, 0xf4, 0xc4,
+	0xb9, 0xd0, 0x17, 0x33, 0x3a, 0x7c, 0x88, 0xcb, 0xc9, 0xfb, 0x50, 0xf2, 0xad, 0x01, 0x35, 0x43,
+	0xe7, 0x5b, 0xaa, 0x17, 0x36, 0x94, 0xcd, 0x8a, 0x51, 0xe4, 0x82, 0x23, 0xe7, 0x5b, 0x4a, 0x3e,
+	0x04, 0xc0, 0x49, 0xe6, 0x9d, 0x51, 0x57, 0x2f, 0x8a, 0x67, 0x87, 0x4b, 0x7a, 0x5c, 0xc0, 0x4b,
+	0x08, 0x8f, 0x7b, 0xa8, 0x97, 0x30, 0x95, 0xc4, 0x80, 0x1f, 0x5f, 0x48, 0xad, 0xa0, 0x7f, 0x6a,
+	0x32, 0x7a, 0xc1, 0x74, 0xc8, 0x60, 0x0f, 0x08, 0x85, 0x1e, 0xbd, 0x60, 0xe4, 0x45, 0x5c, 0x0d,
+	0x35, 0x3c, 0x84, 0x2f, 0xb3, 0x1e, 0x42, 0x92, 0x41, 0x3f, 0x74, 0x41, 0xfc, 0x4e, 0x05, 0x92,
+	0xec, 0x31, 0x1f, 0x73, 0x5d, 0x07, 0x2d, 0xc5, 0x5c, 0x25, 0x71, 0x85, 0x84, 0xb8, 0x92, 0x4f,
+	0x60, 0x19, 0x17, 0xe0, 0xdb, 0x85, 0xb4, 0x84, 0x9d, 0x3a, 0x21, 0xbe, 0xdb, 0x45, 0xa3, 0xc6,
+	0xa7, 0xf0, 0x3d, 0x0a, 0x7b, 0x5e, 0xef, 0xd4, 0x09, 0xc9, 0xcf, 0xe0, 0x56, 0x7a, 0xf9, 0x49,
+	0xe0, 0x8d, 0x84, 0x42, 0x1e, 0x15, 0x48, 0xa2, 0xb0, 0x1b, 0x78, 0x23, 0x54, 0xb9, 0x0b, 0x08,
+	0x63, 0xa6, 0xdf, 0xb1, 0x05, 0x5c, 0xbd, 0xc4, 0xe5, 0xc9, 0xcd, 0x0f, 0x63, 0x6b, 0xe5, 0x09,
+	0x2c, 0x26, 0xd6, 0x8a, 0xd8, 0xd5, 0xff, 0xa9, 0xa4, 0x2f, 0xac, 0x24, 0xd9, 0xbf, 0x80, 0x22,
+	0x56, 0x3e, 0x87, 0x46, 0x24, 0xfb, 0xfe, 0x9c, 0x4f, 0x98, 0x11, 0x03, 0x90, 0xaf, 0x81, 0x44,
+	0xdf, 0x33, 0x44, 0x7b, 0xbe, 0x0b, 0x59, 0x8b, 0x50, 0x22, 0x4a, 0x4e, 0x7e, 0xcc, 0x89, 0xf0,
+	0x05, 0x33, 0x53, 0x29, 0x2d, 0xd8, 0x51, 0x85, 0x8b, 0x0f, 0xa3, 0xb4, 0xae, 0xff, 0xbd, 0x04,
+	0x2b, 0x02, 0x66, 0x86, 0xb9, 0x67, 0x22, 0x6f, 0x3c, 0x2d, 0x24, 0x9f, 0x17, 0xcf, 0x81, 0xa4,
+	0xf3, 0x65, 0x21, 0x94, 0x7c, 0xee, 0xff, 0xcd, 0xe6, 0x77, 0x80, 0x4b, 0xcc, 0x54, 0xd5, 0xc9,
+	0xc2, 0xe9, 0x2b, 0x2e, 0x3d, 0x3f, 0x4c, 0x0a, 0xcf, 0x16, 0x00, 0x07, 0x91, 0xa9, 0x7d, 0x07,
+	0x01, 0xde, 0xbf, 0x04, 0xb0, 0x3d, 0x61, 0x34, 0x94, 0x75, 0xc7, 0xa5, 0xe7, 0x32, 0xed, 0x1d,
+	0x58, 0x4e, 0xb3, 0x35, 0x9e, 0xf7, 0x21, 0x65, 0xf8, 0x94, 0x6a, 0xcd, 0xcf, 0xb2, 0x9e, 0x73,
+	0x9a, 0xaa, 0xf5, 0xbc, 0x23, 0xca, 0x8c, 0x9b, 0xd6, 0xac, 0x88, 0xbc, 0xbc, 0xbc, 0x95, 0x65,
+	0xdb, 0xfa, 0xfa, 0xdc, 0x29, 0x35, 0x83, 0xdd, 0xb2, 0x6d, 0xf2, 0x1b, 0xb8, 0x3d, 0x8b, 0x2d,
+	0xbb, 0x8a, 0x8d, 0xb9, 0xe1, 0x57, 0xa6, 0xe1, 0x45, 0x1b, 0x42, 0x7e, 0x05, 0xb7, 0x52, 0x17,
+	0x97, 0x6f, 0xd0, 0x0f, 0x28, 0x6f, 0x9d, 0x36, 0xe7, 0xa1, 0xa2, 0xcb, 0x29, 0x8c, 0x9e, 0xb7,
+	0x83, 0x08, 0x6f, 0x80, 0x96, 0x5d, 0xd9, 0xdd, 0xb7, 0x87, 0x96, 0x8d, 0x56, 0xf3, 0x12, 0xb4,
+	0x0c, 0xcb, 0x3d, 0x7c, 0x2a, 0xa6, 0x75, 0xa4, 0xa7, 0xbf, 0x57, 0xe0, 0x8e, 0x28, 0x3c, 0x97,
+	0xfb, 0x44, 0xd1, 0x23, 0x1c, 0x64, 0x8d, 0xe6, 0x4c, 0x9b, 0x28, 0x8a, 0xd7, 0x1b, 0xba, 0xc4,
+	0xe5, 0xe1, 0xe5, 0x19, 0xb2, 0x09, 0xb5, 0xc4, 0x0c, 0x69, 0xf6, 0x43, 0x34, 0xbb, 0x1a, 0x2d,
+	0x97, 0x16, 0x3f, 0x85, 0x1a, 0xbd, 0xf0, 0x69, 0x9f, 0x51, 0xdb, 0x8c, 0xa8, 0xd7, 0x93, 0x2b,
+	0xee, 0xd1, 0xf3, 0xae, 0xcb, 0x1e, 0xfd, 0x5c, 0xdc, 0x83, 0xa5, 0x48, 0xeb, 0x85, 0x24, 0x68,
+	0xbb, 0xa0, 0x5f, 0x65, 0xe3, 0x5c, 0x2f, 0xd5, 0x18, 0xee, 0x5c, 0x71, 0x31, 0xc8, 0xcb, 0x37,
+	0x5f, 0x38, 0xe5, 0x5d, 0x6f, 0xc1, 0x11, 0x65, 0xf5, 0x7f, 0x2b, 0xa0, 0x89, 0xf9, 0xa7, 0x9c,
+	0xd1, 0xfc, 0xb0, 0x0f, 0xc2, 0x01, 0x54, 0x02, 0xcf, 0x63, 0x66, 0x8c, 0x38, 0xff, 0x5b, 0x50,
+	0xe6, 0x00, 0x9d, 0x08, 0xb0, 0x05, 0x0b, 0xd4, 0x1e, 0xd0, 0x88, 0xe5, 0xfd, 0xf4, 0x7a, 0x20,
+	0xf4, 0xaa, 0x63, 0x0f, 0xa8, 0x21, 0x34, 0xeb, 0x7f, 0x50, 0xa0, 0x14, 0x0b, 0xc9, 0x16, 0xa8,
+	0xcc, 0x93, 0x3c, 0x75, 0x1e, 0xb3, 0x54, 0xe6, 0x91, 0x2f, 0x21, 0xcf, 0xdf, 0x70, 0x5d, 0x9d,
+	0x5b, 0x1b, 0xf5, 0xea, 0xbf, 0x53, 0x61, 0x29, 0xfa, 0x5f, 0x45, 0x5f, 0x3b, 0x48, 0xe0, 0xbf,
+	0xff, 0x67, 0x41, 0xaa, 0x4d, 0x50, 0xa7, 0x1b, 0xd1, 0xaf, 0x93, 0xff, 0x95, 0xb9, 0xac, 0xdc,
+	0x6b, 0x66, 0xef, 0x2b, 0xfe, 0x5b, 0xae, 0x83, 0x16, 0x50, 0x7f, 0x68, 0xf5, 0xa9, 0x6d, 0x5a,
+	0x0c, 0xb9, 0x4a, 0xce, 0x80, 0x48, 0xd4, 0x62, 0xef, 0xf4, 0xfb, 0xf2, 0x1f, 0x0a, 0x54, 0x65,
+	0xd6, 0x64, 0x8b, 0xc0, 0x16, 0x36, 0xb6, 0xf3, 0x47, 0x5c, 0x9d, 0x8e, 0x5e, 0x6e, 0x3a, 0x7a,
+	0xc9, 0x8f, 0x90, 0xfc, 0xd4, 0x8f, 0x90, 0x19, 0xdf, 0x17, 0x66, 0x7d, 0xaf, 0xff, 0x45, 0x05,
+	0x68, 0x8d, 0x6d, 0x87, 0x75, 0x5e, 0x53, 0x97, 0xf1, 0xb6, 0xdb, 0xb1, 0xa3, 0xb6, 0xdb, 0xb1,
+	0xa7, 0x7d, 0x51, 0x67, 0x7d, 0xd9, 0x86, 0x45, 0x49, 0x33, 0x72, 0x73, 0xfb, 0x23, 0x35, 0xb9,
+	0xe5, 0x56, 0x1f, 0xa9, 0x44, 0x1e, 0xe1, 0xe5, 0x88, 0x37, 0x9b, 0x9e, 0x4f, 0x51, 0x19, 0xcd,
+	0x2e, 0x19, 0xf1, 0x98, 0x5b, 0xc5, 0x9c, 0x11, 0x0d, 0x99, 0x35, 0xf2, 0x91, 0x27, 0xe6, 0x8c,
+	0x44, 0xc0, 0x39, 0xd0, 0x2b, 0x7a, 0xe2, 0x05, 0xd4, 0xb4, 0x9d, 0x01, 0x0d, 0x19, 0x76, 0x16,
+	0x25, 0xa3, 0x2c, 0x84, 0x6d, 0x94, 0xf1, 0x9f, 0xba, 0xd6, 0x09, 0xa3, 0x41, 0xb4, 0x46, 0xf4,
+	0x17, 0x1a, 0xca, 0xc4, 0x92, 0xfa, 0x7f, 0x14, 0xa8, 0x25, 0xa1, 0x91, 0x3d, 0xda, 0xf7, 0x1f,
+	0xee, 0x17, 0xd3, 0xbd, 0x79, 0xa6, 0xbf, 0xd4, 0xa9, 0xbe, 0xfd, 0x71, 0xca, 0xe7, 0x5c, 0x06,
+	0xdd, 0x24, 0x22, 0x1f, 0x02, 0x84, 0xcc, 0x0a, 0x98, 0xc9, 0xc3, 0x20, 0x53, 0xbc, 0x84, 0x92,
+	0x9e, 0x33, 0xa2, 0xe4, 0x3d, 0x5e, 0x13, 0x6d, 0x31, 0x29, 0x72, 0xa0, 0x40, 0x5d, 0x1b, 0xa7,
+	0x56, 0x60, 0x61, 0xe8, 0x8c, 0x1c, 0x86, 0x71, 0xac, 0x18, 0x62, 0x50, 0xff, 0xab, 0x02, 0x15,
+	0x71, 0x54, 0x3b, 0xa7, 0x96, 0x3b, 0xa0, 0x21, 0x3f, 0x8f, 0x90, 0xfe, 0x76, 0x4c, 0xdd, 0xbe,
+	0xe0, 0xa0, 0x79, 0x23, 0x1e, 0x93, 0x5d, 0x28, 0xbe, 0x43, 0x81, 0x4c, 0xaa, 0xed, 0x4f, 0x60,
+	0x49, 0xc6, 0xd2, 0xec, 0xe3, 0xb6, 0xb6, 0x6c, 0x45, 0xaa, 0x52, 0x2c, 0x8c, 0xb1, 0xb7, 0x4b,
+	0x2f, 0x0b, 0x12, 0xe6, 0xd5, 0x22, 0x46, 0xe6, 0xe1, 0xff, 0x06, 0x00, 0x0a, 0x9d, 0x65, 0x0d,
+	0xa7, 0x19, 0x00, 0x00,
 }
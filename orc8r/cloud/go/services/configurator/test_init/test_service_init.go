@@ -12,6 +12,7 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/orc8r"
 	accessd_test_init "magma/orc8r/cloud/go/services/accessd/test_init"
@@ -24,7 +25,17 @@ import (
 	"magma/orc8r/cloud/go/test_utils"
 )
 
+// testWatchPollInterval is how often the test service checks watched
+// networks for changes
+const testWatchPollInterval = 50 * time.Millisecond
+
 func StartTestService(t *testing.T) {
+	StartTestServiceWithACLPolicy(t, servicers.ACLPolicy{})
+}
+
+// StartTestServiceWithACLPolicy starts the configurator test service with the
+// given entity ACL enforcement policy
+func StartTestServiceWithACLPolicy(t *testing.T, aclPolicy servicers.ACLPolicy) {
 	db, err := sqorc.Open("sqlite3", ":memory:?_foreign_keys=1")
 	if err != nil {
 		t.Fatalf("Could not initialize sqlite DB: %s", err)
@@ -40,7 +51,7 @@ func StartTestService(t *testing.T) {
 	certifier_test_init.StartTestService(t)
 
 	srv, lis := test_utils.NewTestService(t, orc8r.ModuleName, configurator.ServiceName)
-	nb, err := servicers.NewNorthboundConfiguratorServicer(storageFactory)
+	nb, err := servicers.NewNorthboundConfiguratorServicerWithWatchInterval(storageFactory, aclPolicy, testWatchPollInterval)
 	if err != nil {
 		t.Fatalf("Failed to create NB configurator servicer: %s", err)
 	}
@@ -9,7 +9,11 @@
 package configurator
 
 import (
+	"sort"
+
+	commonProtos "magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/configurator/protos"
 	"magma/orc8r/cloud/go/services/configurator/storage"
 	storage2 "magma/orc8r/cloud/go/storage"
 
@@ -144,10 +148,15 @@ type NetworkEntity struct {
 	// creation.
 	ParentAssociations []storage2.TypeAndKey
 
-	// Note that we are not exposing permissions in the client API at this
-	// time
+	// Permissions are the ACLs which this entity grants on access to other
+	// entities. These are only enforced for entities which represent a
+	// caller, e.g. operators.
+	Permissions []*storage.ACL
 
 	Version uint64
+
+	// Labels are arbitrary key-value pairs which entities can be searched by
+	Labels map[string]string
 }
 
 func (ent NetworkEntity) toStorageProto() (*storage.NetworkEntity, error) {
@@ -160,6 +169,8 @@ func (ent NetworkEntity) toStorageProto() (*storage.NetworkEntity, error) {
 		PhysicalID:  ent.PhysicalID,
 
 		Associations: tksToEntIDs(ent.Associations),
+		Permissions:  ent.Permissions,
+		Labels:       ent.Labels,
 
 		// don't set graphID, parent assocs, or version because those are
 		// read-only fields
@@ -186,7 +197,9 @@ func (ent NetworkEntity) fromStorageProto(protoEnt *storage.NetworkEntity) (Netw
 	ent.GraphID = protoEnt.GraphID
 	ent.Associations = entIDsToTKs(protoEnt.Associations)
 	ent.ParentAssociations = entIDsToTKs(protoEnt.ParentAssociations)
+	ent.Permissions = protoEnt.Permissions
 	ent.Version = protoEnt.Version
+	ent.Labels = protoEnt.Labels
 
 	if !funk.IsEmpty(protoEnt.Config) {
 		iConfig, err := serde.Deserialize(NetworkEntitySerdeDomain, ent.Type, protoEnt.Config)
@@ -286,6 +299,10 @@ type EntityLoadCriteria struct {
 
 	LoadAssocsToThis   bool
 	LoadAssocsFromThis bool
+
+	LoadPermissions bool
+
+	LoadLabels bool
 }
 
 func (elc EntityLoadCriteria) toStorageProto() *storage.EntityLoadCriteria {
@@ -294,6 +311,8 @@ func (elc EntityLoadCriteria) toStorageProto() *storage.EntityLoadCriteria {
 		LoadConfig:         elc.LoadConfig,
 		LoadAssocsToThis:   elc.LoadAssocsToThis,
 		LoadAssocsFromThis: elc.LoadAssocsFromThis,
+		LoadPermissions:    elc.LoadPermissions,
+		LoadLabels:         elc.LoadLabels,
 	}
 }
 
@@ -305,7 +324,33 @@ func FullEntityLoadCriteria() EntityLoadCriteria {
 		LoadConfig:         true,
 		LoadAssocsToThis:   true,
 		LoadAssocsFromThis: true,
+		LoadLabels:         true,
+	}
+}
+
+// EntitySearchFilter specifies which entities of a network to search for.
+// Entities have to match every non-empty field of the filter.
+type EntitySearchFilter struct {
+	// Types restricts the search to entities of the given types
+	Types []string
+
+	// Text matches entities whose key, name, description, or physical ID
+	// contains it, case-insensitively
+	Text string
+
+	// Labels matches entities which have all of the given labels
+	Labels map[string]string
+}
+
+func (esf EntitySearchFilter) toStorageProto() *storage.EntityLoadFilter {
+	ret := &storage.EntityLoadFilter{
+		Types:  esf.Types,
+		Labels: esf.Labels,
 	}
+	if esf.Text != "" {
+		ret.SearchText = &wrappers.StringValue{Value: esf.Text}
+	}
+	return ret
 }
 
 // EntityLoadResult encapsulates the result of a LoadEntities call
@@ -349,6 +394,20 @@ type EntityUpdateCriteria struct {
 	AssociationsToSet    []storage2.TypeAndKey
 	AssociationsToAdd    []storage2.TypeAndKey
 	AssociationsToDelete []storage2.TypeAndKey
+
+	// New ACLs to add. ACL IDs are ignored and generated by the system.
+	PermissionsToCreate []*storage.ACL
+	PermissionsToUpdate []*storage.ACL
+	PermissionsToDelete []string
+
+	// Labels to add or whose values to update, keyed by label key
+	LabelsToAddOrUpdate map[string]string
+	// Keys of labels to delete
+	LabelsToDelete []string
+
+	// If set, the update fails with an Aborted error unless the entity is at
+	// this version. Ignored when deleting the entity.
+	ExpectedVersion *uint64
 }
 
 func (euc EntityUpdateCriteria) toStorageProto() (*storage.EntityUpdateCriteria, error) {
@@ -361,8 +420,16 @@ func (euc EntityUpdateCriteria) toStorageProto() (*storage.EntityUpdateCriteria,
 		NewPhysicalID:        strPtrToWrapper(euc.NewPhysicalID),
 		AssociationsToAdd:    tksToEntIDs(euc.AssociationsToAdd),
 		AssociationsToDelete: tksToEntIDs(euc.AssociationsToDelete),
+		PermissionsToCreate:  euc.PermissionsToCreate,
+		PermissionsToUpdate:  euc.PermissionsToUpdate,
+		PermissionsToDelete:  euc.PermissionsToDelete,
+		LabelsToAddOrUpdate:  euc.LabelsToAddOrUpdate,
+		LabelsToDelete:       euc.LabelsToDelete,
 	}
 
+	if euc.ExpectedVersion != nil {
+		ret.ExpectedVersion = &wrappers.UInt64Value{Value: *euc.ExpectedVersion}
+	}
 	if euc.AssociationsToSet != nil {
 		ret.AssociationsToSet = &storage.EntityAssociationsToSet{
 			AssociationsToSet: tksToEntIDs(euc.AssociationsToSet),
@@ -389,6 +456,178 @@ func (euc EntityUpdateCriteria) GetTypeAndKey() storage2.TypeAndKey {
 
 func (euc EntityUpdateCriteria) isEntityWriteOperation() {}
 
+// NetworkRevision is a retained version of the configs of a network
+type NetworkRevision struct {
+	Version uint64
+	// Configs of the network at this version, keyed by type
+	Configs map[string]interface{}
+	// ReplacedAt is the unix time in seconds at which this version was
+	// replaced by a newer one. 0 if this is the current version.
+	ReplacedAt int64
+}
+
+func (nr NetworkRevision) fromStorageProto(protoRev *storage.NetworkRevision) (NetworkRevision, error) {
+	iConfigs, err := unmarshalConfigs(protoRev.Configs, NetworkConfigSerdeDomain)
+	if err != nil {
+		return nr, errors.Wrapf(err, "error deserializing revision %d of network %s", protoRev.Version, protoRev.NetworkID)
+	}
+
+	nr.Version = protoRev.Version
+	nr.Configs = iConfigs
+	nr.ReplacedAt = protoRev.ReplacedAt
+	return nr, nil
+}
+
+// EntityRevision is a retained version of the config of an entity
+type EntityRevision struct {
+	Version uint64
+	// Config of the entity at this version. nil if the entity had no config.
+	Config interface{}
+	// ReplacedAt is the unix time in seconds at which this version was
+	// replaced by a newer one. 0 if this is the current version.
+	ReplacedAt int64
+}
+
+func (er EntityRevision) fromStorageProto(protoRev *storage.EntityRevision) (EntityRevision, error) {
+	er.Version = protoRev.Version
+	er.ReplacedAt = protoRev.ReplacedAt
+
+	if !funk.IsEmpty(protoRev.Config) {
+		iConfig, err := serde.Deserialize(NetworkEntitySerdeDomain, protoRev.ID.Type, protoRev.Config)
+		if err != nil {
+			return er, errors.Wrapf(err, "failed to deserialize revision %d of entity %s", protoRev.Version, protoRev.ID.ToTypeAndKey())
+		}
+		er.Config = iConfig
+	}
+	return er, nil
+}
+
+// AuditEvent records a single change made to a network or one of its
+// entities through the northbound API
+type AuditEvent struct {
+	ID        string
+	NetworkID string
+	// Entity is the changed entity. nil if the network itself was changed.
+	Entity *storage2.TypeAndKey
+	// Action is one of storage.AuditActionCreate, storage.AuditActionUpdate,
+	// storage.AuditActionDelete
+	Action string
+	// Operator which made the change. Empty if the change wasn't made on
+	// behalf of a caller.
+	Operator string
+	// Timestamp is the unix time in seconds at which the change was made
+	Timestamp int64
+	// BeforeDigest and AfterDigest are hex-encoded SHA-256 digests of the
+	// serialized config before and after the change. Empty if there was no
+	// config.
+	BeforeDigest string
+	AfterDigest  string
+}
+
+func (ae AuditEvent) fromStorageProto(protoEvent *storage.AuditEvent) AuditEvent {
+	ae.ID = protoEvent.Id
+	ae.NetworkID = protoEvent.NetworkID
+	if protoEvent.Entity != nil {
+		tk := protoEvent.Entity.ToTypeAndKey()
+		ae.Entity = &tk
+	}
+	ae.Action = protoEvent.Action
+	ae.Operator = protoEvent.Operator
+	ae.Timestamp = protoEvent.Timestamp
+	ae.BeforeDigest = protoEvent.BeforeDigest
+	ae.AfterDigest = protoEvent.AfterDigest
+	return ae
+}
+
+// AuditEventFilter specifies which audit events of a network to load
+type AuditEventFilter struct {
+	// If EntityType is provided, only events for entities of this type are
+	// loaded
+	EntityType *string
+	// If Operator is provided, only events for changes made by this operator
+	// are loaded
+	Operator *string
+	// StartTime and EndTime are inclusive bounds on the event timestamp in
+	// unix seconds. 0 means unbounded.
+	StartTime int64
+	EndTime   int64
+	// Limit is the maximum number of events to load. 0 means no limit.
+	Limit uint32
+}
+
+func (aef AuditEventFilter) toStorageProto(networkID string) *storage.AuditEventFilter {
+	return &storage.AuditEventFilter{
+		NetworkID:  networkID,
+		EntityType: strPtrToWrapper(aef.EntityType),
+		Operator:   strPtrToWrapper(aef.Operator),
+		StartTime:  aef.StartTime,
+		EndTime:    aef.EndTime,
+		Limit:      aef.Limit,
+	}
+}
+
+// MconfigImpactReport describes how a dry-run write would change the mconfigs
+// of the gateways it affects
+type MconfigImpactReport struct {
+	// Gateways ordered by network ID, then gateway ID
+	Gateways []GatewayMconfigImpact
+}
+
+func (mir MconfigImpactReport) fromProto(protoReport *protos.MconfigImpactReport) (MconfigImpactReport, error) {
+	mir.Gateways = make([]GatewayMconfigImpact, 0, len(protoReport.GetGateways()))
+	for _, protoImpact := range protoReport.GetGateways() {
+		impact, err := (GatewayMconfigImpact{}).fromProto(protoImpact)
+		if err != nil {
+			return mir, err
+		}
+		mir.Gateways = append(mir.Gateways, impact)
+	}
+	return mir, nil
+}
+
+// GatewayMconfigImpact compares the mconfig of a gateway before and after a
+// dry-run write
+type GatewayMconfigImpact struct {
+	NetworkID string
+	GatewayID string
+	// BeforeDigest and AfterDigest are the digests of the mconfig before and
+	// after the write. BeforeDigest is empty if the write creates the
+	// gateway, AfterDigest is empty if the write deletes it.
+	BeforeDigest string
+	AfterDigest  string
+	// Changes between the mconfigs, rooted at the mconfig key
+	Changes []ConfigChange
+}
+
+// Changed returns true if the write changes the mconfig of the gateway
+func (gmi GatewayMconfigImpact) Changed() bool {
+	return gmi.BeforeDigest != gmi.AfterDigest
+}
+
+func (gmi GatewayMconfigImpact) fromProto(protoImpact *protos.GatewayMconfigImpact) (GatewayMconfigImpact, error) {
+	gmi.NetworkID = protoImpact.NetworkID
+	gmi.GatewayID = protoImpact.GatewayID
+
+	var err error
+	if protoImpact.Before != nil {
+		gmi.BeforeDigest, err = getMconfigDigest(protoImpact.Before)
+		if err != nil {
+			return gmi, errors.Wrapf(err, "failed to digest mconfig of gateway %s", protoImpact.GatewayID)
+		}
+	}
+	if protoImpact.After != nil {
+		gmi.AfterDigest, err = getMconfigDigest(protoImpact.After)
+		if err != nil {
+			return gmi, errors.Wrapf(err, "failed to digest mconfig of gateway %s", protoImpact.GatewayID)
+		}
+	}
+	gmi.Changes, err = diffMconfigs(protoImpact.Before, protoImpact.After)
+	if err != nil {
+		return gmi, errors.Wrapf(err, "failed to diff mconfigs of gateway %s", protoImpact.GatewayID)
+	}
+	return gmi, nil
+}
+
 func marshalConfigs(configs map[string]interface{}, domain string) (map[string][]byte, error) {
 	ret := map[string][]byte{}
 	for configType, iConfig := range configs {
@@ -420,6 +659,74 @@ func strPtrToWrapper(in *string) *wrappers.StringValue {
 	return &wrappers.StringValue{Value: *in}
 }
 
+// ImportNetworkOptions specifies where and how an exported network is
+// imported
+type ImportNetworkOptions struct {
+	// TargetNetworkID defaults to the ID of the exported network
+	TargetNetworkID string
+	// KeyRemaps are new keys for exported entities. Associations to remapped
+	// entities are remapped as well.
+	KeyRemaps map[storage2.TypeAndKey]string
+	// PhysicalIDRemaps are new physical IDs keyed by exported physical ID
+	PhysicalIDRemaps map[string]string
+	// ConflictPolicy decides what happens to a target network or entities
+	// which already exist
+	ConflictPolicy protos.ImportNetworkRequest_ConflictPolicy
+}
+
+func (opts ImportNetworkOptions) toProto(caller *commonProtos.Identity, export *protos.NetworkExport) *protos.ImportNetworkRequest {
+	ret := &protos.ImportNetworkRequest{
+		Export:           export,
+		TargetNetworkID:  opts.TargetNetworkID,
+		PhysicalIDRemaps: opts.PhysicalIDRemaps,
+		ConflictPolicy:   opts.ConflictPolicy,
+		Caller:           caller,
+	}
+	for from, toKey := range opts.KeyRemaps {
+		ret.KeyRemaps = append(ret.KeyRemaps, &protos.EntityKeyRemap{From: (&storage.EntityID{}).FromTypeAndKey(from), ToKey: toKey})
+	}
+	sort.Slice(ret.KeyRemaps, func(i, j int) bool {
+		return ret.KeyRemaps[i].From.ToTypeAndKey().String() < ret.KeyRemaps[j].From.ToTypeAndKey().String()
+	})
+	return ret
+}
+
+// ImportNetworkResult lists the imported entities by what happened to them.
+// IDs are the IDs after remapping.
+type ImportNetworkResult struct {
+	NetworkCreated bool
+	Created        []storage2.TypeAndKey
+	Overwritten    []storage2.TypeAndKey
+	Skipped        []storage2.TypeAndKey
+}
+
+func (inr ImportNetworkResult) fromProto(protoRes *protos.ImportNetworkResponse) ImportNetworkResult {
+	inr.NetworkCreated = protoRes.NetworkCreated
+	inr.Created = entIDsToTKs(protoRes.Created)
+	inr.Overwritten = entIDsToTKs(protoRes.Overwritten)
+	inr.Skipped = entIDsToTKs(protoRes.Skipped)
+	return inr
+}
+
+// EntityChanges are the changes made to a network and its entities after a
+// point of the network's change sequence
+type EntityChanges struct {
+	// Sequence is the sequence number of the most recent change to the network
+	Sequence uint64
+	// Entities were created, updated or deleted, or had associations added or
+	// removed
+	Entities []storage2.TypeAndKey
+	// NetworkChanged is true if the network itself was updated
+	NetworkChanged bool
+}
+
+func (ec EntityChanges) fromStorageProto(protoChanges *storage.EntityChanges) EntityChanges {
+	ec.Sequence = protoChanges.Sequence
+	ec.Entities = entIDsToTKs(protoChanges.Entities)
+	ec.NetworkChanged = protoChanges.NetworkChanged
+	return ec
+}
+
 func tksToEntIDs(tks []storage2.TypeAndKey) []*storage.EntityID {
 	if funk.IsEmpty(tks) {
 		return nil
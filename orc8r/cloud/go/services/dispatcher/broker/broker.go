@@ -10,10 +10,16 @@ package broker
 
 import (
 	"errors"
+	"fmt"
+	"io"
+	"sync"
 	"time"
 
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/dispatcher/broker/memstore"
+
+	"github.com/golang/glog"
+	"golang.org/x/net/context"
 )
 
 const (
@@ -34,6 +40,10 @@ type GatewayRPCBroker interface {
 	// to a certain gateway, and waits on the response channel for response.
 	// The caller should time out on the response channel.
 	SendRequestToGateway(gwReq *protos.GatewayRequest) (*GatewayResponseChannel, error)
+	// SendRequestToLocalGateway is the same as SendRequestToGateway, but
+	// never forwards the request to another dispatcher replica. It is called
+	// by the SyncRPC forwarding servicer.
+	SendRequestToLocalGateway(gwReq *protos.GatewayRequest) (*GatewayResponseChannel, error)
 	// ProcessGatewayResponse is called by the SyncRPC servicer. It receives
 	// a SyncRPCResponse from the SyncRPC servicer, and send the corresponding GatewayResponse to the HTTP server
 	ProcessGatewayResponse(response *protos.SyncRPCResponse) error
@@ -49,9 +59,21 @@ type GatewayRPCBroker interface {
 }
 
 // GatewayRPCBrokerImpl implements a GatewayRPCBroker, managing a response table and request queue.
+//
+// A broker of a replicated dispatcher also records itself as the owner of
+// the gateways connected to it, and forwards requests for gateways connected
+// to other replicas to their owners.
 type GatewayRPCBrokerImpl struct {
 	responseTable memstore.ResponseTable
 	requests      memstore.RequestQueue
+
+	// replica is the name of this dispatcher replica, if replicated
+	replica   string
+	ownership GatewayOwnership
+	forwarder RequestForwarder
+	// forwardedRequests is a map: <uint32, context.CancelFunc> of the
+	// requests forwarded to other replicas
+	forwardedRequests *sync.Map
 }
 
 func NewGatewayReqRespBroker() *GatewayRPCBrokerImpl {
@@ -60,8 +82,42 @@ func NewGatewayReqRespBroker() *GatewayRPCBrokerImpl {
 	return &GatewayRPCBrokerImpl{responseTable: respTable, requests: requests}
 }
 
+// NewReplicatedGatewayReqRespBroker returns a broker for the dispatcher
+// replica named replica, which shares gateway ownership with the other
+// replicas and forwards requests to them with forwarder.
+func NewReplicatedGatewayReqRespBroker(
+	replica string,
+	ownership GatewayOwnership,
+	forwarder RequestForwarder,
+) *GatewayRPCBrokerImpl {
+	broker := NewGatewayReqRespBroker()
+	broker.replica = replica
+	broker.ownership = ownership
+	broker.forwarder = forwarder
+	broker.forwardedRequests = &sync.Map{}
+	return broker
+}
+
 func (broker *GatewayRPCBrokerImpl) SendRequestToGateway(
 	gwReq *protos.GatewayRequest,
+) (*GatewayResponseChannel, error) {
+	if gwReq == nil || len(gwReq.GwId) == 0 {
+		return nil, errors.New("gwReq cannot be nil and gwId cannot be empty string")
+	}
+	if broker.ownership == nil || broker.requests.HasQueue(gwReq.GwId) {
+		return broker.SendRequestToLocalGateway(gwReq)
+	}
+	owner, err := broker.ownership.GetOwner(gwReq.GwId)
+	if err != nil || owner == broker.replica {
+		// The gateway may be (re)connecting to this replica, in which case
+		// enqueueing waits for its queue to be initialized.
+		return broker.SendRequestToLocalGateway(gwReq)
+	}
+	return broker.forwardRequest(owner, gwReq)
+}
+
+func (broker *GatewayRPCBrokerImpl) SendRequestToLocalGateway(
+	gwReq *protos.GatewayRequest,
 ) (*GatewayResponseChannel, error) {
 	if gwReq == nil || len(gwReq.GwId) == 0 {
 		return nil, errors.New("gwReq cannot be nil and gwId cannot be empty string")
@@ -83,6 +139,13 @@ func (broker *GatewayRPCBrokerImpl) InitializeGateway(gwId string) chan *protos.
 	// Also returns the old queue that requests in which can be cancelled.
 	// As we don't do anything now, the requests will just time out.
 	initializedQueue := broker.requests.InitializeQueue(gwId)
+	if broker.ownership != nil {
+		err := broker.ownership.SetOwner(gwId, broker.replica)
+		if err != nil {
+			// The owner is also refreshed on every gateway heartbeat, so only log.
+			glog.Errorf("Failed to set owner of gwId %v to %v: %v\n", gwId, broker.replica, err)
+		}
+	}
 	return initializedQueue.NewQueue
 }
 
@@ -94,9 +157,64 @@ func (broker *GatewayRPCBrokerImpl) CleanupGateway(gwId string) error {
 }
 
 func (broker *GatewayRPCBrokerImpl) CancelGatewayRequest(gwId string, reqId uint32) error {
+	if broker.forwardedRequests != nil {
+		if cancel, ok := broker.forwardedRequests.Load(reqId); ok {
+			// The owner replica notifies the gateway once the forwarded
+			// request is cancelled.
+			cancel.(context.CancelFunc)()
+			broker.forwardedRequests.Delete(reqId)
+			return nil
+		}
+	}
 	syncRPCRequest := &protos.SyncRPCRequest{ReqId: reqId, ReqBody: &protos.GatewayRequest{GwId: gwId}, ConnClosed: true}
 	if err := broker.requests.Enqueue(syncRPCRequest); err != nil {
 		return err
 	}
 	return nil
 }
+
+// forwardRequest forwards gwReq to the replica owner, and sends the
+// GatewayResponses streamed back by owner to the returned response channel.
+func (broker *GatewayRPCBrokerImpl) forwardRequest(
+	owner string,
+	gwReq *protos.GatewayRequest,
+) (*GatewayResponseChannel, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err := broker.forwarder.ForwardRequest(ctx, owner, gwReq)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("Failed to forward request for gwId %v to %v: %v\n", gwReq.GwId, owner, err)
+	}
+	respChan, reqId := broker.responseTable.InitializeResponse()
+	// The entry is removed by CancelGatewayRequest, which the HTTP server
+	// calls once it is done with the request.
+	broker.forwardedRequests.Store(reqId, cancel)
+	go broker.receiveForwardedResponses(ctx, cancel, stream, reqId)
+	return &GatewayResponseChannel{RespChan: respChan, ReqId: reqId}, nil
+}
+
+func (broker *GatewayRPCBrokerImpl) receiveForwardedResponses(
+	ctx context.Context,
+	cancel context.CancelFunc,
+	stream protos.SyncRPCForwardingService_ForwardGatewayRequestClient,
+	reqId uint32,
+) {
+	defer cancel()
+	for {
+		gwResp, err := stream.Recv()
+		if err == io.EOF || ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			gwResp = &protos.GatewayResponse{Err: fmt.Sprintf("forwarded request failed: %v", err)}
+		}
+		sendErr := broker.responseTable.SendResponse(&protos.SyncRPCResponse{ReqId: reqId, RespBody: gwResp})
+		if sendErr != nil {
+			glog.Errorf("err processing forwarded gateway response: %v\n", sendErr)
+			return
+		}
+		if err != nil {
+			return
+		}
+	}
+}
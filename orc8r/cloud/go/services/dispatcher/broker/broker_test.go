@@ -0,0 +1,133 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/broker"
+	"magma/orc8r/cloud/go/services/dispatcher/servicers"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+const (
+	replica1 = "replica1"
+	replica2 = "replica2"
+	gwId     = "gwId1"
+)
+
+// startReplicas starts two dispatcher replicas sharing gateway ownership,
+// each serving the SyncRPCForwardingService on a local port.
+func startReplicas(t *testing.T) (*broker.GatewayRPCBrokerImpl, *broker.GatewayRPCBrokerImpl) {
+	addresses := map[string]string{}
+	forwarder := broker.NewGRPCRequestForwarderWithAddresses(func(replica string) string {
+		return addresses[replica]
+	})
+	ownership := broker.NewMemoryGatewayOwnership()
+	broker1 := broker.NewReplicatedGatewayReqRespBroker(replica1, ownership, forwarder)
+	broker2 := broker.NewReplicatedGatewayReqRespBroker(replica2, ownership, forwarder)
+	addresses[replica1] = startForwardingServer(t, broker1)
+	addresses[replica2] = startForwardingServer(t, broker2)
+	return broker1, broker2
+}
+
+func startForwardingServer(t *testing.T, gwBroker broker.GatewayRPCBroker) string {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %s", err)
+	}
+	srv := grpc.NewServer()
+	protos.RegisterSyncRPCForwardingServiceServer(srv, servicers.NewSyncRPCForwardingService(gwBroker))
+	go srv.Serve(lis)
+	return lis.Addr().String()
+}
+
+func TestGatewayRPCBrokerImpl_ForwardRequest(t *testing.T) {
+	broker1, broker2 := startReplicas(t)
+	// gateway connects to replica2
+	queue := broker2.InitializeGateway(gwId)
+
+	gwReq := &protos.GatewayRequest{GwId: gwId, Authority: "test_authority", Path: "test path", Payload: []byte("test payload")}
+	gwRespChannel, err := broker1.SendRequestToGateway(gwReq)
+	assert.NoError(t, err)
+
+	syncRPCReq := receiveRequest(t, queue)
+	assert.Equal(t, protos.TestMarshal(gwReq), protos.TestMarshal(syncRPCReq.ReqBody))
+
+	// responses of the gateway are streamed back to replica1
+	gwResp1 := &protos.GatewayResponse{Status: "200", Payload: []byte("frame 1")}
+	gwResp2 := &protos.GatewayResponse{Status: "200", Headers: map[string]string{"grpc-status": "0"}}
+	assert.NoError(t, broker2.ProcessGatewayResponse(&protos.SyncRPCResponse{ReqId: syncRPCReq.ReqId, RespBody: gwResp1}))
+	assert.Equal(t, protos.TestMarshal(gwResp1), protos.TestMarshal(receiveResponse(t, gwRespChannel.RespChan)))
+	assert.NoError(t, broker2.ProcessGatewayResponse(&protos.SyncRPCResponse{ReqId: syncRPCReq.ReqId, RespBody: gwResp2}))
+	assert.Equal(t, protos.TestMarshal(gwResp2), protos.TestMarshal(receiveResponse(t, gwRespChannel.RespChan)))
+
+	// replica2 notifies the gateway once the response is complete
+	cancelReq := receiveRequest(t, queue)
+	assert.Equal(t, syncRPCReq.ReqId, cancelReq.ReqId)
+	assert.True(t, cancelReq.ConnClosed)
+	assert.NoError(t, broker1.CancelGatewayRequest(gwId, gwRespChannel.ReqId))
+}
+
+func TestGatewayRPCBrokerImpl_CancelForwardedRequest(t *testing.T) {
+	broker1, broker2 := startReplicas(t)
+	queue := broker2.InitializeGateway(gwId)
+
+	gwRespChannel, err := broker1.SendRequestToGateway(&protos.GatewayRequest{GwId: gwId})
+	assert.NoError(t, err)
+	syncRPCReq := receiveRequest(t, queue)
+
+	// cancelling on replica1 cancels the request on the gateway
+	assert.NoError(t, broker1.CancelGatewayRequest(gwId, gwRespChannel.ReqId))
+	cancelReq := receiveRequest(t, queue)
+	assert.Equal(t, syncRPCReq.ReqId, cancelReq.ReqId)
+	assert.True(t, cancelReq.ConnClosed)
+}
+
+func TestGatewayRPCBrokerImpl_ReconnectToOtherReplica(t *testing.T) {
+	broker1, broker2 := startReplicas(t)
+	broker1.InitializeGateway(gwId)
+	assert.NoError(t, broker1.CleanupGateway(gwId))
+	// gateway reconnects to replica2, requests on replica1 follow it
+	queue := broker2.InitializeGateway(gwId)
+
+	_, err := broker1.SendRequestToGateway(&protos.GatewayRequest{GwId: gwId})
+	assert.NoError(t, err)
+	syncRPCReq := receiveRequest(t, queue)
+	assert.Equal(t, gwId, syncRPCReq.ReqBody.GwId)
+
+	// requests for unknown gateways are not forwarded
+	_, err = broker1.SendRequestToGateway(&protos.GatewayRequest{GwId: "gwId2"})
+	assert.EqualError(t, err, "Queue does not exist for gwId gwId2\n")
+}
+
+func receiveRequest(t *testing.T, queue chan *protos.SyncRPCRequest) *protos.SyncRPCRequest {
+	select {
+	case req := <-queue:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for SyncRPCRequest")
+		return nil
+	}
+}
+
+func receiveResponse(t *testing.T, respChan chan *protos.GatewayResponse) *protos.GatewayResponse {
+	select {
+	case resp := <-respChan:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for GatewayResponse")
+		return nil
+	}
+}
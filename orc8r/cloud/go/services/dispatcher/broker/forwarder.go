@@ -0,0 +1,88 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/registry"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// RequestForwarder forwards GatewayRequests to other dispatcher replicas.
+type RequestForwarder interface {
+	// ForwardRequest sends gwReq to replica, and returns the stream of
+	// GatewayResponses from the gateway. Cancelling ctx cancels the request.
+	ForwardRequest(
+		ctx context.Context,
+		replica string,
+		gwReq *protos.GatewayRequest,
+	) (protos.SyncRPCForwardingService_ForwardGatewayRequestClient, error)
+}
+
+// grpcRequestForwarder forwards requests to the SyncRPCForwardingService of
+// other replicas, keeping a connection open to each of them.
+type grpcRequestForwarder struct {
+	getAddress  func(replica string) string
+	connections map[string]*grpc.ClientConn
+	*sync.Mutex
+}
+
+// NewGRPCRequestForwarder returns a RequestForwarder which reaches the
+// dispatcher gRPC server of each replica on the given port of its host name.
+func NewGRPCRequestForwarder(port int) RequestForwarder {
+	return NewGRPCRequestForwarderWithAddresses(func(replica string) string {
+		return fmt.Sprintf("%s:%d", replica, port)
+	})
+}
+
+// NewGRPCRequestForwarderWithAddresses returns a RequestForwarder which
+// reaches each replica at the address returned by getAddress.
+func NewGRPCRequestForwarderWithAddresses(getAddress func(replica string) string) RequestForwarder {
+	return &grpcRequestForwarder{
+		getAddress:  getAddress,
+		connections: map[string]*grpc.ClientConn{},
+		Mutex:       &sync.Mutex{},
+	}
+}
+
+func (forwarder *grpcRequestForwarder) ForwardRequest(
+	ctx context.Context,
+	replica string,
+	gwReq *protos.GatewayRequest,
+) (protos.SyncRPCForwardingService_ForwardGatewayRequestClient, error) {
+	conn, err := forwarder.getConnection(replica)
+	if err != nil {
+		return nil, err
+	}
+	return protos.NewSyncRPCForwardingServiceClient(conn).ForwardGatewayRequest(ctx, gwReq)
+}
+
+func (forwarder *grpcRequestForwarder) getConnection(replica string) (*grpc.ClientConn, error) {
+	forwarder.Lock()
+	defer forwarder.Unlock()
+	if conn, ok := forwarder.connections[replica]; ok {
+		return conn, nil
+	}
+	conn, err := registry.GetClientConnection(
+		context.Background(),
+		forwarder.getAddress(replica),
+		grpc.WithBackoffMaxDelay(registry.GrpcMaxDelaySec*time.Second),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("Replica %v connection error: %v", replica, err)
+	}
+	forwarder.connections[replica] = conn
+	return conn, nil
+}
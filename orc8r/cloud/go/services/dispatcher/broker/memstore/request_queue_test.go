@@ -62,6 +62,16 @@ func TestEnqueueNonExistingQueue(t *testing.T) {
 	assert.EqualError(t, err, "Queue does not exist for gwId gwId1\n")
 }
 
+func TestRequestQueueImpl_HasQueue(t *testing.T) {
+	queue := memstore.NewRequestQueue(1)
+	assert.False(t, queue.HasQueue("gwId1"))
+	queue.InitializeQueue("gwId1")
+	assert.True(t, queue.HasQueue("gwId1"))
+	assert.False(t, queue.HasQueue("gwId2"))
+	queue.CleanupQueue("gwId1")
+	assert.False(t, queue.HasQueue("gwId1"))
+}
+
 func enqueueGwWithReqId(t *testing.T, queue memstore.RequestQueue, gwId string, reqId uint32) {
 	req := &protos.SyncRPCRequest{ReqId: reqId, ReqBody: &protos.GatewayRequest{GwId: gwId}}
 	err := queue.Enqueue(req)
@@ -100,3 +100,26 @@ func (_m *GatewayRPCBroker) SendRequestToGateway(gwReq *protos.GatewayRequest) (
 
 	return r0, r1
 }
+
+// SendRequestToLocalGateway provides a mock function with given fields: gwReq
+func (_m *GatewayRPCBroker) SendRequestToLocalGateway(gwReq *protos.GatewayRequest) (*broker.GatewayResponseChannel, error) {
+	ret := _m.Called(gwReq)
+
+	var r0 *broker.GatewayResponseChannel
+	if rf, ok := ret.Get(0).(func(*protos.GatewayRequest) *broker.GatewayResponseChannel); ok {
+		r0 = rf(gwReq)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*broker.GatewayResponseChannel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*protos.GatewayRequest) error); ok {
+		r1 = rf(gwReq)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,70 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package broker
+
+import (
+	"fmt"
+	"sync"
+
+	"magma/orc8r/cloud/go/services/directoryd"
+)
+
+// GatewayOwnership records which dispatcher replica holds the SyncRPC
+// stream of each gateway, so that every replica can route requests to it.
+type GatewayOwnership interface {
+	// SetOwner records replica as the owner of gateway gwId.
+	SetOwner(gwId string, replica string) error
+	// GetOwner returns the replica which owns gateway gwId.
+	GetOwner(gwId string) (string, error)
+}
+
+// directorydGatewayOwnership stores gateway ownership in directoryd's
+// hwId to hostName table, which the SyncRPC servicer also refreshes on every
+// gateway heartbeat. Replicas are therefore identified by their host names.
+type directorydGatewayOwnership struct{}
+
+func NewDirectorydGatewayOwnership() GatewayOwnership {
+	return &directorydGatewayOwnership{}
+}
+
+func (*directorydGatewayOwnership) SetOwner(gwId string, replica string) error {
+	return directoryd.UpdateHostNameByHwId(gwId, replica)
+}
+
+func (*directorydGatewayOwnership) GetOwner(gwId string) (string, error) {
+	return directoryd.GetHostNameByIMSI(gwId)
+}
+
+// memoryGatewayOwnership is a GatewayOwnership shared in process memory,
+// used to run several dispatcher replicas in a single process.
+type memoryGatewayOwnership struct {
+	ownerByGwId map[string]string
+	*sync.RWMutex
+}
+
+func NewMemoryGatewayOwnership() GatewayOwnership {
+	return &memoryGatewayOwnership{ownerByGwId: map[string]string{}, RWMutex: &sync.RWMutex{}}
+}
+
+func (ownership *memoryGatewayOwnership) SetOwner(gwId string, replica string) error {
+	ownership.Lock()
+	defer ownership.Unlock()
+	ownership.ownerByGwId[gwId] = replica
+	return nil
+}
+
+func (ownership *memoryGatewayOwnership) GetOwner(gwId string) (string, error) {
+	ownership.RLock()
+	defer ownership.RUnlock()
+	replica, ok := ownership.ownerByGwId[gwId]
+	if !ok {
+		return "", fmt.Errorf("No owner found for gwId %v", gwId)
+	}
+	return replica, nil
+}
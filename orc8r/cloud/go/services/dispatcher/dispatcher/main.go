@@ -9,6 +9,7 @@ LICENSE file in the root directory of this source tree.
 package main
 
 import (
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/registry"
 	"magma/orc8r/cloud/go/service"
 	"magma/orc8r/cloud/go/services/dispatcher"
 	sync_rpc_broker "magma/orc8r/cloud/go/services/dispatcher/broker"
@@ -28,6 +30,8 @@ import (
 
 const HTTP_SERVER_PORT = 9080
 
+var replicated = flag.Bool("replicated", false, "Forward requests for gateways connected to other dispatcher replicas")
+
 func main() {
 	// Set MaxConnectionAge to infinity so Sync RPC stream doesn't restart
 	var keepaliveParams = service.GetDefaultKeepaliveParameters()
@@ -44,12 +48,26 @@ func main() {
 		glog.Fatalf("Error creating service: %s", err)
 	}
 
-	// create a broker
-	broker := sync_rpc_broker.NewGatewayReqRespBroker()
-
 	// get ec2 public host name
 	hostName := getHostName()
 	glog.V(2).Infof("hostName is: %v\n", hostName)
+
+	// create a broker
+	var broker *sync_rpc_broker.GatewayRPCBrokerImpl
+	if *replicated {
+		// replicas reach each other on the dispatcher's gRPC port of their host names
+		port, err := registry.GetServicePort(dispatcher.ServiceName)
+		if err != nil {
+			glog.Fatalf("Error getting dispatcher port: %s", err)
+		}
+		broker = sync_rpc_broker.NewReplicatedGatewayReqRespBroker(
+			hostName,
+			sync_rpc_broker.NewDirectorydGatewayOwnership(),
+			sync_rpc_broker.NewGRPCRequestForwarder(port),
+		)
+	} else {
+		broker = sync_rpc_broker.NewGatewayReqRespBroker()
+	}
 	// create servicer
 	syncRpcServicer, err := servicers.NewSyncRPCService(hostName, broker)
 	if err != nil {
@@ -61,6 +79,9 @@ func main() {
 
 	protos.RegisterSyncRPCServiceServer(srv.GrpcServer, syncRpcServicer)
 	srv.GrpcServer.RegisterService(protos.GetLegacyDispatcherDesc(), syncRpcServicer)
+	if *replicated {
+		protos.RegisterSyncRPCForwardingServiceServer(srv.GrpcServer, servicers.NewSyncRPCForwardingService(broker))
+	}
 
 	// run http server
 	go httpServer.Run(fmt.Sprintf(":%d", HTTP_SERVER_PORT))
@@ -0,0 +1,81 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package servicers
+
+import (
+	"strings"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/dispatcher/broker"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SyncRPCForwardingService receives the GatewayRequests forwarded by other
+// dispatcher replicas, and sends them to gateways connected to this replica.
+type SyncRPCForwardingService struct {
+	broker broker.GatewayRPCBroker
+}
+
+func NewSyncRPCForwardingService(broker broker.GatewayRPCBroker) *SyncRPCForwardingService {
+	return &SyncRPCForwardingService{broker: broker}
+}
+
+// ForwardGatewayRequest sends gwReq to its gateway, and streams back the
+// GatewayResponses until the response is complete. The request is cancelled
+// on the gateway once the stream is done.
+func (srv *SyncRPCForwardingService) ForwardGatewayRequest(
+	gwReq *protos.GatewayRequest,
+	stream protos.SyncRPCForwardingService_ForwardGatewayRequestServer,
+) error {
+	gwRespChannel, err := srv.broker.SendRequestToLocalGateway(gwReq)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "err sending forwarded request to gateway: %v", err)
+	}
+	defer func() {
+		err := srv.broker.CancelGatewayRequest(gwReq.GwId, gwRespChannel.ReqId)
+		if err != nil {
+			glog.Errorf("Failed to cancel forwarded request %v for gwId %v: %v\n", gwRespChannel.ReqId, gwReq.GwId, err)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case gwResp, ok := <-gwRespChannel.RespChan:
+			if !ok {
+				return status.Error(codes.Aborted, "response channel closed")
+			}
+			if gwResp == nil {
+				return status.Error(codes.Internal, "nil GatewayResponse")
+			}
+			err := stream.Send(gwResp)
+			if err != nil {
+				return err
+			}
+			if len(gwResp.Err) != 0 || isGatewayResponseComplete(gwResp) {
+				return nil
+			}
+		}
+	}
+}
+
+// isGatewayResponseComplete returns whether gwResp carries the grpc-status
+// trailer, which ends a response.
+func isGatewayResponseComplete(gwResp *protos.GatewayResponse) bool {
+	for k := range gwResp.Headers {
+		if strings.EqualFold(k, "grpc-status") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,175 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+// FileExporter appends log entries as JSON lines to a local file. Once the
+// file grows beyond maxSizeBytes it is rotated to <path>.1, and older files
+// are shifted up to <path>.<maxBackups>.
+type FileExporter struct {
+	path           string
+	maxSizeBytes   int64
+	maxBackups     int
+	queue          *logQueue
+	exportInterval time.Duration
+
+	fileMutex sync.Mutex
+	file      *os.File
+	size      int64
+}
+
+func NewFileExporter(
+	path string,
+	maxSizeBytes int64,
+	maxBackups int,
+	queueLen int,
+	batchSize int,
+	exportInterval time.Duration,
+) (*FileExporter, error) {
+	if maxSizeBytes <= 0 {
+		return nil, fmt.Errorf("max file size must be positive")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	return &FileExporter{
+		path:           path,
+		maxSizeBytes:   maxSizeBytes,
+		maxBackups:     maxBackups,
+		queue:          newLogQueue(queueLen, batchSize),
+		exportInterval: exportInterval,
+	}, nil
+}
+
+func (e *FileExporter) Start() {
+	go e.exportEvery()
+}
+
+func (e *FileExporter) exportEvery() {
+	for range time.Tick(e.exportInterval) {
+		err := e.Export()
+		if err != nil {
+			glog.Errorf("Error in exporting to file %s: %v\n", e.path, err)
+		}
+	}
+}
+
+// Export appends the queued entries to the file
+func (e *FileExporter) Export() error {
+	return e.queue.export(e.write)
+}
+
+func (e *FileExporter) Submit(logEntries []*protos.LogEntry) error {
+	return e.queue.submit(logEntries)
+}
+
+// write appends the records to the file, and returns how many of them were
+// appended before a write failed. Records which can't be formatted are
+// skipped.
+func (e *FileExporter) write(records []*LogRecord) (int, error) {
+	e.fileMutex.Lock()
+	defer e.fileMutex.Unlock()
+
+	for i, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			glog.Errorf("Error formatting log entry %v: %v\n", record, err)
+			continue
+		}
+		line = append(line, '\n')
+		if err := e.openFile(); err != nil {
+			return i, err
+		}
+		if e.size > 0 && e.size+int64(len(line)) > e.maxSizeBytes {
+			if err := e.rotate(); err != nil {
+				return i, err
+			}
+		}
+		n, err := e.file.Write(line)
+		if err != nil {
+			// Drop the partial line, as the record is written again by the
+			// next export
+			_ = e.file.Truncate(e.size)
+			return i, err
+		}
+		e.size += int64(n)
+	}
+	return len(records), nil
+}
+
+func (e *FileExporter) openFile() error {
+	if e.file != nil {
+		return nil
+	}
+	file, err := os.OpenFile(e.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	e.file = file
+	e.size = info.Size()
+	return nil
+}
+
+// rotate closes the current file and shifts it and its backups by one,
+// deleting the oldest backup
+func (e *FileExporter) rotate() error {
+	if err := e.file.Close(); err != nil {
+		return err
+	}
+	e.file = nil
+	if e.maxBackups <= 0 {
+		if err := os.Remove(e.path); err != nil {
+			return err
+		}
+	} else {
+		_ = os.Remove(e.backupPath(e.maxBackups))
+		for i := e.maxBackups - 1; i >= 1; i-- {
+			if err := os.Rename(e.backupPath(i), e.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+		if err := os.Rename(e.path, e.backupPath(1)); err != nil {
+			return err
+		}
+	}
+	return e.openFile()
+}
+
+func (e *FileExporter) backupPath(i int) string {
+	return fmt.Sprintf("%s.%d", e.path, i)
+}
+
+// Close closes the file, e.g. before the file is moved by another process
+func (e *FileExporter) Close() error {
+	e.fileMutex.Lock()
+	defer e.fileMutex.Unlock()
+	if e.file == nil {
+		return nil
+	}
+	err := e.file.Close()
+	e.file = nil
+	return err
+}
@@ -0,0 +1,80 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testLogLine = `{"@timestamp":"1970-01-01T03:25:45Z","category":"test","time":12345}` + "\n"
+
+func TestFileExporter_Export(t *testing.T) {
+	dir, err := ioutil.TempDir("", "file_exporter")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "logs", "gateway.json")
+
+	// Every file fits 2 lines, with 2 backups
+	exporter, err := exporters.NewFileExporter(path, int64(2*len(testLogLine)), 2, 10, 10, time.Second*10)
+	assert.NoError(t, err)
+	defer exporter.Close()
+
+	submitAndExport := func(count int) {
+		entries := make([]*protos.LogEntry, count)
+		for i := range entries {
+			entries[i] = &protos.LogEntry{Category: "test", Time: 12345}
+		}
+		assert.NoError(t, exporter.Submit(entries))
+		assert.NoError(t, exporter.Export())
+	}
+
+	submitAndExport(1)
+	assertFileContent(t, path, 1)
+	submitAndExport(1)
+	assertFileContent(t, path, 2)
+
+	submitAndExport(3)
+	assertFileContent(t, path, 1)
+	assertFileContent(t, path+".1", 2)
+	assertFileContent(t, path+".2", 2)
+
+	// The oldest backup is dropped
+	submitAndExport(2)
+	assertFileContent(t, path, 1)
+	assertFileContent(t, path+".1", 2)
+	assertFileContent(t, path+".2", 2)
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+
+	// Appends to an existing file after a restart
+	assert.NoError(t, exporter.Close())
+	exporter, err = exporters.NewFileExporter(path, int64(2*len(testLogLine)), 2, 10, 10, time.Second*10)
+	assert.NoError(t, err)
+	submitAndExport(1)
+	assertFileContent(t, path, 2)
+}
+
+func assertFileContent(t *testing.T, path string, lines int) {
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	expected := ""
+	for i := 0; i < lines; i++ {
+		expected += testLogLine
+	}
+	assert.Equal(t, expected, string(content))
+}
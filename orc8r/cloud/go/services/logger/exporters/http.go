@@ -0,0 +1,146 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+const httpExporterTimeout = time.Second * 30
+
+// HttpExporter posts log entries as JSON documents to an HTTP sink using the
+// Elasticsearch bulk API format, i.e. an index action line followed by the
+// document for every entry.
+type HttpExporter struct {
+	url            string
+	index          string
+	username       string
+	password       string
+	client         *http.Client
+	queue          *logQueue
+	exportInterval time.Duration
+}
+
+type bulkAction struct {
+	Index bulkIndex `json:"index"`
+}
+
+type bulkIndex struct {
+	Index string `json:"_index"`
+}
+
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+	Items  []map[string]struct {
+		Status int `json:"status"`
+	} `json:"items"`
+}
+
+// NewHttpExporter creates an exporter to the bulk endpoint at url, e.g.
+// http://elasticsearch:9200/_bulk. Entries are written to index, and basic
+// auth is used if username is not empty.
+func NewHttpExporter(
+	url string,
+	index string,
+	username string,
+	password string,
+	queueLen int,
+	batchSize int,
+	exportInterval time.Duration,
+) *HttpExporter {
+	return &HttpExporter{
+		url:            url,
+		index:          index,
+		username:       username,
+		password:       password,
+		client:         &http.Client{Timeout: httpExporterTimeout},
+		queue:          newLogQueue(queueLen, batchSize),
+		exportInterval: exportInterval,
+	}
+}
+
+func (e *HttpExporter) Start() {
+	go e.exportEvery()
+}
+
+func (e *HttpExporter) exportEvery() {
+	for range time.Tick(e.exportInterval) {
+		err := e.Export()
+		if err != nil {
+			glog.Errorf("Error in exporting to %s: %v\n", e.url, err)
+		}
+	}
+}
+
+// Export writes the queued entries to the HTTP sink
+func (e *HttpExporter) Export() error {
+	return e.queue.export(e.write)
+}
+
+func (e *HttpExporter) Submit(logEntries []*protos.LogEntry) error {
+	return e.queue.submit(logEntries)
+}
+
+// write sends the records in a single bulk request, so either all or none of
+// them are written
+func (e *HttpExporter) write(records []*LogRecord) (int, error) {
+	body := bytes.Buffer{}
+	encoder := json.NewEncoder(&body)
+	for _, record := range records {
+		if err := encoder.Encode(bulkAction{Index: bulkIndex{Index: e.index}}); err != nil {
+			return 0, err
+		}
+		if err := encoder.Encode(record); err != nil {
+			return 0, err
+		}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.url, &body)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	if e.username != "" {
+		req.SetBasicAuth(e.username, e.password)
+	}
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	respBody, _ := ioutil.ReadAll(resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return 0, fmt.Errorf("HTTP sink status code %d: %s", resp.StatusCode, respBody)
+	}
+
+	// Documents the sink rejected aren't retried, as the whole batch would
+	// be written again. Log them so they aren't dropped silently.
+	bulkResp := bulkResponse{}
+	if err := json.Unmarshal(respBody, &bulkResp); err == nil && bulkResp.Errors {
+		failed := 0
+		for _, item := range bulkResp.Items {
+			for _, result := range item {
+				if result.Status >= 300 {
+					failed++
+				}
+			}
+		}
+		glog.Errorf("HTTP sink rejected %d of %d log entries", failed, len(records))
+	}
+	return len(records), nil
+}
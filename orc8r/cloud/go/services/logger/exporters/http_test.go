@@ -0,0 +1,95 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHttpExporter_Submit(t *testing.T) {
+	exporter := exporters.NewHttpExporter("", "logs", "", "", 5, 2, time.Second*10)
+	logEntries := []*protos.LogEntry{{Category: "test"}}
+	err := exporter.Submit(logEntries)
+	assert.EqualError(t, err, fmt.Sprintf("LogEntry %v doesn't have time field set", logEntries[0]))
+
+	logEntries = make([]*protos.LogEntry, 6)
+	for i := range logEntries {
+		logEntries[i] = &protos.LogEntry{Category: "test", Time: 12345}
+	}
+	err = exporter.Submit(logEntries)
+	assert.EqualError(t, err, "dropping 6 logEntries as it exceeds max queue length")
+}
+
+func TestHttpExporter_Export(t *testing.T) {
+	var bodies []string
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "user", username)
+		assert.Equal(t, "pass", password)
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"errors":false,"items":[]}`))
+	}))
+	defer srv.Close()
+
+	exporter := exporters.NewHttpExporter(srv.URL, "logs", "user", "pass", 5, 2, time.Second*10)
+	err := exporter.Export()
+	assert.NoError(t, err)
+	assert.Empty(t, bodies)
+
+	logEntries := []*protos.LogEntry{
+		{Category: "test1", Time: 12345, NormalMap: map[string]string{"status": "ACTIVE"}},
+		{Category: "test2", Time: 23456},
+		{Category: "test3", Time: 34567},
+	}
+	err = exporter.Submit(logEntries)
+	assert.NoError(t, err)
+
+	// Failed writes stay queued
+	status = http.StatusServiceUnavailable
+	err = exporter.Export()
+	assert.EqualError(t, err, `HTTP sink status code 503: {"errors":false,"items":[]}`)
+	assert.Len(t, bodies, 1)
+
+	// Entries are written in batches of 2
+	bodies = nil
+	status = http.StatusOK
+	err = exporter.Export()
+	assert.NoError(t, err)
+	assert.Len(t, bodies, 2)
+	assert.Equal(t, []string{
+		`{"index":{"_index":"logs"}}`,
+		`{"@timestamp":"1970-01-01T03:25:45Z","category":"test1","time":12345,"normal":{"status":"ACTIVE"}}`,
+		`{"index":{"_index":"logs"}}`,
+		`{"@timestamp":"1970-01-01T06:30:56Z","category":"test2","time":23456}`,
+		``,
+	}, strings.Split(bodies[0], "\n"))
+	assert.Equal(t, `{"index":{"_index":"logs"}}`+"\n"+`{"@timestamp":"1970-01-01T09:36:07Z","category":"test3","time":34567}`+"\n", bodies[1])
+
+	// Queue is empty after a successful export
+	bodies = nil
+	err = exporter.Export()
+	assert.NoError(t, err)
+	assert.Empty(t, bodies)
+}
@@ -0,0 +1,147 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/golang/glog"
+)
+
+// LogRecord is the sink-agnostic form of a LogEntry exported by the HTTP,
+// syslog and file exporters.
+type LogRecord struct {
+	Timestamp string            `json:"@timestamp"`
+	Category  string            `json:"category"`
+	Time      int64             `json:"time"`
+	HwID      string            `json:"hwId,omitempty"`
+	NetworkID string            `json:"networkId,omitempty"`
+	GatewayID string            `json:"gatewayId,omitempty"`
+	Normal    map[string]string `json:"normal,omitempty"`
+	Int       map[string]int64  `json:"int,omitempty"`
+	TagSet    []string          `json:"tagset,omitempty"`
+	NormVec   []string          `json:"normvector,omitempty"`
+}
+
+// ConvertToLogRecords converts a slice of protos.LogEntry into a slice of
+// LogRecord. The network and gateway IDs of entries logged from a gateway are
+// resolved from the entry's hardware ID.
+func ConvertToLogRecords(entries []*protos.LogEntry) ([]*LogRecord, error) {
+	records := make([]*LogRecord, 0, len(entries))
+	for _, entry := range entries {
+		if entry.Time == 0 {
+			return nil, fmt.Errorf("LogEntry %v doesn't have time field set", entry)
+		}
+		record := &LogRecord{
+			Timestamp: time.Unix(entry.Time, 0).UTC().Format(time.RFC3339),
+			Category:  entry.Category,
+			Time:      entry.Time,
+			HwID:      entry.HwId,
+			Normal:    entry.NormalMap,
+			Int:       entry.IntMap,
+			TagSet:    entry.TagSet,
+			NormVec:   entry.Normvector,
+		}
+		if len(entry.HwId) != 0 {
+			networkID, gatewayID, err := configurator.GetNetworkAndEntityIDForPhysicalID(entry.HwId)
+			if err != nil {
+				glog.Errorf("Error retrieving nwId and gwId for hwId %s: %v\n", entry.HwId, err)
+			}
+			record.NetworkID = networkID
+			record.GatewayID = gatewayID
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// logQueue buffers log records between two exports. It follows the queue
+// semantics of ScribeExporter: a submit which would overflow the queue clears
+// it, and records are only removed from the queue once they were written.
+// Each queued record has a sequence number, so an export which only wrote part
+// of a batch removes exactly the written records, even if the queue was
+// cleared in the meantime, and the next export resumes at the first record
+// which wasn't written.
+type logQueue struct {
+	records []*LogRecord
+	// headSeq is the sequence number of records[0]
+	headSeq      uint64
+	mutex        sync.RWMutex
+	exportMutex  sync.Mutex
+	maxLen       int
+	maxBatchSize int
+}
+
+func newLogQueue(maxLen int, maxBatchSize int) *logQueue {
+	return &logQueue{records: []*LogRecord{}, maxLen: maxLen, maxBatchSize: maxBatchSize}
+}
+
+func (q *logQueue) submit(logEntries []*protos.LogEntry) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if (len(q.records) + len(logEntries)) > q.maxLen {
+		// queue is full, clear queue and log that queue was full
+		q.headSeq += uint64(len(q.records))
+		q.records = []*LogRecord{}
+		glog.Warningf("Queue is full, clearing...")
+		if len(logEntries) > q.maxLen {
+			return fmt.Errorf("dropping %v logEntries as it exceeds max queue length", len(logEntries))
+		}
+	}
+	records, err := ConvertToLogRecords(logEntries)
+	if err != nil {
+		return err
+	}
+	q.records = append(q.records, records...)
+	return nil
+}
+
+// export writes the queued records in batches of at most maxBatchSize
+// records. write returns how many records of the batch it handled before it
+// failed, and those records are removed from the queue.
+func (q *logQueue) export(write func([]*LogRecord) (int, error)) error {
+	q.exportMutex.Lock()
+	defer q.exportMutex.Unlock()
+	for {
+		q.mutex.RLock()
+		batch, batchSeq := q.records, q.headSeq
+		q.mutex.RUnlock()
+		if len(batch) == 0 {
+			return nil
+		}
+		if len(batch) > q.maxBatchSize {
+			batch = batch[:q.maxBatchSize]
+		}
+		written, err := write(batch)
+		q.remove(batchSeq + uint64(written))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// remove removes the queued records with a sequence number below seq
+func (q *logQueue) remove(seq uint64) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if seq <= q.headSeq {
+		return
+	}
+	n := seq - q.headSeq
+	if n > uint64(len(q.records)) {
+		n = uint64(len(q.records))
+	}
+	q.records = q.records[n:]
+	q.headSeq += n
+}
@@ -0,0 +1,64 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"errors"
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogQueue_Export(t *testing.T) {
+	q := newLogQueue(5, 2)
+	err := q.submit([]*protos.LogEntry{
+		{Category: "a", Time: 1}, {Category: "b", Time: 1}, {Category: "c", Time: 1},
+	})
+	assert.NoError(t, err)
+
+	var written []string
+	failAt := "b"
+	write := func(records []*LogRecord) (int, error) {
+		for i, record := range records {
+			if record.Category == failAt {
+				return i, errors.New("write failed")
+			}
+			written = append(written, record.Category)
+		}
+		return len(records), nil
+	}
+
+	// A partially written batch resumes at the record which failed
+	assert.EqualError(t, q.export(write), "write failed")
+	assert.Equal(t, []string{"a"}, written)
+	failAt = ""
+	assert.NoError(t, q.export(write))
+	assert.Equal(t, []string{"a", "b", "c"}, written)
+	assert.Empty(t, q.records)
+
+	// Records which were cleared while a batch was written aren't removed
+	// again once the write returns
+	err = q.submit([]*protos.LogEntry{{Category: "d", Time: 1}, {Category: "e", Time: 1}})
+	assert.NoError(t, err)
+	written = nil
+	clearingWrite := func(records []*LogRecord) (int, error) {
+		if len(written) == 0 {
+			err := q.submit([]*protos.LogEntry{
+				{Category: "f", Time: 1}, {Category: "g", Time: 1}, {Category: "h", Time: 1}, {Category: "i", Time: 1},
+			})
+			assert.NoError(t, err)
+		}
+		return write(records)
+	}
+	assert.NoError(t, q.export(clearingWrite))
+	assert.Equal(t, []string{"d", "e", "f", "g", "h", "i"}, written)
+	assert.Empty(t, q.records)
+}
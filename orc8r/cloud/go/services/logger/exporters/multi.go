@@ -0,0 +1,52 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"magma/orc8r/cloud/go/protos"
+)
+
+// MultiExporter fans log entries out to several exporters concurrently
+type MultiExporter struct {
+	exporters []Exporter
+}
+
+func NewMultiExporter(exporters ...Exporter) *MultiExporter {
+	return &MultiExporter{exporters: exporters}
+}
+
+// Submit submits the log entries to every exporter. It returns an error if
+// any of the exporters failed, after all of them were submitted to.
+func (e *MultiExporter) Submit(logEntries []*protos.LogEntry) error {
+	errs := make([]error, len(e.exporters))
+	wg := sync.WaitGroup{}
+	for i, exporter := range e.exporters {
+		wg.Add(1)
+		go func(i int, exporter Exporter) {
+			defer wg.Done()
+			errs[i] = exporter.Submit(logEntries)
+		}(i, exporter)
+	}
+	wg.Wait()
+
+	var errMsgs []string
+	for _, err := range errs {
+		if err != nil {
+			errMsgs = append(errMsgs, err.Error())
+		}
+	}
+	if len(errMsgs) > 0 {
+		return fmt.Errorf("%d of %d exporters failed: %s", len(errMsgs), len(e.exporters), strings.Join(errMsgs, "; "))
+	}
+	return nil
+}
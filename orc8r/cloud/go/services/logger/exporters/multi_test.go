@@ -0,0 +1,42 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"errors"
+	"testing"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+	"magma/orc8r/cloud/go/services/logger/exporters/mocks"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiExporter_Submit(t *testing.T) {
+	exporter1 := mocks.NewExposedMockExporter()
+	exporter2 := mocks.NewExposedMockExporter()
+	multiExporter := exporters.NewMultiExporter(exporter1, exporter2)
+
+	logEntries := []*protos.LogEntry{{Category: "test", Time: 12345}}
+	exporter1.On("Submit", logEntries).Return(nil).Once()
+	exporter2.On("Submit", logEntries).Return(nil).Once()
+	err := multiExporter.Submit(logEntries)
+	assert.NoError(t, err)
+	exporter1.AssertExpectations(t)
+	exporter2.AssertExpectations(t)
+
+	// All exporters are submitted to even if one fails
+	exporter1.On("Submit", logEntries).Return(errors.New("queue is full")).Once()
+	exporter2.On("Submit", logEntries).Return(nil).Once()
+	err = multiExporter.Submit(logEntries)
+	assert.EqualError(t, err, "1 of 2 exporters failed: queue is full")
+	exporter1.AssertExpectations(t)
+	exporter2.AssertExpectations(t)
+}
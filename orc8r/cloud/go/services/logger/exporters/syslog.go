@@ -0,0 +1,202 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+
+	"github.com/golang/glog"
+)
+
+const (
+	// syslogPriority is facility local0 (16) with severity informational (6)
+	syslogPriority = 16*8 + 6
+	syslogAppName  = "magma"
+	syslogNilValue = "-"
+	syslogMaxMsgID = 32
+	// syslogSDID identifies the structured data element of the gateway which
+	// logged the entry. 32473 is the private enterprise number reserved for
+	// documentation by RFC 5612.
+	syslogSDID = "magma@32473"
+
+	syslogDialTimeout = time.Second * 10
+)
+
+var sdParamEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`)
+
+// SyslogExporter sends log entries as RFC 5424 syslog messages over TCP or
+// UDP. TCP messages are framed with octet counting (RFC 6587). The connection
+// to the server is kept open between exports, and re-dialed after an error.
+type SyslogExporter struct {
+	network        string
+	address        string
+	queue          *logQueue
+	exportInterval time.Duration
+
+	connMutex sync.Mutex
+	conn      net.Conn
+}
+
+// NewSyslogExporter creates an exporter to the syslog server at address.
+// network is either "tcp" or "udp".
+func NewSyslogExporter(
+	network string,
+	address string,
+	queueLen int,
+	batchSize int,
+	exportInterval time.Duration,
+) (*SyslogExporter, error) {
+	if network != "tcp" && network != "udp" {
+		return nil, fmt.Errorf("unsupported syslog network %s; expected tcp or udp", network)
+	}
+	return &SyslogExporter{
+		network:        network,
+		address:        address,
+		queue:          newLogQueue(queueLen, batchSize),
+		exportInterval: exportInterval,
+	}, nil
+}
+
+func (e *SyslogExporter) Start() {
+	go e.exportEvery()
+}
+
+func (e *SyslogExporter) exportEvery() {
+	for range time.Tick(e.exportInterval) {
+		err := e.Export()
+		if err != nil {
+			glog.Errorf("Error in exporting to syslog %s: %v\n", e.address, err)
+		}
+	}
+}
+
+// Export sends the queued entries to the syslog server
+func (e *SyslogExporter) Export() error {
+	return e.queue.export(e.write)
+}
+
+func (e *SyslogExporter) Submit(logEntries []*protos.LogEntry) error {
+	return e.queue.submit(logEntries)
+}
+
+// write sends the records over the open connection, and returns how many of
+// them were sent before the connection failed. Records which can't be
+// formatted are skipped.
+func (e *SyslogExporter) write(records []*LogRecord) (int, error) {
+	e.connMutex.Lock()
+	defer e.connMutex.Unlock()
+
+	if e.conn == nil {
+		conn, err := net.DialTimeout(e.network, e.address, syslogDialTimeout)
+		if err != nil {
+			return 0, err
+		}
+		e.conn = conn
+	}
+	for i, record := range records {
+		msg, err := FormatSyslogMessage(record)
+		if err != nil {
+			glog.Errorf("Error formatting syslog message for %v: %v\n", record, err)
+			continue
+		}
+		if e.network == "tcp" {
+			msg = fmt.Sprintf("%d %s", len(msg), msg)
+		}
+		if _, err := e.conn.Write([]byte(msg)); err != nil {
+			e.closeConn()
+			return i, err
+		}
+	}
+	return len(records), nil
+}
+
+func (e *SyslogExporter) closeConn() {
+	if err := e.conn.Close(); err != nil {
+		glog.Errorf("Error closing connection to syslog %s: %v\n", e.address, err)
+	}
+	e.conn = nil
+}
+
+// Close closes the connection to the syslog server. The next export opens a
+// new connection.
+func (e *SyslogExporter) Close() error {
+	e.connMutex.Lock()
+	defer e.connMutex.Unlock()
+	if e.conn == nil {
+		return nil
+	}
+	err := e.conn.Close()
+	e.conn = nil
+	return err
+}
+
+// FormatSyslogMessage formats a log record as an RFC 5424 syslog message.
+// The gateway is the hostname and the category the message ID, the network
+// and gateway IDs are structured data, and the message is the JSON of the
+// remaining fields.
+func FormatSyslogMessage(record *LogRecord) (string, error) {
+	msg, err := json.Marshal(struct {
+		Normal  map[string]string `json:"normal,omitempty"`
+		Int     map[string]int64  `json:"int,omitempty"`
+		TagSet  []string          `json:"tagset,omitempty"`
+		NormVec []string          `json:"normvector,omitempty"`
+	}{record.Normal, record.Int, record.TagSet, record.NormVec})
+	if err != nil {
+		return "", err
+	}
+
+	hostname := syslogNilValue
+	if record.GatewayID != "" {
+		hostname = toSyslogHeaderField(record.GatewayID, 255)
+	} else if record.HwID != "" {
+		hostname = toSyslogHeaderField(record.HwID, 255)
+	}
+	msgID := syslogNilValue
+	if record.Category != "" {
+		msgID = toSyslogHeaderField(record.Category, syslogMaxMsgID)
+	}
+	structuredData := syslogNilValue
+	if record.NetworkID != "" || record.GatewayID != "" || record.HwID != "" {
+		params := []string{}
+		for _, param := range [][2]string{{"networkId", record.NetworkID}, {"gatewayId", record.GatewayID}, {"hwId", record.HwID}} {
+			if param[1] != "" {
+				params = append(params, fmt.Sprintf(`%s="%s"`, param[0], sdParamEscaper.Replace(param[1])))
+			}
+		}
+		structuredData = fmt.Sprintf("[%s %s]", syslogSDID, strings.Join(params, " "))
+	}
+	timestamp := time.Unix(record.Time, 0).UTC().Format(time.RFC3339)
+
+	return fmt.Sprintf(
+		"<%d>1 %s %s %s %s %s %s %s",
+		syslogPriority, timestamp, hostname, syslogAppName, syslogNilValue, msgID, structuredData, msg,
+	), nil
+}
+
+// toSyslogHeaderField replaces the characters which aren't allowed in header
+// fields (anything but printable US-ASCII) and truncates the value to maxLen
+func toSyslogHeaderField(value string, maxLen int) string {
+	field := []byte(value)
+	for i, c := range field {
+		if c < 33 || c > 126 {
+			field[i] = '_'
+		}
+	}
+	if len(field) > maxLen {
+		field = field[:maxLen]
+	}
+	return string(field)
+}
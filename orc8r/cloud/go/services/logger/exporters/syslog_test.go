@@ -0,0 +1,110 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"bufio"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/logger/exporters"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatSyslogMessage(t *testing.T) {
+	msg, err := exporters.FormatSyslogMessage(&exporters.LogRecord{
+		Category: "mme event",
+		Time:     12345,
+		Int:      map[string]int64{"port": 443},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<134>1 1970-01-01T03:25:45Z - magma - mme_event - {"int":{"port":443}}`, msg)
+
+	msg, err = exporters.FormatSyslogMessage(&exporters.LogRecord{
+		Category:  "test",
+		Time:      12345,
+		HwID:      "hw1",
+		NetworkID: `net"1]`,
+		GatewayID: "gw1",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, `<134>1 1970-01-01T03:25:45Z gw1 magma - test [magma@32473 networkId="net\"1\]" gatewayId="gw1" hwId="hw1"] {}`, msg)
+}
+
+func TestNewSyslogExporter(t *testing.T) {
+	_, err := exporters.NewSyslogExporter("unix", "/dev/log", 5, 2, time.Second*10)
+	assert.EqualError(t, err, "unsupported syslog network unix; expected tcp or udp")
+}
+
+func TestSyslogExporter_ExportTCP(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer lis.Close()
+	received := make(chan string)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		data, _ := ioutil.ReadAll(bufio.NewReader(conn))
+		received <- string(data)
+	}()
+
+	exporter, err := exporters.NewSyslogExporter("tcp", lis.Addr().String(), 5, 5, time.Second*10)
+	assert.NoError(t, err)
+	err = exporter.Submit([]*protos.LogEntry{{Category: "a", Time: 12345}, {Category: "b", Time: 12345}})
+	assert.NoError(t, err)
+	err = exporter.Export()
+	assert.NoError(t, err)
+
+	// The connection stays open between exports
+	err = exporter.Submit([]*protos.LogEntry{{Category: "c", Time: 12345}})
+	assert.NoError(t, err)
+	err = exporter.Export()
+	assert.NoError(t, err)
+	assert.NoError(t, exporter.Close())
+
+	// Messages are framed with octet counting
+	assert.Equal(t,
+		"44 <134>1 1970-01-01T03:25:45Z - magma - a - {}"+
+			"44 <134>1 1970-01-01T03:25:45Z - magma - b - {}"+
+			"44 <134>1 1970-01-01T03:25:45Z - magma - c - {}",
+		<-received,
+	)
+}
+
+func TestSyslogExporter_ExportUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer conn.Close()
+
+	exporter, err := exporters.NewSyslogExporter("udp", conn.LocalAddr().String(), 5, 5, time.Second*10)
+	assert.NoError(t, err)
+	err = exporter.Submit([]*protos.LogEntry{{Category: "a", Time: 12345}, {Category: "b", Time: 12345}})
+	assert.NoError(t, err)
+	err = exporter.Export()
+	assert.NoError(t, err)
+
+	// A datagram per message
+	buf := make([]byte, 1024)
+	for _, expected := range []string{
+		"<134>1 1970-01-01T03:25:45Z - magma - a - {}",
+		"<134>1 1970-01-01T03:25:45Z - magma - b - {}",
+	} {
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, string(buf[:n]))
+	}
+}
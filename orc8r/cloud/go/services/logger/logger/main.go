@@ -10,11 +10,13 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"time"
 
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
 	"magma/orc8r/cloud/go/services/logger"
 	"magma/orc8r/cloud/go/services/logger/exporters"
 	"magma/orc8r/cloud/go/services/logger/nghttpxlogger"
@@ -27,6 +29,18 @@ const (
 	SCRIBE_EXPORTER_EXPORT_INTERVAL = time.Second * 60
 	SCRIBE_EXPORTER_QUEUE_LENGTH    = 100000
 	NGHTTPX_LOG_FILE_PATH           = "/var/log/nghttpx.log"
+
+	SCRIBE_EXPORTER = "scribe"
+	HTTP_EXPORTER   = "http"
+	SYSLOG_EXPORTER = "syslog"
+	FILE_EXPORTER   = "file"
+
+	EXPORTER_EXPORT_INTERVAL = time.Second * 10
+	EXPORTER_QUEUE_LENGTH    = 100000
+	EXPORTER_BATCH_SIZE      = 1000
+
+	DEFAULT_FILE_MAX_SIZE_MB = 100
+	DEFAULT_FILE_MAX_BACKUPS = 5
 )
 
 var (
@@ -54,28 +68,32 @@ func main() {
 		nghttpxLogger.Run(NGHTTPX_LOG_FILE_PATH)
 	}
 
-	scribeExportURL := srv.Config.GetRequiredStringParam("scribe_export_url")
-	scribeAppID := srv.Config.GetRequiredStringParam("scribe_app_id")
-	scribeAppSecret := srv.Config.GetRequiredStringParam("scribe_app_secret")
-
-	// Initialize exporters
-	scribeExporter := exporters.NewScribeExporter(
-		scribeExportURL,
-		scribeAppID,
-		scribeAppSecret,
-		SCRIBE_EXPORTER_QUEUE_LENGTH,
-		SCRIBE_EXPORTER_EXPORT_INTERVAL,
-	)
+	// Initialize the configured exporters, scribe if none are configured.
+	// Entries are fanned out to all of them.
+	exporterNames, err := srv.Config.GetStringArrayParam("exporters")
+	if err != nil {
+		exporterNames = []string{SCRIBE_EXPORTER}
+	}
+	var allExporters []exporters.Exporter
+	for _, name := range exporterNames {
+		exporter, err := newExporter(name, srv.Config)
+		if err != nil {
+			glog.Fatalf("Error creating %s exporter: %v", name, err)
+		}
+		allExporters = append(allExporters, exporter)
+	}
 	logExporters := make(map[protos.LoggerDestination]exporters.Exporter)
-	logExporters[protos.LoggerDestination_SCRIBE] = scribeExporter
+	if len(allExporters) == 1 {
+		logExporters[protos.LoggerDestination_SCRIBE] = allExporters[0]
+	} else {
+		logExporters[protos.LoggerDestination_SCRIBE] = exporters.NewMultiExporter(allExporters...)
+	}
 
 	// Add servicers to the service
 	loggingServ, err := servicers.NewLoggingService(logExporters)
 	if err != nil {
 		glog.Fatalf("LoggingService Initialization Error: %s", err)
 	}
-	// start exporting asynchronously
-	scribeExporter.Start()
 
 	protos.RegisterLoggingServiceServer(srv.GrpcServer, loggingServ)
 	srv.GrpcServer.RegisterService(protos.GetLegacyLoggerDesc(), loggingServ)
@@ -85,3 +103,69 @@ func main() {
 		glog.Fatalf("Error running service: %s", err)
 	}
 }
+
+type startableExporter interface {
+	exporters.Exporter
+	Start()
+}
+
+// newExporter creates the exporter with the given name from the logger
+// config, and starts exporting asynchronously
+func newExporter(name string, cfg *config.ConfigMap) (exporters.Exporter, error) {
+	var exporter startableExporter
+	var err error
+	switch name {
+	case SCRIBE_EXPORTER:
+		exporter = exporters.NewScribeExporter(
+			cfg.GetRequiredStringParam("scribe_export_url"),
+			cfg.GetRequiredStringParam("scribe_app_id"),
+			cfg.GetRequiredStringParam("scribe_app_secret"),
+			SCRIBE_EXPORTER_QUEUE_LENGTH,
+			SCRIBE_EXPORTER_EXPORT_INTERVAL,
+		)
+	case HTTP_EXPORTER:
+		username, _ := cfg.GetStringParam("http_export_username")
+		password, _ := cfg.GetStringParam("http_export_password")
+		exporter = exporters.NewHttpExporter(
+			cfg.GetRequiredStringParam("http_export_url"),
+			cfg.GetRequiredStringParam("http_export_index"),
+			username,
+			password,
+			EXPORTER_QUEUE_LENGTH,
+			EXPORTER_BATCH_SIZE,
+			EXPORTER_EXPORT_INTERVAL,
+		)
+	case SYSLOG_EXPORTER:
+		exporter, err = exporters.NewSyslogExporter(
+			cfg.GetRequiredStringParam("syslog_network"),
+			cfg.GetRequiredStringParam("syslog_address"),
+			EXPORTER_QUEUE_LENGTH,
+			EXPORTER_BATCH_SIZE,
+			EXPORTER_EXPORT_INTERVAL,
+		)
+	case FILE_EXPORTER:
+		maxSizeMB, sizeErr := cfg.GetIntParam("file_max_size_mb")
+		if sizeErr != nil {
+			maxSizeMB = DEFAULT_FILE_MAX_SIZE_MB
+		}
+		maxBackups, backupsErr := cfg.GetIntParam("file_max_backups")
+		if backupsErr != nil {
+			maxBackups = DEFAULT_FILE_MAX_BACKUPS
+		}
+		exporter, err = exporters.NewFileExporter(
+			cfg.GetRequiredStringParam("file_export_path"),
+			int64(maxSizeMB)*1024*1024,
+			maxBackups,
+			EXPORTER_QUEUE_LENGTH,
+			EXPORTER_BATCH_SIZE,
+			EXPORTER_EXPORT_INTERVAL,
+		)
+	default:
+		return nil, fmt.Errorf("unknown exporter %s", name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	exporter.Start()
+	return exporter, nil
+}
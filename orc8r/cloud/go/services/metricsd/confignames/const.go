@@ -14,6 +14,11 @@ const (
 	PrometheusPushAddresses = "prometheusPushAddresses"
 	PrometheusQueryAddress  = "prometheusQueryAddress"
 
+	OTLPEndpoint         = "otlpEndpoint"
+	OTLPHeaders          = "otlpHeaders"
+	InfluxDBWriteAddress = "influxdbWriteAddress"
+	InfluxDBToken        = "influxdbToken"
+
 	PrometheusConfigServiceURL   = "prometheusConfigServiceURL"
 	AlertmanagerConfigServiceURL = "alertmanagerConfigServiceURL"
 	AlertmanagerApiURL           = "alertmanagerApiURL"
@@ -0,0 +1,206 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"magma/orc8r/cloud/go/metrics"
+
+	"github.com/golang/glog"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	defaultExportInterval = time.Second * 30
+	defaultMaxBatchSize   = 5000
+	defaultMaxBufferSize  = 100000
+	defaultMaxRetries     = 3
+	defaultRetryBackoff   = time.Second
+	defaultRequestTimeout = time.Second * 10
+
+	exporterLabelName = "exporter"
+	reasonLabelName   = "reason"
+
+	// DropReasonBufferFull is recorded for the oldest points dropped when the
+	// buffer of an exporter overflows
+	DropReasonBufferFull = "buffer_full"
+	// DropReasonRejected is recorded for points the datasink rejected
+	DropReasonRejected = "rejected"
+	// DropReasonEncoding is recorded for points which couldn't be encoded
+	DropReasonEncoding = "encoding"
+)
+
+var droppedPointsCount = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "metricsd_exporter_dropped_points_total",
+		Help: "Number of points an exporter dropped without writing them",
+	},
+	[]string{exporterLabelName, reasonLabelName},
+)
+
+func init() {
+	prometheus.MustRegister(droppedPointsCount)
+}
+
+// RecordDroppedPoints counts and logs points the named exporter dropped
+// without writing them.
+func RecordDroppedPoints(exporter string, reason string, count int) {
+	if count <= 0 {
+		return
+	}
+	glog.Errorf("%s exporter dropped %d points: %s", exporter, count, reason)
+	droppedPointsCount.WithLabelValues(exporter, reason).Add(float64(count))
+}
+
+// RemoteWriteConfig configures how an exporter which writes to a remote
+// datasink batches and retries its writes.
+type RemoteWriteConfig struct {
+	// ExportInterval is how often the buffered points are written
+	ExportInterval time.Duration
+	// MaxBatchSize is the max number of points written by a single request
+	MaxBatchSize int
+	// MaxBufferSize is the max number of points buffered between two exports,
+	// including those of failed writes which are queued to be written again.
+	// The oldest points are dropped once the buffer is full.
+	MaxBufferSize int
+	// MaxRetries is the number of times a failed write is retried
+	MaxRetries int
+	// RetryBackoff is the wait before the first retry. It doubles every retry.
+	RetryBackoff time.Duration
+	// Headers are set on every write request, e.g. for authorization
+	Headers map[string]string
+}
+
+// DefaultRemoteWriteConfig returns the RemoteWriteConfig used when metricsd
+// config doesn't override it.
+func DefaultRemoteWriteConfig() RemoteWriteConfig {
+	return RemoteWriteConfig{
+		ExportInterval: defaultExportInterval,
+		MaxBatchSize:   defaultMaxBatchSize,
+		MaxBufferSize:  defaultMaxBufferSize,
+		MaxRetries:     defaultMaxRetries,
+		RetryBackoff:   defaultRetryBackoff,
+	}
+}
+
+// RejectedWriteError is returned by Write when the datasink rejected the
+// write. Writing the same body again would fail the same way.
+type RejectedWriteError struct {
+	error
+}
+
+// RemoteWriter posts batches of encoded points to a remote datasink.
+type RemoteWriter struct {
+	address     string
+	contentType string
+	config      RemoteWriteConfig
+	client      *http.Client
+}
+
+func NewRemoteWriter(address, contentType string, config RemoteWriteConfig) *RemoteWriter {
+	return &RemoteWriter{
+		address:     address,
+		contentType: contentType,
+		config:      config,
+		client:      &http.Client{Timeout: defaultRequestTimeout},
+	}
+}
+
+// Write posts body to the datasink. Request errors, 429 and 5xx responses
+// are retried with exponential backoff, any other non-2xx response fails the
+// write immediately with a RejectedWriteError.
+func (w *RemoteWriter) Write(body []byte) error {
+	backoff := w.config.RetryBackoff
+	var err error
+	for attempt := 0; attempt <= w.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			glog.V(2).Infof("Retrying write to %s after error: %v", w.address, err)
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+		var retryable bool
+		retryable, err = w.post(body)
+		if err == nil {
+			return nil
+		}
+		if !retryable {
+			return RejectedWriteError{err}
+		}
+	}
+	return fmt.Errorf("write to %s failed after %d retries: %v", w.address, w.config.MaxRetries, err)
+}
+
+func (w *RemoteWriter) post(body []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, w.address, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", w.contentType)
+	for name, value := range w.config.Headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, fmt.Errorf("error making request: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		return false, nil
+	}
+	respBody, _ := ioutil.ReadAll(resp.Body)
+	err = fmt.Errorf("error writing to %s: status %d: %s", w.address, resp.StatusCode, string(respBody))
+	retryable := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	return retryable, err
+}
+
+// GetContextLabels returns the labels which identify where a metric comes
+// from, i.e. network and gateway IDs of gateway and pushed metrics and the
+// host of cloud metrics. The remaining labels of the metric are returned
+// separately.
+func GetContextLabels(context MetricsContext, metric *dto.Metric) (map[string]string, map[string]string) {
+	metricLabels := make(map[string]string, len(metric.Label))
+	for _, label := range metric.Label {
+		metricLabels[label.GetName()] = label.GetValue()
+	}
+
+	contextLabels := map[string]string{}
+	switch additionalCtx := context.AdditionalContext.(type) {
+	case *CloudMetricContext:
+		contextLabels[metrics.CloudHostLabelName] = additionalCtx.CloudHost
+	case *GatewayMetricContext:
+		contextLabels[metrics.NetworkLabelName] = additionalCtx.NetworkID
+		contextLabels[metrics.GatewayLabelName] = additionalCtx.GatewayID
+	case *PushedMetricContext:
+		contextLabels[metrics.NetworkLabelName] = additionalCtx.NetworkID
+		if gatewayID, ok := metricLabels[metrics.GatewayLabelName]; ok && gatewayID != "" {
+			contextLabels[metrics.GatewayLabelName] = gatewayID
+		}
+	}
+	for name := range contextLabels {
+		delete(metricLabels, name)
+	}
+	return contextLabels, metricLabels
+}
+
+// GetTimestampMs returns the timestamp of the metric, or the current time if
+// the metric doesn't have one.
+func GetTimestampMs(metric *dto.Metric) int64 {
+	if metric.TimestampMs == nil || *metric.TimestampMs == 0 {
+		return time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	return *metric.TimestampMs
+}
@@ -0,0 +1,93 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package exporters_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/metrics"
+	"magma/orc8r/cloud/go/services/metricsd/exporters"
+	tests "magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteWriter_Write(t *testing.T) {
+	var statuses []int
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Token abc", r.Header.Get("Authorization"))
+		status := statuses[0]
+		statuses = statuses[1:]
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	config := exporters.DefaultRemoteWriteConfig()
+	config.RetryBackoff = time.Millisecond
+	config.Headers = map[string]string{"Authorization": "Token abc"}
+	writer := exporters.NewRemoteWriter(srv.URL, "text/plain", config)
+
+	// Server errors and throttling are retried
+	statuses = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusNoContent}
+	err := writer.Write([]byte("foo"))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"foo", "foo", "foo"}, bodies)
+
+	// Client errors aren't
+	bodies = nil
+	statuses = []int{http.StatusBadRequest}
+	err = writer.Write([]byte("bar"))
+	assert.Error(t, err)
+	assert.Equal(t, []string{"bar"}, bodies)
+
+	// Give up after max retries
+	bodies = nil
+	statuses = []int{500, 500, 500, 500}
+	err = writer.Write([]byte("baz"))
+	assert.Error(t, err)
+	assert.Len(t, bodies, 4)
+	assert.Empty(t, statuses)
+}
+
+func TestGetContextLabels(t *testing.T) {
+	metric := &dto.Metric{
+		Label: []*dto.LabelPair{
+			{Name: tests.MakeStringPointer(metrics.NetworkLabelName), Value: tests.MakeStringPointer("n1")},
+			{Name: tests.MakeStringPointer(metrics.GatewayLabelName), Value: tests.MakeStringPointer("g1")},
+			{Name: tests.MakeStringPointer("service"), Value: tests.MakeStringPointer("mme")},
+		},
+	}
+
+	contextLabels, metricLabels := exporters.GetContextLabels(exporters.MetricsContext{
+		AdditionalContext: &exporters.GatewayMetricContext{NetworkID: "n1", GatewayID: "g1"},
+	}, metric)
+	assert.Equal(t, map[string]string{metrics.NetworkLabelName: "n1", metrics.GatewayLabelName: "g1"}, contextLabels)
+	assert.Equal(t, map[string]string{"service": "mme"}, metricLabels)
+
+	contextLabels, metricLabels = exporters.GetContextLabels(exporters.MetricsContext{
+		AdditionalContext: &exporters.PushedMetricContext{NetworkID: "n1"},
+	}, metric)
+	assert.Equal(t, map[string]string{metrics.NetworkLabelName: "n1", metrics.GatewayLabelName: "g1"}, contextLabels)
+	assert.Equal(t, map[string]string{"service": "mme"}, metricLabels)
+
+	contextLabels, metricLabels = exporters.GetContextLabels(exporters.MetricsContext{
+		AdditionalContext: &exporters.CloudMetricContext{CloudHost: "host1"},
+	}, metric)
+	assert.Equal(t, map[string]string{metrics.CloudHostLabelName: "host1"}, contextLabels)
+	assert.Equal(t, map[string]string{metrics.NetworkLabelName: "n1", metrics.GatewayLabelName: "g1", "service": "mme"}, metricLabels)
+}
@@ -0,0 +1,241 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	mxd_exp "magma/orc8r/cloud/go/services/metricsd/exporters"
+
+	"github.com/golang/glog"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	exporterName            = "InfluxDB"
+	lineProtocolContentType = "text/plain; charset=utf-8"
+
+	// Field names follow the mapping of Telegraf's prometheus input, so
+	// queries work the same for metrics scraped from the pushgateway.
+	counterField = "counter"
+	gaugeField   = "gauge"
+	untypedField = "value"
+	sumField     = "sum"
+	countField   = "count"
+)
+
+var (
+	measurementEscaper = strings.NewReplacer(",", `\,`, " ", `\ `)
+	keyEscaper         = strings.NewReplacer(",", `\,`, "=", `\=`, " ", `\ `)
+)
+
+// InfluxDBExporter writes metrics to an InfluxDB write endpoint in line
+// protocol. Metrics are buffered and written in batches every export interval.
+type InfluxDBExporter struct {
+	writer *mxd_exp.RemoteWriter
+	config mxd_exp.RemoteWriteConfig
+	lines  []string
+	sync.Mutex
+}
+
+// NewInfluxDBExporter creates a new exporter to the InfluxDB write endpoint
+// at writeAddress, e.g. http://influxdb:8086/write?db=magma
+func NewInfluxDBExporter(writeAddress string, config mxd_exp.RemoteWriteConfig) mxd_exp.Exporter {
+	if !strings.HasPrefix(writeAddress, "http") {
+		writeAddress = fmt.Sprintf("http://%s", writeAddress)
+	}
+	return &InfluxDBExporter{
+		writer: mxd_exp.NewRemoteWriter(writeAddress, lineProtocolContentType, config),
+		config: config,
+	}
+}
+
+// Submit converts the metrics to lines and buffers them to be written later
+func (e *InfluxDBExporter) Submit(metrics []mxd_exp.MetricAndContext) error {
+	var lines []string
+	for _, metricAndContext := range metrics {
+		for _, metric := range metricAndContext.Family.Metric {
+			line, err := toLine(metricAndContext, metric)
+			if err != nil {
+				glog.Errorf("Dropping metric %s: %v", metricAndContext.Context.MetricName, err)
+				continue
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	e.buffer(append(e.lines, lines...))
+	return nil
+}
+
+// buffer replaces the buffered lines, dropping the oldest ones which don't
+// fit in the buffer. Must be called with the lock held.
+func (e *InfluxDBExporter) buffer(lines []string) {
+	if overflow := len(lines) - e.config.MaxBufferSize; overflow > 0 {
+		mxd_exp.RecordDroppedPoints(exporterName, mxd_exp.DropReasonBufferFull, overflow)
+		lines = lines[overflow:]
+	}
+	e.lines = lines
+}
+
+// Start runs exportEvery() in a goroutine to continuously write metrics at
+// every export interval
+func (e *InfluxDBExporter) Start() {
+	go e.exportEvery()
+}
+
+func (e *InfluxDBExporter) exportEvery() {
+	for range time.Tick(e.config.ExportInterval) {
+		errs := e.export()
+		if len(errs) > 0 {
+			glog.Errorf("error in writing to InfluxDB: %v", errs)
+		}
+	}
+}
+
+func (e *InfluxDBExporter) export() []error {
+	e.Lock()
+	lines := e.lines
+	e.lines = nil
+	e.Unlock()
+
+	var errs []error
+	var failed []string
+	for start := 0; start < len(lines); start += e.config.MaxBatchSize {
+		end := start + e.config.MaxBatchSize
+		if end > len(lines) {
+			end = len(lines)
+		}
+		body := strings.Join(lines[start:end], "\n")
+		err := e.writer.Write([]byte(body))
+		switch err.(type) {
+		case nil:
+		case mxd_exp.RejectedWriteError:
+			errs = append(errs, err)
+			mxd_exp.RecordDroppedPoints(exporterName, mxd_exp.DropReasonRejected, end-start)
+		default:
+			errs = append(errs, err)
+			failed = append(failed, lines[start:end]...)
+		}
+	}
+
+	// Lines of failed writes go back in front of those submitted meanwhile,
+	// to be written again at the next export
+	if len(failed) > 0 {
+		e.Lock()
+		e.buffer(append(failed, e.lines...))
+		e.Unlock()
+	}
+	return errs
+}
+
+// toLine converts a metric to a line of the line protocol. The labels of the
+// metric and its context become tags, and its values become fields.
+func toLine(metricAndContext mxd_exp.MetricAndContext, metric *dto.Metric) (string, error) {
+	name := metricAndContext.Context.MetricName
+	if name == "" {
+		return "", fmt.Errorf("metric name is empty")
+	}
+	fields := getFields(metricAndContext.Family.GetType(), metric)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("metric has no finite values")
+	}
+
+	contextLabels, metricLabels := mxd_exp.GetContextLabels(metricAndContext.Context, metric)
+	for labelName, value := range contextLabels {
+		metricLabels[labelName] = value
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString(measurementEscaper.Replace(name))
+	for _, tag := range sortedKeys(metricLabels) {
+		// InfluxDB rejects empty tag values
+		if metricLabels[tag] == "" {
+			continue
+		}
+		builder.WriteString(",")
+		builder.WriteString(keyEscaper.Replace(tag))
+		builder.WriteString("=")
+		builder.WriteString(keyEscaper.Replace(metricLabels[tag]))
+	}
+	for i, field := range sortedKeys(fields) {
+		if i == 0 {
+			builder.WriteString(" ")
+		} else {
+			builder.WriteString(",")
+		}
+		builder.WriteString(keyEscaper.Replace(field))
+		builder.WriteString("=")
+		builder.WriteString(fields[field])
+	}
+	builder.WriteString(" ")
+	builder.WriteString(strconv.FormatInt(mxd_exp.GetTimestampMs(metric)*int64(time.Millisecond), 10))
+	return builder.String(), nil
+}
+
+// getFields returns the formatted field values of a metric. Summaries get a
+// field per quantile and histograms a field per bucket, keyed by the quantile
+// and the bucket's upper bound.
+func getFields(metricType dto.MetricType, metric *dto.Metric) map[string]string {
+	fields := map[string]string{}
+	addField := func(name string, value float64) {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			return
+		}
+		fields[name] = strconv.FormatFloat(value, 'g', -1, 64)
+	}
+
+	switch metricType {
+	case dto.MetricType_COUNTER:
+		addField(counterField, metric.GetCounter().GetValue())
+	case dto.MetricType_GAUGE:
+		addField(gaugeField, metric.GetGauge().GetValue())
+	case dto.MetricType_UNTYPED:
+		addField(untypedField, metric.GetUntyped().GetValue())
+	case dto.MetricType_SUMMARY:
+		summary := metric.GetSummary()
+		addField(sumField, summary.GetSampleSum())
+		addField(countField, float64(summary.GetSampleCount()))
+		for _, quantile := range summary.GetQuantile() {
+			addField(formatBound(quantile.GetQuantile()), quantile.GetValue())
+		}
+	case dto.MetricType_HISTOGRAM:
+		histogram := metric.GetHistogram()
+		addField(sumField, histogram.GetSampleSum())
+		addField(countField, float64(histogram.GetSampleCount()))
+		for _, bucket := range histogram.GetBucket() {
+			addField(formatBound(bucket.GetUpperBound()), float64(bucket.GetCumulativeCount()))
+		}
+	}
+	return fields
+}
+
+func formatBound(bound float64) string {
+	if math.IsInf(bound, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(bound, 'g', -1, 64)
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
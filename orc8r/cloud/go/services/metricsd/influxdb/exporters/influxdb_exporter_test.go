@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"magma/orc8r/cloud/go/metrics"
+	mxd_exp "magma/orc8r/cloud/go/services/metricsd/exporters"
+	tests "magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	sampleTimestampMs = int64(1500000000000)
+
+	sampleGatewayContext = mxd_exp.MetricsContext{
+		MetricName: "mme_new_association",
+		AdditionalContext: &mxd_exp.GatewayMetricContext{
+			NetworkID: "network 1",
+			GatewayID: "gw1",
+		},
+	}
+	sampleCloudContext = mxd_exp.MetricsContext{
+		MetricName:        "process_cpu_seconds_total",
+		AdditionalContext: &mxd_exp.CloudMetricContext{CloudHost: "controller"},
+	}
+)
+
+func TestToLine(t *testing.T) {
+	counter := tests.MakePromoCounter(12)
+	counter.TimestampMs = &sampleTimestampMs
+	counter.Label = []*dto.LabelPair{
+		{Name: tests.MakeStringPointer(metrics.NetworkLabelName), Value: tests.MakeStringPointer("network 1")},
+		{Name: tests.MakeStringPointer("cause"), Value: tests.MakeStringPointer("a=b,c")},
+		{Name: tests.MakeStringPointer("empty"), Value: tests.MakeStringPointer("")},
+	}
+	line, err := toLine(makeMetricAndContext(dto.MetricType_COUNTER, sampleGatewayContext, &counter), &counter)
+	assert.NoError(t, err)
+	assert.Equal(t, `mme_new_association,cause=a\=b\,c,gatewayID=gw1,networkID=network\ 1 counter=12 1500000000000000000`, line)
+
+	gauge := tests.MakePromoGauge(0.5)
+	gauge.TimestampMs = &sampleTimestampMs
+	line, err = toLine(makeMetricAndContext(dto.MetricType_GAUGE, sampleCloudContext, &gauge), &gauge)
+	assert.NoError(t, err)
+	assert.Equal(t, "process_cpu_seconds_total,cloudHost=controller gauge=0.5 1500000000000000000", line)
+
+	summary := tests.MakePromoSummary(map[float64]float64{0.5: 0.05}, []float64{1, 2, 3})
+	summary.TimestampMs = &sampleTimestampMs
+	line, err = toLine(makeMetricAndContext(dto.MetricType_SUMMARY, sampleCloudContext, &summary), &summary)
+	assert.NoError(t, err)
+	assert.Equal(t, "process_cpu_seconds_total,cloudHost=controller 0.5=2,count=3,sum=6 1500000000000000000", line)
+
+	histogram := tests.MakePromoHistogram([]float64{1, 5}, []float64{0.5, 3, 7})
+	histogram.TimestampMs = &sampleTimestampMs
+	line, err = toLine(makeMetricAndContext(dto.MetricType_HISTOGRAM, sampleCloudContext, &histogram), &histogram)
+	assert.NoError(t, err)
+	assert.Equal(t, "process_cpu_seconds_total,cloudHost=controller 1=1,5=2,count=3,sum=10.5 1500000000000000000", line)
+
+	nan := tests.MakePromoGauge(math.NaN())
+	_, err = toLine(makeMetricAndContext(dto.MetricType_GAUGE, sampleCloudContext, &nan), &nan)
+	assert.EqualError(t, err, "metric has no finite values")
+}
+
+func TestInfluxDBExporter_Export(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	config := mxd_exp.DefaultRemoteWriteConfig()
+	config.MaxBatchSize = 2
+	config.MaxBufferSize = 3
+	exp := NewInfluxDBExporter(srv.URL, config).(*InfluxDBExporter)
+
+	var metrics []mxd_exp.MetricAndContext
+	for i := 0; i < 4; i++ {
+		gauge := tests.MakePromoGauge(float64(i))
+		gauge.TimestampMs = &sampleTimestampMs
+		metrics = append(metrics, makeMetricAndContext(dto.MetricType_GAUGE, sampleCloudContext, &gauge))
+	}
+	err := exp.Submit(metrics)
+	assert.NoError(t, err)
+
+	// The oldest point overflows the buffer, the rest are written in 2 batches
+	errs := exp.export()
+	assert.Empty(t, errs)
+	assert.Len(t, bodies, 2)
+	assert.Equal(t, []string{
+		"process_cpu_seconds_total,cloudHost=controller gauge=1 1500000000000000000",
+		"process_cpu_seconds_total,cloudHost=controller gauge=2 1500000000000000000",
+	}, strings.Split(bodies[0], "\n"))
+	assert.Equal(t, "process_cpu_seconds_total,cloudHost=controller gauge=3 1500000000000000000", bodies[1])
+
+	// Buffer is emptied by an export
+	bodies = nil
+	errs = exp.export()
+	assert.Empty(t, errs)
+	assert.Empty(t, bodies)
+}
+
+func TestInfluxDBExporter_ExportFailures(t *testing.T) {
+	var bodies []string
+	status := http.StatusServiceUnavailable
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	config := mxd_exp.DefaultRemoteWriteConfig()
+	config.MaxRetries = 0
+	config.MaxBufferSize = 3
+	exp := NewInfluxDBExporter(srv.URL, config).(*InfluxDBExporter)
+	submitGauges := func(values ...float64) {
+		var metrics []mxd_exp.MetricAndContext
+		for _, value := range values {
+			gauge := tests.MakePromoGauge(value)
+			gauge.TimestampMs = &sampleTimestampMs
+			metrics = append(metrics, makeMetricAndContext(dto.MetricType_GAUGE, sampleCloudContext, &gauge))
+		}
+		assert.NoError(t, exp.Submit(metrics))
+	}
+	line := func(value int) string {
+		return fmt.Sprintf("process_cpu_seconds_total,cloudHost=controller gauge=%d 1500000000000000000", value)
+	}
+
+	// Lines of a failed write are queued before newer ones, and the oldest
+	// are dropped once the buffer is full
+	submitGauges(0, 1)
+	errs := exp.export()
+	assert.Len(t, errs, 1)
+	submitGauges(2, 3)
+	assert.Equal(t, []string{line(1), line(2), line(3)}, exp.lines)
+
+	status = http.StatusNoContent
+	bodies = nil
+	errs = exp.export()
+	assert.Empty(t, errs)
+	assert.Equal(t, []string{strings.Join([]string{line(1), line(2), line(3)}, "\n")}, bodies)
+	assert.Empty(t, exp.lines)
+
+	// Rejected lines aren't queued again
+	status = http.StatusBadRequest
+	submitGauges(4)
+	errs = exp.export()
+	assert.Len(t, errs, 1)
+	assert.IsType(t, mxd_exp.RejectedWriteError{}, errs[0])
+	assert.Empty(t, exp.lines)
+}
+
+func makeMetricAndContext(metricType dto.MetricType, context mxd_exp.MetricsContext, metric *dto.Metric) mxd_exp.MetricAndContext {
+	return mxd_exp.MetricAndContext{
+		Family: &dto.MetricFamily{
+			Name:   tests.MakeStringPointer(context.MetricName),
+			Type:   tests.MakeMetricTypePointer(metricType),
+			Metric: []*dto.Metric{metric},
+		},
+		Context: context,
+	}
+}
@@ -26,6 +26,15 @@ type AlertReceiverConfig struct {
 	// Required: true
 	Name *string `json:"name"`
 
+	// opsgenie configs
+	OpsgenieConfigs []*OpsgenieReceiver `json:"opsgenie_configs"`
+
+	// pagerduty configs
+	PagerdutyConfigs []*PagerdutyReceiver `json:"pagerduty_configs"`
+
+	// pushover configs
+	PushoverConfigs []*PushoverReceiver `json:"pushover_configs"`
+
 	// slack configs
 	SLACKConfigs []*SLACKReceiver `json:"slack_configs"`
 
@@ -45,6 +54,18 @@ func (m *AlertReceiverConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOpsgenieConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePagerdutyConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePushoverConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSLACKConfigs(formats); err != nil {
 		res = append(res, err)
 	}
@@ -93,6 +114,81 @@ func (m *AlertReceiverConfig) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *AlertReceiverConfig) validateOpsgenieConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.OpsgenieConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.OpsgenieConfigs); i++ {
+		if swag.IsZero(m.OpsgenieConfigs[i]) { // not required
+			continue
+		}
+
+		if m.OpsgenieConfigs[i] != nil {
+			if err := m.OpsgenieConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("opsgenie_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *AlertReceiverConfig) validatePagerdutyConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PagerdutyConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PagerdutyConfigs); i++ {
+		if swag.IsZero(m.PagerdutyConfigs[i]) { // not required
+			continue
+		}
+
+		if m.PagerdutyConfigs[i] != nil {
+			if err := m.PagerdutyConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pagerduty_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *AlertReceiverConfig) validatePushoverConfigs(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PushoverConfigs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PushoverConfigs); i++ {
+		if swag.IsZero(m.PushoverConfigs[i]) { // not required
+			continue
+		}
+
+		if m.PushoverConfigs[i] != nil {
+			if err := m.PushoverConfigs[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pushover_configs" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *AlertReceiverConfig) validateSLACKConfigs(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.SLACKConfigs) { // not required
@@ -0,0 +1,275 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	mxd_exp "magma/orc8r/cloud/go/services/metricsd/exporters"
+
+	"github.com/golang/glog"
+	dto "github.com/prometheus/client_model/go"
+)
+
+const (
+	exporterName    = "OTLP"
+	otlpContentType = "application/json"
+	scopeName       = "magma/metricsd"
+
+	// aggregationTemporalityCumulative is the OTLP temporality of prometheus
+	// counters, summaries and histograms
+	aggregationTemporalityCumulative = 2
+)
+
+// OTLPExporter writes metrics to an OpenTelemetry collector with the OTLP/HTTP
+// JSON encoding. The context labels of a metric (network, gateway, cloud host)
+// become resource attributes, and its other labels data point attributes.
+type OTLPExporter struct {
+	writer *mxd_exp.RemoteWriter
+	config mxd_exp.RemoteWriteConfig
+	points []otlpPoint
+	sync.Mutex
+}
+
+// otlpPoint is a single data point of a metric along with the resource it
+// comes from
+type otlpPoint struct {
+	resource map[string]string
+	metric   otlpMetric
+}
+
+// NewOTLPExporter creates a new exporter to the OTLP/HTTP metrics endpoint
+// at endpoint, e.g. http://otel-collector:4318/v1/metrics
+func NewOTLPExporter(endpoint string, config mxd_exp.RemoteWriteConfig) mxd_exp.Exporter {
+	if !strings.HasPrefix(endpoint, "http") {
+		endpoint = fmt.Sprintf("http://%s", endpoint)
+	}
+	return &OTLPExporter{
+		writer: mxd_exp.NewRemoteWriter(endpoint, otlpContentType, config),
+		config: config,
+	}
+}
+
+// Submit converts the metrics to OTLP data points and buffers them to be
+// written later
+func (e *OTLPExporter) Submit(metrics []mxd_exp.MetricAndContext) error {
+	var points []otlpPoint
+	for _, metricAndContext := range metrics {
+		for _, metric := range metricAndContext.Family.Metric {
+			point, err := toPoint(metricAndContext, metric)
+			if err != nil {
+				glog.Errorf("Dropping metric %s: %v", metricAndContext.Context.MetricName, err)
+				continue
+			}
+			points = append(points, point)
+		}
+	}
+
+	e.Lock()
+	defer e.Unlock()
+	e.buffer(append(e.points, points...))
+	return nil
+}
+
+// buffer replaces the buffered points, dropping the oldest ones which don't
+// fit in the buffer. Must be called with the lock held.
+func (e *OTLPExporter) buffer(points []otlpPoint) {
+	if overflow := len(points) - e.config.MaxBufferSize; overflow > 0 {
+		mxd_exp.RecordDroppedPoints(exporterName, mxd_exp.DropReasonBufferFull, overflow)
+		points = points[overflow:]
+	}
+	e.points = points
+}
+
+// Start runs exportEvery() in a goroutine to continuously write metrics at
+// every export interval
+func (e *OTLPExporter) Start() {
+	go e.exportEvery()
+}
+
+func (e *OTLPExporter) exportEvery() {
+	for range time.Tick(e.config.ExportInterval) {
+		errs := e.export()
+		if len(errs) > 0 {
+			glog.Errorf("error in writing to OTLP endpoint: %v", errs)
+		}
+	}
+}
+
+func (e *OTLPExporter) export() []error {
+	e.Lock()
+	points := e.points
+	e.points = nil
+	e.Unlock()
+
+	var errs []error
+	var failed []otlpPoint
+	for start := 0; start < len(points); start += e.config.MaxBatchSize {
+		end := start + e.config.MaxBatchSize
+		if end > len(points) {
+			end = len(points)
+		}
+		body, err := json.Marshal(makeExportRequest(points[start:end]))
+		if err != nil {
+			errs = append(errs, err)
+			mxd_exp.RecordDroppedPoints(exporterName, mxd_exp.DropReasonEncoding, end-start)
+			continue
+		}
+		err = e.writer.Write(body)
+		switch err.(type) {
+		case nil:
+		case mxd_exp.RejectedWriteError:
+			errs = append(errs, err)
+			mxd_exp.RecordDroppedPoints(exporterName, mxd_exp.DropReasonRejected, end-start)
+		default:
+			errs = append(errs, err)
+			failed = append(failed, points[start:end]...)
+		}
+	}
+
+	// Points of failed writes go back in front of those submitted meanwhile,
+	// to be written again at the next export
+	if len(failed) > 0 {
+		e.Lock()
+		e.buffer(append(failed, e.points...))
+		e.Unlock()
+	}
+	return errs
+}
+
+// makeExportRequest groups the points by resource, keeping the order the
+// resources were first seen in
+func makeExportRequest(points []otlpPoint) *exportMetricsServiceRequest {
+	req := &exportMetricsServiceRequest{}
+	resourceIndices := map[string]int{}
+	for _, point := range points {
+		key := resourceKey(point.resource)
+		idx, ok := resourceIndices[key]
+		if !ok {
+			idx = len(req.ResourceMetrics)
+			resourceIndices[key] = idx
+			req.ResourceMetrics = append(req.ResourceMetrics, resourceMetrics{
+				Resource:     resource{Attributes: toAttributes(point.resource)},
+				ScopeMetrics: []scopeMetrics{{Scope: instrumentationScope{Name: scopeName}}},
+			})
+		}
+		scope := &req.ResourceMetrics[idx].ScopeMetrics[0]
+		scope.Metrics = append(scope.Metrics, point.metric)
+	}
+	return req
+}
+
+func resourceKey(labels map[string]string) string {
+	builder := strings.Builder{}
+	for _, attr := range toAttributes(labels) {
+		builder.WriteString(attr.Key)
+		builder.WriteString("=")
+		builder.WriteString(attr.Value.StringValue)
+		builder.WriteString(",")
+	}
+	return builder.String()
+}
+
+func toPoint(metricAndContext mxd_exp.MetricAndContext, metric *dto.Metric) (otlpPoint, error) {
+	name := metricAndContext.Context.MetricName
+	if name == "" {
+		return otlpPoint{}, fmt.Errorf("metric name is empty")
+	}
+	contextLabels, metricLabels := mxd_exp.GetContextLabels(metricAndContext.Context, metric)
+	attributes := toAttributes(metricLabels)
+	timeUnixNano := strconv.FormatInt(mxd_exp.GetTimestampMs(metric)*int64(time.Millisecond), 10)
+
+	ret := otlpMetric{Name: name}
+	switch metricAndContext.Family.GetType() {
+	case dto.MetricType_COUNTER:
+		ret.Sum = &sum{
+			DataPoints:             []numberDataPoint{{Attributes: attributes, TimeUnixNano: timeUnixNano, AsDouble: jsonFloat(metric.GetCounter().GetValue())}},
+			AggregationTemporality: aggregationTemporalityCumulative,
+			IsMonotonic:            true,
+		}
+	case dto.MetricType_GAUGE:
+		ret.Gauge = &gauge{
+			DataPoints: []numberDataPoint{{Attributes: attributes, TimeUnixNano: timeUnixNano, AsDouble: jsonFloat(metric.GetGauge().GetValue())}},
+		}
+	case dto.MetricType_UNTYPED:
+		ret.Gauge = &gauge{
+			DataPoints: []numberDataPoint{{Attributes: attributes, TimeUnixNano: timeUnixNano, AsDouble: jsonFloat(metric.GetUntyped().GetValue())}},
+		}
+	case dto.MetricType_SUMMARY:
+		s := metric.GetSummary()
+		dataPoint := summaryDataPoint{
+			Attributes:   attributes,
+			TimeUnixNano: timeUnixNano,
+			Count:        strconv.FormatUint(s.GetSampleCount(), 10),
+			Sum:          jsonFloat(s.GetSampleSum()),
+		}
+		for _, q := range s.GetQuantile() {
+			dataPoint.QuantileValues = append(dataPoint.QuantileValues, valueAtQuantile{Quantile: jsonFloat(q.GetQuantile()), Value: jsonFloat(q.GetValue())})
+		}
+		ret.Summary = &summary{DataPoints: []summaryDataPoint{dataPoint}}
+	case dto.MetricType_HISTOGRAM:
+		ret.Histogram = &histogram{
+			DataPoints:             []histogramDataPoint{toHistogramDataPoint(metric.GetHistogram(), attributes, timeUnixNano)},
+			AggregationTemporality: aggregationTemporalityCumulative,
+		}
+	default:
+		return otlpPoint{}, fmt.Errorf("unsupported metric type %v", metricAndContext.Family.GetType())
+	}
+	return otlpPoint{resource: contextLabels, metric: ret}, nil
+}
+
+// toHistogramDataPoint converts the cumulative buckets of a prometheus
+// histogram to the per-bucket counts of OTLP. The implicit +Inf bucket holds
+// the observations above the highest explicit bound.
+func toHistogramDataPoint(h *dto.Histogram, attributes []keyValue, timeUnixNano string) histogramDataPoint {
+	dataPoint := histogramDataPoint{
+		Attributes:   attributes,
+		TimeUnixNano: timeUnixNano,
+		Count:        strconv.FormatUint(h.GetSampleCount(), 10),
+		Sum:          jsonFloat(h.GetSampleSum()),
+	}
+	var previousCount uint64
+	for _, bucket := range h.GetBucket() {
+		if math.IsInf(bucket.GetUpperBound(), 1) {
+			break
+		}
+		dataPoint.ExplicitBounds = append(dataPoint.ExplicitBounds, jsonFloat(bucket.GetUpperBound()))
+		dataPoint.BucketCounts = append(dataPoint.BucketCounts, strconv.FormatUint(countSince(previousCount, bucket.GetCumulativeCount()), 10))
+		previousCount = bucket.GetCumulativeCount()
+	}
+	dataPoint.BucketCounts = append(dataPoint.BucketCounts, strconv.FormatUint(countSince(previousCount, h.GetSampleCount()), 10))
+	return dataPoint
+}
+
+func countSince(previousCount, cumulativeCount uint64) uint64 {
+	if cumulativeCount < previousCount {
+		return 0
+	}
+	return cumulativeCount - previousCount
+}
+
+func toAttributes(labels map[string]string) []keyValue {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	attributes := make([]keyValue, 0, len(keys))
+	for _, key := range keys {
+		attributes = append(attributes, keyValue{Key: key, Value: anyValue{StringValue: labels[key]}})
+	}
+	return attributes
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"magma/orc8r/cloud/go/metrics"
+	mxd_exp "magma/orc8r/cloud/go/services/metricsd/exporters"
+	tests "magma/orc8r/cloud/go/services/metricsd/test_common"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	sampleTimestampMs   = int64(1500000000000)
+	sampleTimeUnixNano  = "1500000000000000000"
+	sampleGatewayLabels = []*dto.LabelPair{
+		{Name: tests.MakeStringPointer(metrics.NetworkLabelName), Value: tests.MakeStringPointer("n1")},
+		{Name: tests.MakeStringPointer(metrics.GatewayLabelName), Value: tests.MakeStringPointer("g1")},
+		{Name: tests.MakeStringPointer("cause"), Value: tests.MakeStringPointer("timeout")},
+	}
+
+	sampleGatewayContext = mxd_exp.MetricsContext{
+		MetricName:        "mme_new_association",
+		AdditionalContext: &mxd_exp.GatewayMetricContext{NetworkID: "n1", GatewayID: "g1"},
+	}
+	sampleCloudContext = mxd_exp.MetricsContext{
+		MetricName:        "process_cpu_seconds_total",
+		AdditionalContext: &mxd_exp.CloudMetricContext{CloudHost: "controller"},
+	}
+)
+
+func TestToPoint(t *testing.T) {
+	counter := tests.MakePromoCounter(12)
+	counter.TimestampMs = &sampleTimestampMs
+	counter.Label = sampleGatewayLabels
+	point, err := toPoint(makeMetricAndContext(dto.MetricType_COUNTER, sampleGatewayContext, &counter), &counter)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{metrics.NetworkLabelName: "n1", metrics.GatewayLabelName: "g1"}, point.resource)
+	assert.Equal(t, otlpMetric{
+		Name: "mme_new_association",
+		Sum: &sum{
+			DataPoints: []numberDataPoint{{
+				Attributes:   []keyValue{{Key: "cause", Value: anyValue{StringValue: "timeout"}}},
+				TimeUnixNano: sampleTimeUnixNano,
+				AsDouble:     12,
+			}},
+			AggregationTemporality: aggregationTemporalityCumulative,
+			IsMonotonic:            true,
+		},
+	}, point.metric)
+
+	untyped := tests.MakePromoUntyped(3)
+	untyped.TimestampMs = &sampleTimestampMs
+	point, err = toPoint(makeMetricAndContext(dto.MetricType_UNTYPED, sampleCloudContext, &untyped), &untyped)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{metrics.CloudHostLabelName: "controller"}, point.resource)
+	assert.Equal(t, &gauge{
+		DataPoints: []numberDataPoint{{Attributes: []keyValue{}, TimeUnixNano: sampleTimeUnixNano, AsDouble: 3}},
+	}, point.metric.Gauge)
+
+	promoSummary := tests.MakePromoSummary(map[float64]float64{0.5: 0.05}, []float64{1, 2, 3})
+	promoSummary.TimestampMs = &sampleTimestampMs
+	point, err = toPoint(makeMetricAndContext(dto.MetricType_SUMMARY, sampleCloudContext, &promoSummary), &promoSummary)
+	assert.NoError(t, err)
+	assert.Equal(t, &summary{
+		DataPoints: []summaryDataPoint{{
+			Attributes:     []keyValue{},
+			TimeUnixNano:   sampleTimeUnixNano,
+			Count:          "3",
+			Sum:            6,
+			QuantileValues: []valueAtQuantile{{Quantile: 0.5, Value: 2}},
+		}},
+	}, point.metric.Summary)
+
+	promoHistogram := tests.MakePromoHistogram([]float64{1, 5}, []float64{0.5, 3, 3, 7})
+	promoHistogram.TimestampMs = &sampleTimestampMs
+	point, err = toPoint(makeMetricAndContext(dto.MetricType_HISTOGRAM, sampleCloudContext, &promoHistogram), &promoHistogram)
+	assert.NoError(t, err)
+	assert.Equal(t, &histogram{
+		DataPoints: []histogramDataPoint{{
+			Attributes:     []keyValue{},
+			TimeUnixNano:   sampleTimeUnixNano,
+			Count:          "4",
+			Sum:            13.5,
+			BucketCounts:   []string{"1", "2", "1"},
+			ExplicitBounds: []jsonFloat{1, 5},
+		}},
+		AggregationTemporality: aggregationTemporalityCumulative,
+	}, point.metric.Histogram)
+}
+
+func TestJSONFloat(t *testing.T) {
+	marshaled, err := json.Marshal([]jsonFloat{1.5, jsonFloat(math.NaN()), jsonFloat(math.Inf(1)), jsonFloat(math.Inf(-1))})
+	assert.NoError(t, err)
+	assert.Equal(t, `[1.5,"NaN","Infinity","-Infinity"]`, string(marshaled))
+}
+
+func TestOTLPExporter_Export(t *testing.T) {
+	var requests []exportMetricsServiceRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, _ := ioutil.ReadAll(r.Body)
+		req := exportMetricsServiceRequest{}
+		assert.NoError(t, json.Unmarshal(body, &req))
+		requests = append(requests, req)
+	}))
+	defer srv.Close()
+
+	exp := NewOTLPExporter(srv.URL, mxd_exp.DefaultRemoteWriteConfig()).(*OTLPExporter)
+	gatewayGauge := tests.MakePromoGauge(1)
+	gatewayGauge.Label = sampleGatewayLabels
+	cloudGauge := tests.MakePromoGauge(2)
+	otherGatewayGauge := tests.MakePromoGauge(3)
+	otherGatewayGauge.Label = sampleGatewayLabels
+	err := exp.Submit([]mxd_exp.MetricAndContext{
+		makeMetricAndContext(dto.MetricType_GAUGE, sampleGatewayContext, &gatewayGauge),
+		makeMetricAndContext(dto.MetricType_GAUGE, sampleCloudContext, &cloudGauge),
+		makeMetricAndContext(dto.MetricType_GAUGE, sampleGatewayContext, &otherGatewayGauge),
+	})
+	assert.NoError(t, err)
+
+	errs := exp.export()
+	assert.Empty(t, errs)
+	assert.Len(t, requests, 1)
+
+	// Points are grouped by resource
+	resourceMetrics := requests[0].ResourceMetrics
+	assert.Len(t, resourceMetrics, 2)
+	assert.Equal(t, []keyValue{
+		{Key: metrics.GatewayLabelName, Value: anyValue{StringValue: "g1"}},
+		{Key: metrics.NetworkLabelName, Value: anyValue{StringValue: "n1"}},
+	}, resourceMetrics[0].Resource.Attributes)
+	assert.Equal(t, scopeName, resourceMetrics[0].ScopeMetrics[0].Scope.Name)
+	assert.Len(t, resourceMetrics[0].ScopeMetrics[0].Metrics, 2)
+	assert.Equal(t, jsonFloat(3), resourceMetrics[0].ScopeMetrics[0].Metrics[1].Gauge.DataPoints[0].AsDouble)
+	assert.Equal(t, []keyValue{
+		{Key: metrics.CloudHostLabelName, Value: anyValue{StringValue: "controller"}},
+	}, resourceMetrics[1].Resource.Attributes)
+	assert.Len(t, resourceMetrics[1].ScopeMetrics[0].Metrics, 1)
+}
+
+func makeMetricAndContext(metricType dto.MetricType, context mxd_exp.MetricsContext, metric *dto.Metric) mxd_exp.MetricAndContext {
+	return mxd_exp.MetricAndContext{
+		Family: &dto.MetricFamily{
+			Name:   tests.MakeStringPointer(context.MetricName),
+			Type:   tests.MakeMetricTypePointer(metricType),
+			Metric: []*dto.Metric{metric},
+		},
+		Context: context,
+	}
+}
@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package exporters
+
+import (
+	"encoding/json"
+	"math"
+)
+
+// The types below are the subset of the OTLP metrics data model written by
+// the exporter, in the protobuf JSON mapping OTLP/HTTP expects. 64 bit
+// integers are encoded as strings, per the mapping.
+
+type exportMetricsServiceRequest struct {
+	ResourceMetrics []resourceMetrics `json:"resourceMetrics"`
+}
+
+type resourceMetrics struct {
+	Resource     resource       `json:"resource"`
+	ScopeMetrics []scopeMetrics `json:"scopeMetrics"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeMetrics struct {
+	Scope   instrumentationScope `json:"scope"`
+	Metrics []otlpMetric         `json:"metrics"`
+}
+
+type instrumentationScope struct {
+	Name string `json:"name"`
+}
+
+type otlpMetric struct {
+	Name      string     `json:"name"`
+	Gauge     *gauge     `json:"gauge,omitempty"`
+	Sum       *sum       `json:"sum,omitempty"`
+	Histogram *histogram `json:"histogram,omitempty"`
+	Summary   *summary   `json:"summary,omitempty"`
+}
+
+type gauge struct {
+	DataPoints []numberDataPoint `json:"dataPoints"`
+}
+
+type sum struct {
+	DataPoints             []numberDataPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type histogram struct {
+	DataPoints             []histogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+}
+
+type summary struct {
+	DataPoints []summaryDataPoint `json:"dataPoints"`
+}
+
+type numberDataPoint struct {
+	Attributes   []keyValue `json:"attributes"`
+	TimeUnixNano string     `json:"timeUnixNano"`
+	AsDouble     jsonFloat  `json:"asDouble"`
+}
+
+type histogramDataPoint struct {
+	Attributes     []keyValue  `json:"attributes"`
+	TimeUnixNano   string      `json:"timeUnixNano"`
+	Count          string      `json:"count"`
+	Sum            jsonFloat   `json:"sum"`
+	BucketCounts   []string    `json:"bucketCounts"`
+	ExplicitBounds []jsonFloat `json:"explicitBounds"`
+}
+
+type summaryDataPoint struct {
+	Attributes     []keyValue        `json:"attributes"`
+	TimeUnixNano   string            `json:"timeUnixNano"`
+	Count          string            `json:"count"`
+	Sum            jsonFloat         `json:"sum"`
+	QuantileValues []valueAtQuantile `json:"quantileValues"`
+}
+
+type valueAtQuantile struct {
+	Quantile jsonFloat `json:"quantile"`
+	Value    jsonFloat `json:"value"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+// jsonFloat is a double which encodes the non-finite values the way the
+// protobuf JSON mapping does, since encoding/json can't encode them.
+type jsonFloat float64
+
+func (f jsonFloat) MarshalJSON() ([]byte, error) {
+	v := float64(f)
+	switch {
+	case math.IsNaN(v):
+		return []byte(`"NaN"`), nil
+	case math.IsInf(v, 1):
+		return []byte(`"Infinity"`), nil
+	case math.IsInf(v, -1):
+		return []byte(`"-Infinity"`), nil
+	}
+	return json.Marshal(v)
+}
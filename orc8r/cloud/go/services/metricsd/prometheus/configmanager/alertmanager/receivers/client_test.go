@@ -71,6 +71,10 @@ receivers:
     headers:
       name: value
       foo: bar
+- name: test_pagerduty
+  pagerduty_configs:
+  - routing_key: 0123456789abcdef
+    severity: critical
 templates: []`
 )
 
@@ -91,6 +95,15 @@ func TestClient_CreateReceiver(t *testing.T) {
 	assert.NoError(t, err)
 	fsClient.AssertCalled(t, "WriteFile", "test/alertmanager.yml", mock.Anything, mock.Anything)
 
+	// Create PagerDuty, OpsGenie, and Pushover receivers
+	err = client.CreateReceiver(testNID, samplePagerDutyReceiver)
+	assert.NoError(t, err)
+	err = client.CreateReceiver(testNID, sampleOpsGenieReceiver)
+	assert.NoError(t, err)
+	err = client.CreateReceiver(testNID, samplePushoverReceiver)
+	assert.NoError(t, err)
+	fsClient.AssertNumberOfCalls(t, "WriteFile", 6)
+
 	// create duplicate receiver
 	err = client.CreateReceiver(testNID, Receiver{Name: "receiver"})
 	assert.Regexp(t, regexp.MustCompile("notification config name \".*receiver\" is not unique"), err.Error())
@@ -100,11 +113,13 @@ func TestClient_GetReceivers(t *testing.T) {
 	client, _ := newTestClient()
 	recs, err := client.GetReceivers(testNID)
 	assert.NoError(t, err)
-	assert.Equal(t, 4, len(recs))
+	assert.Equal(t, 5, len(recs))
 	assert.Equal(t, "receiver", recs[0].Name)
 	assert.Equal(t, "slack", recs[1].Name)
 	assert.Equal(t, "webhook", recs[2].Name)
 	assert.Equal(t, "email", recs[3].Name)
+	assert.Equal(t, "pagerduty", recs[4].Name)
+	assert.Equal(t, "0123456789abcdef", recs[4].PagerDutyConfigs[0].RoutingKey)
 
 	recs, err = client.GetReceivers(otherNID)
 	assert.NoError(t, err)
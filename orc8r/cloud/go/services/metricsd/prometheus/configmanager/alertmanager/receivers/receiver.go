@@ -87,6 +87,10 @@ type Receiver struct {
 	SlackConfigs   []*SlackConfig          `yaml:"slack_configs,omitempty" json:"slack_configs,omitempty"`
 	WebhookConfigs []*config.WebhookConfig `yaml:"webhook_configs,omitempty" json:"webhook_configs,omitempty"`
 	EmailConfigs   []*EmailConfig          `yaml:"email_configs,omitempty" json:"email_configs,omitempty"`
+
+	PagerDutyConfigs []*PagerDutyConfig `yaml:"pagerduty_configs,omitempty" json:"pagerduty_configs,omitempty"`
+	OpsGenieConfigs  []*OpsGenieConfig  `yaml:"opsgenie_configs,omitempty" json:"opsgenie_configs,omitempty"`
+	PushoverConfigs  []*PushoverConfig  `yaml:"pushover_configs,omitempty" json:"pushover_configs,omitempty"`
 }
 
 // Secure replaces the receiver's name with a tenantID prefix
@@ -165,6 +169,64 @@ func (e EmailConfig) MarshalYAML() (interface{}, error) {
 	return e, nil
 }
 
+// PagerDutyConfig uses string instead of Secret for the ServiceKey and
+// RoutingKey fields, and string instead of URL for the URL field, so that they
+// are marshaled as is instead of being obscured.
+type PagerDutyConfig struct {
+	config.NotifierConfig `yaml:",inline" json:",inline"`
+
+	ServiceKey  string                   `yaml:"service_key,omitempty" json:"service_key,omitempty"`
+	RoutingKey  string                   `yaml:"routing_key,omitempty" json:"routing_key,omitempty"`
+	URL         string                   `yaml:"url,omitempty" json:"url,omitempty"`
+	Client      string                   `yaml:"client,omitempty" json:"client,omitempty"`
+	ClientURL   string                   `yaml:"client_url,omitempty" json:"client_url,omitempty"`
+	Description string                   `yaml:"description,omitempty" json:"description,omitempty"`
+	Details     map[string]string        `yaml:"details,omitempty" json:"details,omitempty"`
+	Images      []*config.PagerdutyImage `yaml:"images,omitempty" json:"images,omitempty"`
+	Links       []*config.PagerdutyLink  `yaml:"links,omitempty" json:"links,omitempty"`
+	Severity    string                   `yaml:"severity,omitempty" json:"severity,omitempty"`
+	Class       string                   `yaml:"class,omitempty" json:"class,omitempty"`
+	Component   string                   `yaml:"component,omitempty" json:"component,omitempty"`
+	Group       string                   `yaml:"group,omitempty" json:"group,omitempty"`
+}
+
+// OpsGenieConfig uses string instead of Secret for the APIKey field so that
+// it is marshaled as is instead of being obscured. An empty APIKey or APIURL
+// falls back to the global opsgenie_api_key and opsgenie_api_url.
+type OpsGenieConfig struct {
+	config.NotifierConfig `yaml:",inline" json:",inline"`
+
+	APIKey      string            `yaml:"api_key,omitempty" json:"api_key,omitempty"`
+	APIURL      string            `yaml:"api_url,omitempty" json:"api_url,omitempty"`
+	Message     string            `yaml:"message,omitempty" json:"message,omitempty"`
+	Description string            `yaml:"description,omitempty" json:"description,omitempty"`
+	Source      string            `yaml:"source,omitempty" json:"source,omitempty"`
+	Details     map[string]string `yaml:"details,omitempty" json:"details,omitempty"`
+	Teams       string            `yaml:"teams,omitempty" json:"teams,omitempty"`
+	Tags        string            `yaml:"tags,omitempty" json:"tags,omitempty"`
+	Note        string            `yaml:"note,omitempty" json:"note,omitempty"`
+	Priority    string            `yaml:"priority,omitempty" json:"priority,omitempty"`
+}
+
+// PushoverConfig uses string instead of Secret for the UserKey and Token
+// fields so that they are marshaled as is instead of being obscured. Retry and
+// Expire are duration strings, e.g. "1m".
+type PushoverConfig struct {
+	config.NotifierConfig `yaml:",inline" json:",inline"`
+
+	UserKey  string `yaml:"user_key" json:"user_key"`
+	Token    string `yaml:"token" json:"token"`
+	Title    string `yaml:"title,omitempty" json:"title,omitempty"`
+	Message  string `yaml:"message,omitempty" json:"message,omitempty"`
+	URL      string `yaml:"url,omitempty" json:"url,omitempty"`
+	URLTitle string `yaml:"url_title,omitempty" json:"url_title,omitempty"`
+	Sound    string `yaml:"sound,omitempty" json:"sound,omitempty"`
+	Priority string `yaml:"priority,omitempty" json:"priority,omitempty"`
+	Retry    string `yaml:"retry,omitempty" json:"retry,omitempty"`
+	Expire   string `yaml:"expire,omitempty" json:"expire,omitempty"`
+	HTML     bool   `yaml:"html,omitempty" json:"html,omitempty"`
+}
+
 // RouteJSONWrapper Provides a struct to marshal/unmarshal into a rulefmt.Rule
 // since rulefmt does not support json encoding
 type RouteJSONWrapper struct {
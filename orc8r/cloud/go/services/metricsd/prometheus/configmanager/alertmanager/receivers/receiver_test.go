@@ -63,10 +63,35 @@ var (
 			Smarthost: "http://mail-server.com",
 		}},
 	}
+	samplePagerDutyReceiver = Receiver{
+		Name: "pagerduty_receiver",
+		PagerDutyConfigs: []*PagerDutyConfig{{
+			RoutingKey: "0123456789abcdef",
+			Severity:   "critical",
+			Links:      []*config.PagerdutyLink{{HRef: "http://test.com", Text: "runbook"}},
+		}},
+	}
+	sampleOpsGenieReceiver = Receiver{
+		Name: "opsgenie_receiver",
+		OpsGenieConfigs: []*OpsGenieConfig{{
+			APIKey:   "opsgenie_key",
+			Teams:    "oncall",
+			Priority: "P1",
+		}},
+	}
+	samplePushoverReceiver = Receiver{
+		Name: "pushover_receiver",
+		PushoverConfigs: []*PushoverConfig{{
+			UserKey: "pushover_user",
+			Token:   "pushover_token",
+			Retry:   "5m",
+		}},
+	}
 	sampleConfig = Config{
 		Route: &sampleRoute,
 		Receivers: []*Receiver{
 			&sampleSlackReceiver, &sampleReceiver, &sampleWebhookReceiver, &sampleEmailReceiver,
+			&samplePagerDutyReceiver, &sampleOpsGenieReceiver, &samplePushoverReceiver,
 		},
 	}
 )
@@ -126,6 +151,56 @@ func TestConfig_Validate(t *testing.T) {
 	}
 	err = invalidSlackAction.Validate()
 	assert.EqualError(t, err, `missing type in Slack action configuration`)
+
+	oncallConfig := Config{
+		Route: &config.Route{
+			Receiver: "pagerduty_receiver",
+			Routes: []*config.Route{
+				{Receiver: "opsgenie_receiver"},
+				{Receiver: "pushover_receiver"},
+			},
+		},
+		Receivers: []*Receiver{&samplePagerDutyReceiver, &sampleOpsGenieReceiver, &samplePushoverReceiver},
+		Global:    &defaultGlobalConf,
+	}
+	err = oncallConfig.Validate()
+	assert.NoError(t, err)
+
+	// Fail if PagerDuty config is missing both keys
+	invalidPagerDutyConfig := Config{
+		Route:     &config.Route{Receiver: "invalidPagerDuty"},
+		Receivers: []*Receiver{{Name: "invalidPagerDuty", PagerDutyConfigs: []*PagerDutyConfig{{Severity: "critical"}}}},
+		Global:    &defaultGlobalConf,
+	}
+	err = invalidPagerDutyConfig.Validate()
+	assert.EqualError(t, err, `missing service or routing key in PagerDuty config`)
+
+	// Fail if OpsGenie config has no API key and there is no global one
+	invalidOpsGenieConfig := Config{
+		Route:     &config.Route{Receiver: "invalidOpsGenie"},
+		Receivers: []*Receiver{{Name: "invalidOpsGenie", OpsGenieConfigs: []*OpsGenieConfig{{Teams: "oncall"}}}},
+		Global:    &defaultGlobalConf,
+	}
+	err = invalidOpsGenieConfig.Validate()
+	assert.EqualError(t, err, `no global OpsGenie API Key set`)
+
+	// Fail if Pushover config is missing a token
+	invalidPushoverConfig := Config{
+		Route:     &config.Route{Receiver: "invalidPushover"},
+		Receivers: []*Receiver{{Name: "invalidPushover", PushoverConfigs: []*PushoverConfig{{UserKey: "user"}}}},
+		Global:    &defaultGlobalConf,
+	}
+	err = invalidPushoverConfig.Validate()
+	assert.EqualError(t, err, `missing token in Pushover config`)
+
+	// Fail if Pushover retry is not a duration
+	invalidPushoverRetry := Config{
+		Route:     &config.Route{Receiver: "invalidPushover"},
+		Receivers: []*Receiver{{Name: "invalidPushover", PushoverConfigs: []*PushoverConfig{{UserKey: "user", Token: "token", Retry: "abcd"}}}},
+		Global:    &defaultGlobalConf,
+	}
+	err = invalidPushoverRetry.Validate()
+	assert.EqualError(t, err, `time: invalid duration "abcd"`)
 }
 
 func TestConfig_GetReceiver(t *testing.T) {
@@ -141,6 +216,15 @@ func TestConfig_GetReceiver(t *testing.T) {
 	rec = sampleConfig.GetReceiver("email_receiver")
 	assert.NotNil(t, rec)
 
+	rec = sampleConfig.GetReceiver("pagerduty_receiver")
+	assert.NotNil(t, rec)
+
+	rec = sampleConfig.GetReceiver("opsgenie_receiver")
+	assert.NotNil(t, rec)
+
+	rec = sampleConfig.GetReceiver("pushover_receiver")
+	assert.NotNil(t, rec)
+
 	rec = sampleConfig.GetReceiver("nonRoute")
 	assert.Nil(t, rec)
 }
@@ -162,6 +246,16 @@ func TestReceiver_Secure(t *testing.T) {
 	assert.Equal(t, "test_receiverName", rec.Name)
 }
 
+func TestReceiver_SecureOnCall(t *testing.T) {
+	rec := samplePagerDutyReceiver
+	rec.Secure(testNID)
+	assert.Equal(t, "test_pagerduty_receiver", rec.Name)
+	assert.Equal(t, samplePagerDutyReceiver.PagerDutyConfigs, rec.PagerDutyConfigs)
+
+	rec.Unsecure(testNID)
+	assert.Equal(t, samplePagerDutyReceiver, rec)
+}
+
 func TestReceiver_Unsecure(t *testing.T) {
 	rec := Receiver{Name: "receiverName"}
 	rec.Secure(testNID)
@@ -295,3 +389,24 @@ func TestMarshalYamlEmailConfig(t *testing.T) {
 	assert.True(t, strings.Contains(string(ymlData), "require_tls: false"))
 	assert.False(t, strings.Contains(string(ymlData), "require_tls: true"))
 }
+
+// TestMarshalYamlOnCallSecrets checks that PagerDuty, OpsGenie, and Pushover
+// keys are written to the config file as is
+func TestMarshalYamlOnCallSecrets(t *testing.T) {
+	ymlData, err := yaml.Marshal(Config{
+		Receivers: []*Receiver{&samplePagerDutyReceiver, &sampleOpsGenieReceiver, &samplePushoverReceiver},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, string(ymlData), "routing_key: 0123456789abcdef")
+	assert.Contains(t, string(ymlData), "api_key: opsgenie_key")
+	assert.Contains(t, string(ymlData), "user_key: pushover_user")
+	assert.Contains(t, string(ymlData), "token: pushover_token")
+	assert.NotContains(t, string(ymlData), "<secret>")
+
+	conf := Config{}
+	err = yaml.Unmarshal(ymlData, &conf)
+	assert.NoError(t, err)
+	assert.Equal(t, samplePagerDutyReceiver, *conf.Receivers[0])
+	assert.Equal(t, sampleOpsGenieReceiver, *conf.Receivers[1])
+	assert.Equal(t, samplePushoverReceiver, *conf.Receivers[2])
+}
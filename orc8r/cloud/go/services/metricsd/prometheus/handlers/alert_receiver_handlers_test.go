@@ -63,3 +63,27 @@ func TestBuildReceiverFromContext(t *testing.T) {
 	assert.Equal(t, testWebhookConfig, *receiver.WebhookConfigs[0])
 	assert.Equal(t, testSlackConfig, *receiver.SlackConfigs[0])
 }
+
+func TestBuildReceiverFromContext_OnCall(t *testing.T) {
+	body := `{
+      "name": "oncall",
+      "pagerduty_configs": [{"routing_key": "0123456789abcdef", "severity": "critical"}],
+      "opsgenie_configs": [{"api_key": "opsgenie_key", "teams": "oncall", "send_resolved": true}],
+      "pushover_configs": [{"user_key": "pushover_user", "token": "pushover_token", "retry": "5m"}]
+    }`
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+
+	c := echo.New().NewContext(req, rec)
+
+	receiver, err := buildReceiverFromContext(c)
+	assert.NoError(t, err)
+	assert.Equal(t, "oncall", receiver.Name)
+	assert.Equal(t, receivers.PagerDutyConfig{RoutingKey: "0123456789abcdef", Severity: "critical"}, *receiver.PagerDutyConfigs[0])
+	assert.Equal(t, receivers.OpsGenieConfig{
+		NotifierConfig: config.NotifierConfig{VSendResolved: true},
+		APIKey:         "opsgenie_key",
+		Teams:          "oncall",
+	}, *receiver.OpsGenieConfigs[0])
+	assert.Equal(t, receivers.PushoverConfig{UserKey: "pushover_user", Token: "pushover_token", Retry: "5m"}, *receiver.PushoverConfigs[0])
+}
@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package changelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	merrors "magma/orc8r/cloud/go/errors"
+	"magma/orc8r/cloud/go/storage"
+)
+
+const (
+	// maxBlobLogEntries is the number of most recent entries a blob log
+	// retains per network
+	maxBlobLogEntries = 10000
+
+	sequenceType = "state_change_sequence"
+	sequenceKey  = "sequence"
+	entryType    = "state_change"
+)
+
+// NewBlobLog returns a Log which stores its entries as blobs alongside the
+// states, for blob storages which aren't backed by SQL, e.g. the in-memory
+// storage used in tests.
+//
+// Each Record increments a per-network sequence blob, which serializes all
+// writes to a network's states, and the log retains the most recent 10000
+// entries of each network rather than pruning them by age.
+func NewBlobLog() Log {
+	return blobLog{}
+}
+
+type blobLog struct{}
+
+func (blobLog) Initialize() error {
+	return nil
+}
+
+func (l blobLog) Record(store blobstore.TransactionalBlobStorage, networkID string, changes []Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	// IncrementVersion locks the sequence until the transaction commits, so
+	// sequence numbers are assigned in commit order
+	err := store.IncrementVersion(networkID, sequenceID())
+	if err != nil {
+		return err
+	}
+	sequence, err := l.GetSequence(store, networkID)
+	if err != nil {
+		return err
+	}
+
+	marshaled, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	err = store.CreateOrUpdate(networkID, []blobstore.Blob{{Type: entryType, Key: entryKey(sequence), Value: marshaled}})
+	if err != nil {
+		return err
+	}
+	if sequence > maxBlobLogEntries {
+		return store.Delete(networkID, []storage.TypeAndKey{entryID(sequence - maxBlobLogEntries)})
+	}
+	return nil
+}
+
+func (blobLog) GetSequence(store blobstore.TransactionalBlobStorage, networkID string) (uint64, error) {
+	blob, err := store.Get(networkID, sequenceID())
+	if err == merrors.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return blob.Version, nil
+}
+
+func (l blobLog) GetChanges(store blobstore.TransactionalBlobStorage, networkID string, since uint64, maxEntries int) ([]SequencedChange, uint64, error) {
+	until, err := l.GetSequence(store, networkID)
+	if err != nil {
+		return nil, 0, err
+	}
+	if since >= until {
+		return nil, since, nil
+	}
+	if until-since > maxBlobLogEntries {
+		return nil, 0, ErrSequenceExpired
+	}
+	if until-since > uint64(maxEntries) {
+		until = since + uint64(maxEntries)
+	}
+	ids := make([]storage.TypeAndKey, 0, until-since)
+	for seq := since + 1; seq <= until; seq++ {
+		ids = append(ids, entryID(seq))
+	}
+	blobs, err := store.GetMany(networkID, ids)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(blobs) != len(ids) {
+		return nil, 0, ErrSequenceExpired
+	}
+
+	entriesByKey := make(map[string][]Change, len(blobs))
+	for _, blob := range blobs {
+		var changes []Change
+		if err := json.Unmarshal(blob.Value, &changes); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal change log entry %s: %v", blob.Key, err)
+		}
+		entriesByKey[blob.Key] = changes
+	}
+	var ret []SequencedChange
+	for seq := since + 1; seq <= until; seq++ {
+		for _, change := range entriesByKey[entryKey(seq)] {
+			ret = append(ret, SequencedChange{Change: change, Sequence: seq})
+		}
+	}
+	return ret, until, nil
+}
+
+// Prune is a no-op, since Record already bounds the number of entries
+func (blobLog) Prune(blobstore.TransactionalBlobStorage, string, time.Time) error {
+	return nil
+}
+
+func sequenceID() storage.TypeAndKey {
+	return storage.TypeAndKey{Type: sequenceType, Key: sequenceKey}
+}
+
+func entryID(sequence uint64) storage.TypeAndKey {
+	return storage.TypeAndKey{Type: entryType, Key: entryKey(sequence)}
+}
+
+// entryKey zero-pads the sequence number so keys sort in sequence order
+func entryKey(sequence uint64) string {
+	return fmt.Sprintf("%020d", sequence)
+}
@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package changelog records every change to the reported states of a network
+// in a change log, ordered by a monotonic change sequence. Log entries are
+// written in the same transaction as the states themselves, so watchers can
+// resume from any retained sequence number without missing changes.
+package changelog
+
+import (
+	"errors"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/storage"
+)
+
+const (
+	// Retention is how long change log entries are retained before Prune
+	// deletes them. The most recent entry of each network is always retained.
+	Retention = 24 * time.Hour
+)
+
+// ErrSequenceExpired is returned by GetChanges when the changes following the
+// requested sequence number have already been pruned from the log.
+var ErrSequenceExpired = errors.New("changes since the requested sequence have been pruned")
+
+// Log is a change log of the states of every network. Each entry records the
+// changes of a single write and is identified by its sequence number.
+// Sequence numbers increase with each entry of a network, but need not be
+// contiguous.
+type Log interface {
+	// Initialize creates the log's storage if it doesn't exist yet
+	Initialize() error
+
+	// Record appends an entry with the changes to the network's log. Record
+	// must be called in the transaction which writes the changes.
+	Record(store blobstore.TransactionalBlobStorage, networkID string, changes []Change) error
+
+	// GetSequence returns the sequence number of the network's most recent
+	// entry which GetChanges returns, or 0 if there is none.
+	GetSequence(store blobstore.TransactionalBlobStorage, networkID string) (uint64, error)
+
+	// GetChanges returns the changes of at most maxEntries of the network's
+	// entries recorded after sequence number `since`, ordered by sequence
+	// number, along with the sequence number of the last entry read. If no
+	// entries were read, that sequence number is since. If changes following
+	// since have been pruned, ErrSequenceExpired is returned.
+	GetChanges(store blobstore.TransactionalBlobStorage, networkID string, since uint64, maxEntries int) ([]SequencedChange, uint64, error)
+
+	// Prune deletes the network's entries recorded before `before`, except
+	// its most recent entry.
+	Prune(store blobstore.TransactionalBlobStorage, networkID string, before time.Time) error
+}
+
+// Change is a single state write or delete recorded in the change log
+type Change struct {
+	Type string `json:"t"`
+	Key  string `json:"k"`
+	// Created is true if the state did not exist before this change
+	Created bool `json:"c,omitempty"`
+	Deleted bool `json:"d,omitempty"`
+}
+
+// ID returns the TypeAndKey of the changed state
+func (c Change) ID() storage.TypeAndKey {
+	return storage.TypeAndKey{Type: c.Type, Key: c.Key}
+}
+
+// SequencedChange is a Change along with the sequence number of the log entry
+// which recorded it
+type SequencedChange struct {
+	Change
+	Sequence uint64
+}
+
+// GetWriteChanges returns the changes recorded by writing blobs to store,
+// marking the blobs which don't exist yet as created. It must be called
+// before the blobs are written.
+func GetWriteChanges(store blobstore.TransactionalBlobStorage, networkID string, blobs []blobstore.Blob) ([]Change, error) {
+	ids := make([]storage.TypeAndKey, 0, len(blobs))
+	for _, blob := range blobs {
+		ids = append(ids, storage.TypeAndKey{Type: blob.Type, Key: blob.Key})
+	}
+	existing, err := store.GetMany(networkID, ids)
+	if err != nil {
+		return nil, err
+	}
+	existingByID := blobstore.GetBlobsByTypeAndKey(existing)
+	ret := make([]Change, 0, len(ids))
+	for _, id := range ids {
+		_, exists := existingByID[id]
+		ret = append(ret, Change{Type: id.Type, Key: id.Key, Created: !exists})
+	}
+	return ret, nil
+}
+
+// GetDeleteChanges returns the changes recorded by deleting the blobs with
+// the given IDs
+func GetDeleteChanges(deleted []storage.TypeAndKey) []Change {
+	ret := make([]Change, 0, len(deleted))
+	for _, id := range deleted {
+		ret = append(ret, Change{Type: id.Type, Key: id.Key, Deleted: true})
+	}
+	return ret
+}
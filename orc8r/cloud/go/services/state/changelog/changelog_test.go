@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package changelog_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/services/state/changelog"
+	"magma/orc8r/cloud/go/sqorc"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlobLog(t *testing.T) {
+	testLog(t, blobstore.NewMemoryBlobStorageFactory(), changelog.NewBlobLog())
+}
+
+func TestSQLLog(t *testing.T) {
+	factory, changeLog := newSQLLog(t)
+	testLog(t, factory, changeLog)
+}
+
+func testLog(t *testing.T, factory blobstore.BlobStorageFactory, changeLog changelog.Log) {
+	now := time.Unix(1000000, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.GetUnfreezeClockDeferFunc(t)()
+
+	// Nothing recorded yet
+	store, err := factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	sequence, err := changeLog.GetSequence(store, "n1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), sequence)
+	assert.NoError(t, store.Commit())
+
+	// Writing a new and an existing blob
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, store.CreateOrUpdate("n1", []blobstore.Blob{{Type: "t", Key: "existing", Value: []byte("v")}}))
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	blobs := []blobstore.Blob{
+		{Type: "t", Key: "existing", Value: []byte("v2")},
+		{Type: "t", Key: "new", Value: []byte("v")},
+	}
+	changes, err := changelog.GetWriteChanges(store, "n1", blobs)
+	assert.NoError(t, err)
+	assert.Equal(t, []changelog.Change{{Type: "t", Key: "existing"}, {Type: "t", Key: "new", Created: true}}, changes)
+	assert.NoError(t, store.CreateOrUpdate("n1", blobs))
+	assert.NoError(t, changeLog.Record(store, "n1", changes))
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	deleted := changelog.GetDeleteChanges([]storage.TypeAndKey{{Type: "t", Key: "new"}})
+	assert.NoError(t, changeLog.Record(store, "n1", deleted))
+	// Empty changes don't advance the sequence
+	assert.NoError(t, changeLog.Record(store, "n1", nil))
+	assert.NoError(t, store.Commit())
+
+	clock.SetAndFreezeClock(t, now.Add(changelog.CommitGracePeriod))
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	sequence, err = changeLog.GetSequence(store, "n1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), sequence)
+	sequenced, until, err := changeLog.GetChanges(store, "n1", 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), until)
+	assert.Equal(t, []changelog.SequencedChange{
+		{Change: changelog.Change{Type: "t", Key: "existing"}, Sequence: 1},
+		{Change: changelog.Change{Type: "t", Key: "new", Created: true}, Sequence: 1},
+		{Change: changelog.Change{Type: "t", Key: "new", Deleted: true}, Sequence: 2},
+	}, sequenced)
+	sequenced, until, err = changeLog.GetChanges(store, "n1", 1, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), until)
+	assert.Equal(t, []changelog.SequencedChange{
+		{Change: changelog.Change{Type: "t", Key: "new", Deleted: true}, Sequence: 2},
+	}, sequenced)
+
+	// Reads are capped at maxEntries entries
+	sequenced, until, err = changeLog.GetChanges(store, "n1", 0, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), until)
+	assert.Len(t, sequenced, 2)
+
+	// Caught up
+	sequenced, until, err = changeLog.GetChanges(store, "n1", 2, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), until)
+	assert.Empty(t, sequenced)
+
+	// Other networks have their own entries
+	sequence, err = changeLog.GetSequence(store, "n2")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), sequence)
+	assert.NoError(t, store.Commit())
+}
+
+func TestSQLLog_CommitGracePeriod(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.GetUnfreezeClockDeferFunc(t)()
+
+	factory, changeLog := newSQLLog(t)
+	store, err := factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, changeLog.Record(store, "n1", []changelog.Change{{Type: "t", Key: "k"}}))
+	assert.NoError(t, store.Commit())
+
+	// The entry isn't visible until the grace period has passed
+	clock.SetAndFreezeClock(t, now.Add(changelog.CommitGracePeriod-time.Second))
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	sequence, err := changeLog.GetSequence(store, "n1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), sequence)
+	changes, until, err := changeLog.GetChanges(store, "n1", 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(0), until)
+	assert.Empty(t, changes)
+	assert.NoError(t, store.Commit())
+
+	clock.SetAndFreezeClock(t, now.Add(changelog.CommitGracePeriod))
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	sequence, err = changeLog.GetSequence(store, "n1")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1), sequence)
+	assert.NoError(t, store.Commit())
+}
+
+func TestSQLLog_Prune(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	defer clock.GetUnfreezeClockDeferFunc(t)()
+
+	factory, changeLog := newSQLLog(t)
+	for i, networkID := range []string{"n1", "n1", "n1", "n2"} {
+		clock.SetAndFreezeClock(t, now.Add(time.Duration(i)*time.Hour))
+		store, err := factory.StartTransaction(nil)
+		assert.NoError(t, err)
+		assert.NoError(t, changeLog.Record(store, networkID, []changelog.Change{{Type: "t", Key: "k"}}))
+		assert.NoError(t, store.Commit())
+	}
+
+	// Pruning everything retains the most recent entry of the network
+	clock.SetAndFreezeClock(t, now.Add(48*time.Hour))
+	store, err := factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, changeLog.Prune(store, "n1", now.Add(24*time.Hour)))
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	defer store.Rollback()
+	_, _, err = changeLog.GetChanges(store, "n1", 1, 10)
+	assert.Equal(t, changelog.ErrSequenceExpired, err)
+	changes, until, err := changeLog.GetChanges(store, "n1", 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), until)
+	assert.Equal(t, []changelog.SequencedChange{{Change: changelog.Change{Type: "t", Key: "k"}, Sequence: 3}}, changes)
+	changes, until, err = changeLog.GetChanges(store, "n1", 3, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(3), until)
+	assert.Empty(t, changes)
+
+	// Other networks aren't pruned
+	changes, _, err = changeLog.GetChanges(store, "n2", 0, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []changelog.SequencedChange{{Change: changelog.Change{Type: "t", Key: "k"}, Sequence: 4}}, changes)
+}
+
+func TestBlobLog_Retention(t *testing.T) {
+	// The blob log retains the 10000 most recent entries of each network
+	const retained = 10000
+
+	factory := blobstore.NewMemoryBlobStorageFactory()
+	changeLog := changelog.NewBlobLog()
+	store, err := factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	for i := 0; i < retained+1; i++ {
+		assert.NoError(t, changeLog.Record(store, "n1", []changelog.Change{{Type: "t", Key: "k"}}))
+	}
+	assert.NoError(t, store.Commit())
+
+	store, err = factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	defer store.Rollback()
+	_, _, err = changeLog.GetChanges(store, "n1", 0, retained+1)
+	assert.Equal(t, changelog.ErrSequenceExpired, err)
+	changes, _, err := changeLog.GetChanges(store, "n1", 1, retained+1)
+	assert.NoError(t, err)
+	assert.Len(t, changes, retained)
+	assert.Equal(t, uint64(2), changes[0].Sequence)
+}
+
+func newSQLLog(t *testing.T) (blobstore.BlobStorageFactory, changelog.Log) {
+	db, err := sqorc.Open("sqlite3", ":memory:")
+	assert.NoError(t, err)
+	factory := blobstore.NewEntStorage("states", db, sqorc.GetSqlBuilder())
+	assert.NoError(t, factory.InitializeFactory())
+	changeLog := changelog.NewSQLLog("state_changes", db, sqorc.GetSqlBuilder())
+	assert.NoError(t, changeLog.Initialize())
+	return factory, changeLog
+}
@@ -0,0 +1,236 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package changelog
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/datastore"
+	"magma/orc8r/cloud/go/sqorc"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	// CommitGracePeriod is how long after an entry is recorded it becomes
+	// visible to GetSequence and GetChanges.
+	//
+	// The database assigns sequence numbers when entries are inserted rather
+	// than when their transactions commit, so an entry may commit after an
+	// entry with a higher sequence number. Readers only see entries older
+	// than the grace period, by which time every entry with a lower sequence
+	// number has committed or rolled back, so they never skip an entry as
+	// long as transactions recording changes commit, and the clocks of the
+	// state service replicas agree, within the grace period.
+	CommitGracePeriod = 5 * time.Second
+
+	seqCol        = "seq"
+	nidCol        = "network_id"
+	changesCol    = "changes"
+	recordedAtCol = "recorded_at"
+)
+
+// NewSQLLog returns a Log which stores its entries in the table tableName,
+// within the SQL transactions of the blob storages passed to it. Sequence
+// numbers come from an auto-incrementing column shared by all networks, so
+// concurrent writes don't contend on a sequence.
+func NewSQLLog(tableName string, db *sql.DB, builder sqorc.StatementBuilder) Log {
+	return &sqlLog{tableName: tableName, db: db, builder: builder}
+}
+
+type sqlLog struct {
+	tableName string
+	db        *sql.DB
+	builder   sqorc.StatementBuilder
+}
+
+func (l *sqlLog) Initialize() error {
+	_, err := sqorc.ExecInTx(l.db, l.initTable, func(*sql.Tx) (interface{}, error) { return nil, nil })
+	return err
+}
+
+func (l *sqlLog) initTable(tx *sql.Tx) error {
+	seqType := sqorc.ColumnTypeSerial
+	// special case sqlite3, which only auto-increments INTEGER PRIMARY KEY
+	// columns, because we only run sqlite3 for unit tests
+	if datastore.SQL_DRIVER == "sqlite3" {
+		seqType = sqorc.ColumnTypeInt
+	}
+	_, err := l.builder.CreateTable(l.tableName).
+		IfNotExists().
+		Column(seqCol).Type(seqType).PrimaryKey().EndColumn().
+		Column(nidCol).Type(sqorc.ColumnTypeText).NotNull().EndColumn().
+		Column(changesCol).Type(sqorc.ColumnTypeBytes).NotNull().EndColumn().
+		Column(recordedAtCol).Type(sqorc.ColumnTypeBigInt).NotNull().EndColumn().
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+	_, err = l.builder.CreateIndex(l.tableName+"_network_seq_idx").
+		IfNotExists().
+		On(l.tableName).
+		Columns(nidCol, seqCol).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+func (l *sqlLog) Record(store blobstore.TransactionalBlobStorage, networkID string, changes []Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	tx, err := getSQLTx(store)
+	if err != nil {
+		return err
+	}
+	marshaled, err := json.Marshal(changes)
+	if err != nil {
+		return err
+	}
+	_, err = l.builder.Insert(l.tableName).
+		Columns(nidCol, changesCol, recordedAtCol).
+		Values(networkID, marshaled, toMillis(clock.Now())).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return fmt.Errorf("failed to record state changes: %v", err)
+	}
+	return nil
+}
+
+func (l *sqlLog) GetSequence(store blobstore.TransactionalBlobStorage, networkID string) (uint64, error) {
+	tx, err := getSQLTx(store)
+	if err != nil {
+		return 0, err
+	}
+	return l.getMaxSequence(tx, sq.Eq{nidCol: networkID}, sq.LtOrEq{recordedAtCol: getVisibleBefore()})
+}
+
+func (l *sqlLog) GetChanges(store blobstore.TransactionalBlobStorage, networkID string, since uint64, maxEntries int) ([]SequencedChange, uint64, error) {
+	tx, err := getSQLTx(store)
+	if err != nil {
+		return nil, 0, err
+	}
+	// Prune retains the network's most recent entry, so the entry a caught up
+	// reader has seen last is pruned only if later entries may have been too
+	if since > 0 {
+		var seq uint64
+		err = l.builder.Select(seqCol).
+			From(l.tableName).
+			Where(sq.Eq{nidCol: networkID, seqCol: since}).
+			RunWith(tx).
+			QueryRow().Scan(&seq)
+		if err == sql.ErrNoRows {
+			return nil, 0, ErrSequenceExpired
+		}
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to load state change log entry %d: %v", since, err)
+		}
+	}
+
+	rows, err := l.builder.Select(seqCol, changesCol).
+		From(l.tableName).
+		Where(sq.And{
+			sq.Eq{nidCol: networkID},
+			sq.Gt{seqCol: since},
+			sq.LtOrEq{recordedAtCol: getVisibleBefore()},
+		}).
+		OrderBy(seqCol).
+		Limit(uint64(maxEntries)).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to load state changes: %v", err)
+	}
+	defer sqorc.CloseRowsLogOnError(rows, "GetChanges")
+
+	var ret []SequencedChange
+	until := since
+	for rows.Next() {
+		var marshaled []byte
+		err = rows.Scan(&until, &marshaled)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan state change log entry: %v", err)
+		}
+		var changes []Change
+		if err := json.Unmarshal(marshaled, &changes); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal state change log entry %d: %v", until, err)
+		}
+		for _, change := range changes {
+			ret = append(ret, SequencedChange{Change: change, Sequence: until})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to load state changes: %v", err)
+	}
+	return ret, until, nil
+}
+
+func (l *sqlLog) Prune(store blobstore.TransactionalBlobStorage, networkID string, before time.Time) error {
+	tx, err := getSQLTx(store)
+	if err != nil {
+		return err
+	}
+	latest, err := l.getMaxSequence(tx, sq.Eq{nidCol: networkID})
+	if err != nil {
+		return err
+	}
+	_, err = l.builder.Delete(l.tableName).
+		Where(sq.And{
+			sq.Eq{nidCol: networkID},
+			sq.Lt{seqCol: latest},
+			sq.Lt{recordedAtCol: toMillis(before)},
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return fmt.Errorf("failed to prune state changes of network %s: %v", networkID, err)
+	}
+	return nil
+}
+
+// getMaxSequence returns the highest sequence number of the entries matching
+// the predicates, or 0 if no entry matches
+func (l *sqlLog) getMaxSequence(tx *sql.Tx, predicates ...sq.Sqlizer) (uint64, error) {
+	var seq sql.NullInt64
+	err := l.builder.Select(fmt.Sprintf("MAX(%s)", seqCol)).
+		From(l.tableName).
+		Where(sq.And(predicates)).
+		RunWith(tx).
+		QueryRow().Scan(&seq)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load state change sequence: %v", err)
+	}
+	return uint64(seq.Int64), nil
+}
+
+func getSQLTx(store blobstore.TransactionalBlobStorage) (*sql.Tx, error) {
+	tx, ok := blobstore.GetSQLTx(store)
+	if !ok {
+		return nil, errors.New("state change log requires a SQL blob storage")
+	}
+	return tx, nil
+}
+
+// getVisibleBefore returns the time in milliseconds at or before which
+// entries must have been recorded to be visible to readers
+func getVisibleBefore() int64 {
+	return toMillis(clock.Now().Add(-CommitGracePeriod))
+}
+
+func toMillis(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
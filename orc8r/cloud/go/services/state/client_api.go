@@ -12,6 +12,7 @@ import (
 	"context"
 	"encoding/json"
 	"sync"
+	"time"
 
 	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/orc8r"
@@ -52,6 +53,34 @@ type StateID struct {
 	DeviceID string
 }
 
+// EventType is the kind of change a StateEvent describes
+type EventType int
+
+const (
+	CreateEvent EventType = iota
+	UpdateEvent
+	DeleteEvent
+)
+
+// StateEvent is a change to a watched state
+type StateEvent struct {
+	Type EventType
+	ID   StateID
+	// State is the state after the change. It is nil for DeleteEvents.
+	State *State
+}
+
+// WatchFilter restricts which states are watched. Empty fields match all.
+type WatchFilter struct {
+	Types     []string
+	DeviceIDs []string
+}
+
+// WatchHandler is called with each batch of events received by WatchStates,
+// along with a cursor which resumes the watch after the batch. Returning an
+// error stops the watch.
+type WatchHandler func(events []StateEvent, cursor string) error
+
 // Global clientconn that can be reused for this service
 var connSingleton = (*grpc.ClientConn)(nil)
 var connGuard = sync.Mutex{}
@@ -123,16 +152,33 @@ func GetStates(networkID string, stateIDs []StateID) (map[StateID]State, error)
 	if err != nil {
 		return nil, err
 	}
-	idToValue := map[StateID]State{}
-	for _, pState := range res.States {
-		stateID := StateID{Type: pState.Type, DeviceID: pState.DeviceID}
-		state, err := toState(pState)
-		if err != nil {
-			return nil, err
-		}
-		idToValue[stateID] = state
+	return toStatesByID(res.States)
+}
+
+// GetStatesAt returns a map of states specified by the networkID and a list
+// of type and key, as they were at time `at`. The state service must be
+// configured to retain history.
+func GetStatesAt(networkID string, stateIDs []StateID, at time.Time) (map[StateID]State, error) {
+	if len(stateIDs) == 0 {
+		return map[StateID]State{}, nil
 	}
-	return idToValue, nil
+
+	client, err := GetStateClient()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := client.GetStatesAt(
+		context.Background(), &protos.GetStatesAtRequest{
+			NetworkID: networkID,
+			Ids:       toProtosStateIDs(stateIDs),
+			TimeMs:    at.UnixNano() / int64(time.Millisecond),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return toStatesByID(res.States)
 }
 
 // DeleteStates deletes states specified by the networkID and a list of type and key
@@ -151,6 +197,45 @@ func DeleteStates(networkID string, stateIDs []StateID) error {
 	return err
 }
 
+// WatchStates watches the states of a network which match the filter and
+// calls handler with every batch of changes until ctx is cancelled, the
+// stream fails, or handler returns an error. If cursor is non-empty, the
+// watch resumes from the point at which that cursor was issued; otherwise it
+// starts from the current state and the first batch is empty. If the changes
+// since the cursor are no longer retained, the returned error has the gRPC
+// code OutOfRange and the watch must be restarted without a cursor.
+func WatchStates(ctx context.Context, networkID string, filter WatchFilter, cursor string, handler WatchHandler) error {
+	client, err := GetStateClient()
+	if err != nil {
+		return err
+	}
+	stream, err := client.WatchStates(ctx, &protos.WatchStatesRequest{
+		NetworkID: networkID,
+		Types:     filter.Types,
+		DeviceIDs: filter.DeviceIDs,
+		Cursor:    cursor,
+	})
+	if err != nil {
+		return err
+	}
+	for {
+		res, err := stream.Recv()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		events, err := toStateEvents(res.Events)
+		if err != nil {
+			return err
+		}
+		if err := handler(events, res.Cursor); err != nil {
+			return err
+		}
+	}
+}
+
 func GetGatewayStatus(networkID string, deviceID string) (*models.GatewayStatus, error) {
 	state, err := GetState(networkID, orc8r.GatewayStateType, deviceID)
 	if err != nil {
@@ -162,6 +247,20 @@ func GetGatewayStatus(networkID string, deviceID string) (*models.GatewayStatus,
 	return fillInGatewayStatusState(state), nil
 }
 
+// GetGatewayStatusAt returns the status of the gateway as it was at time `at`
+func GetGatewayStatusAt(networkID string, deviceID string, at time.Time) (*models.GatewayStatus, error) {
+	stateID := StateID{Type: orc8r.GatewayStateType, DeviceID: deviceID}
+	res, err := GetStatesAt(networkID, []StateID{stateID}, at)
+	if err != nil {
+		return nil, err
+	}
+	state, ok := res[stateID]
+	if !ok || state.ReportedState == nil {
+		return nil, errors.ErrNotFound
+	}
+	return fillInGatewayStatusState(state), nil
+}
+
 func GetGatewayStatuses(networkID string, deviceIDs []string) (map[string]*models.GatewayStatus, error) {
 	stateIDs := funk.Map(deviceIDs, func(id string) StateID { return StateID{Type: orc8r.GatewayStateType, DeviceID: id} }).([]StateID)
 	res, err := GetStates(networkID, stateIDs)
@@ -196,6 +295,43 @@ func toProtosStateIDs(stateIDs []StateID) []*protos.StateID {
 	return ids
 }
 
+func toStatesByID(pStates []*protos.State) (map[StateID]State, error) {
+	idToValue := map[StateID]State{}
+	for _, pState := range pStates {
+		stateID := StateID{Type: pState.Type, DeviceID: pState.DeviceID}
+		state, err := toState(pState)
+		if err != nil {
+			return nil, err
+		}
+		idToValue[stateID] = state
+	}
+	return idToValue, nil
+}
+
+func toStateEvents(pEvents []*protos.StateEvent) ([]StateEvent, error) {
+	ret := make([]StateEvent, 0, len(pEvents))
+	for _, pEvent := range pEvents {
+		event := StateEvent{ID: StateID{Type: pEvent.State.GetType(), DeviceID: pEvent.State.GetDeviceID()}}
+		switch pEvent.Type {
+		case protos.StateEvent_CREATE:
+			event.Type = CreateEvent
+		case protos.StateEvent_UPDATE:
+			event.Type = UpdateEvent
+		case protos.StateEvent_DELETE:
+			event.Type = DeleteEvent
+		}
+		if event.Type != DeleteEvent {
+			state, err := toState(pEvent.State)
+			if err != nil {
+				return nil, err
+			}
+			event.State = &state
+		}
+		ret = append(ret, event)
+	}
+	return ret, nil
+}
+
 func toState(pState *protos.State) (State, error) {
 	serialized := &SerializedStateWithMeta{}
 	err := json.Unmarshal(pState.Value, serialized)
@@ -13,7 +13,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"testing"
+	"time"
 
+	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/orc8r"
 	models2 "magma/orc8r/cloud/go/pluginimpl/models"
@@ -59,6 +61,7 @@ func TestStateService(t *testing.T) {
 	stateTestInit.StartTestService(t)
 	err := serde.RegisterSerdes(
 		state.NewStateSerde("test-serde", &Name{}),
+		state.NewExpiringStateSerde("test-expiring-serde", &Name{}, time.Minute),
 		serde.NewBinarySerde(device.SerdeDomain, orc8r.AccessGatewayRecordType, &models2.GatewayDevice{}))
 	assert.NoError(t, err)
 
@@ -140,6 +143,109 @@ func TestStateService(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 2, len(states))
 	testGetStatesResponse(t, states, bundle0, bundle1)
+
+	// Read states as they were at a prior point in time
+	beforeUpdate := time.Now()
+	time.Sleep(2 * time.Millisecond)
+	_, err = reportStates(ctx, makeStateBundle("test-serde", "key0", Name{Name: "updated"}))
+	assert.NoError(t, err)
+	states, err = state.GetStatesAt(networkID, []state.StateID{bundle0.ID, bundle2.ID}, beforeUpdate)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(states))
+	testGetStatesResponse(t, states, bundle0)
+	states, err = state.GetStatesAt(networkID, []state.StateID{bundle0.ID}, time.Now())
+	assert.NoError(t, err)
+	assert.Equal(t, &Name{Name: "updated"}, states[bundle0.ID].ReportedState)
+	_, err = reportStates(ctx, bundle0)
+	assert.NoError(t, err)
+
+	// Expiring states are no longer returned once they outlive their TTL
+	reportTime := time.Now()
+	clock.SetAndFreezeClock(t, reportTime)
+	defer clock.GetUnfreezeClockDeferFunc(t)()
+	expiringBundle := makeStateBundle("test-expiring-serde", "key4", value0)
+	_, err = reportStates(ctx, expiringBundle)
+	assert.NoError(t, err)
+
+	clock.SetAndFreezeClock(t, reportTime.Add(59*time.Second))
+	states, err = state.GetStates(networkID, []state.StateID{expiringBundle.ID})
+	assert.NoError(t, err)
+	testGetStatesResponse(t, states, expiringBundle)
+
+	clock.SetAndFreezeClock(t, reportTime.Add(time.Minute))
+	states, err = state.GetStates(networkID, []state.StateID{expiringBundle.ID})
+	assert.NoError(t, err)
+	assert.Empty(t, states)
+	clock.UnfreezeClock(t)
+
+	// Watch for changes, starting from the current state
+	filter := state.WatchFilter{Types: []string{"test-serde"}}
+	batches, cancel := watchStates(t, networkID, filter, "")
+	events, cursor := receiveBatch(t, batches)
+	assert.Empty(t, events)
+
+	bundle0.state.Version = 0
+	_, err = reportStates(ctx, bundle0)
+	assert.NoError(t, err)
+	events, _ = receiveBatch(t, batches)
+	assert.Len(t, events, 1)
+	assert.Equal(t, state.UpdateEvent, events[0].Type)
+	assert.Equal(t, bundle0.ID, events[0].ID)
+	assert.Equal(t, uint64(2), events[0].State.Version)
+
+	err = state.DeleteStates(networkID, []state.StateID{bundle1.ID})
+	assert.NoError(t, err)
+	events, _ = receiveBatch(t, batches)
+	assert.Len(t, events, 1)
+	assert.Equal(t, state.DeleteEvent, events[0].Type)
+	assert.Equal(t, bundle1.ID, events[0].ID)
+	assert.Nil(t, events[0].State)
+	cancel()
+
+	// Resuming from the first cursor replays every change since then
+	batches, cancel = watchStates(t, networkID, filter, cursor)
+	defer cancel()
+	events, _ = receiveBatch(t, batches)
+	assert.Len(t, events, 2)
+	assert.Equal(t, state.UpdateEvent, events[0].Type)
+	assert.Equal(t, bundle0.ID, events[0].ID)
+	assert.Equal(t, state.DeleteEvent, events[1].Type)
+	assert.Equal(t, bundle1.ID, events[1].ID)
+
+	_, err = reportStates(ctx, bundle1)
+	assert.NoError(t, err)
+	events, _ = receiveBatch(t, batches)
+	assert.Len(t, events, 1)
+	assert.Equal(t, state.CreateEvent, events[0].Type)
+	assert.Equal(t, bundle1.ID, events[0].ID)
+}
+
+type watchBatch struct {
+	events []state.StateEvent
+	cursor string
+}
+
+func watchStates(t *testing.T, networkID string, filter state.WatchFilter, cursor string) (chan watchBatch, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	batches := make(chan watchBatch, 10)
+	go func() {
+		err := state.WatchStates(ctx, networkID, filter, cursor, func(events []state.StateEvent, cursor string) error {
+			batches <- watchBatch{events: events, cursor: cursor}
+			return nil
+		})
+		assert.Equal(t, context.Canceled, err)
+	}()
+	return batches, cancel
+}
+
+func receiveBatch(t *testing.T, batches chan watchBatch) ([]state.StateEvent, string) {
+	select {
+	case batch := <-batches:
+		return batch.events, batch.cursor
+	case <-time.After(5 * time.Second):
+		assert.Fail(t, "timed out waiting for watch events")
+		return nil, ""
+	}
 }
 
 type NameAndAge struct {
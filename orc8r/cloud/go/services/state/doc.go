@@ -14,4 +14,6 @@ const (
 	ServiceName = "STATE"
 	SerdeDomain = "state"
 	DBTableName = "states"
+	// ChangeLogTableName is the table of the change log of reported states
+	ChangeLogTableName = "state_changes"
 )
@@ -13,11 +13,16 @@ import (
 
 	"magma/orc8r/cloud/go/errors"
 	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/certifier"
 	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/state"
 
 	"github.com/go-openapi/swag"
 	"github.com/golang/glog"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func PeriodicallyReportGatewayStatus(dur time.Duration) {
@@ -55,6 +60,7 @@ func reportGatewayStatus() error {
 				if err != errors.ErrNotFound {
 					glog.Errorf("Error getting gateway state for network:%v, gateway:%v, %v", networkID, gatewayID, err)
 				}
+				reportGatewayCertExpiration(networkID, gatewayID, gatewayEntity.PhysicalID, 0)
 				continue
 			}
 			// last check in more than 5 minutes ago
@@ -74,9 +80,31 @@ func reportGatewayStatus() error {
 			} else {
 				glog.Errorf("Status for networkID %s, gatewayID %s is missing the MconfigCreatedAt field", networkID, gatewayID)
 			}
+
+			reportGatewayCertExpiration(networkID, gatewayID, gatewayEntity.PhysicalID, status.CertExpirationTime)
 		}
 		upGwCount.WithLabelValues(networkID).Set(float64(numUpGateways))
 		totalGwCount.WithLabelValues(networkID).Set(float64(len(gateways)))
 	}
 	return nil
 }
+
+// reportGatewayCertExpiration reports the expiration time of the gateway's
+// certificate, to alert on certificates which are not rotated in time. The
+// latest active certificate issued to the gateway is looked up in certifier,
+// falling back to the expiration time reported by the gateway.
+func reportGatewayCertExpiration(networkID string, gatewayID string, hardwareID string, reportedExpirationTime int64) {
+	expirationTime := reportedExpirationTime
+	expiration, err := certifier.GetCertificateExpiration(protos.NewGatewayIdentity(hardwareID, "", ""))
+	if err == nil {
+		notAfter, err := ptypes.Timestamp(expiration.NotAfter)
+		if err == nil {
+			expirationTime = notAfter.Unix()
+		}
+	} else if status.Code(err) != codes.NotFound {
+		glog.Errorf("Error getting certificate expiration for network:%v, gateway:%v, %v", networkID, gatewayID, err)
+	}
+	if expirationTime != 0 {
+		gwCertExpirationTime.WithLabelValues(networkID, gatewayID).Set(float64(expirationTime))
+	}
+}
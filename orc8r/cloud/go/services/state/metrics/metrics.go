@@ -41,6 +41,13 @@ var (
 		},
 		[]string{metrics.NetworkLabelName, metrics.GatewayLabelName},
 	)
+	gwCertExpirationTime = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gateway_cert_expiration_time",
+			Help: "Expiration time of the gateway certificate in seconds since epoch",
+		},
+		[]string{metrics.NetworkLabelName, metrics.GatewayLabelName},
+	)
 )
 
 func init() {
@@ -49,5 +56,6 @@ func init() {
 		upGwCount,
 		totalGwCount,
 		gwMconfigAge,
+		gwCertExpirationTime,
 	)
 }
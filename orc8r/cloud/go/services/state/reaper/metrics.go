@@ -0,0 +1,31 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package reaper
+
+import (
+	"magma/orc8r/cloud/go/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const stateTypeLabelName = "stateType"
+
+var (
+	expiredStatesCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "state_expired_total",
+			Help: "Number of reported states deleted after outliving their TTL",
+		},
+		[]string{metrics.NetworkLabelName, stateTypeLabelName},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(expiredStatesCount)
+}
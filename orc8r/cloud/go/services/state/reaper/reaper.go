@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package reaper periodically deletes reported states which have outlived the
+// TTL declared by their serde, and prunes the state change log.
+package reaper
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/changelog"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+)
+
+// Reaper deletes expired states from the state service's blobstore
+type Reaper struct {
+	factory       blobstore.BlobStorageFactory
+	changeLog     changelog.Log
+	getNetworkIDs func() ([]string, error)
+}
+
+// NewReaper returns a Reaper which deletes expired states from the storage
+// backed by factory and prunes changeLog, for every network returned by
+// getNetworkIDs.
+func NewReaper(factory blobstore.BlobStorageFactory, changeLog changelog.Log, getNetworkIDs func() ([]string, error)) *Reaper {
+	return &Reaper{factory: factory, changeLog: changeLog, getNetworkIDs: getNetworkIDs}
+}
+
+// Run reaps expired states and prunes the change log every interval. This
+// function never returns.
+func (r *Reaper) Run(interval time.Duration) {
+	for range time.Tick(interval) {
+		err := r.ReapExpiredStates()
+		if err != nil {
+			glog.Errorf("Error reaping expired states: %v", err)
+		}
+		err = r.PruneChangeLog()
+		if err != nil {
+			glog.Errorf("Error pruning state change log: %v", err)
+		}
+	}
+}
+
+// PruneChangeLog deletes the change log entries of every network which are
+// older than changelog.Retention. Errors encountered for one network do not
+// prevent the others from being pruned; the last such error is returned.
+func (r *Reaper) PruneChangeLog() error {
+	networkIDs, err := r.getNetworkIDs()
+	if err != nil {
+		return fmt.Errorf("failed to list networks: %v", err)
+	}
+
+	before := clock.Now().Add(-changelog.Retention)
+	var lastErr error
+	for _, networkID := range networkIDs {
+		err = r.pruneNetworkChangeLog(networkID, before)
+		if err != nil {
+			glog.Errorf("Error pruning state change log of network %s: %v", networkID, err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// pruneNetworkChangeLog prunes the network's change log in a transaction of
+// its own, so it doesn't conflict with the serializable reap of its states
+func (r *Reaper) pruneNetworkChangeLog(networkID string, before time.Time) error {
+	store, err := r.factory.StartTransaction(nil)
+	if err != nil {
+		return err
+	}
+	err = r.changeLog.Prune(store, networkID, before)
+	if err != nil {
+		store.Rollback()
+		return err
+	}
+	return store.Commit()
+}
+
+// ReapExpiredStates deletes all expired states across all networks. Errors
+// encountered for one network do not prevent the others from being reaped;
+// the last such error is returned.
+func (r *Reaper) ReapExpiredStates() error {
+	ttlsByType := state.GetExpiringTypes()
+	if len(ttlsByType) == 0 {
+		return nil
+	}
+	networkIDs, err := r.getNetworkIDs()
+	if err != nil {
+		return fmt.Errorf("failed to list networks: %v", err)
+	}
+
+	var lastErr error
+	for _, networkID := range networkIDs {
+		err = r.reapNetwork(networkID, ttlsByType)
+		if err != nil {
+			glog.Errorf("Error reaping expired states for network %s: %v", networkID, err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (r *Reaper) reapNetwork(networkID string, ttlsByType map[string]time.Duration) error {
+	// A serializable transaction fails to commit if a state was refreshed
+	// between reading and deleting it, so refreshed states are never reaped
+	store, err := r.factory.StartTransaction(&storage.TxOptions{Isolation: storage.LevelSerializable})
+	if err != nil {
+		return err
+	}
+
+	now := clock.Now()
+	expiredCountsByType := map[string]int{}
+	var expiredIDs []storage.TypeAndKey
+	for _, typeVal := range getSortedTypes(ttlsByType) {
+		expired, err := getExpiredIDs(store, networkID, typeVal, now)
+		if err != nil {
+			store.Rollback()
+			return err
+		}
+		expiredIDs = append(expiredIDs, expired...)
+		expiredCountsByType[typeVal] = len(expired)
+	}
+	if len(expiredIDs) == 0 {
+		return store.Commit()
+	}
+
+	err = store.Delete(networkID, expiredIDs)
+	if err != nil {
+		store.Rollback()
+		return err
+	}
+	err = r.changeLog.Record(store, networkID, changelog.GetDeleteChanges(expiredIDs))
+	if err != nil {
+		store.Rollback()
+		return err
+	}
+	err = store.Commit()
+	if err != nil {
+		return err
+	}
+	for typeVal, count := range expiredCountsByType {
+		expiredStatesCount.WithLabelValues(networkID, typeVal).Add(float64(count))
+	}
+	return nil
+}
+
+func getExpiredIDs(store blobstore.TransactionalBlobStorage, networkID string, typeVal string, now time.Time) ([]storage.TypeAndKey, error) {
+	keys, err := store.ListKeys(networkID, typeVal)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	ids := make([]storage.TypeAndKey, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, storage.TypeAndKey{Type: typeVal, Key: key})
+	}
+	blobs, err := store.GetMany(networkID, ids)
+	if err != nil {
+		return nil, err
+	}
+	checkinTimesMs, err := state.GetCheckinTimes(blobs, func(ids []storage.TypeAndKey) ([]blobstore.Blob, error) {
+		return store.GetMany(networkID, ids)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []storage.TypeAndKey
+	for _, blob := range blobs {
+		wrap := state.SerializedStateWithMeta{}
+		if err := json.Unmarshal(blob.Value, &wrap); err != nil {
+			glog.Errorf("Failed to unmarshal state %s of type %s: %v", blob.Key, blob.Type, err)
+			continue
+		}
+		ttlStartMs := state.GetTTLStartTimeMs(blob.Type, wrap, checkinTimesMs)
+		if state.IsExpired(blob.Type, ttlStartMs, now) {
+			ret = append(ret, storage.TypeAndKey{Type: blob.Type, Key: blob.Key})
+		}
+	}
+	return ret, nil
+}
+
+func getSortedTypes(ttlsByType map[string]time.Duration) []string {
+	ret := make([]string, 0, len(ttlsByType))
+	for typeVal := range ttlsByType {
+		ret = append(ret, typeVal)
+	}
+	sort.Strings(ret)
+	return ret
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package reaper_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/changelog"
+	"magma/orc8r/cloud/go/services/state/reaper"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	expiringType     = "expiring"
+	gatewayBoundType = "gateway_bound"
+	permanentType    = "permanent"
+)
+
+func TestReaper_ReapExpiredStates(t *testing.T) {
+	serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	defer serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	err := serde.RegisterSerdes(
+		state.NewExpiringStateSerde(expiringType, &state.StringToStringMap{}, time.Minute),
+		state.NewStateSerde(permanentType, &state.StringToStringMap{}),
+	)
+	assert.NoError(t, err)
+
+	now := time.Unix(1000000, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.GetUnfreezeClockDeferFunc(t)()
+
+	factory := blobstore.NewMemoryBlobStorageFactory()
+	writeStates(t, factory, "n1",
+		makeBlob(t, expiringType, "fresh", now.Add(-30*time.Second)),
+		makeBlob(t, expiringType, "stale", now.Add(-2*time.Minute)),
+		makeBlob(t, permanentType, "old", now.Add(-24*time.Hour)),
+	)
+	writeStates(t, factory, "n2",
+		makeBlob(t, expiringType, "stale", now.Add(-time.Minute)),
+	)
+
+	r := reaper.NewReaper(factory, changelog.NewBlobLog(), func() ([]string, error) { return []string{"n1", "n2"}, nil })
+	err = r.ReapExpiredStates()
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"fresh"}, listKeys(t, factory, "n1", expiringType))
+	assert.Equal(t, []string{"old"}, listKeys(t, factory, "n1", permanentType))
+	assert.Equal(t, []string{}, listKeys(t, factory, "n2", expiringType))
+
+	// Nothing left to reap
+	err = r.ReapExpiredStates()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"fresh"}, listKeys(t, factory, "n1", expiringType))
+}
+
+func TestReaper_ReapGatewayBoundStates(t *testing.T) {
+	serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	defer serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	err := serde.RegisterSerdes(
+		state.NewGatewayBoundStateSerde(gatewayBoundType, &state.StringToStringMap{}, time.Minute),
+		state.NewStateSerde(orc8r.GatewayStateType, &state.StringToStringMap{}),
+	)
+	assert.NoError(t, err)
+
+	now := time.Unix(1000000, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.GetUnfreezeClockDeferFunc(t)()
+
+	// hw1 checked in recently, so its states stay valid. hw2 stopped
+	// checking in and hw3 never did, so their stale states expire.
+	factory := blobstore.NewMemoryBlobStorageFactory()
+	writeStates(t, factory, "n1",
+		makeReportedBlob(t, orc8r.GatewayStateType, "hw1", "hw1", now.Add(-10*time.Second)),
+		makeReportedBlob(t, orc8r.GatewayStateType, "hw2", "hw2", now.Add(-2*time.Minute)),
+		makeReportedBlob(t, gatewayBoundType, "ue1", "hw1", now.Add(-24*time.Hour)),
+		makeReportedBlob(t, gatewayBoundType, "ue2", "hw2", now.Add(-24*time.Hour)),
+		makeReportedBlob(t, gatewayBoundType, "ue3", "hw3", now.Add(-2*time.Minute)),
+		makeReportedBlob(t, gatewayBoundType, "ue4", "hw3", now.Add(-30*time.Second)),
+	)
+
+	r := reaper.NewReaper(factory, changelog.NewBlobLog(), func() ([]string, error) { return []string{"n1"}, nil })
+	err = r.ReapExpiredStates()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ue1", "ue4"}, listKeys(t, factory, "n1", gatewayBoundType))
+	assert.Equal(t, []string{"hw1", "hw2"}, listKeys(t, factory, "n1", orc8r.GatewayStateType))
+}
+
+func TestIsExpired(t *testing.T) {
+	serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	defer serde.UnregisterSerdesForDomain(t, state.SerdeDomain)
+	err := serde.RegisterSerdes(
+		state.NewExpiringStateSerde(expiringType, &state.StringToStringMap{}, time.Minute),
+		state.NewStateSerde(permanentType, &state.StringToStringMap{}),
+	)
+	assert.NoError(t, err)
+
+	now := time.Unix(1000000, 0)
+	assert.Equal(t, time.Minute, state.GetTTL(expiringType))
+	assert.Equal(t, time.Duration(0), state.GetTTL(permanentType))
+	assert.Equal(t, time.Duration(0), state.GetTTL("unregistered"))
+	assert.Equal(t, map[string]time.Duration{expiringType: time.Minute}, state.GetExpiringTypes())
+
+	assert.False(t, state.IsExpired(expiringType, toMs(now.Add(-59*time.Second)), now))
+	assert.True(t, state.IsExpired(expiringType, toMs(now.Add(-time.Minute)), now))
+	assert.False(t, state.IsExpired(permanentType, 0, now))
+	assert.False(t, state.IsExpired("unregistered", 0, now))
+}
+
+func makeBlob(t *testing.T, typeVal string, key string, reportedAt time.Time) blobstore.Blob {
+	return makeReportedBlob(t, typeVal, key, "hw1", reportedAt)
+}
+
+func makeReportedBlob(t *testing.T, typeVal string, key string, reporterID string, reportedAt time.Time) blobstore.Blob {
+	value, err := json.Marshal(state.SerializedStateWithMeta{
+		ReporterID:              reporterID,
+		TimeMs:                  toMs(reportedAt),
+		SerializedReportedState: []byte(`{"foo":"bar"}`),
+	})
+	assert.NoError(t, err)
+	return blobstore.Blob{Type: typeVal, Key: key, Value: value}
+}
+
+func writeStates(t *testing.T, factory blobstore.BlobStorageFactory, networkID string, blobs ...blobstore.Blob) {
+	store, err := factory.StartTransaction(nil)
+	assert.NoError(t, err)
+	assert.NoError(t, store.CreateOrUpdate(networkID, blobs))
+	assert.NoError(t, store.Commit())
+}
+
+func listKeys(t *testing.T, factory blobstore.BlobStorageFactory, networkID string, typeVal string) []string {
+	store, err := factory.StartTransaction(&storage.TxOptions{ReadOnly: true})
+	assert.NoError(t, err)
+	keys, err := store.ListKeys(networkID, typeVal)
+	assert.NoError(t, err)
+	assert.NoError(t, store.Commit())
+	return keys
+}
+
+func toMs(t time.Time) uint64 {
+	return uint64(t.UnixNano() / int64(time.Millisecond))
+}
@@ -10,7 +10,12 @@ package state
 
 import (
 	"encoding/json"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/serde"
+	"magma/orc8r/cloud/go/storage"
 )
 
 const StringMapSerdeType = "string_map"
@@ -19,6 +24,148 @@ func NewStateSerde(stateType string, modelPtr serde.ValidateableBinaryConvertibl
 	return serde.NewBinarySerde(SerdeDomain, stateType, modelPtr)
 }
 
+// ExpiringSerde is a state Serde which declares a time-to-live for the
+// states of its type. A state which has not been re-reported within its TTL
+// is considered expired: the state service will stop returning it and will
+// eventually delete it from storage.
+type ExpiringSerde interface {
+	serde.Serde
+
+	// GetTTL returns how long a reported state of this type stays valid.
+	GetTTL() time.Duration
+}
+
+// NewExpiringStateSerde returns a state Serde whose reported states expire
+// `ttl` after they were last reported.
+func NewExpiringStateSerde(stateType string, modelPtr serde.ValidateableBinaryConvertible, ttl time.Duration) serde.Serde {
+	return &expiringSerde{Serde: NewStateSerde(stateType, modelPtr), ttl: ttl}
+}
+
+// NewGatewayBoundStateSerde returns a state Serde whose reported states
+// expire `ttl` after both the state was last reported and its reporting
+// gateway last checked in. Gateways report states such as directory records
+// only when they change, so these states stay valid for as long as the
+// gateway which reported them keeps checking in.
+func NewGatewayBoundStateSerde(stateType string, modelPtr serde.ValidateableBinaryConvertible, ttl time.Duration) serde.Serde {
+	return &expiringSerde{Serde: NewStateSerde(stateType, modelPtr), ttl: ttl, gatewayBound: true}
+}
+
+type expiringSerde struct {
+	serde.Serde
+	ttl          time.Duration
+	gatewayBound bool
+}
+
+func (s *expiringSerde) GetTTL() time.Duration {
+	return s.ttl
+}
+
+func (s *expiringSerde) isGatewayBound() bool {
+	return s.gatewayBound
+}
+
+// GetTTL returns the TTL declared by the Serde registered for the given state
+// type. A TTL of 0 means that states of this type never expire.
+func GetTTL(stateType string) time.Duration {
+	s, err := serde.GetSerde(SerdeDomain, stateType)
+	if err != nil {
+		return 0
+	}
+	if expiring, ok := s.(ExpiringSerde); ok && expiring.GetTTL() > 0 {
+		return expiring.GetTTL()
+	}
+	return 0
+}
+
+// IsGatewayBound returns true if the TTL of the given state type is refreshed
+// by every check-in of the gateway which reported the state.
+func IsGatewayBound(stateType string) bool {
+	s, err := serde.GetSerde(SerdeDomain, stateType)
+	if err != nil {
+		return false
+	}
+	expiring, ok := s.(*expiringSerde)
+	return ok && expiring.isGatewayBound()
+}
+
+// GetExpiringTypes returns the TTL of every registered state type which
+// declares one, keyed by state type.
+func GetExpiringTypes() map[string]time.Duration {
+	ret := map[string]time.Duration{}
+	for _, s := range serde.GetSerdesForDomain(SerdeDomain) {
+		if expiring, ok := s.(ExpiringSerde); ok && expiring.GetTTL() > 0 {
+			ret[s.GetType()] = expiring.GetTTL()
+		}
+	}
+	return ret
+}
+
+// IsExpired returns true if a state of the given type reported at
+// reportedTimeMs (milliseconds since epoch) has outlived its TTL at time now.
+func IsExpired(stateType string, reportedTimeMs uint64, now time.Time) bool {
+	return isExpired(GetTTL(stateType), reportedTimeMs, now)
+}
+
+// GetTTLStartTimeMs returns the time (milliseconds since epoch) from which the
+// TTL of a wrapped state of the given type is measured. For gateway-bound
+// types, this is the later of the state's report and its reporter's last
+// check-in, looked up in checkinTimesMs by hardware ID.
+func GetTTLStartTimeMs(stateType string, wrap SerializedStateWithMeta, checkinTimesMs map[string]uint64) uint64 {
+	if !IsGatewayBound(stateType) {
+		return wrap.TimeMs
+	}
+	if checkinMs := checkinTimesMs[wrap.ReporterID]; checkinMs > wrap.TimeMs {
+		return checkinMs
+	}
+	return wrap.TimeMs
+}
+
+// GetCheckinTimes returns the time (milliseconds since epoch) of the last
+// check-in of every gateway which reported one of the gateway-bound states in
+// blobs, keyed by hardware ID. getGatewayStatuses loads gateway status blobs.
+func GetCheckinTimes(blobs []blobstore.Blob, getGatewayStatuses func(ids []storage.TypeAndKey) ([]blobstore.Blob, error)) (map[string]uint64, error) {
+	reporterIDs := map[string]bool{}
+	for _, blob := range blobs {
+		if !IsGatewayBound(blob.Type) {
+			continue
+		}
+		wrap := SerializedStateWithMeta{}
+		if err := json.Unmarshal(blob.Value, &wrap); err != nil || wrap.ReporterID == "" {
+			continue
+		}
+		reporterIDs[wrap.ReporterID] = true
+	}
+	if len(reporterIDs) == 0 {
+		return map[string]uint64{}, nil
+	}
+
+	ids := make([]storage.TypeAndKey, 0, len(reporterIDs))
+	for hwID := range reporterIDs {
+		ids = append(ids, storage.TypeAndKey{Type: orc8r.GatewayStateType, Key: hwID})
+	}
+	statuses, err := getGatewayStatuses(ids)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]uint64, len(statuses))
+	for _, status := range statuses {
+		wrap := SerializedStateWithMeta{}
+		if err := json.Unmarshal(status.Value, &wrap); err != nil {
+			continue
+		}
+		ret[status.Key] = wrap.TimeMs
+	}
+	return ret, nil
+}
+
+func isExpired(ttl time.Duration, reportedTimeMs uint64, now time.Time) bool {
+	if ttl <= 0 {
+		return false
+	}
+	expiresAtMs := int64(reportedTimeMs) + int64(ttl/time.Millisecond)
+	return now.UnixNano()/int64(time.Millisecond) >= expiresAtMs
+}
+
 // A generic map that holds key value pair both of type string. This is used on
 // the gateway side in checkin_cli.py to simply test the connection between the
 // cloud and the gateway.
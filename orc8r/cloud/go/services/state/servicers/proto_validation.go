@@ -24,6 +24,17 @@ func ValidateGetStatesRequest(req *protos.GetStatesRequest) error {
 	return nil
 }
 
+// ValidateGetStatesAtRequest checks that all required fields exist
+func ValidateGetStatesAtRequest(req *protos.GetStatesAtRequest) error {
+	if err := checkNonEmptyInput(req.GetNetworkID(), req.GetIds()); err != nil {
+		return err
+	}
+	if req.GetTimeMs() <= 0 {
+		return errors.New("Time must be specified and positive")
+	}
+	return nil
+}
+
 // ValidateDeleteStatesRequest checks that all required fields exist
 func ValidateDeleteStatesRequest(req *protos.DeleteStatesRequest) error {
 	if err := checkNonEmptyInput(req.GetNetworkID(), req.GetIds()); err != nil {
@@ -85,3 +96,11 @@ func checkNonEmptyInput(networkID string, ids []*protos.StateID) error {
 	}
 	return nil
 }
+
+// ValidateWatchStatesRequest checks that all required fields exist
+func ValidateWatchStatesRequest(req *protos.WatchStatesRequest) error {
+	if len(req.GetNetworkID()) == 0 {
+		return errors.New("Network ID must be specified")
+	}
+	return nil
+}
@@ -17,22 +17,45 @@ import (
 	"magma/orc8r/cloud/go/clock"
 	"magma/orc8r/cloud/go/protos"
 	stateService "magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/changelog"
+	"magma/orc8r/cloud/go/storage"
 
+	"github.com/golang/glog"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 type stateServicer struct {
-	factory blobstore.BlobStorageFactory
+	factory   blobstore.BlobStorageFactory
+	changeLog changelog.Log
+	notifier  *changeNotifier
 }
 
-// NewStateServicer returns a state server backed by storage passed in
-func NewStateServicer(factory blobstore.BlobStorageFactory) (protos.StateServiceServer, error) {
+// NewStateServicer returns a state server backed by storage passed in, which
+// records the changes to states in changeLog
+func NewStateServicer(factory blobstore.BlobStorageFactory, changeLog changelog.Log) (protos.StateServiceServer, error) {
+	return NewStateServicerWithWatchInterval(factory, changeLog, DefaultWatchPollInterval)
+}
+
+// NewStateServicerWithWatchInterval returns a state server backed by storage
+// passed in, which records the changes to states in changeLog and checks
+// watched networks for changes every watchPollInterval.
+func NewStateServicerWithWatchInterval(factory blobstore.BlobStorageFactory, changeLog changelog.Log, watchPollInterval time.Duration) (protos.StateServiceServer, error) {
 	if factory == nil {
 		return nil, fmt.Errorf("Storage factory is nil")
 	}
-	return &stateServicer{factory}, nil
+	if changeLog == nil {
+		return nil, fmt.Errorf("Change log is nil")
+	}
+	if watchPollInterval <= 0 {
+		return nil, fmt.Errorf("Watch poll interval must be positive")
+	}
+	return &stateServicer{
+		factory:   factory,
+		changeLog: changeLog,
+		notifier:  newChangeNotifier(factory, changeLog, watchPollInterval),
+	}, nil
 }
 
 // GetStates retrieves states from blobstorage
@@ -52,6 +75,50 @@ func (srv *stateServicer) GetStates(context context.Context, req *protos.GetStat
 		store.Rollback()
 		return nil, err
 	}
+	states, err = filterExpiredBlobs(states, getManyFunc(store, req.GetNetworkID()), clock.Now())
+	if err != nil {
+		store.Rollback()
+		return nil, err
+	}
+	return &protos.GetStatesResponse{States: protos.BlobsToStates(states)}, store.Commit()
+}
+
+// GetStatesAt retrieves states as they were at a prior point in time from
+// blobstorage. The storage must retain history.
+func (srv *stateServicer) GetStatesAt(context context.Context, req *protos.GetStatesAtRequest) (*protos.GetStatesResponse, error) {
+	if err := ValidateGetStatesAtRequest(req); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ids := protos.StateIDsToTKs(req.GetIds())
+	at := time.Unix(0, req.GetTimeMs()*int64(time.Millisecond))
+
+	store, err := srv.factory.StartTransaction(&storage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	historicalStore, ok := store.(blobstore.HistoricalBlobStorage)
+	if !ok {
+		store.Rollback()
+		return nil, status.Error(codes.Unimplemented, blobstore.ErrHistoryNotEnabled.Error())
+	}
+	states, err := historicalStore.GetManyAt(req.GetNetworkID(), ids, at)
+	if err == blobstore.ErrHistoryNotEnabled {
+		store.Rollback()
+		return nil, status.Error(codes.Unimplemented, err.Error())
+	}
+	if err != nil {
+		store.Rollback()
+		return nil, err
+	}
+	getGatewayStatusesAt := func(ids []storage.TypeAndKey) ([]blobstore.Blob, error) {
+		return historicalStore.GetManyAt(req.GetNetworkID(), ids, at)
+	}
+	states, err = filterExpiredBlobs(states, getGatewayStatusesAt, at)
+	if err != nil {
+		store.Rollback()
+		return nil, err
+	}
 	return &protos.GetStatesResponse{States: protos.BlobsToStates(states)}, store.Commit()
 }
 
@@ -86,11 +153,21 @@ func (srv *stateServicer) ReportStates(context context.Context, req *protos.Repo
 	if err != nil {
 		return response, err
 	}
+	changes, err := changelog.GetWriteChanges(store, networkID, states)
+	if err != nil {
+		store.Rollback()
+		return response, err
+	}
 	err = store.CreateOrUpdate(networkID, states)
 	if err != nil {
 		store.Rollback()
 		return response, err
 	}
+	err = srv.changeLog.Record(store, networkID, changes)
+	if err != nil {
+		store.Rollback()
+		return response, err
+	}
 	return response, store.Commit()
 }
 
@@ -118,11 +195,25 @@ func (srv *stateServicer) DeleteStates(context context.Context, req *protos.Dele
 	if err != nil {
 		return nil, err
 	}
+	existing, err := store.GetMany(networkID, ids)
+	if err != nil {
+		store.Rollback()
+		return ret, err
+	}
 	err = store.Delete(networkID, ids)
 	if err != nil {
 		store.Rollback()
 		return ret, err
 	}
+	deletedIDs := make([]storage.TypeAndKey, 0, len(existing))
+	for _, blob := range existing {
+		deletedIDs = append(deletedIDs, storage.TypeAndKey{Type: blob.Type, Key: blob.Key})
+	}
+	err = srv.changeLog.Record(store, networkID, changelog.GetDeleteChanges(deletedIDs))
+	if err != nil {
+		store.Rollback()
+		return ret, err
+	}
 	return ret, store.Commit()
 }
 
@@ -214,3 +305,43 @@ func addWrapperAndMakeBlobs(states []*protos.State, hwID string, timeMs uint64,
 	}
 	return blobs, nil
 }
+
+// filterExpiredBlobs removes the blobs whose wrapped state has outlived the
+// TTL declared by its serde. getGatewayStatuses loads the gateway statuses
+// whose check-ins refresh gateway-bound states. Expired blobs are left in
+// storage for the reaper to clean up.
+func filterExpiredBlobs(
+	blobs []blobstore.Blob,
+	getGatewayStatuses func(ids []storage.TypeAndKey) ([]blobstore.Blob, error),
+	now time.Time,
+) ([]blobstore.Blob, error) {
+	checkinTimesMs, err := stateService.GetCheckinTimes(blobs, getGatewayStatuses)
+	if err != nil {
+		return nil, err
+	}
+	ret := make([]blobstore.Blob, 0, len(blobs))
+	for _, blob := range blobs {
+		if stateService.GetTTL(blob.Type) == 0 {
+			ret = append(ret, blob)
+			continue
+		}
+		wrap := stateService.SerializedStateWithMeta{}
+		if err := json.Unmarshal(blob.Value, &wrap); err != nil {
+			glog.Errorf("Failed to unmarshal state %s of type %s: %v", blob.Key, blob.Type, err)
+			ret = append(ret, blob)
+			continue
+		}
+		ttlStartMs := stateService.GetTTLStartTimeMs(blob.Type, wrap, checkinTimesMs)
+		if !stateService.IsExpired(blob.Type, ttlStartMs, now) {
+			ret = append(ret, blob)
+		}
+	}
+	return ret, nil
+}
+
+// getManyFunc returns a function which loads blobs of the network from store
+func getManyFunc(store blobstore.TransactionalBlobStorage, networkID string) func(ids []storage.TypeAndKey) ([]blobstore.Blob, error) {
+	return func(ids []storage.TypeAndKey) ([]blobstore.Blob, error) {
+		return store.GetMany(networkID, ids)
+	}
+}
@@ -0,0 +1,357 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package servicers
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/state/changelog"
+	"magma/orc8r/cloud/go/storage"
+
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultWatchPollInterval is how often the state servicer checks the
+	// change sequence of each watched network for new changes.
+	DefaultWatchPollInterval = 5 * time.Second
+
+	// maxWatchedChangesPerBatch caps the number of change log entries loaded
+	// for a single WatchStates response
+	maxWatchedChangesPerBatch = 1000
+)
+
+// WatchStates streams the changes to the states matching the request's
+// filters to the client as events. Each response carries a cursor which
+// encodes the network's change sequence number the client has seen, so a
+// client which reconnects with that cursor receives every change which
+// happened in the meantime, as long as the change log still retains them.
+func (srv *stateServicer) WatchStates(req *protos.WatchStatesRequest, stream protos.StateService_WatchStatesServer) error {
+	if err := ValidateWatchStatesRequest(req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	seen, err := decodeCursor(req.GetCursor())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid cursor: %v", err)
+	}
+
+	// Subscribe before the first read so no change is missed
+	changed, unsubscribe := srv.notifier.subscribe(req.GetNetworkID())
+	defer unsubscribe()
+	for first := true; ; first = false {
+		events, sequence, err := srv.getWatchedChanges(req, seen)
+		if err == changelog.ErrSequenceExpired {
+			return status.Error(codes.OutOfRange, "changes since the cursor are no longer retained, restart the watch without a cursor")
+		}
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to load watched changes: %v", err)
+		}
+		caughtUp := seen == nil || sequence == *seen
+		seen = &sequence
+
+		// Always send the first response so the client gets a cursor
+		if first || len(events) > 0 {
+			err = stream.Send(&protos.WatchStatesResponse{Events: events, Cursor: encodeCursor(sequence)})
+			if err != nil {
+				return err
+			}
+		}
+		if !caughtUp {
+			continue
+		}
+
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-changed:
+		}
+	}
+}
+
+// getWatchedChanges returns the events for the changes to watched states
+// which were recorded after sequence number `since`, along with the sequence
+// number up to which changes were read. If since is nil, no events are
+// returned and the sequence is the network's current one.
+func (srv *stateServicer) getWatchedChanges(req *protos.WatchStatesRequest, since *uint64) ([]*protos.StateEvent, uint64, error) {
+	store, err := srv.factory.StartTransaction(&storage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, 0, err
+	}
+	if since == nil {
+		sequence, err := srv.changeLog.GetSequence(store, req.GetNetworkID())
+		if err != nil {
+			store.Rollback()
+			return nil, 0, err
+		}
+		return nil, sequence, store.Commit()
+	}
+
+	changes, sequence, err := srv.changeLog.GetChanges(store, req.GetNetworkID(), *since, maxWatchedChangesPerBatch)
+	if err != nil {
+		store.Rollback()
+		return nil, 0, err
+	}
+	collapsed := collapseChanges(filterWatchedChanges(req, changes))
+	var writtenIDs []storage.TypeAndKey
+	for _, change := range collapsed {
+		if !change.Deleted {
+			writtenIDs = append(writtenIDs, change.ID())
+		}
+	}
+	var blobs []blobstore.Blob
+	if len(writtenIDs) > 0 {
+		blobs, err = store.GetMany(req.GetNetworkID(), writtenIDs)
+		if err != nil {
+			store.Rollback()
+			return nil, 0, err
+		}
+	}
+	blobs, err = filterExpiredBlobs(blobs, getManyFunc(store, req.GetNetworkID()), clock.Now())
+	if err != nil {
+		store.Rollback()
+		return nil, 0, err
+	}
+	return makeStateEvents(collapsed, blobs), sequence, store.Commit()
+}
+
+// filterWatchedChanges returns the changes to states matching the request's
+// type and device ID filters
+func filterWatchedChanges(req *protos.WatchStatesRequest, changes []changelog.SequencedChange) []changelog.SequencedChange {
+	types := map[string]bool{}
+	for _, t := range req.GetTypes() {
+		types[t] = true
+	}
+	deviceIDs := map[string]bool{}
+	for _, id := range req.GetDeviceIDs() {
+		deviceIDs[id] = true
+	}
+	var ret []changelog.SequencedChange
+	for _, change := range changes {
+		if len(types) > 0 && !types[change.Type] {
+			continue
+		}
+		if len(deviceIDs) > 0 && !deviceIDs[change.Key] {
+			continue
+		}
+		ret = append(ret, change)
+	}
+	return ret
+}
+
+// collapsedChange is the net effect of all changes to a single state
+type collapsedChange struct {
+	changelog.Change
+	// lastSequence is the sequence number of the last change to the state
+	lastSequence uint64
+}
+
+// collapseChanges collapses the ordered changes of each state into a single
+// change: the state was created if it didn't exist before the first change,
+// and deleted if the last change deleted it.
+func collapseChanges(changes []changelog.SequencedChange) map[storage.TypeAndKey]collapsedChange {
+	ret := map[storage.TypeAndKey]collapsedChange{}
+	for _, change := range changes {
+		collapsed, exists := ret[change.ID()]
+		if !exists {
+			collapsed = collapsedChange{Change: change.Change}
+		}
+		collapsed.Deleted = change.Deleted
+		collapsed.lastSequence = change.Sequence
+		ret[change.ID()] = collapsed
+	}
+	return ret
+}
+
+// makeStateEvents returns the events for the collapsed changes, in the order
+// in which the states were last changed. blobs are the current values of the
+// states which were not deleted.
+func makeStateEvents(collapsed map[storage.TypeAndKey]collapsedChange, blobs []blobstore.Blob) []*protos.StateEvent {
+	statesByID := map[storage.TypeAndKey]*protos.State{}
+	for _, state := range protos.BlobsToStates(blobs) {
+		statesByID[storage.TypeAndKey{Type: state.Type, Key: state.DeviceID}] = state
+	}
+
+	ordered := make([]collapsedChange, 0, len(collapsed))
+	for _, change := range collapsed {
+		ordered = append(ordered, change)
+	}
+	sort.Slice(ordered, func(i, j int) bool {
+		a, b := ordered[i], ordered[j]
+		if a.lastSequence != b.lastSequence {
+			return a.lastSequence < b.lastSequence
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Key < b.Key
+	})
+
+	var events []*protos.StateEvent
+	for _, change := range ordered {
+		switch {
+		case change.Deleted && change.Created:
+			// The client never saw this state
+			continue
+		case change.Deleted:
+			events = append(events, &protos.StateEvent{
+				Type:  protos.StateEvent_DELETE,
+				State: &protos.State{Type: change.Type, DeviceID: change.Key},
+			})
+		default:
+			state, ok := statesByID[change.ID()]
+			if !ok {
+				// Expired, or deleted by a change the client will receive in
+				// a later batch
+				continue
+			}
+			eventType := protos.StateEvent_UPDATE
+			if change.Created {
+				eventType = protos.StateEvent_CREATE
+			}
+			events = append(events, &protos.StateEvent{Type: eventType, State: state})
+		}
+	}
+	return events
+}
+
+// encodeCursor serializes a change sequence number into an opaque string
+func encodeCursor(sequence uint64) string {
+	return strconv.FormatUint(sequence, 10)
+}
+
+// decodeCursor deserializes a cursor produced by encodeCursor. An empty
+// cursor decodes to nil.
+func decodeCursor(cursor string) (*uint64, error) {
+	if cursor == "" {
+		return nil, nil
+	}
+	sequence, err := strconv.ParseUint(cursor, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &sequence, nil
+}
+
+// changeNotifier polls the change sequence of every watched network once per
+// interval on behalf of all the streams watching it, and wakes those streams
+// when the sequence advances. The sequence is read from storage, so changes
+// written through any replica of the state service are noticed.
+type changeNotifier struct {
+	factory   blobstore.BlobStorageFactory
+	changeLog changelog.Log
+	interval  time.Duration
+	start     sync.Once
+
+	sync.Mutex
+	// subscribers holds the wake channels of the streams watching each
+	// network, keyed by network ID
+	subscribers map[string]map[chan struct{}]struct{}
+	// sequences holds the last polled sequence number of each network
+	sequences map[string]uint64
+}
+
+func newChangeNotifier(factory blobstore.BlobStorageFactory, changeLog changelog.Log, interval time.Duration) *changeNotifier {
+	return &changeNotifier{
+		factory:     factory,
+		changeLog:   changeLog,
+		interval:    interval,
+		subscribers: map[string]map[chan struct{}]struct{}{},
+		sequences:   map[string]uint64{},
+	}
+}
+
+// subscribe returns a channel which receives a value whenever the network's
+// change sequence advances, and a function which cancels the subscription.
+func (n *changeNotifier) subscribe(networkID string) (<-chan struct{}, func()) {
+	n.start.Do(func() { go n.run() })
+
+	ch := make(chan struct{}, 1)
+	n.Lock()
+	defer n.Unlock()
+	if _, ok := n.subscribers[networkID]; !ok {
+		n.subscribers[networkID] = map[chan struct{}]struct{}{}
+	}
+	n.subscribers[networkID][ch] = struct{}{}
+
+	unsubscribe := func() {
+		n.Lock()
+		defer n.Unlock()
+		delete(n.subscribers[networkID], ch)
+		if len(n.subscribers[networkID]) == 0 {
+			delete(n.subscribers, networkID)
+			delete(n.sequences, networkID)
+		}
+	}
+	return ch, unsubscribe
+}
+
+func (n *changeNotifier) run() {
+	for range time.Tick(n.interval) {
+		n.Lock()
+		networkIDs := make([]string, 0, len(n.subscribers))
+		for networkID := range n.subscribers {
+			networkIDs = append(networkIDs, networkID)
+		}
+		n.Unlock()
+
+		for _, networkID := range networkIDs {
+			sequence, err := n.getSequence(networkID)
+			if err != nil {
+				glog.Errorf("Failed to poll state change sequence of network %s: %v", networkID, err)
+				continue
+			}
+			n.notify(networkID, sequence)
+		}
+	}
+}
+
+func (n *changeNotifier) getSequence(networkID string) (uint64, error) {
+	store, err := n.factory.StartTransaction(&storage.TxOptions{ReadOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	sequence, err := n.changeLog.GetSequence(store, networkID)
+	if err != nil {
+		store.Rollback()
+		return 0, err
+	}
+	return sequence, store.Commit()
+}
+
+// notify wakes the network's subscribers if its sequence advanced since the
+// last poll. The first poll of a network always wakes its subscribers, since
+// they may have subscribed after a change which that poll observes.
+func (n *changeNotifier) notify(networkID string, sequence uint64) {
+	n.Lock()
+	defer n.Unlock()
+	subscribers, ok := n.subscribers[networkID]
+	if !ok {
+		return
+	}
+	last, polled := n.sequences[networkID]
+	n.sequences[networkID] = sequence
+	if polled && last == sequence {
+		return
+	}
+	for ch := range subscribers {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// The subscriber already has a pending wakeup
+		}
+	}
+}
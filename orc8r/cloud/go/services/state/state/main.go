@@ -9,6 +9,7 @@
 package main
 
 import (
+	"database/sql"
 	"time"
 
 	"magma/orc8r/cloud/go/blobstore"
@@ -16,16 +17,28 @@ import (
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/service"
+	"magma/orc8r/cloud/go/service/config"
+	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/changelog"
 	"magma/orc8r/cloud/go/services/state/metrics"
+	"magma/orc8r/cloud/go/services/state/reaper"
 	"magma/orc8r/cloud/go/services/state/servicers"
+	"magma/orc8r/cloud/go/services/upgrade/rollout"
 	"magma/orc8r/cloud/go/sqorc"
 
 	"github.com/golang/glog"
+	"github.com/google/uuid"
 )
 
-// how often to report gateway status
-const gatewayStatusReportInterval = time.Second * 60
+const (
+	// how often to report gateway status
+	gatewayStatusReportInterval = time.Second * 60
+	// how often to delete states which have outlived their TTL
+	expiredStateReapInterval = time.Minute * 5
+	// how often to advance the rollouts of upgrade tiers
+	tierRolloutUpdateInterval = time.Minute
+)
 
 func main() {
 	srv, err := service.NewOrchestratorService(orc8r.ModuleName, state.ServiceName)
@@ -36,13 +49,19 @@ func main() {
 	if err != nil {
 		glog.Fatalf("Failed to connect to database: %s", err)
 	}
-	store := blobstore.NewEntStorage(state.DBTableName, db, sqorc.GetSqlBuilder())
+	store := newBlobStorageFactory(srv.Config, db)
 	err = store.InitializeFactory()
 	if err != nil {
 		glog.Fatalf("Error initializing state database: %s", err)
 	}
 
-	server, err := servicers.NewStateServicer(store)
+	changeLog := changelog.NewSQLLog(state.ChangeLogTableName, db, sqorc.GetSqlBuilder())
+	err = changeLog.Initialize()
+	if err != nil {
+		glog.Fatalf("Error initializing state change log table: %s", err)
+	}
+
+	server, err := servicers.NewStateServicer(store, changeLog)
 	if err != nil {
 		glog.Fatalf("Error creating state server: %s", err)
 	}
@@ -51,8 +70,46 @@ func main() {
 	// periodically go through all existing gateways and log metrics
 	go metrics.PeriodicallyReportGatewayStatus(gatewayStatusReportInterval)
 
+	// periodically delete states which have outlived their TTL, and prune
+	// the change log
+	go reaper.NewReaper(store, changeLog, configurator.ListNetworkIDs).Run(expiredStateReapInterval)
+
+	// periodically upgrade the next batches of running tier rollouts, based
+	// on the check-in status of the gateways. Only the replica holding the
+	// rollout lease advances rollouts.
+	leaseStore := blobstore.NewEntStorage(rollout.LeaseTableName, db, sqorc.GetSqlBuilder())
+	err = leaseStore.InitializeFactory()
+	if err != nil {
+		glog.Fatalf("Error initializing tier rollout lease table: %s", err)
+	}
+	go rollout.NewRunner(leaseStore, uuid.New().String()).Run(tierRolloutUpdateInterval)
+
 	err = srv.Run()
 	if err != nil {
 		glog.Fatalf("Error running service: %s", err)
 	}
 }
+
+// newBlobStorageFactory returns the storage for reported states. If the
+// service config sets a limit on the number of versions or the age of state
+// history to retain, the storage additionally records history within those
+// limits in a separate table.
+func newBlobStorageFactory(cfg *config.ConfigMap, db *sql.DB) blobstore.BlobStorageFactory {
+	policy := blobstore.HistoryPolicy{}
+	if cfg != nil {
+		maxVersions, err := cfg.GetIntParam("history_max_versions")
+		if err == nil {
+			policy.MaxVersions = maxVersions
+		}
+		maxAgeHours, err := cfg.GetIntParam("history_max_age_hours")
+		if err == nil {
+			policy.MaxAge = time.Duration(maxAgeHours) * time.Hour
+		}
+	}
+	factory := blobstore.NewEntStorage(state.DBTableName, db, sqorc.GetSqlBuilder())
+	if policy.MaxVersions <= 0 && policy.MaxAge <= 0 {
+		return factory
+	}
+	glog.Infof("Retaining state history with policy %+v", policy)
+	return blobstore.NewSQLHistoryFactory(factory, state.DBTableName, db, sqorc.GetSqlBuilder(), policy)
+}
@@ -10,22 +10,28 @@ package test_init
 
 import (
 	"testing"
+	"time"
 
 	"magma/orc8r/cloud/go/blobstore"
 	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/services/state"
+	"magma/orc8r/cloud/go/services/state/changelog"
 	"magma/orc8r/cloud/go/services/state/servicers"
 	"magma/orc8r/cloud/go/test_utils"
 
 	"github.com/stretchr/testify/assert"
 )
 
+// watchPollInterval is short so tests don't wait long for watch events
+const watchPollInterval = 50 * time.Millisecond
+
 // StartTestService instantiates a service backed by an in-memory storage
+// which retains the full history of each state
 func StartTestService(t *testing.T) {
-	factory := blobstore.NewMemoryBlobStorageFactory()
+	factory := blobstore.NewMemoryBlobStorageFactoryWithHistory(blobstore.HistoryPolicy{})
 	srv, lis := test_utils.NewTestService(t, orc8r.ModuleName, state.ServiceName)
-	server, err := servicers.NewStateServicer(factory)
+	server, err := servicers.NewStateServicerWithWatchInterval(factory, changelog.NewBlobLog(), watchPollInterval)
 	assert.NoError(t, err)
 	protos.RegisterStateServiceServer(srv.GrpcServer, server)
 	go srv.RunTest(lis)
@@ -0,0 +1,137 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package providers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator"
+
+	"github.com/golang/protobuf/ptypes/any"
+)
+
+// DeltaStreamProvider is a StreamProvider which can return only the updates
+// made to a gateway's stream since a cursor it returned before.
+type DeltaStreamProvider interface {
+	StreamProvider
+
+	// GetDeltaUpdates returns the updates made to the stream since cursor,
+	// along with the cursor of the stream after the updates. If cursor is
+	// empty or the updates since it are unknown, all updates are returned
+	// with Resync set.
+	GetDeltaUpdates(gatewayId string, extraArgs *any.Any, cursor string) (*DeltaUpdates, error)
+}
+
+// DeltaUpdates are the updates made to a stream since a cursor
+type DeltaUpdates struct {
+	Updates []*protos.DataUpdate
+	// DeletedKeys are the keys deleted since the cursor. Only set if Resync
+	// is false.
+	DeletedKeys []string
+	// Resync is true if Updates are all the contents of the stream
+	Resync bool
+	// Cursor is the position of the stream after the updates
+	Cursor string
+}
+
+// ChangedUpdatesLoader returns the updates to the items of a stream which are
+// affected by changes to configurator entities, and the keys of the affected
+// items which no longer exist. If the changes can't be mapped to the stream's
+// items, it returns resync true instead.
+type ChangedUpdatesLoader func(networkID string, changes configurator.EntityChanges) (updates []*protos.DataUpdate, deletedKeys []string, resync bool, err error)
+
+// GetConfiguratorDeltaUpdates implements GetDeltaUpdates for a stream provider
+// whose items are built from the configurator entities of the gateway's
+// network. The cursor is the configurator change sequence of the network, so
+// only the items affected by the entities which changed since the cursor are
+// loaded, by loadChanged. All updates are loaded from the provider instead if
+// the changes since the cursor are no longer retained.
+func GetConfiguratorDeltaUpdates(
+	provider StreamProvider,
+	gatewayId string,
+	extraArgs *any.Any,
+	cursor string,
+	loadChanged ChangedUpdatesLoader,
+) (*DeltaUpdates, error) {
+	networkID, _, err := configurator.GetNetworkAndEntityIDForPhysicalID(gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	cursorNetworkID, since, err := decodeCursor(cursor)
+	if err != nil || cursorNetworkID != networkID {
+		return getResyncUpdates(provider, gatewayId, extraArgs, networkID)
+	}
+
+	changes, err := configurator.LoadEntityChanges(networkID, &since)
+	if err == configurator.ErrChangesUnavailable {
+		return getResyncUpdates(provider, gatewayId, extraArgs, networkID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if changes.Sequence == since {
+		return &DeltaUpdates{Updates: []*protos.DataUpdate{}, Cursor: cursor}, nil
+	}
+	updates, deletedKeys, resync, err := loadChanged(networkID, changes)
+	if err != nil {
+		return nil, err
+	}
+	if resync {
+		return getResyncUpdates(provider, gatewayId, extraArgs, networkID)
+	}
+	return &DeltaUpdates{Updates: updates, DeletedKeys: deletedKeys, Cursor: encodeCursor(networkID, changes.Sequence)}, nil
+}
+
+// ChangedEntityKeys returns the keys of the changed entities of the given type
+func ChangedEntityKeys(changes configurator.EntityChanges, entityType string) []string {
+	var ret []string
+	for _, id := range changes.Entities {
+		if id.Type == entityType {
+			ret = append(ret, id.Key)
+		}
+	}
+	return ret
+}
+
+// getResyncUpdates returns all updates of the provider, along with the cursor
+// of the network's change sequence before they were loaded. Changes made while
+// the updates are loaded are sent again in the next delta.
+func getResyncUpdates(provider StreamProvider, gatewayId string, extraArgs *any.Any, networkID string) (*DeltaUpdates, error) {
+	changes, err := configurator.LoadEntityChanges(networkID, nil)
+	if err != nil {
+		return nil, err
+	}
+	updates, err := provider.GetUpdates(gatewayId, extraArgs)
+	if err != nil {
+		return nil, err
+	}
+	return &DeltaUpdates{Updates: updates, Resync: true, Cursor: encodeCursor(networkID, changes.Sequence)}, nil
+}
+
+// encodeCursor serializes a position of a network's change sequence into an
+// opaque string
+func encodeCursor(networkID string, sequence uint64) string {
+	return fmt.Sprintf("%s:%d", networkID, sequence)
+}
+
+// decodeCursor deserializes a cursor produced by encodeCursor
+func decodeCursor(cursor string) (string, uint64, error) {
+	sep := strings.LastIndex(cursor, ":")
+	if sep < 0 {
+		return "", 0, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	sequence, err := strconv.ParseUint(cursor[sep+1:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid cursor %s: %v", cursor, err)
+	}
+	return cursor[:sep], sequence, nil
+}
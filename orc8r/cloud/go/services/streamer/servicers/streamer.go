@@ -16,8 +16,13 @@ the updates would be pushed through that channel.
 package servicers
 
 import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
 	"magma/orc8r/cloud/go/identity"
 	"magma/orc8r/cloud/go/protos"
+	"magma/orc8r/cloud/go/services/configurator"
 	"magma/orc8r/cloud/go/services/streamer/providers"
 
 	"google.golang.org/grpc/codes"
@@ -26,6 +31,9 @@ import (
 
 type StreamingServer struct{}
 
+// GetUpdatesUnverified streams updates to the gateway in the request. If the
+// request is a watch, a batch is pushed whenever the configurator entities of
+// the gateway's network change and the change affects the stream.
 func GetUpdatesUnverified(
 	request *protos.StreamRequest,
 	stream protos.Streamer_GetUpdatesServer,
@@ -34,17 +42,111 @@ func GetUpdatesUnverified(
 	if err != nil {
 		return status.Errorf(codes.Unavailable, "Stream %s does not exist", request.GetStreamName())
 	}
-	updates, err := streamProvider.GetUpdates(request.GetGatewayId(), request.ExtraArgs)
+
+	if !request.GetWatch() {
+		batch, err := getUpdateBatch(streamProvider, request, request.GetCursor())
+		if err != nil {
+			return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
+		}
+		return stream.Send(batch)
+	}
+
+	networkID, _, err := configurator.GetNetworkAndEntityIDForPhysicalID(request.GetGatewayId())
+	if err != nil {
+		return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
+	}
+	watcher := &streamWatcher{provider: streamProvider, request: request, stream: stream, cursor: request.GetCursor()}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	err = configurator.WatchEntityChanges(ctx, networkID, watcher.onChange)
+	if stream.Context().Err() != nil {
+		// The gateway closed the stream
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
+}
+
+// streamWatcher pushes a batch to a watching gateway each time the
+// configurator entities of its network change, if the change affects the
+// gateway's stream
+type streamWatcher struct {
+	provider providers.StreamProvider
+	request  *protos.StreamRequest
+	stream   protos.Streamer_GetUpdatesServer
+
+	// sent is true once the first batch was sent
+	sent bool
+	// cursor is the cursor of the last batch sent by a stream with delta
+	// support
+	cursor string
+	// digest is the digest of the last batch sent by a stream without delta
+	// support
+	digest uint64
+}
+
+func (w *streamWatcher) onChange(sequence uint64) error {
+	batch, err := getUpdateBatch(w.provider, w.request, w.cursor)
 	if err != nil {
 		return status.Errorf(codes.Aborted, "Error while streaming updates: %s", err)
 	}
-	updateBatch := new(protos.DataUpdateBatch)
-	updateBatch.Resync = true
-	updateBatch.Updates = updates
-	stream.Send(updateBatch)
+
+	changed := batch.Resync || len(batch.Updates) > 0 || len(batch.DeletedKeys) > 0
+	if _, ok := w.provider.(providers.DeltaStreamProvider); !ok {
+		digest := digestUpdates(batch.Updates)
+		changed = digest != w.digest
+		w.digest = digest
+	}
+	if w.sent && !changed {
+		return nil
+	}
+	err = w.stream.Send(batch)
+	if err != nil {
+		return err
+	}
+	w.sent = true
+	w.cursor = batch.Cursor
 	return nil
 }
 
+// getUpdateBatch returns the batch to send to a gateway which applied the
+// batch with the given cursor. Streams without delta support always return a
+// resync.
+func getUpdateBatch(streamProvider providers.StreamProvider, request *protos.StreamRequest, cursor string) (*protos.DataUpdateBatch, error) {
+	deltaProvider, ok := streamProvider.(providers.DeltaStreamProvider)
+	if !ok {
+		updates, err := streamProvider.GetUpdates(request.GetGatewayId(), request.GetExtraArgs())
+		if err != nil {
+			return nil, err
+		}
+		return &protos.DataUpdateBatch{Updates: updates, Resync: true}, nil
+	}
+
+	delta, err := deltaProvider.GetDeltaUpdates(request.GetGatewayId(), request.GetExtraArgs(), cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &protos.DataUpdateBatch{
+		Updates:     delta.Updates,
+		Resync:      delta.Resync,
+		DeletedKeys: delta.DeletedKeys,
+		Cursor:      delta.Cursor,
+	}, nil
+}
+
+// digestUpdates returns a digest of the keys and values of updates
+func digestUpdates(updates []*protos.DataUpdate) uint64 {
+	h := fnv.New64a()
+	for _, update := range updates {
+		fmt.Fprintf(h, "%d:%s", len(update.Key), update.Key)
+		fmt.Fprintf(h, "%d:", len(update.Value))
+		h.Write(update.Value)
+	}
+	return h.Sum64()
+}
+
 func (srv *StreamingServer) GetUpdates(
 	request *protos.StreamRequest,
 	stream protos.Streamer_GetUpdatesServer,
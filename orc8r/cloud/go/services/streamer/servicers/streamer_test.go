@@ -12,11 +12,15 @@ import (
 	"errors"
 	"testing"
 
+	"magma/orc8r/cloud/go/orc8r"
 	"magma/orc8r/cloud/go/protos"
 	"magma/orc8r/cloud/go/registry"
+	"magma/orc8r/cloud/go/services/configurator"
+	configurator_test_init "magma/orc8r/cloud/go/services/configurator/test_init"
 	"magma/orc8r/cloud/go/services/streamer"
 	"magma/orc8r/cloud/go/services/streamer/providers"
 	streamer_test_init "magma/orc8r/cloud/go/services/streamer/test_init"
+	"magma/orc8r/cloud/go/storage"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +28,8 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
+const testEntityType = "test_entity"
+
 type mockStreamProvider struct {
 	name   string
 	retVal []*protos.DataUpdate
@@ -38,6 +44,73 @@ func (m *mockStreamProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([
 	return m.retVal, m.retErr
 }
 
+// mockDeltaStreamProvider returns the delta it's set to, and records the
+// cursor it was called with
+type mockDeltaStreamProvider struct {
+	mockStreamProvider
+	delta      *providers.DeltaUpdates
+	lastCursor string
+}
+
+func (m *mockDeltaStreamProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any, cursor string) (*providers.DeltaUpdates, error) {
+	m.lastCursor = cursor
+	return m.delta, m.retErr
+}
+
+// entityStreamProvider streams the configs of the entities of type
+// testEntityType in the gateway's network
+type entityStreamProvider struct {
+	name string
+}
+
+func (p *entityStreamProvider) GetStreamName() string {
+	return p.name
+}
+
+func (p *entityStreamProvider) GetUpdates(gatewayId string, extraArgs *any.Any) ([]*protos.DataUpdate, error) {
+	networkID, _, err := configurator.GetNetworkAndEntityIDForPhysicalID(gatewayId)
+	if err != nil {
+		return nil, err
+	}
+	ents, err := configurator.LoadAllEntitiesInNetwork(networkID, testEntityType, configurator.EntityLoadCriteria{LoadMetadata: true})
+	if err != nil {
+		return nil, err
+	}
+	return entitiesToUpdates(ents), nil
+}
+
+func (p *entityStreamProvider) GetDeltaUpdates(gatewayId string, extraArgs *any.Any, cursor string) (*providers.DeltaUpdates, error) {
+	return providers.GetConfiguratorDeltaUpdates(p, gatewayId, extraArgs, cursor, loadChangedEntities)
+}
+
+func loadChangedEntities(networkID string, changes configurator.EntityChanges) ([]*protos.DataUpdate, []string, bool, error) {
+	keys := providers.ChangedEntityKeys(changes, testEntityType)
+	if len(keys) == 0 {
+		return []*protos.DataUpdate{}, nil, false, nil
+	}
+	ids := make([]storage.TypeAndKey, 0, len(keys))
+	for _, key := range keys {
+		ids = append(ids, storage.TypeAndKey{Type: testEntityType, Key: key})
+	}
+	ents, notFound, err := configurator.LoadEntities(networkID, nil, nil, nil, ids, configurator.EntityLoadCriteria{LoadMetadata: true})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	var deletedKeys []string
+	for _, id := range notFound {
+		deletedKeys = append(deletedKeys, id.Key)
+	}
+	return entitiesToUpdates(ents), deletedKeys, false, nil
+}
+
+func entitiesToUpdates(ents []configurator.NetworkEntity) []*protos.DataUpdate {
+	ret := make([]*protos.DataUpdate, 0, len(ents))
+	for _, ent := range ents {
+		ret = append(ret, &protos.DataUpdate{Key: ent.Key, Value: []byte(ent.Name)})
+	}
+	return ret
+}
+
 func TestStreamingServer_GetUpdates(t *testing.T) {
 	streamer_test_init.StartTestService(t)
 	conn, err := registry.GetConnection(streamer.ServiceName)
@@ -84,3 +157,128 @@ func TestStreamingServer_GetUpdates(t *testing.T) {
 	_, err = streamerClient.Recv()
 	assert.Error(t, err, "Stream stream_dne does not exist", codes.Unavailable)
 }
+
+func TestStreamingServer_GetUpdates_Delta(t *testing.T) {
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	provider := &mockDeltaStreamProvider{
+		mockStreamProvider: mockStreamProvider{name: "delta1"},
+		delta: &providers.DeltaUpdates{
+			Updates:     []*protos.DataUpdate{{Key: "b", Value: []byte("456")}},
+			DeletedKeys: []string{"c"},
+			Cursor:      "cursor2",
+		},
+	}
+	providers.RegisterStreamProvider(provider)
+
+	// The cursor is passed to the provider, and the delta and its cursor are
+	// sent back
+	streamerClient, err := grpcClient.GetUpdates(
+		context.Background(),
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "delta1", Cursor: "cursor1"},
+	)
+	assert.NoError(t, err)
+	actual, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.Equal(t, "cursor1", provider.lastCursor)
+	assert.False(t, actual.Resync)
+	assert.Equal(t, []*protos.DataUpdate{{Key: "b", Value: []byte("456")}}, actual.Updates)
+	assert.Equal(t, []string{"c"}, actual.DeletedKeys)
+	assert.Equal(t, "cursor2", actual.Cursor)
+
+	// Cursors are ignored by streams without delta support
+	providers.RegisterStreamProvider(&mockStreamProvider{name: "nodelta1", retVal: []*protos.DataUpdate{{Key: "a", Value: []byte("123")}}})
+	streamerClient, err = grpcClient.GetUpdates(
+		context.Background(),
+		&protos.StreamRequest{GatewayId: "hwId", StreamName: "nodelta1", Cursor: "cursor1"},
+	)
+	assert.NoError(t, err)
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, actual.Resync)
+	assert.Equal(t, []*protos.DataUpdate{{Key: "a", Value: []byte("123")}}, actual.Updates)
+	assert.Empty(t, actual.Cursor)
+}
+
+func TestStreamingServer_GetUpdates_Watch(t *testing.T) {
+	configurator_test_init.StartTestService(t)
+	streamer_test_init.StartTestService(t)
+	conn, err := registry.GetConnection(streamer.ServiceName)
+	assert.NoError(t, err)
+	grpcClient := protos.NewStreamerClient(conn)
+
+	assert.NoError(t, configurator.CreateNetwork(configurator.Network{ID: "n1"}))
+	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+		{Type: orc8r.MagmadGatewayType, Key: "gw1", PhysicalID: "hwId"},
+		{Type: testEntityType, Key: "a", Name: "123"},
+	})
+	assert.NoError(t, err)
+	providers.RegisterStreamProvider(&entityStreamProvider{name: "watch1"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	streamerClient, err := grpcClient.GetUpdates(ctx, &protos.StreamRequest{GatewayId: "hwId", StreamName: "watch1", Watch: true})
+	assert.NoError(t, err)
+
+	actual, err := streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, actual.Resync)
+	assert.Equal(t, []*protos.DataUpdate{{Key: "a", Value: []byte("123")}}, actual.Updates)
+	cursor := actual.Cursor
+	assert.NotEmpty(t, cursor)
+
+	// Next batches only contain the changes
+	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: testEntityType, Key: "b", Name: "456"})
+	assert.NoError(t, err)
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, actual.Resync)
+	assert.Equal(t, []*protos.DataUpdate{{Key: "b", Value: []byte("456")}}, actual.Updates)
+	assert.Empty(t, actual.DeletedKeys)
+
+	assert.NoError(t, configurator.DeleteEntity("n1", testEntityType, "a"))
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.False(t, actual.Resync)
+	assert.Empty(t, actual.Updates)
+	assert.Equal(t, []string{"a"}, actual.DeletedKeys)
+
+	// A gateway which reconnects with an earlier cursor receives everything
+	// which changed since
+	updates, err := grpcClient.GetUpdates(context.Background(), &protos.StreamRequest{GatewayId: "hwId", StreamName: "watch1", Cursor: cursor})
+	assert.NoError(t, err)
+	actual, err = updates.Recv()
+	assert.NoError(t, err)
+	assert.False(t, actual.Resync)
+	assert.Equal(t, []*protos.DataUpdate{{Key: "b", Value: []byte("456")}}, actual.Updates)
+	assert.Equal(t, []string{"a"}, actual.DeletedKeys)
+
+	// An unknown cursor resyncs
+	updates, err = grpcClient.GetUpdates(context.Background(), &protos.StreamRequest{GatewayId: "hwId", StreamName: "watch1", Cursor: "n1:1000"})
+	assert.NoError(t, err)
+	actual, err = updates.Recv()
+	assert.NoError(t, err)
+	assert.True(t, actual.Resync)
+	assert.Equal(t, []*protos.DataUpdate{{Key: "b", Value: []byte("456")}}, actual.Updates)
+
+	// Streams without delta support push a resync when their contents change
+	providers.RegisterStreamProvider(struct{ providers.StreamProvider }{&entityStreamProvider{name: "watch2"}})
+	streamerClient, err = grpcClient.GetUpdates(ctx, &protos.StreamRequest{GatewayId: "hwId", StreamName: "watch2", Watch: true})
+	assert.NoError(t, err)
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, actual.Resync)
+	assert.Equal(t, []*protos.DataUpdate{{Key: "b", Value: []byte("456")}}, actual.Updates)
+	// Changes which don't affect the contents of the stream aren't pushed
+	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: "other", Key: "c"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntity("n1", configurator.NetworkEntity{Type: testEntityType, Key: "d", Name: "789"})
+	assert.NoError(t, err)
+	actual, err = streamerClient.Recv()
+	assert.NoError(t, err)
+	assert.True(t, actual.Resync)
+	assert.Equal(t, []*protos.DataUpdate{{Key: "b", Value: []byte("456")}, {Key: "d", Value: []byte("789")}}, actual.Updates)
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package rollout
+
+import (
+	"encoding/json"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/storage"
+)
+
+const (
+	// LeaseTableName is the name of the table which holds the lease electing
+	// the replica which advances rollouts
+	LeaseTableName = "tier_rollout_lease"
+
+	leaseNetworkID = "rollout"
+	leaseType      = "lease"
+	leaseKey       = "lease"
+)
+
+// lease records which replica advances rollouts, and until when
+type lease struct {
+	Holder    string `json:"holder"`
+	ExpiresAt int64  `json:"expires_at"`
+}
+
+// tryAcquireLease acquires or renews the rollout lease for holder for the
+// given duration, and returns true if holder holds the lease afterwards. The
+// lease can only be acquired by another holder once it expires.
+func tryAcquireLease(factory blobstore.BlobStorageFactory, holder string, duration time.Duration) (bool, error) {
+	store, err := factory.StartTransaction(nil)
+	if err != nil {
+		return false, err
+	}
+	// IncrementVersion locks the lease until the transaction commits, so
+	// concurrent acquisitions are serialized
+	err = store.IncrementVersion(leaseNetworkID, leaseID())
+	if err != nil {
+		store.Rollback()
+		return false, err
+	}
+	blob, err := store.Get(leaseNetworkID, leaseID())
+	if err != nil {
+		store.Rollback()
+		return false, err
+	}
+
+	now := clock.Now()
+	current := lease{}
+	if len(blob.Value) > 0 {
+		if err := json.Unmarshal(blob.Value, &current); err != nil {
+			store.Rollback()
+			return false, err
+		}
+	}
+	if current.Holder != holder && current.ExpiresAt > now.Unix() {
+		return false, store.Commit()
+	}
+
+	marshaled, err := json.Marshal(lease{Holder: holder, ExpiresAt: now.Add(duration).Unix()})
+	if err != nil {
+		store.Rollback()
+		return false, err
+	}
+	err = store.CreateOrUpdate(leaseNetworkID, []blobstore.Blob{{Type: leaseType, Key: leaseKey, Value: marshaled}})
+	if err != nil {
+		store.Rollback()
+		return false, err
+	}
+	return true, store.Commit()
+}
+
+func leaseID() storage.TypeAndKey {
+	return storage.TypeAndKey{Type: leaseType, Key: leaseKey}
+}
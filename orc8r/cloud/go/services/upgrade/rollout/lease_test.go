@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTryAcquireLease(t *testing.T) {
+	factory := blobstore.NewMemoryBlobStorageFactory()
+	now := time.Unix(1000, 0)
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+
+	acquired, err := tryAcquireLease(factory, "r1", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	// Only the holder can renew the lease until it expires
+	clock.SetAndFreezeClock(t, now.Add(30*time.Second))
+	acquired, err = tryAcquireLease(factory, "r2", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+	acquired, err = tryAcquireLease(factory, "r1", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+
+	clock.SetAndFreezeClock(t, now.Add(80*time.Second))
+	acquired, err = tryAcquireLease(factory, "r2", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+
+	// Once r1 stops renewing, r2 takes over
+	clock.SetAndFreezeClock(t, now.Add(91*time.Second))
+	acquired, err = tryAcquireLease(factory, "r2", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, acquired)
+	acquired, err = tryAcquireLease(factory, "r1", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, acquired)
+}
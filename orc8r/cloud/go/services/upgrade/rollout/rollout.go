@@ -0,0 +1,292 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+// Package rollout periodically advances the rollouts of upgrade tiers. Each
+// running rollout upgrades its tier's gateways batch by batch, and halts when
+// too many of the upgraded gateways stop checking in.
+package rollout
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/blobstore"
+	"magma/orc8r/cloud/go/clock"
+	merrors "magma/orc8r/cloud/go/errors"
+	models1 "magma/orc8r/cloud/go/models"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/state"
+
+	"github.com/go-openapi/swag"
+	"github.com/golang/glog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CheckinTimeout is how long an upgraded gateway may go without checking in
+// before it counts as unhealthy
+const CheckinTimeout = time.Minute * 5
+
+// leaseIntervals is the number of run intervals the rollout lease lasts
+const leaseIntervals = 3
+
+// Runner advances the rollouts of all tiers from a single replica. Replicas
+// compete for a lease stored in leaseStore, and only the holder of the lease
+// advances rollouts.
+type Runner struct {
+	leaseStore blobstore.BlobStorageFactory
+	holderID   string
+}
+
+// NewRunner returns a Runner which competes for the rollout lease in
+// leaseStore as holderID. holderID must be unique across replicas.
+func NewRunner(leaseStore blobstore.BlobStorageFactory, holderID string) *Runner {
+	return &Runner{leaseStore: leaseStore, holderID: holderID}
+}
+
+// Run advances the rollouts of all tiers every interval while the runner
+// holds the rollout lease. The lease lasts for a few intervals, so another
+// replica takes over if this one stops renewing it. This function never
+// returns.
+func (r *Runner) Run(interval time.Duration) {
+	for range time.Tick(interval) {
+		isLeader, err := tryAcquireLease(r.leaseStore, r.holderID, leaseIntervals*interval)
+		if err != nil {
+			glog.Errorf("Error acquiring tier rollout lease: %v", err)
+			continue
+		}
+		if !isLeader {
+			continue
+		}
+		err = UpdateRollouts()
+		if err != nil {
+			glog.Errorf("Error updating tier rollouts: %v", err)
+		}
+	}
+}
+
+// UpdateRollouts advances the running rollouts of all tiers across all
+// networks. Errors encountered for one network do not prevent the others
+// from being updated; the last such error is returned.
+func UpdateRollouts() error {
+	networkIDs, err := configurator.ListNetworkIDs()
+	if err != nil {
+		return fmt.Errorf("failed to list networks: %v", err)
+	}
+	var lastErr error
+	for _, networkID := range networkIDs {
+		err = updateNetworkRollouts(networkID)
+		if err != nil {
+			glog.Errorf("Error updating tier rollouts for network %s: %v", networkID, err)
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func updateNetworkRollouts(networkID string) error {
+	tierEnts, _, err := configurator.LoadEntities(
+		networkID, swag.String(orc8r.UpgradeTierEntityType), nil, nil, nil,
+		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true, LoadMetadata: true},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load tiers: %v", err)
+	}
+	var tiers []*models.Tier
+	versions := map[models.TierID]uint64{}
+	for _, ent := range tierEnts {
+		tier := (&models.Tier{}).FromBackendModel(ent)
+		if tier.Rollout.IsInProgress() && tier.Rollout.Status.State == models.TierRolloutStatusStateRUNNING {
+			tiers = append(tiers, tier)
+			versions[tier.ID] = ent.Version
+		}
+	}
+	if len(tiers) == 0 {
+		return nil
+	}
+
+	gatewayEnts, _, err := configurator.LoadEntities(
+		networkID, swag.String(orc8r.MagmadGatewayType), nil, nil, nil,
+		configurator.EntityLoadCriteria{},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to load gateways: %v", err)
+	}
+	physicalIDs := map[string]string{}
+	for _, ent := range gatewayEnts {
+		physicalIDs[ent.Key] = ent.PhysicalID
+	}
+	now := clock.Now()
+	isHealthy := func(gatewayID models1.GatewayID) bool {
+		return isGatewayHealthy(networkID, physicalIDs[string(gatewayID)], now)
+	}
+
+	var lastErr error
+	for _, tier := range tiers {
+		if !advanceRollout(tier, isHealthy, now) {
+			continue
+		}
+		glog.Infof("Rollout of tier %s in network %s is %s after batch %d", tier.ID, networkID, tier.Rollout.Status.State, tier.Rollout.Status.Batch)
+		// The tier may have been changed since it was loaded, e.g. by an
+		// operator pausing the rollout, in which case the next update
+		// advances the rollout from the changed tier
+		_, err = configurator.UpdateEntity(
+			networkID,
+			configurator.EntityUpdateCriteria{
+				Type:            orc8r.UpgradeTierEntityType,
+				Key:             string(tier.ID),
+				NewConfig:       tier,
+				ExpectedVersion: swag.Uint64(versions[tier.ID]),
+			},
+		)
+		if status.Code(err) == codes.Aborted {
+			glog.Infof("Tier %s in network %s changed during rollout update, retrying next update", tier.ID, networkID)
+			continue
+		}
+		if err != nil {
+			lastErr = fmt.Errorf("failed to update tier %s: %v", tier.ID, err)
+		}
+	}
+	return lastErr
+}
+
+// isGatewayHealthy returns true if the gateway checked in within the
+// checkin timeout
+func isGatewayHealthy(networkID string, physicalID string, now time.Time) bool {
+	if physicalID == "" {
+		return false
+	}
+	status, err := state.GetGatewayStatus(networkID, physicalID)
+	if err != nil {
+		if err != merrors.ErrNotFound {
+			glog.Errorf("Error getting gateway status for network %s, hardware ID %s: %v", networkID, physicalID, err)
+		}
+		return false
+	}
+	lastCheckin := time.Unix(0, int64(status.CheckinTime)*int64(time.Millisecond))
+	return now.Sub(lastCheckin) <= CheckinTimeout
+}
+
+// advanceRollout moves the running rollout of the tier forward and returns
+// true if it changed. Once the batch interval has passed since the last
+// batch, the upgraded gateways are checked; if too many of them are
+// unhealthy the rollout is paused or rolled back, otherwise the next batch is
+// upgraded. The rollout completes when no gateways are left to upgrade.
+func advanceRollout(tier *models.Tier, isHealthy func(models1.GatewayID) bool, now time.Time) bool {
+	rollout := tier.Rollout
+	status := rollout.Status
+
+	// gateways removed from the tier are no longer part of the rollout
+	inTier := map[models1.GatewayID]bool{}
+	for _, gatewayID := range tier.Gateways {
+		inTier[gatewayID] = true
+	}
+	upgraded := models.TierGateways{}
+	for _, gatewayID := range status.UpgradedGateways {
+		if inTier[gatewayID] {
+			upgraded = append(upgraded, gatewayID)
+		}
+	}
+
+	if status.Batch > 0 {
+		if now.Unix()-status.UpdatedAt < int64(rollout.BatchInterval) {
+			return false
+		}
+		unhealthy := models.TierGateways{}
+		for _, gatewayID := range upgraded {
+			if !isHealthy(gatewayID) {
+				unhealthy = append(unhealthy, gatewayID)
+			}
+		}
+		if len(unhealthy)*100 > int(rollout.MaxUnhealthyPercent)*len(upgraded) {
+			status.UnhealthyGateways = unhealthy
+			status.UpdatedAt = now.Unix()
+			status.State = models.TierRolloutStatusStatePAUSED
+			if rollout.AutoRollback {
+				status.State = models.TierRolloutStatusStateROLLEDBACK
+			}
+			status.Message = fmt.Sprintf("%d of %d upgraded gateways failed to check in", len(unhealthy), len(upgraded))
+			return true
+		}
+	}
+
+	batch, batchCount := nextBatch(tier, rollout, upgraded)
+	status.UnhealthyGateways = nil
+	status.UpdatedAt = now.Unix()
+	if len(batch) == 0 {
+		// Completing the rollout moves the whole tier to the target version,
+		// which would upgrade the gateways left out of the waves
+		notUpgraded := getNotUpgradedGateways(tier, upgraded)
+		if len(notUpgraded) > 0 {
+			status.UpgradedGateways = upgraded
+			status.State = models.TierRolloutStatusStatePAUSED
+			status.Message = fmt.Sprintf("gateways %v are not part of any rollout wave, remove them from the tier or add them in a new rollout", notUpgraded)
+			return true
+		}
+		tier.Version = rollout.TargetVersion
+		status.UpgradedGateways = upgraded
+		status.State = models.TierRolloutStatusStateCOMPLETED
+		return true
+	}
+	status.UpgradedGateways = append(upgraded, batch...)
+	status.Batch += batchCount
+	return true
+}
+
+// getNotUpgradedGateways returns the gateways of the tier which haven't been
+// upgraded
+func getNotUpgradedGateways(tier *models.Tier, upgraded models.TierGateways) models.TierGateways {
+	isUpgraded := map[models1.GatewayID]bool{}
+	for _, gatewayID := range upgraded {
+		isUpgraded[gatewayID] = true
+	}
+	ret := models.TierGateways{}
+	for _, gatewayID := range tier.Gateways {
+		if !isUpgraded[gatewayID] {
+			ret = append(ret, gatewayID)
+		}
+	}
+	return ret
+}
+
+// nextBatch returns the gateways to upgrade in the next batch of the rollout
+// and the number of batches they account for. Waves whose gateways are all
+// gone from the tier are skipped.
+func nextBatch(tier *models.Tier, rollout *models.TierRollout, upgraded models.TierGateways) (models.TierGateways, uint32) {
+	remaining := getNotUpgradedGateways(tier, upgraded)
+	if len(rollout.Waves) > 0 {
+		for i := int(rollout.Status.Batch); i < len(rollout.Waves); i++ {
+			batch := models.TierGateways{}
+			for _, gatewayID := range rollout.Waves[i] {
+				for _, remainingID := range remaining {
+					if gatewayID == remainingID {
+						batch = append(batch, gatewayID)
+					}
+				}
+			}
+			if len(batch) > 0 {
+				return batch, uint32(i+1) - rollout.Status.Batch
+			}
+		}
+		return nil, 0
+	}
+
+	// upgrade gateways in a deterministic order
+	sort.Slice(remaining, func(i, j int) bool { return remaining[i] < remaining[j] })
+	batchSize := (len(tier.Gateways)*int(rollout.BatchPercent) + 99) / 100
+	if batchSize == 0 {
+		batchSize = 1
+	}
+	if batchSize > len(remaining) {
+		batchSize = len(remaining)
+	}
+	return remaining[:batchSize], 1
+}
@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) Facebook, Inc. and its affiliates.
+ * All rights reserved.
+ *
+ * This source code is licensed under the BSD-style license found in the
+ * LICENSE file in the root directory of this source tree.
+ */
+
+package rollout_test
+
+import (
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	models1 "magma/orc8r/cloud/go/models"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	configuratorTestInit "magma/orc8r/cloud/go/services/configurator/test_init"
+	stateTestInit "magma/orc8r/cloud/go/services/state/test_init"
+	stateTestUtils "magma/orc8r/cloud/go/services/state/test_utils"
+	"magma/orc8r/cloud/go/services/upgrade/rollout"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateRollouts(t *testing.T) {
+	setupTest(t, "g1", "g2", "g3", "g4")
+	now := time.Now()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+	createTier(t, &models.TierRollout{
+		TargetVersion: "2.0.0-1",
+		BatchPercent:  50,
+		BatchInterval: 60,
+		Status: &models.TierRolloutStatus{
+			State:     models.TierRolloutStatusStateRUNNING,
+			StartedAt: now.Unix(),
+			UpdatedAt: now.Unix(),
+		},
+	}, "g1", "g2", "g3", "g4")
+
+	// first batch is upgraded right away
+	assert.NoError(t, rollout.UpdateRollouts())
+	tier := loadTier(t)
+	assert.Equal(t, models.TierRolloutStatusStateRUNNING, tier.Rollout.Status.State)
+	assert.Equal(t, uint32(1), tier.Rollout.Status.Batch)
+	assert.Equal(t, models.TierGateways{"g1", "g2"}, tier.Rollout.Status.UpgradedGateways)
+	assert.Equal(t, models.TierVersion("2.0.0-1"), tier.GetGatewayVersion("g1"))
+	assert.Equal(t, models.TierVersion("1.0.0-1"), tier.GetGatewayVersion("g3"))
+
+	// nothing happens until the batch interval has passed
+	assert.NoError(t, rollout.UpdateRollouts())
+	assert.Equal(t, tier, loadTier(t))
+
+	reportStatus(t, "hw_g1", "hw_g2")
+	clock.SetAndFreezeClock(t, now.Add(90*time.Second))
+	assert.NoError(t, rollout.UpdateRollouts())
+	tier = loadTier(t)
+	assert.Equal(t, models.TierRolloutStatusStateRUNNING, tier.Rollout.Status.State)
+	assert.Equal(t, uint32(2), tier.Rollout.Status.Batch)
+	assert.Equal(t, models.TierGateways{"g1", "g2", "g3", "g4"}, tier.Rollout.Status.UpgradedGateways)
+
+	// g4 never checks in, which pauses the rollout
+	reportStatus(t, "hw_g3")
+	clock.SetAndFreezeClock(t, now.Add(180*time.Second))
+	assert.NoError(t, rollout.UpdateRollouts())
+	tier = loadTier(t)
+	assert.Equal(t, models.TierRolloutStatusStatePAUSED, tier.Rollout.Status.State)
+	assert.Equal(t, models.TierGateways{"g4"}, tier.Rollout.Status.UnhealthyGateways)
+	assert.Equal(t, "1 of 4 upgraded gateways failed to check in", tier.Rollout.Status.Message)
+	assert.Equal(t, models.TierVersion("2.0.0-1"), tier.GetGatewayVersion("g4"))
+
+	// paused rollouts are left alone
+	reportStatus(t, "hw_g4")
+	clock.SetAndFreezeClock(t, now.Add(270*time.Second))
+	assert.NoError(t, rollout.UpdateRollouts())
+	assert.Equal(t, tier, loadTier(t))
+
+	// once resumed and healthy, the rollout completes
+	tier.Rollout.Status.State = models.TierRolloutStatusStateRUNNING
+	updateTier(t, tier)
+	assert.NoError(t, rollout.UpdateRollouts())
+	tier = loadTier(t)
+	assert.Equal(t, models.TierRolloutStatusStateCOMPLETED, tier.Rollout.Status.State)
+	assert.Equal(t, models.TierVersion("2.0.0-1"), tier.Version)
+	assert.Empty(t, tier.Rollout.Status.UnhealthyGateways)
+}
+
+func TestUpdateRollouts_WavesAndRollback(t *testing.T) {
+	setupTest(t, "g5", "g6", "g7")
+	now := time.Now()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+	createTier(t, &models.TierRollout{
+		TargetVersion:       "2.0.0-1",
+		Waves:               []models.TierGateways{{"g6"}, {"g5", "g7"}},
+		BatchInterval:       60,
+		MaxUnhealthyPercent: 50,
+		AutoRollback:        true,
+		Status: &models.TierRolloutStatus{
+			State:     models.TierRolloutStatusStateRUNNING,
+			StartedAt: now.Unix(),
+			UpdatedAt: now.Unix(),
+		},
+	}, "g5", "g6", "g7")
+
+	assert.NoError(t, rollout.UpdateRollouts())
+	tier := loadTier(t)
+	assert.Equal(t, uint32(1), tier.Rollout.Status.Batch)
+	assert.Equal(t, models.TierGateways{"g6"}, tier.Rollout.Status.UpgradedGateways)
+
+	// g6 never checks in, which rolls the rollout back
+	reportStatus(t, "hw_g5", "hw_g7")
+	clock.SetAndFreezeClock(t, now.Add(90*time.Second))
+	assert.NoError(t, rollout.UpdateRollouts())
+	tier = loadTier(t)
+	assert.Equal(t, models.TierRolloutStatusStateROLLEDBACK, tier.Rollout.Status.State)
+	assert.Equal(t, models.TierGateways{"g6"}, tier.Rollout.Status.UnhealthyGateways)
+	assert.Equal(t, models.TierVersion("1.0.0-1"), tier.Version)
+	assert.Equal(t, models.TierVersion("1.0.0-1"), tier.GetGatewayVersion("g6"))
+}
+
+func TestUpdateRollouts_GatewayOutsideWaves(t *testing.T) {
+	setupTest(t, "g8", "g9")
+	now := time.Now()
+	clock.SetAndFreezeClock(t, now)
+	defer clock.UnfreezeClock(t)
+	// g9 joined the tier after the rollout started
+	createTier(t, &models.TierRollout{
+		TargetVersion: "2.0.0-1",
+		Waves:         []models.TierGateways{{"g8"}},
+		BatchInterval: 60,
+		Status: &models.TierRolloutStatus{
+			State:     models.TierRolloutStatusStateRUNNING,
+			StartedAt: now.Unix(),
+			UpdatedAt: now.Unix(),
+		},
+	}, "g8", "g9")
+
+	assert.NoError(t, rollout.UpdateRollouts())
+	reportStatus(t, "hw_g8")
+	clock.SetAndFreezeClock(t, now.Add(90*time.Second))
+	assert.NoError(t, rollout.UpdateRollouts())
+
+	// Completing the rollout would upgrade g9, so it's paused instead
+	tier := loadTier(t)
+	assert.Equal(t, models.TierRolloutStatusStatePAUSED, tier.Rollout.Status.State)
+	assert.Equal(t, "gateways [g9] are not part of any rollout wave, remove them from the tier or add them in a new rollout", tier.Rollout.Status.Message)
+	assert.Equal(t, models.TierVersion("1.0.0-1"), tier.Version)
+	assert.Equal(t, models.TierVersion("2.0.0-1"), tier.GetGatewayVersion("g8"))
+	assert.Equal(t, models.TierVersion("1.0.0-1"), tier.GetGatewayVersion("g9"))
+}
+
+func setupTest(t *testing.T, gatewayIDs ...string) {
+	plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	configuratorTestInit.StartTestService(t)
+	stateTestInit.StartTestService(t)
+
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1"})
+	assert.NoError(t, err)
+	var gateways []configurator.NetworkEntity
+	for _, gatewayID := range gatewayIDs {
+		gateways = append(gateways, configurator.NetworkEntity{Type: orc8r.MagmadGatewayType, Key: gatewayID, PhysicalID: "hw_" + gatewayID})
+	}
+	_, err = configurator.CreateEntities("n1", gateways)
+	assert.NoError(t, err)
+}
+
+func createTier(t *testing.T, tierRollout *models.TierRollout, gatewayIDs ...models1.GatewayID) {
+	tier := &models.Tier{
+		ID:       "t1",
+		Version:  "1.0.0-1",
+		Images:   models.TierImages{},
+		Gateways: gatewayIDs,
+		Rollout:  tierRollout,
+	}
+	_, err := configurator.CreateEntity("n1", tier.ToNetworkEntity())
+	assert.NoError(t, err)
+}
+
+func loadTier(t *testing.T) *models.Tier {
+	ent, err := configurator.LoadEntity(
+		"n1", orc8r.UpgradeTierEntityType, "t1",
+		configurator.EntityLoadCriteria{LoadConfig: true, LoadAssocsFromThis: true, LoadMetadata: true},
+	)
+	assert.NoError(t, err)
+	return (&models.Tier{}).FromBackendModel(ent)
+}
+
+func updateTier(t *testing.T, tier *models.Tier) {
+	_, err := configurator.UpdateEntity("n1", configurator.EntityUpdateCriteria{
+		Type: orc8r.UpgradeTierEntityType, Key: string(tier.ID), NewConfig: tier,
+	})
+	assert.NoError(t, err)
+}
+
+func reportStatus(t *testing.T, hwIDs ...string) {
+	for _, hwID := range hwIDs {
+		ctx := stateTestUtils.GetContextWithCertificate(t, hwID)
+		stateTestUtils.ReportGatewayStatus(t, ctx, models.NewDefaultGatewayStatus(hwID))
+	}
+}
@@ -39,8 +39,10 @@ var postgresColumnTypeMap = map[ColumnType]string{
 	ColumnTypeText: "TEXT",
 	ColumnTypeInt:  "INTEGER",
 	// BYTEA is effectively limited to 1GB
-	ColumnTypeBytes: "BYTEA",
-	ColumnTypeBool:  "BOOLEAN",
+	ColumnTypeBytes:  "BYTEA",
+	ColumnTypeBool:   "BOOLEAN",
+	ColumnTypeBigInt: "BIGINT",
+	ColumnTypeSerial: "BIGSERIAL",
 }
 
 var mariaColumnTypeMap = map[ColumnType]string{
@@ -49,8 +51,11 @@ var mariaColumnTypeMap = map[ColumnType]string{
 	ColumnTypeInt:  "INT",
 	// LONGBLOB stores up to 4GB and the cost is a flat extra 2 bytes of
 	// storage over BLOB, which is limited to 64KB
-	ColumnTypeBytes: "LONGBLOB",
-	ColumnTypeBool:  "BOOLEAN",
+	ColumnTypeBytes:  "LONGBLOB",
+	ColumnTypeBool:   "BOOLEAN",
+	ColumnTypeBigInt: "BIGINT",
+	// AUTO_INCREMENT columns must be a key, e.g. the primary key
+	ColumnTypeSerial: "BIGINT AUTO_INCREMENT",
 }
 
 // ColumnOnDeleteOption is an enum type to specify ON DELETE behavior for
@@ -71,6 +76,10 @@ const (
 	ColumnTypeInt
 	ColumnTypeBytes
 	ColumnTypeBool
+	ColumnTypeBigInt
+	// ColumnTypeSerial is a BIGINT which the database assigns from an
+	// auto-incrementing sequence when it isn't set on insert
+	ColumnTypeSerial
 	// Fill in other types as needed
 )
 
@@ -0,0 +1,257 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// Package bundle exports networks into self-describing archives and imports
+// them back into the same or a different network.
+//
+// A bundle is a gzipped tarball which holds:
+//
+//	manifest.json        - the bundle format and version, and a summary of
+//	                       the contents
+//	network.json         - the network and all of its configurator entities
+//	gateway_devices.json - the device records of the network's gateways,
+//	                       keyed by hardware ID
+package bundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	"magma/orc8r/cloud/go/services/device"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/pkg/errors"
+)
+
+const (
+	// Format identifies network bundles
+	Format = "magma-network-bundle"
+	// Version of the bundle format written by this package. Bundles of newer
+	// versions are rejected.
+	Version = 1
+
+	manifestFile       = "manifest.json"
+	networkFile        = "network.json"
+	gatewayDevicesFile = "gateway_devices.json"
+)
+
+// Manifest describes the contents of a bundle
+type Manifest struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	NetworkID string    `json:"network_id"`
+	CreatedAt time.Time `json:"created_at"`
+	// EntityCounts is the number of exported entities of each type
+	EntityCounts       map[string]int `json:"entity_counts"`
+	GatewayDeviceCount int            `json:"gateway_device_count"`
+}
+
+// Bundle is an exported network
+type Bundle struct {
+	Manifest Manifest
+	Network  *protos.NetworkExport
+	// GatewayDevices are the device records of the network's gateways keyed
+	// by hardware ID
+	GatewayDevices map[string]*models.GatewayDevice
+}
+
+// Export exports a network along with the device records of its gateways
+func Export(networkID string) (*Bundle, error) {
+	export, err := configurator.ExportNetwork(networkID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to export network")
+	}
+
+	ret := &Bundle{
+		Manifest: Manifest{
+			Format:       Format,
+			Version:      Version,
+			NetworkID:    networkID,
+			CreatedAt:    clock.Now().UTC(),
+			EntityCounts: map[string]int{},
+		},
+		Network:        export,
+		GatewayDevices: map[string]*models.GatewayDevice{},
+	}
+	var hardwareIDs []string
+	for _, entity := range export.Entities {
+		ret.Manifest.EntityCounts[entity.Type]++
+		if entity.Type == orc8r.MagmadGatewayType && entity.PhysicalID != "" {
+			hardwareIDs = append(hardwareIDs, entity.PhysicalID)
+		}
+	}
+	devices, err := device.GetDevices(networkID, orc8r.AccessGatewayRecordType, hardwareIDs)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load gateway devices")
+	}
+	for hardwareID, iDevice := range devices {
+		ret.GatewayDevices[hardwareID] = iDevice.(*models.GatewayDevice)
+	}
+	ret.Manifest.GatewayDeviceCount = len(ret.GatewayDevices)
+	return ret, nil
+}
+
+// Import imports the bundle into the target network of the options. Device
+// records are written after the network, so a failure to write them leaves
+// the imported network in place.
+func Import(bundle *Bundle, opts configurator.ImportNetworkOptions) (configurator.ImportNetworkResult, error) {
+	networkID := opts.TargetNetworkID
+	if networkID == "" {
+		networkID = bundle.Network.GetNetwork().GetID()
+	}
+
+	devices := make(map[string]*models.GatewayDevice, len(bundle.GatewayDevices))
+	for hardwareID, gatewayDevice := range bundle.GatewayDevices {
+		if newHardwareID, ok := opts.PhysicalIDRemaps[hardwareID]; ok {
+			hardwareID = newHardwareID
+		}
+		devices[hardwareID] = &models.GatewayDevice{HardwareID: hardwareID, Key: gatewayDevice.Key}
+	}
+	existingDevices, err := device.GetDevices(networkID, orc8r.AccessGatewayRecordType, sortedKeys(devices))
+	if err != nil {
+		return configurator.ImportNetworkResult{}, errors.Wrap(err, "failed to load existing gateway devices")
+	}
+	if len(existingDevices) > 0 && opts.ConflictPolicy == protos.ImportNetworkRequest_FAIL {
+		return configurator.ImportNetworkResult{}, fmt.Errorf("%d of the imported gateway devices already exist in network %s", len(existingDevices), networkID)
+	}
+
+	res, err := configurator.ImportNetwork(bundle.Network, opts)
+	if err != nil {
+		return res, errors.Wrap(err, "failed to import network")
+	}
+	for _, hardwareID := range sortedKeys(devices) {
+		_, exists := existingDevices[hardwareID]
+		switch {
+		case !exists:
+			err = device.RegisterDevice(networkID, orc8r.AccessGatewayRecordType, hardwareID, devices[hardwareID])
+		case opts.ConflictPolicy == protos.ImportNetworkRequest_OVERWRITE:
+			err = device.UpdateDevice(networkID, orc8r.AccessGatewayRecordType, hardwareID, devices[hardwareID])
+		}
+		if err != nil {
+			return res, errors.Wrapf(err, "failed to import gateway device %s", hardwareID)
+		}
+	}
+	return res, nil
+}
+
+// Write writes the bundle as a gzipped tarball
+func (b *Bundle) Write(w io.Writer) error {
+	networkJSON := &bytes.Buffer{}
+	marshaler := jsonpb.Marshaler{Indent: "  "}
+	if err := marshaler.Marshal(networkJSON, b.Network); err != nil {
+		return errors.Wrap(err, "failed to marshal network")
+	}
+	manifestJSON, err := json.MarshalIndent(b.Manifest, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal manifest")
+	}
+	devicesJSON, err := json.MarshalIndent(b.GatewayDevices, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal gateway devices")
+	}
+
+	gzw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gzw)
+	// The manifest comes first so that readers can check the format before
+	// reading anything else
+	files := []struct {
+		name string
+		body []byte
+	}{
+		{manifestFile, manifestJSON},
+		{networkFile, networkJSON.Bytes()},
+		{gatewayDevicesFile, devicesJSON},
+	}
+	for _, file := range files {
+		header := &tar.Header{Name: file.name, Mode: 0644, Size: int64(len(file.body)), ModTime: b.Manifest.CreatedAt}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(file.body); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gzw.Close()
+}
+
+// Read reads a bundle written by Write, failing if it isn't a network bundle
+// or if its version is newer than this package supports
+func Read(r io.Reader) (*Bundle, error) {
+	gzr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "bundle is not gzipped")
+	}
+	tr := tar.NewReader(gzr)
+	files := map[string][]byte{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read bundle")
+		}
+		files[header.Name], err = ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read %s", header.Name)
+		}
+	}
+
+	ret := &Bundle{Network: &protos.NetworkExport{}, GatewayDevices: map[string]*models.GatewayDevice{}}
+	manifestJSON, ok := files[manifestFile]
+	if !ok {
+		return nil, fmt.Errorf("bundle has no %s", manifestFile)
+	}
+	if err := json.Unmarshal(manifestJSON, &ret.Manifest); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s", manifestFile)
+	}
+	if ret.Manifest.Format != Format {
+		return nil, fmt.Errorf("unrecognized bundle format %q", ret.Manifest.Format)
+	}
+	if ret.Manifest.Version < 1 || ret.Manifest.Version > Version {
+		return nil, fmt.Errorf("unsupported bundle version %d, expected at most %d", ret.Manifest.Version, Version)
+	}
+
+	networkJSON, ok := files[networkFile]
+	if !ok {
+		return nil, fmt.Errorf("bundle has no %s", networkFile)
+	}
+	if err := jsonpb.Unmarshal(bytes.NewReader(networkJSON), ret.Network); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal %s", networkFile)
+	}
+	if devicesJSON, ok := files[gatewayDevicesFile]; ok {
+		if err := json.Unmarshal(devicesJSON, &ret.GatewayDevices); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal %s", gatewayDevicesFile)
+		}
+	}
+	return ret, nil
+}
+
+func sortedKeys(devices map[string]*models.GatewayDevice) []string {
+	ret := make([]string, 0, len(devices))
+	for hardwareID := range devices {
+		ret = append(ret, hardwareID)
+	}
+	sort.Strings(ret)
+	return ret
+}
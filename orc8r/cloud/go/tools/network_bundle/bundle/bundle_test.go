@@ -0,0 +1,140 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package bundle_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+	"time"
+
+	"magma/orc8r/cloud/go/clock"
+	"magma/orc8r/cloud/go/orc8r"
+	"magma/orc8r/cloud/go/plugin"
+	"magma/orc8r/cloud/go/pluginimpl"
+	"magma/orc8r/cloud/go/pluginimpl/models"
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	configuratorTestInit "magma/orc8r/cloud/go/services/configurator/test_init"
+	"magma/orc8r/cloud/go/services/device"
+	deviceTestInit "magma/orc8r/cloud/go/services/device/test_init"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/cloud/go/tools/network_bundle/bundle"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportImport(t *testing.T) {
+	_ = plugin.RegisterPluginForTests(t, &pluginimpl.BaseOrchestratorPlugin{})
+	configuratorTestInit.StartTestService(t)
+	deviceTestInit.StartTestService(t)
+	clock.SetAndFreezeClock(t, time.Unix(1000000, 0))
+	defer clock.UnfreezeClock(t)
+
+	err := configurator.CreateNetwork(configurator.Network{ID: "n1", Name: "staging"})
+	assert.NoError(t, err)
+	_, err = configurator.CreateEntities("n1", []configurator.NetworkEntity{
+		{Type: orc8r.MagmadGatewayType, Key: "g1", PhysicalID: "hw1", Labels: map[string]string{"site": "north"}},
+		{Type: orc8r.UpgradeTierEntityType, Key: "t1", Associations: []storage.TypeAndKey{{Type: orc8r.MagmadGatewayType, Key: "g1"}}},
+	})
+	assert.NoError(t, err)
+	gatewayDevice := &models.GatewayDevice{HardwareID: "hw1", Key: &models.ChallengeKey{KeyType: "ECHO"}}
+	assert.NoError(t, device.RegisterDevice("n1", orc8r.AccessGatewayRecordType, "hw1", gatewayDevice))
+
+	exported, err := bundle.Export("n1")
+	assert.NoError(t, err)
+	assert.Equal(t, bundle.Manifest{
+		Format:             bundle.Format,
+		Version:            bundle.Version,
+		NetworkID:          "n1",
+		CreatedAt:          time.Unix(1000000, 0).UTC(),
+		EntityCounts:       map[string]int{orc8r.MagmadGatewayType: 1, orc8r.UpgradeTierEntityType: 1},
+		GatewayDeviceCount: 1,
+	}, exported.Manifest)
+	assert.Equal(t, map[string]*models.GatewayDevice{"hw1": gatewayDevice}, exported.GatewayDevices)
+
+	// Round trip through the archive
+	buf := &bytes.Buffer{}
+	assert.NoError(t, exported.Write(buf))
+	read, err := bundle.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, exported.Manifest, read.Manifest)
+	assert.Equal(t, exported.GatewayDevices, read.GatewayDevices)
+	assert.Equal(t, exported.Network.String(), read.Network.String())
+
+	// Clone into another network
+	opts := configurator.ImportNetworkOptions{
+		TargetNetworkID:  "n2",
+		KeyRemaps:        map[storage.TypeAndKey]string{{Type: orc8r.MagmadGatewayType, Key: "g1"}: "g2"},
+		PhysicalIDRemaps: map[string]string{"hw1": "hw2"},
+	}
+	res, err := bundle.Import(read, opts)
+	assert.NoError(t, err)
+	assert.True(t, res.NetworkCreated)
+	assert.Len(t, res.Created, 2)
+
+	gateway, err := configurator.LoadEntity("n2", orc8r.MagmadGatewayType, "g2", configurator.FullEntityLoadCriteria())
+	assert.NoError(t, err)
+	assert.Equal(t, "hw2", gateway.PhysicalID)
+	assert.Equal(t, map[string]string{"site": "north"}, gateway.Labels)
+	assert.Equal(t, []storage.TypeAndKey{{Type: orc8r.UpgradeTierEntityType, Key: "t1"}}, gateway.ParentAssociations)
+	clonedDevice, err := device.GetDevice("n2", orc8r.AccessGatewayRecordType, "hw2")
+	assert.NoError(t, err)
+	assert.Equal(t, &models.GatewayDevice{HardwareID: "hw2", Key: gatewayDevice.Key}, clonedDevice)
+
+	// Existing devices fail the import before anything is written
+	_, err = bundle.Import(read, opts)
+	assert.EqualError(t, err, "1 of the imported gateway devices already exist in network n2")
+
+	opts.ConflictPolicy = protos.ImportNetworkRequest_OVERWRITE
+	res, err = bundle.Import(read, opts)
+	assert.NoError(t, err)
+	assert.Len(t, res.Overwritten, 2)
+}
+
+func TestRead_Invalid(t *testing.T) {
+	_, err := bundle.Read(bytes.NewReader([]byte("not a bundle")))
+	assert.Error(t, err)
+
+	_, err = bundle.Read(makeArchive(t, map[string]string{"network.json": "{}"}))
+	assert.EqualError(t, err, "bundle has no manifest.json")
+
+	_, err = bundle.Read(makeArchive(t, map[string]string{"manifest.json": `{"format": "other", "version": 1}`}))
+	assert.EqualError(t, err, `unrecognized bundle format "other"`)
+
+	_, err = bundle.Read(makeArchive(t, map[string]string{"manifest.json": `{"format": "magma-network-bundle", "version": 2}`}))
+	assert.EqualError(t, err, "unsupported bundle version 2, expected at most 1")
+
+	_, err = bundle.Read(makeArchive(t, map[string]string{"manifest.json": `{"format": "magma-network-bundle", "version": 1}`}))
+	assert.EqualError(t, err, "bundle has no network.json")
+
+	read, err := bundle.Read(makeArchive(t, map[string]string{
+		"manifest.json": `{"format": "magma-network-bundle", "version": 1, "network_id": "n1"}`,
+		"network.json":  `{"network": {"ID": "n1"}}`,
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "n1", read.Network.Network.ID)
+	assert.Empty(t, read.GatewayDevices)
+	assert.Equal(t, "n1", read.Manifest.NetworkID)
+}
+
+func makeArchive(t *testing.T, files map[string]string) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	for name, body := range files {
+		assert.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(body))}))
+		_, err := tw.Write([]byte(body))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gzw.Close())
+	return buf
+}
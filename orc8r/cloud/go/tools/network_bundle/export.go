@@ -0,0 +1,63 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"magma/orc8r/cloud/go/tools/network_bundle/bundle"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+var exportOutPath string
+
+func init() {
+	cmdExport := &cobra.Command{
+		Use:   "export <network-id> --out=<bundle-path>",
+		Short: "Export a network into a bundle",
+		Args:  cobra.ExactArgs(1),
+		Run:   exportCmd,
+	}
+	cmdExport.Flags().StringVar(&exportOutPath, "out", "", "path to write the bundle to")
+	cmdExport.MarkFlagRequired("out")
+
+	rootCmd.AddCommand(cmdExport)
+}
+
+func exportCmd(cmd *cobra.Command, args []string) {
+	err := exportNetwork(args[0])
+	if err != nil {
+		glog.Error(err)
+		os.Exit(1)
+	}
+}
+
+func exportNetwork(networkID string) error {
+	b, err := bundle.Export(networkID)
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(exportOutPath)
+	if err != nil {
+		return err
+	}
+	if err := b.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("Exported network %s to %s\n", networkID, exportOutPath)
+	printManifest(b.Manifest)
+	return nil
+}
@@ -0,0 +1,100 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"magma/orc8r/cloud/go/services/configurator"
+	"magma/orc8r/cloud/go/services/configurator/protos"
+	"magma/orc8r/cloud/go/storage"
+	"magma/orc8r/cloud/go/tools/network_bundle/bundle"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importTargetNetworkID  string
+	importKeyRemaps        map[string]string
+	importHardwareIDRemaps map[string]string
+	importOnConflict       string
+)
+
+func init() {
+	cmdImport := &cobra.Command{
+		Use:   "import <bundle-path> [--network=<network-id>] [--remap-key=<type>/<key>=<new-key>...] [--remap-hwid=<hwid>=<new-hwid>...] [--on-conflict=fail|skip|overwrite]",
+		Short: "Import a bundle into a network",
+		Args:  cobra.ExactArgs(1),
+		Run:   importCmd,
+	}
+	cmdImport.Flags().StringVar(&importTargetNetworkID, "network", "", "network to import into, defaults to the exported network")
+	cmdImport.Flags().StringToStringVar(&importKeyRemaps, "remap-key", nil, "new keys for exported entities, as <type>/<key>=<new-key>")
+	cmdImport.Flags().StringToStringVar(&importHardwareIDRemaps, "remap-hwid", nil, "new hardware IDs for exported gateways, as <hwid>=<new-hwid>")
+	cmdImport.Flags().StringVar(&importOnConflict, "on-conflict", "fail", "what to do with existing network and entities: fail, skip or overwrite")
+
+	rootCmd.AddCommand(cmdImport)
+}
+
+func importCmd(cmd *cobra.Command, args []string) {
+	err := importBundle(args[0])
+	if err != nil {
+		glog.Error(err)
+		os.Exit(1)
+	}
+}
+
+func importBundle(path string) error {
+	opts, err := getImportOptions()
+	if err != nil {
+		return err
+	}
+	b, err := readBundle(path)
+	if err != nil {
+		return err
+	}
+	res, err := bundle.Import(b, opts)
+	if err != nil {
+		return err
+	}
+
+	networkID := opts.TargetNetworkID
+	if networkID == "" {
+		networkID = b.Manifest.NetworkID
+	}
+	if res.NetworkCreated {
+		fmt.Printf("Created network %s\n", networkID)
+	}
+	fmt.Printf("Imported %d entities into network %s\n", len(res.Created)+len(res.Overwritten), networkID)
+	fmt.Printf("  created: %d, overwritten: %d, skipped: %d\n", len(res.Created), len(res.Overwritten), len(res.Skipped))
+	return nil
+}
+
+func getImportOptions() (configurator.ImportNetworkOptions, error) {
+	ret := configurator.ImportNetworkOptions{
+		TargetNetworkID:  importTargetNetworkID,
+		KeyRemaps:        map[storage.TypeAndKey]string{},
+		PhysicalIDRemaps: importHardwareIDRemaps,
+	}
+	for from, toKey := range importKeyRemaps {
+		typeAndKey := strings.SplitN(from, "/", 2)
+		if len(typeAndKey) != 2 {
+			return ret, fmt.Errorf("invalid key remap %s, expected <type>/<key>=<new-key>", from)
+		}
+		ret.KeyRemaps[storage.TypeAndKey{Type: typeAndKey[0], Key: typeAndKey[1]}] = toKey
+	}
+	policy, ok := protos.ImportNetworkRequest_ConflictPolicy_value[strings.ToUpper(importOnConflict)]
+	if !ok {
+		return ret, fmt.Errorf("invalid conflict policy %s, expected fail, skip or overwrite", importOnConflict)
+	}
+	ret.ConflictPolicy = protos.ImportNetworkRequest_ConflictPolicy(policy)
+	return ret, nil
+}
@@ -0,0 +1,65 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+
+	"magma/orc8r/cloud/go/tools/network_bundle/bundle"
+
+	"github.com/golang/glog"
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	cmdInspect := &cobra.Command{
+		Use:   "inspect <bundle-path>",
+		Short: "Print the manifest of a bundle",
+		Args:  cobra.ExactArgs(1),
+		Run:   inspectCmd,
+	}
+
+	rootCmd.AddCommand(cmdInspect)
+}
+
+func inspectCmd(cmd *cobra.Command, args []string) {
+	b, err := readBundle(args[0])
+	if err != nil {
+		glog.Error(err)
+		os.Exit(1)
+	}
+	printManifest(b.Manifest)
+}
+
+func readBundle(path string) (*bundle.Bundle, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return bundle.Read(f)
+}
+
+func printManifest(manifest bundle.Manifest) {
+	fmt.Printf("Format:  %s v%d\n", manifest.Format, manifest.Version)
+	fmt.Printf("Network: %s\n", manifest.NetworkID)
+	fmt.Printf("Created: %s\n", manifest.CreatedAt)
+	fmt.Println("Entities:")
+	entityTypes := make([]string, 0, len(manifest.EntityCounts))
+	for entityType := range manifest.EntityCounts {
+		entityTypes = append(entityTypes, entityType)
+	}
+	sort.Strings(entityTypes)
+	for _, entityType := range entityTypes {
+		fmt.Printf("  %s: %d\n", entityType, manifest.EntityCounts[entityType])
+	}
+	fmt.Printf("Gateway devices: %d\n", manifest.GatewayDeviceCount)
+}
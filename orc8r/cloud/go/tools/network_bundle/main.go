@@ -0,0 +1,33 @@
+/*
+Copyright (c) Facebook, Inc. and its affiliates.
+All rights reserved.
+
+This source code is licensed under the BSD-style license found in the
+LICENSE file in the root directory of this source tree.
+*/
+
+// network_bundle exports networks into versioned bundles and imports them
+// back, e.g. to clone a staging network into production or to restore a
+// network after a bad migration.
+package main
+
+import (
+	"os"
+
+	"magma/orc8r/cloud/go/plugin"
+
+	"github.com/spf13/cobra"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "network_bundle",
+	Short: "Export and import networks",
+}
+
+func main() {
+	plugin.LoadAllPluginsFatalOnError(&plugin.DefaultOrchestratorPluginLoader{})
+
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(2)
+	}
+}
@@ -12,6 +12,8 @@ export type aaa_server = {
     accounting_enabled ? : boolean,
     create_session_on_auth ? : boolean,
     idle_session_timeout_ms ? : number,
+    session_store ? : "MEMORY" | "REDIS" | "LOCAL",
+    session_store_path ? : string,
 };
 export type alert_bulk_upload_response = {
     errors: {